@@ -0,0 +1,132 @@
+package swgen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	documentFormatJSON = "json"
+	documentFormatYAML = "yaml"
+
+	// maxCachedDocuments limits number of cached documents rendered for different hosts of requests
+	maxCachedDocuments = 32
+)
+
+// documentCacheKey identifies rendered document, host is a part of key because
+// it is taken from request when generator has no host set
+type documentCacheKey struct {
+	format string
+	host   string
+}
+
+// cachedDocument is a rendered document with its entity tag and lazily compressed data
+type cachedDocument struct {
+	data    []byte
+	etag    string
+	gzipped []byte
+}
+
+// EnableGzip controls gzip encoding of document served by ServeHTTP to clients that accept it
+func (g *Generator) EnableGzip(enabled bool) *Generator {
+	g.cacheMu.Lock()
+	g.gzipEnabled = enabled
+	g.cacheMu.Unlock()
+	return g
+}
+
+// EnableGzip controls gzip encoding of document served by package ServeHTTP
+func EnableGzip(enabled bool) *Generator {
+	return gen.EnableGzip(enabled)
+}
+
+// invalidateCache drops rendered documents and index of operations,
+// it must be called after every change of generator state
+func (g *Generator) invalidateCache() {
+	g.cacheMu.Lock()
+	g.cache = nil
+	g.operations = nil
+	g.cacheVersion++
+	g.cacheMu.Unlock()
+}
+
+// cachedDocument returns rendered document from cache or renders and caches it
+func (g *Generator) cachedDocument(format, host string) (*cachedDocument, error) {
+	g.cacheMu.Lock()
+	if g.hostSet {
+		host = "" // host of request does not affect document
+	}
+	key := documentCacheKey{format: format, host: host}
+	doc, found := g.cache[key]
+	version := g.cacheVersion
+	g.cacheMu.Unlock()
+
+	if found {
+		return doc, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if format == documentFormatYAML {
+		data, err = g.genDocumentYAML(&host)
+	} else {
+		data, err = g.genDocument(&host)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha1.Sum(data)
+	doc = &cachedDocument{data: data, etag: `"` + hex.EncodeToString(sum[:]) + `"`}
+
+	g.cacheMu.Lock()
+	// generator could be changed while document was rendered, such document is not cached,
+	// documents for new hosts are not cached when cache is full
+	if version == g.cacheVersion && len(g.cache) < maxCachedDocuments {
+		if g.cache == nil {
+			g.cache = make(map[documentCacheKey]*cachedDocument)
+		}
+		g.cache[key] = doc
+	}
+	g.cacheMu.Unlock()
+
+	return doc, nil
+}
+
+// gzipData returns compressed document data, it is compressed once for cached document
+func (g *Generator) gzipData(doc *cachedDocument) []byte {
+	g.cacheMu.Lock()
+	gzipped := doc.gzipped
+	g.cacheMu.Unlock()
+
+	if gzipped != nil {
+		return gzipped
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(doc.data)
+	zw.Close()
+
+	g.cacheMu.Lock()
+	doc.gzipped = buf.Bytes()
+	g.cacheMu.Unlock()
+
+	return buf.Bytes()
+}
+
+// etagMatches checks if entity tag is listed in If-None-Match header, weak comparison is used
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package swgen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestServeHTTPCache(t *testing.T) {
+	g := NewGenerator()
+	g.SetPathItem(PathItemInfo{Path: "/persons", Method: "GET"}, nil, nil, []Person{})
+
+	first, err := g.cachedDocument(documentFormatJSON, "")
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	second, _ := g.cachedDocument(documentFormatJSON, "")
+	assertTrue(first == second, t)
+
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/swagger.json", nil))
+	etag := w.Header().Get("ETag")
+	assertTrue(w.Code == http.StatusOK, t)
+	assertTrue(etag == first.etag, t)
+	assertTrue(bytes.Equal(w.Body.Bytes(), first.data), t)
+
+	r := httptest.NewRequest(http.MethodGet, "/docs/swagger.json", nil)
+	r.Header.Set("If-None-Match", `"other", `+etag)
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assertTrue(w.Code == http.StatusNotModified, t)
+	assertTrue(w.Body.Len() == 0, t)
+	assertTrue(w.Header().Get("ETag") == etag, t)
+
+	// YAML document is cached separately
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/swagger.yaml", nil))
+	assertTrue(w.Code == http.StatusOK, t)
+	assertTrue(w.Header().Get("Content-Type") == "application/x-yaml", t)
+	assertTrue(w.Header().Get("ETag") != etag, t)
+
+	// changes of generator invalidate cache
+	g.SetPathItem(PathItemInfo{Path: "/persons", Method: "POST"}, nil, Person{}, nil)
+	third, _ := g.cachedDocument(documentFormatJSON, "")
+	assertTrue(third != first && third.etag != first.etag, t)
+	assertTrue(bytes.Contains(third.data, []byte(`"post"`)), t)
+
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assertTrue(w.Code == http.StatusOK, t)
+	assertTrue(w.Header().Get("ETag") == third.etag, t)
+
+	g.SetInfo("Test", "", "", "1.0")
+	fourth, _ := g.cachedDocument(documentFormatJSON, "")
+	assertTrue(fourth.etag != third.etag, t)
+}
+
+func TestCachedDocumentWithQueuedDefinitions(t *testing.T) {
+	g := NewGenerator()
+	g.SetPathItem(PathItemInfo{Path: "/persons", Method: "GET"}, nil, nil, []Person{})
+	// definitions of queued types are parsed while the first document is rendered
+	g.addToDefQueue(reflect.TypeOf(testDocumentedOwner{}))
+
+	first, err := g.cachedDocument(documentFormatJSON, "")
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(bytes.Contains(first.data, []byte(`"testDocumentedOwner"`)), t)
+
+	second, _ := g.cachedDocument(documentFormatJSON, "")
+	assertTrue(first == second, t)
+
+	// public methods adding definitions invalidate cache
+	g.ParseDefinition(testNullable{})
+	third, _ := g.cachedDocument(documentFormatJSON, "")
+	assertTrue(third != first && bytes.Contains(third.data, []byte(`"testNullable"`)), t)
+}
+
+func TestServeHTTPGzip(t *testing.T) {
+	g := NewGenerator()
+	g.SetPathItem(PathItemInfo{Path: "/persons", Method: "GET"}, nil, nil, []Person{})
+
+	r := httptest.NewRequest(http.MethodGet, "/docs/swagger.json", nil)
+	r.Header.Set("Accept-Encoding", "gzip, deflate")
+
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assertTrue(w.Header().Get("Content-Encoding") == "", t)
+	plain := w.Body.Bytes()
+
+	g.EnableGzip(true)
+
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assertTrue(w.Header().Get("Content-Encoding") == "gzip", t)
+	etag := w.Header().Get("ETag")
+	assertTrue(etag != "" && etag[len(etag)-6:] == `-gzip"`, t)
+
+	zr, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	data, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(bytes.Equal(data, plain), t)
+
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assertTrue(w.Code == http.StatusNotModified, t)
+
+	r = httptest.NewRequest(http.MethodGet, "/docs/swagger.json", nil)
+	r.Header.Set("Accept-Encoding", "gzip;q=0")
+	w = httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assertTrue(w.Header().Get("Content-Encoding") == "", t)
+}
+
+func TestServeHTTPCacheHosts(t *testing.T) {
+	g := NewGenerator()
+
+	request := func(host string) string {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, httptest.NewRequest("GET", "http://"+host+"/docs/swagger.json", nil))
+		var doc struct {
+			Host string `json:"host"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &doc)
+		return doc.Host
+	}
+
+	// documents are rendered for hosts of requests, number of cached documents is limited
+	for i := 0; i < maxCachedDocuments*2; i++ {
+		assertTrue(request(fmt.Sprintf("host%d.example.com", i)) == fmt.Sprintf("host%d.example.com", i), t)
+	}
+	assertTrue(len(g.cache) == maxCachedDocuments, t)
+
+	// host of request does not affect document when host is set
+	g.SetHost("api.example.com")
+	for i := 0; i < 10; i++ {
+		assertTrue(request(fmt.Sprintf("host%d.example.com", i)) == "api.example.com", t)
+	}
+	assertTrue(len(g.cache) == 1, t)
+}
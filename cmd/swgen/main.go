@@ -0,0 +1,222 @@
+// Command swgen generates static document of API registered with swgen, so that the document
+// can be committed and reviewed together with the code.
+//
+// Package of API has to export function that registers paths and definitions with generator,
+// swgen builds temporary program that calls the function and writes the document to disk.
+//
+// Usage:
+//
+//	swgen generate [-func RegisterDocs] [-o swagger.json] [-format json|yaml] [-openapi3] package
+//
+// With go generate:
+//
+//	//go:generate go run github.com/lazada/swgen/cmd/swgen generate -o swagger.yaml .
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// options of generate command
+type options struct {
+	pkg      string // package with registration function, import path or directory
+	funcName string // name of registration function, func(*swgen.Generator)
+	output   string // path to generated document
+	format   string // "json" or "yaml"
+	openAPI3 bool   // generate OpenAPI 3 document instead of Swagger 2.0
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("swgen: ")
+
+	if len(os.Args) < 2 || os.Args[1] != "generate" {
+		fmt.Fprintln(os.Stderr, "usage: swgen generate [flags] package")
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	funcName := flags.String("func", "RegisterDocs", "name of function of package that registers API, func(*swgen.Generator)")
+	output := flags.String("o", "swagger.json", "path to generated document")
+	format := flags.String("format", "", "format of document, json or yaml, detected by extension of output by default")
+	openAPI3 := flags.Bool("openapi3", false, "generate OpenAPI 3 document instead of Swagger 2.0")
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: swgen generate [flags] package")
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[2:])
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	opts := options{
+		pkg:      flags.Arg(0),
+		funcName: *funcName,
+		output:   *output,
+		format:   *format,
+		openAPI3: *openAPI3,
+	}
+	if err := generate(opts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate builds and runs temporary program that writes document of package
+func generate(opts options) error {
+	if !ast.IsExported(opts.funcName) {
+		return fmt.Errorf("function %q is not exported", opts.funcName)
+	}
+
+	format, err := documentFormat(opts.output, opts.format)
+	if err != nil {
+		return err
+	}
+
+	importPath, err := packageImportPath(opts.pkg)
+	if err != nil {
+		return err
+	}
+
+	output, err := filepath.Abs(opts.output)
+	if err != nil {
+		return err
+	}
+
+	source, err := programSource(program{
+		ImportPath: importPath,
+		FuncName:   opts.funcName,
+		YAML:       format == "yaml",
+		OpenAPI3:   opts.openAPI3,
+	})
+	if err != nil {
+		return err
+	}
+
+	// program is placed in working directory to resolve imports with its module or GOPATH,
+	// directories starting with underscore are ignored by go tool patterns
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	dir, err := ioutil.TempDir(wd, "_swgen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	mainFile := filepath.Join(dir, "main.go")
+	if err := ioutil.WriteFile(mainFile, source, 0644); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "run", mainFile, output)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run document generator of %s: %v", importPath, err)
+	}
+
+	return nil
+}
+
+// documentFormat returns format of document, it is detected by extension of output if not set
+func documentFormat(output, format string) (string, error) {
+	switch format {
+	case "json", "yaml":
+		return format, nil
+	case "":
+		switch strings.ToLower(filepath.Ext(output)) {
+		case ".yaml", ".yml":
+			return "yaml", nil
+		}
+		return "json", nil
+	}
+	return "", fmt.Errorf("unknown format %q, json or yaml expected", format)
+}
+
+// packageImportPath resolves import path of package with go list
+func packageImportPath(pkg string) (string, error) {
+	stderr := bytes.NewBuffer(nil)
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}} {{.Name}}", pkg)
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to find package %s: %v %s", pkg, err, strings.TrimSpace(stderr.String()))
+	}
+
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		return "", fmt.Errorf("failed to find package %s: unexpected output of go list %q", pkg, out)
+	}
+	if fields[1] == "main" {
+		return "", errors.New("main package can not be imported, registration function has to be moved to another package")
+	}
+
+	return fields[0], nil
+}
+
+// program is data of template of temporary program
+type program struct {
+	ImportPath string
+	FuncName   string
+	YAML       bool
+	OpenAPI3   bool
+}
+
+var programTemplate = template.Must(template.New("program").Parse(`// Code generated by swgen generate; DO NOT EDIT.
+
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/lazada/swgen"
+
+	docs {{printf "%q" .ImportPath}}
+)
+
+func main() {
+	gen := swgen.NewGenerator().IndentJSON(true)
+	docs.{{.FuncName}}(gen)
+
+	data, err := gen.{{if .OpenAPI3}}GenDocumentOpenAPI3{{else}}GenDocument{{end}}()
+	if err != nil {
+		log.Fatal(err)
+	}
+{{if .YAML}}
+	data, err = swgen.JSONToYAML(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+{{else}}
+	data = append(data, '\n')
+{{end}}
+	if err := ioutil.WriteFile(os.Args[1], data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+`))
+
+// programSource renders source of temporary program
+func programSource(p program) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := programTemplate.Execute(buf, p); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lazada/swgen"
+)
+
+func TestDocumentFormat(t *testing.T) {
+	for _, c := range []struct{ output, format, expected string }{
+		{"swagger.json", "", "json"},
+		{"swagger.yaml", "", "yaml"},
+		{"api/swagger.YML", "", "yaml"},
+		{"swagger", "", "json"},
+		{"swagger.json", "yaml", "yaml"},
+	} {
+		if format, err := documentFormat(c.output, c.format); err != nil || format != c.expected {
+			t.Errorf("unexpected format of %s %q: %s %v", c.output, c.format, format, err)
+		}
+	}
+
+	if _, err := documentFormat("swagger.json", "xml"); err == nil {
+		t.Error("error expected for unknown format")
+	}
+}
+
+func TestProgramSource(t *testing.T) {
+	for _, p := range []program{
+		{ImportPath: "example.com/api", FuncName: "RegisterDocs"},
+		{ImportPath: "example.com/api", FuncName: "Register", YAML: true, OpenAPI3: true},
+	} {
+		source, err := programSource(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseFile(token.NewFileSet(), "main.go", source, 0); err != nil {
+			t.Fatalf("invalid source: %v\n%s", err, source)
+		}
+		if !strings.Contains(string(source), "docs."+p.FuncName+"(gen)") {
+			t.Errorf("registration function is not called:\n%s", source)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool is not available")
+	}
+
+	dir, err := ioutil.TempDir("", "swgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "swagger.json")
+	if err := generate(options{pkg: "./testdata/docs", funcName: "RegisterDocs", output: output}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := swgen.ParseDocument(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := doc.Definitions["Pet"]; !found || doc.Info.Title != "Pet store" {
+		t.Errorf("unexpected document:\n%s", data)
+	}
+
+	if err := generate(options{pkg: "./testdata/docs", funcName: "registerDocs", output: output}); err == nil {
+		t.Error("error expected for unexported function")
+	}
+	if err := generate(options{pkg: "./testdata/missing", funcName: "RegisterDocs", output: output}); err == nil {
+		t.Error("error expected for missing package")
+	}
+}
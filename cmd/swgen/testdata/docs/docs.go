@@ -0,0 +1,16 @@
+// Package docs registers API used in tests of swgen command
+package docs
+
+import "github.com/lazada/swgen"
+
+// Pet is a pet in the store
+type Pet struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// RegisterDocs registers API of the store
+func RegisterDocs(gen *swgen.Generator) {
+	gen.SetInfo("Pet store", "", "", "1.0")
+	gen.SetPathItem(swgen.PathItemInfo{Path: "/pets", Method: "GET"}, nil, nil, Pet{})
+}
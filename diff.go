@@ -0,0 +1,603 @@
+package swgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind is a type of change between two documents
+type ChangeKind string
+
+const (
+	// ChangeBasePath is a change of base path of the API
+	ChangeBasePath ChangeKind = "base-path-changed"
+	// ChangePathAdded is a new path
+	ChangePathAdded ChangeKind = "path-added"
+	// ChangePathRemoved is a removed path with all its operations
+	ChangePathRemoved ChangeKind = "path-removed"
+	// ChangeOperationAdded is a new operation of existing path
+	ChangeOperationAdded ChangeKind = "operation-added"
+	// ChangeOperationRemoved is a removed operation of existing path
+	ChangeOperationRemoved ChangeKind = "operation-removed"
+	// ChangeParamAdded is a new parameter of operation
+	ChangeParamAdded ChangeKind = "param-added"
+	// ChangeParamRemoved is a removed parameter of operation
+	ChangeParamRemoved ChangeKind = "param-removed"
+	// ChangeParamRequired is a parameter that became required
+	ChangeParamRequired ChangeKind = "param-required"
+	// ChangeParamOptional is a parameter that became optional
+	ChangeParamOptional ChangeKind = "param-optional"
+	// ChangeTypeChanged is a change of type or format of value
+	ChangeTypeChanged ChangeKind = "type-changed"
+	// ChangeConstraintChanged is a change of validation keyword of value
+	ChangeConstraintChanged ChangeKind = "constraint-changed"
+	// ChangeEnumValueAdded is a new allowed value of enum
+	ChangeEnumValueAdded ChangeKind = "enum-value-added"
+	// ChangeEnumValueRemoved is a removed value of enum
+	ChangeEnumValueRemoved ChangeKind = "enum-value-removed"
+	// ChangePropertyAdded is a new property of object
+	ChangePropertyAdded ChangeKind = "property-added"
+	// ChangePropertyRemoved is a removed property of object
+	ChangePropertyRemoved ChangeKind = "property-removed"
+	// ChangePropertyRequired is a property that became required
+	ChangePropertyRequired ChangeKind = "property-required"
+	// ChangePropertyOptional is a property that became optional
+	ChangePropertyOptional ChangeKind = "property-optional"
+	// ChangeResponseAdded is a new response status code of operation
+	ChangeResponseAdded ChangeKind = "response-added"
+	// ChangeResponseRemoved is a removed response status code of operation
+	ChangeResponseRemoved ChangeKind = "response-removed"
+	// ChangeHeaderRemoved is a removed response header
+	ChangeHeaderRemoved ChangeKind = "header-removed"
+)
+
+// Change describes a single difference between two documents
+type Change struct {
+	Kind     ChangeKind `json:"kind"`
+	Path     string     `json:"path,omitempty"`     // Path of operation, e.g. "/users/{id}"
+	Method   string     `json:"method,omitempty"`   // Method of operation, e.g. "GET"
+	Location string     `json:"location,omitempty"` // Location of value in operation, e.g. "query.limit", "body.tags[]" or "responses.200.name"
+	Message  string     `json:"message"`
+	Breaking bool       `json:"breaking"` // Change may break existing clients
+}
+
+// String returns human readable description of change
+func (c Change) String() string {
+	s := c.Message
+	if c.Location != "" {
+		s = c.Location + ": " + s
+	}
+	if c.Path != "" {
+		s = strings.TrimSpace(c.Method+" "+c.Path) + " " + s
+	}
+	if c.Breaking {
+		s = "[breaking] " + s
+	}
+	return s
+}
+
+// DiffReport lists changes between two documents
+type DiffReport struct {
+	Breaking bool     `json:"breaking"` // Report contains at least one breaking change
+	Changes  []Change `json:"changes"`
+}
+
+// BreakingChanges returns breaking changes of report
+func (r DiffReport) BreakingChanges() []Change {
+	var changes []Change
+	for _, c := range r.Changes {
+		if c.Breaking {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// direction of value transfer defines which changes of its schema break clients,
+// narrowing of accepted request values and widening of returned response values are breaking
+type direction int
+
+const (
+	directionRequest direction = iota
+	directionResponse
+)
+
+// Diff compares base document with its revision and classifies changes of operations
+func Diff(base, revision Document) DiffReport {
+	d := differ{base: base, revision: revision, visited: make(map[string]bool)}
+
+	if base.BasePath != revision.BasePath {
+		d.add(ChangeBasePath, true, "", "base path changed from %q to %q", base.BasePath, revision.BasePath)
+	}
+
+	for _, path := range sortedPaths(base.Paths, revision.Paths) {
+		baseItem, inBase := base.Paths[path]
+		revItem, inRevision := revision.Paths[path]
+
+		d.path, d.method = path, ""
+		switch {
+		case !inRevision:
+			d.add(ChangePathRemoved, true, "", "path removed")
+			continue
+		case !inBase:
+			d.add(ChangePathAdded, false, "", "path added")
+			continue
+		}
+
+		for _, method := range operationMethods {
+			baseOp, revOp := baseItem.getOperation(method), revItem.getOperation(method)
+			d.method = method
+			switch {
+			case baseOp == nil && revOp == nil:
+			case revOp == nil:
+				d.add(ChangeOperationRemoved, true, "", "operation removed")
+			case baseOp == nil:
+				d.add(ChangeOperationAdded, false, "", "operation added")
+			default:
+				d.operation(baseOp, revOp)
+			}
+		}
+	}
+
+	report := DiffReport{Changes: d.changes}
+	for _, c := range d.changes {
+		if c.Breaking {
+			report.Breaking = true
+			break
+		}
+	}
+	return report
+}
+
+// DiffJSON compares two JSON documents, e.g. outputs of GenDocument
+func DiffJSON(base, revision []byte) (DiffReport, error) {
+	var baseDoc, revDoc Document
+	if err := json.Unmarshal(base, &baseDoc); err != nil {
+		return DiffReport{}, err
+	}
+	if err := json.Unmarshal(revision, &revDoc); err != nil {
+		return DiffReport{}, err
+	}
+	return Diff(baseDoc, revDoc), nil
+}
+
+type differ struct {
+	base, revision Document
+	path, method   string
+	changes        []Change
+	visited        map[string]bool // compared pairs of definitions, prevents infinite recursion
+}
+
+func (d *differ) add(kind ChangeKind, breaking bool, location, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{
+		Kind:     kind,
+		Path:     d.path,
+		Method:   d.method,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+		Breaking: breaking,
+	})
+}
+
+func (d *differ) operation(base, revision *OperationObj) {
+	d.visited = make(map[string]bool)
+
+	baseParams := paramsByLocation(base.Parameters)
+	revParams := paramsByLocation(revision.Parameters)
+
+	for _, key := range sortedParamKeys(baseParams, revParams) {
+		baseParam, inBase := baseParams[key]
+		revParam, inRevision := revParams[key]
+
+		switch {
+		case !inRevision:
+			d.add(ChangeParamRemoved, false, key, "parameter removed")
+		case !inBase:
+			if revParam.Required {
+				d.add(ChangeParamAdded, true, key, "required parameter added")
+			} else {
+				d.add(ChangeParamAdded, false, key, "optional parameter added")
+			}
+		default:
+			d.param(key, baseParam, revParam)
+		}
+	}
+
+	for _, code := range sortedResponseCodes(base.Responses, revision.Responses) {
+		baseResp, inBase := base.Responses[code]
+		revResp, inRevision := revision.Responses[code]
+		location := "responses." + code
+
+		switch {
+		case !inRevision:
+			d.add(ChangeResponseRemoved, true, location, "response removed")
+		case !inBase:
+			d.add(ChangeResponseAdded, false, location, "response added")
+		default:
+			d.response(location, baseResp, revResp)
+		}
+	}
+}
+
+func (d *differ) param(location string, base, revision ParamObj) {
+	switch {
+	case !base.Required && revision.Required:
+		d.add(ChangeParamRequired, true, location, "parameter became required")
+	case base.Required && !revision.Required:
+		d.add(ChangeParamOptional, false, location, "parameter became optional")
+	}
+
+	if base.In == "body" {
+		if base.Schema != nil && revision.Schema != nil {
+			d.schema(location, *base.Schema, *revision.Schema, directionRequest)
+		}
+		return
+	}
+
+	d.schema(location, paramSchema(base), paramSchema(revision), directionRequest)
+}
+
+func (d *differ) response(location string, base, revision ResponseObj) {
+	switch {
+	case base.Schema != nil && revision.Schema == nil:
+		d.add(ChangeTypeChanged, true, location, "response body removed")
+	case base.Schema != nil && revision.Schema != nil:
+		d.schema(location, *base.Schema, *revision.Schema, directionResponse)
+	}
+
+	names := make([]string, 0, len(base.Headers))
+	for name := range base.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		revHeader, found := revision.Headers[name]
+		if !found {
+			d.add(ChangeHeaderRemoved, true, location+".headers."+name, "response header removed")
+			continue
+		}
+
+		baseHeader := base.Headers[name]
+		d.schema(location+".headers."+name,
+			SchemaObj{Type: baseHeader.Type, Format: baseHeader.Format, Enum: baseHeader.Enum, Constraints: baseHeader.Constraints},
+			SchemaObj{Type: revHeader.Type, Format: revHeader.Format, Enum: revHeader.Enum, Constraints: revHeader.Constraints},
+			directionResponse)
+	}
+}
+
+// resolveDefinition follows reference of schema to definitions of document
+func resolveDefinition(definitions map[string]SchemaObj, so SchemaObj) SchemaObj {
+	for i := 0; so.Ref != "" && i < 32; i++ {
+		if !strings.HasPrefix(so.Ref, refDefinitionPrefix) {
+			return so
+		}
+
+		def, found := definitions[so.Ref[len(refDefinitionPrefix):]]
+		if !found {
+			return so
+		}
+		so = def
+	}
+	return so
+}
+
+// flattenAllOf merges properties and required lists of allOf entries into object schema,
+// so that composition of definitions is compared by resulting properties
+func flattenAllOf(definitions map[string]SchemaObj, so SchemaObj, depth int) SchemaObj {
+	if len(so.AllOf) == 0 || depth > 32 {
+		return so
+	}
+
+	result := so
+	result.AllOf = nil
+	result.Type = "object"
+	result.Properties = make(map[string]SchemaObj, len(so.Properties))
+	for name, property := range so.Properties {
+		result.Properties[name] = property
+	}
+	result.Required = append([]string(nil), so.Required...)
+
+	for _, entry := range so.AllOf {
+		entry = flattenAllOf(definitions, resolveDefinition(definitions, entry), depth+1)
+		for name, property := range entry.Properties {
+			result.Properties[name] = property
+		}
+		for _, name := range entry.Required {
+			if !containsString(result.Required, name) {
+				result.Required = append(result.Required, name)
+			}
+		}
+	}
+
+	return result
+}
+
+func (d *differ) schema(location string, base, revision SchemaObj, dir direction) {
+	if base.Ref != "" && revision.Ref != "" {
+		key := strconv.Itoa(int(dir)) + base.Ref + " " + revision.Ref
+		if d.visited[key] {
+			return
+		}
+		d.visited[key] = true
+	}
+
+	base = flattenAllOf(d.base.Definitions, resolveDefinition(d.base.Definitions, base), 0)
+	revision = flattenAllOf(d.revision.Definitions, resolveDefinition(d.revision.Definitions, revision), 0)
+
+	if base.Type != revision.Type || base.Format != revision.Format {
+		d.add(ChangeTypeChanged, !isWidening(base, revision, dir), location, "type changed from %s to %s",
+			schemaTypeString(base), schemaTypeString(revision))
+		return
+	}
+
+	d.enum(location, base.Enum.Enum, revision.Enum.Enum, dir)
+	d.constraints(location, base.Constraints, revision.Constraints, dir)
+
+	if base.Items != nil && revision.Items != nil {
+		d.schema(location+"[]", *base.Items, *revision.Items, dir)
+	}
+	if base.AdditionalProperties != nil && revision.AdditionalProperties != nil {
+		d.schema(location+"{}", *base.AdditionalProperties, *revision.AdditionalProperties, dir)
+	}
+
+	d.properties(location, base, revision, dir)
+}
+
+func (d *differ) properties(location string, base, revision SchemaObj, dir direction) {
+	baseRequired := stringSet(base.Required)
+	revRequired := stringSet(revision.Required)
+
+	names := make([]string, 0, len(base.Properties)+len(revision.Properties))
+	for name := range base.Properties {
+		names = append(names, name)
+	}
+	for name := range revision.Properties {
+		if _, found := base.Properties[name]; !found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		baseProperty, inBase := base.Properties[name]
+		revProperty, inRevision := revision.Properties[name]
+		propertyLocation := joinName(location, name)
+
+		switch {
+		case !inRevision:
+			// clients may rely on properties of responses, requests with removed properties are still accepted
+			d.add(ChangePropertyRemoved, dir == directionResponse, propertyLocation, "property removed")
+			continue
+		case !inBase:
+			if revRequired[name] {
+				d.add(ChangePropertyAdded, dir == directionRequest, propertyLocation, "required property added")
+			} else {
+				d.add(ChangePropertyAdded, false, propertyLocation, "optional property added")
+			}
+			continue
+		}
+
+		switch {
+		case !baseRequired[name] && revRequired[name]:
+			d.add(ChangePropertyRequired, dir == directionRequest, propertyLocation, "property became required")
+		case baseRequired[name] && !revRequired[name]:
+			d.add(ChangePropertyOptional, dir == directionResponse, propertyLocation, "property became optional")
+		}
+
+		d.schema(propertyLocation, baseProperty, revProperty, dir)
+	}
+}
+
+func (d *differ) enum(location string, base, revision []interface{}, dir direction) {
+	switch {
+	case len(base) == 0 && len(revision) == 0:
+		return
+	case len(base) == 0:
+		d.add(ChangeEnumValueRemoved, dir == directionRequest, location, "values restricted to %s", enumString(revision))
+		return
+	case len(revision) == 0:
+		d.add(ChangeEnumValueAdded, dir == directionResponse, location, "values are not restricted to %s anymore", enumString(base))
+		return
+	}
+
+	for _, value := range base {
+		if !enumContains(revision, value) {
+			d.add(ChangeEnumValueRemoved, dir == directionRequest, location, "enum value %s removed", jsonString(value))
+		}
+	}
+	for _, value := range revision {
+		if !enumContains(base, value) {
+			d.add(ChangeEnumValueAdded, dir == directionResponse, location, "enum value %s added", jsonString(value))
+		}
+	}
+}
+
+// constraints reports changes of validation keywords, narrowing breaks requests and widening breaks responses
+func (d *differ) constraints(location string, base, revision Constraints, dir direction) {
+	report := func(keyword string, narrowed, widened bool, from, to interface{}) {
+		if !narrowed && !widened {
+			return
+		}
+		breaking := narrowed && dir == directionRequest || widened && dir == directionResponse
+		d.add(ChangeConstraintChanged, breaking, location, "%s changed from %s to %s", keyword, constraintString(from), constraintString(to))
+	}
+
+	n, w := compareLimits(base.Minimum, revision.Minimum, true)
+	if base.Minimum != nil && revision.Minimum != nil && *base.Minimum == *revision.Minimum {
+		n, w = !base.ExclusiveMinimum && revision.ExclusiveMinimum, base.ExclusiveMinimum && !revision.ExclusiveMinimum
+	}
+	report("minimum", n, w, base.Minimum, revision.Minimum)
+
+	n, w = compareLimits(base.Maximum, revision.Maximum, false)
+	if base.Maximum != nil && revision.Maximum != nil && *base.Maximum == *revision.Maximum {
+		n, w = !base.ExclusiveMaximum && revision.ExclusiveMaximum, base.ExclusiveMaximum && !revision.ExclusiveMaximum
+	}
+	report("maximum", n, w, base.Maximum, revision.Maximum)
+
+	n, w = compareLimits(intLimit(base.MinLength), intLimit(revision.MinLength), true)
+	report("minLength", n, w, base.MinLength, revision.MinLength)
+
+	n, w = compareLimits(intLimit(base.MaxLength), intLimit(revision.MaxLength), false)
+	report("maxLength", n, w, base.MaxLength, revision.MaxLength)
+
+	n, w = compareLimits(intLimit(base.MinItems), intLimit(revision.MinItems), true)
+	report("minItems", n, w, base.MinItems, revision.MinItems)
+
+	n, w = compareLimits(intLimit(base.MaxItems), intLimit(revision.MaxItems), false)
+	report("maxItems", n, w, base.MaxItems, revision.MaxItems)
+
+	report("uniqueItems", !base.UniqueItems && revision.UniqueItems, base.UniqueItems && !revision.UniqueItems,
+		base.UniqueItems, revision.UniqueItems)
+
+	// changed pattern or multipleOf may both accept new values and reject old ones
+	if base.Pattern != revision.Pattern {
+		report("pattern", revision.Pattern != "", base.Pattern != "", base.Pattern, revision.Pattern)
+	}
+	if base.MultipleOf != revision.MultipleOf {
+		report("multipleOf", revision.MultipleOf != 0, base.MultipleOf != 0, base.MultipleOf, revision.MultipleOf)
+	}
+}
+
+// compareLimits compares lower or upper limits, it returns true as narrowed if revision limit
+// rejects values accepted by base limit and true as widened in reverse case, nil limit is unlimited
+func compareLimits(base, revision *float64, lower bool) (narrowed, widened bool) {
+	switch {
+	case base == nil && revision == nil:
+		return false, false
+	case base == nil:
+		return true, false
+	case revision == nil:
+		return false, true
+	}
+
+	if lower {
+		return *revision > *base, *revision < *base
+	}
+	return *revision < *base, *revision > *base
+}
+
+func intLimit(limit *int64) *float64 {
+	if limit == nil {
+		return nil
+	}
+	f := float64(*limit)
+	return &f
+}
+
+func constraintString(v interface{}) string {
+	switch v := v.(type) {
+	case *float64:
+		if v == nil {
+			return "none"
+		}
+		return strconv.FormatFloat(*v, 'g', -1, 64)
+	case *int64:
+		if v == nil {
+			return "none"
+		}
+		return strconv.FormatInt(*v, 10)
+	case string:
+		if v == "" {
+			return "none"
+		}
+		return strconv.Quote(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// isWidening checks if change of type accepts all values of base type,
+// such changes are not breaking for requests while reverse changes are not breaking for responses
+func isWidening(base, revision SchemaObj, dir direction) bool {
+	from, to := base, revision
+	if dir == directionResponse {
+		from, to = revision, base
+	}
+
+	switch {
+	case from.Type == to.Type:
+		return to.Format == "" ||
+			from.Format == "int32" && to.Format == "int64" ||
+			from.Format == "float" && to.Format == "double"
+	case from.Type == "integer" && to.Type == "number":
+		return true
+	}
+	return false
+}
+
+func schemaTypeString(so SchemaObj) string {
+	t := so.Type
+	if t == "" {
+		t = "any"
+	}
+	if so.Format != "" {
+		t += " (" + so.Format + ")"
+	}
+	return t
+}
+
+func stringSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
+
+// paramsByLocation indexes parameters by location and name, e.g. "query.limit"
+func paramsByLocation(params []ParamObj) map[string]ParamObj {
+	result := make(map[string]ParamObj, len(params))
+	for _, param := range params {
+		if param.In == "body" {
+			result["body"] = param
+			continue
+		}
+		result[param.In+"."+param.Name] = param
+	}
+	return result
+}
+
+func sortedPaths(base, revision map[string]PathItem) []string {
+	keys := make([]string, 0, len(base)+len(revision))
+	for key := range base {
+		keys = append(keys, key)
+	}
+	for key := range revision {
+		if _, found := base[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedParamKeys(base, revision map[string]ParamObj) []string {
+	keys := make([]string, 0, len(base)+len(revision))
+	for key := range base {
+		keys = append(keys, key)
+	}
+	for key := range revision {
+		if _, found := base[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedResponseCodes(base, revision Responses) []string {
+	keys := make([]string, 0, len(base)+len(revision))
+	for key := range base {
+		keys = append(keys, key)
+	}
+	for key := range revision {
+		if _, found := base[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
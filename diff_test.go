@@ -0,0 +1,160 @@
+package swgen
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testDiffParamsV1 struct {
+	ID    int64 `schema:"id" in:"path"`
+	Limit int   `schema:"limit" in:"query" required:"false" maximum:"100"`
+}
+
+type testDiffParamsV2 struct {
+	ID     int64  `schema:"id" in:"path"`
+	Limit  int    `schema:"limit" in:"query" required:"false" maximum:"50"`
+	Fields string `schema:"fields" in:"query"`
+}
+
+type testDiffUserV1 struct {
+	ID    int64    `json:"id"`
+	Name  string   `json:"name"`
+	Role  string   `json:"role" validate:"oneof=admin user"`
+	Score int      `json:"score"`
+	Tags  []string `json:"tags"`
+}
+
+type testDiffUserV2 struct {
+	ID    int64    `json:"id"`
+	Email string   `json:"email" validate:"required"`
+	Role  string   `json:"role" validate:"oneof=admin guest"`
+	Score float64  `json:"score"`
+	Tags  []string `json:"tags" maxItems:"10"`
+}
+
+type testDiffPathItem struct {
+	path, method           string
+	params, body, response interface{}
+}
+
+func testDiffDocument(t *testing.T, g *Generator, items []testDiffPathItem) []byte {
+	for _, item := range items {
+		info := PathItemInfo{Path: item.path, Method: item.method}
+		if err := g.SetPathItem(info, item.params, item.body, item.response); err != nil {
+			t.Fatalf("error %v", err)
+		}
+	}
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	return data
+}
+
+func TestDiff(t *testing.T) {
+	base := testDiffDocument(t, NewGenerator().ReflectValidateTags(true), []testDiffPathItem{
+		{"/users/{id}", "GET", testDiffParamsV1{}, nil, testDiffUserV1{}},
+		{"/users/{id}", "DELETE", testDiffParamsV1{}, nil, nil},
+		{"/users", "POST", nil, testDiffUserV1{}, nil},
+		{"/legacy", "GET", nil, nil, nil},
+	})
+
+	revision := testDiffDocument(t, NewGenerator().ReflectValidateTags(true), []testDiffPathItem{
+		{"/users/{id}", "GET", testDiffParamsV2{}, nil, testDiffUserV2{}},
+		{"/users", "POST", nil, testDiffUserV2{}, nil},
+		{"/health", "GET", nil, nil, nil},
+	})
+
+	report, err := DiffJSON(base, revision)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	var changes []string
+	for _, c := range report.Changes {
+		changes = append(changes, c.String())
+	}
+	expected := []string{
+		"/health path added",
+		"[breaking] /legacy path removed",
+		"[breaking] POST /users body.email: required property added",
+		"POST /users body.name: property removed",
+		`[breaking] POST /users body.role: enum value "user" removed`,
+		`POST /users body.role: enum value "guest" added`,
+		"POST /users body.score: type changed from integer (int32) to number (double)",
+		"[breaking] POST /users body.tags: maxItems changed from none to 10",
+		"[breaking] GET /users/{id} query.fields: required parameter added",
+		"[breaking] GET /users/{id} query.limit: maximum changed from 100 to 50",
+		"GET /users/{id} responses.200.email: required property added",
+		"[breaking] GET /users/{id} responses.200.name: property removed",
+		`GET /users/{id} responses.200.role: enum value "user" removed`,
+		`[breaking] GET /users/{id} responses.200.role: enum value "guest" added`,
+		"[breaking] GET /users/{id} responses.200.score: type changed from integer (int32) to number (double)",
+		"GET /users/{id} responses.200.tags: maxItems changed from none to 10",
+		"[breaking] DELETE /users/{id} operation removed",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes:\n%#v", changes)
+	}
+	assertTrue(report.Breaking, t)
+	assertTrue(len(report.BreakingChanges()) == 10, t)
+
+	report, err = DiffJSON(base, base)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertFalse(report.Breaking, t)
+	assertTrue(len(report.Changes) == 0, t)
+}
+
+type TestDiffEntityV1 struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type TestDiffEntityV2 struct {
+	ID int64 `json:"id"`
+}
+
+type testDiffComposedV1 struct {
+	TestDiffEntityV1
+	Extra string `json:"extra"`
+}
+
+type testDiffComposedV2 struct {
+	TestDiffEntityV2
+	Extra string `json:"extra"`
+}
+
+func TestDiffComposedDefinitions(t *testing.T) {
+	flat := testDiffDocument(t, NewGenerator(), []testDiffPathItem{
+		{"/entities", "GET", nil, nil, testDiffComposedV1{}},
+	})
+	composed := testDiffDocument(t, NewGenerator().ReflectEmbeddedStructs(true), []testDiffPathItem{
+		{"/entities", "GET", nil, nil, testDiffComposedV1{}},
+	})
+
+	// enabling of composition does not change properties
+	report, err := DiffJSON(flat, composed)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(len(report.Changes) == 0, t)
+
+	revision := testDiffDocument(t, NewGenerator().ReflectEmbeddedStructs(true), []testDiffPathItem{
+		{"/entities", "GET", nil, nil, testDiffComposedV2{}},
+	})
+	report, err = DiffJSON(composed, revision)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	var changes []string
+	for _, c := range report.Changes {
+		changes = append(changes, c.String())
+	}
+	if !reflect.DeepEqual(changes, []string{"[breaking] GET /entities responses.200.name: property removed"}) {
+		t.Errorf("unexpected changes:\n%#v", changes)
+	}
+}
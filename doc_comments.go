@@ -0,0 +1,195 @@
+package swgen
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/build"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// ReflectDocComments controls descriptions of definitions and their properties, when enabled sources of packages
+// of Go types are parsed and doc comments of types and struct fields are used as descriptions,
+// packages which sources are not available are skipped
+func (g *Generator) ReflectDocComments(enabled bool) *Generator {
+	g.mu.Lock()
+	g.reflectDocComments = enabled
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// packageDocs contains doc comments of types declared in package
+type packageDocs struct {
+	types  map[string]string            // doc comments of types by type name
+	fields map[string]map[string]string // doc comments of struct fields by type name and field name
+}
+
+// typeDoc returns doc comment of named type
+func (g *Generator) typeDoc(t reflect.Type) string {
+	docs := g.packageDocs(t)
+	if docs == nil {
+		return ""
+	}
+	return docs.types[t.Name()]
+}
+
+// fieldDoc returns doc comment or line comment of field of named struct type
+func (g *Generator) fieldDoc(t reflect.Type, field reflect.StructField) string {
+	docs := g.packageDocs(t)
+	if docs == nil {
+		return ""
+	}
+	return docs.fields[t.Name()][field.Name]
+}
+
+// packageDocs returns doc comments of package of named type, packages are loaded once
+func (g *Generator) packageDocs(t reflect.Type) *packageDocs {
+	if !g.reflectDocComments || t.Name() == "" || t.PkgPath() == "" {
+		return nil
+	}
+
+	g.docMu.Lock()
+	defer g.docMu.Unlock()
+
+	if docs, found := g.docComments[t.PkgPath()]; found {
+		return docs
+	}
+
+	docs, err := loadPackageDocs(t.PkgPath())
+	if err != nil {
+		docs = nil // package is not loaded again
+	}
+	if g.docComments == nil {
+		g.docComments = make(map[string]*packageDocs)
+	}
+	g.docComments[t.PkgPath()] = docs
+	return docs
+}
+
+// sourcePackage describes sources of package, it is filled from output of `go list -json`
+type sourcePackage struct {
+	Dir         string
+	Name        string
+	GoFiles     []string
+	CgoFiles    []string
+	TestGoFiles []string
+}
+
+// findPackage locates sources of package, `go list` is used to resolve packages of modules,
+// go/build is used if go command is not available, e.g. when program is run without Go toolchain
+func findPackage(pkgPath string) (*sourcePackage, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	// import path of main package is unknown, it is usually built in working directory
+	pattern := pkgPath
+	if pkgPath == "main" {
+		pattern = "."
+	}
+
+	cmd := exec.Command("go", "list", "-json", pattern)
+	cmd.Dir = wd
+	if out, err := cmd.Output(); err == nil {
+		pkg := &sourcePackage{}
+		if err := json.Unmarshal(out, pkg); err != nil {
+			return nil, err
+		}
+		return pkg, nil
+	}
+
+	var buildPkg *build.Package
+	if pkgPath == "main" {
+		buildPkg, err = build.ImportDir(wd, 0)
+	} else {
+		buildPkg, err = build.Import(pkgPath, wd, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sourcePackage{
+		Dir:         buildPkg.Dir,
+		Name:        buildPkg.Name,
+		GoFiles:     buildPkg.GoFiles,
+		CgoFiles:    buildPkg.CgoFiles,
+		TestGoFiles: buildPkg.TestGoFiles,
+	}, nil
+}
+
+// loadPackageDocs finds sources of package and collects doc comments of its types
+func loadPackageDocs(pkgPath string) (*packageDocs, error) {
+	pkg, err := findPackage(pkgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// types declared in test files are part of package when tests are run
+	files := make(map[string]bool)
+	for _, names := range [][]string{pkg.GoFiles, pkg.CgoFiles, pkg.TestGoFiles} {
+		for _, name := range names {
+			files[name] = true
+		}
+	}
+
+	fset := token.NewFileSet()
+	astPackages, err := parser.ParseDir(fset, pkg.Dir, func(info os.FileInfo) bool {
+		return files[info.Name()]
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := &packageDocs{
+		types:  make(map[string]string),
+		fields: make(map[string]map[string]string),
+	}
+	astPackage, found := astPackages[pkg.Name]
+	if !found {
+		return docs, nil
+	}
+
+	for _, docType := range doc.New(astPackage, filepath.ToSlash(pkgPath), doc.AllDecls).Types {
+		if text := strings.TrimSpace(docType.Doc); text != "" {
+			docs.types[docType.Name] = text
+		}
+
+		for _, spec := range docType.Decl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != docType.Name {
+				continue
+			}
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				docs.fields[docType.Name] = fieldDocs(structType)
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+// fieldDocs returns doc comments of named fields of struct, line comments are used if there is no doc comment
+func fieldDocs(structType *ast.StructType) map[string]string {
+	result := make(map[string]string)
+	for _, field := range structType.Fields.List {
+		text := strings.TrimSpace(field.Doc.Text())
+		if text == "" {
+			text = strings.TrimSpace(field.Comment.Text())
+		}
+		if text == "" {
+			continue
+		}
+
+		for _, name := range field.Names {
+			result[name.Name] = text
+		}
+	}
+	return result
+}
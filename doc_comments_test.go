@@ -0,0 +1,78 @@
+package swgen
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// testDocumented is a type with
+// documented fields.
+type testDocumented struct {
+	// ID identifies the entity.
+	ID    int                 `json:"id"`
+	Name  string              `json:"name"`                               // Name of the entity.
+	Owner testDocumentedOwner `json:"owner"`                              // Owner is described by definition.
+	Title string              `json:"title" description:"Title from tag"` // Title is described by tag.
+}
+
+// testDocumentedOwner owns entities.
+type testDocumentedOwner struct {
+	Login string `json:"login"`
+}
+
+func TestReflectDocComments(t *testing.T) {
+	g := NewGenerator().ReflectDocComments(true)
+	if _, err := g.ParseDefinition(testDocumented{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	typeDef, found := g.getDefinition(reflect.TypeOf(testDocumented{}))
+	if !found {
+		t.Fatal("No definition for testDocumented")
+	}
+	assertTrue(typeDef.Description == "testDocumented is a type with\ndocumented fields.", t)
+	assertTrue(typeDef.Properties["id"].Description == "ID identifies the entity.", t)
+	assertTrue(typeDef.Properties["name"].Description == "Name of the entity.", t)
+	assertTrue(typeDef.Properties["owner"].Description == "", t)
+	assertTrue(typeDef.Properties["title"].Description == "Title from tag", t)
+
+	owner, found := g.getDefinition(reflect.TypeOf(testDocumentedOwner{}))
+	assertTrue(found && owner.Description == "testDocumentedOwner owns entities.", t)
+	assertTrue(owner.Properties["login"].Description == "", t)
+
+	g = NewGenerator()
+	if _, err := g.ParseDefinition(testDocumented{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	typeDef, _ = g.getDefinition(reflect.TypeOf(testDocumented{}))
+	assertTrue(typeDef.Description == "", t)
+	assertTrue(typeDef.Properties["id"].Description == "", t)
+}
+
+func TestReflectDocCommentsConcurrently(t *testing.T) {
+	g := NewGenerator().ReflectDocComments(true)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assertTrue(g.typeDoc(reflect.TypeOf(testDocumentedOwner{})) == "testDocumentedOwner owns entities.", t)
+		}()
+	}
+	wg.Wait()
+	assertTrue(len(g.docComments) == 1, t)
+}
+
+func TestFindPackage(t *testing.T) {
+	pkg, err := findPackage(reflect.TypeOf(testDocumented{}).PkgPath())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(pkg.Name == "swgen" && pkg.Dir != "", t)
+	assertTrue(len(pkg.GoFiles) > 0 && len(pkg.TestGoFiles) > 0, t)
+
+	_, err = findPackage("github.com/lazada/swgen/missing")
+	assertTrue(err != nil, t)
+}
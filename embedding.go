@@ -0,0 +1,127 @@
+package swgen
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ReflectEmbeddedStructs controls representation of embedded structs, when enabled definition of struct
+// is composed with allOf of references to definitions of embedded structs and schema of own properties
+// instead of flattened properties of embedded structs, field with `discriminator:"true"` tag
+// becomes discriminator of definition and lists names of inheriting definitions in its enum
+func (g *Generator) ReflectEmbeddedStructs(enabled bool) *Generator {
+	g.mu.Lock()
+	g.reflectEmbeddedStructs = enabled
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// embeddedSchema returns reference to definition of embedded struct field,
+// it returns false if field should be flattened into parent
+func (g *Generator) embeddedSchema(field reflect.StructField) (SchemaObj, bool) {
+	if !g.reflectEmbeddedStructs {
+		return SchemaObj{}, false
+	}
+
+	t := field.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.Name() == "" {
+		return SchemaObj{}, false
+	}
+
+	so := g.genSchemaForType(t)
+	return so, so.Ref != ""
+}
+
+// composeAllOf moves own properties of object schema with embedded definitions into allOf
+func composeAllOf(so *SchemaObj) {
+	if len(so.AllOf) == 0 {
+		return
+	}
+
+	if len(so.Properties) > 0 {
+		so.AllOf = append(so.AllOf, SchemaObj{
+			Type:       so.Type,
+			Properties: so.Properties,
+			Required:   so.Required,
+		})
+	}
+
+	so.Type = ""
+	so.Properties = nil
+	so.Required = nil
+}
+
+// listSubtypes sets names of definitions composed with definition that has discriminator
+// as enum of discriminator property
+func listSubtypes(definitions map[string]SchemaObj) {
+	subtypes := make(map[string][]string)
+	for name, def := range definitions {
+		for _, parent := range def.AllOf {
+			if !strings.HasPrefix(parent.Ref, refDefinitionPrefix) {
+				continue
+			}
+			baseName := parent.Ref[len(refDefinitionPrefix):]
+			if base, found := definitions[baseName]; found && base.Discriminator != "" {
+				subtypes[baseName] = append(subtypes[baseName], name)
+			}
+		}
+	}
+
+	for baseName, names := range subtypes {
+		sort.Strings(names)
+		enum := make([]interface{}, len(names))
+		for i, name := range names {
+			enum[i] = name
+		}
+
+		base := definitions[baseName]
+		if property, found := base.Properties[base.Discriminator]; found && len(property.Enum.Enum) == 0 {
+			property.Enum.Enum = enum
+			base.Properties = withProperty(base.Properties, base.Discriminator, property)
+			definitions[baseName] = base
+			continue
+		}
+
+		// discriminator of composed definition is a property of its own schema in allOf
+		for i, s := range base.AllOf {
+			if property, found := s.Properties[base.Discriminator]; found && len(property.Enum.Enum) == 0 {
+				property.Enum.Enum = enum
+				allOf := make([]SchemaObj, len(base.AllOf))
+				copy(allOf, base.AllOf)
+				allOf[i].Properties = withProperty(s.Properties, base.Discriminator, property)
+				base.AllOf = allOf
+				definitions[baseName] = base
+				break
+			}
+		}
+	}
+}
+
+// discriminatorProperty returns schema of discriminator property of definition
+func discriminatorProperty(so SchemaObj) (SchemaObj, bool) {
+	if property, found := so.Properties[so.Discriminator]; found {
+		return property, true
+	}
+	for _, s := range so.AllOf {
+		if property, found := s.Properties[so.Discriminator]; found {
+			return property, true
+		}
+	}
+	return SchemaObj{}, false
+}
+
+// withProperty returns copy of properties with replaced property, properties of definitions are shared
+// with generator and must not be changed
+func withProperty(properties map[string]SchemaObj, name string, property SchemaObj) map[string]SchemaObj {
+	result := make(map[string]SchemaObj, len(properties))
+	for n, p := range properties {
+		result[n] = p
+	}
+	result[name] = property
+	return result
+}
@@ -0,0 +1,109 @@
+package swgen
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type EventBase struct {
+	Type string `json:"type" discriminator:"true"`
+	ID   int64  `json:"id"`
+}
+
+type UserCreated struct {
+	EventBase
+	Name string `json:"name"`
+}
+
+type UserDeleted struct {
+	*EventBase
+	Reason string `json:"reason"`
+}
+
+func testEmbeddingGenerator(enabled bool) *Generator {
+	g := NewGenerator().ReflectEmbeddedStructs(enabled)
+	g.SetPathItem(PathItemInfo{Path: "/events/created", Method: "GET"}, nil, nil, []UserCreated{})
+	g.SetPathItem(PathItemInfo{Path: "/events/deleted", Method: "GET"}, nil, nil, []UserDeleted{})
+	return g
+}
+
+func TestReflectEmbeddedStructs(t *testing.T) {
+	g := testEmbeddingGenerator(true)
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	base := doc.Definitions["EventBase"]
+	assertTrue(base.Discriminator == "type", t)
+	assertTrue(len(base.Required) == 1 && base.Required[0] == "type", t)
+	enum := base.Properties["type"].Enum.Enum
+	assertTrue(len(enum) == 2 && enum[0] == "UserCreated" && enum[1] == "UserDeleted", t)
+
+	created := doc.Definitions["UserCreated"]
+	assertTrue(created.Type == "" && created.Properties == nil, t)
+	assertTrue(len(created.AllOf) == 2, t)
+	assertTrue(created.AllOf[0].Ref == "#/definitions/EventBase", t)
+	assertTrue(created.AllOf[1].Type == "object" && created.AllOf[1].Properties["name"].Type == "string", t)
+
+	deleted := doc.Definitions["UserDeleted"]
+	assertTrue(len(deleted.AllOf) == 2 && deleted.AllOf[0].Ref == "#/definitions/EventBase", t)
+
+	for _, e := range g.Validate() {
+		t.Error(e)
+	}
+
+	// definitions of generator are not changed by listing of subtypes
+	data, _ = g.GenDocument()
+	doc, _ = ParseDocument(data)
+	assertTrue(len(doc.Definitions["EventBase"].Properties["type"].Enum.Enum) == 2, t)
+	assertTrue(len(g.definitions[reflect.TypeOf(EventBase{})].Properties["type"].Enum.Enum) == 0, t)
+}
+
+func TestReflectEmbeddedStructsDisabled(t *testing.T) {
+	data, err := testEmbeddingGenerator(false).GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	created := doc.Definitions["UserCreated"]
+	assertTrue(created.AllOf == nil && created.Discriminator == "", t)
+	assertTrue(len(created.Properties) == 3, t)
+	_, found := doc.Definitions["EventBase"]
+	assertFalse(found, t)
+}
+
+func TestReflectEmbeddedStructsOpenAPI3(t *testing.T) {
+	data, err := testEmbeddingGenerator(true).GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	var doc struct {
+		Components struct {
+			Schemas map[string]struct {
+				AllOf         []map[string]interface{} `json:"allOf"`
+				Discriminator DiscriminatorObj         `json:"discriminator"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	discriminator := doc.Components.Schemas["EventBase"].Discriminator
+	assertTrue(discriminator.PropertyName == "type", t)
+	assertTrue(discriminator.Mapping["UserCreated"] == "#/components/schemas/UserCreated", t)
+	assertTrue(discriminator.Mapping["UserDeleted"] == "#/components/schemas/UserDeleted", t)
+	assertTrue(doc.Components.Schemas["UserCreated"].AllOf[0]["$ref"] == "#/components/schemas/EventBase", t)
+}
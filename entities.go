@@ -1,8 +1,11 @@
 package swgen
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
 	"strings"
+	"sync"
 )
 
 // ServiceType data type for type of your service
@@ -33,9 +36,31 @@ type _Document Document
 
 // MarshalJSON marshal Document with additionalData inlined
 func (s Document) MarshalJSON() ([]byte, error) {
+	// paths and definitions are required by specification
+	if s.Paths == nil {
+		s.Paths = map[string]PathItem{}
+	}
+	if s.Definitions == nil {
+		s.Definitions = map[string]SchemaObj{}
+	}
 	return s.marshalJSONWithStruct(_Document(s))
 }
 
+// UnmarshalJSON restores Document with vendor extensions and other unknown fields in additionalData
+func (s *Document) UnmarshalJSON(data []byte) error {
+	return s.unmarshalJSONWithStruct(data, (*_Document)(s))
+}
+
+// ParseDocument restores Document from JSON, vendor extensions and fields that are not described by structs,
+// like operationId or produces, are kept in additional data
+func ParseDocument(data []byte) (*Document, error) {
+	doc := &Document{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
 // InfoObj provides metadata about the API
 type InfoObj struct {
 	Title          string     `json:"title"` // The title of the application
@@ -62,15 +87,38 @@ type LicenseObj struct {
 // PathItem describes the operations available on a single path
 // see http://swagger.io/specification/#pathItemObject
 type PathItem struct {
-	Ref     string        `json:"$ref,omitempty"`
-	Get     *OperationObj `json:"get,omitempty"`
-	Put     *OperationObj `json:"put,omitempty"`
-	Post    *OperationObj `json:"post,omitempty"`
-	Delete  *OperationObj `json:"delete,omitempty"`
-	Options *OperationObj `json:"options,omitempty"`
-	Head    *OperationObj `json:"head,omitempty"`
-	Patch   *OperationObj `json:"patch,omitempty"`
-	Params  *ParamObj     `json:"parameters,omitempty"`
+	Ref        string        `json:"$ref,omitempty"`
+	Get        *OperationObj `json:"get,omitempty"`
+	Put        *OperationObj `json:"put,omitempty"`
+	Post       *OperationObj `json:"post,omitempty"`
+	Delete     *OperationObj `json:"delete,omitempty"`
+	Options    *OperationObj `json:"options,omitempty"`
+	Head       *OperationObj `json:"head,omitempty"`
+	Patch      *OperationObj `json:"patch,omitempty"`
+	Params     *ParamObj     `json:"-"`                    // Deprecated: use Parameters, Params is rendered as the first of Parameters
+	Parameters []ParamObj    `json:"parameters,omitempty"` // Parameters applicable for all the operations of path
+	additionalData
+}
+
+type _PathItem PathItem
+
+// MarshalJSON marshal PathItem with additionalData inlined
+func (pi PathItem) MarshalJSON() ([]byte, error) {
+	pi.Parameters = pi.parameters()
+	return pi.marshalJSONWithStruct(_PathItem(pi))
+}
+
+// parameters returns parameters of path item including deprecated Params
+func (pi PathItem) parameters() []ParamObj {
+	if pi.Params == nil {
+		return pi.Parameters
+	}
+	return append([]ParamObj{*pi.Params}, pi.Parameters...)
+}
+
+// UnmarshalJSON restores PathItem with vendor extensions and other unknown fields in additionalData
+func (pi *PathItem) UnmarshalJSON(data []byte) error {
+	return pi.unmarshalJSONWithStruct(data, (*_PathItem)(pi))
 }
 
 // HasMethod returns true if in path item already have operation for given method
@@ -95,6 +143,51 @@ func (pi PathItem) HasMethod(method string) bool {
 	return false
 }
 
+// setOperation sets operation of path item for given method
+func (pi *PathItem) setOperation(method string, op *OperationObj) {
+	switch strings.ToUpper(method) {
+	case "GET":
+		pi.Get = op
+	case "POST":
+		pi.Post = op
+	case "PUT":
+		pi.Put = op
+	case "DELETE":
+		pi.Delete = op
+	case "OPTIONS":
+		pi.Options = op
+	case "HEAD":
+		pi.Head = op
+	case "PATCH":
+		pi.Patch = op
+	}
+}
+
+// operationMethods lists methods of path item operations in order of presentation
+var operationMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+// getOperation returns operation of path item for given method, nil if operation is not defined
+func (pi PathItem) getOperation(method string) *OperationObj {
+	switch strings.ToUpper(method) {
+	case "GET":
+		return pi.Get
+	case "POST":
+		return pi.Post
+	case "PUT":
+		return pi.Put
+	case "DELETE":
+		return pi.Delete
+	case "OPTIONS":
+		return pi.Options
+	case "HEAD":
+		return pi.Head
+	case "PATCH":
+		return pi.Patch
+	}
+
+	return nil
+}
+
 type securityType string
 
 const (
@@ -155,15 +248,40 @@ type PathItemInfo struct {
 	Security       []string            // Names of security definitions
 	SecurityOAuth2 map[string][]string // Map of names of security definitions to required scopes
 
+	Responses map[int]ResponseInfo // Responses by HTTP status code, in addition to the response object of SetPathItem
+
 	additionalData
 }
 
+// ResponseInfo some basic information of a response object
+type ResponseInfo struct {
+	Description string      // Description of response, status text of HTTP code is used if empty
+	Body        interface{} // Response body object, nil if response has no body
+	Headers     interface{} // Response headers object, a struct with `header` tags on fields
+}
+
 // Enum can be use for sending Enum data that need validate
 type Enum struct {
 	Enum      []interface{} `json:"enum,omitempty"`
 	EnumNames []string      `json:"x-enum-names,omitempty"`
 }
 
+// Constraints can be used for sending validation keywords of a value
+// see http://json-schema.org/latest/json-schema-validation.html
+type Constraints struct {
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMaximum bool     `json:"exclusiveMaximum,omitempty"`
+	Minimum          *float64 `json:"minimum,omitempty"`
+	ExclusiveMinimum bool     `json:"exclusiveMinimum,omitempty"`
+	MaxLength        *int64   `json:"maxLength,omitempty"`
+	MinLength        *int64   `json:"minLength,omitempty"`
+	Pattern          string   `json:"pattern,omitempty"`
+	MaxItems         *int64   `json:"maxItems,omitempty"`
+	MinItems         *int64   `json:"minItems,omitempty"`
+	UniqueItems      bool     `json:"uniqueItems,omitempty"`
+	MultipleOf       float64  `json:"multipleOf,omitempty"`
+}
+
 type enumer interface {
 	// GetEnumSlices return the const-name pair slice
 	GetEnumSlices() ([]interface{}, []string)
@@ -189,6 +307,11 @@ func (o OperationObj) MarshalJSON() ([]byte, error) {
 	return o.marshalJSONWithStruct(_OperationObj(o))
 }
 
+// UnmarshalJSON restores OperationObj with vendor extensions and other unknown fields in additionalData
+func (o *OperationObj) UnmarshalJSON(data []byte) error {
+	return o.unmarshalJSONWithStruct(data, (*_OperationObj)(o))
+}
+
 // ParamObj describes a single operation parameter
 // see http://swagger.io/specification/#parameterObject
 type ParamObj struct {
@@ -204,6 +327,7 @@ type ParamObj struct {
 	Default          interface{}   `json:"default,omitempty"`
 	Required         bool          `json:"required,omitempty"`
 	Enum
+	Constraints
 	additionalData
 }
 
@@ -214,6 +338,11 @@ func (o ParamObj) MarshalJSON() ([]byte, error) {
 	return o.marshalJSONWithStruct(_ParamObj(o))
 }
 
+// UnmarshalJSON restores ParamObj with vendor extensions and other unknown fields in additionalData
+func (o *ParamObj) UnmarshalJSON(data []byte) error {
+	return o.unmarshalJSONWithStruct(data, (*_ParamObj)(o))
+}
+
 // ParamItemObj describes an property object, in param object or property of definition
 // see http://swagger.io/specification/#itemsObject
 type ParamItemObj struct {
@@ -222,6 +351,7 @@ type ParamItemObj struct {
 	Format           string        `json:"format,omitempty"`
 	Items            *ParamItemObj `json:"items,omitempty"`            // Required if type is "array"
 	CollectionFormat string        `json:"collectionFormat,omitempty"` // "multi" - this is valid only for parameters in "query" or "formData"
+	Enum
 }
 
 // Responses list of response object
@@ -229,11 +359,24 @@ type Responses map[string]ResponseObj
 
 // ResponseObj describes a single response from an API Operation
 type ResponseObj struct {
-	Ref         string      `json:"$ref,omitempty"`
-	Description string      `json:"description,omitempty"`
-	Schema      *SchemaObj  `json:"schema,omitempty"`
-	Headers     interface{} `json:"headers,omitempty"`
-	Examples    interface{} `json:"examples,omitempty"`
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Schema      *SchemaObj           `json:"schema,omitempty"`
+	Headers     map[string]HeaderObj `json:"headers,omitempty"`
+	Examples    interface{}          `json:"examples,omitempty"`
+}
+
+// HeaderObj describes a single header sent with the response
+// see http://swagger.io/specification/#headerObject
+type HeaderObj struct {
+	Description      string        `json:"description,omitempty"`
+	Type             string        `json:"type"`
+	Format           string        `json:"format,omitempty"`
+	Items            *ParamItemObj `json:"items,omitempty"`            // Required if type is "array"
+	CollectionFormat string        `json:"collectionFormat,omitempty"` // "csv", "ssv", "tsv" or "pipes"
+	Default          interface{}   `json:"default,omitempty"`
+	Enum
+	Constraints
 }
 
 // SchemaObj describes a schema for json format
@@ -244,13 +387,56 @@ type SchemaObj struct {
 	Type                 string               `json:"type,omitempty"`
 	Format               string               `json:"format,omitempty"`
 	Title                string               `json:"title,omitempty"`
+	Example              interface{}          `json:"example,omitempty"`
 	Items                *SchemaObj           `json:"items,omitempty"`                // if type is array
 	AdditionalProperties *SchemaObj           `json:"additionalProperties,omitempty"` // if type is object (map[])
 	Properties           map[string]SchemaObj `json:"properties,omitempty"`           // if type is object
+	Required             []string             `json:"required,omitempty"`             // if type is object
+	AllOf                []SchemaObj          `json:"allOf,omitempty"`                // if object is composed with definitions of embedded structs
+	Discriminator        string               `json:"discriminator,omitempty"`        // name of property with name of inheriting definition
+	ReadOnly             bool                 `json:"readOnly,omitempty"`             // property is sent in responses only
 	TypeName             string               `json:"-"`                              // for internal using, passing typeName
 	GoType               string               `json:"x-go-type,omitempty"`
 	GoPropertyNames      map[string]string    `json:"x-go-property-names,omitempty"`
 	GoPropertyTypes      map[string]string    `json:"x-go-property-types,omitempty"`
+	Enum
+	Constraints
+	additionalData
+
+	arrayLength int64 // length of fixed-length Go array, described as tuple in JSON Schema
+	goPointer   bool  // schema of Go pointer field without x-nullable, null is accepted by validation as encoding/json does
+}
+
+type _SchemaObj SchemaObj
+
+// MarshalJSON marshal SchemaObj with additionalData inlined
+func (so SchemaObj) MarshalJSON() ([]byte, error) {
+	return so.marshalJSONWithStruct(_SchemaObj(so))
+}
+
+// UnmarshalJSON restores SchemaObj with vendor extensions and other unknown fields in additionalData,
+// boolean additionalProperties is kept in additionalData too
+func (so *SchemaObj) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	raw := bytes.TrimSpace(fields["additionalProperties"])
+	if string(raw) != "true" && string(raw) != "false" {
+		return so.unmarshalJSONWithStruct(data, (*_SchemaObj)(so))
+	}
+
+	delete(fields, "additionalProperties")
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err := so.unmarshalJSONWithStruct(data, (*_SchemaObj)(so)); err != nil {
+		return err
+	}
+	so.AddExtendedField("additionalProperties", string(raw) == "true")
+	return nil
 }
 
 // NewSchemaObj Constructor function for SchemaObj struct type
@@ -307,6 +493,84 @@ func (ad *additionalData) AddExtendedField(name string, value interface{}) {
 	ad.data[name] = value
 }
 
+// clone returns a copy of additional data that can be modified without affecting the original one
+func (ad additionalData) clone() additionalData {
+	if ad.data == nil {
+		return ad
+	}
+
+	data := make(map[string]interface{}, len(ad.data))
+	for name, value := range ad.data {
+		data[name] = value
+	}
+	return additionalData{data: data}
+}
+
+// structFieldNames caches JSON names of struct fields by type
+var structFieldNames = struct {
+	sync.Mutex
+	names map[reflect.Type]map[string]bool
+}{names: make(map[reflect.Type]map[string]bool)}
+
+// jsonFieldNames returns JSON names of fields of struct type including fields of embedded structs
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	structFieldNames.Lock()
+	defer structFieldNames.Unlock()
+
+	names, found := structFieldNames.names[t]
+	if !found {
+		names = make(map[string]bool)
+		collectJSONFieldNames(t, names)
+		structFieldNames.names[t] = names
+	}
+	return names
+}
+
+func collectJSONFieldNames(t reflect.Type, names map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			collectJSONFieldNames(field.Type, names)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+}
+
+// unmarshalJSONWithStruct decodes JSON object into struct pointed by i
+// and keeps vendor extensions and other fields that are not fields of struct in additional data
+func (ad *additionalData) unmarshalJSONWithStruct(data []byte, i interface{}) error {
+	if err := json.Unmarshal(data, i); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(i).Elem())
+	ad.data = nil
+	for name, raw := range fields {
+		if known[name] {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		ad.AddExtendedField(name, value)
+	}
+
+	return nil
+}
+
 func (ad additionalData) marshalJSONWithStruct(i interface{}) ([]byte, error) {
 	result, err := json.Marshal(i)
 	if err != nil {
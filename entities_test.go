@@ -1,6 +1,12 @@
 package swgen
 
-import "testing"
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestPathItemHasMethod(t *testing.T) {
 	item := PathItem{}
@@ -28,6 +34,137 @@ func TestAdditionalDataJSONMarshal(t *testing.T) {
 	assertTrue(string(data) == `{"x-custom-field":1}`, t)
 }
 
+func TestParseDocument(t *testing.T) {
+	for _, file := range []string{"testdata/test_REST.json", "testdata/test_JSON-RPC.json"} {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("error %v", err)
+		}
+
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("error %v", err)
+		}
+
+		generated, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatalf("error %v", err)
+		}
+
+		var expected, actual interface{}
+		json.Unmarshal(data, &expected)
+		json.Unmarshal(generated, &actual)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("%s is not restored:\n%s", file, generated)
+		}
+	}
+}
+
+func TestParseDocumentExtensions(t *testing.T) {
+	doc, err := ParseDocument([]byte(`{"swagger":"2.0","info":{"title":"","description":"","termsOfService":"",` +
+		`"contact":{"name":""},"license":{"name":""},"version":""},"x-service-type":"json-rpc",` +
+		`"paths":{"/users":{"x-path":1,"get":{"x-operation":true,"parameters":[{"name":"id","in":"query","x-param":"p"}],` +
+		`"responses":{"200":{"schema":{"type":"object","x-go-type":"User","x-enum-names":["a"],"x-nullable":true}}}}}},` +
+		`"definitions":{}}`))
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	assertTrue(doc.data["x-service-type"] == "json-rpc", t)
+	item := doc.Paths["/users"]
+	assertTrue(item.data["x-path"] == float64(1), t)
+	assertTrue(item.Get.data["x-operation"] == true, t)
+	assertTrue(item.Get.Parameters[0].data["x-param"] == "p", t)
+
+	schema := item.Get.Responses["200"].Schema
+	assertTrue(schema.GoType == "User", t)
+	assertTrue(reflect.DeepEqual(schema.EnumNames, []string{"a"}), t)
+	assertTrue(reflect.DeepEqual(schema.data, map[string]interface{}{"x-nullable": true}), t)
+
+	_, err = ParseDocument([]byte(`{"paths":[]}`))
+	assertTrue(err != nil, t)
+}
+
+type testRoundTripSettings struct{}
+
+func (testRoundTripSettings) SwgenDefinition() (string, SchemaObj, error) {
+	so := SchemaObj{Type: "object"}
+	so.AddExtendedField("additionalProperties", true)
+	return "testRoundTripSettings", so, nil
+}
+
+func TestParseDocumentRoundTrip(t *testing.T) {
+	assertRoundTrip := func(data []byte) {
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("error %v", err)
+		}
+		generated, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatalf("error %v", err)
+		}
+
+		var expected, actual interface{}
+		json.Unmarshal(data, &expected)
+		json.Unmarshal(generated, &actual)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("document is not restored:\n%s\n%s", data, generated)
+		}
+	}
+
+	g := NewGenerator()
+	g.AddExtendedField("tags", []map[string]string{{"name": "users"}})
+	g.AddExtendedField("externalDocs", map[string]string{"url": "https://example.com/docs"})
+	g.AddExtendedField("produces", []string{"application/json"})
+
+	info := PathItemInfo{Path: "/users/{id}", Method: "PUT", Tag: "users"}
+	info.AddExtendedField("operationId", "updateUser")
+	info.AddExtendedField("consumes", []string{"application/json"})
+	info.AddExtendedField("externalDocs", map[string]string{"url": "https://example.com/users"})
+	g.SetPathItem(info, struct {
+		ID int `path:"id"`
+	}{}, testDocumented{}, testRoundTripSettings{})
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertRoundTrip(data)
+
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(doc.Paths["/users/{id}"].Put.data["operationId"] == "updateUser", t)
+	assertTrue(doc.Definitions["testRoundTripSettings"].data["additionalProperties"] == true, t)
+
+	// schema of additionalProperties is restored as schema
+	assertRoundTrip([]byte(`{"swagger":"2.0","info":{"title":"","description":"","termsOfService":"",` +
+		`"contact":{"name":""},"license":{"name":""},"version":""},"paths":{},"definitions":{` +
+		`"Closed":{"type":"object","additionalProperties":false},` +
+		`"Map":{"type":"object","additionalProperties":{"type":"string"}}}}`))
+
+	// missing paths and definitions are rendered empty as they are required
+	data, err = json.Marshal(Document{})
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	var fields map[string]interface{}
+	json.Unmarshal(data, &fields)
+	assertTrue(reflect.DeepEqual(fields["definitions"], map[string]interface{}{}), t)
+	assertTrue(reflect.DeepEqual(fields["paths"], map[string]interface{}{}), t)
+
+	doc, err = ParseDocument([]byte(`{"swagger":"2.0","paths":{}}`))
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	data, err = json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(strings.Contains(string(data), `"definitions":{}`), t)
+}
+
 func assertTrue(v bool, t *testing.T) {
 	if v != true {
 		t.Fatal("value must return true")
@@ -39,3 +176,23 @@ func assertFalse(v bool, t *testing.T) {
 		t.Fatal("value must return false")
 	}
 }
+
+func TestPathItemDeprecatedParams(t *testing.T) {
+	item := PathItem{
+		Params:     &ParamObj{Name: "id", In: "path", Type: "integer", Required: true},
+		Parameters: []ParamObj{{Name: "X-Token", In: "header", Type: "string"}},
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(string(data) == `{"parameters":[{"name":"id","in":"path","type":"integer","required":true},`+
+		`{"name":"X-Token","in":"header","type":"string"}]}`, t)
+
+	var parsed PathItem
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(parsed.Params == nil && len(parsed.Parameters) == 2, t)
+}
@@ -0,0 +1,60 @@
+package swgen
+
+import (
+	"reflect"
+	"strings"
+)
+
+var typeOfEnumer = reflect.TypeOf((*enumer)(nil)).Elem()
+
+// enumerValues returns values and names of enum of type implementing enumer with value or pointer receiver
+func enumerValues(t reflect.Type) ([]interface{}, []string, bool) {
+	var e enumer
+	switch {
+	case t.Implements(typeOfEnumer) && t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface:
+		e = reflect.Zero(t).Interface().(enumer)
+	case reflect.PtrTo(t).Implements(typeOfEnumer):
+		e = reflect.New(t).Interface().(enumer)
+	default:
+		return nil, nil, false
+	}
+
+	values, names := e.GetEnumSlices()
+	return values, names, true
+}
+
+// parseEnumTag sets enum of schema of struct field from comma separated values of `enum` tag,
+// values of arrays and maps are applied to their items
+func (g *Generator) parseEnumTag(field reflect.StructField, so *SchemaObj) {
+	tag := field.Tag.Get("enum")
+	if tag == "" {
+		return
+	}
+
+	t, target := field.Type, so
+	for {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch {
+		case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && target.Items != nil:
+			t, target = t.Elem(), target.Items
+			continue
+		case t.Kind() == reflect.Map && target.AdditionalProperties != nil:
+			t, target = t.Elem(), target.AdditionalProperties
+			continue
+		}
+		break
+	}
+
+	var values []interface{}
+	for _, item := range strings.Split(tag, ",") {
+		if value, err := g.caseDefaultValue(t, strings.TrimSpace(item)); err == nil {
+			values = append(values, value)
+		}
+	}
+	if len(values) > 0 {
+		target.Enum = Enum{Enum: values}
+	}
+}
@@ -0,0 +1,56 @@
+package swgen
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testPriority int
+
+func (*testPriority) GetEnumSlices() ([]interface{}, []string) {
+	return []interface{}{1, 2}, []string{"Low", "High"}
+}
+
+type testEnums struct {
+	Gender     Gender                  `json:"gender"`
+	Flags      []Flag                  `json:"flags"`
+	Priorities map[string]testPriority `json:"priorities"`
+	Status     string                  `json:"status" enum:"new, active,closed"`
+	Levels     []int                   `json:"levels" enum:"1,2,x,3"`
+	Labels     map[string]*string      `json:"labels" enum:"a,b"`
+}
+
+func TestParseDefinitionEnum(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.ParseDefinition(testEnums{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	typeDef, found := g.getDefinition(reflect.TypeOf(testEnums{}))
+	if !found {
+		t.Fatal("No definition for testEnums")
+	}
+
+	gender := typeDef.Properties["gender"]
+	assertTrue(gender.Type == "integer" && len(gender.Enum.Enum) == 4, t)
+	assertTrue(reflect.DeepEqual(gender.Enum.EnumNames, []string{"PreferNotToDisclose", "Male", "Female", "LGBT"}), t)
+
+	flags := typeDef.Properties["flags"]
+	assertTrue(flags.Enum.Enum == nil, t)
+	assertTrue(reflect.DeepEqual(flags.Items.Enum.Enum, []interface{}{Flag("Foo"), Flag("Bar")}), t)
+	assertTrue(reflect.DeepEqual(flags.Items.Enum.EnumNames, []string{"Foo", "Bar"}), t)
+
+	priorities := typeDef.Properties["priorities"]
+	assertTrue(reflect.DeepEqual(priorities.AdditionalProperties.Enum.EnumNames, []string{"Low", "High"}), t)
+
+	status := typeDef.Properties["status"]
+	assertTrue(reflect.DeepEqual(status.Enum.Enum, []interface{}{"new", "active", "closed"}), t)
+	assertTrue(status.Enum.EnumNames == nil, t)
+
+	levels := typeDef.Properties["levels"]
+	assertTrue(levels.Enum.Enum == nil, t)
+	assertTrue(reflect.DeepEqual(levels.Items.Enum.Enum, []interface{}{int64(1), int64(2), int64(3)}), t)
+
+	labels := typeDef.Properties["labels"]
+	assertTrue(reflect.DeepEqual(labels.AdditionalProperties.Enum.Enum, []interface{}{"a", "b"}), t)
+}
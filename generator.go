@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+
+	"github.com/lazada/swgen/internal/httputil"
 )
 
 // Generator create swagger document
@@ -22,9 +24,29 @@ type Generator struct {
 	defQueue        map[reflect.Type]struct{} // queue of reflect.Type objects waiting for analysis
 	paths           map[string]PathItem       // list all of paths object
 	typesMap        map[reflect.Type]interface{}
+	implementations map[reflect.Type][]reflect.Type // registered implementations of interfaces
+
+	indentJSON             bool
+	reflectGoTypes         bool
+	reflectValidateTags    bool
+	reflectRequiredFields  bool
+	reflectEmbeddedStructs bool
+	reflectNullableFields  bool
+	reflectDocComments     bool
+
+	splitReadWriteDefinitions bool
 
-	indentJSON     bool
-	reflectGoTypes bool
+	requestBodyLimit int64 // maximal size of request body read by ValidateRequests
+
+	docMu       sync.Mutex              // mutex for doc comments, they are loaded with or without g.mu locked
+	docComments map[string]*packageDocs // doc comments of parsed packages by package path
+
+	cacheMu      sync.Mutex // mutex for cache of rendered documents
+	cache        map[documentCacheKey]*cachedDocument
+	cacheVersion uint64          // incremented on every change of generator
+	operations   *operationIndex // index of operations to check requests and responses
+	hostSet      bool            // host of request is not used in document when host is set
+	gzipEnabled  bool
 
 	mu sync.Mutex // mutex for Generator's public API
 }
@@ -46,6 +68,7 @@ func (m *defMap) GenDefinitions() (result map[string]SchemaObj) {
 			result[typeDef.TypeName] = typeDef
 		}
 	}
+	listSubtypes(result)
 	return
 }
 
@@ -59,6 +82,7 @@ func NewGenerator() *Generator {
 	g.defQueue = make(map[reflect.Type]struct{})
 	g.paths = make(map[string]PathItem) // list all of paths object
 	g.typesMap = make(map[reflect.Type]interface{})
+	g.implementations = make(map[reflect.Type][]reflect.Type)
 
 	g.doc.Schemes = []string{"http", "https"}
 	g.doc.Paths = make(map[string]PathItem)
@@ -70,6 +94,8 @@ func NewGenerator() *Generator {
 	// set default Access-Control-Allow-Headers of swagger.json
 	g.corsAllowHeaders = []string{"Content-Type", "api_key", "Authorization"}
 
+	g.requestBodyLimit = defaultRequestBodyLimit
+
 	return g
 }
 
@@ -78,6 +104,7 @@ func (g *Generator) IndentJSON(enabled bool) *Generator {
 	g.mu.Lock()
 	g.indentJSON = enabled
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -86,6 +113,18 @@ func (g *Generator) ReflectGoTypes(enabled bool) *Generator {
 	g.mu.Lock()
 	g.reflectGoTypes = enabled
 	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// ReflectRequiredFields controls required lists of definitions, when enabled struct fields are required
+// unless they are pointers or have omitempty option of `json` tag, `required:"true"` and `required:"false"`
+// tags are used regardless of this option
+func (g *Generator) ReflectRequiredFields(enabled bool) *Generator {
+	g.mu.Lock()
+	g.reflectRequiredFields = enabled
+	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -118,6 +157,11 @@ func (g *Generator) SetHost(host string) *Generator {
 	g.mu.Lock()
 	g.host = host
 	g.mu.Unlock()
+
+	g.cacheMu.Lock()
+	g.hostSet = host != ""
+	g.cacheMu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -127,6 +171,7 @@ func (g *Generator) SetBasePath(basePath string) *Generator {
 	g.mu.Lock()
 	g.doc.BasePath = basePath
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -141,6 +186,7 @@ func (g *Generator) SetContact(name, url, email string) *Generator {
 	g.mu.Lock()
 	g.doc.Info.Contact = ct
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -156,6 +202,7 @@ func (g *Generator) SetInfo(title, description, term, version string) *Generator
 	g.mu.Lock()
 	g.doc.Info = info
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -169,6 +216,7 @@ func (g *Generator) SetLicense(name, url string) *Generator {
 	g.mu.Lock()
 	g.doc.Info.License = ls
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -177,6 +225,7 @@ func (g *Generator) AddExtendedField(name string, value interface{}) *Generator
 	g.mu.Lock()
 	g.doc.AddExtendedField(name, value)
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -185,6 +234,7 @@ func (g *Generator) AddSecurityDefinition(name string, def SecurityDef) *Generat
 	g.mu.Lock()
 	g.doc.SecurityDefinitions[name] = def
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -193,6 +243,7 @@ func (g *Generator) AddTypeMap(src interface{}, dst interface{}) *Generator {
 	g.mu.Lock()
 	g.typesMap[reflect.TypeOf(src)] = dst
 	g.mu.Unlock()
+	g.invalidateCache()
 	return g
 }
 
@@ -207,15 +258,19 @@ func (g *Generator) isJSONRPC() bool {
 		return false
 	}
 
-	t, isServiceType := serviceType.(ServiceType)
-	return isServiceType && t == ServiceTypeJSONRPC
+	// service type of parsed document is a string
+	switch t := serviceType.(type) {
+	case ServiceType:
+		return t == ServiceTypeJSONRPC
+	case string:
+		return ServiceType(t) == ServiceTypeJSONRPC
+	}
+	return false
 }
 
-// genDocument returns document specification in JSON string (in []byte)
-func (g *Generator) genDocument(host *string) ([]byte, error) {
-	g.mu.Lock()
-	defer g.mu.Unlock()
-
+// prepareDocument ensures that all definitions are parsed and collects registered paths into g.doc,
+// it must be called with g.mu locked
+func (g *Generator) prepareDocument(host *string) {
 	// ensure that all definition in queue is parsed before generating
 	g.parseDefInQueue()
 	g.doc.Definitions = g.definitions.GenDefinitions()
@@ -242,18 +297,26 @@ func (g *Generator) genDocument(host *string) ([]byte, error) {
 		}
 		g.doc.Paths[path] = item
 	}
+}
 
-	var (
-		data []byte
-		err  error
-	)
+func (g *Generator) marshalJSON(v interface{}) ([]byte, error) {
 	if g.indentJSON {
-		data, err = json.MarshalIndent(g.doc, "", "  ")
-	} else {
-		data, err = json.Marshal(g.doc)
+		return json.MarshalIndent(v, "", "  ")
 	}
+	return json.Marshal(v)
+}
 
-	return data, err
+// genDocument returns document specification in JSON string (in []byte)
+func (g *Generator) genDocument(host *string) ([]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.prepareDocument(host)
+
+	if g.splitReadWriteDefinitions {
+		return g.marshalJSON(splitReadWriteDefinitions(g.doc))
+	}
+	return g.marshalJSON(g.doc)
 }
 
 // GenDocument returns document specification in JSON string (in []byte)
@@ -262,16 +325,56 @@ func (g *Generator) GenDocument() ([]byte, error) {
 	return g.genDocument(nil)
 }
 
-// ServeHTTP implements http.Handler to server swagger.json document
+// isYAMLRequested returns true if request path has YAML extension or YAML is an acceptable response media type
+func isYAMLRequested(r *http.Request) bool {
+	if strings.HasSuffix(r.URL.Path, ".yaml") || strings.HasSuffix(r.URL.Path, ".yml") {
+		return true
+	}
+
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, "application/yaml") ||
+		strings.Contains(accept, "application/x-yaml") ||
+		strings.Contains(accept, "text/yaml")
+}
+
+// ServeHTTP implements http.Handler to server swagger.json document,
+// document is served in YAML format for requests to *.yaml path or with YAML in Accept header,
+// rendered document is cached until generator is changed and served with ETag to support conditional requests
 func (g *Generator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	data, err := g.genDocument(&r.URL.Host)
+	format, contentType := documentFormatJSON, "application/json"
+	if isYAMLRequested(r) {
+		format, contentType = documentFormatYAML, "application/x-yaml"
+	}
+
+	doc, err := g.cachedDocument(format, r.URL.Host)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	g.cacheMu.Lock()
+	gzipEnabled := g.gzipEnabled
+	g.cacheMu.Unlock()
+
+	data, etag := doc.data, doc.etag
+	if gzipEnabled && httputil.AcceptsGzip(r) {
+		// compressed representation has its own entity tag
+		data, etag = g.gzipData(doc), strings.TrimSuffix(etag, `"`)+`-gzip"`
+		w.Header().Set("Content-Encoding", "gzip")
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Vary", "Accept, Accept-Encoding")
 
 	g.writeCORSHeaders(w)
 
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Encoding")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	w.Write(data)
 }
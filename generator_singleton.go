@@ -50,6 +50,26 @@ func GenDocument() ([]byte, error) {
 	return gen.GenDocument()
 }
 
+// GenDocumentYAML returns document specification in YAML string (in []byte)
+func GenDocumentYAML() ([]byte, error) {
+	return gen.GenDocumentYAML()
+}
+
+// GenDocumentOpenAPI3 returns OpenAPI 3.0 document specification in JSON string (in []byte)
+func GenDocumentOpenAPI3() ([]byte, error) {
+	return gen.GenDocumentOpenAPI3()
+}
+
+// GenDocumentOpenAPI31 returns OpenAPI 3.1 document specification in JSON string (in []byte)
+func GenDocumentOpenAPI31() ([]byte, error) {
+	return gen.GenDocumentOpenAPI31()
+}
+
+// GenJSONSchema returns definitions as JSON Schema 2020-12 document in JSON string (in []byte)
+func GenJSONSchema() ([]byte, error) {
+	return gen.GenJSONSchema()
+}
+
 // ServeHTTP implements http.HandleFunc to server swagger.json document
 func ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	gen.ServeHTTP(w, r)
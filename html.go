@@ -0,0 +1,578 @@
+package swgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type htmlReference struct {
+	Doc                 Document
+	Tags                []htmlTag
+	Definitions         []htmlDefinition
+	SecurityDefinitions []htmlSecurityDef
+}
+
+type htmlTag struct {
+	Name       string
+	Operations []htmlOperation
+}
+
+type htmlOperation struct {
+	*OperationObj
+	Method string
+	Path   string
+}
+
+type htmlDefinition struct {
+	Name   string
+	Schema SchemaObj
+}
+
+type htmlSecurityDef struct {
+	Name string
+	SecurityDef
+}
+
+type htmlProperty struct {
+	Name     string
+	Required bool
+	Schema   SchemaObj
+}
+
+type htmlResponse struct {
+	Code string
+	ResponseObj
+}
+
+type htmlHeader struct {
+	Name string
+	HeaderObj
+}
+
+// newHTMLReference groups operations of document by tags and sorts definitions
+func newHTMLReference(doc Document) htmlReference {
+	ref := htmlReference{Doc: doc}
+
+	tags := make(map[string][]htmlOperation)
+	for path, item := range doc.Paths {
+		for _, method := range operationMethods {
+			op := item.getOperation(method)
+			if op == nil {
+				continue
+			}
+
+			tag := "default"
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+			tags[tag] = append(tags[tag], htmlOperation{OperationObj: op, Method: method, Path: path})
+		}
+	}
+
+	for name, operations := range tags {
+		sort.Stable(htmlOperationsByPath(operations))
+		ref.Tags = append(ref.Tags, htmlTag{Name: name, Operations: operations})
+	}
+	sort.Sort(htmlTagsByName(ref.Tags))
+
+	for name, schema := range doc.Definitions {
+		ref.Definitions = append(ref.Definitions, htmlDefinition{Name: name, Schema: schema})
+	}
+	sort.Sort(htmlDefinitionsByName(ref.Definitions))
+
+	for name, def := range doc.SecurityDefinitions {
+		ref.SecurityDefinitions = append(ref.SecurityDefinitions, htmlSecurityDef{Name: name, SecurityDef: def})
+	}
+	sort.Sort(htmlSecurityDefsByName(ref.SecurityDefinitions))
+
+	return ref
+}
+
+type htmlOperationsByPath []htmlOperation
+
+func (s htmlOperationsByPath) Len() int           { return len(s) }
+func (s htmlOperationsByPath) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s htmlOperationsByPath) Less(i, j int) bool { return s[i].Path < s[j].Path }
+
+type htmlTagsByName []htmlTag
+
+func (s htmlTagsByName) Len() int           { return len(s) }
+func (s htmlTagsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s htmlTagsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+type htmlDefinitionsByName []htmlDefinition
+
+func (s htmlDefinitionsByName) Len() int           { return len(s) }
+func (s htmlDefinitionsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s htmlDefinitionsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+type htmlSecurityDefsByName []htmlSecurityDef
+
+func (s htmlSecurityDefsByName) Len() int           { return len(s) }
+func (s htmlSecurityDefsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s htmlSecurityDefsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+// htmlAnchor converts name into value of HTML id attribute
+func htmlAnchor(prefix, name string) string {
+	return prefix + "-" + strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '-'
+	}, name)
+}
+
+// htmlSchemaType renders type of schema with links to definitions
+func htmlSchemaType(so SchemaObj) template.HTML {
+	if so.Ref != "" {
+		name := strings.TrimPrefix(so.Ref, refDefinitionPrefix)
+		return template.HTML(`<a href="#` + template.HTMLEscapeString(htmlAnchor("definition", name)) + `">` +
+			template.HTMLEscapeString(name) + `</a>`)
+	}
+
+	switch {
+	case len(so.AllOf) > 0:
+		types := make([]string, len(so.AllOf))
+		for i, s := range so.AllOf {
+			types[i] = string(htmlSchemaType(s))
+		}
+		return template.HTML("all of " + strings.Join(types, ", "))
+	case so.data["x-oneOf"] != nil:
+		oneOf, _ := so.data["x-oneOf"].([]SchemaObj)
+		types := make([]string, len(oneOf))
+		for i, s := range oneOf {
+			types[i] = string(htmlSchemaType(s))
+		}
+		return template.HTML("one of " + strings.Join(types, ", "))
+	case so.Type == "array" && so.Items != nil:
+		return "array of " + htmlSchemaType(*so.Items)
+	case so.AdditionalProperties != nil:
+		return "map of " + htmlSchemaType(*so.AdditionalProperties)
+	}
+
+	t := so.Type
+	if t == "" {
+		t = "any"
+	}
+	if so.Format != "" {
+		t += " (" + so.Format + ")"
+	}
+	return template.HTML(template.HTMLEscapeString(t))
+}
+
+// htmlParamType renders type of parameter
+func htmlParamType(param ParamObj) template.HTML {
+	if param.Schema != nil {
+		return htmlSchemaType(*param.Schema)
+	}
+	return htmlSchemaType(paramSchema(param))
+}
+
+// htmlHeaderType renders type of response header
+func htmlHeaderType(header HeaderObj) template.HTML {
+	return htmlParamType(ParamObj{Type: header.Type, Format: header.Format, Items: header.Items})
+}
+
+// htmlProperties returns sorted properties of object schema, own properties of composed schema included
+func htmlProperties(so SchemaObj) []htmlProperty {
+	required := make(map[string]bool, len(so.Required))
+	schemas := make(map[string]SchemaObj, len(so.Properties))
+	for _, s := range append([]SchemaObj{so}, so.AllOf...) {
+		if s.Ref != "" {
+			continue
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+		for name, property := range s.Properties {
+			schemas[name] = property
+		}
+	}
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	properties := make([]htmlProperty, 0, len(names))
+	for _, name := range names {
+		properties = append(properties, htmlProperty{Name: name, Required: required[name], Schema: schemas[name]})
+	}
+	return properties
+}
+
+// htmlInlineObject returns inline object schema of property, array items or map values
+func htmlInlineObject(so SchemaObj) *SchemaObj {
+	for s := &so; s != nil && s.Ref == ""; {
+		if len(s.Properties) > 0 || len(s.AllOf) > 0 {
+			return s
+		}
+		if s.Items != nil {
+			s = s.Items
+		} else {
+			s = s.AdditionalProperties
+		}
+	}
+	return nil
+}
+
+// htmlConstraints describes validation keywords, enum and default value of schema
+func htmlConstraints(so SchemaObj) string {
+	var items []string
+
+	if so.Minimum != nil {
+		op := ">= "
+		if so.ExclusiveMinimum {
+			op = "> "
+		}
+		items = append(items, op+strconv.FormatFloat(*so.Minimum, 'g', -1, 64))
+	}
+	if so.Maximum != nil {
+		op := "<= "
+		if so.ExclusiveMaximum {
+			op = "< "
+		}
+		items = append(items, op+strconv.FormatFloat(*so.Maximum, 'g', -1, 64))
+	}
+	if so.MultipleOf != 0 {
+		items = append(items, "multiple of "+strconv.FormatFloat(so.MultipleOf, 'g', -1, 64))
+	}
+	if so.MinLength != nil {
+		items = append(items, "min length "+strconv.FormatInt(*so.MinLength, 10))
+	}
+	if so.MaxLength != nil {
+		items = append(items, "max length "+strconv.FormatInt(*so.MaxLength, 10))
+	}
+	if so.Pattern != "" {
+		items = append(items, "pattern "+so.Pattern)
+	}
+	if so.MinItems != nil {
+		items = append(items, "min items "+strconv.FormatInt(*so.MinItems, 10))
+	}
+	if so.MaxItems != nil {
+		items = append(items, "max items "+strconv.FormatInt(*so.MaxItems, 10))
+	}
+	if so.UniqueItems {
+		items = append(items, "unique items")
+	}
+	if len(so.Enum.Enum) > 0 {
+		items = append(items, "one of "+jsonString(so.Enum.Enum))
+	}
+	if so.Default != nil {
+		items = append(items, "default "+jsonString(so.Default))
+	}
+	if so.Example != nil {
+		items = append(items, "example "+jsonString(so.Example))
+	}
+
+	return strings.Join(items, ", ")
+}
+
+// htmlParamConstraints describes validation keywords, enum and default value of parameter
+func htmlParamConstraints(param ParamObj) string {
+	so := paramSchema(param)
+	so.Default = param.Default
+	return htmlConstraints(so)
+}
+
+// htmlHeaderConstraints describes validation keywords, enum and default value of response header
+func htmlHeaderConstraints(header HeaderObj) string {
+	return htmlConstraints(SchemaObj{Default: header.Default, Enum: header.Enum, Constraints: header.Constraints})
+}
+
+func jsonString(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// htmlResponses returns responses sorted by status code
+func htmlResponses(responses Responses) []htmlResponse {
+	result := make([]htmlResponse, 0, len(responses))
+	for code, resp := range responses {
+		result = append(result, htmlResponse{Code: code, ResponseObj: resp})
+	}
+	sort.Sort(htmlResponsesByCode(result))
+	return result
+}
+
+type htmlResponsesByCode []htmlResponse
+
+func (s htmlResponsesByCode) Len() int           { return len(s) }
+func (s htmlResponsesByCode) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s htmlResponsesByCode) Less(i, j int) bool { return s[i].Code < s[j].Code }
+
+// htmlHeaders returns response headers sorted by name
+func htmlHeaders(headers map[string]HeaderObj) []htmlHeader {
+	result := make([]htmlHeader, 0, len(headers))
+	for name, header := range headers {
+		result = append(result, htmlHeader{Name: name, HeaderObj: header})
+	}
+	sort.Sort(htmlHeadersByName(result))
+	return result
+}
+
+type htmlHeadersByName []htmlHeader
+
+func (s htmlHeadersByName) Len() int           { return len(s) }
+func (s htmlHeadersByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s htmlHeadersByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+// htmlSecurity describes security requirements of operation
+func htmlSecurity(security []map[string][]string) string {
+	var items []string
+	for _, requirement := range security {
+		for name, scopes := range requirement {
+			if len(scopes) > 0 {
+				name += " (" + strings.Join(scopes, ", ") + ")"
+			}
+			items = append(items, name)
+		}
+	}
+	sort.Strings(items)
+	return strings.Join(items, ", ")
+}
+
+// htmlScopes returns OAuth2 scopes sorted by name
+func htmlScopes(scopes map[string]string) []string {
+	names := make([]string, 0, len(scopes))
+	for name := range scopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var htmlTemplate = template.Must(template.New("reference").Funcs(template.FuncMap{
+	"anchor":            htmlAnchor,
+	"schemaType":        htmlSchemaType,
+	"paramType":         htmlParamType,
+	"headerType":        htmlHeaderType,
+	"properties":        htmlProperties,
+	"inlineObject":      htmlInlineObject,
+	"constraints":       htmlConstraints,
+	"paramConstraints":  htmlParamConstraints,
+	"headerConstraints": htmlHeaderConstraints,
+	"responses":         htmlResponses,
+	"headers":           htmlHeaders,
+	"security":          htmlSecurity,
+	"scopes":            htmlScopes,
+	"lower":             strings.ToLower,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<title>{{with .Doc.Info.Title}}{{.}}{{else}}API reference{{end}}</title>
+<style>
+body { margin: 0; font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 14px; color: #333; }
+nav { position: fixed; top: 0; bottom: 0; left: 0; width: 260px; overflow-y: auto; padding: 16px; background: #f5f5f5; border-right: 1px solid #ddd; box-sizing: border-box; }
+nav h4 { margin: 16px 0 4px; }
+nav a { display: block; padding: 2px 0; color: #333; text-decoration: none; word-break: break-all; }
+main { margin-left: 260px; padding: 16px 32px; max-width: 960px; }
+section.operation, section.definition { margin: 16px 0; padding: 8px 16px; border: 1px solid #ddd; border-radius: 4px; }
+.method { display: inline-block; min-width: 64px; padding: 2px 6px; border-radius: 3px; color: #fff; font-weight: bold; text-align: center; background: #555; }
+.method.get { background: #61affe; } .method.post { background: #49cc90; } .method.put { background: #fca130; }
+.method.patch { background: #50e3c2; } .method.delete { background: #f93e3e; }
+.deprecated { text-decoration: line-through; }
+.description { white-space: pre-wrap; }
+table { width: 100%; border-collapse: collapse; margin: 8px 0; }
+th, td { padding: 4px 8px; border-bottom: 1px solid #eee; text-align: left; vertical-align: top; }
+td table { margin: 4px 0 0; background: #fafafa; }
+code { font-family: Menlo, Consolas, monospace; }
+.required { color: #f93e3e; }
+.constraints { color: #777; }
+</style>
+</head>
+<body>
+<nav>
+<h3>{{with .Doc.Info.Title}}{{.}}{{else}}API reference{{end}}</h3>
+{{- range .Tags}}
+<h4>{{.Name}}</h4>
+{{- range .Operations}}
+<a href="#{{anchor (lower .Method) .Path}}">{{.Method}} {{.Path}}</a>
+{{- end}}
+{{- end}}
+{{- if .Definitions}}
+<h4>Definitions</h4>
+{{- range .Definitions}}
+<a href="#{{anchor "definition" .Name}}">{{.Name}}</a>
+{{- end}}
+{{- end}}
+{{- if .SecurityDefinitions}}
+<h4>Security</h4>
+{{- range .SecurityDefinitions}}
+<a href="#{{anchor "security" .Name}}">{{.Name}}</a>
+{{- end}}
+{{- end}}
+</nav>
+<main>
+<h1>{{with .Doc.Info.Title}}{{.}}{{else}}API reference{{end}}{{with .Doc.Info.Version}} <small>{{.}}</small>{{end}}</h1>
+{{- with .Doc.Info.Description}}
+<p class="description">{{.}}</p>
+{{- end}}
+<p>
+{{- with .Doc.Host}}Host: <code>{{.}}</code><br>{{end}}
+{{- with .Doc.BasePath}}Base path: <code>{{.}}</code><br>{{end}}
+{{- with .Doc.Schemes}}Schemes: {{range $i, $s := .}}{{if $i}}, {{end}}{{$s}}{{end}}<br>{{end}}
+{{- with .Doc.Info.Contact}}{{if .Name}}Contact: {{if .URL}}<a href="{{.URL}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}{{with .Email}} &lt;<a href="mailto:{{.}}">{{.}}</a>&gt;{{end}}<br>{{end}}{{end}}
+{{- with .Doc.Info.License}}{{if .Name}}License: {{if .URL}}<a href="{{.URL}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}<br>{{end}}{{end}}
+{{- with .Doc.Info.TermsOfService}}Terms of service: {{.}}{{end}}
+</p>
+{{- range .Tags}}
+<h2>{{.Name}}</h2>
+{{- range .Operations}}
+<section class="operation" id="{{anchor (lower .Method) .Path}}">
+<h3><span class="method {{lower .Method}}">{{.Method}}</span> <code{{if .Deprecated}} class="deprecated"{{end}}>{{.Path}}</code>{{with .Summary}} {{.}}{{end}}</h3>
+{{- with .Description}}
+<p class="description">{{.}}</p>
+{{- end}}
+{{- with security .Security}}
+<p>Security: {{.}}</p>
+{{- end}}
+{{- if .Parameters}}
+<h4>Parameters</h4>
+<table>
+<tr><th>Name</th><th>In</th><th>Type</th><th>Description</th></tr>
+{{- range .Parameters}}
+<tr>
+<td><code>{{.Name}}</code>{{if .Required}} <span class="required">*</span>{{end}}</td>
+<td>{{.In}}</td>
+<td>{{paramType .}}</td>
+<td>{{.Description}}{{with paramConstraints .}} <span class="constraints">{{.}}</span>{{end}}
+{{- if .Schema}}{{with inlineObject .Schema}}{{template "properties" .}}{{end}}{{end}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+<h4>Responses</h4>
+<table>
+<tr><th>Code</th><th>Type</th><th>Description</th></tr>
+{{- range responses .Responses}}
+<tr>
+<td>{{.Code}}</td>
+<td>{{if .Schema}}{{schemaType .Schema}}{{end}}</td>
+<td>{{.Description}}
+{{- if .Schema}}{{with inlineObject .Schema}}{{template "properties" .}}{{end}}{{end}}
+{{- if .Headers}}
+<table>
+<tr><th>Header</th><th>Type</th><th>Description</th></tr>
+{{- range headers .Headers}}
+<tr><td><code>{{.Name}}</code></td><td>{{headerType .HeaderObj}}</td><td>{{.Description}}{{with headerConstraints .HeaderObj}} <span class="constraints">{{.}}</span>{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}</td>
+</tr>
+{{- end}}
+</table>
+</section>
+{{- end}}
+{{- end}}
+{{- if .Definitions}}
+<h2>Definitions</h2>
+{{- range .Definitions}}
+<section class="definition" id="{{anchor "definition" .Name}}">
+<h3>{{.Name}}</h3>
+{{- with .Schema.Description}}
+<p class="description">{{.}}</p>
+{{- end}}
+<p>{{schemaType .Schema}}{{with constraints .Schema}} <span class="constraints">{{.}}</span>{{end}}</p>
+{{- with inlineObject .Schema}}{{template "properties" .}}{{end}}
+</section>
+{{- end}}
+{{- end}}
+{{- if .SecurityDefinitions}}
+<h2>Security</h2>
+{{- range .SecurityDefinitions}}
+<section class="definition" id="{{anchor "security" .Name}}">
+<h3>{{.Name}}</h3>
+<p>Type: {{.Type}}
+{{- with .In}}<br>In: {{.}}{{end}}
+{{- with .SecurityDef.Name}}<br>Name: <code>{{.}}</code>{{end}}
+{{- with .Flow}}<br>Flow: {{.}}{{end}}
+{{- with .AuthorizationURL}}<br>Authorization URL: <code>{{.}}</code>{{end}}
+{{- with .TokenURL}}<br>Token URL: <code>{{.}}</code>{{end}}</p>
+{{- if .Scopes}}
+<table>
+<tr><th>Scope</th><th>Description</th></tr>
+{{- $scopes := .Scopes}}
+{{- range scopes .Scopes}}
+<tr><td><code>{{.}}</code></td><td>{{index $scopes .}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</section>
+{{- end}}
+{{- end}}
+</main>
+</body>
+</html>
+{{define "properties"}}
+<table>
+<tr><th>Property</th><th>Type</th><th>Description</th></tr>
+{{- range properties .}}
+<tr>
+<td><code>{{.Name}}</code>{{if .Required}} <span class="required">*</span>{{end}}</td>
+<td>{{schemaType .Schema}}</td>
+<td>{{.Schema.Description}}{{with constraints .Schema}} <span class="constraints">{{.}}</span>{{end}}
+{{- with inlineObject .Schema}}{{template "properties" .}}{{end}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+`))
+
+// GenHTML renders document into self-contained static HTML reference page
+// with operations grouped by tags, definitions and security schemes
+func (g *Generator) GenHTML(w io.Writer) error {
+	return g.genHTML(w, nil)
+}
+
+func (g *Generator) genHTML(w io.Writer, host *string) error {
+	buf := bytes.NewBuffer(nil)
+
+	g.mu.Lock()
+	g.prepareDocument(host)
+	err := htmlTemplate.Execute(buf, newHTMLReference(g.doc))
+	g.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// GenHTML renders document of package generator into self-contained static HTML reference page
+func GenHTML(w io.Writer) error {
+	return gen.GenHTML(w)
+}
+
+// HTMLHandler returns http.Handler serving static HTML reference of document
+func (g *Generator) HTMLHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := bytes.NewBuffer(nil)
+		if err := g.genHTML(buf, &r.URL.Host); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(buf.Bytes())
+	})
+}
+
+// HTMLHandler returns http.Handler serving static HTML reference of package generator document
+func HTMLHandler() http.Handler {
+	return gen.HTMLHandler()
+}
@@ -0,0 +1,71 @@
+package swgen
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGenHTML(t *testing.T) {
+	g := NewGenerator()
+	g.SetInfo("Pets <API>", "Manage pets", "", "1.0")
+	g.AddSecurityDefinition("OAuth2", SecurityDef{
+		Type:     SecurityOAuth2,
+		Flow:     Oauth2AccessCode,
+		TokenURL: "https://example.com/oauth/token",
+		Scopes:   map[string]string{"read": "Read pets", "write": "Write pets"},
+	})
+
+	info := PathItemInfo{
+		Path:           "/persons/{id}",
+		Method:         "PUT",
+		Title:          "Update person",
+		Tag:            "persons",
+		SecurityOAuth2: map[string][]string{"OAuth2": {"write"}},
+		Responses: map[int]ResponseInfo{
+			http.StatusPartialContent: {Body: []Person{}, Headers: testListHeaders{}},
+		},
+	}
+	if err := g.SetPathItem(info, testValidationParams{}, testConstraints{}, Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	if err := g.SetPathItem(PathItemInfo{Path: "/health", Method: "GET"}, nil, nil, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := g.GenHTML(buf); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	page := buf.String()
+
+	for _, s := range []string{
+		"<title>Pets &lt;API&gt;</title>",
+		`<h4>default</h4>`,
+		`<a href="#get--health">GET /health</a>`,
+		`<h4>persons</h4>`,
+		`<a href="#put--persons--id-">PUT /persons/{id}</a>`,
+		`<a href="#definition-Person">Person</a>`,
+		`<p>Security: OAuth2 (write)</p>`,
+		`<td>array of <a href="#definition-Person">Person</a></td>`,
+		`<td><code>X-Total-Count</code></td><td>integer (int64)</td>`,
+		`<span class="constraints">&gt;= 1, &lt;= 100</span>`,
+		`<td><code>age</code></td>`,
+		`<span class="constraints">&gt;= 0, &lt; 150</span>`,
+		`<td><code>write</code></td><td>Write pets</td>`,
+	} {
+		if !strings.Contains(page, s) {
+			t.Errorf("%q not found in HTML reference", s)
+		}
+	}
+
+	assertFalse(strings.Contains(page, "src="), t)
+	assertTrue(strings.Index(page, "<h2>default</h2>") < strings.Index(page, "<h2>persons</h2>"), t)
+
+	w := httptest.NewRecorder()
+	g.HTMLHandler().ServeHTTP(w, httptest.NewRequest("GET", "/docs/index.html", nil))
+	assertTrue(w.Header().Get("Content-Type") == "text/html; charset=utf-8", t)
+	assertTrue(w.Body.String() == page, t)
+}
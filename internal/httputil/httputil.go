@@ -0,0 +1,26 @@
+// Package httputil contains HTTP helpers shared by swgen and swaggerui packages.
+package httputil
+
+import (
+	"net/http"
+	"strings"
+)
+
+// AcceptsGzip returns true if gzip is an acceptable content coding of request
+func AcceptsGzip(r *http.Request) bool {
+	for _, coding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(coding, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		// "gzip;q=0" means gzip is not acceptable
+		for _, param := range parts[1:] {
+			param = strings.Replace(param, " ", "", -1)
+			if param == "q=0" || param == "q=0.0" || param == "q=0.00" || param == "q=0.000" {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package httputil
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAcceptsGzip(t *testing.T) {
+	for header, expected := range map[string]bool{
+		"":                     false,
+		"deflate":              false,
+		"gzip":                 true,
+		"deflate, gzip":        true,
+		"gzip;q=0.5":           true,
+		"gzip;q=0":             false,
+		"gzip; q=0.000, br":    false,
+		"gzipped, deflate;q=0": false,
+	} {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("Accept-Encoding", header)
+		if AcceptsGzip(r) != expected {
+			t.Errorf("unexpected result for %q", header)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+package swgen
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ValidationError describes a problem of document found by Validate
+type ValidationError struct {
+	Path    string `json:"path"` // JSON pointer to invalid value, e.g. "/paths/~1users/get/responses/200"
+	Message string `json:"message"`
+}
+
+// Error implements error interface
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// jsonSchemaValidator checks JSON values decoded with encoding/json against JSON Schema draft 4,
+// only local references and references to documents registered in schemas are supported
+type jsonSchemaValidator struct {
+	schemas map[string]interface{} // schema documents by id without fragment
+
+	mu       sync.Mutex
+	patterns map[string]*regexp.Regexp
+}
+
+// jsonSchemaLocation is a schema with id of document where it is located, used to resolve references
+type jsonSchemaLocation struct {
+	schema interface{}
+	id     string
+}
+
+func (v *jsonSchemaValidator) pattern(pattern string) *regexp.Regexp {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.patterns == nil {
+		v.patterns = make(map[string]*regexp.Regexp)
+	}
+
+	re, found := v.patterns[pattern]
+	if !found {
+		re, _ = regexp.Compile(pattern) // unsupported patterns are skipped
+		v.patterns[pattern] = re
+	}
+	return re
+}
+
+// resolve returns schema referenced by $ref relative to document id
+func (v *jsonSchemaValidator) resolve(id, ref string) (jsonSchemaLocation, bool) {
+	pos := strings.Index(ref, "#")
+	if pos == -1 {
+		pos = len(ref)
+	}
+
+	if docID := ref[:pos]; docID != "" {
+		id = docID
+	}
+
+	doc, found := v.schemas[id]
+	if !found {
+		return jsonSchemaLocation{}, false
+	}
+
+	value, found := jsonPointerValue(doc, strings.TrimPrefix(ref[pos:], "#"))
+	return jsonSchemaLocation{schema: value, id: id}, found
+}
+
+// jsonPointerValue returns value located by JSON pointer in decoded JSON document
+func jsonPointerValue(doc interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return doc, true
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+
+		switch value := doc.(type) {
+		case map[string]interface{}:
+			item, found := value[token]
+			if !found {
+				return nil, false
+			}
+			doc = item
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(value) {
+				return nil, false
+			}
+			doc = value[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return doc, true
+}
+
+// jsonPointerToken escapes name to be used as a token of JSON pointer
+func jsonPointerToken(name string) string {
+	return strings.Replace(strings.Replace(name, "~", "~0", -1), "/", "~1", -1)
+}
+
+// validate returns list of errors of instance located by path
+func (v *jsonSchemaValidator) validate(loc jsonSchemaLocation, instance interface{}, path string) []ValidationError {
+	schema, ok := loc.schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		target, found := v.resolve(loc.id, ref)
+		if !found {
+			return []ValidationError{{Path: path, Message: "unresolvable schema reference " + ref}}
+		}
+		return v.validate(target, instance, path)
+	}
+
+	var errs []ValidationError
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if t, found := schema["type"]; found && !jsonTypeMatches(t, instance) {
+		add("%s expected, %s given", jsonSchemaTypeString(t), jsonTypeName(instance))
+		return errs
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, item := range enum {
+			if reflect.DeepEqual(item, instance) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			add("value is not one of %s", enumString(enum))
+		}
+	}
+
+	sub := func(schema interface{}) jsonSchemaLocation {
+		return jsonSchemaLocation{schema: schema, id: loc.id}
+	}
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, s := range allOf {
+			errs = append(errs, v.validate(sub(s), instance, path)...)
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		var best []ValidationError
+		for i, s := range anyOf {
+			e := v.validate(sub(s), instance, path)
+			if len(e) == 0 {
+				best = nil
+				break
+			}
+			if i == 0 || errorsWeight(e) < errorsWeight(best) {
+				best = e
+			}
+		}
+		errs = append(errs, best...)
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		var (
+			best    []ValidationError
+			matches int
+		)
+		for _, s := range oneOf {
+			e := v.validate(sub(s), instance, path)
+			if len(e) == 0 {
+				matches++
+			} else if best == nil || errorsWeight(e) < errorsWeight(best) {
+				best = e
+			}
+		}
+
+		switch {
+		case matches == 0:
+			// errors of the closest alternative are the most helpful
+			// to explain why value does not match any of them
+			errs = append(errs, best...)
+		case matches > 1:
+			add("value matches more than one schema of oneOf")
+		}
+	}
+
+	if not, ok := schema["not"]; ok && len(v.validate(sub(not), instance, path)) == 0 {
+		add("value should not match schema")
+	}
+
+	switch value := instance.(type) {
+	case map[string]interface{}:
+		errs = append(errs, v.validateObject(loc, schema, value, path)...)
+	case []interface{}:
+		errs = append(errs, v.validateArray(loc, schema, value, path)...)
+	case string:
+		length := float64(utf8.RuneCountInString(value))
+		if min, ok := schema["minLength"].(float64); ok && length < min {
+			add("length should be at least %v", min)
+		}
+		if max, ok := schema["maxLength"].(float64); ok && length > max {
+			add("length should be at most %v", max)
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re := v.pattern(pattern); re != nil && !re.MatchString(value) {
+				add("value does not match pattern %s", pattern)
+			}
+		}
+	case float64:
+		exclusive, _ := schema["exclusiveMinimum"].(bool)
+		if min, ok := schema["minimum"].(float64); ok {
+			if exclusive && value <= min {
+				add("value should be greater than %v", min)
+			} else if value < min {
+				add("value should be greater than or equal to %v", min)
+			}
+		}
+		exclusive, _ = schema["exclusiveMaximum"].(bool)
+		if max, ok := schema["maximum"].(float64); ok {
+			if exclusive && value >= max {
+				add("value should be less than %v", max)
+			} else if value > max {
+				add("value should be less than or equal to %v", max)
+			}
+		}
+		if multipleOf, ok := schema["multipleOf"].(float64); ok && multipleOf > 0 {
+			if q := value / multipleOf; math.Abs(q-math.Floor(q+0.5)) > 1e-9 {
+				add("value should be multiple of %v", multipleOf)
+			}
+		}
+	}
+
+	return errs
+}
+
+func (v *jsonSchemaValidator) validateObject(loc jsonSchemaLocation, schema, obj map[string]interface{}, path string) []ValidationError {
+	var errs []ValidationError
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, found := obj[name]; !found {
+					errs = append(errs, ValidationError{Path: path, Message: "required property " + name + " is missing"})
+				}
+			}
+		}
+	}
+
+	if min, ok := schema["minProperties"].(float64); ok && float64(len(obj)) < min {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("at least %v properties expected", min)})
+	}
+	if max, ok := schema["maxProperties"].(float64); ok && float64(len(obj)) > max {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("at most %v properties expected", max)})
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	patternProperties, _ := schema["patternProperties"].(map[string]interface{})
+
+	patterns := make([]string, 0, len(patternProperties))
+	for pattern := range patternProperties {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := obj[name]
+		itemPath := path + "/" + jsonPointerToken(name)
+		matched := false
+
+		if s, found := properties[name]; found {
+			matched = true
+			errs = append(errs, v.validate(jsonSchemaLocation{schema: s, id: loc.id}, value, itemPath)...)
+		}
+
+		for _, pattern := range patterns {
+			if re := v.pattern(pattern); re != nil && re.MatchString(name) {
+				matched = true
+				errs = append(errs, v.validate(jsonSchemaLocation{schema: patternProperties[pattern], id: loc.id}, value, itemPath)...)
+			}
+		}
+
+		if matched {
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				errs = append(errs, ValidationError{Path: itemPath, Message: "property is not allowed"})
+			}
+		case map[string]interface{}:
+			errs = append(errs, v.validate(jsonSchemaLocation{schema: additional, id: loc.id}, value, itemPath)...)
+		}
+	}
+
+	return errs
+}
+
+func (v *jsonSchemaValidator) validateArray(loc jsonSchemaLocation, schema map[string]interface{}, items []interface{}, path string) []ValidationError {
+	var errs []ValidationError
+
+	if min, ok := schema["minItems"].(float64); ok && float64(len(items)) < min {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("at least %v items expected", min)})
+	}
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(items)) > max {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("at most %v items expected", max)})
+	}
+
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+	unique:
+		for i := range items {
+			for j := 0; j < i; j++ {
+				if reflect.DeepEqual(items[i], items[j]) {
+					errs = append(errs, ValidationError{Path: path, Message: "items are not unique"})
+					break unique
+				}
+			}
+		}
+	}
+
+	switch itemsSchema := schema["items"].(type) {
+	case map[string]interface{}:
+		for i, item := range items {
+			errs = append(errs, v.validate(jsonSchemaLocation{schema: itemsSchema, id: loc.id}, item, path+"/"+strconv.Itoa(i))...)
+		}
+	case []interface{}:
+		for i, item := range items {
+			var s interface{}
+			if i < len(itemsSchema) {
+				s = itemsSchema[i]
+			} else if additional, ok := schema["additionalItems"].(bool); ok && !additional {
+				errs = append(errs, ValidationError{Path: path + "/" + strconv.Itoa(i), Message: "item is not allowed"})
+				continue
+			} else {
+				s = schema["additionalItems"]
+			}
+			errs = append(errs, v.validate(jsonSchemaLocation{schema: s, id: loc.id}, item, path+"/"+strconv.Itoa(i))...)
+		}
+	}
+
+	return errs
+}
+
+// errorsWeight estimates how far value is from matching schema, mismatches of enum
+// usually mean that value is not intended to match schema at all
+func errorsWeight(errs []ValidationError) int {
+	weight := 0
+	for _, e := range errs {
+		if strings.HasPrefix(e.Message, "value is not one of ") {
+			weight += 2
+		} else {
+			weight++
+		}
+	}
+	return weight
+}
+
+// jsonTypeMatches checks if decoded JSON value matches type keyword, that is a type name or list of names
+func jsonTypeMatches(t interface{}, value interface{}) bool {
+	switch t := t.(type) {
+	case string:
+		switch t {
+		case "integer":
+			f, ok := value.(float64)
+			return ok && f == math.Trunc(f)
+		case "any":
+			return true
+		}
+		return jsonTypeName(value) == t
+	case []interface{}:
+		for _, item := range t {
+			if jsonTypeMatches(item, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func jsonSchemaTypeString(t interface{}) string {
+	if types, ok := t.([]interface{}); ok {
+		names := make([]string, 0, len(types))
+		for _, item := range types {
+			if name, ok := item.(string); ok {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, " or ")
+	}
+	s, _ := t.(string)
+	return s
+}
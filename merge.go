@@ -0,0 +1,234 @@
+package swgen
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Import adds paths, definitions and security definitions of other generator,
+// paths are prefixed with base path of other generator relative to own base path,
+// clashing names of definitions are resolved with TypeN suffix,
+// operation that is already defined for the same path and method results in error
+func (g *Generator) Import(other *Generator) error {
+	if other == g {
+		return errors.New("Generator.Import() failed: generator can not import itself")
+	}
+
+	other.mu.Lock()
+	other.prepareDocument(nil)
+	basePath := other.doc.BasePath
+	paths := make(map[string]PathItem, len(other.doc.Paths))
+	for path, item := range other.doc.Paths {
+		paths[path] = item
+	}
+	definitions := make(defMap, len(other.definitions))
+	for t, def := range other.definitions {
+		definitions[t] = def
+	}
+	securityDefinitions := make(map[string]SecurityDef, len(other.doc.SecurityDefinitions))
+	for name, def := range other.doc.SecurityDefinitions {
+		securityDefinitions[name] = def
+	}
+	other.mu.Unlock()
+
+	defer g.invalidateCache()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// check conflicts before changing generator
+	imported := make(map[string]PathItem, len(paths))
+	for path, item := range paths {
+		fullPath, err := g.importedPath(basePath, path)
+		if err != nil {
+			return err
+		}
+
+		existing := g.paths[fullPath]
+		for _, method := range operationMethods {
+			if item.getOperation(method) != nil && existing.getOperation(method) != nil {
+				return errors.New("Generator.Import() failed: operation " + method + " " + fullPath + " is already defined")
+			}
+		}
+		imported[fullPath] = item
+	}
+
+	for name, def := range securityDefinitions {
+		if existing, found := g.doc.SecurityDefinitions[name]; found && !reflect.DeepEqual(existing, def) {
+			return errors.New("Generator.Import() failed: security definition " + name + " is already defined")
+		}
+	}
+
+	for name, def := range securityDefinitions {
+		g.doc.SecurityDefinitions[name] = def
+	}
+
+	names := g.importDefinitions(definitions)
+
+	for path, item := range imported {
+		existing, found := g.paths[path]
+		if !found {
+			existing = PathItem{additionalData: item.additionalData.clone()}
+		}
+
+		for _, method := range operationMethods {
+			if op := item.getOperation(method); op != nil {
+				existing.setOperation(method, renameOperationRefs(op, names))
+			}
+		}
+		g.paths[path] = existing
+	}
+
+	return nil
+}
+
+// Import adds paths, definitions and security definitions of other generator to package generator
+func Import(other *Generator) error {
+	return gen.Import(other)
+}
+
+// Merge combines documents of generators into a new generator
+func Merge(generators ...*Generator) (*Generator, error) {
+	g := NewGenerator()
+	for _, other := range generators {
+		if err := g.Import(other); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// importedPath returns path of other generator relative to own base path
+func (g *Generator) importedPath(basePath, path string) (string, error) {
+	fullPath := strings.TrimSuffix(basePath, "/") + path
+
+	ownBasePath := strings.TrimSuffix(g.doc.BasePath, "/")
+	if ownBasePath == "" {
+		return fullPath, nil
+	}
+
+	if !strings.HasPrefix(fullPath, ownBasePath+"/") {
+		return "", errors.New("Generator.Import() failed: path " + fullPath + " is outside of base path " + g.doc.BasePath)
+	}
+	return fullPath[len(ownBasePath):], nil
+}
+
+// importDefinitions adds definitions of other generator and returns new names of imported definitions
+func (g *Generator) importDefinitions(definitions defMap) map[string]string {
+	types := make([]reflect.Type, 0, len(definitions))
+	for t := range definitions {
+		types = append(types, t)
+	}
+	// names are assigned in stable order to keep TypeN suffixes reproducible
+	sort.Sort(typesByDefinitionName{types: types, definitions: definitions})
+
+	names := make(map[string]string, len(definitions))
+	var added []reflect.Type
+	for _, t := range types {
+		def := definitions[t]
+		name := def.TypeName
+
+		if existing, found := g.definitions[t]; found {
+			names[name] = existing.TypeName
+			continue
+		}
+
+		g.addDefinition(t, &def)
+		names[name] = def.TypeName
+		added = append(added, t)
+	}
+
+	for _, t := range added {
+		def := g.definitions[t]
+		ref := def.Ref // reference to itself is already renamed by addDefinition
+		def = renameRefs(def, names)
+		def.Ref = ref
+		g.definitions[t] = def
+	}
+
+	return names
+}
+
+type typesByDefinitionName struct {
+	types       []reflect.Type
+	definitions defMap
+}
+
+func (s typesByDefinitionName) Len() int      { return len(s.types) }
+func (s typesByDefinitionName) Swap(i, j int) { s.types[i], s.types[j] = s.types[j], s.types[i] }
+func (s typesByDefinitionName) Less(i, j int) bool {
+	return s.definitions[s.types[i]].TypeName < s.definitions[s.types[j]].TypeName
+}
+
+// renameRefs returns copy of schema with references to renamed definitions updated
+func renameRefs(so SchemaObj, names map[string]string) SchemaObj {
+	if strings.HasPrefix(so.Ref, refDefinitionPrefix) {
+		if name, found := names[so.Ref[len(refDefinitionPrefix):]]; found {
+			so.Ref = refDefinitionPrefix + name
+		}
+	}
+
+	if so.Items != nil {
+		items := renameRefs(*so.Items, names)
+		so.Items = &items
+	}
+	if so.AdditionalProperties != nil {
+		additionalProperties := renameRefs(*so.AdditionalProperties, names)
+		so.AdditionalProperties = &additionalProperties
+	}
+	if so.AllOf != nil {
+		allOf := make([]SchemaObj, len(so.AllOf))
+		for i, s := range so.AllOf {
+			allOf[i] = renameRefs(s, names)
+		}
+		so.AllOf = allOf
+	}
+	if oneOf, ok := so.data["x-oneOf"].([]SchemaObj); ok {
+		renamed := make([]SchemaObj, len(oneOf))
+		for i, s := range oneOf {
+			renamed[i] = renameRefs(s, names)
+		}
+		so.additionalData = so.additionalData.clone()
+		so.AddExtendedField("x-oneOf", renamed)
+	}
+	if so.Properties != nil {
+		properties := make(map[string]SchemaObj, len(so.Properties))
+		for name, property := range so.Properties {
+			properties[name] = renameRefs(property, names)
+		}
+		so.Properties = properties
+	}
+
+	return so
+}
+
+// renameOperationRefs returns copy of operation with references to renamed definitions updated
+func renameOperationRefs(op *OperationObj, names map[string]string) *OperationObj {
+	result := *op
+	result.additionalData = op.additionalData.clone()
+
+	if op.Parameters != nil {
+		result.Parameters = make([]ParamObj, len(op.Parameters))
+		for i, param := range op.Parameters {
+			if param.Schema != nil {
+				schema := renameRefs(*param.Schema, names)
+				param.Schema = &schema
+			}
+			result.Parameters[i] = param
+		}
+	}
+
+	if op.Responses != nil {
+		result.Responses = make(Responses, len(op.Responses))
+		for code, resp := range op.Responses {
+			if resp.Schema != nil {
+				schema := renameRefs(*resp.Schema, names)
+				resp.Schema = &schema
+			}
+			result.Responses[code] = resp
+		}
+	}
+
+	return &result
+}
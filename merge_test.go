@@ -0,0 +1,90 @@
+package swgen
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testMergeUsers() *Generator {
+	type Item struct {
+		Name string `json:"name"`
+	}
+
+	g := NewGenerator().SetBasePath("/users")
+	g.AddSecurityDefinition("BasicAuth", SecurityDef{Type: SecurityBasicAuth})
+	g.SetPathItem(PathItemInfo{Path: "/{id}", Method: "GET", Security: []string{"BasicAuth"}}, nil, nil, Item{})
+	return g
+}
+
+func testMergeOrders() *Generator {
+	type Item struct {
+		Price float64 `json:"price"`
+	}
+	type Order struct {
+		Items []Item `json:"items"`
+	}
+
+	g := NewGenerator().SetBasePath("/orders/")
+	g.SetPathItem(PathItemInfo{Path: "/{id}", Method: "GET"}, nil, nil, Order{})
+	g.SetPathItem(PathItemInfo{Path: "/{id}", Method: "PUT"}, nil, Order{}, nil)
+	return g
+}
+
+func TestMerge(t *testing.T) {
+	merged, err := Merge(testMergeUsers(), testMergeOrders())
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := merged.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	assertTrue(doc.BasePath == "/", t)
+	assertTrue(len(doc.Paths) == 2, t)
+	assertTrue(doc.Paths["/users/{id}"].Get.Responses["200"].Schema.Ref == "#/definitions/Item", t)
+	assertTrue(doc.Paths["/orders/{id}"].Get.Responses["200"].Schema.Ref == "#/definitions/Order", t)
+	assertTrue(doc.Paths["/orders/{id}"].Put.Parameters[0].Schema.Ref == "#/definitions/Order", t)
+	assertTrue(doc.Definitions["Order"].Properties["items"].Items.Ref == "#/definitions/ItemType2", t)
+	assertTrue(doc.Definitions["Item"].Properties["name"].Type == "string", t)
+	assertTrue(doc.Definitions["ItemType2"].Properties["price"].Type == "number", t)
+	assertTrue(doc.SecurityDefinitions["BasicAuth"].Type == SecurityBasicAuth, t)
+}
+
+func TestImportConflicts(t *testing.T) {
+	g := testMergeUsers()
+
+	err := g.Import(testMergeUsers())
+	assertTrue(err != nil && err.Error() == "Generator.Import() failed: operation GET /{id} is already defined", t)
+
+	other := NewGenerator()
+	other.AddSecurityDefinition("BasicAuth", SecurityDef{Type: SecurityAPIKey, In: APIKeyInHeader, Name: "X-Key"})
+	assertTrue(g.Import(other) != nil, t)
+
+	err = NewGenerator().SetBasePath("/api").Import(testMergeOrders())
+	assertTrue(err != nil && err.Error() == "Generator.Import() failed: path /orders/{id} is outside of base path /api", t)
+
+	assertTrue(g.Import(g) != nil, t)
+
+	// generator is not changed on failed import
+	data, _ := g.GenDocument()
+	expected, _ := testMergeUsers().GenDocument()
+	var actualDoc, expectedDoc interface{}
+	json.Unmarshal(data, &actualDoc)
+	json.Unmarshal(expected, &expectedDoc)
+	assertTrue(equalJSON(actualDoc, expectedDoc), t)
+
+	api := NewGenerator().SetBasePath("/orders")
+	if err := api.Import(testMergeOrders()); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	data, _ = api.GenDocument()
+	doc, _ := ParseDocument(data)
+	assertTrue(doc.Paths["/{id}"].Get != nil, t)
+}
@@ -0,0 +1,68 @@
+package swgen
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ReflectNullableFields controls x-nullable extension of properties, when enabled pointer fields,
+// sql.Null* types and fields with `nullable:"true"` tag are marked with x-nullable in Swagger 2.0 document
+// and with nullable in OpenAPI 3 document, `nullable:"false"` tag disables marking of field
+func (g *Generator) ReflectNullableFields(enabled bool) *Generator {
+	g.mu.Lock()
+	g.reflectNullableFields = enabled
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// setNullable marks schema of struct field with x-nullable extension
+func (g *Generator) setNullable(field reflect.StructField, so *SchemaObj) {
+	if !g.reflectNullableFields {
+		so.goPointer = field.Type.Kind() == reflect.Ptr
+		return
+	}
+
+	switch field.Tag.Get("nullable") {
+	case "true":
+		markNullable(so)
+	case "false":
+		delete(so.data, "x-nullable")
+	default:
+		if field.Type.Kind() == reflect.Ptr {
+			markNullable(so)
+		}
+	}
+}
+
+// markNullable marks schema with x-nullable extension, reference is wrapped with allOf
+// because siblings of $ref are ignored
+func markNullable(so *SchemaObj) {
+	if so.Ref != "" {
+		so.AllOf = []SchemaObj{{Ref: so.Ref}}
+		so.Ref = ""
+	}
+	so.AddExtendedField("x-nullable", true)
+}
+
+// sqlNullValueType returns type of value of sql.Null* type or of type embedding it, e.g. null.String
+// of gopkg.in/guregu/null, such types are described as nullable values only if they implement json.Marshaler,
+// because encoding/json marshals sql.Null* types as objects with value and Valid fields
+func sqlNullValueType(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() != reflect.Struct || !t.Implements(typeOfJSONMarshaler) && !reflect.PtrTo(t).Implements(typeOfJSONMarshaler) {
+		return nil, false
+	}
+
+	if t.NumField() == 1 && t.Field(0).Anonymous {
+		t = t.Field(0).Type
+	}
+	if t.Kind() != reflect.Struct || t.PkgPath() != "database/sql" || !strings.HasPrefix(t.Name(), "Null") {
+		return nil, false
+	}
+
+	// value is the first field followed by Valid flag, e.g. String and Valid of sql.NullString
+	if t.NumField() != 2 || t.Field(1).Name != "Valid" {
+		return nil, false
+	}
+	return t.Field(0).Type, true
+}
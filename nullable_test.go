@@ -0,0 +1,126 @@
+package swgen
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// testNullString is marshaled as string or null like null.String of gopkg.in/guregu/null
+type testNullString struct {
+	sql.NullString
+}
+
+func (s testNullString) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.String)
+}
+
+type testNullable struct {
+	Name      *string          `json:"name"`
+	UpdatedAt *time.Time       `json:"updated_at"`
+	Parent    *PersonName      `json:"parent"`
+	Comment   testNullString   `json:"comment"`
+	Count     sql.NullInt64    `json:"count"`
+	Tags      []testNullString `json:"tags"`
+	Label     string           `json:"label" nullable:"true"`
+	Age       *int             `json:"age" nullable:"false"`
+	Active    bool             `json:"active"`
+}
+
+func TestReflectNullableFields(t *testing.T) {
+	g := NewGenerator().ReflectNullableFields(true)
+	if _, err := g.ParseDefinition(testNullable{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	properties := doc.Definitions["testNullable"].Properties
+	for _, name := range []string{"name", "updated_at", "parent", "comment", "label"} {
+		if properties[name].data["x-nullable"] != true {
+			t.Errorf("property %s is not nullable", name)
+		}
+	}
+	for _, name := range []string{"tags", "count", "age", "active"} {
+		if _, found := properties[name].data["x-nullable"]; found {
+			t.Errorf("property %s is nullable", name)
+		}
+	}
+
+	assertTrue(properties["comment"].Type == "string", t)
+	// sql.Null* types without json.Marshaler are marshaled as objects by encoding/json
+	assertTrue(properties["count"].Ref == "#/definitions/NullInt64", t)
+	assertTrue(doc.Definitions["NullInt64"].Type == "object", t)
+	assertTrue(properties["tags"].Items.Type == "string" && properties["tags"].Items.data["x-nullable"] == true, t)
+	assertTrue(properties["updated_at"].Format == "date-time", t)
+	// siblings of $ref are ignored, so nullable reference is wrapped with allOf
+	parent := properties["parent"]
+	assertTrue(parent.Ref == "" && len(parent.AllOf) == 1 && parent.AllOf[0].Ref == "#/definitions/PersonName", t)
+
+	data, err = g.GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	var oas3 struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &oas3); err != nil {
+		t.Fatalf("%v", err)
+	}
+	name := oas3.Components.Schemas["testNullable"].Properties["name"]
+	assertTrue(name["nullable"] == true && name["x-nullable"] == nil, t)
+
+	parentData, err := json.Marshal(oas3.Components.Schemas["testNullable"].Properties["parent"])
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(string(parentData) == `{"allOf":[{"$ref":"#/components/schemas/PersonName"}],"nullable":true}`, t)
+
+	data, err = g.GenDocumentOpenAPI31()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := json.Unmarshal(data, &oas3); err != nil {
+		t.Fatalf("%v", err)
+	}
+	parentData, err = json.Marshal(oas3.Components.Schemas["testNullable"].Properties["parent"])
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(string(parentData) == `{"anyOf":[{"$ref":"#/components/schemas/PersonName"},{"type":"null"}]}`, t)
+}
+
+func TestReflectNullableFieldsDisabled(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.ParseDefinition(testNullable{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	data, _ := g.GenDocument()
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for name, property := range doc.Definitions["testNullable"].Properties {
+		if _, found := property.data["x-nullable"]; found {
+			t.Errorf("property %s is nullable", name)
+		}
+	}
+	assertTrue(doc.Definitions["testNullable"].Properties["comment"].Ref == "#/definitions/testNullString", t)
+}
@@ -0,0 +1,724 @@
+package swgen
+
+import (
+	"net/url"
+	"strings"
+)
+
+const (
+	refComponentsSchemasPrefix = "#/components/schemas/"
+	refDefsPrefix              = "#/$defs/"
+
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+	// maxTupleLength limits length of Go arrays that are described with prefixItems
+	maxTupleLength = 64
+
+	mimeJSON           = "application/json"
+	mimeFormURLEncoded = "application/x-www-form-urlencoded"
+	mimeMultipartForm  = "multipart/form-data"
+)
+
+// OpenAPI3Document represent for a document object of OpenAPI 3 data
+// see https://github.com/OAI/OpenAPI-Specification/blob/master/versions/3.0.2.md
+type OpenAPI3Document struct {
+	Version    string                      `json:"openapi"`           // Specifies the OpenAPI Specification version being used
+	Info       InfoObj                     `json:"info"`              // Provides metadata about the API
+	Servers    []ServerObj                 `json:"servers,omitempty"` // An array of Server Objects, which provide connectivity information to a target server
+	Paths      map[string]OpenAPI3PathItem `json:"paths"`             // The available paths and operations for the API
+	Components ComponentsObj               `json:"components"`        // An element to hold various schemas for the specification
+	additionalData
+}
+
+type _OpenAPI3Document OpenAPI3Document
+
+// MarshalJSON marshal OpenAPI3Document with additionalData inlined
+func (s OpenAPI3Document) MarshalJSON() ([]byte, error) {
+	return s.marshalJSONWithStruct(_OpenAPI3Document(s))
+}
+
+// ServerObj represents a server serving the API
+type ServerObj struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// ComponentsObj holds a set of reusable objects for different aspects of the OpenAPI 3 document
+type ComponentsObj struct {
+	Schemas         map[string]SchemaObj         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]SecuritySchemeObj `json:"securitySchemes,omitempty"`
+}
+
+// OpenAPI3PathItem describes the operations available on a single path
+type OpenAPI3PathItem struct {
+	Get     *OpenAPI3OperationObj `json:"get,omitempty"`
+	Put     *OpenAPI3OperationObj `json:"put,omitempty"`
+	Post    *OpenAPI3OperationObj `json:"post,omitempty"`
+	Delete  *OpenAPI3OperationObj `json:"delete,omitempty"`
+	Options *OpenAPI3OperationObj `json:"options,omitempty"`
+	Head    *OpenAPI3OperationObj `json:"head,omitempty"`
+	Patch   *OpenAPI3OperationObj `json:"patch,omitempty"`
+
+	Parameters []OpenAPI3ParamObj `json:"parameters,omitempty"` // Parameters applicable for all the operations of path
+	additionalData
+}
+
+type _OpenAPI3PathItem OpenAPI3PathItem
+
+// MarshalJSON marshal OpenAPI3PathItem with additionalData inlined
+func (pi OpenAPI3PathItem) MarshalJSON() ([]byte, error) {
+	return pi.marshalJSONWithStruct(_OpenAPI3PathItem(pi))
+}
+
+// OpenAPI3OperationObj describes a single API operation on a path
+type OpenAPI3OperationObj struct {
+	Tags        []string                       `json:"tags,omitempty"`
+	Summary     string                         `json:"summary"`
+	Description string                         `json:"description"`
+	Parameters  []OpenAPI3ParamObj             `json:"parameters,omitempty"`
+	RequestBody *RequestBodyObj                `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPI3ResponseObj `json:"responses"`
+	Security    []map[string][]string          `json:"security,omitempty"`
+	Deprecated  bool                           `json:"deprecated,omitempty"`
+	additionalData
+}
+
+type _OpenAPI3OperationObj OpenAPI3OperationObj
+
+// MarshalJSON marshal OpenAPI3OperationObj with additionalData inlined
+func (o OpenAPI3OperationObj) MarshalJSON() ([]byte, error) {
+	return o.marshalJSONWithStruct(_OpenAPI3OperationObj(o))
+}
+
+// OpenAPI3ParamObj describes a single operation parameter, body parameters are described with RequestBodyObj
+type OpenAPI3ParamObj struct {
+	Name        string     `json:"name"`
+	In          string     `json:"in"` // Possible values are "query", "header", "path" or "cookie"
+	Description string     `json:"description,omitempty"`
+	Required    bool       `json:"required,omitempty"`
+	Style       string     `json:"style,omitempty"`
+	Explode     *bool      `json:"explode,omitempty"`
+	Schema      *SchemaObj `json:"schema,omitempty"`
+	additionalData
+}
+
+type _OpenAPI3ParamObj OpenAPI3ParamObj
+
+// MarshalJSON marshal OpenAPI3ParamObj with additionalData inlined
+func (o OpenAPI3ParamObj) MarshalJSON() ([]byte, error) {
+	return o.marshalJSONWithStruct(_OpenAPI3ParamObj(o))
+}
+
+// RequestBodyObj describes a single request body
+type RequestBodyObj struct {
+	Description string                  `json:"description,omitempty"`
+	Content     map[string]MediaTypeObj `json:"content"`
+	Required    bool                    `json:"required,omitempty"`
+}
+
+// MediaTypeObj provides schema and examples for the media type identified by its key
+type MediaTypeObj struct {
+	Schema  *SchemaObj  `json:"schema,omitempty"`
+	Example interface{} `json:"example,omitempty"`
+}
+
+// OpenAPI3ResponseObj describes a single response from an API Operation
+type OpenAPI3ResponseObj struct {
+	Description string                       `json:"description"`
+	Headers     map[string]OpenAPI3HeaderObj `json:"headers,omitempty"`
+	Content     map[string]MediaTypeObj      `json:"content,omitempty"`
+}
+
+// OpenAPI3HeaderObj describes a single header sent with the response
+type OpenAPI3HeaderObj struct {
+	Description string     `json:"description,omitempty"`
+	Schema      *SchemaObj `json:"schema"`
+}
+
+// SecuritySchemeObj defines a security scheme that can be used by the operations
+type SecuritySchemeObj struct {
+	Type   string         `json:"type"` // Valid values are "apiKey", "http", "oauth2", "openIdConnect"
+	Scheme string         `json:"scheme,omitempty"`
+	In     apiKeyIn       `json:"in,omitempty"`
+	Name   string         `json:"name,omitempty"`
+	Flows  *OAuthFlowsObj `json:"flows,omitempty"`
+}
+
+// OAuthFlowsObj allows configuration of the supported OAuth Flows
+type OAuthFlowsObj struct {
+	Implicit          *OAuthFlowObj `json:"implicit,omitempty"`
+	Password          *OAuthFlowObj `json:"password,omitempty"`
+	ClientCredentials *OAuthFlowObj `json:"clientCredentials,omitempty"`
+	AuthorizationCode *OAuthFlowObj `json:"authorizationCode,omitempty"`
+}
+
+// OAuthFlowObj holds configuration details for a supported OAuth Flow
+type OAuthFlowObj struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes"`
+}
+
+// DiscriminatorObj tells which schema of composition is used for a payload
+type DiscriminatorObj struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
+}
+
+// openAPI3Converter renders Swagger 2.0 document built by Generator as OpenAPI 3 document
+type openAPI3Converter struct {
+	version    string
+	refsPrefix string   // prefix of references to schemas, "#/components/schemas/" by default
+	consumes   []string // media types of request bodies from "consumes" of document
+	produces   []string // media types of responses from "produces" of document
+}
+
+func (c openAPI3Converter) document(doc Document) OpenAPI3Document {
+	c.consumes = mediaTypes(doc.data["consumes"])
+	c.produces = mediaTypes(doc.data["produces"])
+
+	res := OpenAPI3Document{
+		Version:        c.version,
+		Info:           doc.Info,
+		Servers:        c.servers(doc),
+		Paths:          make(map[string]OpenAPI3PathItem, len(doc.Paths)),
+		additionalData: withoutMediaTypes(doc.additionalData),
+	}
+
+	for path, item := range doc.Paths {
+		res.Paths[path] = c.pathItem(item)
+	}
+
+	if len(doc.Definitions) > 0 {
+		res.Components.Schemas = make(map[string]SchemaObj, len(doc.Definitions))
+		for name, def := range doc.Definitions {
+			res.Components.Schemas[name] = c.schema(def)
+		}
+	}
+
+	if len(doc.SecurityDefinitions) > 0 {
+		res.Components.SecuritySchemes = make(map[string]SecuritySchemeObj, len(doc.SecurityDefinitions))
+		for name, def := range doc.SecurityDefinitions {
+			res.Components.SecuritySchemes[name] = c.securityScheme(def)
+		}
+	}
+
+	return res
+}
+
+func (c openAPI3Converter) servers(doc Document) []ServerObj {
+	basePath := doc.BasePath
+	if basePath == "/" {
+		basePath = ""
+	}
+
+	if doc.Host == "" {
+		if basePath == "" {
+			return nil
+		}
+		return []ServerObj{{URL: basePath}}
+	}
+
+	servers := make([]ServerObj, 0, len(doc.Schemes))
+	for _, scheme := range doc.Schemes {
+		u := url.URL{Scheme: scheme, Host: doc.Host, Path: basePath}
+		servers = append(servers, ServerObj{URL: u.String()})
+	}
+	return servers
+}
+
+func (c openAPI3Converter) pathItem(item PathItem) OpenAPI3PathItem {
+	// body and form parameters of path item are described with request bodies of operations
+	var bodyParams []ParamObj
+	res := OpenAPI3PathItem{additionalData: item.additionalData}
+	for _, param := range item.parameters() {
+		if param.In == "body" || param.In == "formData" {
+			bodyParams = append(bodyParams, param)
+		} else {
+			res.Parameters = append(res.Parameters, c.param(param))
+		}
+	}
+
+	res.Get = c.operation(item.Get, bodyParams...)
+	res.Put = c.operation(item.Put, bodyParams...)
+	res.Post = c.operation(item.Post, bodyParams...)
+	res.Delete = c.operation(item.Delete, bodyParams...)
+	res.Options = c.operation(item.Options, bodyParams...)
+	res.Head = c.operation(item.Head, bodyParams...)
+	res.Patch = c.operation(item.Patch, bodyParams...)
+
+	return res
+}
+
+// operation converts operation object, body and form parameters of path item are used
+// if operation has no parameter with the same name and location
+func (c openAPI3Converter) operation(op *OperationObj, pathBodyParams ...ParamObj) *OpenAPI3OperationObj {
+	if op == nil {
+		return nil
+	}
+
+	res := &OpenAPI3OperationObj{
+		Tags:           op.Tags,
+		Summary:        op.Summary,
+		Description:    op.Description,
+		Security:       op.Security,
+		Deprecated:     op.Deprecated,
+		additionalData: withoutMediaTypes(op.additionalData),
+	}
+
+	params := append([]ParamObj(nil), op.Parameters...)
+	for _, pathParam := range pathBodyParams {
+		if !hasParam(op.Parameters, pathParam) {
+			params = append(params, pathParam)
+		}
+	}
+
+	var formParams []ParamObj
+	for _, param := range params {
+		switch param.In {
+		case "body":
+			consumes := c.operationMediaTypes(op, "consumes", c.consumes)
+			res.RequestBody = &RequestBodyObj{
+				Description: param.Description,
+				Required:    param.Required,
+				Content:     make(map[string]MediaTypeObj, len(consumes)),
+			}
+			for _, mime := range consumes {
+				res.RequestBody.Content[mime] = MediaTypeObj{Schema: c.schemaPtr(param.Schema)}
+			}
+		case "formData":
+			formParams = append(formParams, param)
+		default:
+			res.Parameters = append(res.Parameters, c.param(param))
+		}
+	}
+
+	if len(formParams) > 0 {
+		res.RequestBody = c.formRequestBody(formParams)
+	}
+
+	produces := c.operationMediaTypes(op, "produces", c.produces)
+	res.Responses = make(map[string]OpenAPI3ResponseObj, len(op.Responses))
+	for code, resp := range op.Responses {
+		res.Responses[code] = c.response(resp, produces)
+	}
+
+	return res
+}
+
+func (c openAPI3Converter) param(param ParamObj) OpenAPI3ParamObj {
+	schema := c.paramSchema(param)
+	res := OpenAPI3ParamObj{
+		Name:           param.Name,
+		In:             param.In,
+		Description:    param.Description,
+		Required:       param.Required || param.In == "path", // path parameters are always required in OpenAPI 3
+		Schema:         &schema,
+		additionalData: param.additionalData,
+	}
+
+	if param.Type == "array" {
+		explode := false
+		switch param.CollectionFormat {
+		case "multi":
+			explode = true
+			res.Style = "form"
+		case "ssv":
+			res.Style = "spaceDelimited"
+		case "pipes":
+			res.Style = "pipeDelimited"
+		default: // "csv" is a default collection format in Swagger 2.0
+			if param.In == "query" {
+				res.Style = "form"
+			} else {
+				res.Style = "simple"
+			}
+		}
+		res.Explode = &explode
+	}
+
+	return res
+}
+
+func (c openAPI3Converter) paramSchema(param ParamObj) SchemaObj {
+	if param.Schema != nil {
+		return c.schema(*param.Schema)
+	}
+
+	return c.schema(SchemaObj{
+		Type:        param.Type,
+		Format:      param.Format,
+		Default:     param.Default,
+		Items:       c.paramItemSchema(param.Items),
+		Enum:        param.Enum,
+		Constraints: param.Constraints,
+	})
+}
+
+func (c openAPI3Converter) paramItemSchema(items *ParamItemObj) *SchemaObj {
+	if items == nil {
+		return nil
+	}
+
+	return &SchemaObj{
+		Ref:    items.Ref,
+		Type:   items.Type,
+		Format: items.Format,
+		Items:  c.paramItemSchema(items.Items),
+		Enum:   items.Enum,
+	}
+}
+
+func (c openAPI3Converter) formRequestBody(params []ParamObj) *RequestBodyObj {
+	schema := SchemaObj{
+		Type:       "object",
+		Properties: make(map[string]SchemaObj, len(params)),
+	}
+
+	mime := mimeFormURLEncoded
+	required := false
+	for _, param := range params {
+		property := c.paramSchema(param)
+		property.Description = param.Description
+		if param.Type == "file" {
+			property.Type = "string"
+			property.Format = "binary"
+			mime = mimeMultipartForm
+		}
+		schema.Properties[param.Name] = property
+		required = required || param.Required
+	}
+
+	return &RequestBodyObj{
+		Required: required,
+		Content:  map[string]MediaTypeObj{mime: {Schema: &schema}},
+	}
+}
+
+// withoutMediaTypes returns copy of additional data without "consumes" and "produces" of Swagger 2.0,
+// they are described with content of request bodies and responses in OpenAPI 3
+func withoutMediaTypes(ad additionalData) additionalData {
+	ad = ad.clone()
+	delete(ad.data, "consumes")
+	delete(ad.data, "produces")
+	return ad
+}
+
+// hasParam checks if list contains parameter with the same name and location
+func hasParam(params []ParamObj, param ParamObj) bool {
+	for _, p := range params {
+		if p.Name == param.Name && p.In == param.In {
+			return true
+		}
+	}
+	return false
+}
+
+// mediaTypes returns list of media types set with AddExtendedField or restored by ParseDocument
+func mediaTypes(value interface{}) []string {
+	switch value := value.(type) {
+	case []string:
+		return value
+	case []interface{}:
+		res := make([]string, 0, len(value))
+		for _, item := range value {
+			if mime, ok := item.(string); ok {
+				res = append(res, mime)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+// operationMediaTypes returns media types of operation from "consumes" or "produces" field, it overrides media types
+// of document, application/json is used if neither operation nor document has media types
+func (c openAPI3Converter) operationMediaTypes(op *OperationObj, name string, documentTypes []string) []string {
+	if types := mediaTypes(op.data[name]); len(types) > 0 {
+		return types
+	}
+	if len(documentTypes) > 0 {
+		return documentTypes
+	}
+	return []string{mimeJSON}
+}
+
+func (c openAPI3Converter) response(resp ResponseObj, produces []string) OpenAPI3ResponseObj {
+	res := OpenAPI3ResponseObj{
+		Description: resp.Description,
+	}
+
+	if len(resp.Headers) > 0 {
+		res.Headers = make(map[string]OpenAPI3HeaderObj, len(resp.Headers))
+		for name, header := range resp.Headers {
+			res.Headers[name] = c.header(header)
+		}
+	}
+
+	if resp.Schema == nil || resp.Schema.Type == "null" {
+		return res
+	}
+
+	examples, _ := resp.Examples.(map[string]interface{})
+	res.Content = make(map[string]MediaTypeObj, len(produces))
+	for _, mime := range produces {
+		res.Content[mime] = MediaTypeObj{Schema: c.schemaPtr(resp.Schema), Example: examples[mime]}
+	}
+
+	return res
+}
+
+func (c openAPI3Converter) header(header HeaderObj) OpenAPI3HeaderObj {
+	schema := c.schema(SchemaObj{
+		Type:        header.Type,
+		Format:      header.Format,
+		Default:     header.Default,
+		Items:       c.paramItemSchema(header.Items),
+		Enum:        header.Enum,
+		Constraints: header.Constraints,
+	})
+
+	return OpenAPI3HeaderObj{
+		Description: header.Description,
+		Schema:      &schema,
+	}
+}
+
+func (c openAPI3Converter) securityScheme(def SecurityDef) SecuritySchemeObj {
+	switch def.Type {
+	case SecurityBasicAuth:
+		return SecuritySchemeObj{Type: "http", Scheme: "basic"}
+	case SecurityAPIKey:
+		return SecuritySchemeObj{Type: "apiKey", In: def.In, Name: def.Name}
+	case SecurityOAuth2:
+		flow := &OAuthFlowObj{
+			AuthorizationURL: def.AuthorizationURL,
+			TokenURL:         def.TokenURL,
+			Scopes:           def.Scopes,
+		}
+		if flow.Scopes == nil {
+			flow.Scopes = make(map[string]string)
+		}
+
+		flows := &OAuthFlowsObj{}
+		switch def.Flow {
+		case Oauth2AccessCode:
+			flows.AuthorizationCode = flow
+		case Oauth2Application:
+			flows.ClientCredentials = flow
+		case Oauth2Implicit:
+			flows.Implicit = flow
+		case Oauth2Password:
+			flows.Password = flow
+		}
+		return SecuritySchemeObj{Type: "oauth2", Flows: flows}
+	}
+
+	return SecuritySchemeObj{Type: string(def.Type)}
+}
+
+func (c openAPI3Converter) ref(ref string) string {
+	if strings.HasPrefix(ref, refDefinitionPrefix) {
+		return c.schemaRef(ref[len(refDefinitionPrefix):])
+	}
+	return ref
+}
+
+// schemaRef returns reference to schema by name
+func (c openAPI3Converter) schemaRef(name string) string {
+	if c.refsPrefix != "" {
+		return c.refsPrefix + name
+	}
+	return refComponentsSchemasPrefix + name
+}
+
+func (c openAPI3Converter) schemaPtr(so *SchemaObj) *SchemaObj {
+	if so == nil {
+		return nil
+	}
+	res := c.schema(*so)
+	return &res
+}
+
+func (c openAPI3Converter) schema(so SchemaObj) SchemaObj {
+	so.Ref = c.ref(so.Ref)
+	so.Items = c.schemaPtr(so.Items)
+	so.AdditionalProperties = c.schemaPtr(so.AdditionalProperties)
+
+	if len(so.AllOf) > 0 {
+		allOf := make([]SchemaObj, len(so.AllOf))
+		for i, s := range so.AllOf {
+			allOf[i] = c.schema(s)
+		}
+		so.AllOf = allOf
+	}
+
+	if len(so.Properties) > 0 {
+		properties := make(map[string]SchemaObj, len(so.Properties))
+		for name, property := range so.Properties {
+			properties[name] = c.schema(property)
+		}
+		so.Properties = properties
+	}
+
+	so.additionalData = so.additionalData.clone()
+	nullable, _ := so.data["x-nullable"].(bool)
+	delete(so.data, "x-nullable")
+
+	if isWriteOnly(so) {
+		delete(so.data, "x-writeOnly")
+		so.AddExtendedField("writeOnly", true)
+	}
+
+	// discriminator of union is an extension in Swagger 2.0, as union members do not extend it with allOf
+	if discriminator, ok := so.data["x-discriminator"].(string); ok {
+		delete(so.data, "x-discriminator")
+		so.Discriminator = discriminator
+	}
+
+	// discriminator is an object with names of inheriting schemas listed in enum of discriminator property
+	if so.Discriminator != "" {
+		discriminator := DiscriminatorObj{PropertyName: so.Discriminator}
+		if property, found := discriminatorProperty(so); found {
+			for _, name := range property.Enum.Enum {
+				if name, ok := name.(string); ok {
+					if discriminator.Mapping == nil {
+						discriminator.Mapping = make(map[string]string)
+					}
+					discriminator.Mapping[name] = c.schemaRef(name)
+				}
+			}
+		}
+		so.AddExtendedField("discriminator", discriminator)
+		so.Discriminator = ""
+	}
+
+	// union of implementations of interface is described with oneOf only
+	if oneOf, ok := so.data["x-oneOf"].([]SchemaObj); ok {
+		converted := make([]SchemaObj, len(oneOf))
+		for i, s := range oneOf {
+			converted[i] = c.schema(s)
+		}
+		delete(so.data, "x-oneOf")
+		so.AddExtendedField("oneOf", converted)
+		so.Type = ""
+		so.Properties = nil
+		so.Required = nil
+	}
+
+	if c.isJSONSchema() {
+		c.jsonSchema(&so, nullable)
+	} else if nullable {
+		// siblings of $ref are ignored, so reference is wrapped with allOf
+		if so.Ref != "" {
+			so.AllOf = []SchemaObj{{Ref: so.Ref}}
+			so.Ref = ""
+		}
+		so.AddExtendedField("nullable", true)
+	}
+
+	return so
+}
+
+// isJSONSchema returns true if schema objects of target version are JSON Schema 2020-12 compliant
+func (c openAPI3Converter) isJSONSchema() bool {
+	return strings.HasPrefix(c.version, "3.1")
+}
+
+// jsonSchema replaces OpenAPI 3.0 specific keywords of schema object with JSON Schema 2020-12 counterparts
+func (c openAPI3Converter) jsonSchema(so *SchemaObj, nullable bool) {
+	if nullable {
+		switch {
+		case so.Ref != "":
+			so.AddExtendedField("anyOf", []SchemaObj{{Ref: so.Ref}, {Type: "null"}})
+			so.Ref = ""
+		case len(so.AllOf) == 1 && so.Type == "" && len(so.Properties) == 0:
+			// reference wrapped with allOf to be marked with x-nullable
+			so.AddExtendedField("anyOf", []SchemaObj{so.AllOf[0], {Type: "null"}})
+			so.AllOf = nil
+		case so.Type != "":
+			so.AddExtendedField("type", []string{so.Type, "null"})
+			so.Type = ""
+		}
+	}
+
+	// exclusive limits are numbers instead of boolean modifiers
+	if so.ExclusiveMaximum && so.Maximum != nil {
+		so.AddExtendedField("exclusiveMaximum", *so.Maximum)
+		so.ExclusiveMaximum = false
+		so.Maximum = nil
+	}
+	if so.ExclusiveMinimum && so.Minimum != nil {
+		so.AddExtendedField("exclusiveMinimum", *so.Minimum)
+		so.ExclusiveMinimum = false
+		so.Minimum = nil
+	}
+
+	if len(so.Enum.Enum) == 1 {
+		so.AddExtendedField("const", so.Enum.Enum[0])
+		so.Enum = Enum{}
+	}
+
+	if so.Example != nil {
+		so.AddExtendedField("examples", []interface{}{so.Example})
+		so.Example = nil
+	}
+
+	// fixed-length Go arrays are described as tuples, long arrays are left with length limits only
+	if so.Items != nil && so.arrayLength > 0 && so.arrayLength <= maxTupleLength {
+		prefixItems := make([]SchemaObj, so.arrayLength)
+		for i := range prefixItems {
+			prefixItems[i] = *so.Items
+		}
+		so.AddExtendedField("prefixItems", prefixItems)
+		so.AddExtendedField("items", false)
+		so.Items = nil
+	}
+}
+
+// genDocumentOpenAPI3 returns OpenAPI 3 document specification of given version in JSON string (in []byte)
+func (g *Generator) genDocumentOpenAPI3(host *string, version string) ([]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.prepareDocument(host)
+	doc := openAPI3Converter{version: version}.document(g.doc)
+
+	return g.marshalJSON(doc)
+}
+
+// GenDocumentOpenAPI3 returns OpenAPI 3.0 document specification in JSON string (in []byte)
+func (g *Generator) GenDocumentOpenAPI3() ([]byte, error) {
+	return g.genDocumentOpenAPI3(nil, "3.0.2")
+}
+
+// GenDocumentOpenAPI31 returns OpenAPI 3.1 document specification in JSON string (in []byte),
+// schema objects of the document are JSON Schema 2020-12 compliant
+func (g *Generator) GenDocumentOpenAPI31() ([]byte, error) {
+	return g.genDocumentOpenAPI3(nil, "3.1.0")
+}
+
+// jsonSchemaDocument is a JSON Schema 2020-12 document with definitions in $defs
+type jsonSchemaDocument struct {
+	Schema string               `json:"$schema"`
+	Defs   map[string]SchemaObj `json:"$defs"`
+}
+
+// GenJSONSchema returns definitions as JSON Schema 2020-12 document in JSON string (in []byte),
+// schemas are rendered like in OpenAPI 3.1 document and are kept in $defs referred with "#/$defs/" prefix
+func (g *Generator) GenJSONSchema() ([]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.prepareDocument(nil)
+	c := openAPI3Converter{version: "3.1.0", refsPrefix: refDefsPrefix}
+	doc := jsonSchemaDocument{
+		Schema: jsonSchemaDialect,
+		Defs:   make(map[string]SchemaObj, len(g.doc.Definitions)),
+	}
+	for name, def := range g.doc.Definitions {
+		doc.Defs[name] = c.schema(def)
+	}
+
+	return g.marshalJSON(doc)
+}
@@ -0,0 +1,329 @@
+package swgen
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testOpenAPI3Params struct {
+	ID     uint64   `schema:"id" in:"path"`
+	Tags   []string `schema:"tags" in:"query" required:"-"`
+	Gender Gender   `schema:"gender" in:"query" required:"-"`
+	Token  string   `schema:"X-Token" in:"header"`
+}
+
+func TestGenDocumentOpenAPI3(t *testing.T) {
+	gen := NewGenerator()
+	gen.SetHost("localhost:1234").SetBasePath("/api")
+	gen.AddSecurityDefinition("BasicAuth", SecurityDef{Type: SecurityBasicAuth})
+	gen.AddSecurityDefinition("OAuth2", SecurityDef{Type: SecurityOAuth2, Flow: Oauth2AccessCode, TokenURL: "https://example.com/oauth/token"})
+
+	info := createPathItemInfo("/persons/{id}", "PUT", "update person", "update person", "v1", false)
+	info.Security = []string{"BasicAuth"}
+	info.Responses = map[int]ResponseInfo{201: {Body: Person{}, Headers: testListHeaders{}}}
+	if err := gen.SetPathItem(info, testOpenAPI3Params{}, Person{}, Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	if err := gen.SetPathItem(createPathItemInfo("/persons/{id}", "DELETE", "delete person", "delete person", "v1", false), testOpenAPI3Params{}, nil, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := gen.GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("Failed to generate OpenAPI 3 document: %s", err.Error())
+	}
+
+	var doc OpenAPI3Document
+	if err = json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("can not unmarshal generated data: %s", err.Error())
+	}
+
+	assertTrue(doc.Version == "3.0.2", t)
+	assertTrue(len(doc.Servers) == 2, t)
+	assertTrue(doc.Servers[0].URL == "http://localhost:1234/api", t)
+	assertTrue(doc.Servers[1].URL == "https://localhost:1234/api", t)
+
+	if _, ok := doc.Components.Schemas["Person"]; !ok {
+		t.Fatalf("Person schema is missing in components: %s", data)
+	}
+	assertTrue(doc.Components.Schemas["Person"].Properties["name"].Ref == "#/components/schemas/PersonName", t)
+	assertTrue(doc.Components.SecuritySchemes["BasicAuth"].Type == "http", t)
+	assertTrue(doc.Components.SecuritySchemes["BasicAuth"].Scheme == "basic", t)
+	assertTrue(doc.Components.SecuritySchemes["OAuth2"].Flows.AuthorizationCode.TokenURL == "https://example.com/oauth/token", t)
+
+	put := doc.Paths["/persons/{id}"].Put
+	if put == nil {
+		t.Fatalf("PUT operation is missing: %s", data)
+	}
+	assertTrue(len(put.Parameters) == 4, t)
+	assertTrue(put.Parameters[0].In == "path" && put.Parameters[0].Required, t)
+	assertTrue(put.Parameters[0].Schema.Type == "integer", t)
+	assertTrue(put.Parameters[1].Style == "form" && *put.Parameters[1].Explode, t)
+	assertTrue(put.Parameters[1].Schema.Items.Type == "string", t)
+	assertTrue(len(put.Parameters[2].Schema.Enum.Enum) == 4, t)
+	assertTrue(put.Parameters[3].In == "header", t)
+	assertTrue(put.RequestBody.Required, t)
+	assertTrue(put.RequestBody.Content["application/json"].Schema.Ref == "#/components/schemas/Person", t)
+	assertTrue(put.Responses["200"].Content["application/json"].Schema.Ref == "#/components/schemas/Person", t)
+	assertTrue(put.Responses["201"].Headers["X-Total-Count"].Schema.Format == "int64", t)
+	assertTrue(put.Responses["201"].Headers["X-Total-Count"].Description == "Total number of items", t)
+	assertTrue(put.Security[0]["BasicAuth"] != nil, t)
+
+	del := doc.Paths["/persons/{id}"].Delete
+	if del == nil {
+		t.Fatalf("DELETE operation is missing: %s", data)
+	}
+	assertTrue(del.RequestBody == nil, t)
+	assertTrue(del.Responses["200"].Content == nil, t)
+}
+
+func TestOpenAPI3FormRequestBody(t *testing.T) {
+	op := &OperationObj{
+		Parameters: []ParamObj{
+			{Name: "name", In: "formData", Type: "string", Required: true},
+			{Name: "avatar", In: "formData", Type: "file"},
+		},
+	}
+
+	res := openAPI3Converter{version: "3.0.2"}.operation(op)
+
+	mediaType, ok := res.RequestBody.Content["multipart/form-data"]
+	if !ok {
+		t.Fatalf("multipart/form-data content expected: %#v", res.RequestBody.Content)
+	}
+	assertTrue(res.RequestBody.Required, t)
+	assertTrue(mediaType.Schema.Properties["name"].Type == "string", t)
+	assertTrue(mediaType.Schema.Properties["avatar"].Format == "binary", t)
+	assertTrue(len(res.Parameters) == 0, t)
+}
+
+func TestOpenAPI3RequestBodyMediaTypes(t *testing.T) {
+	gen := NewGenerator()
+	gen.AddExtendedField("consumes", []string{"application/json", "application/xml"})
+
+	type params struct {
+		Flags []Flag `schema:"flags" in:"query" required:"-"`
+	}
+	if err := gen.SetPathItem(PathItemInfo{Path: "/persons", Method: "POST"}, params{}, Person{}, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	info := PathItemInfo{Path: "/persons", Method: "PUT"}
+	info.AddExtendedField("consumes", []string{"application/merge-patch+json"})
+	if err := gen.SetPathItem(info, nil, Person{}, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := gen.GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	var doc struct {
+		Paths map[string]struct {
+			Post struct {
+				Parameters  []OpenAPI3ParamObj `json:"parameters"`
+				RequestBody RequestBodyObj     `json:"requestBody"`
+			} `json:"post"`
+			Put struct {
+				RequestBody RequestBodyObj `json:"requestBody"`
+			} `json:"put"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	// "consumes" of document is used by default, "consumes" of operation overrides it
+	post := doc.Paths["/persons"].Post
+	assertTrue(len(post.RequestBody.Content) == 2, t)
+	assertTrue(post.RequestBody.Content["application/xml"].Schema.Ref == "#/components/schemas/Person", t)
+	put := doc.Paths["/persons"].Put
+	assertTrue(len(put.RequestBody.Content) == 1, t)
+	assertTrue(put.RequestBody.Content["application/merge-patch+json"].Schema.Ref == "#/components/schemas/Person", t)
+
+	// enum of array items is kept
+	assertTrue(len(post.Parameters[0].Schema.Items.Enum.Enum) == 2, t)
+
+	// media types are restored by ParseDocument
+	swagger, err := gen.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	parsed, err := ParseDocument(swagger)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	res := openAPI3Converter{version: "3.0.2"}.document(*parsed)
+	assertTrue(len(res.Paths["/persons"].Post.RequestBody.Content) == 2, t)
+	assertTrue(len(res.Paths["/persons"].Put.RequestBody.Content) == 1, t)
+}
+
+func TestOpenAPI3MediaTypesAndPathParameters(t *testing.T) {
+	swagger, err := ParseDocument([]byte(`{"swagger":"2.0","info":{"title":"","version":""},` +
+		`"consumes":["application/xml"],"produces":["application/xml","application/json"],"x-doc":1,"paths":{` +
+		`"/persons/{id}":{"x-path":true,"parameters":[{"name":"id","in":"path","type":"integer","required":true},` +
+		`{"name":"body","in":"body","schema":{"type":"object"}}],` +
+		`"get":{"responses":{"200":{"description":"ok","schema":{"type":"object"},` +
+		`"examples":{"application/json":{"id":1}}}}},` +
+		`"put":{"consumes":["application/json"],"produces":["text/plain"],` +
+		`"parameters":[{"name":"body","in":"body","schema":{"type":"string"}}],` +
+		`"responses":{"200":{"description":"ok","schema":{"type":"string"}}}}}},"definitions":{}}`))
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := json.Marshal(openAPI3Converter{version: "3.0.2"}.document(*swagger))
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	// media types of Swagger 2.0 are not rendered in OpenAPI 3 document
+	_, found := doc["consumes"]
+	assertFalse(found, t)
+	_, found = doc["produces"]
+	assertFalse(found, t)
+	assertTrue(doc["x-doc"] == float64(1), t)
+
+	item := doc["paths"].(map[string]interface{})["/persons/{id}"].(map[string]interface{})
+	assertTrue(item["x-path"] == true, t)
+	params := item["parameters"].([]interface{})
+	assertTrue(len(params) == 1 && params[0].(map[string]interface{})["name"] == "id", t)
+
+	// body parameter of path item is used by operations without own body parameter
+	get := item["get"].(map[string]interface{})
+	content := get["requestBody"].(map[string]interface{})["content"].(map[string]interface{})
+	assertTrue(len(content) == 1 && content["application/xml"] != nil, t)
+	content = get["responses"].(map[string]interface{})["200"].(map[string]interface{})["content"].(map[string]interface{})
+	assertTrue(len(content) == 2, t)
+	assertTrue(content["application/json"].(map[string]interface{})["example"] != nil, t)
+	_, found = content["application/xml"].(map[string]interface{})["example"]
+	assertFalse(found, t)
+
+	put := item["put"].(map[string]interface{})
+	_, found = put["consumes"]
+	assertFalse(found, t)
+	_, found = put["produces"]
+	assertFalse(found, t)
+	content = put["requestBody"].(map[string]interface{})["content"].(map[string]interface{})
+	schema := content["application/json"].(map[string]interface{})["schema"].(map[string]interface{})
+	assertTrue(len(content) == 1 && schema["type"] == "string", t)
+	content = put["responses"].(map[string]interface{})["200"].(map[string]interface{})["content"].(map[string]interface{})
+	assertTrue(len(content) == 1 && content["text/plain"] != nil, t)
+}
+
+type testPoint struct {
+	Coordinates [3]float64 `json:"coordinates"`
+	Tags        []string   `json:"tags" minItems:"1" maxItems:"1"`
+	Samples     [100]int   `json:"samples"`
+	Label       testLabel  `json:"label"`
+	Weight      float64    `json:"weight" minimum:"0" exclusiveMinimum:"true" maximum:"10"`
+}
+
+type testLabel struct{}
+
+func (testLabel) SwgenDefinition() (typeName string, typeDef SchemaObj, err error) {
+	typeDef = SchemaObj{Type: "string", Example: "origin"}
+	typeDef.Enum.Enum = []interface{}{"origin"}
+	typeDef.AddExtendedField("x-nullable", true)
+	return "Label", typeDef, nil
+}
+
+func TestGenDocumentOpenAPI31(t *testing.T) {
+	gen := NewGenerator()
+	if err := gen.SetPathItem(createPathItemInfo("/points", "POST", "add point", "add point", "v1", false), nil, testPoint{}, testPoint{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := gen.GenDocumentOpenAPI31()
+	if err != nil {
+		t.Fatalf("Failed to generate OpenAPI 3.1 document: %s", err.Error())
+	}
+
+	var doc struct {
+		Version    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err = json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("can not unmarshal generated data: %s", err.Error())
+	}
+
+	assertTrue(doc.Version == "3.1.0", t)
+
+	coordinates := doc.Components.Schemas["testPoint"]["properties"].(map[string]interface{})["coordinates"].(map[string]interface{})
+	assertTrue(coordinates["items"] == false, t)
+	assertTrue(len(coordinates["prefixItems"].([]interface{})) == 3, t)
+	assertTrue(coordinates["minItems"] == float64(3), t)
+
+	// only fixed-length Go arrays of limited length are tuples
+	tags := doc.Components.Schemas["testPoint"]["properties"].(map[string]interface{})["tags"].(map[string]interface{})
+	assertTrue(equalJSON(tags["items"], map[string]string{"type": "string"}), t)
+	_, hasPrefixItems := tags["prefixItems"]
+	assertFalse(hasPrefixItems, t)
+
+	samples := doc.Components.Schemas["testPoint"]["properties"].(map[string]interface{})["samples"].(map[string]interface{})
+	assertTrue(samples["maxItems"] == float64(100) && samples["items"].(map[string]interface{})["type"] == "integer", t)
+	_, hasPrefixItems = samples["prefixItems"]
+	assertFalse(hasPrefixItems, t)
+
+	weight := doc.Components.Schemas["testPoint"]["properties"].(map[string]interface{})["weight"].(map[string]interface{})
+	assertTrue(weight["exclusiveMinimum"] == float64(0), t)
+	assertTrue(weight["maximum"] == float64(10), t)
+	_, hasMinimum := weight["minimum"]
+	assertFalse(hasMinimum, t)
+
+	label := doc.Components.Schemas["Label"]
+	assertTrue(equalJSON(label["type"], []string{"string", "null"}), t)
+	assertTrue(label["const"] == "origin", t)
+	assertTrue(equalJSON(label["examples"], []string{"origin"}), t)
+	_, hasNullable := label["x-nullable"]
+	assertFalse(hasNullable, t)
+	_, hasEnum := label["enum"]
+	assertFalse(hasEnum, t)
+
+	data, err = gen.GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("Failed to generate OpenAPI 3.0 document: %s", err.Error())
+	}
+	if err = json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("can not unmarshal generated data: %s", err.Error())
+	}
+	assertTrue(doc.Components.Schemas["Label"]["nullable"] == true, t)
+	assertTrue(doc.Components.Schemas["Label"]["type"] == "string", t)
+}
+
+func equalJSON(value interface{}, expected interface{}) bool {
+	valueJSON, _ := json.Marshal(value)
+	expectedJSON, _ := json.Marshal(expected)
+	return string(valueJSON) == string(expectedJSON)
+}
+
+func TestGenJSONSchema(t *testing.T) {
+	gen := NewGenerator()
+	if _, err := gen.ParseDefinition(Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := gen.GenJSONSchema()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	var doc struct {
+		Schema string                            `json:"$schema"`
+		Defs   map[string]map[string]interface{} `json:"$defs"`
+	}
+	if err = json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("can not unmarshal generated data: %s", err.Error())
+	}
+
+	assertTrue(doc.Schema == "https://json-schema.org/draft/2020-12/schema", t)
+	properties := doc.Defs["Person"]["properties"].(map[string]interface{})
+	assertTrue(properties["second_name"].(map[string]interface{})["$ref"] == "#/$defs/PersonName", t)
+	_, found := doc.Defs["PersonName"]
+	assertTrue(found, t)
+}
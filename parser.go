@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +20,7 @@ const (
 
 var (
 	typeOfJSONRawMsg      = reflect.TypeOf((*json.RawMessage)(nil)).Elem()
+	typeOfJSONMarshaler   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
 	typeOfTime            = reflect.TypeOf((*time.Time)(nil)).Elem()
 	typeOfTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
 )
@@ -56,6 +59,9 @@ func (g *Generator) addDefinition(t reflect.Type, typeDef *SchemaObj) {
 			typeDef.Ref = refDefinitionPrefix + typeDef.TypeName
 		}
 	}
+	if typeDef.Description == "" {
+		typeDef.Description = g.typeDoc(t)
+	}
 	g.definitionAdded[typeDef.TypeName] = true
 	g.definitions[t] = *typeDef
 }
@@ -96,6 +102,7 @@ func (g *Generator) ResetDefinitions() {
 	g.definitions = make(defMap)
 	g.definitionAdded = make(map[string]bool)
 	g.defQueue = make(map[reflect.Type]struct{})
+	g.invalidateCache()
 }
 
 // ResetDefinitions will remove all exists definitions and init again
@@ -106,6 +113,13 @@ func ResetDefinitions() {
 // ParseDefinition create a DefObj from input object, it should be a non-nil pointer to anything
 // it reuse schema/json tag for property name.
 func (g *Generator) ParseDefinition(i interface{}) (schema SchemaObj, err error) {
+	defer g.invalidateCache()
+	return g.parseDefinition(i)
+}
+
+// parseDefinition creates definition of input object, it does not invalidate cache of rendered documents
+// to be used while document is rendered, public methods changing generator invalidate cache instead
+func (g *Generator) parseDefinition(i interface{}) (schema SchemaObj, err error) {
 	var (
 		typeName string
 		typeDef  SchemaObj
@@ -152,6 +166,7 @@ func (g *Generator) ParseDefinition(i interface{}) (schema SchemaObj, err error)
 
 		typeDef = *NewSchemaObj("object", ReflectTypeReliableName(t))
 		typeDef.Properties = g.parseDefinitionProperties(v, &typeDef)
+		composeAllOf(&typeDef)
 		if typeDef.TypeName == "" {
 			typeDef.TypeName = typeName
 		}
@@ -175,10 +190,12 @@ func (g *Generator) ParseDefinition(i interface{}) (schema SchemaObj, err error)
 		} else {
 			itemSchema = *NewSchemaObj("object", elemType.Name())
 			itemSchema.Properties = g.parseDefinitionProperties(v.Elem(), &itemSchema)
+			composeAllOf(&itemSchema)
 		}
 
 		typeDef = *NewSchemaObj("array", t.Name())
 		typeDef.Items = &itemSchema
+		setArrayLength(t, &typeDef)
 		if typeDef.TypeName == "" {
 			typeDef.TypeName = typeName
 		}
@@ -272,7 +289,17 @@ func (g *Generator) parseDefinitionProperties(v reflect.Value, parent *SchemaObj
 		}
 
 		if field.Anonymous {
-			fieldProperties := g.parseDefinitionProperties(v.Field(i), parent)
+			if embedded, ok := g.embeddedSchema(field); ok {
+				parent.AllOf = append(parent.AllOf, embedded)
+				continue
+			}
+
+			fieldValue := v.Field(i)
+			if fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil() {
+				fieldValue = reflect.Zero(field.Type.Elem())
+			}
+
+			fieldProperties := g.parseDefinitionProperties(fieldValue, parent)
 			for propertyName, property := range fieldProperties {
 				properties[propertyName] = property
 			}
@@ -293,7 +320,8 @@ func (g *Generator) parseDefinitionProperties(v reflect.Value, parent *SchemaObj
 		if dataType := field.Tag.Get("swgen_type"); dataType != "" {
 			obj = SchemaFromCommonName(commonName(dataType))
 		} else {
-			if field.Type.Kind() == reflect.Interface && v.Field(i).Elem().IsValid() {
+			_, registered := g.implementations[field.Type]
+			if field.Type.Kind() == reflect.Interface && v.Field(i).Elem().IsValid() && !registered {
 				obj = g.genSchemaForType(v.Field(i).Elem().Type())
 			} else {
 				obj = g.genSchemaForType(field.Type)
@@ -305,6 +333,32 @@ func (g *Generator) parseDefinitionProperties(v reflect.Value, parent *SchemaObj
 				obj.Default = defaultValue
 			}
 		}
+		g.parseAnnotations(field, &obj)
+		required := g.isRequiredProperty(field, g.parseValidateTag(field, &obj))
+		if field.Tag.Get("readOnly") == "true" {
+			// read only property should not be required by specification
+			obj.ReadOnly = true
+			required = false
+		}
+		if field.Tag.Get("writeOnly") == "true" {
+			obj.AddExtendedField("x-writeOnly", true)
+		}
+		if g.reflectEmbeddedStructs && field.Tag.Get("discriminator") == "true" {
+			// discriminator property must be required by specification
+			parent.Discriminator = propName
+			required = true
+		}
+		if required && !containsString(parent.Required, propName) {
+			parent.Required = append(parent.Required, propName)
+		}
+		g.parseEnumTag(field, &obj)
+		parseConstraints(field.Tag, &obj.Constraints)
+		g.setNullable(field, &obj)
+		if obj.Ref == "" && obj.Description == "" {
+			// siblings of $ref are ignored, so description of referred definition is used
+			obj.Description = g.fieldDoc(t, field)
+		}
+
 		if g.reflectGoTypes {
 			if obj.Ref == "" {
 				obj.GoType = goType(field.Type)
@@ -319,6 +373,51 @@ func (g *Generator) parseDefinitionProperties(v reflect.Value, parent *SchemaObj
 	return properties
 }
 
+// isRequiredProperty checks if struct field is a required property, `required` tag is used if present,
+// then `validate` tag if it is reflected, then if required fields are reflected field is required
+// unless it is a pointer or omitted when empty
+func (g *Generator) isRequiredProperty(field reflect.StructField, validateRequired bool) bool {
+	switch field.Tag.Get("required") {
+	case "true":
+		return true
+	case "false", "-":
+		return false
+	}
+
+	if !g.reflectRequiredFields || g.reflectValidateTags && field.Tag.Get("validate") != "" {
+		return validateRequired
+	}
+
+	if field.Type.Kind() == reflect.Ptr {
+		return false
+	}
+	for _, option := range strings.Split(field.Tag.Get("json"), ",")[1:] {
+		if option == "omitempty" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseAnnotations fills description, title, example and format of property from struct field tags,
+// example is converted to type of field like default value
+func (g *Generator) parseAnnotations(field reflect.StructField, so *SchemaObj) {
+	if descTag := field.Tag.Get("description"); descTag != "-" && descTag != "" {
+		so.Description = descTag
+	}
+	if titleTag := field.Tag.Get("title"); titleTag != "-" && titleTag != "" {
+		so.Title = titleTag
+	}
+	if exampleTag := field.Tag.Get("example"); exampleTag != "" {
+		if example, err := g.caseDefaultValue(field.Type, exampleTag); err == nil {
+			so.Example = example
+		}
+	}
+	if formatTag := field.Tag.Get("format"); formatTag != "-" && formatTag != "" {
+		so.Format = formatTag
+	}
+}
+
 func (g *Generator) caseDefaultValue(t reflect.Type, defaultValue string) (interface{}, error) {
 	for t.Kind() == reflect.Ptr {
 		t = t.Elem()
@@ -346,6 +445,58 @@ func (g *Generator) caseDefaultValue(t reflect.Type, defaultValue string) (inter
 	}
 }
 
+// parseConstraints fills validation keywords from struct field tags, invalid tag values are ignored
+func parseConstraints(tag reflect.StructTag, c *Constraints) {
+	if value, ok := floatTag(tag, "maximum"); ok {
+		c.Maximum = &value
+	}
+	if value, ok := boolTag(tag, "exclusiveMaximum"); ok {
+		c.ExclusiveMaximum = value
+	}
+	if value, ok := floatTag(tag, "minimum"); ok {
+		c.Minimum = &value
+	}
+	if value, ok := boolTag(tag, "exclusiveMinimum"); ok {
+		c.ExclusiveMinimum = value
+	}
+	if value, ok := intTag(tag, "maxLength"); ok {
+		c.MaxLength = &value
+	}
+	if value, ok := intTag(tag, "minLength"); ok {
+		c.MinLength = &value
+	}
+	if pattern := tag.Get("pattern"); pattern != "" {
+		c.Pattern = pattern
+	}
+	if value, ok := intTag(tag, "maxItems"); ok {
+		c.MaxItems = &value
+	}
+	if value, ok := intTag(tag, "minItems"); ok {
+		c.MinItems = &value
+	}
+	if value, ok := boolTag(tag, "uniqueItems"); ok {
+		c.UniqueItems = value
+	}
+	if value, ok := floatTag(tag, "multipleOf"); ok && value > 0 {
+		c.MultipleOf = value
+	}
+}
+
+func floatTag(tag reflect.StructTag, name string) (float64, bool) {
+	value, err := strconv.ParseFloat(tag.Get(name), 64)
+	return value, err == nil
+}
+
+func intTag(tag reflect.StructTag, name string) (int64, bool) {
+	value, err := strconv.ParseInt(tag.Get(name), 10, 64)
+	return value, err == nil && value >= 0
+}
+
+func boolTag(tag reflect.StructTag, name string) (bool, bool) {
+	value, err := strconv.ParseBool(tag.Get(name))
+	return value, err == nil
+}
+
 // ParseDefinition create a DefObj from input object, it should be a pointer to a struct,
 // it reuse schema/json tag for property name.
 func ParseDefinition(i interface{}) (typeDef SchemaObj, err error) {
@@ -358,7 +509,7 @@ func (g *Generator) parseDefInQueue() {
 	}
 
 	for t := range g.defQueue {
-		g.ParseDefinition(reflect.Zero(t).Interface())
+		g.parseDefinition(reflect.Zero(t).Interface())
 	}
 }
 
@@ -367,6 +518,14 @@ func (g *Generator) genSchemaForType(t reflect.Type) SchemaObj {
 		t = t.Elem()
 	}
 
+	if g.reflectNullableFields {
+		if valueType, ok := sqlNullValueType(t); ok {
+			smObj := g.genSchemaForType(valueType)
+			smObj.AddExtendedField("x-nullable", true)
+			return smObj
+		}
+	}
+
 	smObj := SchemaObj{TypeName: t.Name()}
 
 	switch t.Kind() {
@@ -387,6 +546,7 @@ func (g *Generator) genSchemaForType(t reflect.Type) SchemaObj {
 			smObj.Type = "array"
 			itemSchema := g.genSchemaForType(t.Elem())
 			smObj.Items = &itemSchema
+			setArrayLength(t, &smObj)
 		}
 	case reflect.Map:
 		smObj.Type = "object"
@@ -406,13 +566,21 @@ func (g *Generator) genSchemaForType(t reflect.Type) SchemaObj {
 			}
 		}
 	case reflect.Interface:
-		if t.NumMethod() > 0 {
+		if implementations, found := g.implementations[t]; found {
+			smObj = g.unionSchema(t, implementations)
+		} else if t.NumMethod() > 0 {
 			panic("Non-empty interface is not supported: " + t.String())
 		}
 	default:
 		panic(fmt.Sprintf("type %s is not supported: %s", t.Kind(), t.String()))
 	}
 
+	if smObj.Ref == "" {
+		if values, names, ok := enumerValues(t); ok {
+			smObj.Enum = Enum{Enum: values, EnumNames: names}
+		}
+	}
+
 	if g.reflectGoTypes && smObj.Ref == "" {
 		smObj.GoType = goType(t)
 	}
@@ -420,6 +588,18 @@ func (g *Generator) genSchemaForType(t reflect.Type) SchemaObj {
 	return smObj
 }
 
+// setArrayLength limits number of items in schema with the length of Go array type
+func setArrayLength(t reflect.Type, so *SchemaObj) {
+	if t.Kind() != reflect.Array {
+		return
+	}
+
+	length := int64(t.Len())
+	so.MinItems = &length
+	so.MaxItems = &length
+	so.arrayLength = length
+}
+
 //
 // Parse struct to swagger parameter object of operation object
 // see http://swagger.io/specification/#parameterObject
@@ -427,6 +607,9 @@ func (g *Generator) genSchemaForType(t reflect.Type) SchemaObj {
 
 // ParseParameter parse input struct to swagger parameter object
 func (g *Generator) ParseParameter(i interface{}) (name string, params []ParamObj, err error) {
+	// types of parameters are queued to be added to definitions
+	defer g.invalidateCache()
+
 	if param, ok := i.(IParameter); ok {
 		return param.SwgenParameter()
 	}
@@ -486,8 +669,13 @@ func (g *Generator) ParseParameter(i interface{}) (name string, params []ParamOb
 			param.Description = descTag
 		}
 
+		schema := g.genFieldSchema(field)
+		validateRequired := g.parseValidateTag(field, &schema)
+
 		if reqTag := field.Tag.Get("required"); reqTag == "-" || reqTag == "false" {
 			param.Required = false
+		} else if reqTag == "" && g.reflectValidateTags && field.Tag.Get("validate") != "" {
+			param.Required = validateRequired
 		} else {
 			param.Required = true
 		}
@@ -500,23 +688,17 @@ func (g *Generator) ParseParameter(i interface{}) (name string, params []ParamOb
 			param.In = "query"
 		}
 
-		var schema SchemaObj
-		if swGenType := field.Tag.Get("swgen_type"); swGenType != "" {
-			schema = SchemaFromCommonName(commonName(swGenType))
-		} else {
-			if mappedTo, ok := g.getMappedType(field.Type); ok {
-				schema = g.genSchemaForType(reflect.TypeOf(mappedTo))
-			} else {
-				schema = g.genSchemaForType(field.Type)
-			}
-		}
-
 		if schema.Type == "" {
 			panic("dont support struct " + v.Type().Name() + " in property " + field.Name + " of parameter struct")
 		}
 
 		param.Type = schema.Type
 		param.Format = schema.Format
+		param.Constraints = schema.Constraints
+		parseConstraints(field.Tag, &param.Constraints)
+		if len(param.Enum.Enum) == 0 {
+			param.Enum = schema.Enum
+		}
 
 		if schema.Type == "array" && schema.Items != nil {
 			if schema.Items.Ref != "" || schema.Items.Type == "array" {
@@ -526,6 +708,7 @@ func (g *Generator) ParseParameter(i interface{}) (name string, params []ParamOb
 			param.Items = &ParamItemObj{
 				Type:   schema.Items.Type,
 				Format: schema.Items.Format,
+				Enum:   schema.Items.Enum,
 			}
 			param.CollectionFormat = "multi" // default for now
 		}
@@ -536,6 +719,19 @@ func (g *Generator) ParseParameter(i interface{}) (name string, params []ParamOb
 	return
 }
 
+// genFieldSchema creates schema object for a field of parameters or headers struct
+func (g *Generator) genFieldSchema(field reflect.StructField) SchemaObj {
+	if swGenType := field.Tag.Get("swgen_type"); swGenType != "" {
+		return SchemaFromCommonName(commonName(swGenType))
+	}
+
+	if mappedTo, ok := g.getMappedType(field.Type); ok {
+		return g.genSchemaForType(reflect.TypeOf(mappedTo))
+	}
+
+	return g.genSchemaForType(field.Type)
+}
+
 // ParseParameter parse input struct to swagger parameter object
 func ParseParameter(i interface{}) (name string, params []ParamObj, err error) {
 	return gen.ParseParameter(i)
@@ -544,6 +740,7 @@ func ParseParameter(i interface{}) (name string, params []ParamObj, err error) {
 // ResetPaths remove all current paths
 func (g *Generator) ResetPaths() {
 	g.paths = make(map[string]PathItem)
+	g.invalidateCache()
 }
 
 // ResetPaths remove all current paths
@@ -555,6 +752,8 @@ var regexFindPathParameter = regexp.MustCompile(`\{([^}:]+)(:[^\/]+)?(?:\})`)
 
 // SetPathItem register path item with some information and input, output
 func (g *Generator) SetPathItem(info PathItemInfo, params interface{}, body interface{}, response interface{}) error {
+	defer g.invalidateCache()
+
 	var (
 		item  PathItem
 		found bool
@@ -621,14 +820,33 @@ func (g *Generator) SetPathItem(info PathItemInfo, params interface{}, body inte
 		}
 	}
 
-	operationObj.Responses = g.parseResponseObject(response)
+	if response != nil || len(info.Responses) == 0 {
+		operationObj.Responses = g.parseResponseObject(response)
+	} else {
+		operationObj.Responses = make(Responses, len(info.Responses))
+	}
+
+	// responses are parsed in order of status codes to name definitions of conflicting types deterministically
+	statusCodes := make([]int, 0, len(info.Responses))
+	for statusCode := range info.Responses {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	sort.Ints(statusCodes)
+
+	for _, statusCode := range statusCodes {
+		responseObj, err := g.parseResponseInfo(statusCode, info.Responses[statusCode])
+		if err != nil {
+			return err
+		}
+		operationObj.Responses[strconv.Itoa(statusCode)] = responseObj
+	}
 
 	if body != nil {
 		if g.reflectGoTypes {
 			operationObj.AddExtendedField("x-request-go-type", goType(reflect.TypeOf(body)))
 		}
 
-		typeDef, err := g.ParseDefinition(body)
+		typeDef, err := g.parseDefinition(body)
 
 		if err != nil {
 			return err
@@ -652,22 +870,7 @@ func (g *Generator) SetPathItem(info PathItemInfo, params interface{}, body inte
 		}
 	}
 
-	switch strings.ToUpper(info.Method) {
-	case "GET":
-		item.Get = operationObj
-	case "POST":
-		item.Post = operationObj
-	case "PUT":
-		item.Put = operationObj
-	case "DELETE":
-		item.Delete = operationObj
-	case "OPTIONS":
-		item.Options = operationObj
-	case "HEAD":
-		item.Head = operationObj
-	case "PATCH":
-		item.Patch = operationObj
-	}
+	item.setOperation(info.Method, operationObj)
 
 	g.paths[info.Path] = item
 
@@ -683,7 +886,7 @@ func (g *Generator) parseResponseObject(responseObj interface{}) (res Responses)
 	res = make(Responses)
 
 	if responseObj != nil {
-		schema, err := g.ParseDefinition(responseObj)
+		schema, err := g.parseDefinition(responseObj)
 		if err != nil {
 			panic(fmt.Sprintf("could not create schema object for response %v", responseObj))
 		}
@@ -702,3 +905,123 @@ func (g *Generator) parseResponseObject(responseObj interface{}) (res Responses)
 
 	return res
 }
+
+func (g *Generator) parseResponseInfo(statusCode int, info ResponseInfo) (ResponseObj, error) {
+	res := ResponseObj{
+		Description: info.Description,
+	}
+	if res.Description == "" {
+		res.Description = http.StatusText(statusCode)
+	}
+	if res.Description == "" {
+		res.Description = "Response" // description is required, but there is no text for non-standard status codes
+	}
+
+	if info.Body != nil {
+		schema, err := g.parseDefinition(info.Body)
+		if err != nil {
+			return res, err
+		}
+		res.Schema = &schema
+	}
+
+	if info.Headers != nil {
+		headers, err := g.ParseResponseHeaders(info.Headers)
+		if err != nil {
+			return res, err
+		}
+		res.Headers = headers
+	}
+
+	return res, nil
+}
+
+//
+// Parse struct to swagger header objects of response object
+// see http://swagger.io/specification/#headerObject
+//
+
+// ParseResponseHeaders parse input struct to swagger header objects, header names are taken from `header` tags
+func (g *Generator) ParseResponseHeaders(i interface{}) (headers map[string]HeaderObj, err error) {
+	// types of headers are queued to be added to definitions
+	defer g.invalidateCache()
+
+	v := reflect.ValueOf(i)
+
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, errors.New("Generator.ParseResponseHeaders() failed: headers must be a struct")
+	}
+
+	t := v.Type()
+
+	if mappedTo, ok := g.getMappedType(t); ok {
+		return g.ParseResponseHeaders(mappedTo)
+	}
+
+	headers = make(map[string]HeaderObj)
+
+	for i := 0; i < t.NumField(); i = i + 1 {
+		field := t.Field(i)
+		// we can't access the value of un-exportable or anonymous fields
+		if field.PkgPath != "" || field.Anonymous {
+			continue
+		}
+
+		var nameTag string
+		if nameTag = field.Tag.Get("header"); nameTag == "-" || nameTag == "" {
+			continue
+		}
+
+		header := HeaderObj{}
+
+		if e, isEnumer := reflect.Zero(field.Type).Interface().(enumer); isEnumer {
+			header.Enum.Enum, header.Enum.EnumNames = e.GetEnumSlices()
+		}
+
+		if descTag := field.Tag.Get("description"); descTag != "-" && descTag != "" {
+			header.Description = descTag
+		}
+
+		if defaultTag := field.Tag.Get("default"); defaultTag != "" {
+			if defaultValue, err := g.caseDefaultValue(field.Type, defaultTag); err == nil {
+				header.Default = defaultValue
+			}
+		}
+
+		schema := g.genFieldSchema(field)
+		if schema.Type == "" {
+			return nil, errors.New("struct is not supported in header " + field.Name + " of " + t.Name())
+		}
+
+		header.Type = schema.Type
+		header.Format = schema.Format
+		header.Constraints = schema.Constraints
+		parseConstraints(field.Tag, &header.Constraints)
+
+		if schema.Type == "array" && schema.Items != nil {
+			if schema.Items.Ref != "" || schema.Items.Type == "array" {
+				return nil, errors.New("array of struct or nested array is not supported in header " + field.Name + " of " + t.Name())
+			}
+
+			header.Items = &ParamItemObj{
+				Type:   schema.Items.Type,
+				Format: schema.Items.Format,
+				Enum:   schema.Items.Enum,
+			}
+			header.CollectionFormat = "csv"
+		}
+
+		headers[strings.Split(nameTag, ",")[0]] = header
+	}
+
+	return headers, nil
+}
+
+// ParseResponseHeaders parse input struct to swagger header objects
+func ParseResponseHeaders(i interface{}) (headers map[string]HeaderObj, err error) {
+	return gen.ParseResponseHeaders(i)
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 )
 
 type Person struct {
@@ -171,6 +172,161 @@ func TestParseDefinitionArray(t *testing.T) {
 	}
 }
 
+type testConstraints struct {
+	Age      int      `json:"age" minimum:"0" maximum:"150" exclusiveMaximum:"true"`
+	Login    string   `json:"login" minLength:"3" maxLength:"64" pattern:"^[a-z]+$"`
+	Tags     []string `json:"tags" minItems:"1" maxItems:"10" uniqueItems:"true"`
+	Price    float64  `json:"price" multipleOf:"0.01" minimum:"invalid"`
+	Limit    int      `schema:"limit" in:"query" minimum:"1" maximum:"100"`
+	Coupon   string   `schema:"coupon" in:"query" pattern:"^[A-Z0-9]{8}$"`
+	Location string   `header:"Location" maxLength:"2048"`
+}
+
+func TestParseDefinitionConstraints(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.ParseDefinition(testConstraints{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	typeDef, found := g.getDefinition(reflect.TypeOf(testConstraints{}))
+	if !found {
+		t.Fatal("No definition for testConstraints")
+	}
+
+	age := typeDef.Properties["age"]
+	assertTrue(*age.Minimum == 0, t)
+	assertTrue(*age.Maximum == 150, t)
+	assertTrue(age.ExclusiveMaximum, t)
+	assertFalse(age.ExclusiveMinimum, t)
+
+	login := typeDef.Properties["login"]
+	assertTrue(*login.MinLength == 3, t)
+	assertTrue(*login.MaxLength == 64, t)
+	assertTrue(login.Pattern == "^[a-z]+$", t)
+
+	tags := typeDef.Properties["tags"]
+	assertTrue(*tags.MinItems == 1, t)
+	assertTrue(*tags.MaxItems == 10, t)
+	assertTrue(tags.UniqueItems, t)
+
+	price := typeDef.Properties["price"]
+	assertTrue(price.MultipleOf == 0.01, t)
+	assertTrue(price.Minimum == nil, t)
+
+	data, err := json.Marshal(age)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(string(data) == `{"type":"integer","format":"int32","maximum":150,"exclusiveMaximum":true,"minimum":0}`, t)
+
+	_, params, err := g.ParseParameter(testConstraints{})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(len(params) == 2, t)
+	assertTrue(*params[0].Minimum == 1 && *params[0].Maximum == 100, t)
+	assertTrue(params[1].Pattern == "^[A-Z0-9]{8}$", t)
+
+	headers, err := g.ParseResponseHeaders(testConstraints{})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(*headers["Location"].MaxLength == 2048, t)
+}
+
+type testArrayLength struct {
+	Point  [2]float64 `json:"point"`
+	Points [][3]int   `json:"points"`
+	Tags   []string   `json:"tags"`
+}
+
+func TestParseDefinitionArrayLength(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.ParseDefinition(testArrayLength{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	typeDef, _ := g.getDefinition(reflect.TypeOf(testArrayLength{}))
+
+	// length of Go arrays limits number of items
+	point := typeDef.Properties["point"]
+	assertTrue(*point.MinItems == 2 && *point.MaxItems == 2, t)
+
+	points := typeDef.Properties["points"]
+	assertTrue(points.MinItems == nil && points.MaxItems == nil, t)
+	assertTrue(*points.Items.MinItems == 3 && *points.Items.MaxItems == 3, t)
+
+	tags := typeDef.Properties["tags"]
+	assertTrue(tags.MinItems == nil && tags.MaxItems == nil, t)
+
+	data, err := json.Marshal(point)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertTrue(string(data) == `{"type":"array","items":{"type":"number","format":"double"},"maxItems":2,"minItems":2}`, t)
+}
+
+type testRequiredFields struct {
+	ID       int64    `json:"id"`
+	Name     string   `json:"name,omitempty"`
+	Parent   *int64   `json:"parent"`
+	Tags     []string `json:"tags"`
+	Comment  *string  `json:"comment" required:"true"`
+	Internal string   `json:"internal" required:"false"`
+	Email    string   `json:"email" validate:"omitempty,email"`
+}
+
+func TestParseDefinitionRequired(t *testing.T) {
+	required := func(g *Generator) []string {
+		if _, err := g.ParseDefinition(testRequiredFields{}); err != nil {
+			t.Fatalf("%v", err)
+		}
+		typeDef, _ := g.getDefinition(reflect.TypeOf(testRequiredFields{}))
+		return typeDef.Required
+	}
+
+	// only explicit tags are used by default
+	assertTrue(reflect.DeepEqual(required(NewGenerator()), []string{"comment"}), t)
+
+	assertTrue(reflect.DeepEqual(required(NewGenerator().ReflectRequiredFields(true)),
+		[]string{"id", "tags", "comment", "email"}), t)
+
+	// rules of validate tag have priority
+	assertTrue(reflect.DeepEqual(required(NewGenerator().ReflectRequiredFields(true).ReflectValidateTags(true)),
+		[]string{"id", "tags", "comment"}), t)
+}
+
+type testAnnotatedFields struct {
+	ID      int64      `json:"id" description:"Identifier" title:"ID" example:"42"`
+	Email   string     `json:"email" format:"email" example:"user@example.com"`
+	Ratio   *float64   `json:"ratio" example:"0.5"`
+	Tags    []string   `json:"tags" example:"[\"a\",\"b\"]"`
+	Invalid int        `json:"invalid" example:"abc" description:"-"`
+	Created *time.Time `json:"created" description:"Creation time" format:"date"`
+}
+
+func TestParseDefinitionAnnotations(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.ParseDefinition(testAnnotatedFields{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	typeDef, _ := g.getDefinition(reflect.TypeOf(testAnnotatedFields{}))
+
+	id := typeDef.Properties["id"]
+	assertTrue(id.Description == "Identifier" && id.Title == "ID" && id.Example == int64(42), t)
+
+	email := typeDef.Properties["email"]
+	assertTrue(email.Format == "email" && email.Example == "user@example.com", t)
+
+	assertTrue(typeDef.Properties["ratio"].Example == 0.5, t)
+	assertTrue(reflect.DeepEqual(typeDef.Properties["tags"].Example, []string{"a", "b"}), t)
+
+	invalid := typeDef.Properties["invalid"]
+	assertTrue(invalid.Example == nil && invalid.Description == "", t)
+
+	created := typeDef.Properties["created"]
+	assertTrue(created.Description == "Creation time" && created.Format == "date", t)
+}
+
 func TestParseParameter(t *testing.T) {
 	p := &PreferredWarehouseRequest{}
 	name, params, err := ParseParameter(p)
@@ -195,6 +351,45 @@ func TestParseParameterError(t *testing.T) {
 	}
 }
 
+type testListHeaders struct {
+	TotalCount int64    `header:"X-Total-Count" description:"Total number of items"`
+	Location   string   `header:"Location"`
+	RateLimit  int      `header:"X-Rate-Limit" default:"100"`
+	Flag       Flag     `header:"X-Flag"`
+	Tags       []string `header:"X-Tags"`
+	Ignored    string
+}
+
+func TestParseResponseHeaders(t *testing.T) {
+	headers, err := ParseResponseHeaders(&testListHeaders{})
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	if len(headers) != 5 {
+		t.Fatalf("number of headers should be 5, got %d", len(headers))
+	}
+
+	assertTrue(headers["X-Total-Count"].Type == "integer", t)
+	assertTrue(headers["X-Total-Count"].Format == "int64", t)
+	assertTrue(headers["X-Total-Count"].Description == "Total number of items", t)
+	assertTrue(headers["Location"].Type == "string", t)
+	assertTrue(headers["X-Rate-Limit"].Default == int64(100), t)
+	assertTrue(len(headers["X-Flag"].Enum.Enum) == 2, t)
+	assertTrue(headers["X-Tags"].Items.Type == "string", t)
+	assertTrue(headers["X-Tags"].CollectionFormat == "csv", t)
+}
+
+func TestParseResponseHeadersError(t *testing.T) {
+	_, err := ParseResponseHeaders(true)
+	assertTrue(err != nil, t)
+
+	_, err = ParseResponseHeaders(struct {
+		Person Person `header:"X-Person"`
+	}{})
+	assertTrue(err != nil, t)
+}
+
 //
 // test and data for TestSetPathItem
 //
@@ -218,6 +413,102 @@ func TestSetPathItem(t *testing.T) {
 	}
 }
 
+type testErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func TestSetPathItemResponses(t *testing.T) {
+	g := NewGenerator()
+
+	info := PathItemInfo{
+		Path:   "/v1/persons",
+		Method: "POST",
+		Title:  "Create person",
+		Responses: map[int]ResponseInfo{
+			201: {Description: "person created", Body: Person{}, Headers: testListHeaders{}},
+			400: {Body: testErrorResponse{}},
+			409: {Description: "person already exists", Body: testErrorResponse{}},
+		},
+	}
+	if err := g.SetPathItem(info, nil, Person{}, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	info = PathItemInfo{
+		Path:      "/v1/persons/{id}",
+		Method:    "DELETE",
+		Title:     "Delete person",
+		Responses: map[int]ResponseInfo{204: {}, 404: {Body: testErrorResponse{}}},
+	}
+	if err := g.SetPathItem(info, nil, nil, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	info = PathItemInfo{
+		Path:      "/v1/persons/{id}",
+		Method:    "GET",
+		Title:     "Get person",
+		Responses: map[int]ResponseInfo{404: {Body: testErrorResponse{}}},
+	}
+	if err := g.SetPathItem(info, nil, nil, Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	post := g.paths["/v1/persons"].Post.Responses
+	assertTrue(len(post) == 3, t)
+	assertTrue(post["201"].Description == "person created", t)
+	assertTrue(post["201"].Schema.Ref == "#/definitions/Person", t)
+	assertTrue(post["201"].Headers["Location"].Type == "string", t)
+	assertTrue(post["400"].Description == "Bad Request", t)
+	assertTrue(post["400"].Schema.Ref == "#/definitions/testErrorResponse", t)
+	assertTrue(post["409"].Description == "person already exists", t)
+
+	del := g.paths["/v1/persons/{id}"].Delete.Responses
+	assertTrue(len(del) == 2, t)
+	assertTrue(del["204"].Description == "No Content", t)
+	assertTrue(del["204"].Schema == nil, t)
+
+	get := g.paths["/v1/persons/{id}"].Get.Responses
+	assertTrue(len(get) == 2, t)
+	assertTrue(get["200"].Schema.Ref == "#/definitions/Person", t)
+	assertTrue(get["404"].Schema.Ref == "#/definitions/testErrorResponse", t)
+}
+
+func TestSetPathItemResponsesOrder(t *testing.T) {
+	var clientError, serverError interface{}
+	{
+		type Error struct {
+			Code int `json:"code"`
+		}
+		clientError = Error{}
+	}
+	{
+		type Error struct {
+			Message string `json:"message"`
+		}
+		serverError = Error{}
+	}
+
+	// definitions of conflicting types are named in order of status codes
+	for i := 0; i < 10; i++ {
+		g := NewGenerator()
+		info := PathItemInfo{
+			Path:      "/v1/persons",
+			Method:    "GET",
+			Responses: map[int]ResponseInfo{503: {Body: serverError}, 400: {Body: clientError}, 299: {}},
+		}
+		if err := g.SetPathItem(info, nil, nil, nil); err != nil {
+			t.Fatalf("error %v", err)
+		}
+
+		responses := g.paths["/v1/persons"].Get.Responses
+		assertTrue(responses["400"].Schema.Ref == "#/definitions/Error", t)
+		assertTrue(responses["503"].Schema.Ref == "#/definitions/ErrorType2", t)
+		assertTrue(responses["299"].Description == "Response", t)
+	}
+}
+
 func TestResetPaths(t *testing.T) {
 	TestSetPathItem(t)
 
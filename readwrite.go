@@ -0,0 +1,212 @@
+package swgen
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SplitReadWriteDefinitions controls separate definitions of requests and responses in Swagger 2.0 document,
+// that has no writeOnly keyword, when enabled definitions with read only or write only properties are split into
+// definition of response without write only properties and definition of request without read only properties,
+// that is named with Request suffix and is referred by body parameters
+func (g *Generator) SplitReadWriteDefinitions(enabled bool) *Generator {
+	g.mu.Lock()
+	g.splitReadWriteDefinitions = enabled
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// isWriteOnly returns true if property is marked with `writeOnly:"true"` tag
+func isWriteOnly(so SchemaObj) bool {
+	writeOnly, _ := so.data["x-writeOnly"].(bool)
+	return writeOnly
+}
+
+// splitReadWriteDefinitions returns copy of document with separate definitions of requests and responses
+func splitReadWriteDefinitions(doc Document) Document {
+	split := make(map[string]bool)
+	refs := make(map[string][]string, len(doc.Definitions))
+	for name, def := range doc.Definitions {
+		split[name] = hasReadWriteProperties(def)
+		refs[name] = schemaRefs(def, nil)
+	}
+
+	// definitions that refer split definitions are split too
+	for changed := true; changed; {
+		changed = false
+		for name := range doc.Definitions {
+			if split[name] {
+				continue
+			}
+			for _, ref := range refs[name] {
+				if split[ref] {
+					split[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	names := sortedKeys(split)
+	requestNames := make(map[string]string)
+	for _, name := range names {
+		if !split[name] {
+			continue
+		}
+
+		requestName := name + "Request"
+		for i := 2; ; i++ {
+			if _, found := doc.Definitions[requestName]; !found && !containsRequestName(requestNames, requestName) {
+				break
+			}
+			requestName = name + "RequestType" + strconv.Itoa(i)
+		}
+		requestNames[name] = requestName
+	}
+
+	if len(requestNames) == 0 {
+		return doc
+	}
+
+	definitions := make(map[string]SchemaObj, len(doc.Definitions)+len(requestNames))
+	for name, def := range doc.Definitions {
+		if requestName, found := requestNames[name]; found {
+			definitions[name] = stripProperties(def, false)
+			definitions[requestName] = renameRefs(stripProperties(def, true), requestNames)
+			continue
+		}
+		definitions[name] = def
+	}
+	doc.Definitions = definitions
+
+	paths := make(map[string]PathItem, len(doc.Paths))
+	for path, item := range doc.Paths {
+		item.Parameters = renameBodyRefs(item.parameters(), requestNames)
+		item.Params = nil
+		for _, method := range operationMethods {
+			if op := item.getOperation(method); op != nil {
+				result := *op
+				result.additionalData = op.additionalData.clone()
+				result.Parameters = renameBodyRefs(op.Parameters, requestNames)
+				item.setOperation(method, &result)
+			}
+		}
+		paths[path] = item
+	}
+	doc.Paths = paths
+
+	return doc
+}
+
+func containsRequestName(requestNames map[string]string, name string) bool {
+	for _, requestName := range requestNames {
+		if requestName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// renameBodyRefs returns copy of parameters with references of body schemas renamed
+func renameBodyRefs(params []ParamObj, names map[string]string) []ParamObj {
+	if params == nil {
+		return nil
+	}
+
+	result := make([]ParamObj, len(params))
+	for i, param := range params {
+		if param.Schema != nil {
+			schema := renameRefs(*param.Schema, names)
+			param.Schema = &schema
+		}
+		result[i] = param
+	}
+	return result
+}
+
+// hasReadWriteProperties returns true if schema or its inline schemas have read only or write only properties
+func hasReadWriteProperties(so SchemaObj) bool {
+	for _, property := range so.Properties {
+		if property.ReadOnly || isWriteOnly(property) || hasReadWriteProperties(property) {
+			return true
+		}
+	}
+	for _, s := range so.AllOf {
+		if hasReadWriteProperties(s) {
+			return true
+		}
+	}
+	return so.Items != nil && hasReadWriteProperties(*so.Items) ||
+		so.AdditionalProperties != nil && hasReadWriteProperties(*so.AdditionalProperties)
+}
+
+// schemaRefs appends names of definitions referred by schema
+func schemaRefs(so SchemaObj, refs []string) []string {
+	if strings.HasPrefix(so.Ref, refDefinitionPrefix) {
+		refs = append(refs, so.Ref[len(refDefinitionPrefix):])
+	}
+
+	if so.Items != nil {
+		refs = schemaRefs(*so.Items, refs)
+	}
+	if so.AdditionalProperties != nil {
+		refs = schemaRefs(*so.AdditionalProperties, refs)
+	}
+	for _, s := range so.AllOf {
+		refs = schemaRefs(s, refs)
+	}
+	if oneOf, ok := so.data["x-oneOf"].([]SchemaObj); ok {
+		for _, s := range oneOf {
+			refs = schemaRefs(s, refs)
+		}
+	}
+	for _, property := range so.Properties {
+		refs = schemaRefs(property, refs)
+	}
+
+	return refs
+}
+
+// stripProperties returns copy of schema without read only properties for request
+// or without write only properties for response
+func stripProperties(so SchemaObj, request bool) SchemaObj {
+	if so.Items != nil {
+		items := stripProperties(*so.Items, request)
+		so.Items = &items
+	}
+	if so.AdditionalProperties != nil {
+		additionalProperties := stripProperties(*so.AdditionalProperties, request)
+		so.AdditionalProperties = &additionalProperties
+	}
+	if so.AllOf != nil {
+		allOf := make([]SchemaObj, len(so.AllOf))
+		for i, s := range so.AllOf {
+			allOf[i] = stripProperties(s, request)
+		}
+		so.AllOf = allOf
+	}
+
+	if so.Properties != nil {
+		properties := make(map[string]SchemaObj, len(so.Properties))
+		for name, property := range so.Properties {
+			if request && property.ReadOnly || !request && isWriteOnly(property) {
+				continue
+			}
+			properties[name] = stripProperties(property, request)
+		}
+
+		var required []string
+		for _, name := range so.Required {
+			if _, found := properties[name]; found {
+				required = append(required, name)
+			}
+		}
+
+		so.Properties = properties
+		so.Required = required
+	}
+
+	return so
+}
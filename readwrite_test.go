@@ -0,0 +1,131 @@
+package swgen
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testAccount struct {
+	ID        int64     `json:"id" readOnly:"true" required:"true"`
+	CreatedAt time.Time `json:"created_at" readOnly:"true"`
+	Login     string    `json:"login" required:"true"`
+	Password  string    `json:"password,omitempty" writeOnly:"true" required:"true"`
+}
+
+type testTeam struct {
+	Name     string        `json:"name"`
+	Accounts []testAccount `json:"accounts"`
+}
+
+func testReadWriteGenerator() *Generator {
+	g := NewGenerator()
+	g.SetPathItem(PathItemInfo{Path: "/teams", Method: "POST"}, nil, testTeam{}, testTeam{})
+	return g
+}
+
+func TestReadOnlyWriteOnly(t *testing.T) {
+	data, err := testReadWriteGenerator().GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	account := doc.Definitions["testAccount"]
+	assertTrue(account.Properties["id"].ReadOnly, t)
+	assertTrue(account.Properties["created_at"].ReadOnly, t)
+	assertFalse(account.Properties["login"].ReadOnly, t)
+	assertTrue(account.Properties["password"].data["x-writeOnly"] == true, t)
+	// read only properties are not required, required write only properties are required in requests only
+	assertTrue(reflect.DeepEqual(account.Required, []string{"login", "password"}), t)
+
+	_, found := doc.Definitions["testAccountRequest"]
+	assertFalse(found, t)
+
+	data, err = testReadWriteGenerator().GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	var oas3 struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &oas3); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	properties := oas3.Components.Schemas["testAccount"].Properties
+	assertTrue(properties["id"]["readOnly"] == true, t)
+	assertTrue(properties["password"]["writeOnly"] == true && properties["password"]["x-writeOnly"] == nil, t)
+}
+
+func TestSplitReadWriteDefinitions(t *testing.T) {
+	g := testReadWriteGenerator().SplitReadWriteDefinitions(true)
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	op := doc.Paths["/teams"].Post
+	assertTrue(op.Parameters[0].Schema.Ref == "#/definitions/testTeamRequest", t)
+	assertTrue(op.Responses["200"].Schema.Ref == "#/definitions/testTeam", t)
+
+	// definition referring split definition is split too
+	assertTrue(doc.Definitions["testTeam"].Properties["accounts"].Items.Ref == "#/definitions/testAccount", t)
+	assertTrue(doc.Definitions["testTeamRequest"].Properties["accounts"].Items.Ref == "#/definitions/testAccountRequest", t)
+
+	response := doc.Definitions["testAccount"]
+	assertTrue(len(response.Properties) == 3, t)
+	_, found := response.Properties["password"]
+	assertFalse(found, t)
+
+	assertTrue(reflect.DeepEqual(response.Required, []string{"login"}), t)
+
+	request := doc.Definitions["testAccountRequest"]
+	assertTrue(len(request.Properties) == 2, t)
+	_, found = request.Properties["id"]
+	assertFalse(found, t)
+	assertTrue(reflect.DeepEqual(request.Required, []string{"login", "password"}), t)
+
+	for _, e := range g.Validate() {
+		t.Error(e)
+	}
+}
+
+func TestReadWriteRequiredProperties(t *testing.T) {
+	g := testReadWriteGenerator()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// password is not sent in response
+		w.Write([]byte(`{"name":"team","accounts":[{"id":1,"login":"john"}]}`))
+	})
+
+	reporter := &testReporter{}
+	g.CheckResponses(reporter, handler).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/teams", nil))
+	assertTrue(len(reporter.errors) == 0, t)
+
+	validated := g.ValidateRequests(handler)
+	w := httptest.NewRecorder()
+	// id is not sent in request
+	body := `{"name":"team","accounts":[{"login":"john","password":"secret"}]}`
+	validated.ServeHTTP(w, httptest.NewRequest("POST", "/teams", strings.NewReader(body)))
+	assertTrue(w.Code == http.StatusOK, t)
+
+	w = httptest.NewRecorder()
+	validated.ServeHTTP(w, httptest.NewRequest("POST", "/teams", strings.NewReader(`{"accounts":[{"login":"john"}]}`)))
+	assertTrue(w.Code == http.StatusBadRequest, t)
+	assertTrue(strings.Contains(w.Body.String(), `"name":"accounts[0].password","message":"required property is missing"`), t)
+}
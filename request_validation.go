@@ -0,0 +1,437 @@
+package swgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultRequestBodyLimit is a default maximal size of request body read by ValidateRequests
+const defaultRequestBodyLimit = 10 << 20
+
+// SetRequestBodyLimit sets maximal size of request body in bytes that is read by ValidateRequests, 10 MB by default,
+// requests with larger bodies are rejected, zero or negative limit disables the check
+func (g *Generator) SetRequestBodyLimit(limit int64) *Generator {
+	g.mu.Lock()
+	g.requestBodyLimit = limit
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// RequestValidationError is a body of response for request that does not satisfy document
+type RequestValidationError struct {
+	Message    string              `json:"message"`
+	Violations []ContractViolation `json:"violations"`
+}
+
+// ValidateRequests returns http.Handler middleware which checks path, query, header and form parameters
+// and JSON body of incoming requests against operations registered with SetPathItem,
+// requests that do not match specification are rejected with 400 Bad Request and RequestValidationError in body,
+// requests to unknown paths or methods are passed to next handler as is
+func (g *Generator) ValidateRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		violations := g.validateRequest(r)
+		if len(violations) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		data, err := json.Marshal(RequestValidationError{
+			Message:    "request validation failed",
+			Violations: violations,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(data)
+	})
+}
+
+// ValidateRequests returns middleware which checks incoming requests against operations registered with SetPathItem
+func ValidateRequests(next http.Handler) http.Handler {
+	return gen.ValidateRequests(next)
+}
+
+// validateRequest returns list of violations of registered operation for request
+func (g *Generator) validateRequest(r *http.Request) []ContractViolation {
+	index := g.operationIndex()
+	op, pathParams := index.find(r.Method, r.URL.Path)
+	if op == nil {
+		return nil
+	}
+
+	var (
+		violations []ContractViolation
+		query      = r.URL.Query()
+		form       map[string][]string
+	)
+
+	for _, param := range op.Parameters {
+		var values []string
+
+		switch param.In {
+		case "path":
+			if value, ok := pathParams[param.Name]; ok {
+				values = []string{value}
+			}
+		case "query":
+			values = query[param.Name]
+		case "header":
+			values = r.Header[http.CanonicalHeaderKey(param.Name)]
+		case "formData":
+			if param.Type == "file" {
+				continue
+			}
+			if form == nil {
+				form = requestForm(r)
+			}
+			values = form[param.Name]
+		case "body":
+			violations = append(violations, validateRequestBody(r, param, index.definitions, index.bodyLimit)...)
+			continue
+		default:
+			continue
+		}
+
+		if len(values) == 0 {
+			if param.Required {
+				violations = append(violations, ContractViolation{
+					In:      param.In,
+					Name:    param.Name,
+					Message: "required parameter is missing",
+				})
+			}
+			continue
+		}
+
+		value, err := paramValue(param, values)
+		if err != nil {
+			violations = append(violations, ContractViolation{In: param.In, Name: param.Name, Message: err.Error()})
+			continue
+		}
+
+		v := schemaValidator{definitions: index.definitions, in: param.In}
+		v.validate(paramSchema(param), value, param.Name)
+		violations = append(violations, v.violations...)
+	}
+
+	return violations
+}
+
+// lookupOperation returns registered operation for request, values of path parameters and definitions
+func (g *Generator) lookupOperation(r *http.Request) (*OperationObj, map[string]string, map[string]SchemaObj) {
+	index := g.operationIndex()
+
+	op, pathParams := index.find(r.Method, r.URL.Path)
+	if op == nil {
+		return nil, nil, nil
+	}
+	return op, pathParams, index.definitions
+}
+
+// operationIndex is a snapshot of registered operations and definitions, it is used to check requests
+// and responses without locking generator and is built again after generator is changed
+type operationIndex struct {
+	basePath    string
+	routes      operationRoutes
+	definitions map[string]SchemaObj
+	bodyLimit   int64
+}
+
+// operationRoute is a registered operation with precedence of its path template
+type operationRoute struct {
+	path   string
+	method string
+	op     *OperationObj
+	params int // number of path parameters
+	prefix int // length of literal prefix of path before the first parameter
+}
+
+// operationRoutes are ordered by precedence: path templates with less parameters first,
+// then templates with longer literal prefix, then templates in lexical order
+type operationRoutes []operationRoute
+
+func (r operationRoutes) Len() int      { return len(r) }
+func (r operationRoutes) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r operationRoutes) Less(i, j int) bool {
+	switch {
+	case r[i].params != r[j].params:
+		return r[i].params < r[j].params
+	case r[i].prefix != r[j].prefix:
+		return r[i].prefix > r[j].prefix
+	}
+	return r[i].path < r[j].path
+}
+
+// operationIndex returns cached index of operations or builds it
+func (g *Generator) operationIndex() *operationIndex {
+	g.cacheMu.Lock()
+	index := g.operations
+	version := g.cacheVersion
+	g.cacheMu.Unlock()
+
+	if index != nil {
+		return index
+	}
+
+	g.mu.Lock()
+	index = g.buildOperationIndex()
+	g.mu.Unlock()
+
+	g.cacheMu.Lock()
+	// generator could be changed while index was built, such index is not cached
+	if version == g.cacheVersion {
+		g.operations = index
+	}
+	g.cacheMu.Unlock()
+
+	return index
+}
+
+// buildOperationIndex collects registered operations and definitions, it must be called with g.mu locked
+func (g *Generator) buildOperationIndex() *operationIndex {
+	g.parseDefInQueue()
+	index := &operationIndex{
+		basePath:    strings.TrimSuffix(g.doc.BasePath, "/"),
+		definitions: g.definitions.GenDefinitions(),
+		bodyLimit:   g.requestBodyLimit,
+	}
+
+	// operations of JSON-RPC service are not checked
+	if g.isJSONRPC() {
+		return index
+	}
+
+	for path, item := range g.paths {
+		prefix := strings.Index(path, "{")
+		if prefix == -1 {
+			prefix = len(path)
+		}
+
+		for _, method := range operationMethods {
+			if op := item.getOperation(method); op != nil {
+				index.routes = append(index.routes, operationRoute{
+					path:   path,
+					method: method,
+					op:     op,
+					params: strings.Count(path, "{"),
+					prefix: prefix,
+				})
+			}
+		}
+	}
+	sort.Sort(index.routes)
+
+	return index
+}
+
+// find looks up registered operation and values of path parameters for request
+func (index *operationIndex) find(method, requestPath string) (*OperationObj, map[string]string) {
+	if index.basePath != "" {
+		if !strings.HasPrefix(requestPath, index.basePath+"/") {
+			return nil, nil
+		}
+		requestPath = requestPath[len(index.basePath):]
+	}
+
+	method = strings.ToUpper(method)
+	for _, route := range index.routes {
+		if route.method != method {
+			continue
+		}
+		if params, ok := matchPathTemplate(route.path, requestPath); ok {
+			return route.op, params
+		}
+	}
+
+	return nil, nil
+}
+
+// matchPathTemplate checks if path matches template like "/users/{id}/avatar.{ext}"
+// and returns values of template parameters, only one parameter per path segment is supported
+func matchPathTemplate(template, path string) (map[string]string, bool) {
+	templateSegments := strings.Split(strings.Trim(template, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range templateSegments {
+		start := strings.Index(segment, "{")
+		end := strings.LastIndex(segment, "}")
+		if start == -1 || end < start || strings.Count(segment, "{") > 1 {
+			if segment != pathSegments[i] {
+				return nil, false
+			}
+			continue
+		}
+
+		prefix, suffix := segment[:start], segment[end+1:]
+		value := pathSegments[i]
+		if len(value) <= len(prefix)+len(suffix) ||
+			!strings.HasPrefix(value, prefix) || !strings.HasSuffix(value, suffix) {
+			return nil, false
+		}
+		params[segment[start+1:end]] = value[len(prefix) : len(value)-len(suffix)]
+	}
+
+	return params, true
+}
+
+// requestForm returns values of url-encoded or multipart form of request
+func requestForm(r *http.Request) map[string][]string {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(32 << 20); err == nil && r.MultipartForm != nil {
+			return r.MultipartForm.Value
+		}
+		return map[string][]string{}
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return map[string][]string{}
+	}
+	return r.PostForm
+}
+
+// validateRequestBody decodes JSON body of request and validates it against schema of body parameter,
+// body of request is restored to be available for next handler, bodies larger than limit are not read
+func validateRequestBody(r *http.Request, param ParamObj, definitions map[string]SchemaObj, limit int64) []ContractViolation {
+	var data []byte
+	if r.Body != nil {
+		body := io.Reader(r.Body)
+		if limit > 0 {
+			body = io.LimitReader(r.Body, limit+1)
+		}
+
+		var err error
+		data, err = ioutil.ReadAll(body)
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return []ContractViolation{{In: param.In, Message: "failed to read body: " + err.Error()}}
+		}
+		if limit > 0 && int64(len(data)) > limit {
+			return []ContractViolation{{In: param.In, Message: "request body is larger than " + strconv.FormatInt(limit, 10) + " bytes"}}
+		}
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		if param.Required {
+			return []ContractViolation{{In: param.In, Message: "request body is required"}}
+		}
+		return nil
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return []ContractViolation{{In: param.In, Message: "invalid JSON: " + err.Error()}}
+	}
+
+	if param.Schema == nil {
+		return nil
+	}
+
+	v := schemaValidator{definitions: definitions, in: param.In}
+	v.validate(*param.Schema, value, "")
+	return v.violations
+}
+
+// paramSchema returns schema object with type and validation keywords of non-body parameter
+func paramSchema(param ParamObj) SchemaObj {
+	so := SchemaObj{
+		Type:        param.Type,
+		Format:      param.Format,
+		Enum:        param.Enum,
+		Constraints: param.Constraints,
+	}
+	if param.Items != nil {
+		so.Items = paramItemSchema(param.Items)
+	}
+	return so
+}
+
+func paramItemSchema(items *ParamItemObj) *SchemaObj {
+	so := &SchemaObj{Type: items.Type, Format: items.Format, Enum: items.Enum}
+	if items.Items != nil {
+		so.Items = paramItemSchema(items.Items)
+	}
+	return so
+}
+
+// paramValue converts string values of non-body parameter into JSON value
+func paramValue(param ParamObj, values []string) (interface{}, error) {
+	if param.Type != "array" {
+		return scalarParamValue(param.Type, values[0])
+	}
+
+	if param.CollectionFormat != "multi" {
+		values = splitCollection(param.CollectionFormat, values[0])
+	}
+
+	var itemsType string
+	if param.Items != nil {
+		itemsType = param.Items.Type
+	}
+
+	result := make([]interface{}, 0, len(values))
+	for _, s := range values {
+		value, err := scalarParamValue(itemsType, s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+func splitCollection(collectionFormat, value string) []string {
+	if value == "" {
+		return []string{}
+	}
+
+	switch collectionFormat {
+	case "ssv":
+		return strings.Split(value, " ")
+	case "tsv":
+		return strings.Split(value, "\t")
+	case "pipes":
+		return strings.Split(value, "|")
+	}
+	return strings.Split(value, ",")
+}
+
+func scalarParamValue(paramType, s string) (interface{}, error) {
+	switch paramType {
+	case "integer":
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, errors.New("integer expected, " + strconv.Quote(s) + " given")
+		}
+		return json.Number(s), nil
+	case "number":
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, errors.New("number expected, " + strconv.Quote(s) + " given")
+		}
+		return json.Number(s), nil
+	case "boolean":
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, errors.New("boolean expected, " + strconv.Quote(s) + " given")
+		}
+		return b, nil
+	}
+	return s, nil
+}
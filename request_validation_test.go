@@ -0,0 +1,273 @@
+package swgen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testValidationParams struct {
+	ID     int64    `schema:"id" in:"path"`
+	Limit  int      `schema:"limit" in:"query" required:"false" minimum:"1" maximum:"100"`
+	Fields []string `schema:"fields" in:"query" required:"false"`
+	Token  string   `schema:"X-Token" in:"header" minLength:"8"`
+	Flags  []Flag   `schema:"flags" in:"query" required:"false"`
+}
+
+type testValidationBody struct {
+	Name  string   `json:"name" validate:"required,max=10"`
+	Email string   `json:"email" validate:"omitempty,email"`
+	Tags  []string `json:"tags" maxItems:"2"`
+	Score float64  `json:"score" minimum:"0"`
+}
+
+func testValidationGenerator(t *testing.T) *Generator {
+	g := NewGenerator().ReflectValidateTags(true)
+	g.SetBasePath("/api")
+
+	if err := g.SetPathItem(PathItemInfo{Path: "/users/{id}", Method: "PUT"},
+		testValidationParams{}, testValidationBody{}, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	if err := g.SetPathItem(PathItemInfo{Path: "/users/me", Method: "PUT"}, nil, nil, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	return g
+}
+
+func TestValidateRequests(t *testing.T) {
+	g := testValidationGenerator(t)
+
+	var nextBody string
+	handler := g.ValidateRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		nextBody = string(data)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	body := `{"name":"John","tags":["a"],"score":1.5}`
+	r := httptest.NewRequest("PUT", "/api/users/1?limit=10&fields=a,b", strings.NewReader(body))
+	r.Header.Set("X-Token", "12345678")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	assertTrue(w.Code == http.StatusNoContent, t)
+	assertTrue(nextBody == body, t)
+
+	for _, r := range []*http.Request{
+		httptest.NewRequest("PUT", "/api/users/me", strings.NewReader("not validated")),
+		httptest.NewRequest("GET", "/api/users/abc", nil),
+		httptest.NewRequest("PUT", "/users/abc", nil),
+	} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assertTrue(w.Code == http.StatusNoContent, t)
+	}
+}
+
+func TestValidateRequestsViolations(t *testing.T) {
+	g := testValidationGenerator(t)
+
+	handler := g.ValidateRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("next handler should not be called for invalid request")
+	}))
+
+	body := `{"email":"john","tags":["a","b","c"],"score":-1}`
+	r := httptest.NewRequest("PUT", "/api/users/abc?limit=0&flags=Foo&flags=Baz", strings.NewReader(body))
+	r.Header.Set("X-Token", "short")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	assertTrue(w.Code == http.StatusBadRequest, t)
+	assertTrue(w.Header().Get("Content-Type") == "application/json", t)
+
+	var resp RequestValidationError
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	expected := []ContractViolation{
+		{In: "path", Name: "id", Message: `integer expected, "abc" given`},
+		{In: "query", Name: "limit", Message: "value should be greater than or equal to 1"},
+		{In: "header", Name: "X-Token", Message: "length should be at least 8"},
+		{In: "query", Name: "flags[1]", Message: `value is not one of ["Foo","Bar"]`},
+		{In: "body", Name: "name", Message: "required property is missing"},
+		{In: "body", Name: "score", Message: "value should be greater than or equal to 0"},
+		{In: "body", Name: "tags", Message: "at most 2 items expected, 3 given"},
+	}
+	if !reflect.DeepEqual(resp.Violations, expected) {
+		t.Fatalf("unexpected violations: %#v", resp.Violations)
+	}
+
+	r = httptest.NewRequest("PUT", "/api/users/1", strings.NewReader(`{"name":`))
+	r.Header.Set("X-Token", "12345678")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	assertTrue(w.Code == http.StatusBadRequest, t)
+	assertTrue(strings.Contains(w.Body.String(), "invalid JSON"), t)
+}
+
+func TestMatchPathTemplate(t *testing.T) {
+	params, ok := matchPathTemplate("/files/{name}.{ext}", "/files/report.pdf")
+	assertFalse(ok, t)
+
+	params, ok = matchPathTemplate("/files/{id}/avatar.{ext}", "/files/42/avatar.png")
+	assertTrue(ok, t)
+	assertTrue(reflect.DeepEqual(params, map[string]string{"id": "42", "ext": "png"}), t)
+
+	_, ok = matchPathTemplate("/files/{id}", "/files/42/avatar")
+	assertFalse(ok, t)
+
+	_, ok = matchPathTemplate("/files/{id}", "/files/")
+	assertFalse(ok, t)
+}
+
+func TestLookupOperation(t *testing.T) {
+	g := NewGenerator()
+	for _, path := range []string{"/{dir}/index", "/files/{name}", "/{dir}/{name}"} {
+		if err := g.SetPathItem(PathItemInfo{Path: path, Method: "GET", Title: path}, nil, nil, nil); err != nil {
+			t.Fatalf("error %v", err)
+		}
+	}
+
+	// path template with longer literal prefix takes precedence over template with same number of parameters
+	for i := 0; i < 10; i++ {
+		op, params, _ := g.lookupOperation(httptest.NewRequest("GET", "/files/index", nil))
+		assertTrue(op != nil && op.Summary == "/files/{name}", t)
+		assertTrue(reflect.DeepEqual(params, map[string]string{"name": "index"}), t)
+	}
+
+	op, params, _ := g.lookupOperation(httptest.NewRequest("GET", "/docs/readme", nil))
+	assertTrue(op != nil && op.Summary == "/{dir}/{name}", t)
+	assertTrue(reflect.DeepEqual(params, map[string]string{"dir": "docs", "name": "readme"}), t)
+
+	op, _, _ = g.lookupOperation(httptest.NewRequest("POST", "/files/index", nil))
+	assertTrue(op == nil, t)
+
+	// index of operations is cached until generator is changed
+	index := g.operationIndex()
+	assertTrue(g.operationIndex() == index, t)
+
+	if err := g.SetPathItem(PathItemInfo{Path: "/files/index", Method: "GET", Title: "index"}, nil, nil, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	assertTrue(g.operationIndex() != index, t)
+
+	op, params, _ = g.lookupOperation(httptest.NewRequest("GET", "/files/index", nil))
+	assertTrue(op != nil && op.Summary == "index", t)
+	assertTrue(len(params) == 0, t)
+}
+
+type testValidationNullable struct {
+	Name    string     `json:"name"`
+	Parent  *Circle    `json:"parent"`
+	Comment *string    `json:"comment"`
+	Shape   Shape      `json:"shape"`
+	Content []Content  `json:"content"`
+	Created *time.Time `json:"created" nullable:"false"`
+}
+
+func TestValidateRequestsNullsAndUnions(t *testing.T) {
+	g := NewGenerator().ReflectNullableFields(true)
+	g.RegisterImplementations((*Shape)(nil), Circle{}, Square{})
+	g.RegisterImplementations((*Content)(nil), Circle{}, "")
+	if err := g.SetPathItem(PathItemInfo{Path: "/drawings", Method: "POST"}, nil, testValidationNullable{}, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	handler := g.ValidateRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	violations := func(body string) []ContractViolation {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/drawings", strings.NewReader(body)))
+		if w.Code == http.StatusNoContent {
+			return nil
+		}
+
+		var resp RequestValidationError
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("error %v", err)
+		}
+		return resp.Violations
+	}
+
+	assertTrue(violations(`{"name":"a","parent":null,"comment":null,"shape":{"kind":"Square","side":1},`+
+		`"content":["text",{"kind":"c","radius":1}]}`) == nil, t)
+	assertTrue(violations(`{"name":"a","parent":{"kind":"c","radius":1},"shape":{"kind":"Circle","radius":1}}`) == nil, t)
+
+	expected := []ContractViolation{
+		{In: "body", Name: "content", Message: "array expected, null given"},
+		{In: "body", Name: "created", Message: "string expected, null given"},
+		{In: "body", Name: "name", Message: "string expected, null given"},
+		{In: "body", Name: "shape", Message: "object expected, null given"},
+	}
+	if v := violations(`{"name":null,"shape":null,"content":null,"created":null}`); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected violations: %#v", v)
+	}
+
+	expected = []ContractViolation{
+		{In: "body", Name: "content[0]", Message: "value does not match any schema of oneOf"},
+		{In: "body", Name: "shape.side", Message: "number expected, string given"},
+	}
+	if v := violations(`{"shape":{"kind":"Square","side":"1"},"content":[true]}`); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected violations: %#v", v)
+	}
+
+	expected = []ContractViolation{
+		{In: "body", Name: "shape.kind", Message: `value is not one of ["Circle","Square"]`},
+	}
+	if v := violations(`{"shape":{"kind":"Triangle","side":1}}`); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected violations: %#v", v)
+	}
+
+	assertTrue(len(violations(`null`)) == 1, t)
+}
+
+func TestValidateRequestsPointerFields(t *testing.T) {
+	type body struct {
+		Name    string  `json:"name"`
+		Comment *string `json:"comment"`
+		Parent  *Circle `json:"parent"`
+	}
+
+	for _, nullable := range []bool{false, true} {
+		g := NewGenerator().ReflectNullableFields(nullable)
+		if err := g.SetPathItem(PathItemInfo{Path: "/items", Method: "POST"}, nil, body{}, nil); err != nil {
+			t.Fatalf("error %v", err)
+		}
+
+		// null is accepted for pointer fields as encoding/json does regardless of reflection of nullable fields
+		violations := g.validateRequest(httptest.NewRequest("POST", "/items",
+			strings.NewReader(`{"name":"a","comment":null,"parent":null}`)))
+		assertTrue(len(violations) == 0, t)
+
+		violations = g.validateRequest(httptest.NewRequest("POST", "/items", strings.NewReader(`{"name":null}`)))
+		assertTrue(len(violations) == 1 && violations[0].Message == "string expected, null given", t)
+	}
+}
+
+func TestValidateRequestsBodyLimit(t *testing.T) {
+	g := NewGenerator().SetRequestBodyLimit(16)
+	if err := g.SetPathItem(PathItemInfo{Path: "/items", Method: "POST"}, nil, testValidationBody{}, nil); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	violations := g.validateRequest(httptest.NewRequest("POST", "/items", strings.NewReader(`{"name":"John"}`)))
+	assertTrue(len(violations) == 0, t)
+
+	violations = g.validateRequest(httptest.NewRequest("POST", "/items", strings.NewReader(`{"name":"John Smith"}`)))
+	assertTrue(len(violations) == 1 && violations[0].Message == "request body is larger than 16 bytes", t)
+
+	g.SetRequestBodyLimit(0)
+	violations = g.validateRequest(httptest.NewRequest("POST", "/items", strings.NewReader(`{"name":"John Smith"}`)))
+	assertTrue(len(violations) == 0, t)
+}
@@ -0,0 +1,136 @@
+package swgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// ContractReporter receives responses that do not match document, testing.TB implements it
+type ContractReporter interface {
+	Errorf(format string, args ...interface{})
+}
+
+// CheckResponses returns http.Handler middleware which captures responses of next handler and checks
+// status code, headers and JSON body against responses of operations registered with SetPathItem,
+// violations are reported with reporter, response is passed to client unchanged
+//
+// It is intended for integration tests, e.g.
+//
+//	server := httptest.NewServer(gen.CheckResponses(t, handler))
+func (g *Generator) CheckResponses(reporter ContractReporter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capture := &responseCapture{ResponseWriter: w}
+		next.ServeHTTP(capture, r)
+
+		if capture.status == 0 {
+			capture.status = http.StatusOK
+		}
+
+		for _, violation := range g.checkResponse(r, capture.status, w.Header(), capture.body.Bytes()) {
+			reporter.Errorf("%s %s: %s", r.Method, r.URL.Path, violation.Error())
+		}
+	})
+}
+
+// CheckResponses returns middleware which checks responses against operations registered with SetPathItem
+func CheckResponses(reporter ContractReporter, next http.Handler) http.Handler {
+	return gen.CheckResponses(reporter, next)
+}
+
+// responseCapture passes response to underlying writer keeping status code and body
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *responseCapture) Write(data []byte) (int, error) {
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+	c.body.Write(data)
+	return c.ResponseWriter.Write(data)
+}
+
+// checkResponse returns list of violations of registered operation for response
+func (g *Generator) checkResponse(r *http.Request, status int, header http.Header, body []byte) []ContractViolation {
+	op, _, definitions := g.lookupOperation(r)
+	if op == nil {
+		return []ContractViolation{{In: "path", Message: "operation is not documented"}}
+	}
+
+	resp, found := op.Responses[strconv.Itoa(status)]
+	if !found {
+		resp, found = op.Responses["default"]
+	}
+	if !found {
+		return []ContractViolation{{In: "status", Message: "status code " + strconv.Itoa(status) + " is not documented"}}
+	}
+
+	var violations []ContractViolation
+
+	names := make([]string, 0, len(resp.Headers))
+	for name := range resp.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := header[http.CanonicalHeaderKey(name)]
+		if len(values) == 0 {
+			continue
+		}
+
+		h := resp.Headers[name]
+		param := ParamObj{
+			Name:             name,
+			In:               "header",
+			Type:             h.Type,
+			Format:           h.Format,
+			Items:            h.Items,
+			CollectionFormat: h.CollectionFormat,
+			Enum:             h.Enum,
+			Constraints:      h.Constraints,
+		}
+
+		value, err := paramValue(param, values)
+		if err != nil {
+			violations = append(violations, ContractViolation{In: param.In, Name: name, Message: err.Error()})
+			continue
+		}
+
+		v := schemaValidator{definitions: definitions, in: param.In, response: true}
+		v.validate(paramSchema(param), value, name)
+		violations = append(violations, v.violations...)
+	}
+
+	// responses without body are documented with null schema
+	if resp.Schema == nil || resp.Schema.Type == "null" || r.Method == "HEAD" {
+		return violations
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return append(violations, ContractViolation{In: "body", Message: "response body is empty"})
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return append(violations, ContractViolation{In: "body", Message: "invalid JSON: " + err.Error()})
+	}
+
+	v := schemaValidator{definitions: definitions, in: "body", response: true}
+	v.validate(*resp.Schema, value, "")
+	return append(violations, v.violations...)
+}
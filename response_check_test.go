@@ -0,0 +1,90 @@
+package swgen
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type testReporter struct {
+	errors []string
+}
+
+func (r *testReporter) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestCheckResponses(t *testing.T) {
+	g := NewGenerator().ReflectValidateTags(true)
+
+	info := PathItemInfo{
+		Path:   "/users/{id}",
+		Method: "GET",
+		Responses: map[int]ResponseInfo{
+			http.StatusNotFound: {Description: "not found"},
+			http.StatusPartialContent: {
+				Body:    testValidationBody{},
+				Headers: testListHeaders{},
+			},
+		},
+	}
+	if err := g.SetPathItem(info, nil, nil, testValidationBody{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	var (
+		status  int
+		headers map[string]string
+		body    string
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	})
+
+	for _, tc := range []struct {
+		method  string
+		path    string
+		status  int
+		headers map[string]string
+		body    string
+		errors  []string
+	}{
+		{"GET", "/users/1", http.StatusOK, nil, `{"name":"John","tags":[]}`, nil},
+		{"GET", "/users/1", http.StatusNotFound, nil, ``, nil},
+		{"GET", "/users/1", http.StatusOK, nil, `{"tags":"a","score":"1"}`, []string{
+			"GET /users/1: body name: required property is missing",
+			"GET /users/1: body score: number expected, string given",
+			"GET /users/1: body tags: array expected, string given",
+		}},
+		{"GET", "/users/1", http.StatusPartialContent, map[string]string{"X-Total-Count": "many"}, `{"name":"John"}`, []string{
+			`GET /users/1: header X-Total-Count: integer expected, "many" given`,
+		}},
+		{"GET", "/users/1", http.StatusInternalServerError, nil, `{}`, []string{
+			"GET /users/1: status: status code 500 is not documented",
+		}},
+		{"GET", "/users/1", http.StatusOK, nil, `{"name":`, []string{
+			"GET /users/1: body: invalid JSON: unexpected EOF",
+		}},
+		{"POST", "/users", http.StatusOK, nil, `{}`, []string{
+			"POST /users: path: operation is not documented",
+		}},
+	} {
+		status, headers, body = tc.status, tc.headers, tc.body
+
+		reporter := &testReporter{}
+		w := httptest.NewRecorder()
+		g.CheckResponses(reporter, handler).ServeHTTP(w, httptest.NewRequest(tc.method, tc.path, nil))
+
+		assertTrue(w.Code == tc.status, t)
+		assertTrue(w.Body.String() == tc.body, t)
+		if !reflect.DeepEqual(reporter.errors, tc.errors) {
+			t.Errorf("unexpected errors for %d %s: %#v", tc.status, tc.body, reporter.errors)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package swaggerui
+
+// Version is the version of embedded swagger-ui
+const Version = "4.15.5"
+
+var assets = map[string]*asset{
+	"favicon-16x16.png": {
+		contentType: "image/png",
+		gzipped:     "H4sIAAAAAAAC/wCZAmb9iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAABNVBMVEVisTRhsTReqzVbpTVXoDdVnTdSlzhRljgvXkAuXUAtWkErV0EzZj40Zj85bz0lTkMkTUMkT0MmTUIkS0IjTEIhSUMkS0IkTEIkTUIlTUIkTkMlTkMcQUQcP0UfQ0QdQ0QfREQgRUMiSUMiSUMjSkInU0EkTEMmUEEiR0IiSEMpVkErWT8kTUElTUIUNkYVNEQVMkcRM0QSNUYQMUIMMUkVK0AAJEkAM00AMzMAAAAAAACF6i2E6SyD6CyC5i2B5Sx/4i6A4S593S583S520jB00DByyjFxyTFwyDFvxjJtxTFtxDFswzJrwDJqvzJpvjNouzNoujNnuDNLjTlKijpKiTpEfztDfzxAeT0+dz05bj44bT44bj82aj81aD8zZT8bPUUbPkUcP0UcPUUeQ0UfREQgRkRgJREvAAAAO3RSTlP09PX19vX39u7u7/Dq6ufh4eDg4+Pf3Nvb2tnY2NvPv7y6rKupqaGZlpSOiYWETDEkHh0fFQwHCgUBAAcHrskAAADYSURBVHjaPc/ZLkNRGIbhz26KjVJpqSKGtjHPc9a7W7OEEhtBjDWUO3XghqQSwVrNTp+j///OXhlrLpdJdg9MLblbxqwPd5RLUDpOjK66YWMwTqRpaM0OhZbo3dskljea9+HyAevxHtoWVAjhfQtr5w3CSfUE8BrgvEDQpxRc3eyfH5wenlQuIO39Sb9x/8uv+bXvmPSjbABPRZznIkGvxkOo7mJtV+FsQsutcFvBuruG9kWZMY+G5pzxlMp/KPKZSUs2cLrzyMWVEyP1OGtlNpvs6p+p5/8DzUo5hMDku9EAAAAASUVORK5CYIIDAFyhqVOZAgAA",
+	},
+	"favicon-32x32.png": {
+		contentType: "image/png",
+		gzipped:     "H4sIAAAAAAAC/wB0Aov9iVBORw0KGgoAAAANSUhEUgAAACAAAAAgCAMAAABEpIrGAAAAkFBMVEUAAAAQM0QWNUYWNkYXNkYALjoWNUYYOEUXN0YaPEUPMUAUM0QVNUYWNkYWNUYWNUUWNUYVNEYWNkYWNUYWM0eF6i0XNkchR0OB5SwzZj9wyTEvXkA3az5apTZ+4C5DgDt31C9frjU5bz5uxTI/eDxzzjAmT0IsWUEeQkVltzR62S6D6CxIhzpKijpJiDpOkDl4b43lAAAAFXRSTlMAFc304QeZ/vj+ECB3xKlGilPXvS2Ka/h0AAABfklEQVR42oVT2XaCMBAdJRAi7pYJa2QHxbb//3ctSSAUPfa+THLmzj4DBvZpvyauS9b7kw3PWDkWsrD6fFQhQ9dZLfVbC5M88CWCPERr+8fLZodJ5M8QJbjbGL1H2M1fIGfEm+wJN+bGCSc6EXtNS/8FSrq2VX6YDv++XLpJ8SgDWMnwqznGo6alcTbIxB2CHKn8VFikk2mMV2lEnV+CJd9+jJlxXmMr5dW14YCqwgbFpO8FNvJxwwM4TPWPo5QalEsRMAcusXpi58/QUEWPL0AK1ThM5oQCUyXPoPINkdd922VBw4XgTV9zDGWWFrgjIQs4vwvOg6xr+6gbCTqE+DYhlMGX0CF2OknK5gQ2JrkDh/W6TOEbYDeVecKbJtyNXiCfGmW7V93J2hDus1bDfhxWbIZVYDXITA7Lo6E0Ktgg9eB4KWuR44aj7ppBVPazhQH7/M/KgWe9X1qAg8XypT6nxIMJH+T94QCsLvj29IYwZxyO9/F8vCbO9tX5/wDGjEZ7vrgFZwAAAABJRU5ErkJgggMAoNOfQ3QCAAA=",
+	},
+	"oauth2-redirect.html": {
+		contentType: "text/html; charset=utf-8",
+		gzipped:     "H4sIAAAAAAAC/8xWT3PyNhO/8yk2OsRm8Gvm7dHBYZ5Jc0inaWaelF4ymYyQFluNkYwkw1Dgu3dkG2yD09KZHqpDiKTdn/bPb9c7ueGK2W2OkNpldj+YuB/IqExigvJ/s1fizpDy+wEAwMQKm+H964YmCWqYPUXw8q2w6Q/wHbnQyOxkXIkMJuNKbTJXfHs/mBimRW4rGK8wCMZqwax3V54sCsmsUBJ0IcEfwq48dWtNNShavhHDRkiuNqHKUaIOTWXG7On4+Espd9fRNSjtq6UWIa5xQuO2XSldI8x01si1DrvSwvxGM8EDWOUBUK3vBqdrsQB/zBTHvVWfKPeotdLj0KKxfm19phh1voYpNemw7atbq7zxsyMZmmLuYiYT///DUGOeUYa+N/UC8G69YWPhATAz2Ad7wjNINesitvQbb6jWEMMqD02eCeuTW9KSo1qHC6UfKUv9U/78dSCCD6r1EHbgft/EO8TgEQ9GsG7Mjp3ZJCLeEEbu9u7QQi6NXeUwhZ9eX34Jc6oN+t7OQbhHf1dC+qXewQs6Xnao5H/iNoA1zQo8D/JxabSFlvCJW4jjGAiBaaUAEXB0aZx9f3pQy1xJlNavsO4uoA6nkyFEsDu0+VBRpY5ixcM4blh5Rh1/0KDWLHR/Q8NSXNIwQeuTRaY2ZFgZTBlDYx4URwL7/T9VLmyqtPij5MS/gfHhIkZawbi9hZs2irs/z4Vz+6YOU1+ean3U+mHu96fRSTzxqGOwpEsMeqWNKjTDqLKd9MtkuMYsArKhWgqZfCG1RGNo4qC+tcMAS7qFOUIhDV1gADk1BjlU2d9QAyylMkEOQoJBvUYdwq8pXshJz9YMRQ4LrZalo0cVcknDM2oeBp2tC/Qq782BWxwztPhFj+yjhAOqeO3++1KW0SybU/bp79y+k6Sg3Xej9ubClb6eViXKVoiPrtE+m+TSjtrvshN/1Qg6EBADeSOjo86IvEdAYNSr6NYJ/YNj9ZlzJJhC3/EISAgEIiBSQVO9oJGhWB/zbFM8MYMMr3q60AKmQJ6VRhByoSIgo85lBIT09q7/VsWVBv9dvXXTtd8DeetW4IKKDPl7BFdG+Zpi+ksuXkP2ciKIyqGh7ndRM0pcUwmNDfWAwDJl0K8l6kw6unPFiiVKG2qkfFuNPzdxDF6mKBcy8dp1oAt5gjh37QREOX9co7Q/C2Pd8OV7P748Pyhp3ZmiHLkXtD6953XWeqMd3MNgMj4OhpNxPSmOq1H0zwEAdt+6vpsKAAA=",
+	},
+	"swagger-ui-bundle.js": {
+		contentType: "application/javascript",
+		gzipped:     "H4sIAAAAAAAC/9T9a3MkyXUgiH6/vyIRbGVHEI5EPgAkMtHRUOKlLqq7q6ZQxRYJgCjPCM/MKERGZHl4IJEFzzWRFCXqNbOzMxrNSJodaadFUhyRaurJh0SZ7f2+1rxmY9M990vtbXZTvDvX7P6EtXPc45UPFKqb0uy2dQXS38fdjx8/5/jx4+ufXikdhbzkew4LIlbygl7Ih1R4YVAa+YxGrBQxVorGtN9nfC321rpx4Pqs8jiqvH5n//DN48OKuBKlT6//P1Z6ceBAQZMRYV0bYfcxc4Rh22IyYmGvxK5GIRdRuTyXMgzd2Ge76k9F57OFabWNpM4ss8t6XsDKZfW3QofurvppnpwRYbWXtbur/1aOVV8e3tnDnmA7bFHs1BQDLyJm2i/rmjMR88A0LfvV60vKS8y+rjVbW8029tl+9dqIYcQE9xxh7IjKm/Zl6Lml6g5k5vb6F8yTL5yOzz5tmY/pJY0c7o2EdKmg8rKrQta6NySBvV7+lHk6XrXML5yO5Y61u94nob1+chpXq9XqGvypHcG3eYSB1tFpXFcp9Wr14DQ+Ojw6Olvve0NCVavts1Wrvd4fEs8+MSoGMdaNMwQwmzULuyRIZJvCZlIaBhEVzkY+dZgZ5MYB51cNRelYcC/oV3o8HO4PKN8PXWYKa2pZVlo0JIZhVQT3hqa14/XMlchShQ3aDWPR7vo0uDAgJQ+Lrt+reIHLru72THZSPbNeXatNzcjSFZQiHFjfjipDKpyBSVUDfjE9tv2T6tmOjuMVwSJhxtZuof12NJ2SzUaztYmTSfjcdPKKawpy/fk2TP+9qYV1BzY3641atWaR0OaVwAwsQm1ubtVq9U2LeBhHLRLZ3KzVtrfrFvExLrJIbHOz1dyqblnEwbjYIiMo26y3NizSs7m50Wi0GtZOMjClQW5oBvaC5XE8GXZDv1w2Ivwxm1DxBONUhHx3wVjrnGzaXpDIyuUbmmMVJwwiwWNHhNy27TR+JfldGfFQhFBsN4GtnTZIoF/TtJeuQjGYShNoUiRo4EBOYVliwMNxKWDj0oPJiB1yHnLT2KdBEIqSQ32/REuOT6OoRKMSLaUQ52ofqtp7ITfVsqzu8FdExWdBXwx2+OqqWgiBLU742U5QYUE8ZJx2fWbnA1Ku1EgA/e55/Vilr1SJcUn9mBleUArKZTOojLkndJpF7iJtqiiCdY+HI8bFxGQkqFywCQmsaQZlX2NhOjklLyix3WU1CHKNDbc5yUBsr1RJHj4IJ/C0V6pTq81OxJnNCcvavVSjo0ZGt3bBJpHJcG3pmD4Td8dB0r6a4SgZt5vyQDUCBsYOKj3PF4yb2bLPyMrCKg6YopEhRxhzcwH0hvDKKI4GFToa+ROTw2gmKz7r3cRk2cwLu7YjXqG8Hw9ZIKIEA0SCAdwOYt9fsdMcJ+JsNx9oX093xM/Vdy9NTlaqVqUX8kPqDIo90hN5Is6ALLaf07No4Qx7LDIZeV5Rk1tW+9LkSwBZjjrPG21OhAXAJwOaQ5dujkR0k5mPmLiXrPa7vd2s+lzsQhpTOT9HMnF+LuXCUkAmSLdALMaF/Wi8GAYpF+5euQZtQdiUqMqyyg/z2VeElAl7sZJQQJGni1lsgb56PVPxAbZtsxwFu896jLPAScgY8BulAY2Cl0Wpy1hQ8gJPeNT3IuaW1kpRPGLctAo5gOQx17B20qkxmdUWU8DfK/t6mm0d5zMEZcH4A7rbNlaxy9tLMqzYNsszcTbQ7oWbA4PJW5IyoBE2g79MAT/6TJjCavP2gEY5PKxAF3EadoFatXOr+TjpUrKiA7u6E6zYdkrNLa9nmszGvp8Eq6tn5MqybNu+SjgEvjOP052UF7JnicOrtXJZTeWKnaMLtTxdqAFdIHy+bH1h2Xq+bB3LBvaFCf0iIfBheSI8MaFqcj2FFZmAHlbYJeMTM8gh7kUBz9Op5IiMRoRcWzYfXA+HWSW9ihdZJmx9hJ3wMyT6Hc7ppOJF+BeozEzuYwUtTAe3rJ2FW/Sd4JL6nlu6YJM28OTMEcwtYY2lkJcURO2SscqtKWLvfoE1TTsmUja85CoGXVjkUP3qmsLS9B/D6bhaFlbJSUDCnTn817zGomVcLmuUF4sZj2NYktAdzqIImYs4EiXmiQHjpS7DBQPdy3MiOyzjhRJq5XBGBTNFuSyyRHKdY6raen9n+R18dnefTi0iymVNxUxBmEW4LUhgn1zDwBvRIIx9dz8cjsKABeLhyKWCGURVPScKfHz014O80sGJqMTYzl1c0xHBqBH+ZMQopBmWlLOFjgUVTJeK4DcRxCgmGtZ0ekaCcnlo8tz4BRYJMY6EFhFTc1RJe27t4NiW7tr7yMc/Rj6+1aha5A7y4o8t8iYw4JtbraZFHmDcm9aO4i3vldiVYIEble7m58i0rhWNrlQqGe4Rz7QUPhp9Jt4IXea/SYfMICazX12rrdi2b1omsxJCZ3xqHfdoD+YiWjeANiay1PoXKp/+1Gk+/XR9HeSr9uKKnKS/0XrkDNiQLq4vy3aa5EvqVdNtzfTiPusdYz7Vi2ufietoxJxj5jMYiagtpnY2z8maE5WeF7gHKfQm02xF4MLOhtX0mUhnqc0IBgHJo7YgxSYComBtU8LZk9jjzG17JKBD1o6IF91nvbaPRe5RMWjHxPWikU8nMPhth3iB48cuu8+oezfwJ+1eEvMW9wCz/El7UOiDQhjXZqahVi1OpWGRIUQhJUti+hBzj3tDT3iXTMfu+EyULu1k5yQTm5bLFLc946WXOOsZWjwulyflshmptvMoY04si6xQlU7T9HQyQDImKzTZ20aarBz6DDDRNKIRDQxyjTiMo2AMoeoSfteEJ3xmTMlti6kC5+eCXQljShwpI2u+sDfsG+Q64k6bm/XNWtMiA+b1B6Jt1KujK4OMPVcMdGBqJcuyawcVL7rbOW6YVjpILhtx5lCB/E409kDi9+2UHvm7fntlZUIuc+MKZABoyiW5tK4dGrFk9NtLxsgloWmZ+b7qAtM82brOYVURQROE1FiYgdzupih5K8yDoQB4KeDVUnCHc+Cq/EVobw9ibh3dFkqX9Wjsi2UQ9hWECwBatsZvBx/QjekUiNI9YkC1sCtHgGqqvGNa5h3Tsiow6qoQKTT7wLQqsOPNZVCALEoukh/IoZAjnwehhiTF0RSITi5a4QJEdMPQz/qVxrCrEQVSORIDjAziYZdx4s5GpJh4x7QqvhcVgJmdw7T2ualMUqbWlGxu1Ru3UoL1ckowrfAqKMHq2/V6fUYJppVbvs3N7Y3NrQ1Ug1UC00clmNmq1jfqSg1Wb25WN5Idt5fuuFG2iRf2XmTj1PYLPxHt0v0q23Iect8gSoM7v2mxRZtWwMZmbFqWySox902gcZ+v+KGDWnKrIkKlAzVhK9tJdzCFRXxqM9TMeC4VIX/I/XYwtblp7WTsjH0dc7+dEPMCpKZFCmVTKTLYNQZCjKL2+nqaoaK19BUvzCKNdjCdPnzzuHN0eJ7u8m95vn+fOcy7RD0AKGWuZwAX84DzqS1SwJlAzsv8GLDz28POp4uYAwVhYa4URUaoTWB5zSpxKm8OLTMb5koemkR2mjstEOUyMjqW5nh3gYNv52qJuV8uY/WDt5ZXvzhLzH1rN3r+9trzQyrWOGyTxpTMFaAGuRaU95loG+dKe00489tGEIYjFjBeCkLOeoxzxg0y4KzXfvTSNZ+uu6wb93dj7tsvXbPACV328P6ddDXNQjp9NN/2QG3lWN0LVESoL9rG3cD3AlZKB6rUpW6fwa5vtWGYp1O11gfPX+vpSrdIfiH5IXWZ216pEcZ5yNsrtek0xfoDz30jjAMQIBWTwWyQ5u4MaZ/tsEoYQGkbKcMMjifVVoE6Qk6sfWFW3a7OGXEnh6QQvNVa9Homll2xZ0onkIsc5OLWkItbQy4QcoRhmi3AhInPZhfL7EaLeT6cc5STjamVX0IKqGXFAL+KvUbsyUVRX0wTjJmS7a1qrXWr7WrISIQ/+iy/cakNKYQNqdWqN3OnLTTVDSgNSCpZJodSVqpH1BGmsHZyBwsksFl6sPBKgIcLMLkn/MwGncKMEmqtlilwvNlTiqSitdoOfxXOLNbWrBWgpwLrs+AQJhrBQa7JSS2nCkKUyrQXWnPxMBhQOOl0S8BmlnohLyktQOllY5WtGi8bSmfi27nzz7ROhjqCVKdZLpvMvp7qxShoH+iYbRgqTIXgkZ2wgF4QMP7agzdeT9PHA0+waEQddp/12ZW9fhqtrherYskvdSyZ1crUXynnq2f6txj6UrIsAapINdm5OY2YeKCbW3Q4VgAH25qSfPH+guILS2P7ZKbljhDcXqijTjZXyBGZ1gk7s8Xi5mfquKGGhc1Hi8pq/RSNIq8fmDM1EU1+F0ISLRsGPWFmATesOZD2YS9Y2h0Ns2nglmEQNlMBdd35CtKDH1CK6a5gLtMigb0IF4Es7HJcV8IMrPbJGfFsloZ3hO1VooHXA8bIWqvZtk3NkAirXA7xNAroweJZqCDgdlh5HHqBaZSMRUPJ2TC8ZP8UHQk1dSmXzVyfrB2lCYx0t3ZQyxRB7zSpiYDUfIIe9ucm2rq+qbr5xTOg0TJUQWih5dWZQVoFaFKaDRkYRs0h4Z2UmixDxBy9WdC9tAox9G/C5bQdcxaR+wuBWALD/Oj0FwIwN8ZZ+zPNi7ATOIOQKxknX4Oyf0kq0KTPtIhQcd3Y812cumMB8o5q8MR4xSAM8uzitLQNgxivGqQIhxiC9GC8sg55jVeNM41CsxNUaCMPG6jRMvKSmJsYO0WYEyom7JOzbNfGc3aLVYqnUCa3UDcPa5qvvmwbL6/Crrv6svFytsIzTJ8SNjUtbX3ywhtowMZveYEbju2VmooRPA4cZHH1NpdKC+kumhVi2W80UijWwNKfUs7XxrLfy/ZJHPVFyAzchW9e622uDXIKjr/i3RSzp8acWSTdm1POzmFRpJDtAbsSptpIsjCcQBenP1fjvBmTfY2CT66W1zjrAXprkqlK70eKeoBdgu4GBxqoiRefnRFMU8KXnQpfosKZby8Uv6zi6JfLhWBKdBfFvjI3AjkaXUHlqz3XPTjnWDBISTcXjFMRAUADXWA7T8QZcrFAPHUlx3Gv510xWDqhXSXUDhIeN3yF7oSrqxbXm9+qsWasBifhWTa4uTWSLMsiwHOYsAjV9CJ2wwd6zOboZpa0rM/JcKPSXUi5kpwap1y9NttL4mFrTRU/iQIhGlKOx+DBbp59g1NpKA0HjupYne+a3DbKA+b73mjHIKHdIIG9bbVN0JmlLSQ/FfGgC4A3jJ10VaKykwHrPwgjsKsRq3YSu2q019cNixTT4DfEjahCpFXbWDdWVRjin8SMT3TCrrGqIyClx2kfBDWd+CljNYtDtPNmGFhtRbVeJ6H9BhWDisM8X+kG12qfxpieH4Ycorxct+gr1XLZRPYk7kaCm9SySBqoktBa5avedAfFZL1WbFGctcgWayHxF9EFoAAMR1oZDYJ9JF17CgaSp+un6+teiqwBrjQ1mnZwUjsj3OYJHMFJ9SyZK4uYhfoqn961zF3bPN2Vn5Kn6/Ily1r3LAurgwl4kcpO14vV5euCSXuhunbTuooV4SS/SE2fwopeSitIEAHrwMNWZS/ma/y51oaYKrhwpE4/ZVlprTtxuZwUTkulGBCf1FLACLOp6VvWdBk6IBR6CagftvqTOwUdj8enFTzwTKojS1HLAVTNV+qs6got4iQH5XbCdZR0WyretsWuLpj1RqwBRltZRLQaWG3PdEg0E4ntj9L29RoeqTXs6zXsp2t4pNZwMgdkhJyR6ayOrDk487EIJYYzGK226ax65ohEa06KEzPQOav2aIqQpXQB572H1CKL3VFQ9BZC0ZuBorcAit5zoOgpKBLqqGcHYRnYfoE+algGSauvpKAMVp1C01CnM48UDk6Km6Nf0atAv1zbSUoXqV20XgdzRdPJKs+oIyau8lV3pldTtK1pG0PPdX22eL+5iR4u34cCexv2IqttZpsPCef2ISCnBSL96gyRLnaS6k6yRZ2kupNerpM06+RsvxYY7KTba7YValRC49IlHW1Y7VwfWQo7nN7AMgxgGQLnrlpQ4ChWnjjL2XjkJs7PH0du7FwcxMPh5CB0QHBA+1HFACLBYy7yMwnHHvZ6ERN2NVV17QFnzbjNcoH54iwfKtTE9I9FXDvYEeQqWSIK5mqeE2TvqkbyO2qh8TnBc7ZAsSkN6myhWSZzvvhJKnNORsy0zhbIhA9o/wYlnB5ZlRXVUMkkjxar4EbLjEqvU/PR9snZNGeojvricrlYW8HadDprkloQQIUlFgmgSnMMxnnWlIywXM7Cs6crSsKgs1fcfc42n01Vufyg2WrB2GJ3xhzNauftqey8bRoIe1ypiAcLxnpgFzSH+c4W1Vc1EsyZmaW68iRjiGOS5YMB0PUXFEFzZqOChGrYQhi2nBwCdw4WmwpOFT0nw2X2h6l1PNNtKCNEKeFHJuewIfV8WOvJT6H+Ep4sz54y0BMzSmQY6bkBNbCsMZ3NfYhVL8F1LDNXJBMXFzQDJUTYNlafW8FNZCQpNzUdMIb6ZAMZMX7pOSxRdnCY6IGgfRXIp4p8KJdRJL8+5tjr0vOjf5xrfMlQ5CCaK/6ahm9JUd3sLScwp9vKj4Kw83UlZlNMW0OJsScE44l9UXowr+MrTjhc10XXjVWhTJJ61GHdMLyYKzUejytJ4uKiSCL7nA7nyqYpWJBdjfyQs3VB+xGWTqyMFpxjXQThOCjpPpfA/qYkwtIo9AJR0gCURAimxcyavhAyA9c6MxOI0Zc/S4xOpoDwChAgLwxsIx8Qya+bkf1jIbaueR6x39CNL2MTVPLPej0sRmyNsrOlXwCDjdU81C+GiYvKRmEcuI4fxu5c4SxpUemPicW6uMbiuYF4QaT++SJQc4Vv4sCUCuU5LFuG+1bufCqHG2Ze15oo1VGFpNbX5BOuL2Uap5bRyI+jY68fgCo9TRH6Rz5dpD8/5mIaDcKAzS+lexD9pmp3yZpQwMyVvLlQsWrTerF9XoC90KpaVEm/d41Vo20Y1upNQD1/8y/IEGpCu59wQsHSCGcz5j5KMTgFhpLjcZYBRCf0H+p0NdtJ7H3mUzTEzpJA6hvd46znXdnKZFTdjhyPwQQmyfCAU8/3gv6xT6MB3O7kFZeBudM9xh0WiEOwfYJzMUxS1ahKtSXDF0wkDLuoWbR21z3CK+Px+KY8JuqidN7ZHiRFUFGZS7/H2QjMdFzVu8IwiUJQparIRQMn5qKWDqRYHD8zuiIfWjywYkHk0qEWi+M/5qKN+QKm+mF++JZgeX5MF1WwhDWLuV8wCFg4glIW0hJwZqLTOZfSzGq3cSsCzdYqI4vz41Vk9kL0IiU5aNiZKU7LdDjaWe8To2xYtyQVxTPYnE7CtJ4/MnhOiskaqYp5FJKZzLJIPhNEpsrAQgXHuSW7uNh4PJ4p89Z4vLBAAX2zMsqGo5CYFVyIyrNlZ5Kx9Mz0zXVm4aFZOm8K6FlyRQzDWlBt2t9b1FmkbcR4qbawysXzdov6F9LDebgXjPmCyo110KOyijOgvCPMzMAOVQagHUQTlypZq82P+MKpWXDclLtr9XP1+nrfI0b5SRyKHcPKp2ypFFhOxYSmSvhUo1VMaOyrBH+mpsahiu9D/I7gk/S2JJu307WmDtXioM4lpsmePZ7X9D1Hx7lEgzklh/b6SWft83DItk6uwI+Je7ZOzuHHwdk6OQaLv3XSsddPXjbO1skFJFxVq2unV7Wj06vm0dk62bfXVQ2nV53O6dXe5unVXuf0ah8yHWydXh1sr51eHW2dXh1tr53G1fp+Db9bGDjAwCE6TqkfbuB3H7+Hp3G10cSERnMDv1v4beK3oxIO8AsOVxrbmLyNjTS2O/jdx+8hRnVq+G1g4GgTv00IbGzX8ItVbtahss1GDQObW/htwXdLRW1D+5sHCNjmITSzeaQCR/XTuLpVx8DWBqRsbR3iF6rcamL5rYMGfqH9rUP13cIvZj3ErEcIytbRPn4hqlmr4rcOCU2EsblxgIEOVNLcgz4097FgE8FqHm3gF5OPIGZbOajZrm3iF6PqG/jdhu+GSt7EQEcF9jB9b0sFYLhb1Q0ItBot/GLUZhW/OPatLQClpbrb2saU7U0V2McvQN9qYUILp6PV2cYvQt/aw5S9On63VBS2pZrfh4FqHWBVBxhzgK52WofY7hGWPoLfnSo226l28AvNdnAcOzVstoPd7tSx2U4DUxp1/Dbwu4nfLfxiVuxzZ7OFBTb38QvgdJo4MR1E1I7qbWf7AL8IXKeFAKnudrC7HdXdDna3g93t7GG7e6o8drqDne4cYKZD9cWqjiB1T/Vwr7qPX+jhHvZwT/VwD3u4p3q4hz3cwx7uYQ/3Gqo4dmsPp3IPe7W3qX4j7Hs4oXtN/G5jOdXDPVxle2p97eGE7rXqKrCJX6y3hblaWG/rEL8IaAer6mzgF1Fnr4NZO6pC7P8e9nxf9XMf+7lfxfR97Oi+WhL72NF91dF97M8+9mdfIeX+JtS8v4VFsD/7iJn7qif7iJn7qif72JN91ZN9nKt9NVf7OD/7an72Eb59nJ/9A+jXPs7PPs7P/pH6wmAfKOgPEPoDBf0BQn+goD9odPALVR1sQFUHm7jIDpAAHah5OEC4DxTpO0Cid6DAP2hhtpZKQVJwsNdQgT38Ys37uKQP9iHzYRXX5yHixCHixCHixKGiAocbmAtJ4+E2Jm9v4LeJ3238dvALlR+2EODDFia3WioAMB52VEs43YdIrQ47mAtn+7Czh18kZoe4GA5xMRziYB8i6IcK9MN9BEF14GAfow6gjaMqlDtSoB9tNPGLMBxtwaAfqW3hCKa5prxx1ar1DnwbR/DdVFGbm/jtqMABfGHQa9UtTNjawu8hJjer+N3EwDbm2j6Eb0fVtY9F9pv4xaoOVMIRtnsE4NfqG9v47agAZKsrWOow9bX6JqYriOoIUX1LpW9jyrZK2caUlkqBQazV9+oqsInfbRUAAOv7mL6v0hHM+r5KP8A2DzDQAAytNRBDaw3YM2qNmkqBxVRrbGNrDSDntYbqNO6rtcaRynYEQG0gotW2YB5qW1tHGADkrW1tqxSgD7UtVcHWIQaOVMoRdLGppqsJq6fWxNVTa9ZgvJt1ldLAwIYKbGJAjVETG22qCWvihDUV1E1ArlrzoIlfyLWtKtsG/qK2rXJtA8rXtrebGABKUNsGpK1t76l07G5LAdiqweC21Oy1tqDvraYKwLZQa6k6W4DvtZaqoLXfOo1rHVVBpwaj31FwdDahTAcWSk1R+RrS7NrehgpsQDV72w0MdKr4PcQvjO3eHuLFHnAYtX1V/34dyu8j21Db34Bs+wqx9oGPqu0rAPcRsfYPWxgAbqy2f4gjiDStto+d3j8CYA9UzQd7UNmhChwhqhwpVDmqQc1Hqk9HG5iyoVIQ2Y/UcB1tNvHbwu8eflUy4ssRwnek4DsClqR2hJxB7WhvH7/Q8yOF0Uf7mL6v0vcxXa28I+C9akcHKuUAmzlUKdjNoyNVwRFWcKSyARNWr8LmV6820ZlfSznzgw2tXqvW8dvELwxmvVZr4HcTvy0VdQBf4LXqtfoWfrfxq0rUVfIRBmDzqtca+yoALdZwyuu1DUyB/aFe28ZGAD/ranrr+3VI2G+owCYGtlTgELMd7qkApsDGVN8/glrUJNYP6pv4beIXIDpQdR1sYRRwsfWDbRUFG079oKMCHRXYVgGo/mBPpexhyp5K2cOUfZWyjyn7KmUfUw5UygGmHKgU2Ffrh8DvNqrVTfxuwRf580a1gVGNPfzuw3dDJbQwV+tABbB4R6UA9W0o6tuoAm/dqOHyauAsNGqq5hqQ8kYNO9io7UGZ2pEKYJkNHLONA6CuGwrxW0fA1HfU7tLZgK2ms3GgAkAJO5sqZQsIWWerpgLgLLKzBVtRZ6u+B98NlQCiQ2cLvUh2tlpQXpHIDkoNnWYNiFKnieWb9ToGYOV2mtt7GICJ6DRxIjrNvSZ8Ud7pbFdr+G2owCZ+dUoHv/sYqNfhq8DZbjbgu43tbO9h4EgFjpr43cMvwNnCddBpAT51WohDnRawE53Wlgo0YQRa2xsYgM2+09qHfrQOVTrga6d1uKUCmHKk6jyCTil62UH2vdNRAHY26vjdUAGApqNa64Dc2Ok0O/g9xCigkZ0OMEqdDkxhp7OHmfZamAw8R6cD22Sns4/1HuCYdg4wQUHZOcQa1Sh0gGZ09nCv6+wBjnb2qi0VAJD3aiqlhilIDjt7dRXYVgHMpkZrDzbYzt4mzsMe8B6dvaZKOQRw1Io/aAJLdaCm+KAJK+qgub+HAZiOI7UxHXVgLzrqNFXgoHUaH+2pFAT0SMkKR3u1Jn4P8HuEUTDCR0p8ONprYObGtgrs4/cQvhtV/Nbw28DvBn63MOseJhxgI8jjHh0g1T86ANbh6AAZ7aMD4JSODo5UCsJ/qEA+hO3z6LCJtR0CPT46qsNwHh0B33x0tKECmxjYwmy4IxypHeHoCKs+QuH46Agw7ejoQKUcYMqBSjnYX69EYcwdRu7a+6vrp3FdMR/1Zrd3Grsug7pdtwcBJUy7XQw4KtCDQI9VGX57oGKoKg3GFibgvPcY7MP1qqu2kV4VKms4WLyb1lKvVt3TuFEHEapRB666UW24+K2exvUNp54Wc3GYXBf2KNdtMhVAgLcZfFs1jGrR2T4wxFeXwSbmshqkszomNOqY0MAonC2XbeIeCE3Wq7gVbVK6hn+68O0C3d50ALpN7Eh9s8dO42qVtvDLcI+rJ1uc61RhV9pSA4xajnoXiFG9C1hZ7+Ke2q118QuNdkHLUO8Cj15vALD1BqY2cC9tODBqDYYbbqPXwO+2CkAvHExvwY5RbzVwJ1Ybea3XS6Z9dT0/ZUeoFWooFRGqgFpKBYQiySbwPNVN1GZtApGobqJ0sgkMdXUTUK6qiHx1C1UtWxt7GNhU2iAQWLaQFaluoTJjS6kxtlALtnWI5Q+38avUQYcHqAJCRQ/SiWoTtUzNDtbSRNmpibt8tQk7e3W7tqUUPi38Ysp2HVPqmyrQxG9LBaCBbaXd2AYurLp9gFLdNoq024dKWVOFCloNpaxpoB6mgTJtawM1O5tK8wMsXbW1hWqcLSyyrRJQhdDaw4J7qiCOXAvHrLWPuiCkY9XWvlLwYMIhVnXYQGVOTWl2MIAwdBQMuA9UOygCdkC4qnbUuHeA36x2NlF5gqPfaarfqOVRsHW2lQIGK1SwdXA+O/tNFUCdjAKug8B1EKwOgqXof3UPwUISWd1TYCFFrO4hWHsI1p4Ca29DaVq28IvJOGR7W0rRgr8VJHuIZXtKc7qHw7SnFH57CMkeDpPaG6r7CMO+an0fW99Helzdx+b3UeSs7m8oJckmfgGIfWx+H5vfV6Oyj6Oyj6Oyr2DZxxnbV7DsIyz7Cpb9faUQwRoPsEYcoX0coQM1QgcI3YGC7gChO1DQHSB0Bwq6A4TuAIflAOE6QLgOcFgOEKyDfdSd7CsVyQFWhc0qFrJ6gAqCA6WIwVVx2EClRkMpLFD1criB03uIupdDVKAc7ql01PIcohrlEEfgEJnW6iH286i2jV/IdIT6wqMGah8aKuYQv6iiUArRI9SiHKGy+gh1KUfbqPo4Qs3JkdKcoFhTPdpHfUN9D9UGDVQubKJUUkVJqbqpNBFbqInYqqvABn6bKoB6B2y5VkUptLqtsqHCoIqye7Wl9B3AZNYamwdKxD9CMbuupG0o2WyoQAMDm3X8ohTdxN9N/I2jVmuipNUEdqm2XUX4t6sY2N7EL3RsuwOdaCnZsFVHsbihAsDR1zrI4tY6NQxsotiL6taa4uxqHeAjax1UJnSUQN1BSVCxN7U92GFqezjRNVyBtb0tBGYPgd1TAiUustoeyge1vQ6AuYe8Z23vCCXmOlawDxNb21ei5P5BHb8q5VBJzChEKxlyH2XIfVRg7IOqtqYEn9oBSs8HR3sqgAKlEnyqoLmu7x+iFIiidh2VfPWDQyUYoeBRRWasUVUiEXIH1RZKD1tKVIDJ6Gw1N1QA5YYWihItlDSgkc7WkZIAkMFHZnW7ivx7XckEdWTpQUHa2d7GrDitne19FBYU/7uNlbTqikuvI+ePy6jTArToKH1HB8l9p4XqyE4LeeoWSjCdumK2G8h5A9/Y6Wzs4xe57KZiubELqCTvdPYUrw0KgE4HRZrOHsoAeygW7CFXv49c/aEqjcJD5wjbO0J2Gzexzh4y73sg9nf2DhXLCxyjEuWODkGVfnRYV4H6UcoXPrbXq2stPNRRW/sWBtQJ0Bbq5JtKV9nEraK1pQ4wkJlQEk0VJZpqR6V0MKWjUhDc6p5K2cMUhYnVPUzZVyn7mLKvUvYx5UCloJBePVApB5hyuKlUp3iIdajOrQ5BCKgqrUz1qI60RKnPkLGrKRVHDdGr1lQqkuYhBLaRo6khR1FrIcmu4bZfa6mF0YKqax21sjrbGFC1dbC2PaXw2UMCtqcWLWr6a/sKgn2EYF9l24dsHXWm1tkCQDvbSqTehnY6SsLptKoYUCktlXKkAjAlnQ7W1ulgbXsqZQ9Sjo6wP0dHtVY6xXfsu6uPyZv4fWAb5m77xFh9vGqcXddIY3p6WrGuG9N8lEHu2caJsfrmqnGmcr+5apyerp1dV8lWbapTrF2D3F901A137IzVe6uGZZ2eGqtsSt5YnK1trN43mbUKP09PKxharVmrhnVdJbX61lQaqw9WDcuYkgPbVF6s+4dXI1MDVwGYPp2BZ1hkPtOZYVnkdXvd3G1fBWtrl4wP+yyIuoxTEQf9tdG4K+cSGF9zhIp3fGfgVBmtduv9Om31HRejxxt8e5P5271BvOkGnMog5GIwZpFgPBjGIqa+FJxeMp/xyAuimNPAYRJa+X/+dR4ArK0RPqltX/rbo6CxRTFmM4obG49bja1uP1J5us5Frduimy5nGw7GDPtd6rXo0/6T0dbjJIbx0QbddDcox5irC6fu+g26yVh1MNs8U3l6TyfRtrvVii/7w7TiplPtdoMqTWuhfmMwYXUq6ZBxz6GBdkUsLxjnk1HqPV5GNHAvvQsn5OGQBgIr8GrdrW6NbtE6w/CFw6+aTbd2taEa8PvdLhU16m6nHaENutGrbdE0TC+cpnvZS8JOlbboU0cNTvDkstmLqlU2pJL26NDzJ+D2igaTFFwVK7s0oAHlbBR3fc+RDkwL5yxilDsD6YThhRf0nQENAuarrvlh34uE50RyzKgYMJ6k4jxtdJs9QauOmpKtJ09a21vdxpVK3a7SJ8xxeU31orsx3qpu9hhXONTzntTr287mIMLgoN7lrN9gl2qIHvtPtmpxa9zs5kakwR6LNEiHTbo9SIOTQbM/Soer2+p1R2Fatjuo0WaNJUGHNt2nbpgEvQ3msKtRErzacNwqVWX5pVNjVTpsMOkFgNx4JZ360vd6LEPsaMQZdbtMCC/oa8xPxmkc+oLx6MKPxxrjmHNx6Qqnpcdh5PDHLaehQ0+dutNidScdlMctZ1uFhPdko3X1ZKKQZNLj4Ya31VTdmPS7Xp0Oh1cyvYPkhYH0R37PC2jgeNSXkTMIfcqjgTeKYDFEnjNgPF2FrNptVptqkDY2u3zTmaTz6F4NLqIkELGBq4Zne1Kl1a2GGva+c8EbvWqKoi067qYB2q133STQj7fr6VSN/HovnUbRcAc620WtG2/otR70u84mfeomAdZiem2G/e6ot91TgD5xLmhtNHQkdZwwDgQNRCS7lDs+nTiUu7LrU+eixz2XTmTXD52LbgwkS3a55/ZZJMKASYf6l15w4TMvgLEUnKLLSulw5noiDmBYWdD3AsbgPqNkgBcj7kUskr0wdAMmxiG/kF5wySKBV53UShqEgvmR9OmwG/L+wAs8OQxFyJ2J47NIhj6d0KDPw3gkR4NQhH1OR4OJBKemkUC8kyMeumpmI/jdR2fol0xy5sbDLme+TyV6M6RiEA6ZHHu+79HhwPPVANVq3Y0E02rORZ3V1DzWnjwZ1/U01iPER4UT3UhUq4osNtxoY6OhcWWQ5dmoO3W3RVO0SeI3N5+MN+oq/1aPP93Wv7erNKxrktC64KKqZ9JxLurdht52nvKt1ob67dao81jHs97lZFsvehaJy+amqrPniI1660L9fvykWdd19vxxY1Ov+3796mpDr6Q+y+DvXzSoqLH8elO/r8R2bUPFe0O+WWsEijJtjWstPQ6PL3ltu6XG52LExzU9thcjPtlsJr/jZm2rlydFyW9xVdckyrvabtVUnmDyZFLXhD8UcbO1peoZdUWr2VSwjfrdqOqqcXjSejzp6nXCB08uW1sKNh5extvbqv6olfUrYtHm5obKI7aq3U3d1mX8ZHtL48N4g0cbVYUzV4Mnm3Ud/7THa1sbXfns27/37Nt//+zb/+7Zn/32s2//zrNvfxN/fPPZn33p2bffefZnv51bgZKOKNfrgEZR6HhUMFiW0QUTXer7shuMRpR7XRrJbsgGsKoYlw4deYL6uCTDIIp9pK1qFeJidmIv8gLEeRbz8NKLcGleCU7RHZfswRURtXAGjPpi4FDOpDcchl3P91ggvcCNI8Fh2x7SgPbRPaIceiKKu1408KQi92PPZTJg40Ho+zRwZcCuhOtx5ggZBpMw5hFkyPEAIx4K5ugVG7ssEEB+OaM+g5XMZLIB00ByiIJtRAB9pqzX8xmX0djrCSe8ZFwKKijSiUgK5gyC0A/7EymYz3ph4DlUxoGHxFxM5KUXCTriXiDkpU9p4DLOAnkZ+hfg9pUpBG5UOW9O1M/RVbx9oZfuk1pNr+h+l3vDZBFfbSr82xRPh5vpEo7G6merShtUFWu53SearLeY2KzH+NOpd3lT5XX6G90LL1ngUVUkP+N6yhJs6VXf855E21Hy82lL/fTCKm16ybISDfVzeCWe1BS8YcMZb6h1MYqiiYYhDuLRhurxuN8d6G6O+11f4/6kRRv0CSAtC0TMmaR+j/JwyEJJfbWDPpV0CHuFS4eSBtSfIE9En8QUyC7DbYb5YUBhYwnhRkdfOgPuRWJII+mEw2EcwBQpxAn5RDI3Vh5uJHiRHiHu9ajHx17gRjLbtnse11tTj7OIBV4cyR4PA+GGIZe9+LF3xXh4JXsxDzyEvh/6Ll5okgMvoojPEvYDl41Cgb/6YehG+CtiQcQk3G+ZjJnvSy+IhCdiwWTG3lzE4YXamHzqdnl4wWAbCxyKmyesCY6oCkxRJCY+k0PKLxgu2CEwHNT3IzlkfjeMecDk0HN4GIU9IUc0oBGgsRzRKAIUhdWjmVbJPbAYdn1PRs7jcBBEYSCjAWySnk9lFPqx2gpxUXVpcKF+9SgfykiEzsUg9IeZICIvqRrwKEFdT/1yavSyn2w2viLDrEY1Ue0Nukwh2uMaZb3k11ChmV+jTsqk8KuMH8dfoxp11C/hXIzVZnI5eBJfyknohl0KRIZ2Y3dAu56kHu+F3AHs8xWdoEKEPGCThIlBbob1wlDAjz71AoiJmCKjYSy8JzGTXU5dFjmh7PKQumPgdXgcRcB8dGOXjlgkZFeZDEMMENEoAnrLRDgOpEOF4mwc4CJQTBjwSQRkyfFG3KOBJx1PTBgVkXR8RgPM7HsBtu74oRhgTDgc4qSALBIDprjMB1I1gR+hJwSTLhuGDqdCuh4dhoD3rhfhzqF+AFa54Rj97qZclwTsiAAZWIKhTHgR9amQ7JJxbJRdAQPeZ7LHmNulzoXsedC+mMCCGrrUhw0iVDsQLiePcQnrYsIol31O1TaEXBgs9X5MuevRQA7cnoNNDJgfecGFJwehD0bpsKAi3LSkFygH3dIbeZwGfSqBMgfd2JePR8OQ92kgfWDUYKD8OHC7zLmAtcK9UAg5pBHjVHhy6Fx4QcQmcsiGIQdqMGTcuRhGrhyGXPRpn8lhiGSfy7ALlxUZl7DhBjC5owHlQ+pM5MhzRMxha/LjYRca1dvVBHhHRV048z0lzVDKcaOL6DBy/LgrI+bEHIZO34aMZDQIRyOoJxqEY+ENmYT1rNZg2BNj2Gkj4SiqEcWjkY/C8QRkYubAmvQQbWInDALYSS9pgCMsLxUJRgnFQ5cGYyBWnqtWT6tK1Y8g1DGjGlXL88kVHcpJeBEO6JDK977+/q+89xfvfe/9r773Z+//xnt/Imk37FM3lNShLhtOJO3TiwENJPW9Lu1SiWTMcyUVA58JKqmWoWjsevAMIY2jURgJSS9hmCjK0kN2Jbs0HtA4kl0WCB/WKotEN57IrpKmYRWC3Cy7cZ8K4SWMjXQop5cU1htnMFkO5YiDieAItA/G3Bl4gaC45qgL8X7cHTJXOqHvsz5wR37YRy5pCBRZJqI//uUMhRjqiES6l04Ikh+VuMw45ItHQBMd4GQYCDqec8GEdHiMQg3yLxEsyD7C7YZDJDzwzmvEXJloQoDsQj96QNXCQPYY55R7+JfxUO9oTPaAtQr6sucJJDw9XAjwN4TtEv6OsZ6QR7hKedinQYQ7HexkfXiJjU9kH+AK+rIfutR1J7LPQwfjY09QHskBHXZj3pdABsIY9kJBnQGuVhQAB2GE5VEuY7B4BXhTkIMJsI2e9CJ8rkB6EUR78jEbM59P5OM48EaMywu4Lc0CeREOKcDlU4d6VxR3RhC+fBpcQf98GgHE0qeCh10mfeb4jDvS96gHRMyH12uYK30vcEI/0Hsn7JcCNlQ5BIGecRkwtb4CJnq+dyUTQTNgsVr+F15Ax1SGvhvQy4lEKuM5MuReH+ZrNPB8kPxHXohUdBT6nvAY8qUi5JIzxxuxSHIWCOrD30uPjeHvFWAwUgNQFmgNF7CrQ89FtnVM+VMZOR5D8jHwLqIBldGAe5wOZeQFfRhfYE31Xz4AoiJCDvQLqEPIhYxi3of5iyaRYEPgdoEMXkgxYKisw7+cSYHoGUnh9XqA5yKMBl6XAk0BKizH1B9SLuSYBv1+GMsx3nxL9VdyzLqRJ5gcM1flH4TReBDKMfqdjOAvEs8rJOETOaFDehUHchLGIu4y+TSG/Wcg3/3+u+/86Evv/vDdH7z73Xe/L9/7+nvffO+vkOp8/b1vKvLzp+/9+Xtff+9r731HBb/3/q+8/xvvff39X5Xv//C9r//XP3nvL977pgp++b2vv/ef3v8qpL//Zfn+V97/6nvfeO/r7/8GRMhn3/61Z9/+BkpYb6PY9WfPvv1DSbuUi4Gk3S7sGLTbvfSYpA5c1pK0h/wT8FPOBPiKbhzBH8F8IHcjOpHUjyiyGpEIgaN9EgMp5HTohJLGYhBy2aUTxgPZZTQWQNa47wWyOwAyBZqUADgMWEMX+CcMJLKGILkNGaearkmHRswbwB8vCKWD6hKkcUjigMmWziBG9aPHHR8YCOoNI8VQONIJez2GxC1iEArcMNIkSwuDmlDB884wnS4sxkC6IG0By9HnjCENA9LFcEPRwpsbAiMu3Tjww5F0oUoh3Rj4B8lwn5UsYLw/kVpsY5coxcKjelxIrVLt+Z5zAXy46IY+Eqd4JPuUuyyQfRbyPpN97xIJlR92qQ88Rt9nwFgIL9KSqRwwPgQa5I0G4UgOQueCTaTWGA3iPuCvN6RDKhRbjurI2BPyMYVdUz4OkdQ9jlk/jOQFjXtAmMDvMsc/PpMXYTRQ3LrjAZESMB0+HU8gkvU4vZC+ghMUYxAJYx1IP76K+UQOqcs9Vw4VzRrSCxaPNKmSQyoAs1CuZurPBMgWjQbApgyhQBg54VgO44jFQ6mPCALaDycUpOwYzhC8KKKB+jPBE4UwkEE45vFTGfZ6nsO0jkyG+L6FDDkFfAk58nmhiOILKkc97ynjSoMGNG8SeaEcebTPBHJBTMgnMesyR3LqQG+R/AEb5EUMxPER9YAWIklSJFDyEBSlkod9oAt8El9MYhnRHgOOiF4A8BENwp6HKtYQNa2xz6RWqyP3xKTeTqJBGD/2ZBQ6Dgj6oVLNCs6AVIrY9ULFL01kFD+NLzwZIQGT0cQFSUBQb8Q8KWjYpaFUDnKlAEEhlIIFgQeEkQUuleLxkF5dSXGh/oSTUFAtDIHOwAmH8tKjj4HT8pAxuPR8n0KI971Aan3KZYir6jKcAK2+jJkfRkBiYRWPgbsMgKA6dCivvAHQkAl1YDefgPbhSj6lo1EYyXf/9t0f/uiL737/3T9/9x357g/f/bt3f/DuO+9+792/A7L5jfe/+t533/vG+78Bge+89/X3v/L+l9//qtSk9Dvv/dn7v4qB97/y3vff+85734DAV1TW974p3/8i8nl/8/5vACH9CpLYvwAyK5+9/a1nb//w2dvvPHv77Wdvf/vZH/+2IqV/nimu/uyr8oMv/84HX/qjD7785Q++9DcffPnrH3z5LyRqfCV1Y19IykQApz49kPuHSFVHI+AGOfASFOeLxoBr9CnMcpd6bgw0E1TPyDcCux0q1bPshhgfRs5AiWBwBBMiD+hGQBUjoIaMjqQD4g/wfEADgcRIdHYinZDiSY2LnBrKa5Mhj6VLuzGXLnJYQPuAb/MFlW7o9pl0OWwLbtylnmS4bzCXXVCJ/qMkQ76WjWBl96gnBrLHYPKAY/OBD4PfIY+HSN5C2adAq/peT8D3En771GXwjSIkc6HsY81AfAPZ596ISSUL9GPH8YBHc5nSP4DuwaVyEHLUQ8TwnVAhpHdBgxCPXXzpcS8aSO+SOaF8zMRTIS9C5gfyYhIKoGJDJGIwej7rw/EMu4oj6Xu+PwG+ymUSWB/4feX50g+Bn/RDB/KEQjD8htKPRx7QNmeCCsF+KIfM9agcenQIynpYf8NwRDnKXEwGFEYpwLYC7wLIlRc8htPQCw++Y3EpQdlCZRhRoE2UexF8hfpOJLoyUcRKjrynT6nE68VyhBvpiNMrTyruesRByBrxcBgCAYuEBHYnlJwNaFdRL9CXhAOgVheR5KHLQsnjfhdolA+aE9jMZASbGGhR+EhG4LIRCBJwZReTEZORjzId8k/REKh5BEdGUilUI8WnCepS5N4YEqyJVDqfKIZ2o7EXRai05FJ4IMcJj4e+FEMQtEUInLcILyahFGHoRxKYQIiB7VmABIJ8HJOCx5GQIg6ghjgaxDJG7veS9ZFGQe8uQ/eCgnrzMgSSBDweksox6yKf53VDCSxyBF/flUpPNqGDMJRPvdEolO99/f/9W0hyflUCw4Xs2nfke9/4rz9E+vJN+d5fgPyoMsDP76Ao+f6vvf8VLPZrOSLzx7/57O235bO3v/vs7S89e/t3n739h8/e/oZ89rU/ffa1Hz772peefe3Pn33th/LZO9969s4Pn73zzrN33n72nd+Wz/7yW8/+8ofP/vKdZ3/59rO//JL88N/++k9/7bc+fPvrP/3K//Th939XUpgofAafutSRFCQp6vVDSS9ckF19POO9AtatKykHuZaPqKRcMEkjl0oaeRTpFJIp2aVdPAZ2JQ5nt3tJZZeBeIpqIe9CESzQYsIH1Elhj8puCCXC8EJ246dPpUN7eFIGUuxwhKwefECIjYDzAzIGu7/ThQTgIp0BFUjO4DNBIRYkUh8+I+lMQiBhQK+A0XId/wKpGBAxxbZJ12OoFBoA3xZJNxxQCXfkgX+LpHtJ+5LxwJM92Kd6QHx6KDWCMrAHcnHPo/BxQRL1h6hWRVkUODiOR3dA5FzQsjLZiwMX6Zzsd72nKGvKfp8xpHqyP+wOUMsKnx5ydLIfA6cX81gOgINAlQCoikBNNAL+jslBX1yi2CkHUdeRngMfxuBMwu3iwQQojkLpCRrLx/SSyseMjYC5i+TjEY/khet68sIbe/JiNOzLCw5iI8ylz/qh9PtdIX3PVafT0oe59L1hCBQQmTuGlE/6oHn1QwgKlwKDBwpbryeHDJg5NmRyyIJYDvGIEtTIwNLJYehSPLGUoPeQKGMGICDCeYgEhgKIIDBpfijDEQvkCKZiBGgxcpwxsF8gfYLyCQCCg03QR0VyFA58OQp5IHEjglNO+PTkE+DyOfVc4NLg4wkUT/HUBNXDkg8hLgyHkkeXI8njAUfWTKLuIKLclxG9hF9XoYyckMNHAGsGH3YhI3Y1AXo4BsGVomILPmMZef4FCK9UorgYXXiBjAKnJ6NwEMsoBEIZBhOgikgUOUiwPSmofyEFEG4BLB2c2UjBLqkUHoXPCGjeOACGDChbl4HqiwK7BZ9L/ABpEww+IYqxQMu4HMOZ1di78EBEZUjZ5FUXKFrYp/Ip5VQ+BfLwFPaUd//y3b/60Zfe/a780ReB0/rRlxSBQ5r2jfd/471vvv9l+V9/OYn55vtfff9X3/sL+d5fvveN97/83p/I976H9O1L8r3vAa+lWKuvvv+l975XYKvks699C8nbO8++lga+rgLf+Nazb/zw2TfeefaNt+Wzb37r2Td/+Oyb7zz75tvy2Z9+69mf/vDZn77z7E/fls/+/FvP/vyHz/78nWd//rZ89td/9+yvv/Tsr3/v2d/8C/nBl9754Mt/i9//JD/40p998OU/+eBLX/vgy78uP/jyv//gS29/8OW/+ODLX5Ufvv31H//t7370nb/7h7/7mvzJD37lJz/4tY/+4Psf/c+/In/6+z/4h2/8xk9+/6s/+fq/lD/92r/76A//9qe/9lsf/eavS0opSMySdsFWIJTUjSRlfUl7PhBXicc9oQ+sHlBSSceRpFeg8uOy23Vktytk1+nLrgPiMZBNV3a9UAKp6A4j2R2OZTcAlfwQiKbsgsI+vJKoFnwKwnAXqCcQTyCboGmXTpdKpxtIpxtJh3Wlw0Lp9Kh0esD0DaUD1DVypEtdCZuoC8pydindgS9dbyLdYCTdsC/dUEhXXEr3kktAcmCZmBtLFj2RDI6OaACqN9kD2TUMZQ+OjrgvewKE2ED2Jh6webJPR7LvggxLJcqrQ2DqroDYyX44kv1QyH54KQfdUA68Szm4gKMl+DeWXncoQV7znFh6vaH0AkfCuodlD/QE1HyeuJSPna587IzkYy+Uj31fPh6O5OPgsXwcCvk4nMiL3kACK3XhDeXFKJAXHOidALFV+m4kfd+RfuiDmQvQMmDa5JCO5LBLgXUDMiaHng9HunLod+UQjqGGQMVATAWSNgRWTqI+XQRyKLgMaFcGXSoD5siACSBxMuj5EtjBYHABbJ1Ekzc+loEQMpg4Eshj2OtJWHhAF8LAl2EYggpOhkLI8HIgQekyAiF04MqRB/8COQocIHRwSixHcVeOxo58cumATh7onOSeLzkweR5QtkDyMRCykYxoJKOuJ6NuJCOHysgBHX0kIzYGeiajHgd6BUydjMKJjIAKIuvmSEG7QJ6kcDwp3As4SZZi4ErxGITEkRT8UorYk+IyknE3knEQyjj0ZYxGQ0JeeiApgnw4AhUa0CI5BluTcCzHwpFj0ZNXXiCvrq7k1eQpqM3kJA6A4ZLvfufdv3r3B6A5++G7fyv/X7//7jvv/g3Iht9/9y9RRHz37+S7P/zRL7/7F/JHv/yjL//oi/JHX/zRL7/7HfmjL7/7/R/9svzP7/znP/rPfyX/y9f/y+/8l3+vSNQ35Ptfeu9v3vuOBJHx/a/I97/y3l+99x357O3fefbHv/7s7W/LZ29/49kf/9qzt/+DfPbdX3n23W89++4fyWff+5Vn3/3dZ9/9X+QHX/reB1/+6gdfgl8/AGoDpOaLf//Bl/7VB1/8pvzxd7/10b/5tZ/83b+UH779Jz/+7g8++p1/Jz/66r/8yVe/8+O/+0P50b9558Pf+MMP//0fyY/+5o9++id/+tN//UfyH/7s+x/+h+//+Pu/J3/6H//nD7/6Jx/91dckcG2upKCVk7QvqSepL0GaDCWcOXNJI0mFpLGkY0mvJH0qu1R2u7Lryi6T3Z7s9mV3ILue7D6W3aHsBrILh3uyG0kgRJeyO5bA1QFfJh1HOq50etKBA2jpeBJlR+kMpQMWTdLh0omlcymdsXSupDORzlPpMuk+lu6FdIfSDaX7VDJHMiYZcFWSRZIJyWLZ82TvsexdyN5Q9kLZ47JPZb8r+64E7qcn+33ZH8i+J/u+7A9lP5D9kew/kX0u+5HsC9mPZX8s+xM5uJCDoRwEcsDlQMhBLD1Xekx6vvSAYkgvlN4T6XHpRRI0XUw+HsrHoXw8khdMXvTlBRAHeTGUF4G8GMkLLi/G8mIiL55Kn0q/K4E6eNK/kD6XYHklpB9L/1KCdorKoSOHQCLksC+HAzm8kENfDodyCNoqORzJ4RM55HIYyaGQw1gOL+VwLIdXcjiRw6cyoDIACiGDngz6MvBk4MsglMFIBlwGsQyeynAoR1SOmBz15KgvRwM5Ar5HjoZyBLYhEtTxQo7GcjSRT6jkTPJQ8kjyWPKxjKiMujJyZOTKiMkITttk5MnosYwuZOTLaCijQEahjLiMhIxiGV3K6EpGExk9lbDYXSl6UvSlGEjxWIoLKUAUkwL4Dym4FEKKSynGUjyVMZVxX8YXMo5kPJHxUwnciCMvmbyENS8vA3kZy3FPjiM5YXIi5FMqnw7l07H83/7qf/sBLO+/kO/+1Y/+OSzfX5H/+5f/p//9y/9a/h+/+Tf/x6/8Dfz5bz/4S/nfvvS3/+1XviP/f7/zB//fv/qW/OBLf/HBl/9Q/vi7/+Ynv/NbsN5+/Pd/BH8+/P2/wz9/8Jvyx3/7xR9/9/fkj//u9z/8znfkj//+jz764p/JD7/4xx9+/2/kh1/5Tx/+9tfkh1/50w//xXfhz09+/zfkh//inY++90P485Mv/kf54e/86of/4Z/jn+//Lvz56A9/TQtZ8sM/+MZPvv/3wEF89Jt/Lz/8xnd+/L3/UX747b/+6e//QH74N3/8D3/0W/LD7/39j7/7B/LDH377w9/+PfnRV7764b/5FfnRr//mR3/wffnRr//mP/zRN+RHv/n7//DFfys/+td//+Fv/BH++f4fAHH46e/+QH70u3/94Tu/Jz/6/e/+5Du/LTVf8tF3v/vRV/+F/OiHf/HTf/PL8idf+t5P/uA35U/+xR9++O1/L3/y9R98+BvfkD/5u3/54b//Zfzz/d+FPz/5T/8O//zgD+Q/fPFf//SXf0/+wzt/89G3fkv+w19+6ye//ifyp7/8e//w538if/r7v/rh7/8v8qf/8T98+K++KH/69v/44T//CrI+3/0za53s2fMW4iufeunnyi9/enXd3v3C+aNrOf0f0JT8qJD1C8bq68mlSuMlwyJPl/kZTbyxgbOyFXxvjS32/l70PKqee/LvUS72B5Qr75t7RPmrdMQD31VRR0Tc4H0SRA3lvpAtecbRnvGflrjNnW89ed6xCAAJ7ZMzQtM3YomH4/SQ+Pb1UD3E1jY8g3htwzeI3zaEQUTbCA0Sto22MSWxXSWOXSUj29uJX6E7ybstqXO82EpchzvK8XKp2nbNnrXT5YxeoFvkUq3dN7MCazWLFNPr7cuZEo32ZCZmo92didlsj2dittqHMzHN9lUWkzhZjkzHmsarq8nMdOC9suy1BBdmwBiiC8Ddc7NmtXlFsEiYzCqXz83cA7p99a6C0VZ5dTZh7ZqOXScjNdTmwByY11Myssj1gEZvoB995dcQH1W2rPaxabV9eL/VtoWUZlaPY9fbRgVqF7uO3WgbP5/83oBCOVAuEeiKAiTLyjBrCr+Ux3n4J7lCUiYlAJq0BIJQKNSFQge59M1i+rgISbNtrCW/t9oHGSSdfKFDLLSWQKJLd0yrXWypUOiqCP7aokKmY28umYkDtAs4CEUyDYXKzxe8w1iH115YWt21516142mu0DE8Ia5WSxbZUe9Ojir5NjP3xsqX5KjiuVcktnbWT9YqZy+tqw7g0x747lbO4aR+d2dUmcGm3SRb+lZD8lJPm++Y8w4ozeSVV6NiWJVRODIteNnRqojwdbBo2KdRziF3kCDl1DJp9owtjMTQvM4oVFuQnJ/nNifqOZe26iDq79t0allTQBp0KTm2yMOif8wlr1967pWt0oBSQ3AXv+01/ZTlzIDYKytsNi7NmM5EkiuNmJInS570sa6zB3S86LPwXP3Cd2FWTFEuq3dGdbaH3FNOWM3Eh3XqJ/ggZNGboXiN4jvv6MT0Lj8IBVa4PG9HvM5oJO4G7K2Qu7ANYIGZdu+MOq7LGT5jqetC8xoviN6IfeGNfHYQCkjFVxJnSy1wW5rbZpOhPIp9P3Pc2mdXydarIBnl4zJ80huufvFNYPuLYFsAA9uZeQ1jHoKUYJsifc1YPXuonqFLoiCiepa9+FupGNara7XcYKRTtwiQBHo1STojQoAe7stlAbUvWlAG6Esjh3sj0TZWbJuXy8ZlNx8BMDwPSZag3wqTUpTLzxkeQC98CZnl+m9Y1pKG5zHuhtZXABXNledCkODrgEZJrZ2eYHzhbCJki2qz17+Q+JE9Wausqp/VtdbZp9XLjYTNzM4NBdYJuxmaAqfZPvnC6Wl09undE2N1H+8vEpagvL1+AlXiZ1d9TysfM8ps499PW7un67svratXqT5rm26RRV7f/VThEuhq+ec/tf5z9v9wblry5Zc+fXp6cnp6dj3dXWmTys4X/j9/8K/OPv28vJDJ2i3e1jzB+6DEMA0CVzUXDyXcP21M2+buyo3pMEGWubty6q6ersObjypm10qvxL5h1i1iWAYxpGrTMNfXrV3VtnKKn8u7tTjvG2atakE/KwZJZANiAHBrxuoduCFrqHLwz9xtq/FetXZV0Fh1U4HC2s09PE2MvgeXVl+yF95mJW990idh/hHeI1AbtJZaPgsvD2hkV+DbL/1f0FP/82WlRDbK9y4vFSXwh/YS1+yE2st9rxNvThaLQLaa8+fPbaD5xLcFPJrq2OJk44yMbHGyeUZ6tjhpnREYLqS4xLVHUvbIMJOnwMs4Pjz8JNmATE58K3mqHHZIL4gZPk85gKcWFds+XJ7BLZfjmRlWBHW4uNb10915/jN5jZFnvtAtEquxfo1GD4MuBUst5u77IRhQ3qOcBSa3rGWld5gfMRywvp3Vcye4hC6nhPeBr7q/0391rTYLS99Sz8xd2ifp2wYkfXDHOKv0vMA1zfnb7CsrfrmMO5+f7nzMmlo48JdqGic2T9MurZ1cwxOL+LafCw1W7QkCMrb93eTtkbaza4zHY6NtCN81yKG9suLvRBnT3C0wzd5ipnlA8q9JtMcQbHMy+/5D+5AsfBShvbLikhzqtwMyj/XtkCxEeMWlkxjRfkeza6aweYVdMQd24x3Lz+SDqOgF/2bEmGelCF/obx8mxLAAwbklbMM0EG1KEHuWxp6oWBCyjCmyThqvV2o7wjaujWlCIwK7ql8mz9pI3yZHQLwMjNDa8VDaDlZX2/AL3n8P1IPVQ3plBms1UrWS18pRYAkWD8ICrJ5hnWDBMw31Wk0/L17dEYjyOfasje8QJ0Ij18JgUFR+mWZlFza9k7WK2oY+fbKmfpyeVpKfq5ZhJTOZ58nxAdm1Wtvkq/hkc+FRVGw1yL+xvP6Fky+sVdJNvX0KHMh6Kn7z9lrNSqS81wpgfurkXEFyXSO1RmsKW7GOgS24b1jkM8U99QvppvqL/1jvrOU3xtfgWagweCujnOzK/szHfmztk21gs4Cku1iudTq3Q3n2ydmSpXutH0PXG5Gf4X2k1x0gdCSlFvx9K3mqG7c3B/9ojID8GWXrFygbLVC2OKFsEclB3g6Jfkiv7QDRSUbRSzDnF/KIAFyf/v901do9ddENysnaaXWjWjnbtXZPTYhsTE+t3TQWI/KhjakldRXmbrt10mpunZ26cvuktd3cbFTh9xb8hh+bJy2M2KifurJxst3chFD9pLW9tbnRqNcg1DrZxt/VM7l9srVRr53JrZOtJGrzZLup88qNk5YKQELjpLWl89RPmtUz2ZQ1K4UTmGE369n1FqnVp6fuqmWZJ2TnbFXxqJ/atT6d+hA0pLG6blbNkxqw2LXp2i7+wv5LFVmfru0WwzCGKrJeiNQ5a1NrTY2ZrJ40t1tnVR1MYzdnYjKGHBfy53/m6vfCSv0FIv7RFl5uNYXPWU1ULQ3PptmzKydfqK61yM6nzuAtJMMikb1irgBnCILvSf0MeImqnSzDXcNopw9j67i1GqlZJLZn41dpQpproJZc8ZO380/ddRCr43x4R/WFRepdJVOcNM6scnkmFvR6TkG1Nyms5aCwlmmylhMaoh6Ga3skeTeuHU2z/TEs7o1pq4se9zlPNpCEAHyusBP8fG7r2Kwu2jn+2Wz+SlqgsbDAL80UWDuvzDaxNlOEsWXbk2D/BPsTi+xrndT+HEnfpmz/M5I9Ndn+pemiTYyxn/0uVlhI+dqC+bXFopPZbGfJhja30S3Z1YBjCpKn7L0dWMu5tRl8nJ0uXLTTZUv5tLL6kl7Fjh0v3PouX3zr40Q/t7l468uOpTibeQ4cRrYSBndHLHhA+zB+lTAALptBkGIQWiaeSgmH0BIIppUwOAgdmE8SIw4HTB/ypeeEPbtKXLtKhna847wy0qPYz8bOSc/9eum536XZnzn3m8zE1NvjmZhGuzsTs9E+n4nZbO/PxGy1787ENNuPZ2K223dmYlrtN2chrLYfzEbV2vdmo+rt+7NRjfYbs1Eb7YPZqM3267NRW+292ahm+2g2arv9dDaq1X44O5rV9hOzP3+82bOmzurq7OHgK4Y6Unlp/ghwRR2d9exao60fP9s1e3adDDV6JEdnQ4tce5EW5ZJzM13z7kum1T7MTt56dmNJeUDZXHmzpxBt9pDxOKtrUT1g1dt+zQS5nfTsjSIcaS+eX7JWt9rGq7fM/pZp5Top5VX2Ux8DS/nZ+eNQXf1nCyPUsxvtz86dOR5nNZpJLwDIFMa3zJmu2rrdTlbyIm0EmuzZm7lW9otj27O32sV27CTQXNpmJztgKfTg7s09eJGaF0D+eLb2l42XdYXbbePlpPJWe/3kVfvR2XqhqnwjPbtWzdV7B+rVdZXLZs+u1XKpb+IMvmwsTn0wO5obi7o2s+ruLSh0m6k2e/YGcdbWclXdz+OXeYv1qlC4Z29kdbwBfImxpg7QEzbTIXU4Pl9dQgVg92gbjtpUDL2SYBEe3N1/8Ll7hzN1NeFY+eFolJyCYc3NG2p21Qala65XreJSOcgZDMCYbbbza6xn17ayvK/P5N2+Ie9eLq+a7Gau1SNI7dlJXbXtdr7s0/xU4NRldLXVTq0boNKtXKUPZ8Arro0ieE/mmliIX5+Fc2tFVLPIlzCylo052i84efuFt3IveSv+xiVDOGK3QBVFTUFci6STDmrnCkzSrmeqXNm0ZWl+kgZ/E9JfLgfmsAKEVdcPfxI8LZfDYqJFPmtaxLWd1VoG62sprJhp1czVsFtv11KtVtIVQZwZ24ap+4qjNWxJbx2LUJMTVzenFMwB+9nYJ8B42Az/SGkI2jdUPHTVZvgHLDB0bclYoYFCGkoSdU91og5Nd9CbYilkSywYRA56gYfjKfTokysMbJb8IonpQWRfT1UAzTfgDAlDeBkwDWkNDrwznX9FPg0HbPwWeixISzznaGvJKU1afOkBF6YKHgcOFcy+Vhxtu0rAaA45bYMFrqEbcOAOqBK09MBrhv8oyL1gCgck8FyxGjwNGg084T1lr4mhn/VaiTnR4tdPs0gcVi3w8CH1vafsIfej/V7fxBe4Iys/4kY3DH1GA8O2AXnCXkmolF39t51lzs/NonKYsqv/trPMxVkUyS9tNaJDxakVyS+dSYdm53sRFGnqbu53u1hwHkuKA3acpahxy2Vd+v7yQmjms+0uimwvqfMmfFzU3MKcu0vi28srX4CHohCUciU5TMhPEArPNW10kqgXdDBVJCQRmTYBYywx4CFsH+PSIechNw1PnW6UHunaH5WcXr+0VooYK8HVRyM5pMgjUgpCUAQBgj3qMLi6mYQLIAU3AKDrXgBAkSQU0eiBjlY4lGSyZsmDyH4nllRJeJZuiOy3zpqGi+REJL9Utsy6DG5AZiRcEJ6qxqDfcPKDWbTZFCplnpMd8yT5U3XOLPFZpIxTp0Jqr4MH4ucxmu1q8q0VQ20GRDwLCN9NA9P2TN60upW0ukohh5QzEfnKF5XOkqXMh/JwLCqXJUuZD02XjFmR/nzCoVNjlhufGwYmGxEss2QMdOeXQZ9D+wWgG0qZWwBUb6RsdiNtF88yUwVVyQtKwsKd5O44uKcduKFRQcZCnfAzGJ4TfmaLE36WcW1Tk0l5PSVJs28iQJV7d4/vPLjz2cPzO28e3XnzzoPPzUIzg+EzayM13UPNK7dPwAbeQIcA8BfN74wzog6KT84SaFD5dp0q29qFAcvZELxqV8vlYHV1SpTuLT80HI95odNBcl4wfwgsKsrrzjyVe4QWi6gTfVQaxpEoDeglK4kBK73cf7nU82m/FDFhWDt5DeEJKFarO9wW6WGvFSiFZSKdeUHfDInuhWURncxPqmcWCRMziFUIa+1gZhM8XxFUMDUZWTfLQTca7cjyp2pb1R0J79HL8qfwZ1/9rO9I9eK9LH+qsYHf1o613vcs4tl8h1Z6IT+kzsA050aR/1zdtqtqGIHe4izDgJuMeNaOOsHQJ0khCaypt5rqNlHNSjJV6c2TuejEn6Ta1ELZKUn1qsX4qZVot9HHnSM0YTHDNIGzYXjJHgZjGgjmZulFZC4WL2A1q0QhF6a5yCSzxCp9Ju6i+tm01kQ+NLUyhBFgcvBKzjRCJGYR3GYnApYFz5dF7Ogz8Uam5jYtXZpQO1gNYZsXq7W0Tpw8diJWa2f5irI14dlZ6qJqXw13RVus1naUUa/DTI/UrJ3EgGk6X/crtFxOM4tVOE1LteysOLwLJ2ERXc9zMlJ6JiMLrIwMnUFpQvpMgA2P0iJm3Pry0pi8rKzyw7K0LCYvK5vy7MtK6wzLyoN0MrtDL6sq5rNdAI4Sy6bxD3MmTrPN5LfyF2oDTK9u00B+z3+hBsCqa3kDbMEmBHg8Q/FnZPGqRQTcAKpmJFxRh3Qx8Mi0UqpOqJ0Y1GVGTElJz+Yn4VnhvM5kcP5cJb7tJcWiV/ydaHXV8k6is0qULhqxihG5VWTtBHmaGhAvPdgNin0tsq6saNtkGDtzMgtyRyw7Z3sFDtlguzCsLPJVjOxDZJ5eqcWArC0Di/uTZOuu4gHY7OCogREn4dkOn9+7AuIVumwRnUmRbs6oUEN5H/vyWeqbHmx3dqHYqreMdk3T8935prGxxKZ4hugvaHieoSlciUjFDUSrYlR28JwIH4RZFjEUJDkxdVe0V2poAMfmetQWJZTKAgeyghpChJ3AGYT8WHXIarMKOgx7QPumNZda7GEOvfN6K6+nwEx0Kla+i0kkypYsXSYPUmWLCYvpBBioXyycyrLC8Wuekk+VjfvTYnYd+/mFsYI9r25NTHX+t2az54wz5wyVX0AB8aKag6l1trNoNG0xNzPZiM5aOrPZk/mkSlByzyWi1jk2r5dre7RA0i4I6ySVsttFoTsls4ni0mhUahuVhkFYRSFbDvKYsArQmge0b/uEJf21rw9Re/aUvKbtz36RaExsj8kb+mBeMHIPlWWfJw+5335rmlSQFB+mxfuqeNtJC1/qshMs253izQ11hY/Z6yCm/bw8VbdUsrN+j2Xbz/oXXjldp6fRp19d91KzmDRnxNLJODkj6npWyMzrPGKt1NBueKWqr9/BHYCEGmSMqrCu9YG+yDY6dbSPO2CbKbJ1DSSjnVhgIT3A6oXeCWEDzB1Saz7meaXTO4urWA+Oq5mj/1/Q6eseGGBMk81npTadJiLjNew8oG6gsQjhN1xGnGZD5bOcnQoJSEgo8UhEfBITh4xIjwyIi6b4IrxgQUT6qK/F3YbbVRLYw1T+eSXY4bBt9kD75HuK2Rqe8DNU7ltQZGRXibDNUEU7A893OQusHHP9KrDba2tYC8B77oBEAkouk9rhiTizVG1o2DwQQ18zkxSjy2XTtami4YEg6194hZ68ehqdJVjiWuXyCOz/R2trxGNmmhXiV1ctsmKOXq0iK8OuRL5eqqyzciUQhD4eXZl9G3BO6Z8iMrD7lWy8LTR+S0Dq6b7aVTLA7KZnkZUk1kp4dhxgvEvh2GDTdsl8MIrb8V9Jsu74q6sWq6iRrqCyjwr2OtTYO/HPgJODiwpmbHup+IYJ0DPLKpejBPnwaBH7SzSUbS+9XBtbBFuHMzFSLILTE45YYJABZ7120iwRnvBZ2zCSkqurc2WLzaVw3dyWQgWdZ20Ncnl2Amq8mtaSDCaYIamfz+ltrtkCYtqg7oCpc6gww3RMhEUikgTFag1kZzTBh0kTa2s7gKdq0lbsZPrK5dyArdiIy4hbO5ZYW8tWZMyU5OqEnFV47DPNGWFprzeB/qsTOIfZ3Kw3tze3LDJiNq8EpsMs0oPo1kZte8MiAxXdYzmbKRcb8Jm4VtaobZHbTjic+PSZ2A+DnteP2nRqM7xvoDmhVJsmrJw6T5+wKT8KYeXcvIaVCTRVTEbqDSzGIYgkMIJf0JsH6Pi2bZyDI+oLY2pV4oiZMYN5y3Xf9SLwnWieGJryofUraKaGlAtQlrDIOLMUENdxxB4GEe2xNyi/gGcn2tHUpqaFHD5nAXBBAmxH+8z0ycLsqb5NlMt+uRzvBprfPPSxbdNwvUuDXGfDNmCmZXJiDHUlhkVcGvQZD+PInxwzcScIGH/twRuvt6/Pz3Fw4unUasPgTWFPqVDXfS0ML8rlfMg0uqwXcnasJQPdfmQsEslKrALLEAV7JjpCcK8bC2YanPkGMYIQMI/xUhByptz5g70mcAwuq2g7KdBHRvZ1DgFMy37VXDBKK7WplZysDpntsgzB+imCzRcT9kptaqcmzIkCo5ZoP1fsLPGkdrabD7Svkwa5LcDQb/fkrH2S6ilxNoyz3Nyt9NWtVcoD5na6YSwO2IgzpRktl02oLPRZZUx5YBpzwJZcLwL/hiUHxyLmWK40AgfrTDBe8qKSqytkbinyAoeVXm1U6luVaokGbglebit1WUmpUFxQ/V5uVKqVasWwyE2woV4cESGRCUHT2jk4OO88eHC/fWIol9HGGTm6e3/vzsH5g84vHOeGohfyoXFGOq+/fvet84POg44qx5PsGAqm1vRmIGpTsrHZqG63QTAn3LJfvYZRKil3KsYOr+BS4hXXFOQ6MbUDjOlN9fkWaMPM7S0LxfIAdHseRDRrdRC7IcqDlcnNVrW+UYdlCSRtq17Ty7nkQNpmrVq3yAiO3Hv2aIealhnYkWmZjqUkN8fSP4LiygDKVVnH7a/yOELdhKZbOz4TJWGj8nd0YlaJX/m8b5nMOsObmNiV3fRXW8A6GVWOaY/dRxpix0nalGxubtfqtxuk49fuvnXeefjgtfN7d+89vIeDNSIQcff+nc8fqsEjr9/9hbsPH2BgQO7dPzxPM5zfhZ91THLJwugh+Wzn9TsHnQeqtj7Zv/vm0Z1feKirwchLcv/w+MHdXM2dB3fuvolpEwKeMw+YevwmDBQN6BL1oID3lGF4nIXf8sTgHsgbkbg7Qv4eMhwSP+yHscKHKx1YnPWcjDjrJNXdGYJa0lMFj7Nm7sIv1cXObOziei+ybPdoFMF1T4zfz+I78KiNPiqBpLu5JMdhUbQfutjBo5AP78HKV8PxeFm+PRp5DvQFRJ1cxXeyAvcZ+n7G6DdJQlxwADDuAcG3wrIhyWq5R0aql4WkO703GXOZ6tx9bOheOIpHGH4jWYo2NxubW/Wm0lAHZoCL0mxutWB9ehhHcVWa2xubWxu4LiuBGSWrcrO6AVbeyVrVC3RkG4Av5yNo0SA920g7apCBbaiJN4hrGyPOztPE8xBnziBD25iP7NtGwtIa5NI20lHCCgwysQ09SOc0PxTGTtFcVktByPWNyIhO4BGqNpvO2KLms/Xy2VQfD21mvyrsV3FTg/Y6jloZfGqLHV5J4UeFW+WmOTKt6U7RWU++7cF82+fPaVuN760aJsdL6yKM8yQQTG1BMLlNCYp9bY/oe9fATDGiTmNp2ydoBuxMbUpGtg9CqmnA0z+GteMynwlWiiufr8C7hX3GH965z9SrHWrREoOmSwdI80hKvFoXsDH04JBzE6G447YdonlVQ02/4tcN2LiBKyVDhg/PtY1C10tDOoH9N8ZtnZTgeTrYqAUVrDSmUUm9LoYbs3p1q3RvJkvwsiipCWJuqcfDYQkA0LkNEEAqjPOQ774I2FgiAzo0LdOzpuBg6nlkzZyZlmkemzqz2DScx6aL22KyAuB2aLV/U6UpsgQKWUISR4zjT0pGCVmO0p94qdsnju+xQNxx27H+ecwcDi6TAP969nWf00CcK2kuKWqQyAlHDG6Lwd9I645LhrW4zSkZ2NfT5MqGr/U6XJHntW7oToz2Sv5wAg5aRbnsmRbwYwquc88F7mCHz8ZHCmA+taZmj8RkVNAAdWGfMNqDSmFUbQP3j5KxalaJU+ENy4xXjbaxOpq90FDgXR+9pRZCu5SY/eRHs/TStT9FfFcLgJSCEFTVjh+D2rOk4C15LjKtCuxHVqaYn924zGsYmjZC+Auft8yehdqqQC1/xMyH3AfnKPoaK6Mu41F7QJ7EjE/a11PcokBfnDD0d2+JQWpe2yHRc5liiVfEEqRSvn1d3EHnB9fDwY3wSn8BpfQcOpzpl1+jBLnCDKumO7ceo9hKQF48VGpA0qHywWLjsc1yI9IWhGvyCUpTPs08PrEFAxfk6fTzx4uAPvyzjHs9j/G2D6M+MyKFbfYcshtYqA32Wi4j2WrwSHEFRCng5zH32hyLnV9mjWWmEy82jOHiYRRTsGW489999G6PfKOPP9Q/s6Ed3WpoUwyNcZDfzK3bEifXvaAdFNRHJD+qUZ7LiElIo8YxvqcU8gj2yhFzsvAIi2bhHlIChDkhJC4IhAlAQwhgD/rYg0sF8QRnkrqupx79/2dQUp24aXa+O7V7lQxmcPh3Pd1B54RedLdz3ACNP/SP2U5FbfyHvR7DR9o+S/2YmRANYDL3GJPhZJjbvmmZl4SRlaqltINJ1AiUpKYFCTtG2H3MHJGdMHbB9r6C/bNhP7meEh0kXSvhucc2r4gwOTdEUfZQ5QZhBHyl0UyuWX8chQEpgcpzfeRTLyClT69/2iDGvlJ/rsEeYRSLXK2Nx+M1UCOsxdxncEDGXIMYv7SmsYe5a8CcGG3jl954/TUhRjregOsqO0Glx2BLvYaJGJMhE4PQbRujMBJGOmGHySwSPal6470TCMahFyFvUzzumI0G+Qheu2TzWefip1YFZLGixxsl+3/m+O6byTl5Bd4t0e7pTKF4OnT8SAIdhRkP0/gdVgkvdrmUwW68iPXrzzB7RUYwz/qB98h2dFvWb6JZPzG12p+gYVYBFjeO4KxpCtYZFQdvu5uaGmqnhthfk1kVXQ6WBUuHuVzOfqsBvE70czMJO4JPdBqz507V+W5uKjh45mRqBmDgV+1HpIShdumla50wfWQR/fPcZcpCUen0VP5cXK5UPuv0kTVVXUZjtE8wlGr8pjvF2295PvxyoeB5bzbbZJ5dv2+DCJ/foTKCKPKElms9PTethey6lY6+yDDNBdoBTpOP1SueoDe+I9jQzARy1yCAo3D89xlwrgtE/w0bDXXsVwG26xsEPNgGK5+vgN4ZzmenpNVoVjdfXJsYg34oBQn3UYx3CvGdkfeLbIIJo5zOo1arbbdmdB4bjdZWXakiUXentB5b1WpjU2kjN7Yb1XruzCQ206miPcH46yFFj8jKiiQMxZ0AyHhkz0agte5sZAU0a3dhfmxWyW2QlYIuaL7YfG9tmJyR1n2OCF6lYdbNJXH87DCvPU0KTgkKvvf8GB6bbSuKc82ZGztAsWmi8iRUb+IeiVJ8jKa4g7evx5yOkl3en05zKO+oqScJOhZ4rutMwRhOZ5iBawh+JgqDNiVqU257U9jZI9j2dk/g+t0oDJJzIf3StfJtGRl4SqDjcnpNAxzSwbbRh/MZ86RSqUREZNbW/m5oXp+Is/b1JWzz7etUiOSZEBlMSaIM0WskPddJuz1KZMcbOx0s73SYdJpipz2bfpJOR3ZY6LSX73S0GxQ6zZPuRbPdmxJcRi++nJ3c6tzemlmai9SRG41GqzF3TKBWbnpMcH0SV2a062dtTaiQeibEVWk60muQwObOKLwNwq0pOYkrqX49qwkA5zv5+lBdRm2zSqIKaIg+c2xBZzxlBlmgpXA9G7K9QUdW5iUOliK3aYUFgk+O2RMzOczgxNS89QknwZkt8IIQnlW85VtmoObQsoqdydNuTzGHoR0k860MTcG+H6+knilvchSpCSjhQinRWyD+tvB4nQkTlmzmZU7pLjCDZoyz6hFrDGIka8UAx8IL0lOdDUCgWoF0TnSOM5Itt0QuLshQPspQDGUoYSWmAGktuptnRMvaOgwbGNhILRqq4pTrI5UCDoGIU5h2RSFDzZPRqR3shMpSJmHekaks4Eao5sS/NX6gY0WYBJUfR9UifGEvfNULdYC0HP9JsKj1ytgTgzdigRxDpHhBRM4iPrKK0u0i5wr/7yxHwEDBUzyCuvW6VPthth4XHlrdurY8XIUZyalvXECQKUFW4HaUDWhHMHtaNiFuFvMg7BSOzrrAuOVK7E3AhiDSx2q5gkchB7HK4+rUXx+rZbDqozUv6hSjzsnMAf7xTRR3s7bVbM1Q3NrGRm175gCoVtveRmf/EBfDcSw3W82t6hb4IoC4kUUGcFC0Xa/X4ao4xA0sMoS46mazYZF+Ssw12b4E/QEjE1ghw8q9GojHgHYaOWfpMpgfdwt5E/ZYmfuiUUthDwW+GG9oLNgKTbXc+mq5wZ1vpOL9yuteJGboM5CwOfosSCIunQjCz7ClrEJrJ7ADxDxAIkJtqn0ioTE8OBIl44STZoWdpdgF0EXtFE0V3urcf/POm7/QLi1EpBm7hCWWCHBHKmBXojSkj0Ne0gajFUNRJ2oHi4eMeEvHCO5TIfGe2cMK81PihSFSY8tuGtuSICcB8c6Q26OIGIFCZ3/H0OeF4KtKa6xgV7PKZa8SeU8ZmnpHyQ4AumPDQoEGLgVfsEKLvmrRSx3uK3cqwhYJvWNI7UhkR5qg+H44Zu6xqpgIS7vEBQhJhHlhU8JZ5yo4JYf2vCbiY9rDLJqIxbIiT3BIAA2oLCZO6XpIqlSIsGy6g8zkIpkpjrueyKZQ2K+i6arJ0tGu9Dwe4U0CPDMxoSqmSzBimoIEQHGBz8gmV+QnN5FlPZsn2ACNR7YoTrQyKAb+RsFf8SL4Y3pWuYwIWPGiN+jIjCwLgKB2NIsSdDFKAAIUUQIdweK0i0qUg4FEmuFIV78uQKdTcrWE6s0xBMlqsSxyvphiFGcbtdfA8xUkfT11xbEQ1u7Kiq8Xr5bx5xcuSazY1+Dug4NrtoeFXJy9QpGVFaU8ZpaVJAiyUkMlk762ivIDOV7S/2TXB20DQUn8djsx3OWMhdpjg6mV4nuePZhZFmSO1GZ3RlKGAjk8rcOkJBwxZQjW9gi7EpyC5GuDC7nr3M7MC2NfLhfDeqTze317Cb0tl5ckJHWoHqgMuUqKJdPs6YEAM2/smS7osajtExCMp8gXNavN2sbtZuPhPTBBOlec37GaFPLg7i/8wuvFyJDEI5fqaYOD7X7fVwGPACeneZooN6EJhpyrkgYJsyhVPmcGQvOXPpWiLUgVbdcn7Azs4TNZ3ZvVyoXzWrmooJXL8VqCzJxdok5OPFcnV1DC9Rco4awdBji0yC7IzKNdXqVtqSlr1eubW7ebMiz4OTr0VX/U9ORVafVmKnCH6bIC1W56yvT5exVf6cYy7WpmkImK9UTZFbDxA7jPHYDKNa9stsg1aI1IbWur9sm0C7XN2lYD2V0Th0GrGACJlfavXt9qbWvtX6uqWV6z0WxupCxqjOawr8MM5YbFrJKwMjNesCXm1MBOpjMsatWUlmyRCi2eanOwKKd18+e0brSgdYP/CIL84oNFc4OltSxhOkJ6AKh9fRJWiss5lblYZch4n5lmlQSpPCUqeslYFjkJK8VFnxZNzwrS7KlUymdESk5WAiWXwSTdrpt9Nt/Fze1GNacLTjuYdkZpKdSpzK5ow3WTKUEkueXYhuMAepJfQJkwtjeBs+dZoBRmpstKH6hiEM5Tr/Eorc2nub0JVsmUpHBzTYkAsRLiE05tjqc1iXYorBSBM5k+GaOEWnlyya1rnr/IqM+8uD4xetXeqFZ3zbCiiC9owuHGHaaZBrzZz3QLwGq/UC64KIWeZLWwg8cuJs8dVa0aJWMVH2KyiDCBjbCstlDYN7caub7qguuj1mpWbynQMwE31+bYCGBQdgceUOAJ8nLQG4ZAKP35o0+9dM2mj6z2GO/uVRIfHHiV0jaMKWlstLarL75KvfwqrW22FAqbW9XtZlORtMbG5vZGbha9lPacBCn5mCFDKaXJ6+uvky1XYVbuciMzK5VK5ns8tcRXVykf3r+zn2ijzUX9t3ZExaeTMBYJ/cf5OmBsBHeWXqNKRoK5AnjSyqL2dcaXhGlf7iZx4KsiPZeYTs9ALQ1DdHvljdbJRA4Pff9BqNUwc9Bp7Qtn1J08CI8xt1bAJEX19Qytg3F8RvlxvtZjkp/VznLChHNa36g3t2f0Mlrf8ly9DKI76mXMjc1as46aGbgIhHqZRIs+zKh+H5OHmV7GUJN1rvp2LkK0udWR2DWdZIBGJsdh5w8licq/mMHOO+NL767PSsKE4jl0h3M6gXnz7OqO94rY8VZXLXrineWkYw/9Xys0tQg3rYqrp88L+hZwKaCoYUSc2XSH2Uru3GXtE3aWInMApAVmGxs84uHwTnQMCr5fZBOTqTeIEvcCyUUCLHvikehMUdyV5GqUWSWjiqYmFr6qt1O37dQ9wW4x3awStxJ+xjIfrb90zYK5ReVZ08UJkTV9ZFntWq7qcvmF635k5Ti2IgFmSEHHQADN2QPt/ChOLVIwlC4s90XIkFdThlN1vBEW561c1tdTqb6w4RXUBTVrR/kTBa/kqOwpKiDgQSZj/eYM6c7roxIg/wpiQQ2AbihR/RNUvBQrAEce5nDGDC1yEpMR3KPr2YZxZkc7qMtICBbeQokz3v/Gyk5GcK+tcMZrnONjjHPXl1ITUBY5dAR2nTCMJbhnVxoPPMGiEXVYCVT8pUfnj1KVYBxE8WgUcpFdUCIloJiPfq5efYSMAKNuxQCaCeTS9AszoOT29EryObikKKGidqVqpWWUFZS5sCdSQnRPR/eSaOu/bw8jDU90yx5GEIKAJviwLFHFk7Fomf5tZiUA25lsFEBorJ2+aVW8yAwIakgSvprBtV2Tz+yjM7uP2t2KWQpbkQlqlfM8YEAb0ccKr/SCDBh8lQpvFgxMS9+AVCl4hbIiwoKYN080QMNjpURjkvJSHRsZ25mGZjympfxF6CCBryQ/dD+RHgQ2kpDhKAbjO7j9BlQ6WdAG7UahHwu8XRBURmGEyhNCbbG7btJYhFINnhx4rssCa71diLbWcen2vCvmFqtImGqO97QVZWI7QjndysEI19gXwCgsYq7ASStwZJ5jrBTqLpepumseVMJLxuE+xWr283O537+Unv1mosF01ohDoUImcF7PsivnGe8znuFajmf4nasFfNHhNCeOXhdRuZ07y8liDYvcQPOy2U9OvXXXgt2TPBklkNrmhcg19B/Mz9onZ1Ny01YObehzdRJmbRTJNN89gdT2bAMqBRuZklROvz65zInF0WyfCaxptZKLAvLkTI2S1igXB0pxwyl3jvxqV4v8wPN/MmFiq1arb86wnVvNeiuV/b2ETODl2hK7EixwoxKtpLyDmikew/Sb1nUUjxgvSgp43oKeBY0wAEHQUJplLAieX/CsFn0Wwe1j8OS0YEbnxIcCYpoBwbcKp/qCd6p0orM3t6MRDQxyDf4KsE0F0pTMZmQkAyk5E95uNv/Rxpub9UarUf2nG/hMqioOP85IkCmD77ioS6iI8C6aKqtzoetsAbfpTDK1qZTFpQrLiNw8g/QffwY3tqu1j6FRdHMzqM2TijPY2Niqzh6gK6GsIKjp25cFQU0fjPcySWyQ3rXMuWxILtT3wPA3vbpwo14x1UW1mdrloXz+lt/M2QclefVRlOfN/anNidZDxcCnJzZXvpkDcmQqd51igfJI5JVHyZ4V3aQcMiwSFFXEeOaYqolNkdgkZ7WDhshSKiKLJN4tDIQbn0yAI1VzJcldLs9DWi7npFItMpZQLjEe3n/d8ILSoPL5XbSNNj3TskxmtU2R8SgzqEoNiwj0ymAzK3mFFXZ7/XqUE4JbEB1t2/ag8vlMa5LkyESF4gg8uhdGkdf1WWkIDMqa9mZS8qIoZrulBwNWGtG+uuiotDol4BtKyVuwpW4sSrT00nUGzHR9vfTw/utYBLABbpG4ldL+gDkXJTGgojQJ4xLlDG+QUSHYcCSAERchNqEgKWlIKo8WzEKymePx9swMM2sK+FMJudf3gpWZ8VCxzx8Nh4dRtKZyl8z9u/ePrfyYQP+SROi7+j21Sm7IIuwXumEqiWT8zJeuF8AxtbJxYcntUdW3CKOckHPmiNLL6n74Gty24KG/1gGjgbVPv1zS9yAWj9L8yMyep0ynpmXtLFlDUYyNGhZJMhyPmGMKpRElVN1AUY8LRjntK7OmDJ7j0BnIkup9FcQ1igyjOdcDEqvbHwwP8aB15WJr7oqHs+CCB7pKe5VZCy96OIuueaQlcjf22kZEh0xjQnbvZPkdGbgWM00ujIzICK4Fzne/nV2ROEG9bzocJKFdJJ0AUtR1n+XP0f2CJgKeDSqKUI8O01sP05KncDMMWCnslV66xkN4UNskb8PAetUHoucaoHNF6Iz8CWaeYV1eJOFj5x0gJpzrrmZx/fzQGCRNt9qsIBcU8rVRPQUH/1qxYlZJLzFyyJsCFGu3pFQq/2nCAbe2bnm0+ebhW+cPXrt/9603zw/v39cHM8XI873Og/3X9DE0JB3fO9xPc3uFqFzeCBPQ6DjJ65P91w876nesfp/vfQ6DDsmva+3+Ix+1h67ylBuQgI1h4SQZB7mILJtLspsr2v8HLknt/AN/76lrEZd5RqZZq+YkZcb5ecDG5+hhOzhnnBuELoo+70LLBvGyREQjLBHNRyb5/SwJzqtV/lhFIowGcXKh8+7EyLMWSw/l8egnYtxD/+24Yiwkl2nh3mxhuvA6zmA2m7cwmzubLVqYbTibzV+YrW8mFtRzJmDVhSZg1bwJWBVdIuVbiRe2cvlJWwHkWakWWnKKRGVzq7X9Ca8CaJvTAmutzzsKrPXmRnWrlrHW6TH9CYpQdYtws17bbmxaZ/mz+NQlrLJTu34c4Z50PZ2SAClxTMy8RYPSLomK4DSI4CIkullfbv+2ssJSDXrmY7xI0I0HSWWM6yttBhEWYeAMPXtxHDXU9pImCuaR5kpCJ70ArR51ECx7DKgS72vBqNxuatLOzt2i0hNRmBwt4xQmRx9aFT3HbNTzB1QLl3UpnNMqF+bLSPa/XomWAAHNyDKIsrIXiS27FgiMbJA4HtkEoE7G6kKSnCPAlIfLCoYkWE2PeZLzAGLMGronxYg6OVhSGSVVElirCxy2OcU+p5ejQF4DjWDiFbNcTn6/Wttlq0bIS8aqaAcnfLV2lkus77JVsWqQkqHTVLhktNmqsAiYU8e+CypxalhTU2TvEDPl/x/XzcdBFTgnT5EkxQwQZBuwVlOr73Tqg1QFl6zEgsE+mJo1m63GJ1O3tBqb2t2RiayCQtvt5tZWs3BWzpYY6jDOc3Y4uNPopmDeUnOcMMfnUMWZYMsvDvzlczQNi8hho1Wtz2oaNCktahrqm9UZS309KG6mfIATYSTlyqS2b4P/WNauzlyETd3tPAwuAvCUp+KnO4UtR4/pyaBSZLRuvJYCdKBPeMLVKsYjJzcyLZGYCpZI6bRMxUO6iZ2ypYxtMSq7kwXUc2FpOGTJzSwckMxBrVi+5182UTbQvGASW4BD9ZDN9dDCe0mL4Cs6M/cVyZvtcLIt5JviaGz8on1OmNwb52mmnQS45MqDMtUmKJUYFvlEs4aPeOxNzLxcgPudZX38zv1jTSd2+P9Ck5kIJrefzFB7nbCWTiref/+Ek/piPUEZ6nmXMNXz51ImTJGuN7sfqe9CwrbL7dlMC66rpC++Kx5j5nKAut6Q83gA9Qmg2MGJSAR9OOXj4O139qacMpu8Vs23w2mun+d7n7ttV42E4GaOeYMX726AA30TbLCn4b55uz2N+v6hKqykaZ9GAiPmzdkyo1OlCs9sMsHCNlURFLQCKcLAjpygmaWvJqlC2mSjAi2b6iJBq9X8GJ5MwwKw1VaOcQizPQ6Os8NRdOT5gvF2kNmj4S3pauvF242eIxnNMN8ZVFHhkaNwjsUEINbgkcEiXRNaVtjcaL6Ygf/rnc8lvkpDouOO7rz+4PC+nnwd98bdg0OtSYFr2qqTiX5NHVEr3YmK0kOpVCipmZ5DlMu+N8Ab0pyMkndLGabGaomCC0Oo1Sgm9LAl1GgUE4boeClKowGKnFLCv/GmQJotvp36wclug62Yt74PZkmZD2aXwHBpdI6QT53VU4DXiqDfZjisQfH6w+gTX0ozjJug8OahgGFuq6VS39qu1z7ZWtncatY1PQFU1v4+4NRJGfxvt7ZbhdWyhPdPrCZS9j+YM8Mv8v055xfZoZqnxAEA6pN1S3P5M15MkHZ6aV93kusp1ydepbA8Zw0TksWQqWtJVkSt3tkiyTIpFoGlfINFP9pGBYrDoPP3AyqIAwt4eyxmkEIpuKBrsgSegHDFHHiVHIFZsG/qp/By7RGaiwHsK4j12v4fXkGENPAsn9DIwCImRSvAVcNQJ8ob1Vvaczsx54ltsEd0KEfkosQkRlPB8YCKlMopCngcD4eUT+YUZ/oMOcwMe+nsPWvPzvHOau5Beswi9eyCHJl2xxRqP4UFLCyicy6ZGnQoYhZiofMWCKRFwy6l0JmjLvWF1KWepy71PHVZABw6mFCTBnfWxBn6DXAUGZphJVZgPzSY64mQq5uGSBpuN5kC3B25qQ16NHenQ6vBll00SQeEZ7bPwc22z8Grtd1grdZGOlbb8V4JEhvotdqMFbS6DMtMTtAUeie9SuLPmeDG+WN+Z6pu0B5PIsGGJqgNHNMi10N6daA84TN4uQpd8Y2wlYEdVwqonF0zHZTLKzCVA3hLhcfM0L971I+SgBnZfiVlm8yIDCyL9MrlFS96k75p9qxyuafem0SXNTmbzCrpWRaBR2hbtc3qJ7v3NOMgqnBzUGmokpsSIrtFze1rl3XjfrtKvKAXtmvg4LxdJ2Cp2m4QpdzdAN0yWF+csDMp12rIxvth/3VUplCQvTx7jnu70Rye51BCvFrbFQolQru2E74i8EE3fhIWUSI82wnw5U4vPWE8YWdmpVLhqQIwQhvbmVFWp5uQgAfJ2KmFWTAF88BgLMwCCZgDh21hFkwxLHKd81wFB4d9dcmNbDWrt70KrLeE48PXD/cfHB6cHx/e/6xmSIOEIb1/+M8eHh4/ON+7e/C58892Xn94WGRi59PP7x8+6Nx58/zo9c4vFJnbQt47b+6//vA4cafvJXk6+/hE7+Evdd649/rh8fkbh2/saZii2Xr277754PDNB+cPPnfvUG8IaY7je3ffPD6czxKTtNfQ2fPPdu7f6ey9fpjrm0OODx/MdQzfCgAdwd37+kBSiaHPy9dbkk83NiARE8cFx5j6wDJiQruL3AvdCfrQ1CeXmCKoF8ymH/m0r080i6XvBI4fR4l//EtI7aBrzsMrOhz5LHqDwRPJycsGaVnt/BK9TesLQJCmDAtmE8eqK9CFz1LuwWs0GdSH8/1Bv/WZwHulTmFvzHNOvMC7uZrjRdXELL1MlF6PBseq5xET58osJcIL0mmkNrc4B6+b59odE12WznE2zuEhZZSQFufykklAcSnNQ3EizpmeifMhTgWeBc/Vo22GzpWKKS7kUJMyk8WZ6+f5pZ6bpFejG3qNY3uu9dk9nVEdPd+YdXBj1jh/89y98eZ50WXsQzSVCehQXWIoCmXDZCPCFtqCwPneG+rafs6T8awUujh7com9n/pHvk2t9Lm15nX/N4CLfWwHs/V7N9eflMoNyiRpBZNmsutHPB+oMdchfKkpnG05Jxe/aE3TafGxidsOpv/cwSy+TjFbb5snThvmxjFeXHW+RK72w6R2tYDaIoeBnFywSTsgl9SfHzQnj8fPKZog3FWKcAiRSCDiRK8xLwy01nCuW9nTHbcpPIX7LUsam625t7Tm6VQ/mJFWoydIPLeOk+pZUo04qZ1BTZ1b1TQo1pRmgxqajWb99geiy5yP9W5yBaYVjbMn+nOWzFridDIlxAhNipqtZBfq2eYA5K54Vu66nndwlRuFkljs7ARE/0WSPXovn/FNJHbNxBvVIo+KRY9dqWTG8x6GcIT0vb3UBxEtl6HeKMkKF3Aiw5pvJMoaCQlVfqsQ0FRchqLtkBT8vMMOQIsOgJJ4wyKJI/YkS84xu34Tgc54uUJ8KvSK5L0i65RclAFvlMJRj/JSxsYlB0zylDdQb+5KXCqsK49GU0sbOINsR8vlxKUkhKQ0l9XLpxYUDEcsuANGkoFyhg41aAgLSQdUUCN1x748B0mtMTK/+tF5eg0QLAlPFrrZNzz9+pRxhr7JaHYFNNS4RxMBOz/g+brL5YI9yHyGrM6Cik0QvF+ajhCstdvhDVuAN+cscEehF4g89rAc9uQzaByKFNYkSE8KIwvlF404YuFzUCfWXY6XoA76yAoseJPOInpQcpb4mV4xp56oFKhIJXGWa1o7N6hUwrxKBdCkukNfCXbo6qoVntC81EzxOjkaqirnu3gCqzFPAYL+H6zMsyoc/RKDsyj0L5l7HHcFZ6CLeo6j3kxnYgrCQGkTZoY5pgoqkjSYkkZj4+NYlsR55VSjVq3dwrJE3UwCjWByOSk15Mms7orOF2GSu9S5gOve+tYj9lvfNrkHuyPNtCixHZhGqkbbV07WQEKApxHwrn12V2ThLSClOHgzLKUNa/+FjrYM4ws9C4r8NnISECd3yzBa/NYncjRTMpc6qGsoAot4uO04Sx35Zk06ZJQ1abz0Emc9oHrOLlTVvgEEZ6pbGT3HXbCn3QVnlfPCi60wSCMb/eim5CXnACwzuJkFJibaQICR63DUHiGvxwncGjOMjC1DRsjJzbqmDcRBZ5hwCeIBn9wRd2OBb4kCFUj+3TwVONoOHFZubzWbm5/gccolN/IWuaBe5BBVr5mClVVyexJv7fXSe3ujJff2UEBUV/fgZ+HG3j6edBoZ15REAcOUv7CXOOpOJauKeh6UhIkTYsyN51vKQae1E+yGJ8GZzduhzXVyQtB0feFUeVcVZlZaT08qdaXOwYMCRFQF+kyo91GsnawKe7YkUc3R6TTLn8l1LO/uttDvnbxbMU2CWeo9OnePEKYabSGS93xEkTTh23d5rxSBlhNzbaVcomncCUZ4euJB4D48dYcIYuyHvqHwAvfhQ60ShZt9RvKiLBI2NL0zPhMPRw9CWBoq0rVNkfNUzYBFMQyrIsLXwzHj+zRi2iPncHZ0SR+tWZkdVFLDC9Mq2NLmGIQ7rmGh625rp+DL21Ujdbmjdrjh7lCVSR15K7fvybCPlq/NuaTBxrIUxXRhWgj3+HIOri1i/K9/WDKBpSQl9PttGXMVDIiSuBa7uifhGTrnLJfnQdpKNo9OzsveXP3eYrh76Y0tsZCNvnVFhk+7zE9AeaiHum1YhO3eNFhGySAMvlZ7Nlu0uCVKCk+fc+VX2m0byS9D4X0238QAddqaglA9erKG2LKWZclIkrqJq0KwZsOj0InhpW9k8D/WcKRvtt5iOD6N/73YeACUQAZ8JljbCNh4LXtIUI1FFp59aHDp2GRZFo8NDodjWual3Z/3lnyZigSjuW1X2WO1GW64AupRz8BPEjaiyyhnHNfy7j/x8sSWP+76HP7fZX0qdbthkeE/AjbmV+ci7MIhXtOa7duuO0C0ySJEm7wYorVfBJ/YcFlKVyeEFjFKrz14cA9fU829lFzw1aM2wpJBHr380rU7ffmRusXf3NrY+hgSUN5SDIUoZTGxUd/Y3Nbar83mZlO/CrS1VWtql2utunYLurHZqDfVFo+cp34MeavZ2soeQ77eT0WgzGIIHkjD553TR8NJ7hQn59ZOHQZEbW82JpWN2tGiKg7RjiHnKjQVqpIanTQp5fLBg0vedx7BAfhksuWiF6aXy5Y5rxfIMPspwxzlGOaUk1OGDODvSZ+VzLFyM45EZlkv7dGcpVqMxMUFcFuzsfdZD+1h0jtV+tn/qHDRKotMHDLHNltAdJ4jXmL/8VghA2ANempMyfOL5FtakD9ZdtENREtYFol3Z3MEKSGN9bM/1nwtI55SvhTtSo8MQonxyFgADTfIkpqyJONeOrIlgxQNiJQYDcyrvp2c2j4nnvcyKyFPa4L1Ha7TIG/6bCo8569Wd7XrodWalT3vuirawkrCUHJqVtXjAaq3dQt49OvpTX0EkpXoOvwpQWLxT+BDBleT94ndxswd4Bc8yMydrCwQziCmF3KHPVSWfVbOKUtlrnqTkUcvXYtp+6VreOJvas0BM3cE/48A0Vwb5nWlUsmfzuaAnM5A2V8wZKZVhJAlEIqlECpw5iozH4EzgDY0/2hRw3nQjezIJNd2/ujw+W3n6zOvCwPAAYxg+ojoA0A2JWIepPSl1KSujztlKVCLajSTU8ibJmk6s4+Es1skQ6VVErppa0HdJCuXV0RBp5Y6+DMNXYsBCxU81BDPZrsZaTfa6o7uzjInRwt2ha4fOhdrEdOXD9JdIjHymJIXrmVN+cS4VVFBuzdkH2wUcycNCU/4zJiSbEAs6wXgzG1sa2BZPbpd68kV3CkxHgxYBDykR4w1vMRYCrG6CJ3hcM9l6K2l74dd6id+XHSWijEPK+yIGkPCxIz3WB+BD7xFS9ZaYAeVatwK0cusjLLcc2lkbpnmwMhHkyVLJ82+KBH1sWDmDJzvJ7vdv3zbQoa7tVHb3kDWsBKYXv6tv/So4EgzAcpbEez4ep9zcvvcvZizF1W1gh+YyYGCe55AZaIWcXN50JJAyiXUalaZyqcWEfoNoKU63qyuVKIzUUF/76mVvBuTL3wQDgvl1UCJVP+rpL6dhQCJRLublx9NoRS8JK+uVfkzOFStyC/nx2NKWFZPkmn68M3jztHheXr+9Zbn+/eZw7xLdg86mr6hqrqNpeBcUP0ql/UP8Jg1o8Iulxf2aw7OqUVWctXlQU6qmKt0DiFMlts9UifXydbAiL4pt1gvP83BTgLgRb2n7NVq5vDfNMBxWYczmokJzyeRYHK2hvLHAooY5rNGpmXmsp+fKyXDtReg7QwcuhKk020F267QPC8BDYZhaA09n9M6pAiIJ7iAmg5Y8eK44fQaJFs+MYkjxl+jEciqzEXBFw5+pgRF8Fs/6qDnZYkBaZ789D+G/4rN7drcOY92zr7gnIf0Fp2NkkFKvPBufX2ztp2QsWF2vSL1B5zdXdAWjgY8yEr0o53Z05WJgEnt9ABd50gsLPFYohiHxxN0N3uAM81Lsrc2rbYHx7xVMqhceZYZEgHY4fixy97inmB3A38CKiUSJQiKeWGd+daUZKaE81MsEp9fGOKEz85bQPgCa95jJsCxXJvmU7WBV1TkzAp3GPozXg0vSS9oT4iTM+jtEi861E9FjbMDysMMva/Sn3fUIBwOR2LSPie0YFkMvi+P9XXFzlxK5znGzBfZMfg+jt+VmRLunuczcLxjTcldO3M3hifAeG0v9l24kkGFM7gTeHCCWtycVqqJXyAjCEtqlsGqBlGDkSzSwrft52u04UxKTMljO549vroDUXDLxj9MUexNiJvTRxkWeQAJA68/8L3+QOyDvsEi9yAyGSs1Vwi2Gi28hnQ/l8WwyBsQTFUC+VmB+wJwJWk/HA7D4BAYgQiv5BxM7b5pkddtnpoSFRQyypcY2culJ8sP0ti41Kvc5S7jzH2Djna6dlfKvblX7OCQTs/ikb2H1XQJejPLyqJTs6f2UWE1L8z0MMmULVKlDnliw1/bfrirlj763X+oNRkPi7ZxQbqzqDK2GdiwX6uStm0HSSWaUmidMraUOjgql83kUbskw9DkpIusk2URoPkgBkV2r/CgXGTtoquUXmKWR1aOcFdZICl91jZCdBoLuHm09IVi8pJtdL2A8smSfOCGhgrI+ZYNB5psa+N5OfWrx5kDwNARTKxFgjM6hMJdKWGsPNMyu3qcu2AdRvts3bAWJtLY9cJliZeey1TiS1K+lXmxjNMT5WTox3OHC6lzCaALOeX//nSBYt5LdHF69aiFHmVeOy+p54NEUOqFvGTceOjTBT39kLme8rkEAhF46PxsuVwcvKu18Xi8BoO7FnNfPTDhLh/FYewLb0S5gCcWyuWnWpfKQaYVHosWLg7NNin132s7ufEDqy80pqJHIR8aFhEFanF4JQyLcPt2zWQ+L3q5JyIDazdoz+cmo9uIzT5bc1LTpil5ff6463GqZn19uqBSodQ5i89UBHB2OjEPMreUvepri2ygXiMm1+TCIy6aJA1JXz9g0lcDxRl1YeNPHWhAttKlfbCLm/8otMy+tglQk9FFMwQvGejsYDlPDhIIPDKE50L6BQPV4ySs16lFOnZ/Ae0mF9mL4sOMiyH7hWjtJgIuyUfkjt78hpaUKzXypt2HZ4nMhF2FhaljUsZJxyAF8QQbgvFekni2MDWp7Aw2vqS6IB7iNgsuP3VnVBxitJRd2BDflPIBju992zB2DArqaLCdPZTynpTmffvkzCJmjlxCAtR6P2Pb+mSltphvsywypyu/Xy7nq8uqAq7ufq6EnS+hAEsL5N4bvJ8aBb5uZ0UxX456H0uZo9DH1lJzEsG1xduQ5FZT7ryFGC4VdE0v58kaHku3h9MFK8QtLsmskjUn9M+x4PT5S7m7m5U8x1KlFMXbs0nGlAxJd3d0k9ni//qHnzaSQ5XnE5JcA7hapuSQHJfLS1oolOThaE2vqCkxzJcMckwMy7DIQbl8qUQ+oBSufTlPKdzMmB1fY2DLZkyo6ULN8JrSDF/9IpoGXn2W+iBkTq2P1dvsKWtjSlJqkMZZu/mQasB6YSQonqAtp8+dqUXGL2Jdwcg1SiBujrn2qK/Z9NcTM7h+wTh/OCvhKAk85eAudu+3LzIbnm6igNjPeAMtUZCT4RlYV5OuMi2dBe+NnITO7FfPzSFhFvEizWK77Tu5wN2Ruu1+1xxCngMvgo3JbTumZV5YuyCQXiRP/K4gLblbh5R04i00JrD0idhnbMVRHqtz2V+0c/Zl6C7qrmV+BmjcL9oG+Gc2liEODvxzt9Unc9N2jywUWBPmu/0kUfAqSU8HwEoLWbZEfg0DJcPgmHfwaUYlFKoMV4lo9iA8AqFB82XgDntmlp8nMKpRfDH8ezPRQwUJkkRFUfEzebm3CNB0geHHHXL9ItI3uxrRwD1gIzFo14qCt0L8olRk5eRxZTCc6US6VjIzc0A9WKIZO0/unhpFKH0a9GNwYPiLJFUbqo0vsKT8zNQiC3fRBSh0n1zrJtpPsCfH1k3aCT2FYLwMdiWfzB1VwdY4TJXgwXLTieLsMAI3WbOwwHD2XluhH+GMhS+IhngMYoLOKcydeKUqTHjHCDeXYDm6Bs/fvnQ7C46S5kp72eEMnT2cqUTPP5bhtz+T4S9wIFOJChFpvsXnMTp3mpicxKhX09Gb0FbtExpALTqN2axtNVu3eG9Sqz6zy3+zdgejFBXjj/1cjRqR+eORdNDN4inH3OHIgqmZM6hfMrViBnVmrYrSlvtMdITgXjcWzFTsaHIFXR38Fg9iMmd9mTv9clmkh9fFa7PLrB9m4S+eKxZOqYWJ4zJNj18OPPeNMA5E0So+PfC+ods7XJ91ZROQaJeYLRJtWF7LxBItk7aGirlvWLc7ESpCtWwBLlhpQfLiegp3pdAnPFoqxORP8BKKhrn0bzVQoTYcK74dCbdtRK5z9lzt+Fqkh56m55tJfU3nDPSTepZ1AEYYrwQW1BBojkCt2QNINU3p5OSnAfsT21GiAFTjFxkLqycj25y/xJcauWn5eTFo1kwu0rPp7RodqEazdyE/fqM7frEiU5AQCGdgzswXCa1yeQQP8UjJ03U5mwlWaYirVMw0BNpgZWsyc/2EiMJS4zNLLViAy+HSHYIW6FFkm0VPjgvQKbhxnJ4//cDZR7iXJ5t7/FwJLjNLiZcYVV8PxNA/CvmNedWunT9I1IeP+R1Cc3HBlKiLMHze4Fndg5mrXll6GAmTnB83nOd8xJQUkhdZeZbLj0pr8GDLgjR4YteqiFCZGuKtiN14OWf0/BF29JuSoHMFvih5YxKeOGob8xXkFLsUXfCSeJEhDWbQTFYphxskvkkvGd+kl1RejqOFdzELF0hPOF6YX4plqVaIL0AV4ermuHVDIs2r4HaX49vMfr7g+HspizHVHS40VejxcjxMuNQ2g0ta6OZQIaLGcTbFZx2s9lwVHh4jFC8SqDKqGiBOt+8Dmet/ckHTSmUY5aT/dpyoukOtfVl50fEYXOjxOkbEBNJSVvEtTkdHFBjFBb4OF9xdrjerG43F9uVFZ62ZgUx6i52OvFRimX/sqFw2i1u+0ahUK7DMReK2cMOacfU600ikOnpjIzNt1CtVw5rxDKsKa4PlwH4VHtrDg8uCSDcXkd6KT2oo+eayLKZlzdl/s+Syb0A4ub7LPbwPMndBWZAgvbFefLMZJrakLffaJQeOmYOXRanPREm7hk+kmlZzY7P2yaSaVr26sYHIYaKzFH39oNbcqCF6mHh1RD+b0GzqV2RM9CunTT2qW/pax1a9VnD2PMocxWa3+XP3NKCP+1lCdktj0RuNRSexQdGPLFyJmc0STlE2z1539LNnvLKbHVk1sfI7i534ZGO63azN+p3d3rqFixjtF8ZJxze7IXPiVBb751vso36BpyyeM/MNptmLlKEdwJO9RrGEcdY+mYua8/mKvkIz0Oad2i2BLjGOyvnrQZDICSXRmR0gcx4XDuasmbZPDG3kgs5LCCURQSWWMkY8I1zx6z5xcrZCNxZRjFysn1TbyTfvQJpj55L11JxUKpXRmR3mmcnCm0TZy+1mZpPCE4AYvFSPR17wkl2+RQEt+rZTiWZvaROh7A1vOxD+c6Yo76DxhacLffUEOzfPTQi3WpRTvL3CDC0FLPUG+QIAJVfQNRp5z4eLEk8N1Z3MFV84C9diD5RL4NLX9Atg5Z2hhQVnaFTB6pFoIayZPYtHIjWIBVuqpUOY93L5jzqjs04hF0C0wPHmLUBqB8nlBpzP50ATkJAYicfDm8BZ6ORzKQHV+qUc1cxJsTRHQb2Ugs7xhWgxGCpauixxtnceoQrq5/gdXQL4zI2VeV9vaolQ+wSd9WgfI8Z9fTBW6nnMd5OnM0c8BCMcuEYcVoZeFHlBP13DzyHJnASZPQFB2pl4TaHKDiqtMmn8F9kkKpcXRqeP7yV6+QV5QJUXzjpGXwJYnkQWYEtcphdfGi14nYIqxQ1906/ILWTu4oBdjXBXLXEWAfdQes48g9iM+PBcD7O3w4gc5tJle+PsEFlzOzK1Pv70n5zl90/vzC54Dcv2z92fxRx6qfHM7efw5CyZxPZNQ//w8IYRzzxTAlmFx9xt/rxhR0pWGPbkXGi3OPa7N5HCuWHRLEv7toUMw9IvVFa3bukrKJr3Wdyft1vW7obRcnbxqW3qcxiqu8lsvUsGNIJj5dwxtHI7fJNptPI9PG8erTwO02VOkM8JX+wB+ZjwJe6POyRa4vv4YiZFtb5PFh1hYdLdhIazPdYLOUvOgyHtcZp2PEAHUQk9xNQ3n2PNPy+VbNfnH8ZTJv8FU34tqfQSD5Zovq/dArjZNaRFT7uWcj7qbuu03SKBXd0JXhE7ATprD/JO54Kz7HmD7ILALd3epZYTvdRjXWDtMuXoPTk9TJz/Dk1z7tWOXaAiLyQ7qZXPteLbIpdJvbjIllAGQfissKKtdia3Lj7HgqNZn2WRbnbBIneHq3DOHcxYKYRTm+VHmFA7zI+rcgOYDSq1MvPdoDK/mtJ3RxOuAq0Lll9dsUzV3P2i40DzBO+IRmmP01JgX8hIUFm0xs25zMVQtpcjQpDxP814KadSK7V011g+cJDVy9LTYUqHdZTbPjywD/LUA3Zgv+FVhnR0GAjusQjdzuYzM3gxafeEgXte+J03f7fO2sxKrtigAVneqn2uGcsiNMGCyP7HmGD6sSd4h9krK1657MGpTfbCa2HaD19ooWZCpdYsjJJ92CJXt64pZ4+r1/t5VhZ27kL5TGTUqXMiY1bP8QvQjXlBL6un8wL1LBDRsoouChUh9gdLPFYkpjnzF9jB5GYnsMPdk3CZQMYIXy6QQeKU+RG7DuxleQTkmSHnge4HEPT9/EYB3eAv3A18r5DfKFeyGzqhu8CXd+FsumA/QiQtnGNCd+7muwO7NSe3m5cw1yGq54Vwm+6mSEKXdS44s9oZKi3Ng92EzZ5w+xbZd7jNF/VSWyoE+ZfKc2fDSd+pTSucjXzqMPTXe5/1D69G5qNrcJowfUSMPr4fDmNG4fPYNu8krxcllZjCFlKenJGVlSVLpVKpiOJWb1lYmCxgnOZfpycixzgxi3C7usNfYTt8ddUSJzzPOPHc23czb3I+n3HC5WmD/CbOTmpn0Cdd20qq3kxIdaVSCRaRXzQDBwpwB/gtYU01u3pH73hv2vMvesI2m/C4uT0jz34HJMk0w7GH+YRMnADcJJHWh6yMFgq5kbb+ODnLa3c53t5YtC3POE8HxgYdSGoN8KIiJ+xsZ8ZYBuelYEXCrFeqoClGpQ1L3UDPm5pc0wUOPqWMciX1W1DNjdptZTxliaGOH2fOI0eLH1fTggKdcXWv3lbDtRjqbZFE2ZtjXt4gA82YSS4n7KD+ksyJI+m5ArFtzjxqdp08as8yN/gVR5l6vVKDO552ZDLLImKae0k1R2GiZGfP2iFm3gn37Mnm81eVYgNpygYKK1vr8++/kSC31vmrtV2ef9mK48tWwYKXrdKn9GFhFnlbNYlOevCUkK1M5mEvLFrRSoYrFqDelOCp4wu/y6CfSBrQ6LUwEunDSHNOEvW99kGSqU/g+gxaSCsNBPQkHmrJe0JGPIRzwSh51Eg5GU9fMcqw/pDkjuSvZpfA+eIl0Ghsb9fmXp9Uq6H4hlnhwHrOlXtg8xcceG9Opk3RwGqLYjiRcv1sZdJkZcZ2ZGZLB7qq/XTl3wn0F65ZmluCoyWZC2s2X6CXu8c9umGZOnqZkkEe0N6sh4/MY0DeszxMNs17lKeFY7hd0c5BhQ4iXVuJSSCsptfZ0mejqqg7HOYhySS9tzwxmJFoohna8hy/9wm7DB6MY3Jpx2Rix6Rrx2Rsx+RwfgCWUq38SF/9TBZ6hm/5MWTWLsuPoQUvzfysmsvoys1NTkmz2tjc+tlcHtDn5maV6HVcWWaLk+00yhAEWCGeOjFInGuHxWsDtOhc28u7845IGIBXTG2R5KtTz3hqc+LY1DQSr5mZ7Qy+LwK8R/7W6Nz1AkeZiMUkBUlVfCMoM1CndlI+XNmYM3dBTz5TgkYkn/AxhvrGVlXbrMCsas9IrY3txBVptbq5rTSam42NViv1jbTdzHwjXScuGnJOR2Ho7gg2zLkXzW5KnyuBJ+cx9LOMo3ZZ0OEo8wqKrzP7OXOWMPC9gOnH6UK+R90+y8xgYDsEyP7vg5sKFdLpjgD7fDuEk7s+E7th8T6yuoAVz6UrTFSpiLszd/rjcjl3JzYul/3itVi/cC3WX4jTeRcAmRWop/mnXUO78zHAY49y55Omee286R+MVLTQ2Yib3OgTlex6300LALH3dpOdYqh6OjI/94O8vVFtq9p8MT9h261W4pdXKfAdPA6o1lqZ0RHwln7lHIxgwU/IkPILw9oZVdDNXIXHPuMVFkB/zRNt13oGSsCICRM9zj7AwWob512fglfW7KGr9DaG9jidmxxuG4WbZ8n9hKWOS5V3DLjBkOgf4og9DCLaY+n4sakd4KWrUUUbmQstQziVCESIhWW0yf8yK0TAxxjPLn0tevnLHfW5gEY8jCN/cgz7ccD4aw/eeL19fX4OJt3teEqK3qdQYRZoLYUCybCmRW59p1fJu46yr3PjBkhiLugW+o/SE6GfG1tOIXrWlCAF/dm/npNDUIWBilo3W5vzno3pout5qU8x1V+WbF1Fj2LJm+T+Wje8Ms5AZMpuzK7ApRsxfz26XDa5tovIR5PAps+/e6day8qtwcE/IM6UGAdpbNu4jVNHnnnWBXvS2fxR5fOJsWmuy8VRcfU90vyt0ixzJRctZXVacL6LPNZy5ADLMtxpP9lb0mr2Q/QgXm/k3v0GPnZ562Hl89aU4Kb/32nnVMRGGfhm9vfBDe4L5vcENn8h04iG1PeLOHWpGI21CDgNY0qChf6kF5QApINuGMoQHV5s39yqfjJvytvNWiv/ULvSnq7UMmnWywx/l5nyJldmr5WFxzEksILUm4hj9kqVzAivyWVx5M2g5HOeP9OrwbTA3aJ+vSJ35XbBJUNeLqsXEUkVyNVKzZqDQf1HWvVa45ZGElrndxx4oxETv8ACxoEfPHdi7p+P4MmaaMB8X9sP3Ji7S6OBtia4MZ8zVJYA+4XH2/FAv2gJoFiIgjGA9kv/3PebavVaawuNASqBOVK2AM2NBhgXuBg3AHk49RPYx7ghHHzDPYTN6gYcYqcXYxO/ONkdl2QqjfNPf/rkzMhr6Vl6U4lbr1R3WVu9YMa073JuAY+msggLDm6Z/aqx5paAY2RSrn/h5Px0fe1svV8RLBJKbjReNlZZquhff3m9T4yXT09fftmwVo2XDXKYrwQdfqV5T78Amb/wBcPKxZ0a633y8unp6anxci4eY41C1GmAxdHh+m6u2jW3BAlrbgnT2gvBftl4eZWtvmy8TK4Kvdxlbag4zfnyzxunwcurbAaSGegeQYOPHhU68tI6MR69ZFirL58Gxs+/3L7N6C3opRpQPZoZzTjXt1hLApTsoPRM/KeEvZLWFOH1qMLrofqQvGtyaycoeUEkaODAAr6sfL5y5PngmBM38kelkgF+wI126fo0KJVKJfUoSxuigwr8nuIvIAC7j4jOA6EkD/yeGo/ahjGF1Okjqz1f+UvXeCSQeLvPum2e8tNAnnJ5GlgwAlAFXnDTHN2j69PgpevUjShM9PQ0mD7Su/GxnXfoT7jq+fxrja82yuXU1WWaeNI4280HULaxV2qE2qkvPs++3QETLx4wKcscdrNlDl21jZKx6hQO1oSV526if9zmb2o6tpEnWbUfldD1ORnlYUlvfS8wU3q1unC0q/nRrp61azlAenjb0oDJT2iXsKZY/yC5AKV8hoNfCLDQXbUNoOXGakCY8o8FQe1YBphVD3YmXbSQZBHPNNZ+yUjuXSoTUIiPTYuMTItE6B0/f2HzkUUG5fJA+R/Uy7AkSmGv5JqWOZwDUfdiaKmRGu7oqvFqIhyn22InMo211wyiHPGrMYb9JwQKrH2VrMHqemndS0hJubz+hdTn3ek6+smD23VpFp64xBnbBeqA29whDNzYAhvjcrlvWuahfWLcu3v8wCDGvYf47TzYf81INofDuRFKun7CiAAKNJ6jOtzugk46G0lj7ciwiFhIhTwTnTzZPw9LHIkN/FC0Zgf+2El4igTmkdVOiuiHC/CoG3q1qH4rN59rOE5rSlFSevnnX7oeqxZffmTtQC3XeZDdkn79U9jjnUlR9R2ZQJSt9gLZG/XuykQa6hGWBnAspRpndAhQGFIpzWLDsAdkWnf93PexycgVMR6dBgYxKuyKoec6nTAmxukpUEayn0Qd6g0TXrbcbG5+Ms4WeTl9cLm11dKs0Ua9upUdXCq5Onef7fp+gQGLcs8DgSPdYOY2G5/JfR3ljMSm4NsZG3zxjozzLJ56erN45XOzVqvOsHjbW7d+nxONPevNBtwAHGByT7tqbmyCNnaIcS7yd2ZzY3tzU3F48G5UKl5N7Gsn5hHcX8dnlBk3COhGX2Pg6rZdQ5dfPp20DQ91pms9n10ZBN6G6vMwDtz90IfCvN8165tVUko+lkFG1HXxeoIQ4bBtVNOYB+EIg92Qu4y3jdroqhSFvueWoJrNGimpf1aS5T51vThqGxujqxL8q5aw9NXxgLrhuG0EYcCSvElzGDcl3Z9hB2cgW9j8jb255Ygs7O6Q8r4XYMa1zdFVEnEfu1GIep310pindwKXXbWNVqvVWjJC44yzJ8qkQ6+IzKt1sjaygwea1whG+ceQh/jI+m5kak1yz16prdj2wLTMmBjRJBD06rXElzJslotTlNkgqnmSp0XBTCiO2H3Ws0zlgu9kTA7P0gT0oW+lLl301fVZMSwqengRiYcXMecW2SInV+R8Sf00KUdzNpOHqLthrmlZO0kpZT+uj2nhHgQ6wVyYqBib5HwUxSl0vOImvjsqzsDz3TdDl0VFlxBaMNNKtBVWCUKXgQ1LuZwbD1ZBfQQ6MV08BNrFq+Zo1kDPTAPYfXMPGvtzJjGsQl338JIFAqqGgTaNYRhHbDxgzDfIY3hAMYq8S6ZeSVZWGdeLKuJsGF6ym+pChZhFTsB8LOHEl0006djHkGSOYc/q6GOQwLCABO/jznF9bq5cJS7KmW3b491ue0Ie25lCSR0x4NMZvqCfw0czyHXk8ND3NRkJSNjrRUzoYEhUKqxWZWj3KviihsGm5TJ/pSpluEpftYNymb9axZfYRpxBpzUimdaU3LF7c57aLitug1ynnt90j9TSMay8k1GYv9LQAzBwoZFITHw8g72s7Nctc8mqE+rZXmtKLha4ZYabkpQzapDrxLEueACcaTbx2HAxtW5+aDevHtOUZi3SpMYgnPXa7lKXpqo712PPFYO2UatWf87ISLmi4Y/jSHi9xBJNxYLCjguDUN/rB3CwGLUNh6ltQVHPhDrWgH5Op4tfGgVHLr7nXChVDjBQCpzZrWYKnt6SHi3w/NiNhQiD5fUle4reYdJNKSXe6ozsahdebvbpKGKl/PNMih7lohZ0J7rsF2eCch6ODZKOrEEGCqvh94IK4gg0nQOYrqtd41M+rJY1rGQNz0jahTiDXMFJ1Gu3zT5V5hDl8hI8mMG9lGQ9D3H0xqv2SpzrZC++n3R2dJUbhXn8mk7xZVJuHy98xT5xOAvGycsdzhaAumuKwhruisBQ75eSPH5g1WPg58vlQ7QcRF3aYkRVNQskbNeO4mXGA08wY9q+nk6JfjUA8UjrpG/j2tYJR5M1Ea45vjfqhpQvGvB+ZT8cTR6E+0kecg0EpH0xXb4QEluaG5yD3sEdgIA8cOv3VrJNQZ/cLtovtDUbOMRDG/3XEzKr7Nrmt3lMGD6Hy9dK1ud6JNQGas6s4nWU2X85if1XTx0Tgk3RaIFRlWkkO0SETuN1ZD/tqUFytSVEekXA68hehC9SmNYub8+bXcNQF01iucIZMgAwRc4gHRjJXqC2v7wZt7LQtXtmapIbkLlbXNAnevJomSYd1BaPzgAMXXV2YJBKxFz5CtavP/QCQx33FxYog43OnR1MPVy0gASGMiJbnNUt4gWuI9LYqrVuKf7ipcc9IOxUuwvyCrYF0dzpmD6eq21rmbje2qg25t/MzLtRBanXc0qIxtwDezdBBTvi4RCbz+wdrwc0wijY2/UzytPp811f5h/cyqqo6SrU1b28F8d9cCWNM59/PqsXVBZnmn36cOb5KsWlvake1EU+mbNg/sXDDMhKAmN2NsVM40i/fJyZvcwe7XGi3EqI7OyfT6fezMF/DhwD2iylnTKKBjyo6UwKg37ieq777XB+SKZZH5XlQXKNy5sSRIaf6RlsYh6iO37D4ercTtFLBnRKjP//f/i33ykZJFj63Mc+XERGRwdqsksGMdC1v9idHcW2IAYpRUw9fZjc6Tdwb8CFcbsBmB9stfzGnhjML8voVjYUqWoqgQpXAIkSErkAfzlqpqY2M5UFhlFoG9+/wlchDhQLAkML1O9WdhlLXmPzSB5JI7IInjkDB7HAvAF/aj8quDfDCMWpuFhxM5jtR2/Njqv50nU0tR4RM7aFlT2LiHcqkkDFi+4z6mTwlcumn0se0hESswehWn1ieZpF/OmU1Jtb9donewtyo7nRnPU+3Kxvz53Zwi6gTLwUjVYuupByazxx8ocbsMpSnAQRuM3skzPSi33/rn7rsy3sldr04x59XCePapV4+taSttxRpr6wZfMUNLHL2idGZzQyiLFHI/Y6nYSxMIihTS3vcdof0iPPRxHKuBP0wvSZdyN1c12IUqbT+ahOYsS6J4J8vKo2H5O+zR3lA/kchdc1khuOhdzHiWW48QZYBqU/3tJvs4LdJTsjI9S9OIjwga3Otxw91gtYF83v8By/wytzVERd9En2luvF5D6eJ/eL6BGwInPRlkmtKclrw4ulMiPYZKfLzGensw7gRmhQsdmsbt7SQiXCW63AU+AsU2TZPAcXzxHxgh7jKgFjnhJFXH7pjdeT9wQg+iGZrQajn5AhG4Zg6by/qNRLafLxotJvPc/eYmvuEUV8MHHmEUXk5QvK+Fa92mjNGFvUN+rN7VljC+WZvGBs0WhVwbvDBOMu4d5/KhuMMa4Lzwulhh9XGHcIV47RjhrqO8a48/+Tuz9ha+NYHsXhryLm56NMR40sCQx45EY/bOPEibd4yabokEFqwdhiRu5psQTpfPb3qep1FgF2cu697z/PEzPqtXqrrq4VWF3q/fEQWF2QdgAsLhFud3dgHK8x7QkYQdq4js/hxupsde2N9Ypda5VqpG3nsZRcpAPluVxcmZsk5Rfhp5CgtscJT0OyGisqzdw1Rjy1WpHQNkMik0zV3yN+FidKuQa2lPhfbS0Nuz8wkXuOJrHkmzIBzQAoiYaeT2PJSVtmz9+9foelkGNii99YsJ0vjlXZsENB/qIrLhaJetAFW9N478F0Z3vzwW53d3P7wU5v83hrOt7sjR/ubE13duJpvGPHADZNSBVh1cIIdIlkfr6tcrsP99oPuu1up9Pe7vn5Oyq/1+l0o87keC96cPxwJ+p0Oh31z3ZvZxrt8e5utLPdi4FYsp5QOvrH0XSWxVInJankJ7bAcZbNeJzCmgb62z2TbDRZLxbuir5Hio/Dc+dj+wwj9+IlAZUiSZUmuWKEpuzVEKOUH6EYebRcvhrKkTMK+dj+ZYb2TmnISRR8SD+l2UXaQPvPBqimQJsr+gZ6xOK//kxCToN79wSfKj/xFUVf3mwWlY2C/wnI/ia4zngL6qXx5RsvQFpwlqTe7xF9CWUSxf6D7PhSfY7oU5WTnC3OVIb+4pfoO+Ccv0zSSpIuNaIvVO0XeEWo+vp7RB8X1EWIM6CtUxnp1d7kPf8m7+FNblQz8Sq3/PyhGHkNcPwZQip+kxUiwryvXG4z66XAi/1Flb9fGlyezUwMQ7gW38I/L+Gfp/DPi1HhOR2HnBBCXc9tY1TcbKJPa/sbIuaFtpz0yrlvPRnLZejSMIIYgJ17jWsYcicJEX3FmZPlMoJCEEmvD2d8q5ydtF1kPXItvV8IhvvJrrUyuvDOiF/ZKEw14kaSNoRa8AX08RrjlXkU6mmcv74wO/TKABoT0JbYEMN4tFzin7ZTYa5kGXb8cpm2ddiXtzqpUvbCxIPxCtsYMVjaH+kwHpHSbwbNQETqtcvbbG6C0A8O6aK6Ugsaq5CtJn1QXpGYRP6ixyN0Rq/9I2CgOqyPX8ul+YJFoeb7cahLmhSaKpvEZ8y3B16jRtStPYFd/wR24QRS8bWnVxWhX6Aw1mz6v/og2zPolaPPF6LjnWr/L1oP2LYllkvuNc3b+tyXkzUSsByFjbjZxLjjWcpfT+2HfXbQhSsSp1evp/bDFlHBHZrNMF8uF8QxXOzZyQe6VXii6NqgKgoVH8OxpRJjoF+ezcA/NP4J8SfDX4RWx+UNSpg0AtrLfaO6IwrHnRePO6877qkDWaw/7qk67vM7H/fUHPdUndO05rj7We64y9rj7pf1jrusP+68dNxLv1mKx12UjruoPe5zJsrHfa6Ou0MDA1497rx83FfGIuYadfGuL89m0RlFAuTcxtA6pg7S6JCCAAcOdjxz9310SfH4R0cr0DC+XtHr0oxF76qRsg7gGXfGzpZL1X3jk+b6PaFzwafJZfTcc6byasXO6AuANJmGabMZPmFPlssgzWR8AqUC+omFzwfPW0EElm2k9YS+IuT6dPh8ADdsmkdB63mkPoMRe7WCNl4MP43gvtPn8C8mwcK5HLvlTtsLTg8hgDE2zpvN8HC5vFwu/wrfksG5jV4cHUHSS0xSATKjv8KnZBCes0CRmAFVxJr9TaIYqEYgFZZLKGfoe1NO/7ZBNT8wT0KATmuTPjD+NhizagqiGOdZGOUE0TZ0GvEOdnh7eGivWijZpDCDHWrqJ8X6iamfuPqIjMrgZsV6mamXtQ2FWQtuXKzmaZPYakapsYO4pW+c9T+ybeTFNnLTRu7a6CtElPe1XrQcilbrX6Yp71aVK/rZobZDhex+pvdYRy/dL6gtwZtNPQG8XaCyCxdIKefefjmNfo+tXSPyWS49SsKYEHa0CmSnr3ZL1k8HilzlVZoO6D7ZYnYmXgz5aNCJuoREKm5RpU7m04pmCrwWvN0Ix7B2Q54UpWOS7TuCFhyJEwIweIozvEzabsoV/cEdCSOHC/Emdci2Ug/cBI7XTQaIgn+ERmFm9TBK0++NppKz8UsIB2XjB/R4XsrevLf5fUj2O3gSfmbpwFJSWv09+1pKShWBZnmz+RleKbA54APvePOB+Nv8aMcmbplzZQgXE+YDUiID+znsmD6oVK3pK4Sm6qchevRaQdnwdGi7Aiy+XIqRo6bkQEb2RzpI3Q+w8HiPUBCyKrTAyu0pHV1IBc+PjLHL5RJrMrzv1JUjVitDyz0z02OWkEqa0VSxtpL+jxgwO7zXamHwlpgM8BK5wojW8Gld78Ykgt/m+iWrKBQqnlQyDaEZnP87Xi7BJMnHIjlL0lhmIkB9pkJSs3m3loq14AE9n+MFUmmxrbPu2rLhJ2BL+Flt0kRhRkEFY0JZPSht+yRtlItrCICCQupHt9vOeSyAGVZffChHhFy/GKIa/rHg8aeV2gSY9AwX3yzqvVZrtaKxPlpwODL2xj2bxUC4TXc8OI4s6U7oRooLaG5qyznJCjb85xrd/nnvOlv9WWvUnS2XNRUaWd/jB3oBtLMKL7CRrSDcPkcKAXZlRgaGwDB9EOpicp8j4BuqpBpEmf2TOSgyNsxGKyu/Heg3n8EnAg2H7SthuUSCjgp3IoV3HM/wrzlrFwiBf188A/RKOTwknZo8+xDGhJ61VXyYyUDRbTF9HZIQMLU7ZtdHgLCi0xUh0QsW0xcwL1748vNbR1vYjxm509bPqETqG/CGHKl/7fuh2dx4h88/L8++F5rNjQN4BxVy8eVlvhwGHpwObaKaTDli2VCOop9DSTPc9XbObpoa+sKQJDiPquggi4a2CISEqJk6Mzcc5ubz3ebmMwW7lvDzkOPwuP/mWi695OJ8eRmlyfo55JpMbDZPm83aAf4SWid2L/BdDPfwJUkHXuniQyYKDtIreZqkJ41xnDaOeeOUCx6sSPSiXSzZZdcreq/Vsi/cy5q39iWIPtReNkwIA7PwHti4js2m94rBh7TJIQ7cTJuxGCUkRyL6jNciiVjMufeolDQo/d68F23Z7c9Zt88fMdnnrRaemfKUps7P2/WqL4dBcZaCFh+xrG0HRt1QJDHYuLaOQsl2h+IuLGOuIw2KYg/izfwd/V2BdYS46EjhIktkflck5L8zFOZ3UI4gxjpyGOuogrGgbcCXR4pvQohA5PU7Mwlqt/+ukdjj8EjxU7z1J8RuGdUSMmO0CdlvfdXgb8ykqwZ/u7FBnEickXAjXS7TZtMiSbtaz8Kjwi4UbFhKsn5RBfsQCkL9ZjSuFfRmhEIisYK1+EmTl4pXiQQi+Ukdi4NnNmnYGfUt5Mb5NdT8ib0POaGVK/Unw0TELQuiAP2mlcj0KIsOmk3ZatGfmDT8Y6SxvWqiVE1lNptic5P+xARZ1V0SP2lFf/P+UnKH8qvMpIY/4Tv4J72OP9EO9fKJimdmzmldUyaVmLHrR+pP5pHqlemTn1rsp6Fstf5lskdIEig3QBseVZHqFdVY/ycf6/80gpX8aUX/QkkRbyvvIprlqX4Q6rOucB4VI0LfFIRyQj84Z+HWkd8zlQSWJuqgrvEwA3T+OzQnvp7w8SxWCgWgJ5ekE1Qy/0NC8FR4TJtObNtdQn92yUNOL5EXgX8EGdF7sBeft38n4Qf6M6G/2J+f6c9ghba39zeVulCQ7/k4zJxXCNT2AVl/t3fXiO4mhs+bwydabK9TIHKnEtjrBKAPMeVnk/Lm4O3BSy2210mHL9+8/01luHBTSnRPbVQVzH6Hqd9TFaZFBTTCpB+oF7kFU37ElJcf3h9AgDY/5zv64vV3hZTfqQkq4jX5Gy1EGsG0n3RaHVS/muG8fnP49uD989evjl4evj9QwUmwBOfUBWN6/eLnw6eYLCvJR+8+PH7/9lDVEhxH8u7J94cvVUrKqeepA5fapBiHhpgam1SIOQcJCa846wDNNk6Rgn+fQTImzTjVniBtqTE3JmElp4mYe8rpGL1zvQEdHEyaFJIeXz2HU5JIpU13VgbYb+yEU+0MrFrgSTw+VaXOuY23gT1oD6KmYdRlxgzr/R0LHHM6nvFY/Fyte2EgfqJ9krq4H4d2MNo/qcu65DTn8q1WN8KkI52kbOkg5R2mvFxIoC/9jANOZ9mJn/KJU66iivipT2wq/nytR1Ho9qNNdPWeY8dK8QoTXnEfLShNl4I6jNZCKWqt7+yC9ktRa11FTSxoxPTA/LOoELPT65Wdj2hd+aI+zM7Og5I6jNbVKajD7O2UdGF0lJSCLszuzsO9nZIuzG7nwd5OSRem29vVqjDaGewrNDF+sLNN6Hss+IrQN1B5F7RF3kLB3c7WLqEvMfctoU9RG7QH6jgvMO0poY9xOA939gh9hmmPCf3LBWIx/PAAHPAcqc16BN8B/VxMRIOqn4tpypvrvWIiKr4F9JdiKocToPKOEhdW73tdyhweU/sHnZ5zeWSU5wL6YyEV91VAv/MSz9Sedpm/68xZduISf9OJuD+95n8qpevivxaSy5ByXhyoNXI6OuMyPlIetqkslXKubcWanKNc4ZiAplCCyyPlftjzjJJx35N0KBinL/EOHwiQ7ngeWiQ4FgHPEoqN3a8j3QyNqZRrPlATtEquXPjcmHvq+VhOcluQewWTSsHPteXySrmf/XJqbDNuNJbRNeCcj42TKFGKppJSLoTJzFYMVAGhgBSgTZrSRDl5A54RBylcHBKatXFhQ+NzMMD7RwQrQDsf27+/aQM0IZpaohrfx/bB3qrKXyooWYccGk75BVxZh6LaOp3xcz6LVByPgJ7xPAcTH94WPM6zFG3DIyCGxadmU/1tQ9rA+251NX/JWQAkzaZjR+iFTQaiXbxpw4SA0ZXyYuhFkhlz439X1Ex2OXRN7E92jiYDU456o+a+jsb04N37aM7Awssz1J6CZu8CWHHFGHheb23vyoegg44h0siTdMwb51vtbqfdaYBh4UUymwE7QlntToDzcr7d7rQ7/QbQjfWkArrL4PFkIyAUJqGjseE1+ukzDJqX8Vhk0Sm16rwqYWLoj+ep5GLM5zIT0ZkN+uWnnqzYNCR9J+rAp0Lsh4qmNlP5z4jbCzEzmhPnbN4+4fJFkvJX+OR7lglwWT6oT46UfTe90l28k8L6Rm6MQ7tEkBdxdID+NBtHkv7jwyZtecpT50wWe5TGT6zQ7jtzc/x0qPVTAdaXK0KV1RcwgVyER8V1SYoSL3iGVQ4fxxPCeBs01nG6zsMr6n5qrwCoFXrqF3Of2hNQO7Bl8cwyc2bNo06DTHlbnXFmHJfDKQ9RiVAf74Bew9ueC/CJCq81HYa+rfNXKrZgPwfM8R6bPRTisUY0K2vrUqRWQ4ke/HCzzEFL33hT5exFSMIwzq/SccGh9py3c9Sx7/vMBRQ2ldBYMOHHuh99bPKoMckg7PhpfM4bcUM11JCZNu/ljSyljfg4ExBYrx2YU+XhCll0Ho02Htei0EuUUrVRY8QgOWKQIvpRW9i0OdfbaSO9aTTKFqyhnYNvPpklPJWNSTLxg5o24safRWj+bChfNbQxyYD7mWbyVA9OuWfK609oftMJ1at0xhbunNI65HNePoXHdafwovYUHurwYtbRId59QARcsvgiTmRjHJJwbjRY55zq7cJpbnfMtQpI+jKeR2OcdDCeeaJcQdhdMV/pBjm91kf8UiGZI5OThnOa02uDchYKy9F/fMjIOxHteDbD9c5Dgk6rQKkTcc3jq7DirMKcYueeSLmhXi7daXZ56qBD7sZUOxbEdIM6AlINEAuqEUM58jUlUByxIgrXXZJm87IW113qxi4VrquittPw7P9fUJusR22wFO/oQf8I7K1UsACQ//tBD3DCOqNmsxL8AHO6oJeI22wSkjZKl0MSvmMnIQkPUPR8ZBblAOcxyOY8fT55kqWplt6oUVvb3Hf6LDR8w+brhQBj3kLdD2L2JdsTj6CRhiiQYzQh9h144c5dLgXaiC7yfbbd6QyK2MzkveeXsgVe9CQcKFKGDqJHMU80KtpoIOfo1/LdufIsgxrP0PYop0eEwudcfd4RI6wIhcXQeyb0aoWL+iB+OgTFc2MTrnVI1/ax8MgoXRY0XSZ8xiVv2HuO4r14w5Dn7VoOUDgc0cu2BZusCAU/YKe8YGXev0AlmKSMSdF4RZ20//3fgHhG34Xk/c3uchnOuVECpxZsJumUh3C32wfThHsh/goPp3u0GNiZ6yOYKlyqLZLnigkVCZrkv57N0LWYbfzMNn5b09hMJKkJzq4aS11MVPuS0ISd4GvAk7BLzjkLbyg7HOnC3taAalflXr6ndaGWuYnOg30dc2ZHaSv+sqbimqlTaprI1VO8txR0Y+w8XlRetb/Wt+9P/iFOfrEaXztr6I8jMPGKNJvBb+7yK5oz8Y5cc2o1j7iTF4TX5SZ0/HAJ6C4SK6Ub+8OK0Hd3rfbZVvtxRejBl1f7bkXoJzyUrgJXWb+vCH1SYCCAdSS9+WHrOxWjxQCuM+QqADCvVCAUDdCYWs4PEqPWq7OP/Ke1V8LpiuUhsCXnGoUhl+aMnvfn4G8GKQvPnBToBPS4CVfbOaspoPHMuUJBzeaGUrHDchgM2u1cDy2dofLgtYr9b1orR8p+lolwuKDjEdXu8VEiT8yvJIUGr7lrIGf+D9Rj9jQB/mqP/yKhpH4Z0g834OprNgFsoRyOohDNlRkWeh+xIMBrS9nOaBvwD2LG3ockNK/ptsysld6k2Zy07YI9B1Vw7xcr5EWTZnPRbI4RBL+UaGfz55NwQhd0jE77DeHimHJ/3rterKJ71+PVn33eVoGW2KxdDAKNKo6VRGLnadYuBQYPbWhQnePpgMuVvTTTO9S0pfu8XJa9U+9sHfdEAHetJjwhm9UkhjAlWLwS2pXN6lKxwnIZfHv/28Cak82qcYux3byYU96jWAjP0BVsiLjZjHGQhPu18Phc4a0d6xMQ44F5XgoYVYhsHDn68MrS9qGyAhwoqbSar2gDt/drjGyHARahGUmIm3BQdCiCFKOCwDH7pJwbcNI/ZqJ9vEhmRk4Dsoe07QQ6IW8bXASyc/VMPSZ9266HaJgiZ6WJPquJz2k7ns9nV1qhj8KjhGYGBOH5H6kIjWo7zwgVK2/pfQBO+8Y1L4jWiTNQ1+KkkBumEQAp25OFOmyq+OaFGbpqubZ7ZIa0FaWHrQTP4mTGJ8ChQJYCuvEwzxI030J1liCgNpV98+23pVrtb79tNP5Iv/32TZbnyfGMN94iozaPvv22AS67NxtPXr99pz9fcXmRiU8NaGQhuE798PZFQzHxG2cL8GXPGyoKVyMT6isPGtNMqJb0+rW/IXcYtXpya0c5QCa9gdOcxLPkL66cyyCXCCM9vOZlRw/lq1UFfPrbjh1Sd+FqtmO2qly1/kWcAI8+93ijdWHxLKFPZyx2+Fg9BvFA2qvXj/G6oDVYJxqvIHKLS1BBgUN07mz7mbP7l2cz4196ATJF74b0q9C5Pd2GId8uyk/Da5hczdzKFIMkp5ac8BbBXXZINtQMSO2laLZmZGTlvRs+1pCDv9F1xJVPSD6vqfnTnWq+4sYlvV839YhQPQKOExBJ04pAfw9bu52trwghU/CN0+ls9bSTKtAoUUJlHXhTRRh60NkrhNu8c4CShAoO1DKInawzi1hnZtR5b45VPBCE5cuHM/GHo6Tct0rItcy5ICHXUuWxc7swd2E2pm5WTu1caVw9YdfD07anZTMyUVUqIkTZ1is70O7OlCTZJpOIU9fWh7cvbFO6PAqZbfFWEBCvPPAwyhVsPNFxO/uOhK4nv6LRZClW1hG2tRx2dNdGjDqMbUzzSM1LGHdyumK2FWd2U+7UYDQVCNuDQACadl2jXo/rT2FsdYKEfY0U3vmZfazG6itK9Esv10/12YoJumDJADv9+SEBmeCf967jVVsp4Ruvf7OBIn2OwAQ/Ut9BdUwg7A5oMbC37wJnQecjmheGVatpddsw9ct53WCrL/MEZI0gGMiWy42YlIS1Su74iwrEFTVugqwxtk7BtAdDkBRg1z/yq3ZAKLemyv5EftlUOeWIPKD5iCZqyko6XvWz5E+QMDyPdAUmpF7srOmNt6t2plIO0y7A34GqXbr4SMipKN96XLPT6scrClsD9/2iPRXZ2Q/vgOYk6h2qDAY9l405iytPXVUXPUmHljDPaRhKZUXkjXuMT82MJjqi+PTGRy4Oi2aFN27mvXFNkMDnn6DNmF4fX4FvaROB/skpH3+KcrcIXoxivQ/swcsIVXz3ylzMCHAclXgPdkGtvt8d9sLKcwb1N5YGPYZz5XmTF/x+a2ysR1Gphf/hCHxdyQLgDUEd7ILneMIlkEiaJojVIFiqmLaDGbxPLNWLBmqYhdS81adQSfoXVfzyJ9nEZLiEFVUphpQiUUpFWwczYfbL2mEnrIDPjXevjMZFPO4c7v7efjzLjpvNtD2JZezz+l1emLBkTbMUvV5DoEKsTwhNvBlFrdA1OwEZZfpeMrOZFbeE6xLJzDwA9nLNhVSjxfr3Oz0rvCnX9n2DQusNRyASlg0OLE4EgcasiNtowurOwsjBajCiojfa7XY8QrPUkzIdcUNe4bqPR2Rgp6Ddbid6zO7cpIRE3Dv2tcemMlg91WlxqpU8pLyrIIYWKfRw04revQOzjq59pzO8pnH9ELhxx6TNZubmTAVo53q3CBKly6W1eq+WCo40Ua3eiQFUwUcGUL13o8pncS6VAgEqvdKFVmT+SI2OGfx6rn4pbS/UcaXmvsWf76kv+MKkN7RGFoY5b+lNdzUWeYpF8POFuW7gx2Nwc5dpj3f8UnKRxrOn2TjXLu90HEjj6Y6fnWtHXZ9xDXKtKG/f17lWkzciB60Sb0QGWhfeiGa1Hrz5+ZRPkzRxzfxIp0k6calaFX5SKvY7qidZnYnfKHg507rvamFzre3uwMSJyjL5PD3lIpF6ijmnMtbBbyV+P+UyTma50WovNvDelE2x7AmfvC7OQ8btozs36u1m4xvt9hJeM+rtpt6zTDj1dixiUhbluiZjzCmy79+Lq+fy9cKmz7njFgD4L7mMSzruU05vIneM3nylGaM9b+enkHHGQVTyxlQyCvOncf69WalzTksUo1GNt8n5c0W1P7dK8ZUsZGkYtfgyu8YqxSvhsNGKN8O65HaX6gBoJueI2+1cynnnjdjTVz/gdBynh4qj46V/sur/SZYqpROjKK/T+WM+zQQ/LKrNn3AJB9ZQjW8dK9hn5Wh1+iR/ySdJDEnYdezMEg8/L2KFjJ7zIr9gd+82B5Nas/32aJ5KUb6gT6+dSRYU6jWTohjN88HuXkmhXvurLCjUawuAok698kf/zvEtDsqu6T+xYXDCJVze6BJ2nuUSncjNuATUn82Np1ag4tQdPwbHeFLEY7gKnjjf9gfGt/1roO+P0Mf6E9/Hur0F0BP7xzWlMGQeAX3++nxkjIC8A54Sr24o9M5qGgVpJjf5JEHXBmATUF/JckTMSACMN2sKO7KlVOEts6ySG8kYOdK6q2CGoKscFOQnzeZBMXicltxpPwgDGWHXr4WOaA4QtM+4OOGAacKXaNEZSfq0PITbqnnTQWhpvHYMONgXsPxoVPnYdfKCYjCkDXDm+KyQzMFuRTlH0e/BaaYa+uvGcv4lrMp/cOWfGVmtLmzCNEOxz67Yh2qYJXwbS3b/fBAOO5sPR9+SP9rVr/uKEe2iHUjaRdWzn13jT/2toehg6P6eK/Gz6l77y+Eomn3UJdbJ5oF5imu3OYUkDS5vNtUDchCWHpI6sIN1x6Oydevg1KpSPDXxmz7p5E80JRDWKJRMuxpCECxzoUTEejJ7TpMJMMDS1SY6EF3pwNiEwP7zB7Ii2ijSX2ks8I5LEur5MxeLWunvby1vrihV/odyeV3KEFQB9SGCGj/eUsMjwXBVv2PlYBc3HfXAI80CEHSowGcpKz+N6guatRfLZaq8Aa3o72WAy8FD/JZsC2VkEnmICxbmtzXTYAhJHPtPawoBeYkFfl03mVrjsIwuOXcV7tFf6PdKNIzbeVzDLVEsmDCw2y+wB4sr6/0iEjXm3/a9Bf62URYLsxNqtdDSvlsuXUc2tbz5NNJEwTStbEW/CZu6rgmBTcCx8fCBXRn4j0p+26IDlV7a3G7pIaWdYOA4WPxpJXBbadaq51ZYjbGC51vJQ06WS7+06XWCun3QVWqdrXvdlAK5+PxCkDOTwk5Z0dSbAM6p5FaH4KSC3BxrjLnZlva8eXtHzdqIeAL1cbZIZUgedQduBa1XXX+cGm7tdYKQsj8xjZRtK+LG2rABVoSeFFemILMp6g0V0RitXurwH81KCpc6UIDR0UpRPACT8C4Tkoso895zOgnsv3xDGFjVlMOU55mQoBKuoYQ1K86+i+rjnOEMsuhd++K07TodZpZfJAYCW1AhUfxgP6FmM6htV9NwPIhVw+UBgO/LjKWDq8LUpiSS/fJxu/aet4LjAvrv+EzrAcR8PVVoWEQlPJesr2KYPqUa+boaFa5fqeLM0wKMuSeVkFSY62fhlUnWlBl7ZfI1ZeZK/XSjQ6e8xivD03qZiKzyyst7l2beBVlhMN6lgZiNC6ingDOVQAKiQTvJxKptf6ojBiG10zsWBe9Ep0D/4AfwxUOo7++wGoobZyz1Y3NOKkpV3HWLSn32l6V4LdIsgFSLElagge0p8V6b+EBIv7WRfqvybm+c/4LcLRuBl4wVndT38jf2Q8wmFXdexalJayakdhLMAKdq/mOyomf+7lUhVW6CGU9APZTrZm1tlUTtU73PyiK6g7KILqVhCkypkFN9EJ3NwdptltGYtPNy84mvHn/iabBLJpfL4aivHEJ+6Tn0xVf+ti6uXfmtW1k675WolpeQAp9Ba1if30oYKdq0v8YhjATjJqemFdwP0P1nZwSH0s7OlVXI8ecmZag3BRNBblk9MxEnRZzkX5cpjFmF3EdFOzt48/B32gQk8pMCUhANwcPtHcpHJb1Gruf3cX7Kcwzdi9imuE7XcKfZkR7zv+1UPQhAbaBIdFpKvHFRoerXbAW97tKH7uL/GeiUkVsZPmOK4O8SsRbtjaoLQdMbz1tdjYxpiwUXTKKyGclyCdFU1KjBmL/45NFmC2SwJj264GFMlbsnMgjOFjOZzGMh70MckU2QqgamSCbOwG4KioFybDJG2un+5ebFxcUmFl+IGU/H2YRPAuvl0aEvO7Qaxb2kVl8vW3kLcFlaAPNEX78E6pmtHUPjnaGfjIaIuQX/FS0/dHvIObbd2fdfp/SqB8nbclmYKGQGuPEc3TAemvqd3DSmtDCmYTZCUW5aerRq0JNqq5kbpx1hXjfC0vji5TJZLnM3nHf//eG4B/tNw7Gl6oZTziwPR0HtW9toBO7zsNtnqEl9/9/hMN78awT/dDYftv7YbI++JdF9PLehiqsDaAk6qlJeRggLN31BNL5WOkvUlgpW9JMPn6JpvADwKRsqFWqKf+ARbB/p5rIDA7sV2ACZa6qI1v4WYWBO19C+/arOCaxbvhNPR4b08crWz3TFEi3yMlJjD6iYkCkqbushgGHME7UJ9bVBP9ZzNq498wIIglqDj8C0PruZ+1eYEq/Fm4ilTHOHl8sw89pWkq5gBBY9acH4obaUCrMjvEwt/oOVXhfv2pJO4EQxmYZ82B0V91wc0EIvjgl6W1Gj6ZbWWL0M5YgJNJ+F5XpefD0k0zAE/ie4tPBO/0anQqB+yQJQNx81S5FMQ70MPYi6A6E4TLdd7V7YTqygbrxzP0xVpVmauFrpnWsZt/IbcZuDuDIPC5T7K89msnFQMsGJ1LX/Mp6vVhR1tu+mslHyTLeo8zg3rvNwNq9z5zb1RavK1dfNotXe7tZO14lWNbZYMF8FpuDOyNN28Uw0eCnAsWh7LvKKeRAM/kubn2YihGFVaVGaMpx4jDkpYZCdfvxI9uNWi0AwFldhGI/6CGYK4N3sMM9Ytg3zEUvpAp1x59RcayPlaHasgjYRFPqM9fEea3wKORRiG7SVB3XRrnfx4+5KPlLs3/UFPU8DtOJhACJU0PmdJlay/VC0nRs9mDdkr6/otNKA5wSl2AQPJcyjsScERaXtvQd3NB8AlSqt95Ku9GQ3UtP5+o1l6RhZcBmCwoYngqM6STzL+87zO5pPTdO2MpEqlWOVV6oYBFIsODwMRbSxITB+/t7uw68wi/gs7GEEg6O2CFNVLqXXcCzeANnitLWecXoUC456Euowf8cpmr1ZxR6l36RT39gQzL/olLfK2EPpOXE64Xz+ZJalSjvjL1TlQG2Ux1dvsiQ1yjA/OwUQpfhkf2vAfjD6GpkZR6bGkamY0LFQFVNJjdY5/paSZsdosIk/uaSL1E/41bQbm3Zj1W4M7WafEq24IqjSbMVfB1r/Dr4vBf284Dre9JGZ7QTQXKfzcEuRmmmYIHoLtzqIDheYNlNmJls7DyBC/BzTxsrIpNfZ3t1G3ZF2Gk5RcyTc2d2CCMZnmDZBzRET4fQc005QdSTc3d4CDHuMaVeoOhL2HnTBG+Ihpl2g6oiJUnqEaZdadUQptBxg2jt0x2hQ+BNM+4TuGE0k1I+Y9lr5Y+x1AJRXmPQcnTEaXZg3mPYe1SVEuNV92HlAKExQt/eQlBwzon9HfcJesGCRoniTT1yIg5NZdhzP3p8m+cB9RnUlcz6bDuCf6CJJJ9kFvX6mH6zRYwqaxNEz+iyZ8eivFXuB6/YBIHiwA2GhPyNUH0DybzWE7mEaOvv1g9JpZlN0f/A/w9H/btxrfhOSb1u0z4J2kk745etpyBV1/X1Nvfv/1q+FPzbbR/8Zte4ZCzru3/s/QAW8fqj4WmbI9QpfXTwfx3NOa4K/9Opi3hleWtmRNrfh64y5OKF1oT+NpW+zmQ6ygedhhZPoAHUyPisFBkvV+3Okhwwk2vce5wadWP2CLJpgkebxFLFl6rIVroBrGfy+HCILwsYOUt0eIMV8GJIwayseRch9IVQFDFP9HlYPOgGYRI5jGXqT0N0B0vfDfM7FkzjnjpUr6WaPrMiaYeo5/pdrEspq9ydB4Tvi7oX9o4WN8TbyJOwQgRhpJ7khSgaV0TDe/sSvqDA1kRmVy6sZx2cOv5zPsglHJrfeMUnN7oWNSa9VgShekZVymplghFJ/SQ6KD76alpLCoGmAdHkwi4/5zG8paNtJ+tI224Ei9oOzWIrk8ivhU/aMoVeE6oChKYZI0wQ8B0XrjyEJY/YRI/oEbnHhzU6DvnVBkVBJA2Z/xlSQ6CO+271KNKC2REoFsHYDPUnAalOjQThylg2Cpq2p2o6gx69bjFx7sZ/HY/6Uz5KzRPKJ198M+qt097W9BQ3b1IyonufJvLbjxT/b8dI2tbDqGSuMg+xil5yG5P/kcaI5e4KjWHeu8rr9KN1+zOmMJaFAdwU4XSyAnUDHjA/Km8ubOrX73PbNvZ04dhZk0m3ZhdubM39vVg7w14HcrgG5DSC3/wswe/ih2czuBndGYwt1Xoa0D5D2ayDNPBhzYoVmBXSQ0ngNcDdCps8IGEXlCFk6Aiq0btn7pTPkw5npGQ38U2kmMPWRUkZzH06HlO6+7nRh5xBWu4ID3ZQghov0rqVzt7N92M0+gH9ndbth5nZDTuf+xtAD8ZBADVmiD31qD31mD/3fvDftpkvCtHSOsupFCIXKm8O28FGRK26Nhb+5JHWVzYpVO4d33GuLA/18er3COBxuojTH/LtSCHXZBmkn4yqe+e/s2nreeMvzSHKK0uvn6U/wnHoNXkDPoin3SODfvNn7STtjkadJTj2+ji38U+jKsjwk4QLk47H45DZfg4d689nwpjR3RLHZR1ARHBqEhfsD46f0SX6RoK+89lzwc8bbKb+U5Hoc5xyCkU5DwWppdKTNDU1euVzQuXEomCToqXCdgSX9vV2dslB45ZtNvDvMT9KeZuIwHp8Wh6KuL1tqyEf9OsF56Jdg0nObnrbQb3qgOPAbosanDrlWc8O6PR1Gz0o/MHmP1tWCaw/nci9Cj1WyA8eLp5LCt2uzq9vEEkJV6XYjOJ6yo3/2IutuxQ3EcIU3YZjBaLn0sjTDevM9ZtH7Vg76hxOEmqcacqtxf/sGq4+bzVC7WFzbJa0WKHasguTwc9bdpma8uzQU7UXOxTNgKC2XyFeChV6IGTVz1t01I87crGH9Xof+3vZPH144pl4PN66tsyHqXBTZue/t1a5nb5vW1gsz08u2XtKut6Rd1+yuW9Iuy3Sd3QjAkl39cy/SpbceqtIqfaujmsZZ2+pAwz3Gta+j7jahme1ma1t3gx5cG1BSt7EdYVIY4xsSOWRhpg2h4UWpHN7i8BoqFdyvWxRrihJC9BczSTT27Kn9VNMedgbj7NFYQ/MQl6SdfbKQbz/wIQ+Tfx7MpBbMxILJMpoo8LYfRHb142OxmKOmIyQE1Kz39k4EfwOeTgJXOpfZXPmo5Ch8pcNhd5tudUbIhyZ0HZoHlPWr754JEvjXumKyVO/9EATySwj3dhWfzZboAfaP4/s1/BhecPeD+BEd01uOT1XOT5ZLL7ul0vY7pMQTeepiKXj3meSOVqphAPVqB9obFRk6mgEEjYNch2YuPm7abKY0ZtfZp4i3s09U+e9diNlyKbVDgIibTeD2l02DH5pHmkcpD7m9dFY0YfFa9JezbLn8VfFHIYygVI0ez7JjkIAfL6ZTx7eZaVUd4/3Mv8XQHyPUZpzGaPvPOM2J8eMtGa5YQvqxokMkjdvZ8UcmnbvbWC2CPn8Go8UFjSnhy9/OC/pDAW0EaK0+nyUyxF8+ryblf3efWpdhTiHa8jqPla3Q9SoChtaxD1g9y8LupbOQhJL2UCNj2AHtaTHsOpv+YTpigocZoXxF6LWvfpOVzsBy6XFhXRC2+Dw5iSW4aQ4ls78Ilc1m8JbHY/kqlsk5V3pwSrlEDjbQtsmQRhuKNALjg0rIW9ilCXGhdf9qNrl/C/8FoWxN5rNS5rPlMtzYQCbZY9xq7ST/OeEX2JUJq+cHC9FwNJs1Sum8DXwKf7fExRkqMuWUflmenfGwjjWhZxd2H4oUOAOi8wwf70VOTBT8q9cJaIFJEgXLYEVzzq7H+bmqk5/rghK/Og9VlRyLOsw2+zpM02z6v2jKQGtjBnK7JEuBMo0lzZhslxyr0phJS4sgslQmxjRhUnFlk/SE5qy0E8AVaIXFKSOpn2EzJgY1U+ozq1dRXQHokH94+/yJEXMCEkbhK8eHkHNK7/gCw+GCBsEIVSgyHuZkuYzhj5edq1zrV2kBc5xTQWNsNUH0NYSBJer5SKj6odlG5idO3luO8qsJGa3dPAVpCONwl+J6jplun86ZbZxOWanlfhnMa6wUjamuEs1poUI0XSGjLvGdBOKgqtcgY6xUDCCbVMWg+SCPjkICGgFuJmfhhIxGKz8h91bV5ZU2TE4GujQZjaLi1slJs5m3+TkXV7WTWUZCK6IaO8B2Dfdg5nGuR6NI9abg/0qotO4l8lBqO9Td1MOR82G6XAbj/DwYmZKwTR12Wnha4IZTBE/gVO2R5VIxBOiM2W2fmk0zsMyCPLKJdME2QNgNxERxRw2MlCYKhE4KgMVzC2NkARzQ+ZrDvIruULtfO+8S5n0eckLHoaxOvSSDmSlwUNA4G6uZrM1R057wYY7zXeKZ5IMDZEg9wVrO6MOOwcgQzGafhzUSgKEvAaDBKCA4hKEYEdBhj2bYS1LsJaljfdvhD7mu6w0rKzaQVYVhhiYbfkQpmgMS2/CYgsL0gZ34R8RtxLFPVL1StMsNJAtNHdGSsRSIlpiBXiVN2Dwk4QxtIIArpeK4AK8maech6W+EgiXtNCSkPclS3jfiEmhO6LsDnTwAvUUXyKdBBfLC9lgYbEqn2N/CdTPV3YzZtNLNKRtrGZ1psUhynBKDCuEt9ywcno5In8MLiKeTcEYnOti1l3RKVo58nbY5PhmSNJ7Nrq6n7Sk8rUyc6JoOzThOTIeLYocn1Q4XfodJscMEO7Snk1Bo9bFHDc25Yzc9WbPK0snSMrOYgnLY4G6WMz3LYEdTnuUYFzN1i5mwGBYzZzEsphwmI5Z7g8iKg8j8QUhF8Zrr8GUIr2S4aJLpVSjptSIXQF80SSfJWFtdoEKuHfT0HyD7qWRc8XYcJpaDIIgksp5RAQVZz4CTacxqtNaqvVPpaa1xQgXr9MUj3hetFgGE4kEkRlqH6E1R/bYWK9tD3nRyhHQQDCyGSIFjj/zuTM2MQlcZqSFn1GbJIHZ/QbydcSQBY66ietGc8fVMPb6Ot4a013J5I2svJ0bGOeahmmHS/y6cUW5PB74n5xx4W5qbp8qtVGx7JagU5l04wIvvDJXz0L3sGLbnlI0BgZ0yZbEyNagA9pxiDUxB5QcmKiX9k3pOrrG/UkBMhhxQLj1lxY07cRsXQiJCP+csDk/pnKMkALcam7fOqR0N7jB3tBGbcTrhqIK0B8Fiz7jSS+KEnnB0k9vtPsSIJZB8wjEKCbiz2YFIrccq+YoTesGdJ95DlXzBCb3kzm/OkUq+5Bgrw4SnPVCp7zjGtACdpIfbHQxigYpKnKAi+CkviSH0IE45U3dzO+fgCklmsFteT5fL66OjOfw+OoqGo5X3VsST0mwWm+NtW5zJ1XJZzDWnT0CsRUkk2Iu+vkhNFC8M3BcCcmN4hYPBJlZc0VI7lv0QkmtghrVRxiIWY4msCl1NxVNXQ2HafEIO9DjHgseSh5JEofCKSfeNCBv1Cj7a2bGZJdD7axxfNz5yww+RZFVSW67cpJz4erUeOtI4ymAlY7TnkFMQtCxbSMJB0/B+4le5eWV5SfB8UyhsOOqbHjNYFU5wABlpNrUpQebQlhvAexyAFvfYULAo6TG0pWFreuw8/HQnjxPFDfWeZ6YSWoQYzU170Fz/b7iROhnRYwdxv5mXR2lfecQIMcza+DQWwLg9kKEgZJ9t7zWb8hF7sEucYrtotfSR3ui4jt56tNhmFwOiWJ7lfbBDLif+B5heEXfyoP+ANOg/nUJk3fuY1g28LfGSF17mrnoXyt73q/+ng9X92k/NhrIXIneWAugf5JbN1qHCTZ98JPqy1YI60DAGnLNTYyioSsRYTkxzKcMNDtSouV2VEnjWj71mQRnca9loCnXdsF4UmC8girNdKBSCORmrwCKH6WhQ2m6QptjqPRLBD6eSnIG8Cnd72gqiRtDKLNEj9PX9B5i+Q2+PeeGZZmGVoQ6wRWNzj5dRE97LiloADv4LDlQTOqEVFDdQwbdQRqXgKA0HVvlpYkWwuYo9IWiuth1Lae48P7CM5m2oyWJai9NDEFtYREZobgNY3BkgmpvpeQ1VOKFyFaqIEfQZZ485/Yuz95x+4Ow6nkwiH307z/4NNH3BSVLk6XXKL55mYySzwP8wVaF5K9XVHoPa/eIdD0mlVnQb6HuU6iN0hwZvBq3Q6A0g/sxDQbEV0JAm/RS4Aey9IizU1r0HRa6zeRSoNgMd1QAqgSHMirRVhmVDuRrxZOIXhy/ttzZbkZtAHmfzq1tBht7Jl3SK46p0vFpRyXO5Zg+UgMSS3ymcQ930g8r/0Qlf10jDlWQ1WwA8tn6+eSe+4aE0wooxbOmO33t0y25QR0Wu37C1YzUL4vXaJWB8/9/cpx94G/6qHfCBt+GvmvUPHCl8Nc8feBv+enzwnzUqBswPeLaoWywY7o0jdKioNsfK2zroTEZzFBx2v+dFJaRx8foCvplgG10vyrU6PBsdLylrNsOsmBQ3m2HMOoRCCzVSCTEQuLwcI0ZA3NMfcL0JoYGWuUCqxuCF6eRKKwkOABbM5sTGa/HKMcN2T7C4Xss7VjGHnZnq8MvUBf5lNr+6uSm1UOr00kJ18BTu2ie6A1h02+I1cq0hiX2n2lEngG50kVENOURpByCThIfBe57Lhr0kGlOMgBTQ4P3hu/ee3+1nB89fHD4NaEwl5aRfCzqnyUpNmQ+2G6Y3N4V6eKmr0eAOLFWUBjWoMqI0AGvL0/gzm//Z0PaIV6AZkGaykaW8Ab6bTKm8oYlVoEDePnuyufOw0wto4Ib6+s3R81fob78y3FW6XIZwNSLxq7htodpy6NbTvIrvB6ibSBesS8dWaYrONcODTs3Hqf5AwuiU1W/46AfeV/RwOGU58LWAbt1g0wLZ2myGU/YSXtaEwsEK7FMuYGy6XAaWZoDfzeYCfYZ45E0AmlyLze6IeDMMrAWliBEAPbapbKQaZ9kkmV5BWOw/bTd/NjLR+NNr8L7tUS0LLMlxnMLcTzPRMPZ2DaGCatFGMsWroHER5w3gsC3EPMs5bcxnPM55I+ey8edxnFpi6CVAocUx+Z+N6Sw+aUxjIHDjdNKA0AyNRDZkplo1c9tuvMwEbyTpFJ9M0ziXmyDL2UQPsY23hwdPXx4qrU9hWFqMMTh/9sdsOB0N5ixv54j6O3Rh+ET3AxItGBtvdpvNcG7wEbW06rzZPNUYbE4IXbRatEjYz4jC0ptwDqZkymaGrueznF8rqDbe4EIXT8Lh5RxjCTbitLFI8+QEZhp8IG52O40klfyEi4ZCVI2z+BOsnjzlWF3wKRc8HfOJKoAZMcDT4DMOp7QBhn6Y/BcX2SY0C0dowi8Lh+fNwfvvj56/eHH43cGLo4O3bw9+O3r+6unhr/YoIeC4V//znymK2Rb7bEz0uHzs3GxO983Yy0jrlDfyOR8n08RA0Xj54d37xqvX7yHC2glyB0RDnsapGiOa+wAi0MPJ8QFiBlkYAkYXOHr94f3R62dHj19/ePX0nYUe3qSASsOEfeZDgHJk+IczOqWckH8exzqut50pA8OH/2MwJNNwhrseLubFI4iLuTHzVRo0upqVN+WTOMUw+1wAE9GDIJY4/xOeJ4LDaZWn1Z304ZUKwXTw+MWhAcrjI/xSCIzsEyA3UhtwgMoyshLYiOcaOShxpmMXui9O7YZ5B4ETXj05PHr1+v3RwSu12QPSL1wR5r0b+zbVlh2UsA7NmfndTx7l/aTVIjFw9RV1NUxGVNCNDsUQNpxBln93mgWKSxdx7Oboe14kP+9pjztmG6X/wG4Rhd2S+sA4QH7wBLQeA8JuHa50VBhjvKwOwcnaS19f83Ha0O35e0gvzevHPxw+eR8AkFTZnmx84EMOJ+f/JDHhuq9R+8FrYi0wkFsFJ27odqoYuNqnilMKDZVYYDrRSHGKQHyzHgg8E7mMhb4bgvvBN3cBxBHFvEgUw0/QRapODlLDaycHcquTMxc856lshFpnA8J3JmnjT+j8TyQQ/oSO//RWkxQm8tnb1y8xPMrzt4elAehbSsPvPRF0iiHK9YgcV0/T42uHgtl3H0s8mfxJG39qAPSooPP1o1K32z8yLOAC6gH93x/Rk4NXcNqfvH71/uD5q6MPr54ePnv+qjhCQdwDEMeg+Y/6BDji3bE902oqtJSBjLPVbTbx4+YrL9bD+tK7T4/o4Kkdg2PeVtfGPbp0gnlKcfMwVKME7dib4QXICqDGCCAQU7IxybhaQH6Z5PK2C7sM9k0HXz/Zf+ThsMyMUAhAs6nUYVqN9JomzeaNMOC7F3iiXzJo6O6uw0Y0UTtsz7LZ3sEg9EdX3zffb19PfiTTUJJfePieh5JQJEMo+ADqqNjT14KJ5fIH7jjxrNNPH1nZQdpqEQHM/RFNqTRxHnw1iWlY1DnljpWkxuALer5zbCfOHNtJSzWaTelrozor+0pa0bSJlWePJqxMz+kg4EoZMXTCJbJhCS4nPILJECztdzaY2NzsA5rYQDamGFGUZbqiVpAC7W+wxOUodkBJTKc6z13nhQLWk9fNoFQkrXkRprXgZwxKwhiy2jGYldsAtWC5wSSKSH7nSK3+wuNPL+M5/a0oMUFupfY6InIsCaV08kfGV/SnspgaM+GNchyPPzFOC20wn0/5q94wsm1dm4SeoIzLouZUjb7A78rnGicrPf9GH7BedM7dWLCeJKswBfpYwIMhs7kKiSnfSL/hqfpduSzlNNWXi24R3TsIBuYJmnOlTiH06SZBUqFsX1BWqt4InqaLlKFAYwMvbTzjsXifnPFsIUNVm5hWci5NTkxW/ToHIsptSLMZqo92PJkcnvNUgl9NnnIB6HmR88Uc3OvStYU+8avbimA7k+wivbWl2wuNT+P0hEMZT6aHnBqes+GIirbCFYxT4fZMZSpp7eSVtR/cVlASqVDaBcNtIQi9y8yqu/j2ya0tV5nf9a2VZ29de3cq5080Tb2pgE0uUZviJ/RQRQgV7kRKcyKtKhcafG50iZYsmPOo14n0hbTaiSaRcrOkNAi06of6bd2t/MK9WjrTaEHYBPcCdTppIfe3C7cL2my6bxDoUU8/QsgSX0HC44lc1/BnJfoGfP0KR21+hIWn/yu8ihMtVc/ZRpfOWKJB3Oz2Z/us059tbhrlKj6cs2Q4U1r2G89h0udkuXQzPJyPPF27RbOJD/kKR6N8S9aUGYROdo5UF5L4OiBjK7gftN5yiPtlJYRoSu5XKIg9vSorHGmHRDf1oNvllTYVdVssBfqLloxR+v8wE/0KsbBoNpWxy6KGkhibzHGzWVaErUzQmAyEDBd0DFvBm46MRIsNxpAL1qF/fwoLw62rM0bLGaA58uUy1ltnw24iVN2YwS56ZDL7s1ZLbah5H3WC5iwezkblfbRc+pA44XAtFFCBrIgf7j+VlrtUkf05HSWDuFXhFA65gAOnaaE4B2Z1CDcmzSqu3B77jtfec6r04SGuj7Vrid5yukjrMl5aV2sSFPYePtwhNJaoVJdZt9OJrEqXrdS3OC/cbkYn5p3JquC4UL1wRjjIcrk44fXdwTaNVKAMDPMC9bF4DQCY/pTz+Rc2BVVqmkNN00t5U2O6SE1ldA1aW7Uh16i/62yLyGSzyQcgEUeEwCFaiXPLV5alI40l0HOAnh9giUt2xkMS4j+gaQwazzpUvpLENJu51BI7OFL24ZmyQ9ycKqd/CA2kTn6KjObhzFSlKRn5z1o7qVYQqyhOv00as1iCZrBttc9Zud2YjEiBe2r7KAhGPFF3s3kmQ9Oir4Fs0tZ4eLLkb93pD+47Ny6oc2cWWrU5FKOVsqAcjkj/F2WKWsucqIBrbBSk0YBWljUQXPSNuqXBQ/IZl3GzeSyV+4jSpaXHtVz6A282w7y49jmhqiFCKGe5BU9NOfhk+af6NirVhdVeFKGZWWj65TVfwF6ySpJ0HmM8U4jkXtn4pIZ3IEsPQUV1ddBm0dOnFC3WhYchPGeBmIEXZPVBSKezWEqeRqeSThez2dUrsHUExwrYWZ2N0kSGpzKcSuW0mqalCpIm+RuRnSV5HXpsnEm0HD1Bt3OnPAVlYaUM/opflMYOvBNdbQFTPZeglWs5E07P1GvijQAL0uSc39LWeG1bSW7vo+gcRvNE4cAyWrKVLtSQLK5EBhegT2hL1bqAdkwot+gKfh1MJginTT2G1O+U+89M1HQUDBVt07CFnukyI+izovJs7AqNITWAY2pEJ9CbqhKdwbe6e2uGV+D+YKmV937PpVe0TIAODBPmURfsPTwsc1CMXVHtNuStoKBEe6NaLvGk8QWz9Zks6XRV6S97qVE4rqB+ZmsvZEGU1i+cgAO0b3tTGMix9GLf1MylNAx0Kii3lxL4qfftrscloBt48Q1HtDK/a6eRpraybu1jwZtcit5uojNpWkm1TVMN+MWmgFO4pjXoSAyFfeggyxZnzo1tXpxSIBbRZ7oaIXFSKX2j1raZobD3YyE+Taac7VWVptWb7C4zNV8/U/24psuYuEvwTNrOwAjV2THyOjvGYp/rp7Sf13SbO85Iyk4lKHE6e62bj2MERjW28KmsOAdV3rZgIbTrEgruTW89rdWusO0Ibbc98CY+eG9ubdVSirzgxeLMb6XOv4ErelIuWn3Qe9jiXGqO94VbTYnSCmOIa6iyggBGFoVnclXVjL/y4cBulktzb1iC3V0ctt6xX+9KVbhdbmfpY/+3oVchzQ9TdPfJPJSVu1SrmVYuUnhnZTPe5qhKxoEsXyGv+VKiU+Y9MJg6Uu8yYMy9g+St3Z0HDwk9UMnvJKGfIHnvwe4DL77VE1ljVYT3Unscz+VC8HcyHn96L+IxH6xJVwr9ZX1/olSkcyjGQuuSiKiU/g3GiKJojKhlLEq4Ai5IbAUwY8BOjA4/Oi3BKCGeryDhsz6dSZQBiHqpyraAF5K8ITFBBc77a5jJ7tbOQ3B8rSb4tST0uWTDYkiHV5WU95BSiPhbiB3mR5KsDURMPbf791UciVKi34KXXOjFb6PsuJ++ARBV0/f5ZYxuCGmQSH6W298jt4XeSs/x6ZD7l4v/Gx2BcEdZGCT53DlCkuAZW1sVvXLJgiyX793PFEotl2/ken8pacnZto/vXpaEINZJz/8EYLSDFsNgjBmzDHZTwjIwxszZJ9nWUUbCGH32AqJyw0gGZev3nEKT9g5KSJTj5nkqWcUTBm34KeBwKqAvJDMxhFrRH/f/uL+Ef8j9hD6W7IlUKqVv+VSF1i/cwkpYimIikZwkaTzDQkxQfJqr8wqvfzSYfSZB3PIXGt2FRzKEMOuSDWtoZhUaAtDesDNqNr3NimlbkKZ3iE56MFrRr2zIRGnR7bimVcruf6Hl3DTdbOoIe/j74V27ckFm1nS2XRnGzn+l7dw0XhzI3t0681AFJvYqUG9/XUNblYYe/H2Icm/43lh3/j6IubdDvJZ3/wbM/8xu+LKm/9nNcJdNvftPNX23wzminyW7/sSvogCi2wR0PlucJGmJ62ld26iAcc/1OzwEVH+v8Fzo0M2uUnR8CwLhZnOj5o75ULl/pP8yRZFDGFumYqoDxABTA7oCFfCn2bhen9EIw9FrhgxxVJHTY9GKiw20Kd58y6ckoNdYhlPdbpQgBwoYYZGJRII+lemY/QDgFZ0NwFWGLjVyliyX88EvMpzDjYXRuBU56kb3vQRLybmO2TKlXs/YX17oGt6RaxaCxjRhf6mIpqAGsFyGibqKUGyagjRVQc5K7v2UOmBB+8N6blzLGPlV+oyNFci1ZxXH23CrP8rnfHwWz1FPfz/w7/GMcuBO5o5xEs9mr6d/3P9j0vrj/gB4KOjTIW1rBtZ7wZUIF56B3qJLhhIwLsIF5Z4dMMqcWBDQKRPrnGAYmmPOtD9nC/0craWtV45f8eVFExR5wr/rSSYuQk5nEGpQKIcW6skMi1c2UsaXZJgMFyPtDhw+FcNBezICX/3hlOY0puAOdyNrL3L+JBHjxSwW75CaXgieG184SJJZ114NDq+lAWy9KHG+hQV4uLGhIPNBuGC/SfCEYUVn4UydsnCBHO0ZM8fnSbaYTVABTVNwzmIjangOxdds6upxAu9KTAlCwxn7EWi9KSHtIxULdDAzX9FMu5otIAml6HXDKap2CGTarMI6NF7lcJbg/YxbWjlomZhZpWfMm8WYzuj1PexrssLlzZvNfINZVazhGU2k2bwQ+sgAlK/IqG8U8OqcR4KFupVZoUrojYIrLTlFA3sK/6LUiqZWMuBeGP4+TWTbsFrV+134tD7rLJfandBa5JyqPmEVV6swRaelnJ5B/Mw121RXPKs8zBE9goXtz5IhZf0ZYm5lYwzaFj2TND7Os9lC8mR6Ff0ilRqNyiwcasuZGWhb7mfgJiuCkTyTa1x1+yXhsFL/ORA9lhQclasf30sQMMIificpvnaiHyTll1LEY/lMZGeQ9aOk6DsamqnjXGNmyOm18ex6fTAe87mMnsoVNW5k0Y1MjUdW53QRdhWymNcWKji9hYFpQKPfJYW3kg4W9j5TMpLfpBVe/+DnfuJp9JO0wdzuSfazdFvqF+kkQi+kcepr/JlBqm/U8ViG+nX3XoDNlMwsJokbgs/QaWnjw9sXFJX5s4VsnMbncEXHDXMttlEhNmp84/tw+8ZmQ4Z7LEoawE9znry3J9rwWAGLs1Mpvmr7GGzJvH38b/RQNBCs4lCuWKI9ziZcOXI3nNJyCc10IehbXf/olwiXu2FeQZRpkjN2kRXZhXuke4rB/qAZngRtd7iBaEJLzkAEa3z/pux3iWpYBlI8tgc8JHaGU0KvDf5OV1VunC6sMZBZi+9kvS9io1cp1cr5nIjvPMaJAl4XloMC+OjOtAAiOO7BGuxn2bantgYAh/VUcUmlevGDFz4Hye+FifxNat1PYQVO1ppYa6UkUkczlVqR3JmOFY9NzQ4wV17DPwglHfFGkjYmWoofePcyX9U55PnNY73X09O1JrnO4lMT1LRxgnZBFjDFs7XnMLjvHi8Y7yxfHOdShF1jP88Hw5EOZMY90wdLj/3k+0H6ydvkVZBVBNfwANgxYcAK5/QO3nEIUbGT/ePyq/TDlbjWh8MgoHd12jMakWLsM+G7XDUD7XrdcuFQLUayWC7VRIrlMvifwIu8rslfxZUbn8biQHr2fhm7V3A5B280DUmn4IYIVQ82UtNNaroBUjT4H/D7mem4JFLoZyMS8WvfjVqNcSNDHYTlUn+0kY4yMrEbn5Ck3mzBvA2L+xKBqVoteH4DDGnIBE1W6oGEapGZcyoZ11MNMySMZ0Md3XXGPBULoECA5JhZvXpDZMzaCBOuDujzzbWkca79Bnnk5TU0y+s6N3sg9Qk4TMqJR0/1QfHRj9nnHVjP+rg4SUrMkgdeKG1vjubGmdfKAmyFOGFMjTeChNWRtX/Pv75+SWLU4xMuH8c5/yBmzzLxKpvgvU9j58cxG6xzrdoosA8ULWLfWwLFipJQHTzRvjNXUYbRlWUsTrj8kV/lNHe9JYOhZpcEuJGDUZTQmadw+FFiezVrqayFC66Gkdv8iV95JohOyxfy0mzCjfdL5zxHESLuUNscOO8vZWir0hhNgmflHSdQVo17eRVyWnMK6XXtxFdiOGVr5zpzc53hJAsCOlDV+aZ6uYFu6Ku9piVRcyycqLA4s7ZmaRHvAOH7DXpbuN4WNKaWs0bUc7x2C88g2M5MI6S6Rseu0TE0qlbcXW2NObxjVlQYjOiLi9ay02pUBFyQT6vGBxgGlMfXMNpoYpGQJStSPDg0dvCtRSlA2WVDOWprj3ksbVvYX8ZjkYUJ5R4V5wzKD7U41ZmBezgjxj1V4D84v2iFZHTQlNp5c8K+29iQZtTcU3dXbgjVmGJ/TGfZhM+MCZMaF5QoDSypHVjpwqgbREZWKhhXJgouY4vxsazwiBN1UkWWSRaLUAuTdMvHUI7ToZqRnMtAawXV4Vds54TLN6i5F3LUUkeLIOvxyZcpAiIdnyazieBpPwNPd4kIM6X0IUiUKQEx0qVanUSEFlQq0QceLJiC7aQONmX0xsGQ3inReDSwaU1ftp4jRpoy11fGOv3skQt5G4I4NBvREJbTDIGAJmE/A/XCFB1/WiSEPihFJjOMjeBDrGaqfk5dwFFPWwxZafZ3b2BhjO4VGKR4HNdovMLNzYt+rxSVppdCd5wOxQgNE8NU+Q1Gfh1csTRViq+2c7JaAednFXqcnlj4w4C1uzY9RNerFS16Ek1E2aeeWhKG25Fyb/6YGBQ0SoWXR6iuRyL9QZGH5BapBvfY/euKgWeywi+WiFBQa3iDuF95zM0Lp2xFZzedOmO6iXurXziETpyLwfEh/OGEHy9OXvBzPouCJJ1mBg3l0XCkP79PcpmJK6BRUKUEs4zijCqnnp4TrdkLScgmgypzl2Z03q9X1GN3w8OlkQman2YXTwEa8IodIwIyFRUcgM6iAAB/Gc8DOkuOX3J5mk3yCMdVclZ7muSAkOm1J7yJvFkzlB+EkCqrJoMzbir15jvhUh1TMJJuHyfpRDducvXd7wrphErZ0zh/u9Bn/kj9qJQBNtycT96oRUAVR8GUkiDm69WxbBZbSdXw2vN0CWTbaZ8SSzTBSNXdn0gwBoRbF/NgismacobVa8rq36b4Yj6JJdfTGPotrEH3uP/WIFWs7jYoemotOLKt6AKlni6Q2O8OxGY36gBt2O1nj0Q/a7VIOsw2u75WUDbqh5JppSnSnmUnVv8uJSuLSc+5OM5yvgZUm80YK4FdAJmmVaAzD+h0vztIFdAx6/bjRym6gc2GcRHoeD3QmtwPhj7DZNRoNALingAZ8Ybmts8NN6+2BrcU99MkH4O+dxrLTNBEmdY6T4tur8KxHQ3ChHHU8CnnkKiwPUF/UTAeVRj3KeO03qy48lCG26wSk8XH+2iPJRQfXLD6+J5GTTbB4LJjOqWnfz+u52lhAHRmokHM6ZRO6Bk9oef0ih7TC3pIL+nRDR0m5Q4T1WFSCSRanElyrUqwHR0FEGRk8BIDm6ChdKSTLbhdDNNoUmmqVzHkFBvwAkFuR7rUVscPr7gTjZnrgc6ZHI5BpOzFtvPoYUInzP+t3MKNwVEbPWN19iT5kKs3zYiesA491zrANownspROHp2bFwe5LoEJk3HFzocnI3rM+PBqRC9KD84rQg+ZP6PHhF4y3S09sy32eq7FjcNqspVqVUdxCeY1w8sRes9K0Dj6JJb8t4TPJuFpCJtjRmggOwHt9UxMTBzcZLmEZcTVcF3uOkiOWBbGcLHFzebGUbVIcYl7u26Nj+kVvaCCzogNqHnSatGkGJrVRtGsBGxMvICNCdra21N3CsarQNRg0I5inAic6bK1BrGBTbfpDON56N0FdPiYzVxgj2qcVyOdMgFVKK9M8FTnTBXLYarn+qHu5mHECwfIxow1XRXTtyIThHWL6pil6gm2jQ97jGNqduiOC2+pquzQGQQVAQZZkib5adjdMWUf3jEo5jbtbtHuDu0+NJExkVgSilBIgFmOK/wKPFkrjdblsmgNg4tgf4YCrVL11QFDXnN1aO4NVYSRQWVH0Ltm6RRpnRu10fGlbzZA/iwTbzTucVYLK1ICyydK10HXN2RGiYS1rZKCGAdevwduAKVKN45AvxVWxAMTXmc+iV1HXCiBT1ldum2rwsJ5j3fvetWNDiVqW1Q7fbtIn2SLtPZJ67dWhtJN+tqBvE/mt4ylptG+i4hauIngdVbtyWyG58ot5hd19j6ZwyDO/CZ074HyXulEK3Kw2Y2k7V8TuDevWoldceO61SyYGLHaVFhGWpujyHNJymCqfXnz7PQT2S4aEtZzcWv8ESm1EqksDfQbgSunAQ2j+1EiP3zrEqmo5LAMbfAdytXSLN3UVndzdYaVnFG27VMRdfftU9EAQAvyUOs2zWmblM66qaaBKmS/P+Wplm9iq75BYnU8ueMEKxMzyyuwDZh9W62sZsHk56HPhqzYdJQnfeWjv1JLdetfNjXRYFacdboM3HhVcsWUcPxJM999tccSbV6hpsxYeUhOOb2uPLxrO1mRPjo6gTzLd7Cr5lpk3gFQzOyb7wBPvFl7BzhNob541Bm4d13oK3ZAP+C/zK+sHJklefoNOp8TfCMg0ROOEtAbbg54IHbdGCob8ZaDXGJr6b9lIb/llBY4XFxZ76v2QQVUtmumUO284iM/Rf2csuKaINd3bkEoVpe+ID1Mf8MOtijWjhoYsB1axd/Nppl0t3eMkBjlC6VM+0aGPnxoniyE4OkNQFWuzRqCxTKNVGM6tUgTVKvd9X4rXIplqsBvOZStbt3g1tJyfkNjv6zfyIvk+Jaqjmln6x2t4ao7uYPWHfEwBynUfmIcZdxMx5RVbL1W8hsaoXJ9M7n2kebAUWy9O5NUhgZbuy/2Q9jWroNJks9vImg1ua1RmpEwODI9VGoGVqrksgunlKgge1Y8XsIhbjRtA1BYiwbWFdRO2BQb2l9ZxWDGFPW9Kuxh7+YPY+trytwaBpvSgvpTjIrAQhNNON2s8AvZ/4UUEAuF5RRYh1a3UnC/2ym9EnS/anCiOLKP+HoQenD2xeDkcMEv/BtUfIvHp6hsdBqLSQNjc4M7ZMvU63Y6NGgoUBpikeYgnlU6uukGY9WDqm/w+odO2Xr6hksqueV50/fnAioUgwzXV8b9lQua411A7rrf7HveK1gcNUv7SolAtGtQeEgo7IXyxbDZNarLqf/0tXYIyKlAnmJbYb2QeDFGYx1jNGNxIcboLMxsiB5L2MXFIKKxC716Pat0sbqBIJyFwxoxCLcPfFAyGLmORLvmJROm9LrwKAI/YI5X5U29L4WbATwcddwS2a7xZAJQiNKdz9HKfrUaGUFA4WF0A/7kbcewKIo5seHXUwzQeRMWL9q++4JFWN6FYNeCT/PonqSoNRRJQZ16QyQEdUzJKBUrOhZgVr3VffDQm5S5cHd0RSeoW6sT1PV1grqoEySYbGtbvuep5GLM5zJDubHVrvXTM8bboFH8RPAJT2USz/JBkKTj2WIC8bPz+IxvKhPPoF8+OW5+eHi9ELNI+mratAoFhkyqwBCltE7je+wggoBbN+l8g7YwPmbsXE69ueRKgRVD8KHSR6oD3MLYz1Dzh/HKC4ImTpEpXi5jOmO8RooxZrxGc4LOsbCvJEKnjNctzCnjtQszAWg/JXNzLvCE0TPG600I6Anj7VMp5xQkCMYMyLwW2flymdITEFufLJe/UTGAcKDRXIQn9Lpmnaa163S6IuG5Xogrb+Ne4Vw3m+FCtOEctI2BwvB8xDihJtnqEbMv77hvnl1D3ZrRY6hxETG3Dv8Wwq1CTmhN2XGhrD2lhKKOmQrinvll8IATGkpNiXAnozaGLOcrKxEXVUFxQiu7KJrR4maJ5rS6qaIxrV366GyFxMBMABvcYVy9UpNBmeBjOSiB1Yqw7KH6eoGVPZXxsVjMZRjodQKnvYoRfQsfGq/zm5CN73vBoj8wtSHRWLR/hFcKjPMUkOze3vbWDqETgT4UTgWhZ4h7d7d2uoSeqOQzQei5wNDA23s+Sr4SNf6c1HV5RydOtqlj4TEPbDDODcawDz94kYqp6vm5WC5NQeFH0AXOC6SLsvvpADlZJiAlWCJia40Lwa4BVda8CRE1IYZUKkdSxZkWK42ab6xi9y5NvQZUxZzZL6SZ7SUGEWBs1lCgGGHEUrKiGP/5dhhp6nfdlypuNNN/sTP0uwphi46zbMbjNFDxVK7mHJWlAgxGFZC+dos5NJwAGuhwTMHIOYXAesp/BNTt6BBURHc4TPUQrsfZbMYR9meAu2WUtstJ1DBN+sYhUaouoMOzubxCnSHvvhfOrBi6MEMdZiPmPnHA7me5ObbRWa2UB60vn9mFmDH815gcXLv46ggSDVaBceqlwn1/ePvcevkMBVF+486exjL+4v5DsVxWpofg9oE2mfqjxw+fbin0NNNS5ajSHL3DooEMy6GGw6Ii2XlBCFlxvxGQQUVpGSwwjpCwjLhn4uBQxqW44xRRszVURAx5NeM0YWmbX85nQN/kxlo4RfOV3HhEfILPrGFndPMaZ/7y/hAeihCkuvRa2cShRZ5ShdQOkn8MkcTWIJk1QLAi8GySJ8rjiwYvSgDBUa+1LwBn4VN9R3edMCUTqCIMO03mxMEMuUQ1U8XjjrOROc9iBud4+IU6t7uANoyayPq1ws35lqOH70mlR7urcy4SSxq+nsO/0bWeZje5tNBalK9cwLI7IJ1ZGenMHNKZFZHOrB7pQCvvQLsoRto+oEG8kKeZ0HCjryCc3k0Mfuj5/TkQd71tkqm7S0oXzkb4zhnwqmumLbMX2QUXT+KcA79ss0sIsntqFl+UFr98YR0KdGanpvSWy02fC+Gfi1SfC6G2Qs3pcCti9kizaT/tqSm6IPok/uY9rc1jQM6jwycUJyemyU3T036SZZ8Srq33nQ2oHnrArMlaTHEGE883X8GFP8uMS1pP/JRVZE4p8SYFnVfW9VkFMG/VL4qd1srywAVzx8VZaUL0CZCWD3tbe11CXyuK84kwZsMfBQttK69FSAbwT1QXxyDns+kA/olUoADSPpZZ7M7Lc+HLkbkLWo7r7Tn4wZevdsuV8Bzfv/gyTuAhLGU8Pn2ilhU4I88ygUf6TXwFz3uau8a8Un3J1lzsUJCKYo+pe1yLwfUqUowIB3DBZulamRlpGHPmuY8G9GTQCZ/Qhas2g2ozOmax6fdquUy8b1ASRuf8G7CRFzao1JSdCGBo0qHnxMzzlWbdmBE8KHCf2jOUF1BPrm+Y3CdJn+CR0p0tlxvz5XJjXA6o52AGIt/9KjokcX46xvXC9yfrzKvsAi2GHLyoTbVhszSCPm16AHo9GRNI+qp8OJ/xPPmRo5eojAQ4NPgGLAt6aPo2cBQ9DdSkFApVCX+4B+BMFoqpJL+YpZgD4HYoIIDtev/fx3GejO/dV2GUQ9FGB3PWpShesoucC6WvFAS4dyAI7UUmJpgwZh9FWEZZCQ0iD1vNwMunxSIH/k3GgscAgeO3j8nq/r+PeSy4qILlz0KlHazjGkp97JjBfu/hwCHA55ynzydPsjQ1uFGNd05PMdzFJ57ivpuw06EYBpebmIQMU/DJA3dynh9hYjCiZ+xU1TnCFT+DpxPCApj4rHhvLpfhmQE1IHTdYGA+5958nvk2/3M6AY6uMlrLV6FhCUWSOmQRZdQiBqB6gOsSa47QjAkfseFQF8rG1NxMkEbomOXN5sISAjnc+ij4WC5VDKq82RwTd2cHGrVtIm94xHK77zY0+TxnC7jz5oWrvlxtTnM219z5BnRxY+lcSdocQ9DDr3CvmGQNwZROUNt/yl7hVeyy9fROac2RP0Nd+h6hgknwzwRWQWj2NI8Fst1CQbsdZ+e6z3qdTrOZPdqCP8fCo4dXpNaYx15B0JWkPTLsjnyPtY6TXiQhiPZYP/HDw7grW9GPbKJdLFHYRqiLTNSbBiLwFhdYYyv3FLvcvLi42IQrfHMhZuqlir5Zc3C7upjJZB4LeR8LTGIZYxbxoqFq3ZdUY5UT5vbZMEdymLSx2SQ9gV99/Ua9XlGN+GuRsX63OuI+BbxsGopOhlyrtBlEoJtN+/oXcmvSVeGXmXIvxusrUVLPN5dqXLh9k+JlXX/hI0O8ZqPSxR2ICOWg6v9NgkGZhr7TV66NCs8YG4P3hTGdFmgKwLQ1ZMSEJe0a96p/m16A/6d/l16Y/h16wSMUhLljaIlkmMD+jVmGlAO6OL356qEzeCALiKc8xi//GqohPGKDgx39kbWTdKB/RprkyIN+PpyPWI6hdGCKh/PRMDPEhLtUkXZQDeO7WFUn7lIrvFmZKeCCHU3paZm+OCvRF/0U5TI724bMmHrX4qlPZoBZAKHrOi+TGabZWhohbja1ychJf8zGhQt9XLzQB+PIXOdr+wawTzywx/5tfkJnd7jNE+82j9VtnoHVJmKt5dJw8xYKq9/lRi6fBXhYLc7gGtRxqOAecsm0S/rrmwWfhav6lrNyy3NsObtjy3PbcuxJpZpNZbDnp1l9shpxZzBNtBNM5XucGNwwWN9z7dUWVaFI66BI66HQ3NxAO0ZxcNxI4dzhNnYUviVzAJtWoZ3WQTuthfbYeLUtQLrfoSc17Z7WtXv6hbOw3+mDcc0JuYXgM87nZ+VwZzEIeoC0ykmz6X7cSBp5xTwaiaqwoe+BB4dvJhqgJBbetHM+DmhgD+TzCXoLl6dKoyI4Q8W7sgdxd5jBl7dgdwkwwKPhaEXfCvQrbeNPv8rks2yRTv62h+mXINpaJLPJW41yXgiPV/+0wA5DKbmwagGpoRMKBMXzCdJEZi6QmlCzgeSQmw8kgzxCZcEmAins9wJeHXK5FMvlbxCQIgE/lc1mmLGwQ8einY5JGFsPpHP2UrT9IWg9W0SQKfUAizJfuST3ceuMwuCi8YouPMcbiFmbzfBYhOqblOkHnQybVX0yEEyYVDoO/RAEL3xJ5vrpS9aQhzB96g2sKciKSsRijUrEWMlFQdj+QcxQxwIu3Ge4jFNcBnHOlUqF+vw5FgnELs9RnQIXHvQkIJxdPENNCW8hr/yfj2ElIPVYr9ZfO8gKvVouwyt2PIijCx0t5YKh7ksQFDRWJs3m5K6KNU79ZUU1wwN8EfRBY/FCA8tOCJ3h7+qEsRmhC51XmTW2IHSOmXau2FxBfqiHdk8QZGL3leWhc2X2Vvix4n0BDDpSm8LRDfT+vaRH7NAjyN85svkIKOsj+okdmiP0mh3aowW9XoCkp8XCS2b2u9ohUU7dgkdjqlY1mtLS8kan1DQXvaaqk+jTirqlu1Qm6oO6JwfkaAa5Pu2pO+2Z21dxcf8lNdus4P3oeoX+jpCdKOlQe6emggLXIQhIkfyigWpMcRarleqzXSJdMORhoVU1qOI2m7Nmc1bQ1pyjruZM32mzNZqVCzEDpcy5fUxnWuy9YBkds9nQzxnhG3xjUeptwWbDzgiawt0xRUppBgTSmE2BENK9uRd7cB2obsoKJ1bzB3gV96/D4b9XoxZZ3T/pS5Sb8TGorROtogPMDIv6xCpcrHnPJNMQDDr1wjWb3g/wXaY3RzGVCpbjU1wavznafcBbfnJ4OfeFlEokToOTgPQXbGEd4aQYeZ54sY2tFnaNdwK45mvU/jq+2l9nBLgn/VqVwSCgGby40sEn2UYWUOg5AwVwSWRzBKExs79SQhP2WISZ0kkZZ+Dd+bEIY/93ENCcZe3TLJcQGhX+oh852NfmqVTwfhhyljSb+aDseRoYsfd9X4d5a0aiGfGd9wxq3M1EfBUuaExW4SWpM+Qp3mD6ZqocdacLCNKlmOZuGhLgKpTEUbqdnAzsJywUWtgugHE7w5nKCzOliDNgQKjZyvXfIKBzpl554GKpOmMSGBDjSnyQRXHGBB235iSak6EszZhTXFAzJmGuCN2w2vzaS9+F4cfTi75G2a/phcaUXsefALV9mM8takMFRC1VfI7PYnmaD1/jm7yf4O1UImUTdau8quERXa/6fA0bbQhE+Gi5DPUXA9av/h5y/eznxphAeK7onqhpWMsWQX+mNdkpubaIh4+G6Uj7NadiFaodXPGl90aE7/wHhtvRb0T4vJjTf3UDN4YKmrErNTp0S+o/c8BNGu682H15Co5o4nTu+zaXDN34whw1m+dmtggppZc3GfRFg7a3y1RZMrLvuLUq1W7f1cY4wHaYDicWqmbpK/vC6mrJTt8otl/EIjXymzdmFn13yKqF4JtGkjfis+PkZJEt8sYxH8cL4Jue8oaWuTYAHzRO47xxlgnIidNGlvKGXRv0wow1oEnftXJMXTdxOsEywAXik80k9RpAZlnemCTKd63qGHxxfpOk36jctmFwox94prxbWpTOzR1k0LwN7m5zvOWVykXz50Ui+KTZ3OAVhSpH9mmrkre6tAd0ZSphJgH8ucjOE3y9IxV57O8+j3duErUOXtWPIzqmkwyUhDV29aOVVUB0vn+xcMPa/hZm2USziPMGNByQVdZsZsieii7cW8oLLek4VO8UhwrcNBu08b7WDNKrokxqwgt2PHguwvf0gkSv1F9iEGizCUjF4lNSoNLeslLmTTIWWwycgxkSrhXyQdAELQjSOhdtoyikfNSsCIWwUhdlXYi3hia7cFBWEP+UhxeEXiBH4XHt058PPE+/f/yiAlaoOB/uRf6sqD33l1ij02sr/CVCr/QNGsw2ViXF6B/aFQ94yzlV/nIcLdT/p3SdU3ZS57v1BHy2nmhfrRlL26r4YSoTwcEYkMb6Wn+ajVE+XfMYzlla96zT0uuChcG41osj0g71NgNTdl3VRRc2QkRcZ8KR1Gro52UF9tmXKLDPV/RUv0V/7Bqeh1yBO59TRZoZByp0KsLC6Zuq04c1JjVe2Tq0YkShFBaNBxi9hGf4mEslBRZNkZgTqFtkmZtnCJF61alvCirasMEJrWq9n1knMHfQeqdrDwJs6Q8Coi5u7z3oeSrqn5EbM+Pyepyl0+QkjzAWwxP9Q6yY8Zl/PU2ja+R5RWHKfqMZKpNzxT6J4UeWS/MrXi7rzjbs42xdVjW1qvOKNl/IMOErQn9XPmafpz+B2Oa1AEXbkBPKWQZ/4hDaAoRV4u1RePUtJI+eCqqfKtHUfr5bHEvBi6Zc2pms50X+WulfcSZC0k/Zdc1+rTftKW70ip1PzZmp5XLVHaNavtdqZV7BWdUTXOx5gsv2twbZ5hZ4gkvYVj95lPWTVovEw2Rzy/cEl9iLQqFhmtJ2ux2TldUo5W95HklOs/nzCdhUnPyyUu4ZteOdyG62a0CcB2Pl1/EaRLt8En0QbfUFHn/7FhuvVvTh3oPeg0izd9n+dQCEl7a06Yu2CCWhoj0JIcqJomGikLD9TKt7pKBI1+lt+wcgsxcDbvD8VBE2nxfxDBxSR2n7mAMcdHtva3v3bn1DMJMkn8/iK2QpAQipDfSRMs72eXviCiyXih5aLgOr9B6s6O7Wdq/z5YNdeIPderDT2yWogwVvDRrbCaAJE+GDB7u7O4TmTIQ4OniJinCns9XdNtAuAFrED4gVNHQaS7yTmeCRwM+rXPKzKAV8YWqGY8DKSduvCaxCAZL0sEPj9vdvSDimvtXtDWFXZTHsqmCdvnjE+6LVIlL5cjU71HmpzfCZgBZB0OpYwzYHNsF+2KGz9u8k5P9NCCR0HuI8ANH/Eh1QAUM7TlIu1HQ4bvu1yDL5PEVn8VFhyqMFPYs/8VL9aE4FT8HCBntQ36rR4ryD1SmFDV7am3m7mIBbHffFnbe6AxE3OrcQEbYvOK0ZNWZJ7m3U3la30y1t1N5er6c2ajsNY7VNd3Z7D7fVNt3derj1ANiWIny4u7v7EJW0tBWycYmDt6qWj/jXCw9hNljV2htJies0kxAQycvWeGiyxguucvStnApkgjNuXJkKnsp3i2OmHccvUojIMRbJMUdGq0qdJbnkKRc5OzVuW9PJjD85jdMT/gu6LBNsXYbnf3VlONLWxstuQvC2+ornkk8AmnVOIqS4emfgC0kJuLYFHQzTqGyrWbKt5v7clKqqoiFWqxtcuWaWvoPrQhVqNqtpqqkkt+BOahbzsTLbCstTj3X9sVb69woDN6i8dMXFHRR/FqY6XLdsNvp0Jrg3s2uLl3dKxSgTvPZJtamE7xr1GmN6+Xu5UGpFq5udh/X4UPZ5n3DkUxzH408hEFqKska/pidcRrX1hsD5ln0BlZHXJQB3CqzZd/SfnYUKmzVlGx3ADeza9B1xCrUVRxSeVmAMZ32HQMJA/cFOWBZJlvk8c/Bertki6Ol1o0szLDpQf7AuU01EQn/QupbxT2Q+QIFlFRKzwz54m+aGPWZcTXlJ5vjdgjHauLqVs8pOyQodDNAzVmcKoAwAvLemSmibkEbrczSC1ZFOBjk8DV/EV9lCHk6nfCwjTFHf+rI9qVXUw51PhWOUU99dO0UpN7b1kp9lpLAj1a6Cq3gSSi8AQwFDwM4sISh6jZ2CPpGaU2XzxOEBhVH+4hs69d1CYjch0dX6ZzXQZW2/DwdjCcXGsAO9JpvNKthhQdrDS/sKTmFp5CrqH6HDjMYjEzpGLJfWoD8vrmKYtN8oBpww/riyFU2JuvPOwQx6d3unR+gV3MBbWzs7hB5D6g7cuhd4Px+Dm1oR7vYe7m6Bg9rhiB6xIe5Y+Mczy3pXsDJxur1DEIgoExHR6o4c7+bAqHOc1S2IMedWGxbIHAJMKmfL5IIkAUMHJlC7a2EplfY7o9aNCyCD2PxArz+2AgwnCX1TqSfF5pFftFB+C4362AY4HsaqBYoDxJ5jI70TdFrYB+jahbM0nNLMdO9HemMc9BdWYrkMVduAixmzoA4S87VczkISeYPgNPe+EzfqDl2E14AgouDd+9dvD48+vHl68P7waUD1ukTXHMM1CuX6ry9K+25KRXl/T8OqKT4OfQz9iepeFjV7mc41dxliwMB8va5e9mqrdUYek/Cj3mhF7vb1ykhvJAZAKpK/NHOiunRQw5HQphIHk/M4HfNJGLR4KyDBKkqBZtWyLK3y4wv9xsV6IANUxgdAKqNjJ2ATFBWK1Sd4d2CinZ8Cs/p7JA28CQIunK0yV+rEQiHXH/kVPXOZp2BCnAkeRKf0hIUCnyNvOSzaNBMXsZhAoGZ6zBwDstk8oR+ZsBj6uWvt42AcfaSvAGFewRtK0GFQnEqr/6V/lIYKimBrhhRQBSko69JAgwlqZxZMY/V5KUFz4T17XufvQa9y8aUtqy9tmrJMiabDDj2HwVyv6KvKKz6jbjjIxiwMJ1rQdcMBfRM9nOiMetBEKdUOiS0wmCzoL6Vk5GbO2Kv2fCFUbJMxmw3sTVXrtSgk3lmYOyeMt92rsCnjsXzLJ4vLZ2rGOcw5lYXFri8UWOWJods2nGLwXIgkQzOWgtVIwlKwGpmxdNgb0cXt9y6IXNpPlKIs2EJ36KHzjWrSSVi+2VwdFViHDLLovboa348gxrjpWLcEKvj0lJmXgz5JpNkspVhUXZNlXKiQvsmZe6XmuklcxTN2OtDVIp1BTyxMayej1oWSc1SG2gpnSJ6cjQg9vqFBjJVsxPRHfZ+yOqM6xvW8QMrAK6NKo6hXqHX/ODRLfkZP6XwERMIxLPtHdgzL/vz2IZ4O5pF3HOf0ukCzXaxweKd0Ti9GhL6yDb5FWZcg4Tt6SV8T+oa9GnZG0Pdb9gqIjWQavmk23yg3Zvpe0b9w+C+9pqYkJPRpMWFG6ItykcfFhI0uoc/YuG5YLxxdMWOMPbX3tc2ITsIzjxAAnXicxzd0NiL9g/ATHT6lL+ljOqPP6Av6cUToQfiEDqf0jF7QE6oyX9CP9O0I6l3QE00D/nX7rJePj6TeGjyj14JPo2SlLK0SKukze9xv37ODcuOLCtX5fEX/ItFf0PqC/kWfjxRL4xRx3Rk/y0JQQIElPG2XUSST9NRH9Wxe+JXSY2NzlXv33ZrY32VQT/154PS6HvlFUgel162clCA4qQP6AkwigGw10lNQIYffjsZ8XoANY4kMUIS/XMIfuVx273PGuvdlxDdA3UJuMN9qy4a2VA25YKaeZZoL7KpkUQybr+RKmytNM11NTmlnRp/4VQ7M0bSQIAvxejFkRMG2aKPbd1IKCEtmimIEHcBSFV9JRa9F1p3xMAMFx43nIR/C94hK9Ze4nsz43RS99+OIW+RqZcFMCYbrhAaN1Cxc1p7wOU8n+etUw4VBTzNvId+UQrNrNYxSLe/xXZM7MBdJTR6JulhJTZ3r921hAxUHKPxNqvmxtWcirYElbZ/F8/cZ/sDCUTGFrPrra8MLwC/OioAVsjitawDm00Ri9YNm1zhqA835QovZmhYzQk1rhMIzeKXdX71kwzoTjkpPfPA25ES7dqiTaja4oezf1zkkvDbXODImSH0LvlqM7fd9bWDtOq0PDP7qK6GpSeTDdNRfM3kCPNTW8EPCrPgAd0JniNrhTrUKHJmGma9dy/G9bidrpDlGT/+7U21nGufXY028KIbr83C+9s2twHt8Z/BqILr19KEQBOh8fFDGgr8EGfcE9yeKJWnCLBIrNEetZyauf5pSySBrNuNwRlOwu0/ZjERhgucPlP9T4FnecR5rSJqVP4XPXBDRMoQZjW2iCDn+pjJM4U/s33d/+XGttfcFxVihY5bBlCBxpGdjrpLMEVaJU6+cl3wKM2f7mYQZ1TpTSrVnYx6e0gT0TTfGCJ6Zv5hlNGGn9KTZPB+EOeNoeUNlHfIOZ0wqlzQomwK4E0Kik0FYi+tta1/W3PkgnBgwztjGNJzQHERkE3qGmva2JF2QaLGqrsXYI7Un+DtSoOBQx4Safr1uYf46tOAz64OPVmQ7SRP5Mp7jvGtMS1OX/lSjNpOVmSzY4ioptq9MsE+oaS+gJrXUmsmwbQUjJebmFKWHqfqYsSzkbm1B3Xwh+OCv6BkJ1TaDXDfCzwYpGFSWeiHF+uk+6/TTzU2DQuUwHenoHc6Z+ap6WNMadcTn6Xk8SyZa6xCoras5bwQtg05aQWOaiUbQEq2gYfBs4+KUpw3NXgI1Ret1phG00nYdg6EF+rbeGv5cpS9d5j3HtLfMHw62KRx9BeuOv3/9pGhj/xFN7GX7rLh4z+KxzIRyGuRZ3D9Fg3ssXVpUV6FgG/MSTWOk0v8pFSzw0B6jTb5s53zGoYAqVjLL/xCN+zW3JRWIgCyYaHxn+IcJy+iMJQpTj70ul0tAP0kZTZ26ItPBz9EULfwreMvj100Gr6IJPfFa8sodu3Ing1fRCb1Q5Sp3xaEreDF4FV3QS3u+wEURQB/QoAirSigA5pUpJ5a7hFN3xODUxDQoLX9A6Dv2OZQ0p0F1rQNCD9jnUNAFDdzCupj5aTh37zCPGWeYqwEtsezW828t33Y9187S2YTWYKHoiNZjoegdLWKh6IDCLEdjWpzl6JSWZzk6o9VZjo5pzSxHhyt6if7ZkST5BQKX4778vv89m4HYUILZ0dExgMYnH9AVek7n7Hss9QNo/+w+eLBL6I8ov/mB0O9AuoNJv2PSd0YH6DfQAZJsX6m8gZqUWDEQUoxncZ430ga/lHBtNfK2xTPXij96E2sh0wrQRno5R/xfjPG6Wrn3g/eW/vOXRJ4qlaPw3nWZiY8aP3/SdEV/Yoja2L7woU996OOvgv7EyhJXtJwnzMjWjsoNK64O622WyfDedVoelMBBxSv6K7OaOWGHLtqfn5BQDH7CgKPR7yGhv4RhCBQY21eXkl7GmF23221U1kP9iYSF+i0fZgXHwMulh5rVZ5SVMTkQkqBDda3iLvtxGJJQwA1KCP0t5ISEklDOmSUM2T5epQoofBdIo00ZMwnRcWto6RjoV3jI0xT+4SqAjeTeXEjVtl3mDJcZVYkkDUSWycAs+qx20T2nyYo6zxdzrj4p5yGnKZUULMY/vHp38OzwyN60vySz2Vs+5sm5Orfw+rIV3C4gK7utjPLoj9oWXW+TdJCgv5Zo6IwQy9srxmA0OnNW3T8lXavw3nVW3koxbqXZiorCsmRm9hox05qdJAwO5vPATt9MS6zCKlRKCpmRFU29VXGPTLhLZxzarpga9mpNDXu+qSFqwSPDSusEW5ZU6pmegIW4JqZecYxlokrThswaqLvciB2B1G78EueNk+Scp43Y0VmpwXtAO6b+G2qgpgHPxa8hpwmVISERfpIoASnrNE5m75IZT+XsarnkoYqUjKZFnrnJNEknDo4ooCmxgf+3trZ72+uV75S+5xaq0D2lT3r4wQu6dEpvrqBL92Cv290r69KhJL8QVQPIQI0U5HIp9w0riWjDIP3LvuoF6xQiYIM2xiOJCpEYXp97ipCpoykXde9hZVRb9vxgaGj0ho6RlksV6tRb3l2dHWczo+LDh+p3O5EqqspouTQ5wf/+r0kNRqTgfWIqsrOaTjUrVXdeUVD34AWP9QXW6Ho/7+18lox5uAfmk4aH9dqaPQnQCvGQE7oCLqQokzkaQJh6qLBcBu+4qjsojCYKDsyR0gXv/zscRB+S5XOSynAQ7S27O8utHgkH0ZNZfDbnE6JauHdfeyAkAzU2wy4oTRC5rj2O5m0TS8nP5hLOYz4XPJ5g0EVcg+OZC/XY/iN9njYyMeECih7zhilCsUIMIGl7rbxxtshl4zTGqHiVxQ5JQ9GK8OgJlURhDBrPD0ENeu6USqdW0cWdiVP/eVvkcAMy0iknXHqsaAVAbnjHN5WBZpQCWtqeJjPJRS0Lr7aJp1yJwzJlC9Xm6eJMTRGagiizUs2bA/y+smw3jwHi28izbl8+KuPmvmy1zPjVoXE4WfoIWiKClv/qDU71tIBMfaNTZ/UKWKZDx0r3Gnj2Eu1eo1vGmQ90AXXg31hr1JDT26qGYIjuQbYGrLoOrhDI29ZAUPRAaKeZr5SA99qTwp9otIFvaOuTrev9MuiD933zcuWNp+1eQmcYHCyEPwwaCwUa4Fo7QNWoGMioh3+H6KoRHTU601tMg1ZdQndECgW65QKdERlFW+U2JQjxv6jhSoHeHXq+uUC5hd5tLfS+GMhb+6wZ1pfP8JfOzJcP9Nbl6d06ctwIYp9tV/aBHG7905uhUmDrH98t3du6+PvbqXdbF1u3dbF1WxdbXzyT3b9fY+tvH4Lba2zdviO+tI+tr0BBf7vG1t8+zN0vnqvurSPvfHGN3j+PYjpffAK7X3Ekv7SPrS/u48sPaefv1+jdjim+HLd8aR+9L+7jH7mCvxRVfPnuvgtG+9tI8R/AYN2vuEj/GwTLl9/Wfxs39/4LqPcfwJOdr6A7/hvk3ZcTN1+6O++Cnf82gv8HsHHnK8i0v41LO38fuX45VbX15SvS+4pL4W/j/M5XULV3ep4oBhWh8Ex2TI/zfyZMbpWJXeRWq3bw+V7h7DhxpHJfvqGcVNGMnXgM52yN4x6dPQkn2n+Q5p+AglIxqKo1kVIMhEbgFTj2jfHSbKJs8TBMUX7KuTLHw59Fm5hrZeqCvlOfp7Mk5e+wDvqz/MSvaK5kSZo1g1J6qfz6y7aMT9A2ZmzcvSNHH+04QHRuuB9jSF4o8E7pGatTYetUJPZO5Ui0WFepFoZhUQChCxyH1zBkEz4LBxxxWhpSJCnGeh5nE76Z8xNY2027LwUNNt2Wywh6El6tUPYF8MdG39hnGwpy86JKE6+uHazhUPG29gILUibwHKG8x0HnlKsIGPQCDOFB0PUKva55P1xlvfXIYKi/RtFwRA+LNS/M8Pwm1m/njRPXvo7Desr0Ps2Vpx3bTHQVHlpJo1qG6Nzvx3Dc4hxc2GITZj+ihZ/2V39TB15rRAtILtlZKK2ZqefkuKSbDooGU1Q0gC2QrOgpoZc6INcFGwazOD1ZxCccLJF0Y2i2dDXDpEUus7N35lc24e/jE6OkVNplyhDq4kWS8lcYP9SkqEKldBkL0PJxqQENZvaHlfqZrl2WSQGFIKidm+8sPTG/Z5rpmVtzLWz/jQDwAxo8UQPRQwL1j1zaWPV+6L3D/0+zzS//K2zzw/9n2eaH/8fZ5kfs/h/p/ZOC0bC7rWDzKfkZFfYnIAa0IC8cgdLdNZ1lsYyCGZ9KsGqdQHict8nJqYyCbmd+GZi7LXVHpmjHeb1C5628XT2I61CJPis6smYFqWH0T1pnIQ+nMaeitjN3OzsXH8Xrzh7AjMnWeuDyeZwGFJEcHn93v0lCHS5V5n6b+VUq48vN0+TkdAbTxsUmVjKBj9UYa9Q30kEaZiRKVx5hl9HgjzRQqx9e42gj6fn+S+/dp0FAzI0IZXUHCa3OSJQXQlge+NeqtmrmatAB1WRIpEfvqJXImwg1pk03IdyfEIzup1rD4mYG7jZRIz0QuaLm9oiGGkqkhrQrSb4arSqm7NZBIbvWyh9RkOBdsXk8y8afAnqWpL8kE4mO2oQ346kXJ9j4NAravQf8LCCkfB74WUABloNZcpJGgYDkAEgk8Q51KaMgzVIerGjManXfeShJZLfnZXgJuz0jBd3aJ4VjrWcCt/ysuNVL9xj6Wp7F4oTn0jsUcHRrb04kTu3tVutmnXuUR0GDdDhCFVLVst/mnPF24Qal07qZyAeARSMM3TR1nbAFFc2mJhJP2Sf02ZRh7OI0P02mMjwIBT11mHGO0d+m6tQzPZ/6pyJ97G6YzvhlAATh3Tf+1G1E6W2518Wr7isfTOgA5iu1ftBte6efPjIi0n7aahUMVvwnhIoYZZy5Pwmv3fHKRt7xXYSgn/COA5pDkz3jbDhztKGOsiwtvvKeNR5h2X/tVaLxGifQhopQgHH1VqiSFh+r7iQUHGD68DrUYXdBbWE4olO22aWnrONbNFi0H3/9hDun1m76OHVHMJK0dB6jnFZOI2DpurMYZdSexEh4axLT0gnT9vH2fEWzlYc7zqwuTtpsymYzM/TWpzCnkiakz72jhPZcjsjwRfLOzO9vzxiYR5q6nQGsBpCQ0Rl2Yv0wnle9N42HpyPqI0GIfuAFpz5Dzx9HxFlaeLdiX9RRZcZ4JoFw0nMNVQuol5qLxmO3qFsZde06LiDojJ2EY62mNG116al9+RYOWe4fMl57YAAvJRAeAk/CzIUdyzByq7Gs0NseZqbVHTWb6q+dn0oCsJEmtwyNrDzGwhkrQD65C+T9Mc77mIenrS7t0DMvcP05OwmhlYTyNYhCD/mcrFytK6iV36HWlY77wE5Xp63Wqn/6aGwQIjkP8Vk1xaBsxQm8Yv6qja0GXzINr5rNq4Lj6GN2El7Rwm6x3R87dCXBNH+hXNLjxOrX0oL6YX6e+1e7yC7yOv5S6Sl8Iy1bYt1wn3UjKqybtMi6UfeftxFIgVp8VbSt9OxvLQV3sJCZCoRF39C34HRwZ3u3Q+hLFr5nb+kbsKmspeI1o0A9Vcw1sYa59ka/Pzz+Qc37Y6YfPYanUApD6baxHFg+hU/XRwWmi6HMht9Am0Os/S3zKo6+GZH12d84xPFNMPoGArpoeqnMIPQc1YyXy7GKs1SiqSbO+8xpswm8v3UU3Ylr7Wy5PMNoTDXvpCtX7HzQjc7pcYGaLDJM6GENqXnkWjiE+T+kB4bwQ6LvU4UMfOIG8anZ/ERfF4jPt66919Dea/oSTohmt9CnjLcVw4W+cCWfDoK54EH0lD5mvK35MPSZK/B4oN6Z0WP6l94e9IPL/msQlr1SDwSEFBEYYyX6i35mvO2zc+jPrKL0qjSDjY6rDsihAsGi/wfFmLgjiye+lcUD96Wi/WKf9hMsHqYjKm3oH0H2WWe5rGjZanx69Tw/tEweE9sFvHKHmdFRtpRBBjbJF6T/mX1eLt/j+O6xyaD8bH5Hr4t8huiYWjZEtDDR/sHM2iOSjuqerVfUsTOiDyvl6p7+wrL26exjvlxmw2/mYs2xXC6vwRniicgW6eRJNstEFPzPdDoNVvR79ir8TAYBNBJEwVwk+dnHPKA/sOmgwoP4eS134heakBWJ6mo4NPOzu64G7rb9HmN7ml9eGRJ9T9d0B53hFV185Sy8V86TwfXFaSL5u3k85jAwvgmnL1hF5fRgRejGZ7KG8/GC/kDv0XLqM7qgHwjpO0/fB83my+XyCWyXA7aBCP/lcvkct8aPrO7R/mE1ot/V+jwsnC/hXw6pObOZC78Bp/xnQwK+goeKPjd19+Km9vyQ5PKFbjQPiT0ikqwKDhLMe0kMjMM/amDRg1lFMYQENdcf8kIjWbwPw5SswFGdecc0m6ruhtew3wZGr4pMjyvPn51CKDZjFV77cxV9dtBJPC/RB1qepejHFelrg6bv7Mw2m+F3ipBmPyoJwO/sY/gdPaBv6YSe0Cuqsw3Jc0WP6BPSv2HX1O2ZjZNm8x59GV4DpRP97tMmWYU2mQKneUX6L9uCnyS55MIsGXtbSbIuEV4i9C+Q8tjatg66H6NJxwuizf+egRJ7r9PbMfl/Yf4zk/8BNN973Qe7Jv8z5n8w+T+DPnz3Qfehyb+H+T+b/F/AmGT7wZ6t/z3m/2LywbBwq7O7Z/s35oU6H40MwfLE5Btbw5DQ39g1oKvIMTI0Pys752I6yy5+jYJ4ITPLuo2CThuYV9RhwSj4n62trYCOFTZElBCsKOLBzTQ+40F0Pc1S+QtXbK7jbDax+bkUWXpyUwl1x2K+wulBIuNZMrYd/s/e3p4tzc/mp3Ge5LU1TCEZQ4+m+k5vvDfdspkmfJxXIp7wB1OvOj+bz2LJ71DU2EBvJpM7FEJ8fUM5Zfni5fem47hn848XsxmXXv5ka2drx40M8KaXO53GLiuRs3V5OVfstfrcWEqRHC/kutqfF9naPIgRIY+S9KbsJDV7qK6IZu6uHXKOpM36/E/8CoJ9+62Px729mrUp7plSqRmavszWFzCc6+i6btNizJ7iFOOJqqE0xg+PHx7bioKf8Mu5V228c7w97XhwpZ/W555xGRdALrQN510DVYVD7zzqg7ta9Z9WcGkYfMyzNKB/EVqfGdDH9VmXZ7OAfq7Pu4oh8/v6TBWU78f6zOM4Pw3ovfrMeXbBRX7KZ7OA/r4G4Pg8VpI0AFwj1J/YdXwSSx79RmMh4+gfwam9nje/ceydx8Vx7u+kOL75rE4DegNyLe/L7e3ttWfXzyttHj+rgqem0/F464ZDWcqv4LFSfmXXl/IrSKHTGY93dup29poSNYhpqxfHnN+Gm0qlqnihVKCEksu5N1w0paJ1aLgCTPppfW4VE+7sbG/H8W2YsFSqNB/r2kju0lH5OiyVK99Zx8fdrreGNXNWKlGDdksl7AIUx1xuJxur/L9B5dxMt6zoWZZmn+LkH0Itu709H7tMJpM1tNH0YW9nt3fTdakKrMcw6y7Q2+rVXKm3dmVmeQ30ZTqimKvpTLfZJg/51OXCXmy06zZekeCtO4jH092Hk+P1+K+UX8FvpfzSQS63XqESd3ivx9dj11J+5XopZpdPnc6+aQOUr6Rbq3iHYR0URbx5a4s1CL0yLbUIvTw5ZjfDMt+h2Dzni0m2vmDNVVQqUffGKM1FPZJaV+r2BqtEwe6D3e4DvpYuKGXX4NVSiRLxUK5/Oz4t3CPVYhBiRUz+IWR5uLW93XHI8une08PDh2uPSzm7Hv3tdQ+6T7q33It7zx4/efz4Lri1fFPqmrft2nXFKrt2b+9J52nnTk8DcXIch92dLdroPuzQRne7Qxud9gNStzvWVH7YpY2Hu7TR7eyU6tYc49IYSoihlHvLPFlNkrUjNyUa+/V3Qbl89cIsrXz1mitvjfJlUcqvELuPt/cOD56uv0wOHz95vPd4/XVxsPX4cO/JWnxfmjIMpZiv3/+V26YEfunWLbV+J27ONBNni1l8w76+lfqCGzU6BbwQRNfAT33Kx5kODozeTgTwEte/i5482Nk5uOlRVSxgEdwagEvo8cHhXvfgSSG3ZmPVlbptcW/GB3WkTGV5a2/N8h69MbdKIJQO0Y2os+Y6K8F40823rmixSze18XE6bbTXzo4D3CtYOcHuBMbzeHzK79agX7RmyuysxGKySNKs0XYLVMKYrs18zsfyY6Ntd4w/NZ293d1Csdtx3i2zY7fXeJZ9XAgzmvL2sM0Vi90wj+Ps813GO57PG+0bTocHYJ7fqUFbrHoe3fxNkum0fo5dh/yuU3cyy2d3Ae1EZNn5lddtlGYyjKaJyOUmKkWQWmBP40t+24YAJthtZWaT5G4DmiX5/LaNMFvEdxnzWZalijl3l9LpSZJe3uns+SWrR89hh/n0Lv3ORTbmeQ6OY+9QOr9pj7lTD9KvxZ0KXiRTedvinSdnt8JGa29k4Mze3PqKZsd5Mkni9B+iz/d6x/D2M/3wDu/x7RsosIdb492drS9nWdxar0rL3b2rpAbCtSTedDqe9Ho33tM7O3vHx501ZFaRY3Fn1kZl66vs9aOr0J6TrYe72w/WMTJKuWUq6MFud6+3jigYHx/Hk7UEh8q9iT1QplKL7ZUfeaXsGn5FqUT5WXI7QNVjVwJp3ZtuTbHqm65YsIYTUSpRQz6VR1lPPq0rdXuDlRuaj3d3Op31j6NSfo3YrbvHH+6speRL2TWcjFKJtU/wg85e7/HTL+BpmKP3NUzkFQ1kdpYJkV1sppARRNfrZuDhzsO9G2aglF2zRiApqeOR36FofLI+s3RmS7n1iHJdofJilMpVxabF/JplLwNbkTvzh1sPpjed37oS1ZdTqVT1SikVKCGWUm7ldV7KL6HYSufpp/W5tYL/znhn9wZB4PGD48nO3g2M8GJ+BS2X8mtwVqlE5anSjXvH/KanZ7FAlXo47j3cPj6+jVawpe5I4RQomu6kO+11HUUzfjDeG+9UyKAvYpLchDzAv3UWkvAndBrN2X4SkvBXovQ2f6WcDH4a8lGE7qOzGVeudf98yz8veC4bqHfV+ObeNV9900hydLYbn8fJTLkOVVpdQOxq7TF0OMrjyZ+E/kZW9GGnt927xffu2V/ocnfOafYdfk05PXiGX6ecvvgJvyac/pji1xmnv8zw64TTTz/g1zmn37/BrytOD67w65jTn5Qz3wtOjx7g1yGniWrvktOTOX4dcfr0LX694/R31fIBp88/4dcTTi8T/HrD6QcF31tOhfIX/JLTi1P8esrpd7/j1wtOjzl+Peb01Rl+PeP09Bf8+ovTn1QrHzjNFPSfOf1hB79+5jT9Fb/ucTrP8OsXTn/9Gb++5/SNmqsfOH16D79+5PTnh/j1Hadjlfs7px8+4tdvnP56jF8/cfpazQaXnmvjB3tbnYcl18YPd3c6OyXXxrvbW3s7BCOspmGuQphAwhgTFsrta3d7G3wiTzFtDsFNrO/kCaadQmiVUITdbnfvIaEi7G339nqE0BPMPoNAMSLc3XkITV9h2rmKjNx7sLtXiozc2+5B2iWmHUKIhFCEWw870J0IH/Y6Ww8JREeA7CMIhBBar80i3Hqw09slhH7C7ANCnwD83b29HqGvMe0JgUit4YMuxmR+jmkfITCiCHu7ne0tiJIKaa8gJKII97o7nV0CdgztNHwDSq0i3N7aergF8Q4h7SWEORRhd/fhzi4EOBThzt7D3kMIbAjZjwn9C0HY7XQI/YBpfxH6GZre24L1+BnTPhN6D8ptbXW7hP6CafcI/R5Afbjb2Sb0B0z7HuMQhLu7e90tjETQTsMfMRBB2Nt6sNMB5UBI+53Qn6DubudBh9BfcXQPoBnOoevezkNCJceinBMqILX7sAPJKXw/7MEK0kwVSTmhMVZ82NntEZqo5JgTmmPNHqTO4HN7b3dnm7QfL6ZTLrSmzYKzQOORgI4RWT0NSfu59lTcTnLzidrsNhYr96xNzuDHYIz/8rbMfngXkohH154N5pRbHWIqQBcYSztPsck0dI6Ts2nj1/bv7WfJjBdKbJxVKgGWdUmAZgUD+N/xz5CuMK6gU07aMnuR5FJZGf0WknCmqiqoUj0dkpVcc2/4RW3PpjB60QmOvv12OApoin5yMxHOuGxkEPtG1TMOsiEEoBxmw84InHerj2ZTfxib//zlYiaT+Uz3rnPJtf5g1+Vy0UaHKrXfqLuicvjnvWsoubp3LVb3TDUTTe/PEdMQUCTJeEP/XBULgu+Z25vKht2RsnzTrWCKNYBauViquDIprsxrMeGCT17G81CaBUphgVZeUVkuatdSYlHn29rfh2ozDHg05J6vpAm/OcCZK3nmldzYqI1P5wqf3LnZ8yqMOuLJFWc/h96pOuaezxEzdSe4qSchcZMgqAmOoULayRCMiGlKMODftW9hevHFTWKAg8y12XfRiyvzkTWbV9AWurNZlbo+9MctVcMuMKCgJkAsxDKRfVdOsP2aCRUDEXKIVCBD4Qd+unSYpQ+QC+XCCRCABV3Y0xUuOBkseHSBm2wKsLsJ4Gw/6AWMsZC3goCAAy677UgbGb2hN74jbo2KNtbjTIM0oIRGQAbMEy6fp4jCJBnICFyh9Eul20mOlcDUKXJNOCDe4fChRUkFG96fJjMOL6A/vmXDf38zan3zx8W33wTh8N/BqEWC/uB+QuvLhMN/90ctUizBAkz+lvQHQSHdJt9P0Hj9XXEmBYQyYaLNL/kYA6mqQKGSEPfdbEprgU88C4wJB97ah7fPbVAT5U7NWVoYshkDHocOc0DLnveM4vbjnleO9vDf7fujb5VvDvohJOEzXARFpHmBwz5xa8seq4U2IWOHI1zGHGzYZwwNwMIAAMAHO6ELkyb450Ui+CQAgk2nncWXydniLAB6zSQlqU6amCR8iQL5pn+DWDaWAZBurp0XOIMBkHGuJZt4ZRIXafJ5wZ9LfpYHQN65BkzahVffpB2atHksJRdgpq0Q1yVEXdgA06IFPdLOgfA+1gErVOTdjclyuRFeLpdHzWaA0Q7gcGHixmWzuXFESHE2D5gLRsEmzSann5hXsdlU2BNCSaidQ58U88vHRpUdZ4tUhgb212x4QD/RJ7RQsxoFA7oPYMMbYOroExocqxBbulAIw0Zn+IQGmqfhcjo6I0klP6nNsQgWYapcPyYcCOPlonXgQ6xyOJiv9cF8jShuY4MTJIJgCT42mxspsVF60Ew6eKu3bGOa8NnEPEHnKtA1bOTcj7qsB6GXPV4uAzClTsYo7L+PyuqQThSWEmqfVOFVKID98O71q/Y8FjkPuXfkSxC+ATYMl1zo2Dg63CDG0DjmDRWmA5oCYNHi+Tn0ijjnNM79U9lsnvBwoTcMaTbNZ0iazXFIwoWevAVOnoukJ4Z81GxqgK7BTPNHfhVxikgpcnNoDDhxDqfAkAjQcJs6YJzNfEAI9Pncnjs/R8HxnIY6HpiOc5SxTzwEP1qU040uYqq+BqvdbgM1lemqGVXRrgywUgOLXsMAplUyDQ/1bcJsN0iH8ovGW35yeAlUmwqiYq+24Gc3+dNsNssuGhpfQDyiFQk5PURfZ7n185dMw4sCTqjtFKKA77PucsndiX9kEPCfaAOsetW3eyOWjRmPweDrWq4aieRn9667GGo8CKIgD1Z/IjQXVWiOb4XGg2G/DoY0c3CcZYI35ClPbwHk2AfkOuUQgf0sO0eaXi2NXCF4V3cBLwykWCC2kho5g7mleaqEHfoSI+j88A5wKAa+lBl4j0FkYMcm2nnylyYpuKr1jkuiSo3xmjSUuKUYp8VkDnyikLc5BPnLycB8hYJEHI4OIdgJeN4JOdiUTiZhijuQKo8FCIL3HrCPKL2D0STUHbZXWWOyUDiH540YtiCftAPY1m2Z4SKFZLXCOb/y57zdbqv1P1Eo+KR2aivL/nMB26RZY5alJ1w05Gmsl3x8Got4LLm4dw1GrXIQ5EEUmHU/qW7A85t6frSm5+J+v7nP82qfczXoeW3X60Y743nuBqranlfbPlVtn9a2vW48J2iTKsrNn1ab9+kE3QUieBZMUFyWnOFBOBsg0k6mYZK/il+FT2PJ7e1Sh79gUhtQ6j20AL2TKFgskkmxNdi0nhsymb0AQ6cncc5DQjfu/3t4HY4Gw87mw3hzOrreW23a7+07fHd7qyFZjQb37t+AaRHS7wAyBaUFzREDG+WIXpX6uiC2QC1t28j7brKNZ5sCoWNXVXeqMA8c3WYzmMaz3PzY6Oi/sCPXgmGaRjj6PpldD4pHWJUh2bj/783BH5NW+Ecb/pA7TKJu7a6d+8Tbut5v7TRtmGbu2q2P/qH0p2Zz44mrwRW14iFKdBBRpBI41XRFogn8u9ELCtuKCrVgYbNUcnk+YOXXMPbWrQa2dcukmCTNRnnCS+4EgNGQ5K8P3m1Fgm106fHVPM5zQ5I9OeXjT1HKNrqrrw/jiE4Oyg+863x8ys/iKKZzQ6CCcxaeypd8ksQQSi5KVnCpCq4cjVgwV/bp/4mHksY0oym4kqCvvUCUZkp5+/JsBncn/IVoztSmqih6inxq37sn+BTLASIAR8u48PDhKEv4ZeR+8cx5VjUr9M2jweXZrHHORZ5kKQu67U7Q4Ok4A9EZCz68f7a5Fwz2/0j/uNwab2xuNn59+aLBL+Oz+Yw3xnGaZgq3K0cIfNJviCyTDe3PrwHwwhPDhkBsbG7+cbnFv1FUCQ7BbCv9U3sSu//Hu2//uB/+8a5F7t0nfTd8BvwC4+op7NCf2mf8LEv+4pMneMH8+vLFoYJPO6ElK/qRs+E1hCGP8MlyX8dUVig+mV7B2uXR0KDW0WpEn3M2NI+gEX1VjA5rTl2h+3fY6TORnb3DjUJ0gFIaW4YaTRhw5z6ag/yRW3pftgE+G8FwCDFwIRCubNfBOoo4oc8dB/aHkIQJHmiunmODT3DUkR1DeyTKVvR9/RBeWSaIYl3FfXiqxSzn7d/ftCeLs3moPmdZPAkzQtEPqnLeudld2VOR8/bB3oqgbzYAYRhbP1+jZjOM2WVIwliPO6Yd6vJJ9SlYZgLRAL+ixthGKDVbroE6dHpLBi5ssWMIyfsnNGg0ArKib3hBBKA23lf6jAyCr/cZCX53qnW3autu+XW3jN/+vnMGhuQ4CGaIpriVkIaAo7QTHqY2L2XqG/LuX57N9D0myeA1N54A74cwn8ursxlx2e9VNuzm6JX7XtG3nIWGq8xBYCIZ3AGzTDEI2jmPxfi0gOt1kFygXwzTQLZRY0yEXetLtxkQK20BGXhDkIr3pNM499wyGfakVKFg4c1sGmMBoXxYy3qEMAyIUZrNdaxJcD/lOWakL1GOpslSx4H078EZH/BoxnVMU4+YpMFCTjf3AkKlSwUre76zDfvzKWfXMBycvfxdJiQX0XU8m5/GkUYU3DLtTgOCMz3jAHEseCj9PEJVVNFSRZW4rqrJJSsq45N1EJSqQlDgF96ssOHILp6AxdNHLVXhdu0eT5vNIFB/pbr+h4IGLKA8rSxFStyJ/levA0e6FZCRDhwRONeZUic1YT4fe/frxsYvIdH86++AmhoKiAUoQA7gpDTPfMlPhdSGvQDMPHjphx36ov0KBT629l++kCnc4Mvl6wLdFuDMnWa5DLRLsGJ2t7fb7rQ77a7OVh6MoUevkw/ciC+LcrR2kheFah7nvK9oBv/pjcmGgfC8Ql7CSr8vPP2DHjDUULCkNstYkT8BAUdixLoVJYQKQz8Z6TNZLovAhoSmLBSVhsqliJX3aNSluxkI9EPm+zXVBONn3IlVHuRyWTij6vAN3voD9/bYHzlssX/1OgEBTP8zNip5SMLPPOTVzXgEB+4elprWzuX9f19uWoxKCP3lxrKax7a0QoCl5fwvtYBhqaUKXqNuG39fkAyqw/eVdxUg+I2OzxT2z4Ni2qt41VowIMui9JiBHBFDwZj1GlshZUwKMrprIGBks5mG8ZCPQJtKy7LhZwT/sO+5ysMriBAau6PxA7817jXvG34f7KfSjXkTU94XYwF1xQ11pakX5wFU4/Wi7EpjDf82cHD/6GMd+wB2nsq9SpEnfP7OyAd11YPZLJLwJkIu2fdxfspzeCR1Vl8fSahvEI3CMBq1uBDaOnw2voneZ88nPJXJNOEiagg+5sk5BrmHiNjPz6CJhn08NeK8kaTzhbSCp9RgDdTyJO4VloBwSoXmd66McQ1P4/wUHKw1m1mzmTab4LFcXSWg37Bq37tOV1ho8961Kx2S1Z+EqhqV4n8SqtNSQuUgjuJhZwSI383774XTZYCH1aDeSmx03LsPhdEMFSWsWgRsdznkI1I8AXZVOAEptev1Nx7aLfYrDzNARls9UkNIeDvrJ19Q+isPE6gV5Kdx78FOQNqLObDUAAVNkhNAI3Vt/MoLsZocjmzdP1ExhlzSfY0MXRKDlMBoRXAJaA9uxo1wA/WElssNkKofns3lVQiER+9Bd2+diqOFKV0Tod8d0o2Nm8VNKFAGNZaBcrqE98lK61C+RrSXrgAc0N+6ReXyd1PcboaKA+1rQxQD8X+a5DITV/CZzbnSw7xe0fEsy7n5AYyUyGtmpQhn+5R2COIiSSfZhUNwMAecqdS+9Y3fyKbDABoNaPB4lh0HNHiWibOnsYyDEUEiW1UBnDiUI90AxnC5VTrPIXA9RUW2O03V2NOZ1GqOBZ1JrVhY0JnU6n9GZ1JP9YLNUCVMtrOpFqtTI02nmhdGnRacFc/TgF+OZ4s8OecvbZIR1PuZNskJ5Kknh6dWfk6dxJ06QTstiOdpAIE4IF9reL2eBt61PTb4XHOO5N9F3LMy4taPMP1q19khuYGnhcei8IQLjrMJcis99DwwTeo09Sugtodbu4hMC1ktTZQUxLWS3N6g4jEVCUwnMivlWECvVz7NqfWC+sVpM9o9Q1ddUjPk0d3GLFermkbtxJFB8bfX6N1XbbWiO52t7t2w1+Utasy93oNO6UT2HnS7nbIW8263V9Jj1jq/BT3mbm9rd6ugxyzC7t72w15JeVkr6xrlZX3oj5EaZ/tGZSTDh4oT4gINqT+bzaSWdY50Jr7+6IWPrQ2+5JWTRyUDgkdJHFHI2umLR7wvWi0CLXmnUXiKkn1lMXjY4JeSp5M8PAkJuVZUbcjdhgR9UhTBnia5hhY/oaOiDBYiI1rKIl/MuWjr5gRZwab5p1qFtgRZgR7DXZrse55+J9W2VyujX/SP8eAu1H64fgLW/pFYwUOjfxWSNiawQ3sdX4Xa87cenysjaLpa0d2Hu9quonJIZPv4SnKF7WucJs+0vB3CO6TISjJ9bH0bilZK7m9vpivk9zy+khx3T7UZKmismkoY0Js0ZzEEDV3glssKkQaosDTM1rehbAnoQqzCDk0oLOyYdeic5fudQbK5HSVIAai9Ou+LFtsmkqVDcPUfCyCGD2CNR48edfeW5eRWFzN61YweZOxU07fIiC6G41ZrxOT+fnen2XvwwEvY83/3Hjxoyn6PMZYjy64OqF4tTPv724VGSL97YyvdzpqhbdeObH+/dyPMdhctYF2ByVe/spb0UhGiFBLJWPqvLQoPGZqw7s7W3hbNWYfOWLqZ9fNHs37eYgnRL5BFyGlO81ayPxvMoryVEDXUbBBKiC202R2Zx4oYyv393qglhvLRo+3mztaoFTAWEBLBDGc4N6D1u9kbPXq0R1o1tbsdrL6/r6pjSz3dUuBwQ2yZbitLXQqGYZCGI5oxj0S1nIIPSSr3cIYG7jPCf2nMgoPHT54ePvvu++c//Pji5avXb356++79h59/+fW33+Pj8YRPT06Tj59mZ2k2/yxyuTi/uLz6q9PtbW0/2Nnde9i6H9CEdeDMGDf8yaO832olRAyTEYuHyYimw9hf6ISMWOIIr1khIoZuYxrKf23vd6qP3eepUkJTTIZ2QyEHT85pqDuwE9gO1L0KPDHj7Rzm02JLxphoNkPBJKFDQQWqEnWi7U3xr23v+bfQ8iS7rTAAE0x5zmQ/f5TCztkiGaxyDpt+p9nd2e12d/Y6pAVprS6sfHPnQQ9TYCtDKoRUTswuCGOWkf397p7eAfH+frfnvnf0585WM3b6zInbEOkw2Az8ee6QEdvp0XQYHFXTt1YUzVbW0ScGe4sQ8TMSJOFeZ2f7AakP6qZiMzSba7MgDtHAfYYBhGr5mLcXMpm1kxRdt+jgJoGKdNCX2qaGzahsv5tlF/qnf9JbfAMUIULOOnZWZm3gwozDFgdmevv5q3dvDp+8P3p58OvR49/eH75jDzp6gAnrdbd3t/e2drZ33ZbMNSuL7yfeDnwbpydcbcNv3p9yrRsZfNPirW8CELUmem9OM9HI5thSAGzf4Btiub3QkDuGniWHlrHkXL4xYhbwzU9nTuxCqKdCPTP3UUFxw7Lcajlx0hsNkKreYEzZhrnk7ZGCeldzbk/cW8NbwlTV8zd2GHPvZbowUuDSQRK38AmLSgfVUWjZhVwuQ8lArLMXKKWGGTAztAQduLJ1ow0+pJ/S7CK1ovYItCnNAgnWWargYZpzm4fC5GUsbV+IRCoOVD/bUMgDRHsqilKHZg5Vpysb7wSXWm3ddpL/nPALT0RQsov6LuTU7Q/v0bR245yGsn2MjVNFL72eTnMuqU882SWZwvJoHRATsaV2lmBP4AusZkOk5U1BG2p0tOENVf+gjUyor81Z8ombfV7aRkHLboK+ngWvKYKqqt+F3AzUzyN2HtTeKrFq7D347jQWfOLVhPAPIaeV9Epv1RL1nVZP4frjhsijfNq0SoeZXNXe2hPnOLfY2Otps2k/Q7vCGyDjSz0tscZMCUdTBbjZ26V9CEdJDTbk3i7sLCdWexJo7zx0JFnHi9EGyi/jbA5hkju0QyWYMYE2pguepdsoMt7tYnHbzu+uv0Eedkg01ds3UOApZghUazaLYYx4exLL2B00k2D2f2ZysrVbRt9pah7Vr7bM3ojkLJHJOa+97fiwWnBUmvvaMhYjErUy/4+dy1WRVeZt+I1bNry6BNffLHZHow5r5x+6ch28c1+9BR56OXQz6ES4m312+9R/bFstYVu0vPGNpF0FopL9tMW6BC0HFYWXjvrVMJ6n3h0oH3XgpDhE/UiuGX2QIVbHQWcLmScTnD+FoRrHYP+Q6xksNNcKxXLZIetaVQO6rVUco73YXOQwaTgFGC2ockNFN2RSSaJqGhWE1hNCaZEQSld+XFNNrLE11FpwIMH3i2zIDJXYx7Hk+mQ0MDSp1ozW/OkGbKCo0bkMWomT8HR3SCtowNTmgUN5S14XdLSIPK30xj1uaimC5bJ87bmqbkl9K5+Nu9w06wm7G/CFwQ0axhJW+Ma7rQ3dZF/Z9dJutJvwhdtIsKXNZgcNF8x89pWR7EYXz1a/T/KLREt4x3HOgzgfJ0kQ4fcslkna1T+OkzQWV0FkzlsfU5E2jMzn5p7N/x7Ykno9VPY475mS43yz52p1d2bca0P91M30vtUdnfJL1/f+/n5XJWuJXmR1BV2vWiwS+RdROtjsRj5okoUBUKclTfgM5MrFOLWIURQu2ujC3LooZXK5lI86SjerQ6jcB5Zh6f4NilXEcikKxdQj2Uug4hHrFGvDuFmHPGKhVF8uGxaTL5cht+S6XVlOrisz+By5mpo+uXEdP5VL6g1iHlXl7Jv2zMdy4dLqHVSg+qo988prxtsE6d1fK5z0ubalLm2MtLgxzs3GsKTiUI768A+qg1H4h3mo9MpFmEY81mEVSm2zW2tmKAYYTl6wDonEvntTD4T3wI7Eo037aw+3lPeb0N9DwVpC7bVs0Im401Ol4lEH001aSxAq9h1019452uz2XcHNrtWnF486WG6jULCzqhuQxOOiyTXg/RDqYXVpUXPHk3fA6T32prD2UWBVdGQTqARaQ0G6O9FTudT8q0E2uCnbiFpg1aPagrM4l89rCgPgYOyoQa/djOfxzN4e5sZQ44MLQ9PjHjnlTwYiJ+CadWnuLouZnby+/zxIweoOjxdjLEyZvoPT0n4HBVF18hhj6XJpj577uWl/E1Iw/eotl9J+e5ub9Wh+n/XoDP4R91mvxL2wlCQwD5MBHKaItwWPJx+ep7K78/gwlN8maEyVGYy8qa6zmIl+/Cjvx60W+CyB5mICtt6hpMiLTAedKN7UkKoUZDDEhMabaQsSZvau+DbBjY0iHygWbzKoS6E7zAGiOBSt2X6OJyffnKE0sR/vs04/3tw0OsAbnRIhO+unHoStlGwoGAEwsPvoHwsef4IR2os7XpkZ9FxkmNWHWiqiaQiP6459c9ozKvopIBBdKCVkP8OBZyRKWWZ12exeAViVdCXdj+/31CTdBy89rNNPHqXIgLZYD/VQnqcytNrOvW8T2iO0u4Nn9HfoUQ8k6fOhaCWAFk2K53nDG5HK/DH8PpTUjYNQpczt6lzW1PHf2ua14SvuKummGWyrJYhW0sVnRVHGQjy/MLLa/VFN9z/UFXx3I5zUaIzDWbbr4cGsJp5bye9GGMpN1iOPOgQXQ7AC3AnsU7G/vwd85X/1HuwYaUjmqYD17ea6ZY4PSrI5/TYR3vU1SIvSInieVJI0Hw+a8lr/ZFtnL2N52j5LUotGHAcFJDCaepWKeM0eib5bXz7MRlqRDpUXEyb3e1sPB9uR3O/1tgZbkdzvPuwOehHesFkrecSEm/SczvqaYkoUxdToRvJRt7eHJh6SqEOJhEmjFwnor9Ud0W5vj7Gw+7DXxIt1xsKtblMSkCDubDUFne13e7vYxIwU2thybaT40Ss35n6luunuA2y621uG0LjpJaWz/V5nG7qZPXrwoPdwZ7mc7T/Y3dreInVdb9d0nePH1s0wuF95CaI9C5EGLjXA5XS2v/PgwdaDZnP2qNvtbne7PQPUamW8LwzCmEGpLbjASBSbKoB14XOHpmrXxkD8d5rdTm9riUMFnPtgZ6vXWUJaMya2JKFZiyWrGj5wmQOB8rBHzJolqusQd+8TfaZ0nH2VA9q+CpEEAQWcjvsROBREtNjttfU5SGnaYk8chhErDOrb/vTSqGOxhM7a7397c/j06ODt24Pfjt59ePPm9dv3vlYHqMSpAfEyE7tLqGTX0yzzle10Z9u91eo22UgdgbOOgQAojG73ECVMsyysaCVudFerkNSPZ7n02IOWTtMaeculo+E2SnlKW2+5LPwELl6SN44hHjCyIMafcnxYTxpojdoI3cAI6IPMMyEbF6fJ+BQYNcYwsnF81fhTsWr+bJw/aF+2Gx9y7iVtty8bybRxlS1MnUY2m9h+dcPtwE6ZGqEx6wk9jguo2gnUtrrmNl46uFM44dJfvCLzQ+mmmPsJXo8KttXqTl1qltff7dKKRKDbWXueZbN3yV+c7XUf9qii8NkaJQ8rwVqzqXxwv2QzzrzSABNypdYBsSYZOZn8EesMcsdr0/4JKq8zKNKeJqgRJEjk/VQ/VnagGpgPaR5PiyZ7voSvWAxEszcVNatTV0Y7OdIcIt4+MoWbTb7BmDfD0NJYGT6V7JKrcjMUCBtmO+VttZWoz0gjhH4XylIl78kn21mNII3QjQJ3b7ncKL4L1zPijhfTbkDxb8+x4/JafpxqD15VDj7N3kX/IwV+WYH5Zmlk6zGRdWjsKBc0N80exf1WKwMaH+gS2DXDbAQUDvyEVoBaUWS+7ko8Qu5U+kgMulFnRX1Za2FdNZViLTFKTzaP27OGtXM3Rl+BQ/P17JiNjmXCuGsAN1o6jmV1n22UZExrFjyYJbms4bnGqeKrwkKrNdZc+xpWi1Vj6Ojb3D2RcQ/AAkvWoXXPhRYyeEySoVALhzbUMu5M0wc1zRjzcFCqxDNWOC4ka1lPc6khswu4mCyX3okiYHqJQkHgB6xhT+RcGiMNCXwIeMheG9n+Lafsyybdg2WVtey5sYZDsAvcwWdnvgDCYSm20Slk5BfxvLvj0z+G9vG4p3iO/9XbYGtkXVq0iYKINapN3Z3N40Tmvq0u6/TlI96XLdYj54bJKFtd90I8TfJVBdqt3p2g3f470G71boB224d2i1Dzq9WlstW7Bfqd7TtBv/d3oN/ZvgH6PR/63RL0O97vHpWtB97vLSpb2zeMzkiebhuf9+ZFM7eCoMUcS80n71BOohNN7WOKLUnKvb9AS2MNQx1ghfLKE1XFy29RElbLVDyoHFfLSfQnR1k4wuAsFaBGwEuQa1Uyf9qUjXwQWGFVjU6Y6UtPrrPsgquKdqjw7KrC9nVvRcC46l63AUx4kZyFhHqrsg/aJbzFgka73W4EhAaP9D4LWrwV7AcrGsML1cE9jEesZhikMLY68sfj138VEXSHNVL0iwRRqbxFkFhHuNwkPixcaUZEZVOUciZYYappjfxMxRv1U4BpmBXFVChhF/tOnySFhKwg3KrHC9kC7LYaApIayF5XWmbpPvQj932ppU4uyEj8EipB7+Ii8RazMFPiss0wVR80YUaYtmlkaXrr5o6Qi2mC7lBhIOa9noEKus/GshiLs06fo2YuR87ukAPVNwYbyOuYwU+a4M8i1Rc/SoDqSx7Fhurzd+h4tpjwfM27BRnTCJ1RvXVPjIrcYk0bDY2lMJFudEqVPVnG3RrolhpAdb7KacKz5BFaqZZa0oIIlMIlYERL/o6tUbtMmVxTGbFkkj9L0kTyImVTuJ8UqKGRuhhxIG2oQz2kDdXwiCi/osZ1nn7kgxulvtpK1PYmyEDvs/KhUuMlJNJSPZVtDEkLR2xT1oiOMy3EI5Ztu4/iu0cdJYteLuUdTqCntoGjtyoqBf2UgPTT5dIBrU9VRYUgrRE0X3i74zZR82G17F21ES6rVUuy5aMaQL5Kuvyu0JAnX47vLl9OST9FxYOyvI3GKF8uEQpg68sqDLxryI2Mkh4FzbuoQuwDnnJmTO2jWIjlUlEqZLXSOO8J2+483HHa2a+L6g5B0F/DmHcvYInMeHjxprX8T4jm0ISnr6e+a/v7+F/ob11fzysSe0PmhRslPY5wA46aut5Sdd5S86LTKhcKYNlPEZiUZC322xDU0qxeWua6flXt2rtHKu2qCyW1UvY+h3dHVj/cdMhHrd6DnW/TIW91R6Sm+/eeWhz/V3cDfLCsVQbUrEHjQnmRpFJdzrwl98WaOu/FFbg0llkjHo95njeO+VWWTgw2mWnn3g6kN468Mm7K70ArBaq9uneooY6MLxT19Jf7GaxsvE5Hr6wqrHT1UE/PU/sTrdRSOWsmAK/Khk/X+KN96xOTn0MJHyhp2zUoVYtnZfNxcgLi1O3ew+2HO7u9hw8IQbFpa8RiGu/vsz16t599JTo0De/v65a3eqS2E0Ojq+oJTfzWbvvp6WC+/Ptj3S2OZqf480Hx5/bfG+tWcTS94s9u4Sf8EK09N9anlV38lVulr1VovmZzvfD9/VvPXS1JNf0Rg/shDs8suk1o5uwssE5vC1JFa9s1+PjuDe5VG3zQg1SYpln5Oiqx/OyL0Xtxh5z95z+cPOoM4IknyKOOtj+KuHr3MUFoKD092YGI/vMfiTWkrQFYPJJYQ0IN+YjjJ3ciZew1XxzHVmn2ZpFYRUvW/62UVJJUvjhkleTnmFwmormaTE04iuVSYWmfdNN2MpAEj4iMdWlsJI6tVvwIHnXZt6z3YIf04TZUBVvx6Nus77PaqmA+rgfz8d8Hs7W5KRFUBabc79QDCcVuBXOvFsq90j4yp1lDqkDs0uJbVc1hfTfdnTXLhhl36KxX29lSDxUNFNf1/Hhdz4//Rs+PHu25zut73uqtGTNm3KHn7WLPYd2gzY8eGm6SVndnd3e31935VqdvrQXu8TrgHn8NcKV+R63Qg7K744NpYd4akSpwj5MTAGNn+8Uh+yksKBv9HGoQjGzVGSaaAyzM5t/13AVKzyWAWC7vhdyHfXPPw1hI5mETrRYftVA5wvttv77tffttb5tmzKbcUlOoGuY06iszJa1Qf2bk0SN3p6J/srVT8/j/xtSoAVQGVhy2/fKn5k41TRiq0E5NYT5adprqZub/Jv7PvmXd3h5VjLUw3VSvq3l2Efbo3reS1F5kf/sm0KBaVJ8qZbZ+WrwN4pZfpAJ0rICOa4COa4H+mosBtLz0BA82u9+CGuCmwRJdEq29N+pvh5uQc9+nd8qY0gx9q7e7s9cUAyRXO93dnc5SRGJN94//RvetrgHgK7v/ynvi9mvCIeBHj3rba/p+/Df6hmaXd7kAavH//z30rypve7iJtx4UkDlv7YxaoYABkrthrC+8U9Zh/v9riD+UONi7Iv47TsqX3Q21k/JslsXyq05IhvUNr3Gjo55oq/oeHv8DPXTX9fA0WxzP+N0GsXfLIPBZuK6Lx/9AF926LvBpWvMuU+l1F7MWUXDW4vaqU382UnL9xhd5FG4lQTa7wFlVzDx3UeM+kdpqFW9t4V+BKrsVjxi/n4FLHiuXbYk1Q3m8ZiiP/ztDEZswmK43mFZmhrO5me0zuNLj0ngyGE98t/Hs1Q9nb53YqTCe5dIbR5eC1U+H0MKkg77Amq4rDz/be+VuvxMAPYq6yzUgqGnpjhhs6T1QWVgL0uO1ID3+Z0DSIFiQzCytA6nyVLQgVWiAO4G0TR0zzsHV2lKQ9bZNQk8ldHeq01da3+21kD9eC/njfwzyMtzdMty90pxv3Qz52kcmSDYtlG8dWPra6hDzFXQup6X/guqrrdjZ45s6e/nPdHZHhAvoyVzwRcy02SX9AuJKN7t0MyUrp43ZRUOlOtSbPRI+quLAKQQWYtJsdrQ8HVCXCu0BtgjUYbOQ34/39ztkM7kdqd0RF3/1KDVGLo3zJqy8bqitf2CoX4Wnu71dutnt7YGWteLw9h48aPFW9264+28gaHjh7NJN+LP3dVj6b6Di+s6/BB//DaTrjIVpwTT4ljlYg4s9bN3aXgfo438UULNVHA4u7ph/HAt/ERLedOhwr1P8L/Bx5e7dEfMX4eV/uv+6B4y3gC9czxudiuqRa+HxnVro1rdQ+wDxmnh8OxC1D4w1TVShADXmekx+F/VLK1ZWwa5KCpgg8lsuQTec0FSpXyplmyLPwhniKwmWy0ENcKgMXLX0kXKYJghNi24/ShrwqqeqnwpVUq4VQioVxXcyFrIoI7fSS9CWYLerHq2Tf6Zru86zhRjzw3RS6Tj1VZ1w/IXJs4at8lG6qebHJbV873OboqwQW+uAqlavHjbJL4k8TdIB9u5+h2q/3KKOz2lRFglVqCQ0K+5FsCmq34s17v5qkyUYgkuqDBv8iYoqRfVmKpWjhSBHZU85a9xsGPWnagxPUuvvIm02i94GU/JF+lXJNOz6Fh+eyWfBU2c/VAppSjMP7X2XS6NrBolKyRfiQHGIDloTwATdTERemFEXXyXkRh1Bhy5HbVlvMh/J4s91qjWv1+jKikfOVgnaUWpE2JO+VpnnI8rrKQImQIcqnzEdP+a73T1AVfqaVnhjwpte6VUaG/UCCoTYYEYfOkWHz56VANKe6/Wfb/Q9ZrGo1tb5RmmDgW1N9khsSg/ClgS3uNm/ktHK1/3Xx/wZu145Vbe/zEXwDKLvKG/m2pd5Q6ghisVYZiIk1+g3PFxnWAl90OCM53l8wgN6jWBGst4UgMKtZMwtx1k6TU4WxvxypfXcMSDkn/eu7Y9VY3jvmq9Gf6r8XMbjT1RHEHKFTrhsjDEOjKWyVrlJ4+T6JuihTECvSwDRonWoGhj3h7AiKxfER3dbB3nU0Il6mlZ/rjxnOh+cy3SMP8jL1lXBJpxIPuyMQFdaCZPEPktb232xybaIZH8e3bvWaiah2NyCmxyjMfctTCa3Y/McAJ8rijx8X4CSnHRvtOA4OUlS6aPTIIVo1frkqd2dsXh/a2B8QzHG7Ht58Oc+a3QgFk8jTieNR40efn/7bePe9d63YdzqAljp6s8ICm6G1ezNLhYgpn5dpqoNw3M9wW+hcimcvmftw7dvj15/eH/0+tnR24NX3x2G+mzRjHKy2ijTSj+H0rHw3SUADlo8Tj0fypYYAbvecymBPsnJKlQz62b8Z2v2d6OrQwXq81c/H7x4Dobj3x2BCTmAo2tR33viPU/REZ/U01mGAV02nDPWnzFSwrp5EMulGSgNvJDHlJMqeaTqP/7w7Nmhbebx6w+vnr7rlwut7UStljL8dKslV3/CwP4Kg3U9BDSssfjlA9joq5t8Dv4ZFXz2KXVNiPYqrtYoga8IdRcSoRqo8poUAHI8/D/RvgWAutVLZdmW5d61OWgQVJ3aO8OC4E9qqXunU/ynu2CyqYHE6Xnivdr+k2bMUoDq3m4n+XO1+CF4w8DtFB/noSD7vW+/3eoNMvbBWOIKQqIKekA+P7MlaCiMWmKPfPutEzuBpvFmWJtFCLbxIcwIerQI0oDQtMX+bDx3kwi7xZs6iPj1J02Li6YR6S/s/vDfrfudzYcHm7/Hm39tHo3un3jh9TxnNH0JCKx7v9OvqEsrLpQXaLHGW412EwR0SsU7jdgH7x0Pmk3xCByVbBvHYVh6/8HOVvchuQYXN1tkf7NrY5n1th7S7sMu7e49xPHIJF1w8JSUoPem9O51YK39BsQj9CJyWwO0UE8o5yStMNuE4exgPAaxqVpSTqIwPMGNIIE3GpxJKoD+xCmAKl1w7qPctuhqgni+1nqdbVe2Vy27v7+zBA8M4JdlCe16dRFqW3mrrnK3t+z1tqlQnvGhgVJLuGChMD5dyPpYAkBXNOaZVSKHPrdr+9xb9rZRQIWe+bHTegCs/ZQXINFDgakfkiQsGU2GnGFkRBdCF2LlaiNDa4T4C0R2I2UPZlpBv2/OAZrt9glYIrKg7yKGFbze/ugeaopKcKQrvHPCrAVvZvs0zzy3e4quHWYtMVIejqqK/d8VpaV+IFC5XFr3D/qj7RG1tYlIsTWb1TR0xAcfXrBA/9bZYFzbL/1WZ1IbuKAWKvBFUAg6hDzqooOu7o4ykje4p7vzrfC9LvQzVQQmKEVp41CMWjBLK+eyy4tA9pODts7bjMK4g1/9KJS/huS6ajCGBdEwwrP+Wq1or/uwtzbgg4k/td3pdTsq3MODBw8ePCQ0ZlmYhoG266iY7kF4Et7ml9BRXhKRq+Ab8GNjw+ou17AsIHZkyGngtR4AQhpkoSCRWK0ownIb7A/2dro6VIUeBsAe/OuZ7tBrHt89/wrgDbimBLA9oECOBd7y6QweJroe2CIulykWChMaEzrDYvr5csKlFx/7Kc/HIpnLTKiK/z/m/m25cSRrFMZeRcT0YDKHKYrnA1hZ/NRVqu6artOUqqZ7hsXRhsgkiRYIsAFQhxb5xext/z6Hrx2+8X/rK4cvfOlwxH6Cfz/CP34Av4JjrcwEEiAoqfqb7XB0FwUk8pwrV65cR7Yx8+avOirHFHNIKyP39vcSL20wDuoGwrlabnqHa+wMV0YbRNS7svXQQVZiEjDPuOxBzb5tb/RqYbxhbQwzjGrmdcu2N8bXtAfVKakb1HTR+hzjzGdRone4Xut9k7Ej6JprdG033Iw2JB0Ms3D203bXO+qkH+XK8PWODdqNfjsNKIWDGlaMxkpA535XCGme7cowF5hsPGHS9r84xmFSreoZTL+Bt1X0kogf3BTa8ywl7kK0ac3f4C4V+jRNzUvzTkci6SAySplHULvHQ3WZl0c1HXq2rWryFHvoyIgkjC1hLamjAc73Ar3rb1RPQSwjwgc6bCo4WovG8SRtK1a9Vq+RET83OxaFCltzZNEMVkckrCmDRR6yNBmcQKZMIhKVgE24UyNP2HgCTkEzkOERxfCYvW67PzgUZAz9Q+67enmil22Dg55EZfxDCRT3OxZwksh43TLg/XYbZrqybuZ6RS5onA9axFw61F5BY0WZQDbfzDbMZfOpnxHEev39ZzFYfOeMp7FgfNyg1UZGO0Llm9QI0GWxJkFyNvvReDOR/c0Mz+Nqg/lp7labcz7NszVtm0z5VGVvMEAQDGriHpmygO5c7lcbu9TtHEtqsYg81/d+za8TC2XbHg9hYlnMvZqMYL/durjL9j2zxeWrqKIHyNIGe0+FlglkqO9HpShAgORLy0XCeAES0+q6/MfqAne7e1VtuICYYFU/C/DhwRlxuhB6HfT7MUpLvPid+45MwVNVavk9pQ9Ogiyeb3tT5dbw6K17e3y6ENyqGqyLKXq79WqzcOV6SvKkxpgmPtiezFPW3kv8wsETv8wkW1q7ybLQDiY92ArkKGvjg5sssQXIIOsXt2svErGc0DVPExAU9lka1lgi1aOXbiImFsZBUWHtC6HvIcOOrOl2K5dlnQVsebjzqgNl/T+Tn7hVXdeS8POnF2mQc6+2TJL1+8C/s20iM3+vEgCsa7GYbiKRfjvHVwnwXm0deWHkJXepR689IUiWZ5Q95i3GHbMeaScf3liOmnj1hb8JbyzDAai1EjNvs9rL9lYmmzmX3mK5l+97SFS5tAX8g4ChCuYmdychIXZX4txLxEOzoPOMsse9WcjqgX5X6qrP5yqZn6vTyBib795axWxv3NtcHnWIPaG2IAzEXr53kPgVM6VHUZipNCajOusOkhEs4Cd/H3/Z1Ov1Afw268fwpyfgty9f5vNJ9ZsTkwATOZcixnH3e4uO5FH6+ePrF+FqHQYiACRt3pFc8xIYlGTOsnry7M8CmR8lWfhy4w4L0X2b9W67/tiFpNFQsfPurUTcJidr3/WAHf9J3ALvFtOWycq3HOtz5FtMr4HMsHv4XsVc5rGY+WzDpuDuQsra73cYE7U2E5ebxXYL6dEdoEqPBwRuM7NwisdLbRoJNxHIgCNwf0k/gAhd+EK2RxnZFMuc+QLeiBWv3QC4EDAQFQeZC7apxcmdL8DZHESkRBayTluHsQf1cmvu3YpZ9iEJ17yevk19b82tSEwTUmdH6n+a5b5Zeok4X7tTwa11JIwP4vLKSz7HIpJD4DjJ2fe34a+HP67iw982JV/c2ezsWgTJGy9ORCAiiGm9vjO5vPI0DGpxEq7hbuEuXDWrSU1GSqf4fR0JqOilXH9iejnCubgM3WgG8eLpfWTb2pvsjRsFELgKrmbAKN8g3Z3Lb1G2VyCRjhBenx2Biylv7k2P4mQDWjZMBp7PV1Gb+sKN4IlIEsTl4Vh3f7LdplT7sLR0LBIs64KwAKnQ4HD9LDhQWrcHMo6kFgYvwjUcaiUzp7+S4syhClEKyRBIHa4NIpi9WHr+jGwoi2sxrvC7cCYUOMeQ7sNKy40SU1ZJ6xC3YvoiXK3cYKaWvoTLCOlHU5nr6MaNjzZBvEGJxnzjg7EJ+FSRKCbIra7yFZwtL9a0iWHtjJadI4sFX7XKiBEeXCs929utgnWRzatt6ydSVgdlv2lAuTr2h6SKzl3fh9yX7vQKKlhH4WoN4j63hNFBTlbu9CiMj25PPEklBu61t3CBYQi7+XQhgoSOrH/+H/5PlmO9SCLfolWr+iLjk2Ye3353/yX+45W4+xL/cXeyYAndkVSY7gVHySjRQmPHgqnB0ekhOUe/u78Sdzt2dBYkIsq2mey+3Bm7uRe4vn9370Psi30WmV+LxCq8lvh6lHsjMXV0wqnvY1pMKGUb287Du8yTwrtHUq7MdLdjg05zYPLY9CnWbPfbfZPJF+xYv9Xu1Evy9lutbquQt9vtN8vydrrNdjFvp95slWTtNVvdQSHroF4flOVt9huDbiFvq9vvl2RtNAadzt7I+q2yvO1us1fsQqPT7bfL6h1AiP1Cdwf9Zre0u+1mr9iHdrNVVm+/Wd+rt9tpdZslebutVr+Yt9Vs9hpl/W23e41ifxvNTlkfOr3W3vS2W51W2djajUGjXuxDr9Uq60NvsD8P3X6nWTq2Zr9TzNtrNTpl9Q46rfbeUnS6nbKs3U69WG27U2+UdaHZqneK4NDrdwdlgN7odnvFaWh2uuXg0Gt3i3n79Wa/dGjNTnuvv/V66fS2u516sb+ddju3gyLS6w16Dcoi0mk1202acdbb9U4eBdQkTxKUqXas2a7n4BW61mv1nlBe8TRhK7W6vVwV/c6g3s2qaHV69Tw8g+/QyL2zqPLWvAOV/UY9V0m32+y1cUCdQbf3tNpEkESeiHes02jnhA4Raff7ncYTa7kW0d2OdRu9Zn5Y9eag/rQqQJtyx7rNVj+/Ss1Gp954chWJiHasC1eu/FDag+bgqZUEM2QV7lij1+01CuPpt1pPrwd2X6uZB5Zmu/XEpZmH0Zk7XcKWGAzy/Rj0uk+dV+25csf6vXoeXgaDeq/71FrwGgrn5qA++A9D3ZW4i3es227nwaXXGTQ6T6vB4OjuWLPV73bzner3+k/sy8pd7xhQA/lh9Rv9p05OJGabqYBTpN/Ld6PRaD91nYC0j2IB+GVQWKduvdF/YleQy7xjrX4B6lrdevOpVYQr6MWg3c9vocZTlyYOowTOh2YBUAb9buOJOzley3E02u1m6z8Mbch2hA3QaNTztQ06jW79QcwNTMxaEN7sWLNX3D+9Vqv/yIC0rNWitUtECI1Wv3UAa8vzKK2u2RzUm3t0Sv7Ai0in2Rl0qCFBAozZHwzojjW6zXbZqdurt7tScIxDggvFvkh4+JBYVeBgMi/SKNYLkLa37QReMcModBIQZ3fr7daD/cDzEPpRUMl/pBPyJHywGzKL6kij1ejWH+wIHoJf3xE8/h7sB+ZQ3ejX24+sC5yjX98LOEEf7ARkUH1otvsPzwQexL+pD4mIHutFIiLVj16j3Xu4H3CW/5Z+qFP8ka6oXKo3rWaz9fDKIEnw27rzaE/0dul3Og/PCdID0AnY691WgzKv2B8W86IOyyNd1HSC2U0Puuml3fTSTKNw30JFbLdQJoYycVomzsq4co7bnd7DoNdXSOnrplhRKA/OchpXVE50r9NtPwx87fZv2IcGafJgb4x8GjP0m/2Hlx4pna/v0spdP9iVlbtWXej2683OwzgSSKWv74Ikkh7shcyiV2fQfHQbtDtt6MhXQnok1iI9Ng6BcUnnoJTq3KBRf+QkQVrwt8wSUoGPTBPm0Qs26NYbD8MMEJRf3xUkJR/sCObQB0q/nJ+WdQNo0t/Qi3D1SCfCVTYVrc7DsAtU7W/pQ5Q80ocopTH6rdbD84CE8W/oxPrx9VgbC9JrdBsPw0Wr2R38hu0TJ26UxGBP+bVbKCupF6zZ6z2MgRutVrv/GzoJ+jhf2z0ooxFxu93M3+RazW5jULwpyPUc9Fp1Ogxq4Bse7IXxgd/LZr35nQPvtfR1R1lZ33NG0KGqJSvFUNPNUBdEJNTol18lWj1gmqS3pP3LRclN5y3cg9ud4j24PWg2Hy6oJOBuHHsL4Hl28rxU4K/1+3v8NVXsoNg31R6XYlhMBLDu1Qs8g/agNzhQOQv5o9XnVFI9EcuGhvsfavHSXYH5Bz5wDNDR7jeKHKJuu1P/2u4YKx8UzQHl570O3ZV1p9eq9wuMonar233K6qV8wGY/D/jt7qDZLtbwFfN7SD04nedDGcoGOGj2Coyefr/dbz5lgIeaiXesVW8P8hup1ek3219f6/nd6jL0Y7hpDgr9bPTa9c4TazS8XAOItQcFDmKj97TOSS5bq57j/0dkUG/U+08pHxe6Mui3C7yLZrfbeFJXNAsG7IwK8NXrNhFDFTg7DBhG/T5isXanW8eERqvexIR2qz14Glr7EIUrD/lqcFUqcOfqrUd4WudKsdVkowLZWeDPDfrdJ9YjyUi4anYL3LF2r9t4YiXZQQqg1ipw6kBo1X9iTXDmwU2o3WuVyCPMxZBbgsGnTge/NLsNfGg2eq2mzDKot+Ch0W/KL/3WoC0XcCDzdhu9LtbbRZkJVDcYNHCRe+2WytNqtDCl2+41sHiv3u3gcncHjSZCQm/QbsimOvWHt7/ck8DxaDZ7D7ESmSERwgEYmk3tXl70VJsTy0tE5CZhZFFYzVa9/UjlnXar9fBe+VG4V3gKN3utfqnMDu5eRXlZo1xsiELrfZFovYw4RaF1MW+938/D1aDbNdFGvVmUuDbajdKuoPC6kLfdbrfL8oLwupC102t0ykfYbxXzdgftUoYSCq+L3e11emWzgcLrvVlul8rfUXhd7EM9v7fTWQbhdSFvr1sflFHAKLwu5q13emV9QOF1MW+rWwpBKLwu5G32Oq2yelF4XYSKQb30Vo7C6z3Bfl5AmVL3ILwu9rfdqpeODaTXhbyDVq/0qonS6yLsdMpBEqXXxXkYdEqlySi9pkNAVb2Woqp79T48tOp1iaH6ffyUH3+3Wy9VGwEJN9TX7Pf6iMTarUFPor5ObzCQCLMtK253exKP9Otdifpakjucn5Jep3RGQD5eHGW73y/VJEGpgeTw9Nq9juQvdnEvMI8HtVRD9sDVC0xniUjjkQtlcu8RlwhatXT8IjeN8Y12eo1e++HONNvNfvu/X2cMy0rZn0a/03i4R+n0BDWl6fvEHmXmSNlNtLyX1gs3+ENyFIvkyKqqLrvxkXuU3n9lZ/vt3sAR/Pl9SZv0Hnmc7UcWW9613UdHoAyFQpKw/R67YGA8DaNITBNQlg6nbrrCg269M3i4D436oN34LTP6pDUOjtS8Y296jVb50Tro57eK4eNNrt6+nhpycKTDHcl6EJkVrUwmfTpUJLAXn90mIoi9S18Q4DqUu38Rpr1jf0d3O0pRtNx9VAO7P+j2FFOiI+kjFye3mz+pzTnUpm4JD9DxDGURd0kCm6xo9YfGod7zxij9MG5MlLUai0Cp2nveND4204+bzPGRP4qckKDJ3uZ5PKTJOIaAVSlfS8oL+63eY2NtdRv1VEVCsrPajUGbEkulWeaQw9F4ojM7ZYH5tdO54qDLhysRRavzqIGxAeP9br+tVqTX77faMMXpkoHYttcBVT7mA6Lr9ZtgOZtiv2m6jmyNxsptuP7M4bHVBj7zMhUYs5nWN3qQReYRAWu9Uase7C/3igcHFnvBUxczq+HCtsmKh2TFgvLlp5JmvGZ37JLdsDN2yy44mBiwc45GW5UL6XiL85lt++SCSgNMPoU8dzwawZ6CDOSaOjNyTYfXz8+H59UqveWL0Yok4/MJO6cO/l2TO3bObpVhJlR0xskNn5OEXVBaC8RtkqvTGU+GFXLJXXLGbiitzcJApHXH5Iat2PhSXl/Z+QTslR39mjalw6+qmePn7A4EXY3uoJT0aHeagwO7lHm8BDrTpIRFzJMrGDMftixAiUt8aUTtsY0MDGjbUYVHcncPN8+nQ1C8JzH3x9NqdUIrPFZIs1LfpfOEGafVKuYV2+30yAuOfGrb/ng6MT0Ywrfttq6GXRG2fdzYmfYb9/qy7HgEDLyV2A3eGrB1YO+W0UVyh4RSj7E5kBPT6tWb3b3NoqYLNwtwqKSd+XiChrgwG/sQ3+BcsIg34U/IW/BnytvwZ8278GfOe/BnyTsYfjuVmKVVzdiKLdh1hjURorlHZpSdcZfcUHbLA7JiC8oueEzOEMTZKb/ebn12xZPRKZmxC+pE2+0cn+va3Hd4oUAaJn+53Z7D5J+B7eglvyV3/AxhnN1QOHy9OUno1fh8wi9TU9dLHfpVWV0dtRy9xGgTddRxNJjK965+P5fvTWdDrtidMlsoVNbWlTVk5p7OrOpYQ+DkcLudjqbOVR4WNNadkjplK3ftTEmDMqkE4ExJkzKQ4DhT0qIMtSScKWlDhmDmTElHPqGY1JmSri75UcDZ6UxJDwCq22u0O4/hYsmnZ2G2BZGmbLZbHQleCqbi9Cxhfhr7lG34eGJKbEHzv7Kx7cbJuJH7ACa+dfqsztY8JpbxwQKEPd1uK2sTMY8KFM2UpkfShhVc1cGmk7Acpoe1BzggBhfUaFq9f37ZNmx8EjOXmLicUspi9N8b86ga02H8nNeH8fExQBcavie2nYzjiRnFO862/XFj52xAhb3T7R8kp+ReHvQ1kuu0Wv2OckOBhhoitg5RJqpJ9znvNLbbSp4ck7MwTsOHJKaHFDALG3uTkjC98zB0GnB2g8vtsZiQb6WjSCAkQqCzQD2hMXj0WN8jFss8gYwn4zS+SaUCDl5yQ1BWsyjWSbZb44skYxs71qCyT+1uezB47JbU76tJVljSy3BnDmEadDXbPHraBGxD70MSyTN8KmnDNZiuAzTH8GfJxWh+3HDqbMYF4IGGdB7bpComNYD1EiBqDT471uPlhC2rfKaCr8PHKp8xMVo+qzvzZ1y7qfWJ9REVAsArGLhHuztCd6xHN16yPArCIy/wEs/1pS8xCMAJzYnR8jmvO/PnyyFUS2XDYPbLI7JhsnU2zWKObvLoyhfzxNnAQcUib7HEZ0nvdXqD+sOLUHacp8RaqgaeYhX3dnhYHKSPmIDDbQZPt4QF8pw33IgGDjqnBVzjk+nxhtUpLEx9uHk2HW6qVTaHM4Ws2ZwJ8F6gR73WtMqcrXfIUik3EsBzOHcrGk+k3J+Cuner3NIEpgqmJLObZ27pHkkjSoNHmeikmfYvetYfSQtVJyaCuSTAAKc++uGVb/I5oTvmFerWcwfgG2ZtQNAU91k4lBkC7rKIi7E7GYK3WSLGwXFjwiL6HHxIjQNwYYSB0oIK5261Ch5kITVKDz2xY3GJ/1/depi6kQKI0I8er7MYPbKF2238zIW2vGo84d6z0LbjZ+4oIMnYmzBwNEKf8fooGXvV6sSJ8KbkeM/CfErm6MpYJhduUYPWY7fuTlNpkclbhpddxeMUcRuYmvkahh+6WgxTGbagykO2gZwZARSC6Zz7wP8wbKfBn4mHnwEtcs5JwuEMorKAvk8wI9isLxUJ2uW0NkwCfVTELG4AoCglssq6IyXNcB8rNZwBYxhJR3Trg0PVs4i5OfNqd5SQgETg4IxFcAI7CYkMg+sQrv6I+iwUdYPE6oAYQC1MJoFgIVfGz9Jmtc48fg93HWf/EIQbjlOpuNXqbsdkmpkrxLD3Qw9gfa+wdN/LMnMY4jGyd3Q1d1SPS9D73QPcpEpi25WQpsSlxAq5odzvhm5pXx4cX4Sj2O2YIK7Zl9TNyo71m51W8yDOYyEPyP0ucwrEXBCcWQr5PUK0IGOK9cHZC3LABr1Hju9mv684roq7iPcd6GF+L+rufHIXaj8qSfyGW6eavLM498j+2qXk347QPHPEc/Z2MYtYoHdyut3EyPqcOmtz1B4VI+vdxgez/oKiDYkKC25sBvBUayzLjiTchymLKR1FzmYEWMCx3mt+KQmQ0KXgOk06aReCjowROwHQbv3uoH1wRWHxlI0rTLqcR6IMl3+9nbrxTIpWp1ewEidfgi/xH93kaPx3Z/JHZ/z3L8HkjyfoRhvta0P6EJvUs+3idCg33sPk+HhIBTqKc5ll0QyB71i/0210H6M+u51Bv09rc6XRBhZWkvDrdZqarSSv0rGEJ3VRHrTqoDYMhFC71+s1JFtp0G63Gpqt1ANe8FzqFbd7tDZ34+QHcYfspXanLdlLS1AMYCu+BF2FRESvchza+4VIXmT43ingxE3qQCkXQyOg93DOLymbEcHQz6yTSMaBEyKRDDe/KE40D8p3s+fY+1U44Kx7je6w4BXDMKDDoAB4SgFSP+w+WbqJI9jp+cXZu08fX5+dO9EOTeWXfJodQ2zBVySh7HqPKsMlYCFz+QKg1eP5QOXeyJO8IR45RCr4co/fq2EgEwr4IVfizkmU8+6IgVG/F25iJ1A6wQwRmxqbtGKeOZXGjoGaeBSD6wv1xD3KAvQMAEXgbT1ycfzVqiPUA7NeWRUuI7dKDWjwHO9RysSO3ZU62wjk8LDHMiaArCK9m6b14KUz4oHszzAaRjzCzkh3a1fijmde8aPdMEWQS3aPrhBMBJ65nluo+22q2I0+yHUbNFLG3TMOUUZqegZtm2QvPHuUs6Ooh9RN+ziSVU+Y7vNQNaF0uFUJth5pmHKkt3d83qmK9lEnRmhgEQyCsoDfocQE70QyQ8jleqG8Q/dxqLqlOjUOdOcCc6wuLiIOJ6QsRO2odMAuZZEaAEA90S8hpPtulozPLgBLhIM5PnYS9bDT99Vgx8r45XqJEhaliwS4+cmc82HCwYEAgrjq4BDxIlziJV8VFZeQ90GHwITQEzCkCU/S4e7Y0o1LePmVinKhL0B0wgDSohEgpZKFSnOm4gfbVr3YsThXxKAXr2UpeSDWHamY6Ny7s1mZaEHlFjzLL3u2Bo7Aklkw8RbL9zAtreYYVwcKTbFf50kUBgunFDvxpGodvdaUIQslKnP5igR0uMGsBb/gKmSnRLoBk3FlHMHixE2Eg5TMFXDjEhPpAtrMkX7Z5nWNzQsFZfwAF7aubUfZakbGFs3cUMjm4bYl4TTi0UhuTwd1jxO1SenIAv02OF5HSlqG+IZJ+raxc6QL/UIO/JvlUeljWVR9nmTfie6QRgaqgHqT+eRkRCNLKVFaadOsEkkXp0RS9P1+p/44c6ml5QPqRHezA3khEtASAschzMsuI3F2c/PTM3+TnflTLTHDc35QH3Ra8pxXh/qSz/FQn/F5dqizFZ+iYRJbqAfkZLJrxQVAbXOQ0NTZZRkVLOCG8KsI8FCWjygevaE7dsNztwYv1gqofDzZsQPRZ1dE6GzlzvbHdWBXJnD9GN5k5zk/sP3P0u2PHHVVSzJuTA4jl7RMGYKQ15es2mgEFz2eOOYIpa/QsWDJhD5wiCyIWcgYbzYfiRyv2KXcrH+HQM35gglrUFap/Dtck59MpQXlVFpI730i2JyypUmlzZw74DThCqfoQZFfIbjRDRmwTh4gv+Y58mvFZyShbFFOfvGVpE1cgrzvSl0PvgIYNhxdkoACMBMo4oRwks4mPGJil3Em5uz+4MSvJPpCyWSc6Rekt1OXiFyL0eiSJLQm6yOCOpFtr0nEkpo3o7atz/UxvJcA1n+o0aUbF1uEUwIGePDQM5rClu4fbGEh0MccQDIOQK/vA+fjIo3g9tC5uMhiUVN5sqFZarffegxB9rr9fk/dm+UVOkOQzEvZ81KQ06w3BxIpGtcfiR+n2W17neHPuUSQ9bYUqqeXrdme0sEquzItMlR6zReISu/4ovR+VAbTC3bJi/7+3rprcJ60lw7o3wJ54+XIikViOZY7m1nslocQvf2C39r2rbGXzoGB4s3Jyran5Bb4aDfb7YUeg21XvJxUggCCvk3dghApMSewSRWknHKy4IlRSI6CbEjCTqlCCoJNQ1951XOwyhQhRHAfi1gyPtMIITERwiUiBGqM4AouWXQ4I2M5UgvvDRaz5M6yWKrxwawFholZurHFLBkyBmkElp7H6RE9yZ8gcmtgAyj8xdKci2GFCJAgXNDt9sa2VduYJSanTBi1pFgz5FeKassmYaiYXTe2XVmTSO9v7DDGtlNiYO1yTkyI3IR1ZPCn9CnGDXPcnXTwdrPdLslpSkIWg0WVk5T7ndPUpRT9LnhUy58OJGGCXbIzytyaQIdmJO3RnCxksHcgc84hcteCBeR+4YeXrg+dmIfRFO6v9R07xx5HtZSCxbKXlC1g67e01+qi8jASK6jh02i0unK3Q7xRtdvlDn2CKAX0lxLUU6rNQW2iBkLc+nD6LPVrPtV+zdc8Hk8nQ6gC/Nei8C7CRzj/1gyAfU2RpOv2er3mA0zaFTC5Dko5Jdyd1E6Q42md1E4sWPtE8yyjvIdOYIRWGmw/HzLR9JmBDPFyVwd7ksuCYFU/HgmScUtFLrZiJmdF2WWp2QqiERnlKSsLQMtajXr7UQ5Xq9Not2hN32LSioscr1aj31dQoFA2EsLNOsCGf4h9fZhhnzKncneo4f408JAETDKgXVKtbFgENwCPCDbVewEAiPtMoFuBerMMtNXhEaZAbAzK6GQwOmQlWJtjiksaLKJ05xzIB8xWJIBg+vv93iGNUeMQvzcDzZGGLWghNh5pQloWeI604V1F2tthEIt2+9EgFv1Wf9B+fAbKzkwP1Z+GHmBnMZJT4TEXo8lRR4ACAHD5kdP5FRSF0s1zJfu9OZDQBeYmvZSf2utIigJhWevpDSRB0e+Dpym2zkBynpEhQFAMBj2wWp9lmhGrDGYXKfCza+7VPnx8/+HsI7vjXu3F+3evXn/3+ePpt2/O2CVflGyOG76offv5u+/+enF++ur04+uL15/OPp5+ev/xnJ3xWU5cdMvVwXjB06PxnKeHIzv9+r3jsRlbsCu4IUQsYZ7UGHjB3rOf2eui3q7gqOz3Vt+53uL5eGPbeNh+0MkfQIOioIp06+CfC/nn3ClqLZhivaMoYwU9IR/Iat4V+CefAN9+MNEY+8g/jM8m2+2HsfVv/5ZO6mS7ndn2h/Fswt5yGMrH7fY1mVH2kitHRJwntv1Bk1bb7UcY9EvbJi/4hrxU6iCINylgzqJjadt+gcQYsAC32w15QTnnl9stmY6m5AW7BEn5i/HZhAJR8IKdsVNK2Zq8YO8gFjOgJOAersbvJhy+XNv2jPML2/5o2x8xllAFX0nFte270Zx8YBakWqCwRj4B9/FtCVSE5KNk1aHDWeD6vueSRxI7r8kFMrtjZzF667wmt5SpwTuvyTndsSvk/P0Ma/6eAmH6abutyPcPIOxZkg/sZ/Z+/PNE6XMG5F6xpxKGE2NQGFB8x96nlIlr25UrWKUzuCS/hVn5wM7YW3YPw3JmO8pWgBjfsvdwYHZb7VIcnRl6K0rETS3CDPKjNn/wgNdmaGgeLh9BS2kotelR6M2E5i25gMsoaH4zPCF+wzGupqBX4eVK5vc71jjEcuztdhRYIFRqeLRaT9bd1/5XUcHLpbYdEjfvRfsRuak3KuSHq+c9Ulkt8AqZP7fuX5yff9z4AjxSO3X24vz8HLxXvxRT343Q/kCm/gVmVWfyPREkH8U0UQkv37/Nv0m5YPb+KbwSAb42GLDdPkVuEM9F9DoRK5XrlZd24vtPb9+c+v6L7PYj0/YSXoXRSg1SpUiH21kaRABwVbVvvZUAtS1EJE6dvXNXYgaem9+6a3gNZ0J18YPrwfh+2YhYD+qDv1l4Qfqg6zj/y3dvkOxV2c7/8p0MtJi9Q7SIc7EwEkIvSLLX3Fyd/+U7OTdhpCfmHOOES0sInQRLdL4UQlcD3t8/Re706sVGFJP0e7iZql6ihl6r1HKw2e82wJsfHBYncy8S8/D2ywn5MqvSEy+3VyqBbVcDZPM1W61mEa72zHSkB2MwAqy3u4daNho4eXv++mz7KfJmIkhOlDtm8BbQ7tUPFTfYGWZN3tqdbb31MgzE1luHs9S7MzXivIa1D+Ly0gcb52avPTjUhFkvGTnFqmntjxBZSEiv8llDYNBYWqlSTyjtuLWOQnD7bXEeoIwK3yh4t6q3O0/p4Y24rMdkVKn9cbqMwpWgZo8gd6mr3G7B0Dn1gm0xK/WDbdHt1rJ2DNVRi9WgdDfFcF66PjF39TCYz93aSxGEbMNj245r4I/HC4N4u/Vt29evbMo3tr2pXfeHUwwbSgI+1ZEOaxYFTaDnddsOxvXJs/ao4VQJPCJkUsoqoW17cB6TgHsars9mC6GBGrSlIO9z3mtT2zazvcApyzJi87JiM9gUWJANSh1A5zbTKYDFj+LyBy9RVX6pnRzaUWhufvAgfRj/B2NRtVKC1pqgMRCYNuY36NgyrfaQ32M4YbCY5cXGXdRi1lp9eh2fpdcai1kQt2Tq+kJZw7NUu0YzjN7PrQnMUa9ff1jHee/GUqKvLLiOeZTqfFSIhfom4FYdyABy0CRMZmMhabAepayHN2tMRB1hhjeYr7BGUsrwbiZv8rK7TZwyWGpzedHpNTvKIEnRQ9NMyWSd3W/mGZW0LKGCTFaZiuoNpGMuSmYae6YoT1bkf90xyHYhrREauTQSUZncLCSzgO6KKcxN00KYvbzCfSoxMMjw3FUgeSxsSciU0Yi0fuJanMjOuKhJJhm7lUuZsAtdNzvnZ6PAuR0F45uJQ+BXxlLLWj7lZ6ONs8Eva7JhNwy03m8m7IqfZtlQPWOG2vw04hWfnI1mzk2V3I6smuVYv7NodcZETVLQ1LbPbXtOztkMbqKncJWJbJtcc1ELwk/Y7+9EMiKXPMZM1LaVLZRzPp5N8CZr29ejaydRZdUBukiP0tV2S+6U/1DbjkZTsmIBdUTtJnLXkLAkK+pc2LZHVnTkwsuKgU7P0l1ttyvbXqnHhW0v8JGC7OOOWfAs40muySmbsTvK4DYzJxsW8hsTrVA5YyHMGFuTzTicwCoBZoyE69v2FdwcxrMJ1HyFn1ANH/f7Qd6JyairVAQxOHOprRXwIzrdevO3U/RltqTpRRGDg6gPYRCTe8km3jHc7SWNNvpNUDsMS/y/MlfHWARqHi+nwwdIJBUy1LZzsUPhujPycLGJS0vuGp7MR3KBMCmYzvdL7ZYN2bhh8aCG4fGABNjYoyq/qNRgaBELx9Xq5vudFGnERaOTaH3d7Hd+w1LKw8BI2MkZ2o8ZWzFdlhWidhLLtAVHG+F+q97/ijPAMLJTk1lUAJeoXArPet2OPAHUbE95UNNgw9bS+E5624VzAF8h7iWcBPc7UCwo8PCkpHPJEmoaO4wnTEYTToZhtUoDUFWz3LFVDavWxBou4cI+JdYL5lrMMpD5C2JV5yRgFoO4JtRKsTqUIAI9aGULshlNccpLpKNualll4HwWcD87kBge+oUFjfiaBMzIlVmYFM+4cDQjCUstAiLqqO2i+qn3Boa5J2HWDR5RFoJmOvAov247FzdwMAol0IVlEK92PglzEM+QC/owHzvlkex3AfanbZf6zcq8caGRuas4TwB6MSjShiuRLFUA2dy+gWySCCeVYLsN4NxIS+ei+Zr78f7sp9fnn86dmEkGq+Mzk73qbBDZt5qDr5tjRJkwqSnGZDEMWTrDJh7zDnH1M1Rj2zEROU6++e0wAhUF3FQeb8NgZhmS81TjvFSTXdCRVnfaPYZWizTbs+bII8FYTJDFBVJN6sAr3HnEZIwBtfA1lK8gBgQj9lKri4Hi0DfaTY22FNfcK7WJOOw1QkVapwbh51F0xcFMji7dbt0xlMF7CNjZP+JJIxMdGPjU0NBS/kv8zFZ/8yS3G4VIy9nk+kRQJwE2spvJlI88EpKICUqVQ4wNiXMOMbxE3YDQrA1mszwOUr/1mIkjCJY0upLWANFIgooTkEiGYe+XmC6WQ7eogb0YR6sx287ZMwX7vlMk8fxp6cW2nT3DlZgcYODYtvxbnicW/ty24bf8e1RbgPbiguZsNU3ZCKHbrcYKxDK+WJTA8Qyo8WGaRpluutL0JH/em2uhkKjMsN3mlyZvg4KmTOBmtV/kniIrrV/OjCmyc8oWP6ipgGVSrROjltk2afA9SB2prwCt6SNLqDSrbHdbT2LlaO6yxdJHxSy1kCPUrj9yNMnrOhp9A1s7T6GBHdJXsdBdYs28a4tKdy2HWek1V8q9wRT3Yfxh0GOqrzkjoNTUB6PvW5KRZA7CLel/JSbWrxZeHPc4IKQOdGPJMaTdA3L0EgJYBuxUHHkyYRyNVr35MCSbLqRa9VZdUufpmZlaVQ1DNEoAE8NEcovpdltMKhUVQGdMZlahDGDsXqk1kCJww5RtcRDOAzRFtKbuJpaR70CiIZhKYGCItImFRHPd8jgGmWDZpLZhOz5EbadKZxuVpSs5LuDDToqWG52+Ei0PBu1GnyoLna600Kk02IrPIWofBsVc8HrOlkVqca60vOleorrXLx3rvVVdVKvsRqkYo+yFgpWIgTekBpAJ7XdKKyhHm0E3pD6T4JvanCXyTqC1hsFKfxiNVxPeYIJEVKEKMNSuzbOKcpbQkA+uByhskvmH4TMXbwpwrMNlgfMVvU9IwELWoMrEXJ9QO5bJD7VtGWq5F/SU7vPUKQhbYmdam+NGViZRzr4to5fpbVoxyviy00OMhLMfTlmMrHPLsT5YtCq06idwG7C6tVHdKws/ax1l68waXsMGSBU8VpOaXsUdM5TES7p5oI1KPddEpbHfggaLHQuDV5EQv4oyOnVp2zPgnID1r2oMlJIFBQWUoQtLDpOM6pJlzHfpuaBfbyiqSWFIP9tDm2zjTDPmY0GzXaEd3BftZrcpVS7U1ltxtf5Hrh8Jd3annQl4v0Kc3gWPM7KMXfNYu+aEKfK227k0RJAn4R1Xr9stUU+o5n5N2SX3yR3cdUCREx6Xbgyqm/AYi4QOg33LwRtyx3KR9xdklV0la3N36s4EF+wM8qEF/M68jWbrcIkVAd9wx9yyDLKlHWr94UhuOYb8dhOIiz4b38JCsZIurolgt0/o4hTyPdRFWdFIjG8nzsFuykw7w4z4HlSPA1RvDFGf2mUiwP3rHLrChEB7wAjuAZ+lernOg/4vFKWD+2JDEjiZItQhpDXYwMCDV5OwkP7vVms3AZbcUSSmwrsWETuyqqJqHUXil40XiVlm7RntpPJg/7DuIAuzKw4aHxsKPN4TowfkLHgrqGlKQmnDz1GL1Ru70sUL0GL1cj+rSIEYDeSiGW63++2lCi+QJr0Q9joPcE6LfK8MQaJOeLPffpjaMEg7w3xaXhljRUwiAulLB9Kb/Ek1BbYTuutR3EuLZRImdNtz8vcv8R/JyJn6bhynA6ZfLk/YkgdkjtGR8eSVj2QDOKZw7ay4OcV+Q68T2NFTJsCYYY9rDNqci0eqUiITPIBQTGKdxnfBNA0+hrpS1ncikPBTSMe8JR/TDhg9nW23lcqSzBlcPUtZ3MOF9tduEGeVNVyv95igw9SwZ4X8ErrdVlaKny2fzSICzg0QegogWxfOCqjqZqf5sGgupURPfrf9ku2XL7WTPb8h8l7jj4HQTe+1EQenTVEFZLkENv8oIAl1KpUETIC4VwsgejQcHGUbT1mZC5qGV3YZyH5rIHu8EdELNxZwOQSl5JmbgLsDtuFe7d3pp9d/OePWO4tNQRHw/Zu/vnr95g23PljGRvRgzw7ajdLItTDsB7HCvjvSkeSMcOGojYsqkIWNC4d3t17uXtigrdW9xf06Vehh6v0KnYekeIsk6KqFjiqVxLE+isXZ7driPEQoBClxt/uIH1nEAQUMIX2fSjsRRAyZQwWjk3HZFWmPwNuVMJNDYkllrwzvS+8nnun1RO0l8MJTHzzie7bci2Q5jynzIqm8EU3TIa8zz5HzjBu11C5N0IdkRgCtCvtEWkEn4XotZlwKTWuRiDd+whNAVqtHjiXTlj7vFhI5CWAUws55hVSi7bYS1TLrEMpOs+TX56mCK2VXRvq7T2cfP37+8OnsJWUv4LxmF5S9L9udAagYBsySWxgjvwM5syKAvOiO/Vy6pUeIaNnV6AUBi0Mmxo0Je08d45VSBz+rZIomOKc04AL1GBGFk2s+h4VXJMTsIJcOyvrkWslJpLnlhgg6vHx+N7xTPgNv+M9EjO/QVc6ULNhNyg68GRpykhW6YAw4EFXX0mXWmTJHH1bILbrBDLQbTCnShJpvpZjXQPg4b8pbjaC7Mu/GN2juUujMbq8z0gl6qY9uBPAwA3A3Zf8+phzO4iHgBjxkcbI97sLVXe1eKu8/agAgAlSrEGX0mcdl7LtszID7mMfF7kBRsKhTL15ma+hRBoroqNb9oHQuvfwY9muGtrmye8hpmytl8nVGM84zxfUlX+doRuALDMcTDAsCcfdh0S0vIC5XiYTSEQn5hmyIe0gHmQQ8pA7c7aVBF+cB8Pb35Zv3qSArGC8nUrtZWoTA6R3w+50zx+p8ElDKYgLZ6HY7JQFbMnKAx0pNls/9ng68E7ByDXhnBvQt0NIlbFBEj6XO8uud3mMqS0T7F0NHlN1G68CllsXMZ0DmrTOMPs8w+tLUuYGbarsJR6lytQIoutceSAMBUCME+4CIoDId+sgloKMHF8517e0mQeXX95exiK5FtN2ua1J5q/iF3fB1prR7xtepgtstX+vAKeisd82sXzZiI9560yhM3BgsEM9BW/xCIobhOYj6izJRwRJUQLkDa35+VpuFK9cDzUWYUS8hdAjO2BIe1uYBC3ko0RBs2cRUn5A7Khx5hDqutsMXu132jDUGiYiAlFptt3fb7fV2W7ncbis3o8rCttEoMhJx6F+LESEbnr4pEoPSnGXTLXiQJZtashQB2+RkY/R+SgK6o87dKJd6hr3/5E2v4LNDZnxOZmxdKDuTZQmiEp/fKHVn0HgFJV5iWfIIQiPmUK4T8dn9dOlG7jQRETJ1gA7O1+tL6jHmlcIeOc/fzyRdcj8PHGG6mdkNDc8iCWXhdktCnjCPUMC6GNhu0HzAi1V5yJ1es11aRvkBDUuVJSoPhVbaY9NLZCO/phoUlZTsBnsCWR8R1JDBywLwWT6pYFOBbQfP2g1k9DX77a9wbZoaC4UHZJClSiCw1J8/viHW5cjlDfuSN+0pb4FKY5KsnZMT15JuNGLhRtPlBzdyVzGLuGXapLnJEuTd3Jr+vlmfAVta2cMWHSwl2kbdurQoi6o8qAIuBrOQiqgloYxfV0kwnOF2m/bhBCvGDkLnUPVwGYn5dmvhGy4VsaaoVuvyBqSpBVDjOze6TyyoyYJ7XAUcHUIZKJFOBTQbQ7v/dmnRGijtwvC2W+vSyJarsbQVl19aVNqvg8bldmvdBsfHouHeXU732nNOTv7bf/mf/h//7T//t/9i0doyBCdL1u9+/7L++28bZZnd3/1P/3cLze+X0P/GFCcigkbKst9aStlI1i0FQsDhfHIcDsW48LIoQQZoAY1h2yeBm3jX4mgazoRSOneJB011B53mo25HlMg65CWu4IZI4q/ltuZSebIAXN48u6wl2236HCmCKL1NEOtbd3akUEQ+/MYwQQ/dAQClvlQAjpaOzlUK7GWO0uVsBmrz0qNZ3KCiF0q5u+Uq7J1+/XEHkYb/uxIhvGU5ib4v9+qt9mP3ULg6PyXgRhbgIo2x8WkpjkAVJpwdzUIRQ4gQdzoV6+QoEouNDyjldh2JGNX/8p7lmu324zCQk5am4irzoplRpmABpdzN9Hv9Tk9Spq1ur1uXtKkSZq8zP8RzngsVyZblolU2y2mTmTh0XqQ1Qexj2+AiYU7uL53Gjs3JEsybUCprGrHumcMvpW2gdZlG22gxs0ADY2+w+0sH/FnWLjM5iSZwWQI/UXr6sJBb7uV0JuaLpffzlb8KwvUvUZxk2HocTXiPhdoIwKJluJreg29qpJNB0XsOwxF0HE3A5wC+JZSich3UUOFhXoqb84DLp8pZSgFumccbbM792pwtQTw3DJ97Q6qLrdiCrw134eBuFt3hjeCWuqBsThYUBMILID6vdZ2XvD68e345pCt+Pb6sVicMxOouWbIFWyH3HIQ/i/FqYrDBnTlEXmru+1YuXvw6g0FLSU3RKEBJh6RAx0e3w+2ucnskBfxsmgl/1qA2+Prs4sPH95/eI083xwJelvHHnkmttOfIw392YlUtnZBXaKT3oL/sJYIsYUWA/Jr6YSwIHep4tGs3EkHyI+qfaA6TBgppR5/sTD6LvHpLf0mnU8DrP8lScKas/LmH6juZ7b8mt1fc2qQeOlP1UU3jj/SDIsVtOxgBReoQkvANsbx5hJpztBaDmVZt5sVr373jVhAGwmI+KJuJYPZi6fnooSepxdFUn/XWz+61KyfIsSgjgqNX9iQbt26d1sK1CAhlxrSlPXulkEJuHpmovYLYHwEdZqDtaRlwBB41lZ8dg/c09sbRZJLxmOluGI/XkzxnWmEgSYYXVWmk+MdQcALaZ0TmOUcAKMyGdZoz8wMuagTtCepEfEVyCscRWnlGUj0HIfth5ZV+a9DqKX0KaShf4PupkAZ+ipmHSW3OUa1lVIZlwQg3P1iPCGN2WcBjWOKQ+wSjjIR6l4PPDIwp4qLNfcRDjCrCgnE0yR052NFHKBw5QlSK6LfrSuNFjjXOBuhnrgJyGnNseuAEWfPH9Ezn3BKGLdCSW6a2qMVm3NLODSycynDkjUp0mGNY/0ROUkwiysqkWLZtKGtztAOXBkagVRLZNlpoRLZdiWq6Ue3sai0djMJWTb+hK3Jw6aC82UU87/oFfd6D17xcapB7NY+6uc5vpAXGi+HmAQ5FtcRTNQs7Z+o8ZWJcasiR0sIZCgOmGrjDgb4oBzzwSLUSo3U6Bd5EGEn+aLxZww42ZKq5Kc3NEKoeMGSvPLzNDBVORcl4mZuRwjZLATJVO5imYPwo/MndOVrv62cIbs7clObEhJApP2Xo0dFQ/ayAQtVcagbAFCBXqlR+aphvGvFQpLZRbS61k6Qj/5g/olF5QK0bFWdGhz8RWZpCDKZHaHlQA5eniJIqj8otb1DaXxRLHnkEtKKJoI7UipTC7kGpMW+n002V2JDMUHQosST+s5ixlyWWPTzC7bacZchClK4B3YxdgA4crCmNKt1sP3JhNDwUmG7Es7BJihiSYZMaXWQnxzm6aJrpGa5Np3wGFe6PpgV3P055fLGhihe4oZlvxc1EebTLhWzJpGNg85CY1hKAl9JeOLlP09EanZBjLPzOASWBlFmTOipIQR+PUIgHCJNTYlmFIbbM20ZMMCSLORfbrTcq8xOpoh+QirfdWkZAQKuCnvbgWyUGgl5QKrUqe/UDrh72QnDc72o5C1cwqwaofURJIgMOtd8lboPoWdq9XJ6kDtKAWo9YOaZBSuqoSyGdQsOhEtBKSGIWoS+IAP/6ZMoiSWgMdYCM55shxe88GW/grmHb5N89zAget+BvSllM8Tqfd+pzcO/u60yDnOPhXYmIP92Ve/dkUFwsVadlwaNER4gEmYr8c99wmhDjR1EX+1soKHgnrlTQPXF2Lu+cCFzKDh5deUN6BuFNS6YlH+p9uyXWxQXuuosLywvud6N91j5omaIWJ2BfInhAHhk+KUqTmNEISjooJREbT+D0i8yNjvtnP4pD3vVeKnCLMEIMZclIGv06US1thwcgjCM0DeTIIMb8o5rjKS9EcT28bBMB+01TC7U5Re1svW0eC7BkhqoJuafD+YWAhjea3l7zOpvDrpo+Xw9pxDfjtb5axyRUW2TOxGgcsRBuPE5oUuLzfHwj7YAHwxox5ahnowSxg05eEFbOKJSxKkKtYZWzaTIiZuzrw1tjSUYcWVUVwAyM+mDLDfqNxpONa4yjLVWffrIZDUNXjJkmLtDiLom4SPtN0SlmwJURjT66AmVmw0VN+QZ4KKNuoPI1DUhKN9bRf6dhcC2iREWvPUrCo3XkrTxk8uqYV+DRrdHqHjCg2INeqUruZTy73CXrANctMPXiwpvgB/AcRsvkSnAjxBONwe18njJ5Rj5JWAQfMFoPmqGVyGHb9Xq9+SQD63s0InEqDeVxThC6K1J9Ok9d50GNy073AKGQRfUxCAXknD8Y6CNA/hoq9eSD0Blh8xLtxBcdO6lZIRC5QDG4Kez8SDPY0bS4NZCS6oLnBKV8sxTuTLGN4DVxPR9fd0PTU8Ge611Z2b2XiJWW/6lSutJRWp+SBTpZe4nRWLIrslMlUzTNDZsgm4C0DoHVGhVJldT8KCgTNehjzmN1AvRyp1m6dqgF8QQPoKHyhABaRpsgducgOR6HkxH88GQcTlBHOGTwCFSKwc3ARkoj9dcf0kdhIb0PbTs0ju2RvJo6unsA+f3mA8KgpwgKpC6G1kcOUhzi+r4WGYQBaCPnBtUePIx3S7krB7cD7M+QBJkns4QVXdJmnARwmp/6OAVzUJ7sjCDQGJbm0Ug4zX33mUom62VHOFhGPRpuUpNcyM0La/OhZ9sQnArCj9l2RBIWP9XBLiqqoPs29ABZNr/ZEZqa+rgpLDEvo9YRP3fSWLelYaAe9GeK21B5NY1GwjG8lwyBwvbR8HXK/P3RqTXcUbaxbbCQc8nU9JEjLY3xSll2/vR76QkkTZRQhx3dTj4WPmssJmAFBkChr+tozvEwiQDADK1YFxfTMBLHP8cX8dKNxOziwmIeD8Yu2BsTF2wA8kq02NdyFommobF9OiSPOZaQXYc/WZDsZJQ4YHdAiaU9RVlA5spIAPcqzbFatWa91rLYKpwJJxhZ600kLMeS1q0Wm4brOxmT0vqv/9ejZr3RPm7Wm82jlyLw4qMPm3h55Ubi+oj86odeFE6vatGGWgzsrYJYOKlgfeEly81lbRquTnTOEzVhJ5d+eHlyLTty8ub1i7N352cWi9Gk7kk1WGBf0au3+71HDtleO68c/PAGzeg3DzdpGfMgZa97261EhyTiAfEoKg4nTihNkrvtbrP+8IVJBQV2UwkxbklE0khsgvklaOScJvKarF5fhDNxijS8ESmuEIu6JMiqVoUF1TAZ1EB60IhQhS8VcqiCz+rb7fw5X44ESJ7lmB1Uo1uzOaXPOp3moLvdBs873VZjsN3Oqw3O+RL8hMo81Qbk6raa9e12+rzTa7VbIzGKsbgTOGK0gUc2rzapMz3GjFUSHGO9z5416rTa7XRa3fy1YopDd6YYOFVODbxg5NReqzlo/Ba/JM1Gu9fut7rtHvP4yfjvX+rHXzb1eu9scsJifjKufdm06vXml82rV/Uz+O02JicL5nPr/bWI5n544xx5wXqTHAVCzOKjG28m4F6ZiIWIYklQSy91KFb46AaLVK4QklgZdazNMKZzJe3C4Icv1JqzpbTINWFgZrpAWZl3woXMnIaiuzbNedmdfDPMA+iB6CrVZrPa6/yRiGfNbj6iSi5aRh1ZLYJHozURJ716nTri+fMGE1WQLZwApf+83ekMqcB3gPugylvdNFIsCaqt7h/FCRHVVp/SfHQWIxRz3v4hDRQFtjURBzsykYLys2CoXeUviWBRtSp5hc85Qplth884gq9tR8+UV303y4owyTnptjuNpu3S0YokjJBGvdmyQyphVL65ClipQyBPSFl0fKz83MuEjOYA0rwkaqzHG80+hGxlU95rqnhn9WH0LBtOtUpJwEG4T581mn3bhqrnJKCywkUWCPaaL7CGhcxjHVt0eI0haaUloftY/c+5BypqoFF5xwNZ/xm/rmLM5btj7/maEPc4pidnNBWc+JLbFlc55KB/PIO4daykESAW0oF4tl2txs9dsxqgMzn3stW95TG7AGBRIzjnF8/4dNRwLp7zabXZHTW7zsXxFArePjunaI47lFEkbo/P2RVvdY/Ph3K6Lsl59fT3V5RSUD8lpydXlF0AJO7091v4NuU3JGZn7JrzBcV1uYZIpnG1yrxqVS/nDGbXoruHqD0WMeVw6JosyB2Gn0TjHPzBOUt4fZg806LPYVKtUpB3JhO2IgGbEo9FdISKZVb1jETUye4MMxJgPXCb6zZ6X+OY6bHjx8BUD1KzeJgAm4WiziALkJqS0bnr223AeeOkTlOuw49RGCyOAvQBCzG3I7EWiZcoXSJk2AbP60MSPH/+nDcwJG+VJ5Q2bAwrB8+GdSNas5RpVUtf6tq3ecF8r9dqH3I7YnD4SvwcVIBaBA8c1j//8b//r//D//w//h+B95K9qK9AxY7FRPu7FjLAeq/df8w1h5r9wprI7mqSIEXpPrfGVtUD7hbbcGmtRKy/W1W/6letP6KYRaXKlG8sOGeeYowK7EGSZOzwhg3354jHJGIbhtonTSNpKpOi/GkdJ26UOGvSoEwEM2dNmpQlkbdy1qSF19FmiVGZVmPPm2QrR5KbTJO9EFbHMMlW67zMdHVmmWuxVaa2s8j03a9T1fY7HgOL+PVqBX6RE8EueVzDcCxZ0g2PUxX2Mx7XXnrxGq6Y7JbHmbOyCx7X3oo4dhfixdINAuGzcx7X5MHOTnmdXYFe1wtuhQEah6NJ9HQJm84aSr2cuOaHU1SmLyjhvC9ezMGFoI6ylPAr8GivVFXgmSXAuSo3NDKA/L1UL8z70X9PBGp9U/Bab36Ia+swTtQQyTkRlCk3VdPQr1onJ1Y1QEVUuhve2fYl+mTcRx+znMszDXBX42r1NBeO2SdT6SXqNvOqh3HZQ3JK2emuQL8Yo9+x61Fe4fQm06L/GW9+zpltn9WC8KaQEdN0ngvbrixGxOPERU2cC1oDSG8yF/82amGwkrPBXzMwcPHMGWIe6LLV3Nns7FpI99YiEBGYLuVmEv0Z1LwVVHmOMo0YNcYt0MZyUO83nWXbrszJOzSnecf26wbXHFinxV6zSoNSJ+QvgDm1IkrHzMpLuJc51SsjF63tA6m5OEsVb1EWxNOASWjKcQZjkXzyViLcJDinrJ53rgLfnTsmI7ZeospSu1HutgePLhX6f+XeMlc9esFj/oQy3uhR9Kw+CklUTVgdZPZKTwolLmUsOKlemqHpx0x2UqYC9NZgs2JPp8LzWWQQ/Q+csQGvpkbMQQVMoeD+GYzgRva8PoqchJIAMSqYEj0+X17wcNfFc5gXSGGDer3XGAyanXavXR8MGhSdo6Os/xE2iT7JSkxdD8YyxzF0B63Ok6U0iosu1TW7XR2bRzpvQ6fCIPRR+jOSZzfN6XatQSMxCT9omYf1IB8eLeJBOzi1iz8Sw0yTTbC1DsGbaqpL4Q9JuDUTc3fjJxby/pCBmVBWcUmENWae1SIlopl+jYimyJ1QbUo6y6LMJzJSHkOFojIzNJh3LdWGmXyMhcm09IlmVogJcECSqmWhj71+ifX2/W4YjA+wGCfc+tUy8EEqyft1AjI0be0B4lQgjh7RUkG/DAB/stxhpra2nubK0nvPlOCFGwRhtgyusuuRcw/L4R4Vp8KVRynaSR/muD/YM0PdKChRN9KuhXYoNWg3+g9RlnWNICM3mIUrIh1lNWqGi6wHbfjlgIlVJWaUfssBU2J6YVU9Uq2GVZe1uigsbJZ77kJDLbMlkC7nLKP2DN7VV23rBOA7aPWe4oIt30yp17V285DbNTPzjpq6WFro0G7m9RULAcga/Va7/1tFLVpH71mSCVrehcmRCMLNYpn5v8zbXmC0mINuXqQqIIBKv/HIzlE8dTcjq72MvR6n6yjRa1O5OgmJdXMVK9UuuWqg2mXbUzA+ACOA0dTB1xsvWYab5FwkCZiNeoc3ZsUlG3QuVEH/rFrWrUBjA44+dUhFBSdWVQxjKUIQdAQ5+HQsJg4++ba9Hq1JQp05SVKMCZ+Qfdjut4ohOr4kX4Iv11/mX6Kj//o//s//53/88x//+Oc//vM///Ff/vmP/8U///G//Oc//od//uN/9c9//K//+Y//zT//8b/95z/+d//8x//tn//5//L//sc/vmya9WYffwdfNnMxn1sQJazb7P22uKPSoYKnrbLjLAYYGm1DVENltC3ttwseq5RuKQYJ63cHKuoo+q7T1yMMW4pBwsCKSV6P1IkJ1yMMTwD3o0UBabNLHtbkWXrDFftxL7RyqsBVDN/eNMK3N3XYVwy7e6tis8cjuGOitSoD61xMdW6pQyIeykCVG4j5NCURu2MW9gQuo5lcBEjsiGW08Ap539cqWcU8mJNIRjpgDUrZElV6cE184Dqm3BYBLvAxnqi/k21aqAAQW8yHC/AwHsXkDMJk+fBHRYECMeRQcrLODMn5hlxmb+zeEDbArRkiYao+4ysa7bor9XK6WERiATGM5IB3dGgGxNyx+3wO5ww9nncG9e5vg0DDWSR4cNqLBq70PwuOQDA+n4TBbht0ABEGO/VOt68D1SGIzTJ73RX4B/PiF6ghcr6Ga4f0UMEWvEiOsmtuvXVvvdVmdeT6fngjQMl9Jm6PxO1UiBm4WbvjoUHvXfLZc95pbLcVt8RSNltqgR7kGkxoxVyIo6J4OcAFJ5ZMxyC5Rby1H1sZHMusJsOio6wEPc2gl8mh4TVQOrbaiz0mTc13CClTN3FKeY3Kv4OvbmExXxOP1WHe64rZeNwo2YnD5FmAnEdvTs6Iy48baEHiOdn+TCbSn8W8iu4bXEqfL9QBdUeuqcFUDoHRy+bAx4TrpouXXDZnLvB8d9o3yfw5z5XHPNWqESxDd47PGcq6Wbvf73xNnEUZSllci+juKfMbkVa7MWhTYmERi+7YPT45pQxCFVp6D6k1DKTWmKRKgLgB6yU2aPk+Dxr9bl2HHGj3BvSBnu/Y/dzzfSfcUeYSYBH40Olmo1P/+mmae34ioqfNk9zB2GIC9wvZkURE/7KZarfzGqdPHUMwew0IwJg/5nErTbcYOEXAgJ7jiW3jCEnDKJhXxeZA21H2+JzEOAOqjn/RJDCXeAgz/VbrN81EySQ8NP7/4ND/taNutltfQyt1uv1Wjz4BeMcTbQ0LNq3sXr3AFgJvvs3SqG9GQ41Gq9Mua6jo4hVOTzjQ8ka3mBnluoD0KW6cKFzJ5ge97qD+NSut1eqn/mYm4ifjDF3gX7dilq4SUMFgUO99DZVh6I4WDAU8rlEyakCPJ/oL0BwVuBY0TmICnrEa7LhOn9XZhnvEUpmsp8CDv91WNjgnWKYsWMeTJkKf7f4o1jMI3gXrjpu9ImIbNHs5yNZTcQigoG/SCaajSC+opdtt9tqPznE71SxSWKAQzwDd4yqXblpFDIg5jGes5C1SO2ma3bHXKtSsEb92zj30IbTkXi11e0rWORsWgoWYHh0p2JLLWZKB/ddMTQWsAsOVcersCnBMAhpObJ9yWyp6J8mCX0Vc1KAMKgVgJdWqNnJIQHcllZiPiC6knf2oW756Az9lSMQ7UrMMIhCoHIH62Ng5Osax8RVCqWcZVOI4YJCefQAOgyorrwkz7tZONYjhc+TeSTsn2QHKQpIWgWcdU5myysa2p9ryMwZ5wQzlkGjiF5OZjrerOqPz7XIx3lmvM2h0HkaE3V6j3XnKDgsrHC6Gvhsnr+Um27F7403ivm6/1+999Sm3ctdfR7Cs3DVSKyt3/a8j6hr9r8J3cMEe0Jov5glzMwznZXegWAsknzI4l1iRmG2mGHMMcKL3vDewbe9Zv7Vj9/LTE9DasDgFCUseGnWj0R7UfxuWlxdIT2L0CCjsWMCgxw3WnDxhyIoTHNOMKxzregieqeqlRG9WomOq1cHVBcN4pszTcboxKmmj/xtvzHqUwLNp9tt7N2aUbu27zpQ4e51dnufZNXmZXZ5zQm3wqA16gHhJnhtqjqD3JdEHu0ulZU8BqtWO3WOVTiknh4VszqcK5y75hsyhGz4RbCkdkhteCJZOwpZUGraAOQuJ+NzUrGREBaGKOOfX261LDFtM8OAU42epbMlJxKPxAj1Qkkiha8pU0bTVTJoyI3O2YpfyeoquLrK+RaNrJ6Lkjlwer1idIqd8uHp2OVxVqwz8/a/g+joHP/M4YtOniI4NwEMWIKS0uvXm10CKRGAQderrrqZQAlEYPPzrrluNRucrOl8e1c2wAVYQ7WeaLJuMiTSVWhh97bhH4j/kTkLYZQnnGAe5wBkCOgb9syxApQp0GhfoR0xqNC6U7iM4LjUpBJmH6OEiA6c0B4AYfj8Dp5aQZIGaWGVT9AY0S6HrWU9GNCBz254/b0kOyTIX7WClM6+edeutoTJslLwacLImNSe7naF41usOBajIKVfbSZkeKBGUCRWntNt15N+B/Nurq79NJ+ItGZhChint9tWXhhPxtvqiJJJOxJs7uT3qw+BZuzcMqlW6UIrjV05SDdi1E+2kJ1c1V6RUMz2pXR+L2jVeHLGyhT5doMqEL8bBpHal1JpJnbJQP2v1t+MGlUxbEhq6XtbL7749e/X96YvXf/oBaJpwBxbSj+8b5Jhtt5Wz7bZyi3smKvDNDJ/1rlbOTLg6AlClkO476LomCXWuScJy2pzjCRq6JxrXwPg3OPDgSAZ2uSMh0n4yx5SE7FGt7WLj0ei44RjYteFkQxhVBRaCa4dPEvrcJxEdNZzjBvolkEYncprV8kRDCv3h4TioVidK/e7ZJnV0k8j0zGQGI0B2G43fdiSqI8/LdBKKuCLjIit+cYGLrOk4GeEFXSyZ7N1lphIyU49ewFb7jOPFPuNYTkzGOX4KWl4DTK0fPCfX0vt0ypG94TG5BBTjgjQB8MseJebNCSzu7SjiAa87DXwmUr/55vgMZR+3x00W8BlZoi4/q1MGXyi7qUbHwfOVYqyCCy1cVeDYXrIAJrE+XD8LhmvQ973mZ9U19YKjS2DRQl8vx9cTqSatz7eAoXY0VLLmZ8P1s5tjWfqOr6sRI9d8XQ2wjtHl+G7CoQZHgQ8kDGXJm+H6+c1xUI2G6+Njmn5fHzcmUlPam5PoeUBVbmjj+RnmxXaOG6ql48bjbcEII+zj5XhdPZtkMzxeV5spOF/qIWK/WCihu9PoPsKhzUlFVFjrsPbSTQRagXlGQMyF1HjKoSrIaN7ug/CmhE6VMi9Pns69VqvfeIRtDHFZc+2kMRb2wFZ/wZCRiE3ZPTwqJlizM+iUMihM8VIWDc/RM4JUULNbamNYPn1oWudlCp5xNqV+Ri5sUhp2CkZ24EHU0ton3vwOtVpjclI7UbYUcx6btjPL7DWzm4hzqrQrHue0IdiCn4y/bF7262AM8vLVq1dg8nHNT/5upH776tXkmxN2p1JfZHm/OclpI5qGEuiO61jGdoXHaqbzuCbXDNX/INJ0iPGj73TCNQvoyPryZWNVV2RJBOz3Rpc6Yocxf/aVG/4AmWfzehf+9Ftt6w8VDs7pVKJMo9utzCfcmZEB3tADydS2DXiS815gc4I4Kl0J55BJbMDrZe4BXb4hIR0Gz0I8JV04ibKtGkgnLjH3yBS9gDM3JQeKMa5i8BnIFiB4lZKi7qDRPGzJN0TVhnqbkqAGw2JqcGBKxFq9B6QnQO92+6C69NZd57hYZRq1KkWQfMh3zXbKxlrP8VOBZu42ujKoNqpAQj/AkLbZfAQNoAfOHBo4EIIs5yRT4QD5olnh/VzUoH02ZbFiUOFxKppNCGJKdHfnaKUERGTtQe8R9GB4a5POGGvzpw+n6H2uwrmrOgYDLH52XBkHvN2pP7lTmlH6Gzt191CX7mSHBu1Wt/twh9ChCK0pvt8DfQERpsxUflcU6v7XHTTbDzdpGlBkrn1Sd/JxNk0+z+tbecqj0eMzBt6KfDU78V6MvMzLi1N6+/CIq6ORos+Vdr/55GVFDxcFnyt6dMxPidCHxpAt6qFu59cgtV5DGyFP+lCNMSAjupaZgoEAUDPaSwu4ZsmC1Ugt0kS5aqHK61FieBSYSkZdr13vPHlpFQWeUzWSXryesHyly55fzkMOvXJLKBew2eg9BpNpLNvUIeXjndTDzfXVlX3dsXtgsDsPqShjlN1GLgj+o8gRTsn82LUCF+LazqDb00i+2e022k/a/JJ1//Del3ke3vqd7tfwccsDUEsfJnLPgIMVHdAf1MrMHiqX3GYXXd8HZUBflLkRwYMT/byQhLKAp95M4I6oHHWzKY/3lHki7pJEZ4Y+jidox7fhjaFPRP7klqafYN3nQ/iSDbD/SMQSJihGZ8jLcP3tlvgYpQXC2oGdU7KJHWu+AY+5PugcaV8w7Ph4A3GePZqXWh2uRI4I6oiEG4dBsRL4P0vYGR7LdGzkDZlqf5SGsxcA2kJA3Ufv7XJ183S6XPw4W3E/W/FNuuJsyq134VEYiCPVgyO1EjPrMWgI7g6DgUv2FN8YmEkhcKx5kALHHJ8ROJYF+liONDSBw9XA4fMGWyIElEFIiBCyge8+GIKS4BCEbLZbsIVfwvpi9NPi6qsMG8jgglsYwY6P/e12jre+iLhsSo3lLn4x1n2p131Olnrdg9y6t9ut9tdo0iiJL+JUCMex55Q9DXSoDPA2UkG131Pcmm4njSAsfeagB6P9RU+vpZFwfQNBVyouBC/LoWfjTj33Atf375R/O5h88/q827G89rbWspClSmBrI6nzmKQ9Q06Zb1gKSc9BsMzRyIwarPceSZggdA8S0gwR9MER7CtLq5BPsjDeDCohnPKusvSbc7PT+1M0LJk2cGIPAg3jE7PUN4vN2b10WSRd5wCzr99vPCGgFIszIPIzINpk9/2phpx1hkfmGYwtU3BBlj96YZIsf6XovOD60oYKyei6R0YlUojqMoPIm0zSdiZTW8BoSyNcXGSY6pxrRR12msHxVT420gvoO0RdghBvKYT/jAKLPgRHep0hxHcZQvyEAosWDO9Dpm3xEYpBSEnK3maivZeox91qNih7k5qjfpsJP17xt6Zc71ee7aPP/IOhdfErZb/wD9jtvxQ/fANrb/gPZz/yOfuef8P+xDcGF/UHvskiNn3HN6m569/469qc/ZX/jf2ZVyrkB9v+QTk1R8ND296gN3dQJMAEyn7il2SjY/V8RIzshYH6KAQ4kl+6wcwXs0h/tFgi0O2j4B/JryW6HrfkR1T0yCLA/EhlWGnb7nY559/m5C9gJvm9Bv/cl29BFbg0pklRf1f6Xv8xj+WFom+DvJf+ovVIER0ppEKinCe3+x0dv5rwgFVIIni0jwx21AwtFGBkG9t+aduVn7ATgkcCIg3l+/hjTYZEq0ntjn3UyEJxMEBmhVRuZHijS4KKNUsRwD0j2TFX8EMMb5cn8iACrxacJzJaNYu5NxK18MoRtbnr+cznwqDlREbLCRU3AI2g4xGEwiZNkHXUUhCx7amQoQHSJN6AwLacczAYcB2CtiYqXBeLeExcyqa2TaYqKhgLIbqclBwLfV6ONuRPKUY9ni5dLzia3k1B+5w6JOChIBGlIxAHR8xnG1Dzjyh1NsQ19Gem4A/fttOWNtIYzCtOmagFYeLNPTHbbkn2AjTBi9xKmf7yYaJc/BAPIx7A/iZ0SF1BIpBGmdU0WIJBl4xp84VUO6Q7FosDwTLD4Z9HhAQ8t7WJhX/AFFjNFU9YUJNEKo9YUPMCL5FZBas0gJXGCtiABJQ6Ab9XFTiJpnGjHav8BBI/vhlbYWBVxQSCaQfUEbA6wrZ/JtZnjSgMkjLFGBHdMT8PyGASv9nDHlItTAYPx9mUkeW8OdkI6Uw54e9yk/9m9F1NrLyEZKjqY9ZwwCLqxIIIwSIWUBkWLQPKN9st1jtqOg2WSFJNWxiqXYLkyaY0rm8Do2AZy1fRMT52bPqk0aZjHWbDSOv7Xo7GYgkOIfuwTD8woWNyQi/X+yBT5HkGgAATnJbdjs33CwiM+okADw8A7OhIn0dUN8ahWRnivsk8AfBUh+aX+7UB1pcV0fv9CnVgTj0JRhzNbJsfTdHI9jK7ohx5SSz8uVLGw12f0GEwelFyybzXSnzY1hqvBGtBlgAOgsLjXD5q/AC6jkLdLUGI7ZD9UTfUqBuFUmljqjQGfI3AD8f3nPyY91wgidrvKUOXM9gxlGhLsPis5NvQaUFkhxPd3cSMuT0XRHXVIBtI/sj7xVTe/FXrNTKNieBZgq2Dx7pCXw4cqZ9YCnXwEcfvaK+AKmwgNdvmM/I9K1D9hn3/X+TAWMD/Rk7xmf2YXZYitX0ASIJaeMUrl3i+CRbgocQvpY9VFqgziMO2kY+qN6zOeSTXaRRluBicLwDCysOIKwjuBGreyRjObRlLJrtmqLYk0R/y0pB+AYN1FDQfN03kg5qlS2sGNtPLvGOva3P+tzLUA3vlx+0W/rgjHePM+SucHqzi2/YlqnJ9UxK9ldJ7j3+Dw2AQ7IYsyTfMgtc9pV+5ZNAzM3rwj0XdkjXxYAtBh/UtSb7kLisM28G5tdj3kt7JZZDQrqTF35jEl6H6ugKfWd+w7+kuNuSd7CZy1yYLUezYvcIfzo87yhbkR/arPvaugdR2+RT+xOm999c9PqRARUWYvZJb6d/UDk2Fg5rWYSlssMS45z/Ukr/dqsYQKMpA7z2QydAiLviPjnIK/0jFgZD8u8Mcm78V2XZhxrZzCyetBIe7Pc6Mx+ss5o3hRRlTxueeZsoAd2Z9mG23yRguvmS4xMBFc5E7EypOi0oyGCyuZrCExN1nrLHInYonDR+HHJYMOcwNeX+UaxIaQ8pmMlD8Id3RUHc0IGE5B7DRAQagZDM3GvXWb9T4w/iDBXdhqZqfjAnf62mrcCs12TAZf9q7655CjZ83JXnIVKVS+fcNiTPvY7EUHXyV2mOvN+g97gAYfHlSpU/Awmysbnap9zKriZir4RkGEj538Ua+4a5xIwcFMyLzsnRK8lxi82SNmRR7O6FhFlFuD8E0W4uyCG9g2EbIE7kaKcg855EWRpebPDgYcyFiIWWqbDXzWqiKiNSSQZKJ3fogpzO9Lx0pLj/iprVwEzBxAT92VIpBe93HeE8ZkObUcQpySRlju6iNquB4msHxOgPeXIhzF+AY/ZnFP3rJElhUruF8dZWpmi1QczTNadFh+ASor5D5drvA6NYaIEz2nFkfqwTbbRalC67jlQUqYeg8JdjIJ0pXmw43qQdKHpMVedpmYdpSBrkdJmd0OVoqct+ZkYRFLKpqzWjKOZeq0Z1ea9D/GhETeMqjtSTyVk9BGpKXh+5kIm+FaAMeSpSqQkOzv9/p5LWcut1We0CJ5cZ3wTTduqgQ1QXlaCWea/RazdJySzd+rRg0UKrVGdRbpRnLjNvBZUizvD+e0ZVWp99s/zbdyzJdK8WG9bOtc8jkK/X6UXCxpzyEzFJ7h1XGhV1kXNjr1IfFXeaq6TJz1nyTyXLPMgn8bRYA7SLbuueZQ4vTzOPFVRY35UUWhAK4tV3Nq1XRKF5ncv13mVf3T1nwzw9ZYLaPmZXF24xB/TLzkvImi3f1bRbV6FXmMOXXjMX7Gc1H29DwL1yvL/tLxtf+JjtPfswMeaWBKvueQ6hnbzYTgUXZn7TPE4v9wH8tuLBi3/Fv8Lj5G//GOG7+RNlf98h19mceaoctP/E/2/afjW9C5LRrE3j9s6yBReKAMl4gkEscCv4OPM4L/h4CvQn+AZCx4H7mpNgX/CVRPn+Aqb3B93B9nCVNZRas/jgJ1ZfjSCy8OAETfbaWOWQ65JB5jRxzzCHd0ywFr8CtpJIYPuoLr2gMja7s2EyAD5t5mUynV+GnJBRpGOByN/2h0v3CLPK47O1ozYWrf80txtTN9AQDQf6qQjKqG8tfwSFsKFQ2iMwDDPC/2naIWYEcdELBVqWMWe4LcIFzSn5KMfd3JFJ0xZ9Y4i7gFFd6KnAXT3aUTSGYbs1I5QllEcTk2OfqcOzKQpCN6t+lPmcCfksyn62X6HCc+AIUGokZDHKEeo3fUwiW8f1kHEwwvCI+cfD3HfFT6HFWwDkndeCRIBNFlt1ucYK+Z+ekwe6RGadrqFM2g29wVFEn1I87dl2csMvshDyT5PsVidIAfWfIAtbD+ZEEBvGVSN5vTO4Ei9D8d6HWC2MkQnd27K6E385vZUiImHjy/s7SGasQdSv7q23jvAGDorLEeZYB3ki03VZUnGlos6KybbdLzQSSVzuYimRCt1sY9mU5nJxBR9SSAXMJQExXGWCVG3jQN5dABr/SfQ3zWSNsOpJLAAELjOXGFQl3O3ZTNh+uIGfKYiHzyfIjSfLUMTYlsKlv8W8sueAw09GOnZXVjJDKImghGW2EI5sJcs1E+WYqaTsJTv1fdVsBw22F7QW7IVlvt+TPPG8qdE1+YpL0koxHIbTDuSNPxhh1C/HpJYenSJjZdqr5ZaqNji6I+UpTgo2/kiBlDiSDJHTkC2NiBozIx/H3E4QxkoEM7r+ZwmQJbi6RCbKOpra9FICnEA/tRfiIRQLGQ2ylOInsLfmJm6eMEe2jBM8qRkgtcRcwz2/Jn5mV8yFWroW7EuSVNJWCUh9qc34n2LvanC8E+1Sb82uBzK9LwV5ASMzanN8I9nNtzs8E+1weHnQlyK+qShQlhYL8xCwDP1pPDd6ix2SUBU7LZrt9S/6K7ub2Av9Z7E7k2Vnom+Mgi6qyVnp3YKIiAc75846yH8kVmYuCOsovUipkOPv4055m5lo6yyi5XVwof99LMjXDxE4hApFCpH82jgBM5xFbY6D6BHbqlbh7VagaOPt3mTtGIYioWtmWUY4CVbtAqqTtrgU6/d3EQsKHOf9w/NflN2+19kXxGxrjP0FNVU/uNFNzLtVINQy1TomgzrUgp0pFlRU0fxeC7aknXwt2UAP2Ujyxq3v6qBg01rkp1i2BJHbOnlZxnij6uTZPNSjLqy3ZUFDoRilVsgikb4+ZG1TW222+YYkt/0xS04AxmApMrAqPBAHkvN1a9zv5eu86YmcmyKFhD7Dnhy0ZJCXzMUO2cL9KAPrIgsBZm1nhwfl8Z3iIneF3kux7eFyRUEocYxKy1EsIZZU7km0joMSCcQN2ikciIS0hwN2v1Hn4afyDcoN4K/hPOmrg8C35if1QihhjcitpDBzzX8if2Z8o+3b8/QR3Rrvg+CG7hq6AR36qHEz1W4P24VyQpdvodbulWZTVDWba84WiM8XCjWRF/UE+fJiRR2vDgF1B60C3MeIJttVqtMrryd2dIHRPr9E+kNFwfAgBiLqdQWnGTRBPwzWgbexdp91qtR9nZJXZlaWaUNIjfret2FjSCkVe1/sd5fFRXbZR866BKkrz9EZZEwHuIby1t/p1YHfNeCWsnU5h4D/JrWDbVu4d4pyHbMX/O5i5sAX3ifWjcK/QkmbFNhKZ2/aM3gd8AxfXFxlhRFYsywy0Y1wTAcwwkRTTNTdZZnfcJdc1eXMCRgO8LV20voTHhUikBeY1BoQdeuSaKclQ8RCaEmWClbnXn+dFMwmYZ/8qAtjj6lHK50D2quQo263+pDoFB64qn2basaUb/0vbvyxpf+nGRuOXWeM5UuVf2fjoRj85aS8WIjF6cZP1Is71giXl/YiyfmQdiA524CzzweToXLDy2IA0PzWyDPdC7PUHvQLuQF+uwKPudvOGAGg9iTr9vYKJAOjZo1lKsS5UzAbmXr3e+BrmnlI4dFOFw0dUq5OoTP01rM1TSYFrRA6NpDhppOWOTiajIpGWLyU5+VKz3+u3DvNUIVBGGCOObbcGvXJ8PcsydTu9weDwKSP9DrY77QPYeiUSFyJXYK5ur1eeK7yMRXStmbD9ej5sW5Zv7SaJiIK3+nxrdRu95kPnmzoqe91WseFmr00z4VKrqWPHm/i/q/WulRffOHPcdThEo9YT28io6CogYzjeTLQv5iws4xr8HazB14IPytZrVGdj8Xgz4bG0tgU3UI1m++nWvIqx7GUcYDy0+t2GtgZBRuqGn7w9f312VPtSU9qWMRxfYRY7Zf3EYHYl/oRZyIORn5FprEkLDtoECUY5wx9Puq2fEqUlAbJgR4ZMzfnUZWgindGiG2nUoyJaOGsS1rJXCojsdZAAbPn6m36X0q1Oq94+bGZaAJPHePqZXYZhP93st7t9HfwAOdfTjKBYZ3xn9Lwi3a0sMxb0LNWcLvD0Fc//OovGc5eCbIGnbyhhK+79bcayv8gY+cDT77Tava7i6TcH9abi6YMndcnTV2z095n3mJ/5C1M+wl5z6/PHN+dIO35wI3cVW+zdXlomlv3El7Au7ANfGnzy18D3NxPeAfcf/KsK3PzsJbx8FL9sRJxYIANwifW9cGciAhbzt/ylbb80iJFX/I1tvzESfuVhTUZmYp9zDPZfeFibCdBD/vzx9YtwtQ4DEYAeN3DO9pK/ydu5/8jjLBjg9zzO+Ox/Kpi7/yA/xktvnoCwQL6tpTDzbzKzihT4V/Umv/2Zn3ypnizYTxwxBGmjDnfJZv1pLI4bwPKTDyChIGTk/H785cvMPZ5P7ps7em9VRdXaUYtZC8+C+1ciDkVA+CWLgJBdrsVux6IyHt+fiGB/ZtYRhtpoD3P1JKZuWxiRYTSkUCJhQhCI28cSw+5jt2OB4Cfjyh8I/ffJ9vfN+smChYLfWxXLsX7fbFjM+gM+9SxmEXzqW8yi+DSwmPXv8NQ7s5j1+2bdcqyqVVCeNkQFyLfwSj/+ifwFObOCuUIp7xbVpD6ZugLvmN4Wzjn5QERqKkxNP4pWthX279QfDZeKUAY1Z3WtGEGJSN0WqUKbqtgp/U6VzrXLRJ6MgtqVuDP8JGKSdooI35h6nVAWgbVLnRa1eqFLeiigG4Tvm0iG9GamNx/kLMhI42s3ikV613eytD9vRHRH9rwJiJE1gu59g44WRn8lgjWoo3jFdDf0hRn/HH6d13g6fP74xtnrLfROaeVt1jM3EQRUabMuPeDHO4ZTmZ8q/tqGShfb4BeKCLahFFdhWEG1gYgllOLkD5FmdjkJ+Tm5JGpOqcrOSMw94rJQ5UY7xHxCRb7im+K/fSbW2e0aTROPYsB8wVQcARtWu81pWnT4PTGXB01pnTMSq/aVUucZ8VOdYkl6a7JlCmSLoAsi2JTadllt07QSMZ4C433HsoUs3l4EzVtY/40IZtmWdPMWPtN6CkNKEh6MQzCiTpntBHW3mMUtyso6EgnyA4moHlQkCIgNILtkJcUi8lzf+zXHYdTdESzhuSpByqE8MiVprwSX3pfY9yRChWDYIbRqcauKb2pVTbEFjG7HJJQ5xXD+qjFVP6+z4j7Q0Fr7BV7TinJAncG0beunDKx3SK9scrHBZDwwNhWHJBvP62UcgqFCZ3CXQ6sFijrvm1xocbgZTgW7l2HLCvfGqz3lFtbUgr0P6vb4PYn0vGhwNQAVdUyjWjoNBLm2e1yCkoYaGTGe8A+GJpZuLJCSNgmJUbrm0TicwDJjfC+Q0IaAecJqdZjku1G8rD+xDwbEKUlfDuYCGQAgGQe6F1HKhoS0HKYHjIsdOfX9f01fxhM1IWmHwAljks1JBFgB3OeGqi8p9Af7zJN/zZSo+ahWizNS0TeKSqOMa/Fw64CONFiEPEj74ILVjic7Ekvxs8/rQz+LDuuD3y4ID+tPqOqRZ9vEHX1HQuYfHwO8EBetINQBDFpf7nb7PQkliHsKvmNgLOehKu8eTxMCerNAKEjd0XL3f4iinifwK93OoTlMrgntb/2AI4y9VQn4NXmyK8u9/RQQkvBI4XYcNsPeKQY4yzt7SMcBSCfWmhvS4/KOFV0plOfWXpl3bM/tSnmB1HXzbpfTbkCd9g2gt5/ZNJt4GVEmLaXzPCxD1ZOankoEteeLra3JRrDXBbliqpm7Y/eFi5SzEUCl+aAz/0Zx5taCx+SVZLbO5XMsEpo33sHj+SbjKiJpeRnO0Mn1HYko5/x1inm4qC3l7QotEd6Q9J2iDckbthZwVk/DIBFBcoyBuuh2O99PZZa7hnsOBlU9uT2+ubk5nofR6ngT+fKGNRvCfSoWCf/86dVxHxyAEsHuoXPOBamzMzz1VfuYAqfELr2SDFGu81bKsrJZzE11OqcYwEMcsAZ5Ww73S0FM0KfO/Q7lZyvyUk3nrPQGoc7hbykeqS+/ovLhtzlL1RkIKg0C+NtyeIGxqTuyMxPQRyPS5z4gwUFyjtY/HyCEXKv7kA8uVNum5TrASmXRy/gfhWDCimexyXglU8PXlOG3gM1TDkhB1VF62WKrNB6E1HXs9AZ1yRfRKuPobw7ZI71Wc9CQ/BHFCbnJGDBnsi/1tuSPKAbMBb9F7sQ5vzWYEcDNsIBNclYrzCK74mc1PY3sBY8hA3vPY4PD8DNXJN/rIGGvjfij7J18WYc37BPfmIyFD3xjeNf7yDcZm+Et3+Qd572UX9fhGvgim4wH8S3f5HgQr/jG4EH8yjcG0+Ez3xhMh1/kWxK+CW9E9MKNBShNYulNoMp/w63XwbXre7OjeLoU4OD+xyxpGcaJxb7PEgAKLfYnfjJ2j3+dnHjsB/D3N6se19T7d/zky+yE/Y2f/L1+e+Kxv4KTv/pxb1L95gS4IH//MoOnnyBZcjSq35x4TACv4Esd48ZFR7/7/Ynz7Pno38ZfvnyZ/H07OWFJ/nvxcySwvk29jr4E6/VmfVLdFhO+OVlIpoSsZiI5EgcukYCOdBTQ7H4rj9wECC4ZsamNofn/QhImft/sdCkT/DURJ/CoKayPJJGh5qFGGZizpEYMAF96tssbesQh+hQ+yrCU9aH7rD90q1WK9/axOxmR8HmEYvGARTykRn7qEOUGHILBB9ylrFoNaWbOkCuX7KQKFAywjwMMbRsd+I4jVEdDk2PQbQrQsS5Emo9GlgP/OZY0EHcg8S2BEuBwkUXPejIkveVYlGZKB1Y1gUDs2TnAXAHuuzwwWLyHN3ZvHVlOg/3B+oPTYNYzeLae4+9/spzGjrJY5fUg7+/wywh/7/F3J3P5KlcMuU7wi4O/Q/zl+Ptv+DvG3y9f8M8Ef/+Ov1tZ1aZcB/Aa2R56UqPnraZtR88azR5qvyUMw3Dv8yPRNnAq+P08WTvNBsOA1bhyyyRZO/06/o2ddrvFbmJ4v4nxbc+wWHVE96DJU5MT2/5A/sQ+YQdBdcJyLPSE9glZNMongrW1gEwvmB/nXRscifTQs+21IJ+hStbESo32tlvrBDhBJMEmmtAETCjwrrZba6QffocPRRvlNH5qDT6L7db6fVPAI7IzQbn4fgcKu/eoU3uPuqj3qCF6jwqa96gdeY+ajPc7dou/F/h7jr+n+HuFvy/w9z3+/oy/r/H3Hf5+wt8P+PsRf98etP/HAHKXiukkBbAhz5gFxNNKWe9JqKxPY+MYwt2aRnlLWXIR7ligPd4KEqFJN8tXq1VbzNqJy6/Q89EppTXFYtN8yb2G3d1u+HafN4cnpuQTler1KAc2yGuTvApY05kA33tsDigNnSlcw88dGBpKj+2XqMgFRoU1eeZAzk1tE4socPXb2o3jmzCayTc4iCQy22DM+PTZTZboPlyyXnTyPHIXQIrp9ykGIv5WnH7rxnCfgg4J/i0RLBLMsihVbwG+sZCvYBHXz1Kv38PUtbzLw/F6wqbKkfxMOBhwdbutwAZzpR/7RJPg3wynfCGGQEp7wUbs5lX+CzrU4CtheplfYTWubZMP5AfmwmapWpy72611rP7CVnApVTUMNZhYENreLbaKkw9NoxvEtHnIZNtkU/Pic9ABcn1CKxzVD+fQJmAeC/0cQR5lQfgiEjMRJJ7rx4RutzClFc7lOhiF9GLadkWul1bEQg6sXuk5S0y38PmugHeSsc460W1gZ9JFp1QOrtjsaMpfCKdQXWTbkW7ZzHldyDma8jPhIMYKx+tqYzIiU1B7BU/YDimDnzp7QyT4IcRM+SdBd8aCLiRcRNtttFfatq3f4aKly+XNyYFssOb36ezpwWjIX5AIH2i6ASL519wDuIHKRjDlHxUQ7qZcz6duYvRCOJcZ5MpRXeOorBPoPWL4ipovej/lRu7dlN/i7JkwfqcL45im/CJr/F2xoUvMuz9ul/OAGqgiSh9NlBGljxp1RPhHI48I/zw6iUPtXl32WR9grm3ngIdO+Y3I8o5wfP/d+4j7+4PI8IAEqfv/jg3DXx1s4pjlVm33/6s1KQA2gLC57W7ktsutjwJU17Zh/TSytE7+tfOVnw6AbimjgZ1g7oIz7CGkstw++kTmbF1tUJpuhML2uTX2njEU3Edpu0b+C5n/3+R2m9k2mXPr9+26VZ2jAmOdeXxF5hlr9YbXhzfPPH3i3UA8FUg/4974ZjLUZ83ZdnsnP9zyjSBnzBd0eDfKZqzKb51sVqv8Vk7EHajJAupOoxbAZlZ0oqtoQleRhIc3G6zdzLYtOKYUAk1vp+4mWYaRl9xZw/UxDk4Nptpg6kQ8V8syr3LXnN1zIcO8nOKsAS1cOF9gpn8WuaNUHr3b7TW9/4+MpnBmmSM7+nGoevN1p7Jx8saQBhTc92GckLn+dBTDRzUpr0V6KmcDtMbQ4dE1r9QdayI7T67x1geTl9Kp+/19SpNXgiWcnwr1zQTcK7mHP5DvNDn1W6Z2u5UEuGVV+FzC6wX/mcxZo47k+cXzbqfT6ui+fT9U27mwGhecc5MoGcFEOxcITjuD5hrCLGaTp2vdFeHsRf5gk1DGCgcMnfL3GWYHIkLmq/DIgMZ3eWhUR2MBQz3xgNOH1pNKHzp65oJ8JAsSsjVFohop/EdqjJdhlIjgg5ssCaV7h8rXjKbsaDDh6r1Bf2RTLbe1JESibNtHGTVbHJZtk7VQnRjXJ3AhG6XEYJpMnVzvC0OTPfpZOPvQ/eVLGZhLu54Er9xzIDneGSQH7kCJSrBJy2I5uEz36oM70/LDqetDBYj24AExjqqSZnWmaGNnXmxycP5awXkR2UHHWfEQS2vRI5IsAvikXn6XnttuBVlYRj20UH4fDIYqPQ++Rm/fHV6IPbQCi0AKiyMxTQ25FcTnvxCfz5Hj8fumwEQfLnCKh+HLdP02IoVdwB7rgXnzoM4SAGL0NWUcUnpnM8g7BWbp8ufhYI7ESp1WgZWX3YPmlLLSq5lt66ktzBqqkeUaBqYSgDPf6H00pK9UA3Qoy49IYSWpIyvEXhbXnqbn/UYQl8WCmgv/STiPVDoqq9NRUJj2UtXuCpqr/oNwUugdqTLWH0oWaKRar3LUiHOyV9kJ6/fNluWkbThlnTIb/iiyLuqMqo8eaISsq9XdjqWooFyTCpECUAGKbYhAPkFaVTCRhZ3LEyv8sFYW8or4uM5y/0HwN7iMIq9Gco72BbHQ5JruFBXK+VzhE6TBFBNTYad1lTfZlFerGwy7N5yDm0/I2+d8Y1BGWBTrkRx4xdAHTulPDNJBv7HR/WNS/ZnMCYYNgisti6pVLF4zulHP1Bvg2/qYR2zzvKuTdFi7tCuhHG3wvC4Re1qbbQfP2sZQqjtNC0FTZn1Dnai11lQv65QpVn9IQ+6mp3QdElT5kDfqfwyrLlQePm92OmaDPqiyNzvdP8JDNWTNCq9WA9tuV2APb2SX4EUXkodncWVgqirwoig8jduzpKE/3lSrE56k55OiYqdGd9RK7syvMHyPb46nbMN7w3qFbyB2b31IYw59Zv54c3w84f54WvWOG/A+rR4fexMep2dmv5LBgvxz5O+Qmd1gANKskmRgjQxTRH9JdkRBWvF0ExydRn4gwrSrfeK++JUIlBNBbj9FxIAN/bGf7raJbfvZJZwkXL/R523doBhKfcdMNw4VlDS1TkLuQyzFLDvCT6POQhMN13Hnh6xOEYF/IH9jIR01uk6fhfwzCVkfMFPDaVLKEGeH1OP1jGz9QBp1yPFnBzP+1fmJhWab/GcSMpfu3pCIedSIo5lodSqPRxAHPOAcIn9Bpd5z/o40O13WOc4MPVO2+RHmyMAZv+BcxPwlibSKUmRqbcVVbOSPstrWceqT4ShGKZjaSw9AA/TrA0nyK54K9SLkJZe1LBEyjtAVNFftjkl+3ffutfisLtIf1PX6Q0HVSAdm18W3W3wsMvwyRi1+lifzjpVcJ/crhzsUltKX+u02TdK3/h1LN8N+BUfSwj1rGFSmMppnX3NKVZ0sUYlaTVolKR2EbTcw7ulaEKHo8e02PZ6OD6iUZu1gE7IqVKfRg0Qvx3p4LORCXkNcLiTHx8PPyZLFXKgbiM9FetyyDU+AQjK1/iBc2Ihsqtw6ObGYKL/Mw6Fd5VGVBCBSrQaOZdGq9W8WZZsqDwUJJVBWFMWDEtWqS6mTzo1KPjmRZcQeMIw80BfVrB4gHqsfiQeEJ3UsS1cfq3pGVjVO2/RV4u+sqk/ZBmY3+T4S8wOuHJWISovEtIAqKRF/GUreYAgfeQsvOAAZarV0E2GEBuXWpR9eWpzLkP2GythLQUR6L6uFWHNmzCAzWjA+LYbW122hdHVNSk1ULefkxKoqB0XydlfVh1OCa5bAmjmqSqaDN03Dsq1hDAjABSd0P7vWiZfTCRI0yMxmyv5UI4lD9WuYxtr3M6dLtsKqVVCADI0cwkNw7OXq12GZpTwc6KlUHxTUCHJ5Ef0loB/go5t3mCZV96FhZMgmLsv8Hx6GIXR8dBgZu3NvGHmSOge5iEJMuDWwA0ZItixHPSejEDYOulzC1a4mcrcVCfYD+N7cfuxcAQoULgJKoXtlXcI+pK3vAc9TenCqevChVFX2wQm51I1/KEahftLibreS7JGSZ23aogWKTq6XV4KqbrrJ8oGJAmySC3Kzh2LhLHJEHsUKjWIVABebODyVJG01Nd7RXX6tuyxV2g50WDL8VI/RTqcqdEeKBQW935+wTMDuSPaHvBWix/jP8gLGjIyWle/lB0EpewTn5zQbD2FKs7T0Ti8B240PDV2fyMbof2eMPl8Sx17Jxk6s3z0wVvMSnhvuR7il57NItf8DNiZy0vZHaCgeSya/MhV5WWa+J28l7A0qbz3RQWnAL0jClHYJBqCIpNo7SWrLSMx5YPaBJer4lAE05CFNlDk7Hlnygz7A8FN6OOAnvUdlKY1wZSn1hp+kykdN4aw0KatIYyJZEW7nmsIvqm65vXTd8g0/ySmWHyTQGclaGadWgEjZBTdW5QBsCOy8N4K/NHRn2LdFnSwJdgf8HJ7jmtGxmBDlxCGx7RItZJ0vmUh1sYK7rLxGOvJJXAhN/kawe1hG51tBrHQhLWYpss2iTC4oZkiX1KJML6j+oJdUlk3fKNPLqzPqBZYZ0zfK9GKnNap3VaN+owzWWWf6HlVQLXXyqI9mcxoMsky6OTgsdFOot2qpIwT7onBv2hf5rvui3iiTMKGzSXiQmdSzzqLQVi6jTINOA47RHXZjVQM+UbQfeIOWCNJ1EzkIJY/bIbx5zKLhiTW9kDjlleAvVDQbaXMJitG/QmIkrsOrLHH4SkBwtJeCWYXsFvPJK8FeUMp+TfMUSkOeXzHP7ga/f0ZV7bBMPd5THsRcaVbhvBToFKA/aGeRQNv9dr/E+wIEom3RYaZOH+xYv9XqtkryNtv1VruQt9sc1MvqbTR7jXohb3vQbJSFIh4MWs1iveDWt6zeQWdQHxTy9tq90jjxjXa7WRxbp9frl0VE7YMP+70+NNtl89Bo9Vug00rAHwdl+b40W92yvgwa9VajUH+z3xiUzUej22x3i2tS75bF3e106+39IbbbxYCyLcP9g3SuEGZWCG7qUBmjr8KKUhZLs3wDifv8/uX7t5/CKxG88WL0pPYunAn1vDM6sX8MpzZHQyMmSLzduiTGsPQJvOo8221IfBYgreE5wPppDtqdTulKYDDhPEQOeqWr1q+392a10RiU1tts94vVDrr1bmko3Ua7V+xBo9PrlWRtNZutYg/a3WZv8NsXC7fUv36xlGHdg4ul8uwvVqvV65Wimn6nU5yqxqDVK1usVrvTK65Ar9Vv/AemCjHKv36qwKLvwXmCDPuT1GjUm2UBxTu9TncPxzZ6g7IJ7feb/eKEdpqdZhnsdfv1ZqeQt9Xtd8rydgbNvYVq9tul9Q4a9b0d2G/WSxe1O+jWiziw3Wn2y3Bgs98cDPbOmn6nW1pvq1McW7NVetw1+q1WsdpWp1EKr71Gt1Hsbq/b6jZK8nbBWXsxb6Pf6Px2eMVT7V8PryrQ90MQK7Psw2x/0CpFrOCzvrivO/3y47tRb/QPHJ3dVqtfVn+7M9gDhUa73Stbh1an2dkjJXqt0mO216s3C1nbjUGjXtaFfqNXBIV+t1kv9WfVqvf3wGZQvnua/W4JjJfus2avW8w76LTKTqRWvT3o751enXqv9AAd7FXbbNU7g9JJaA+Kc9vodnv10j50izuy3esNys7aQb/dKmKQZq/dLau20xx0ugfAp9kb9AvHA3gcy/Zbp13v5OfF8LG1Y4Nmp12KDXvtXutAm+1upxQGmu1ms3eIQmwNmmXgCAFqDlGVnV633SgnPjp71HCzXgrDrSbYhB4YR6+bK6MtSB+au4LBKOC7dsGFKVreUgZrPABno0+sdsdajUG9Y3IGKjkf6qaTfMmP3nPV5QVHggVpYAt4te3MvZcXHMkvLOTWK88XH9EIW+f7FqQm8tnoREGEAplYpV6UmoDfZwLI3HoVRquXbuLKznjcQkz+7WY+Vy0BS8KjMIKYj62xNIQ8eh0kfcw5sVia+Nk7mPrCd1drMdv7+DpIGt3SIiXJr4Ok1SzNXJL8yg/dg+ndtkqfMJ8bI6558V88cbPdlkUItO1Yx/YixagiqUWwjNIuKH1+3NgNdTVHG+UDQLteqqSGpMiclGWhGDsZ/909/rV+PPhy/Ltvfm//4Y/V2t8v/tP23ycnXhqqWYrjMJykNnQmqTY1WDG700RE4F1I2u0fzT3hz44kayQzCDQsjAndpX2dZoN+vLsiK7fOjvD7QNzuSQtQXgvmy6nvahnfM/MZrhx1JBDpUwMxWJ+O5T5InXFNSjSNkh0YwKadmadM+ZW7BvM/cWREk56P0vtD0asYlpH+daT7fskypo4kcbwY/4KrrdIq8hWgYFuAY4G0GmHbCnhKnJQTQUsq3e/VOMlqzMa8VEAmXUt8jsVMKzYo56jKsykpQM6pHwl3dncEvxalw6w86v7r6mfGZoAaVKVmR2XA2zDwQ3dmrlFCoO1444PJKGRAV6u5VSRCRQuGSDFZo6sMqKDNDA2yiM8MJ/tJDXp/GhubGY2hs5oW6exIO/t0G+ArqdNh1kyGyWAy7xLxRurqZK3FIiHFnJSypHaJbWfNXhcEIdnUNqTo4cILvOTbcHZXYrsrM0CR14GXcMHEqMR5W5brk7hNuHBC2wbEb6AnL/6gn9/PUz9xWAZycuG4tq3PgqeV07m5cCLbLpxtT6tCdjfFnYQ6nm2DlTpJ0K08VA/4+GBtCUh6svqMxecLWDl8pCzLgdOoD0YyLi06odgPYiQ9MJzt1s+EbWW9oE72SQ6YP3p6FItoMZVyw4LepPfcv5BDoKFLxXulmJWI2+Rk7UP4+YIfGCcPIradf69BBY/WX1KGfh242PZjbXy9ixu6Y6EK+FID5ZP9eMNL5QIKveqZ+nb5Ae3jV3QYXcyVL2jAx6Hyj0Novkq9Fw3KQOH2abiBkz9MELsf6YxHUOrIjY9g+BlN8JR+AEBOYAoxyTWA/YDot9j7kZrc7fbwtJlz5KTrRFRM2JU+/GoAvnw/GCi6PzTWUOu8HgWH11DwfDo7cOIwfdTAROAZ89XLe8BfSPE4YRGmydc0ImWZDzvQRlRUWW0ehasXSzd6Ec4EerYzooGDExtiWXR3oL//MrCCdTkMVnlgojvm6t0414fKAViCejUU3KRQ8HMcBk8pAXI6KepX4LubG5hHklcFIbDgQLqzhE+B2MgCeANZORaTofHMo1FUtdgR6JElO2ZWLZ0o5g54mXSUlodmJvlSC5GUhyqXXUK0iMENRkYvHKmvYNazdGN+KPr6yl3Dd4MQJRvpfNKsIRbFaC75fuPs5IsoMrZQTEM7Xk50HfRQTyIIjifPxISlLUYTFml/dmaLwMTex+RZnDMNYEXqWhKt4LWJyND1ayIKVUtu42+pPK06OVC19u37H+v4OGLJxGgBrk1GKyUXqP0uSPC+42Pr5dmbs0/gwvm7s08Ws74/O31pMev9h0+v3787t5j14f05pH/4/MmaZHfcS8OVDQtYyEkCfkXudzR1dZe7hF0WLyul19r85WSYeRiGXwkU00wNlwvzjSWabtCaWOoV8erc8KunttNKJMtwxoV6UInhTEBSONO60N4icKEH8oGFUsWHc5ERmeBuyXxnuUuVsm5KB5NeqFP8a44pMd+0nriZYsXuShxLhRCLVdJR52mn8jlIDswBiXiinlWT+kVCRcCjWhJ+Xq81A4HdpdyRALgfo8DRpDfOYIJ/dF34CLOWm9JEPahc8kXmiMRcRJGIpAUOwU5wzvM9Q0jNp1LbDsshC+5cMrqa74c3YgZ+mY++O/t0FEZHUNFRJL35xRrs0psaCY0b6k3hhqpOMIPNEnkrQqWvN2LZFi3nG3hzXVHEhc7NLelyXPFNtDs5It3So8f3kOtznVtlGYaJZhzse9onAWUlqSFGKMvxU84U2gcg4vcp+QVOh6yZmLsbP1HUfpy4ySbmGVdHpYya9bqjX2TW8IobRZ7zZr1u20bKs1a9blYq70LZMzAok1FifHWs9z/k70p7YJ65UE/gF2wiChdxwKDm5Xnqh4Hg5W5NVcwd6S8zf88E/ZZreXoZtVGddmamnT2xuTOSbwPjoCeb2DFmiRnTUZi81I+nmhRjnijbREqpdhP50Pez2h6XRp5MsiO4D3XzdbNRy9qZXEaEEazKYkKqQd7ycaveYK16k7XqLdaq91ir3p8Mz2qRmHmRmJYEjztucM5vUxyTmGzQj26wKPJBZYeOALgzSrSk69mk3IMlNbTpyPA+Se3l+7dnt1MhgwGL3CtGWYDq8rkyTnuhdBn5JOLYXQjtLB+VHhMdiRcHo+2L4sSdXvFI/i12LAMdzViQqlwE6zCh7FC5nLvTwnhSDHBBBBAcDzIAA+ZKIPG43BxQBCUICpfbtn6quZdhlGQsSpfsTyaxTmUmi8knHBGwKKU8Akr89PbN90myVp5XM2rEJ/Q+lo0QuotLmJLpXZFrUCjbQaXbhwiOTJhT3/8o4nUYxELFQ0G/NZa6O86ZMBByNPoSjL8kRxONmRWSl19ODhwLhQPBKRwI8nCRd1wVo1gfB46Vfk6PgICFCrVDOFvEglakRgDKe15wFI/impHkRHmu00/HaqqPIb9ciTCrpFiDkz3CDA4DIrdgyCIMG1DGC3aLDOp3IrkJoyt9IB/NXc8XM0tXkEjx6H+kCoST/QqeCI5Yx1oExNOEo4fUKcTzsJSxGJAlnkm2jeIaxJMwbMjQZUu48pK9vLZNSnI3KEvnHYaKcy8ZqMasI/qVbB7mpWt5QPqBDDa1wBKilRCEMr1xbZtke3g2O7sWQQJ6HSIQEbFwIi3mU5xWJN1h/4jpElC0OcNt1OPAHOjA10ANkViF1+JQzeA5tBYDNKc0hpediNLTi5EAnd9d1Nahfzf3fB88KIkaOqHebol64hdM1NQO5rBr1RTwS3yWM8nPAINmuRIjV2LkYklaqcLH+ci3JGHWxYWI34azjS/SMPmgv7sj4H+aWJtAFpllkrdY+PMR/Djq6ttvdgflYVuHAc+8UNYWo6i2wEKsXOsGL2Evzs/hrv3i/Lwm4qm7NuQjWZqSkBQK1/me0UI5wf2fspr+E25ELxLxkRsc6eI1I6Yb4OX0WoQoT1bNQn7cYC63LObxqDZVnK7TBCQ41Wr4LBiCZ12S5D+GlI7cKk+e84ZtJ894q7HdNpo99GYKJva2nTzn7T5+6/S228Zemm23O5x7I3DVUU0yoUWjS6vg6VbW0kBXvZAz2W4rBNpr9rdblTCQf/JNJc95t4Nvgzq+DXr41mg2qWwtUq6pSUgd89mtcuv/8//6f6a2qu5uaK4awveL83Ok18115AlLdiSACIa97qB/yO94plTT67ZpTfIIsxM2NHlS5sU+2G5z7y/dRBSSZDSwjLhwNSDm6knvVTW4ok1H6i/R5lnSHX6QvWfDn4brO2TW7oq1Qm+oaWUKpBIy20DNhkgeaElnzTIyRZJnBc7o50CkcYO8ZIP0pGVcF73suphxeMql0RG933c8bdvKZFWM9iTSYM7IPTQ+TF9hZh188sGLsn4RhftdbNq8WBcXSBteXMigVRKbOCB23kHXfV6CSXD9iojgWWO71WNIUVkugL9WSBmWlW6m1KFRZJgRbmYyC4paiFKyK29baU6ISqJXsmTWA3q/199A83fAkUZ+0gO63SokD+xHEpRRcZuM5cpjErENZUBAkoBtKEVP3GqC91oWacsC85AInHyIEmWE7CusvwKALNHFxL36k7T+pDiyxCyuQIc6ZlLCVBxxCnL2HRsMBl1HlCGR5MH4lmlSpQJ6TXsQv5PBcPcVRR8Vo6beymWdattOtBNrnQwYQCeWdFTUvvlG9gXWCnqD/9RFzdIlsh5LZqtty78AEKPskViRcKdJTfgC4NGiTrfeqLcytJqzPqs0wDhdsgRsO6l58VsRLQQY/KTx3UY+IREXLL9+ER2NJw5ifpZQR57dhl5CqEkGA2UGUzchCQUuOykLpS6RUk4/wzU+mxtBUF1fST2lCjByiuLRQx+h2rnnJyLK89jTMaxV/tfxWWoZJVnvzniyQ8GGiYlZktOYSzAgW5myXB5Ppo60+f0uE66VrQ0EnT2k0xOOkwkPCGBVOavMJUlZXpfel4cfkiOw7YrWR8vLT9Q2wCzZXtmbnywb3VEi0I018fDBtsuGlYzdCR2FY3eyz6WpJLXpJk7CFRbS6NvXkjPzo7FB9/dQNIocf0dcFlECQRIYNMoi6mCzAVGvOG3hzrj1CxYBE4K43JWyB5RQY4PcNV6225C5+4PjJWnbbcJcuQc/B76I4/fJUkQ3XizQvcTcEzMeDCXbo7gJNXh4nPM9pO2NzB6prjuxfggI/Nn5QPWUzHSxun0p7dyL4iQ9O4/iJUptL4WkuSP3ztQGjMRsMxVFAkQvILxh5B+GEXJgsBvuG1qxGyAmwfbB3O+lZELueEhXTpj7+CGsasX4UPyQyrZGZaJOfZQ45YqdDzSXZ45xnqZX9HN2AI1035y0QYboJx1lkpvV9AjTlrlS62W7zS92ukQpWcYTJnZMVpZVHkkazLg9pqP5KOY+QnJFPWWjylFge19rYLWZy1IyVx+i8PYuCxNnYNRvw9AXblAUpb6fS6Sz1xxRBdh4krOC3VFKD+gx5w7OkLn6G15d6GivCfPOHLGUazYGQmgyDFGeCuwy/w6ZVAjqLrDziI7XjXEZVBbBwiwkZWDbCXFZYPJc3R0LVF5oIQtCRXelNykXdco8/I3xd0Po/kVpb/NnG7V42ciDRKUI4PI6sS8i1s6Jxdj6t3/TqZYm2KVWCiqb5FqMU/yUV3g2IudkPWWpSsWjpJzS0uyDlzd9arzXlCKPCvsU45nmUpC7Tpn11l1jge3WOhey7Cg3FMc61SukMp78nYycz972NQ0SMnL620Z322pSMnKUVjuVNXyjortHdCTHpjSbd/njKaH3KqgPcgLSuytG8knfTD82LDD1gCi4tUF/NgFe5MbRJAsKaSic0/vsPChRE3eTRKzWyVESHsVrVOIJwuAY1/nSz66/tS/B6+AojECLPAnh7NBZGBbAY+RIEtLx0WoTJ0dL91ocuUd7AEXokeSOQlAlGaq/lGxh63K0yOY63YtfReGvImBLnlGLZs4ZL6UiX4p4GnnrJIzYSueYR0L8Ktgia9P12XVeksLueNkOUqjFttUDu+R3cquzG36XQd/wcrsll3vRZ1K0jkVQnQPC2m23ZFWq9rOjbLHdksWhjzfbLbkp9WEhUSNBAm8ofcO/I8XLsqI7ISLb/keMb3ZR9gGDnZ3zd0TtV3MLGwHMTstyrACbQ9j7km9p4LQXZV+VFBCC45e1HHkrCJb/jsgbn/lJxAnEzf9E0j1BMyLkXdn91FRb2uN05vZm9Lwxio4bDgbmbEBgTgzoGoxDiAmfMSvCdMeizgwLqIEjPpX1wdQT3OtCTk2Q5nxHomJg1nCQNnwjaYes2Q8pWALOO2dr8Pkg0H1chowCnqkdHh8P9dkJWobDMmQfyhwujyC2kVtBFikB4S3oEWDfYKpcuhPjcALWBik8px37aDje5Nck65C8rdFLMmWCgUUEYH24U6F5hB6oceV9m+mgDTUTbaglbDN5HHlzEuHBFQHu0GfVO/V6gP5RkWfN3DJhB5rE5vFo7lnAD6EvajduFBBr7vr+pTu9OsKSoItiMeXHcpeid3QhxFdkbLkWs9zLywj+TKMwuFvB02wWiTiGp0hgjijxpuip3o29Gf7dzLzQYtYl/Jt5+Ivv3gJ+fS+4wr/h9OqXTZhAERCkwB9o63KTJGFgMWvqBtdujA8oG4MnESQCMk09LIeSd2ZJ3zPT0F9E4WZtpQFKLWbN3MRVf3wP/cXMZvAjpqGK0m/NhI+/iev50NxsDk3NPNcPF/iAmbxr+MWcUIvmrTBLrNCvtfBnsUjwcZF1GD3xQBfnIWaeh6EcAGihWsxaNuCnCT8t+GnDTwd+uvAj3Jn6g4WWenxLfEtW0B2YYG8FPfWC9SbBvzCMq0so67uXODxfLESACZB/5XoB/lnjb3Ql//yyEdDXlQg26o+X4PBWQvY6cGEWghAXCic9XCe6U6EedLhJZEcgde1NEzkFa/UbLhQE/WIxK4I8EWSONpcAA/AhdleQHIupqjEWcPjBw9KdhTfwsHJ9aD8ON9EU6o3X7hT7GK9dLJJE3pWQD2GwwIc7hNN4c4m/q5UbYYvY+wQIDvirYDGByQL6xXcTbcWgQD6BVYS/S/yRiwQSYvgT4Y87hSlNIBdM5Qa6eu3Ct2tvJmA33FwCpcve4FaLr6GDuJ38ZOHfrZfG40zMjTe1Im7grdxETEM/jLLXVagmTL0nkRvECtSmXiT36dT31uDkCIF+jhAv4inCtO+tYyHdtGswRbCF3ulu4d8I+7S8ElGA4OcuBMJWoP+40SJyZ57cIgBhWN3Kja8kOLlqY65UT7I/iawThKmLUD+pmqFK1zdqjhRYJOHaXGEMyAYP8q4sV0/9US0lXiLXWw4lUWBz7QkAr2sc2QRirML6zMW3vtw+c/ECpvytm0TerXpXOm2fcLJFlKXGEkvBW3ANaulGsZfefL6JxRtvsUy8YKHSYqRIALO8ddc6LXFBSL1YIgIRr/wwlD2By+Jp+vRt+vRd+vQRn75zN3HsucG3/kZ27rVar7lArlD29E7i07l4G0brZeiHizt8fT+fK+QmPoSe2RtgT218N8qN43wdGlk+yUAmc/FpE11ufBFMBczsK3nISECV6DuMjtdRqAKfTDdRLPGzF0/dSA55FoXrFAcAcB7P3akwn9Ewx01yScp9V5YQR9Pc+yby8D0S3iJQ0gFmLV0JRfhXgc1KxPqPCeAiXq5VbniOsH8rL469YHGsd468GcADzmUc+t5Mb+BNcBWENwCBmxin51ecnpVuNZj6YSyRs1Rzs1ZzmMkZPkUujGelG1ohjkfUL2aIkVarjZ94sgOw41eI/kP8uZZbc+3OZrK69dINkhDxfiQx3UoNCIsipsWHXxBxr/S2W0nMupIIFd7Uk8atK0SqK7UZV7JncOvJHrA3E4hXLIevT4CV63uLQB818k0fXH4YLOT5vAJIwSDu+vkOn9JyqN2GDwqjrGI5tFis3CDxpjEizyBM3BST6pfjWzxvV+tIZBMZhAEu1y/Y39/h0CYQTBle3elU4IKnw8B+419M3iTh1F17iXa9hwnhau2LRL+q89MLsoMUk30XKSZ3egWDQ9x0udDQdIm3aUU6yUJT4fuwxHKPwhuso3pbiumVmBl0le/iCT31BR5aut5p6Mfqj0SXQGhFOlE+KjJrGobRDNOjMI614jgQYkIdlagVLDuQqvem1FYMIDPLHkumQX2J4NSDm9zdpVzbWXiDinjwGLmLhQI+EUyVMR8SkVfibulJmkxjkCiltWJ8Uhhs6c1mIsCHBaIDdfhFYu672H1vpqmvlUSgXpCIBYYbY5YXSzLrygtyBJksqmYL+iunwg/xKPPlhnNv5a+8EcGzmHl4biKbAx4kJecFWRbY6mu54TZSlUwhwCAMpvIv4FD5hKwaiYGD8CZyJSUnAkXQrTar/LEM59My9CV4wazHXqD20jqMJdWwjoRagPXmUlUOyDLU+xCYQWHg3+Gjj7+oqjPDx2v5K6JYJoQ4lii8idUfReGthe8j6MLLNFyLlFLEcvHSlUner/pPbNCH0VQtQZy40gUkHgpxNFXoORE5miKlFxP3EnkDOSLCDWJNKEog2wBGWSPZp/Y83rqA8vNmuE63Kx8J9dgPEWd8k+KMIDlOoc+dTjerjaob9m/iXQuNSYBQOL50Y6FWwI2n8kBykyTyLjeJUCufvqveub96qw32AkrPYfpFML1T71DbMap/QoKQO/fSw8vY5V0OP3hrg6LcBF6ik4/VgYnP0cYXeTQSRsewSaJ16GtMW5J6LAnRuIw8wPdIwKGhsBisCI4Btz68ISU/k5QW8srcQCGZ9GoxQ5pL5rv2FMWBdJKYLYTaz8IX17qbig70fF/9OQ4BkSZ3+lUNNqOh8UHPzRzIt2M9E/LNqECSJCvPT98U+KbPx+7s502cEjhxEglJeKhXCa74cu1GnhukWW80TM2RBIUWFnD/XDQ1Wa8pJYPG11SO7r9+N68WGaqUZSQ+BCIzt0AIRl4ArcGWvYK2r/AN7r5XcPcFsltmvhJ3ayA1Y/kMusmBUC9wgMQGDkXMl06LL5JERMfZ8QaVCn+laW/5CuNJUaavqNd0XTDgWXpzOZZrrl5WnvGikYd8TSdCvuo5k29638MlSDEosgzxVfZ8a96UiuheAqTE+hqtb1bhNHGvcU5CTaoDP1pxODL40nRBGCnKVT5o2E6PaSDD5vIMWrtekBzrgsZ1LZ09dToUxqRSTThRSWkOvb5AUYnoWrj+euma7/FaTJMIeoep3gqxny4P/YlgshAtA1xuMFkgfEcCITwSa+Em03ATJOmb3N+R0Isn/YbAA5B6iO2nrsT6IjtKcrAcq3uPgVV0kriV90FMgpMuXTxsL12gOJnNxLWn5z5O4NKaeL48pZJwnUIjvmSLGCdReCWOZ268lCL2XJJRP6bBvpm663wCqO9nKSsvEZEPs5ulFZvLvmg4zk7GCEiodMru4kSsYGtu5C3TPC/daCGS7OlOn50KQNLn1NIQr+zHbjBdhpF+U8w7OW2YYq4MJKSgqQ9jQDUbQDWbwFNMQ2lwq678l+EtPsXepefLYQP9IZu4FlFy7M6uj+/0i+zd8W3h/c443cFhtYGDFGV1E3mIZ9Q2vgWd9UD4kmrBEd7tJ0EztzCC26ZBNkBjd5B6B6m/wr8wXLnBDOibCWU/GuSEpCNwc8ptrGmSS3EtfElo6wvmNPQ3qyDWOeSrRr7qoyShZiIIVzrfTKyTpUnAp6cqPmlgQXcZmk8gqdF5JNFY6SniA5xIijhD1oFIlt70KpBsRF9fSH3NToZbc+5mBAl6M8GzOlPhMTsjV+6tTvcC/RRew/UB94a87qW4MtikY1f0chTe6JQovNFTJglWBQYZ9RrpXke61/JS6cOKpG9ZT+Q7vCj63pPcTgQTzSVduxrlp88SmSCNIJmdl6omRd7Fm3Uh4W61EknkTQG4U2SSJ1onlH2P4HUL3HxHLdjtyndw0WSqJo4hWQ8Wizv4HSr5E1+Qky/3X+7HX26+/Dj541b9/bL7sjtZrCj7ATI8+33h8++f48fv4OPfgZF4PP5y/OWm9mVTr3/bO/6yefXq1avJCWV/wxywwjLHpPrNCWV/xVQycuT/8+0yock6Hm1Xrucn4TYR/hZ0ApJwO/Vm29vVek2dLTpNm2zH7vGv1dqX40mVjJzx39WbM9l+Q+mJR9mfdeVfbqpyYrfQQ+rAx5/g4xi6Wa8fw59mHX5P6182jW4ffvv1sy+bpvzcrDcH8Nt59WXTqtfrk5MFZUJgA8D8/waqTErsOMvUc268YBbeSNMV+bxjkVEWBXMge0oVeLlAIVomjMp0epXQ+kPoe9O7XEg1KcKDRxZyFLscJ8nxGjMex5v53Lu1hlFtuokiESTnOEG2XUgAaf2pvrGQEKRuAS/mAds4I4/WorFm4Wq9ibz5nYVxsn6n4mSZKkP5/hOX3cv37z+9fVOqvrVjMoNs+vPHA7l2tKg9VJDAfYo2cSJmIBeOj+SkHFlVt2odZd5OLsWRbAyUGJgK7JFJ90Tq6m4vjEfdtlOjHFNtfWS+OIkglEVlEv4AQ/mgfLKmTj9uNWutWqNusUiZTM0gygzEW6sktVk4xXq32wHqEOv3WhDO0CqMGpqr55v1Gk3bwC1ZNNSyXl2GueaLBzai6uWVDpsW86QGS/RJSWXOpAyOgZk1cK7Zhic1nThVia/w9sXW8OquxAzS3rprNs/Mx9ej/LftNqm9DX/FJACxt+7aWbOlah2s73UjM2nK+sGNYhGxBU9qibHC7JpvMr0Adsffkms8ZwPJaKfsUiYF4jY590AyurAou1H5lp6PPYotyt7JtLULG0AWPiAojiV4fOKuAnLVV5IJs+jwU00R67adPtbCm0BEetIxsOOBb1K1JhQ8EmTBAspcwUMxCkUt20bEkgHjPMFdFgvuiZoHfM2Vvm0wHxNlCRjRa6XEwzbGhz0YmOLHhUjUsOJv7z65C1gqthY8gEbCCGeIzQXoTsO2nwv+kbg0Ba+34UyM3Nyrc7/L9i6Ob4nF85BbMt/vbDsWxsaLzVnIZhM2yFxqyM8EWwm2EPxP7FrwH9id4N+xS8H/xm4E/zM7E/wndiv4X9mFkIj0XPAPYB0xnmiF95C8pCwkb+DnW/j5FX5+AQ3GU1XoqqTQXyDXN/DzI/x8D/lfCFMtieTNu7Gm+0RO8AtgtTn3QMLq2DL5WDONfKwZ5WpTOgtKGVD/8ZrAlcYL1PH2fhWzbzeen7wONDh8fa2g4Ekpe6/m7Wf197UAM853+PtJAM76gL8f8fct/r7E3zf4+y3+vsLfX7HUZ/z9BVP+AsDEvlF1/6jXZl+6oJUnDGUGJZ3dk05JubOnyWctKvLy4p3YkLgEoVhdSoZwiMUkSZvKpdBJn8qbJqbXvOtFQSKeSr4lnacl27erNRKIarB/ygarxqYzSq2FVIKvpchSej6h7AdVw3dZDX5icB8lx94zueqKI3KIXx2FRV6u7rvizerBpmTu3wS3lkmydk5Obm5uajetWhgtThqDQf/krZss8eftG4v9tTQfEHInOHF/PlTP4ORWKnL8JPifBRMJgEuS8HHe5yDkqUoQQX+KWGbCooQb70zFEmNhso/+8YpNmVvu8GvfGLFgTqk1k3fMM2pI6D1gtyCRpk0kMa2rFAGpvbnwjyShbCb4THD0tJEkmauN2ofTj+dnHy/enr18fXrx6a8fzugoSpySdLYS/MDccM5nYlRKmjnngE6t0zdv3v949vLi0+l359K1C0JWUjM/sJWgzrlgp0aB00+fPpYWgA9Y4EoAwFqnL19efP74+uL89NVZrtRH8h36x9jLgKW/E+x7Vfrl6adTzGF28iP5U1o6lwFL/0kAbrFevf/47euXFy/ev/t09u5TfoCFb1jsR8HeZ8X25sRIx+z3O/Zzln1vRox0nf0vglufz88uPnx8/+r1mzNZPRiZmYmIaqURGk7qxenH16eymneFLzhy/PJJpImf3/3w7v2P76C+T+9fvH9zvt1KbJ3UcI5fvf948ens7Yc3p5/O5LeP8O3H79+/Obt4+f7F57dn7z5h+htI/3j26fPHdxcv37/FtG/zaRevPp5+lxZ4ZXz89PHz+SeAoL9+OMOPL+Hjq/cfX5xdfPv+5V+3W3UyyAGdn757/en136AHb9nnNPmHs7MPepHg7Ehqr99dfHhz+kLWeZsO+0xC0cez785++rDd3gpAIEnt3enbs/MPmP3PgiW1F5/PP71/e3H25gw6ffH96buXb16/+8623YQc/FozT3y4fb0QuST+xJK/uf19akH1Yv8D/6paHuqRdSkNQzLa7oGqHyRCVF8fzMN/a+WUfQAX6bg1KPsWnoECqVP2F3i+UIclkoOAwuC6Brecv4gaIErbJh/IhWAvIRj9qWB/oTT9Hl8v9Oc36vM36u/3+WzyVhXr3N8+kBvokrdps7+qHD9mOTVaAzwjB8D5uRrKR3IhKGS9EEYuQC+6FGAD8KQMpa6EfPpITmWpU2HkMkvlELBtfyA/iEOoeR932jb5Bpr7Ucinj+Qb2dw3ohzRUvZZjifVEMH1+iig5QvBxlqXU1FUqII4oewCtt2lL/TkjZV24gSOUfTt+V7UMImylW2vUDU74eAnM040zSQJwjClCjOCcEKZn2YrkpeK6tQEUoFQnVC2SYvqPKbsUakzKipyQtlUZX9Dhx/INJEgM03YK8m4WavPv8LndcI+y+R5UhK95x16RYBjRGEbSWMA7Yd8xs8fXzuA/ORHJ6NZd9oW6JykCI2ygJ+TtCrDWtGsj3P+VzFKiml/Bnu86wVa8Th7X/9mfLVtUpxDaewfo/9cRxmlkSm4TqCOKKmrtHWk+sub/6v52bZ9aChtZ32gnT8LsPUtqcu2K1gDLf3+N/ge6+9YOUSDh7/bbUUOaseW+dW8IClTid0rhWpH7CSrTtQyZofK9QI4IsCikhd1ZVgtauEmERFct7krsm+6FKG73Y7N9rzLRnf5DqSnhZPnLUIHiKAMjLecxGTxHSiOFHia2wPiVuZKq4TqLA+Nv2DeTsVYTKg3J2/EdvutjFG/TEhiNKWceUZ392iyZNTELMvMt2Orki0jHUi/FFRw65nszPNnJ+rBqoohVH8vWXKnRLCTv4+/RF8C6UmMDiNwOBOM65PdQ4Q3xu34wzNIPML70yM3nufPAN09f3Yi/wAae/6Hqqhaz07w+Rleap6nDsD2WEwCfA54c/KTQMDFeUs4etWaUemE+VUUrpQTnRBD4mczhZbkOTamOmHpfZJxcTQHh/wkzMuvNBGR61EoXvOCQIGjSDBCurk80oQ7QU+t2+1e4WFmMByBtyoviEWUfCsAOxN9pQMvZwiVEWVeLeMTjuvKopIyNSWjaerb+KMYyVPGkccLBV7wRzHa64Lj7dii9JKY2vHn2YHbrWCCTWvn37//URMzW/X64v1b8/XT2U+fcO5YpQGuKx+/iy4Bce6FiEEGs8T8JR/hcHshGZel4oscPgEXSgZdGZuNz+mD5dNdeCCXuVFLO2oi0QN1mABAd+wuf/dW4h7zxu3TUWKOwXf27+S2DdLLSxGZpK7m2du27mnhI8z3jl3mESlYNy8Bf9n2GcEHRvLm+QgyEeRkgfSUxm5KUBRs5MuEWJc41HM38BLvV829iC2m7MTYdWKYIi/hhVXqUPhnJVnr11MBIMsAZb+IxHYrQYxMuhObdRgUugA90JxQJ2DKoe8ndxE7FwKdXy3d+EW6Fwmcg3cJETX0kKAqwe9wlZGfFHedbre595Ii1LZ/JifPxidfbiYnCyYyJLP3xYD+0nnSxjQc3YhBWY3WTrxcxWWFKxdiHEy22/fwR/qIwEfbvk1IQDGlcFnc5yxBq4VMLKA5DwAP1KEZUbZdyESMOgC7A81d+UZ2VJ4g79DY3aQsmMtvVGKGR1WI9JBqm0SPu0rGdtwYes95fXh87NEwj53viDv2JuiQ8RK9wBSmb1eG3jbgdQgyjIjOR52MJVvBNUr5thWkGCuwZl9OgpDIXFv8SAurNyJwTWzBjje2Ntp/GxDCEn5FErYQ0tGzfLtWb7mcwJ2AO0wpzVZLhVoYMzxXkCeUMthU7jwR0eGNXWlQJ5uEHTtL9syZvTmBQOEEeL7KwRIyfOEZdhW6/He3W/wbJnmQegfg8DMYbgL83aEzGqR7jrw5eS0g8TKfCIRZMtlusZAEdnIrXe78NiAHQPtNkC3oQf7HwZb3s7JEtv9wJble7GclCYVTUVKvicz0BGbI109W9FsnK6LUQAR6MX+QJrv69WdyK9gVidgZUtDGqqMeuIR2U71FpaTPcKDLTQpbbLsF2d8LEklTUweCJVW+13T9JwQ9cnOgxSiDU/VQ37Hbctoo5ZhbxxZ9Xt+xizxFgKcaC5nLvOH+cZqSI7HFErnvlJ/jxCCBYLcoMbLP8WIj79EWSvH+glIzy2JXQqyhQhClqeMwq985FTs0ofa41k8Yeqk994YHPAZsOeUbpILYmm9y5BD0IeRKLsM5B8+M+OK8J+qJMheO7yllfk13krvqBXvJQ+bXdDdBGIfu0abiB50kpbaseOSnw4BZ8ikLuVEpqxRqsW0yS8iUJdRojVJJknw5eX7isZBSlUXetJCDF/IrEqaoV74p1KuYHzC8JE+dnCVkzlyoEPBRKAq03YKWehpa5G60qJnhzclagaA0lyT7maApSu+nbiy06gqcLZYT8vxNLKTDy0i4V0Mza6osk8ufpkKEA7hAgfaFSSm/OydrNmUhdfLpBNLYbXb+5K69u93eEVMC7LsdO0943iGUCibCF3DjRpDd3zfnaHL48v3bbNtEPKgF+iJGh7QIQ7KINKiMZBl2kxDAaiTSlJ6J0DzbFtkXyi4gMx3uDWu/L4aDuFhlynBCqP2lx2zDpmzN5gBGBOSMqFgVcuvLbWtaOT7+ctsSFmV795RQUrIK5EpuKWHqSkf5B3tNLJ1y5MUyAEbqaEJprhRaISEPjSh9NKtp5kXJXVaNLMeO0Fcz1IAslkpOTyOnyCbvPFi3m3hTJI6225JNUshzX+5tIlUhMzPDBvDmRM7SgRwZmyolDUPo+lux3XoJcWlexWq/adsmv0im/y/S8VGYoQZc4SXgizCPL4BgX0qCfTnJJjUKw+QIMsLEzsPoEm3cjtxgdjRFNiWooWlgmh15wTEK0i26Sw/UMHfLpCDQJRtOYr5KiAYpDVQ0zzEwtHRICNQeNW+fICyDVduYt3zEO7nEUcw3jo5YKlmDG4VeYdxv4MwFPFsBNjvKm8Ps8HxmpesEOPRVkbUUUifE6ZPjCVOK4uiNkDqUr8TIhWNwF9v2S2Hby4TE8uImb2ypQ5MZYJZfxCh0Yjqc8lkOawB9PjUGPwV1tO32JiFTQBTTA4jiPCHTHKaYUrbmU1zxtVQB+CVVvsOBvJEg863AS82cb9TFPM6vDB2agxjSeW5+8wNEQJjzNIj+qahJ02wALvDBwteqkYDNcZXZHPlfK/5RjOJsPzhxdm/RlcGigbykMgul2ebEtgt93UuoqbwHPyCFAbSmEOyhLIAXV9x6Vnn5/gWIc4+s6kPZq9bzL4FVXSnJ3IpfkVV6rss3fa6zUmhbUWe1YxEcdy9QYynv/xzvRKBxVIdMaJNbzAZqGHLdIV8D8nnxX8C0Mz0F925TwB3zEnAHb/BCgBABqiOVg5wlBOjJiEKd7mz2fRheFVjpZbjUtiU3iOPvdjuesAuZgk5AU1xXqA6BFLNp6L1Vr/kieffyigGlWhybmU99v5AfsnNglEe7TO+V7gjdse6g3anv+xdGfaOj5N7w9WaEWPHDGx1eBQyEuQojJ2le3qgmx2IXXovId9ex4VaQpCWeCahDxaPyw5vnApPpLgk306UoL1RtFIsdN9KC7qwYSDkhoKhUW3kB0fkZlqdMfnBvs7qZqofu4s1lErnTxKgNSz3juhbblpmf87Q4OAXGXM+LmZ4ZeWSv8p05blAm04UaZDavdOIUmh6NH8h7qP7JLiMyclEKYRmNMeg0M3KwTqtax1Y1zbrbSfiIDsBH5AYLEcORbsJFnSnXhyoaFS4ZeD+92KxnbiIuZD6igUyWMipMnaliMf5cVLPwnXUFApnrSYDpzL1XfZg8M6uS5Wy7Imoa5ozPeG+mSbVqOAw0S6vg1nC5Ka/2gVoFFzj0wgcGrUUy7qCOQ6nmMUqdSO91IdHx5YpzmMUsMM/RaCR0ee0QOaSOcpwYoM0BoEOioAxYlLJ+Y1v8hhlOccG/dooPVxuVztk4mdSM7S2n/P/fJhqNwWORn+nxhLm56Y54HRxWfsV0RzgvaroPlH2oqBKV5lFnmsHEri7PI94sk4lplU9YNQMB3LpZVK3unjyf7v58uvvzqZc2LF89PeczcZsPG3wImgvgJJOfgcc7ccxLP8qNbaBdI0N4UxX72NkaH1lVI6uKPMWOLIiJMrF2yHrOh7CLiAxgI4VccQHTl+NPA5oS6MswesYTXJ0hTUO3sijrPgh9gNSIN5eyxvJm0NG94M/JvR/eyCOMQa2OXHom58VpVOX7MeYAX8S7XebaOtqxRq/R7x2IfcAivhfTQDkM1c6JpVZCAIogpd4F0UHoSP11DvgRTb0T5yIa+5kXeCATh8nBRsKb4AdxF4/SJ+dBN/1PdesPt0EzHMAj7v1pqYvuB2veKRWEdwhQNS9+574rD+RQ4cKw1nIhzFfNC7xETpEEy2xd3SxeUi0MpuKAa9XSQHjoWHqe+XeOMpWXNKJVwlzKAhLlvDuXEuzForZdTCE6wGJIWUTGk9LQJ5TuVggILruHAWHIKaZKShZ6EbZKOwN8fahIt4mQhc63s2pRlOzWMIbX2cpLEhFxl7kGcF4I+KYDhZZ9ehFugoQXPq3cWz1oXVa53m/Uh6Yb6kMMMVEanOoPn5biyPJVzVbmNB9dGl+KI7g33a1Fts2OPoqp8K7FTKb/oZrWn/P3nAJKatFWGATYOkkPS2+NVKeQy/TsrALcqyMXpAlsg2IBQIJpM8Tl6UzSkfnG9+2IKEu/qlmnDsnsFWuBuMmAj4iaWHkJsYxUhIKanr9R9ghBgM2eMI+7SEWmHfWoTOIRq1bz3cjkPvtw6I1UsWA0jpg3ccYeiyZOMPJqm0CGR4yo46WnAwk5LAcFS0xPW2UC+9RD+09kTKonrjQQptwIpvAhjGMPXGObMH20EqswujvyhXt1NBMJujeqHVlV3QKEBquqUzOBQ1HPS3yELt1qR59jcSRkbbVY5ICA0KMEOHmwULE4kk4g6HAqQ4VaZlYIUihmYvajq3y0TGuqUi7YVAZhTRjwpWB1de/Yhk+ZsoK1bdMcNv9GNrTEM++UqJAfGJ/ci7JgnpgivUjsYT0dN1iSOeB64VVAWVYHiIIALIoWtOrWp+rJULZqhzr578qpdpah1PP+Ohc4j99jD8AYTfZLeXBnsgZHMOi4k7AMuHcs5FMMCECClC0Tpt3gEQvUGHloBiqZ5xtOwR72cbozAjWf44k64oJxknpwTnOFcKcv2SDhKBqNs65st+HEGYcTJx8hIyMkMyfSqRd6pqh39T6sViOaaK/zRs2mE/pkBxeIGQlZqKvJhr3MFIUllVscNRxDMkfEk7GYHPK4rCGtkSuaJutogRpq61kXZpkXaNlMqfPsajWQzrOF4TTb8CW9yrBweQ/hmKQBEg4jgX/Qit+BZ3xKueGlqmXFyJoPHFpqnz9wZpkY64Fza7jfLEkyjbGjECIRySFlFEgxOxIgLp7/u/Lwl27qUtBEYBa7zxuAwobbD4Lt7VhsflDHvNKYMwOniWf17TYk4kCcaJw76Ws7nB+V9gjEL+Emge94X6gdvc7m1sXwB4FYuIl3LY5kB4zJRWVZCGjpcfA9wCSlmYs/nAK8sRNs23yr8PIAB5JW1bl0YP+Hjnczgz7hVWKOotpP2m5lR3c5OqxwUvF/+YIE/6LpN0+k/FDlCZQf1uLQsIxISCr+qlkMtiAvx6rjCYt4Yxg9K55nGMIjkeRJ+g05GP9f7v68v21jaxCEvwqJx2ZQZpEitQtUiVe2lcRxbPladnJvSFqByCKFGAIYoKjFAt/fzPT0vk1PL9P7vndPT+/Te/9xky/yfpL3d05VAQUQpGTf+zw97/wSC0Sh9uXU2Y8KqSyxa0AYqbscWrokBKf66W3gyqjtmZ5UmFO0Uh75vQ6qvme+KWyPCQjHSD2TmYEro5bLM4Jup7Euz91g5PNRBdtsWnXb61oVG9AfFdy8bhELPHzoSJmxlIxdC+bRWAWTdBWcT8fg3xVwyCeB7ePypVobKiKVRmyGbGT7dCYlffIWm+H1FdjDXjTQZeeZTo+5nu5opPdAOdU3w11A4Q5AZWmzcBiwJXXlsk0jDsK7+7fTWmhnGU0aw02GOwY/TNNKNOuurCPHwZCv7kxWbaHvd7eRRwIL1SslD6UYhYSMMAmZILf7iYlk5raNHbEApFHFDJFUR8xoEngnLcYajQWw2L0Lkjq2su6CpmiwQJljeUke5T9ZlBsUUpKYyowlKEAkgYiKdhxlKq4uqLi6jQYoCUUQDE8OrucO8uO79VgukYbMlYpAoNgQ7rdy0yQRyTSuvJNfIeTECinWksBL1OuEq2Ab9faggyFiQFIXAQYAAv0o5UTaMFUsQvCSwq2PnDkvSdLTWlncX+F4zJbvt5KteOj75ZeX4tnRoLi17rcHo0LTOmMpRfNp17aTobtSsvqpO1ltZKiFUD2Sso6mnAZmhviMJHCVGLObkQgBKa5flTE7ZK40QMNuLS4CaOnkOLSLOYrV5qfnvvOnGOll94otcEoJMXpgwDiRHViDWJFzILIDGsABDRqNZbXANHSW7mS/dFeq7GMNZRcug8i9+vp+JeV1pZuRk1IG7ktpmlyxbuHdFsQ5z6KJlo9LNnie+4brg+zcEoRrYQkPWl2ROzkY23VO19vt1vqdscy31/faGHPILgZ/MkPZiqZyjQi9Ytx8A3ME1IoVWVFhBK8UqMi/JOafFKFjTEiD0wykMr90fejUpevLjtFQSimYa2dYMqbyMY84XP6u/Vr/Tr/GN4Fwr5lrn+CPNB05QK4R9YqGzVnkMdd++/pZmiIPD3PnFCcKRRpVk2ZBayAj4qSJ61J1ibM29dgyHjSNWYv6aaA7OmOWRYfgV2UKf8YlO8DrufX6YE7PWUk8rIhZVmetP1LB93gvluJDxntxvT6gAn5kFHyK8nbRBPFZgFxCdF7Uiff9Tr0ew8UqS9EhUeq32EGrCUeha2NHIUe9Hg+IY7UsqX4On6HV9gDztGTbdbY+IA6+hwwFeUpp98xyZnWWdmNsE9pukUynYT2ntzvE3EXFQztiY5sQaVWRou2ygm7kyB8Y2vDJOSgmjbidjRuay7UxKu2RmWNsOQFT/cN8n/uhizmh459713xkh0myTQiFmrqBE+hIbvbau9YaKNeb9f2ALX51cvyyKUfmjW+wNjNTKIfesurLJ2s3VyLGEmM7l3itqrleVU97O1fmV/cs0xTh2+lUBrtLW1U8BahBoGam9VDG0x+yagtT9baczdH2J5UoYhToC1ewiIrmZTyNvECMy5HyyAQ0PT7IwniTOS2LWpiye0sAvPrW9EPwNSGaxYbJrZHDjpbAuLlU2GrvbG/uLgsIb5WIK4GLWgFwwUeVMAC+dwgRiJDpbtGoPLw/DZYHD6UhS4O86/YGVqckKjHXgeo0GlSCkbtJEihoRiCSXSlLTtTdTLvUo6ipkQOAtE2ob86oJ6/mnBJp6kXSYGPTWC9ttCDXS/EJHaGb4D4TyBPXWLObhqxdVdOczjLVCZC9KbwmxQXpEHgaU9bqTPdnnWm9ToaSiWE9sOpT1HL1mJ5t24JFle698iENgUsw1PoCoC5AbisqiyxiDj3rYKcyt4jtExN/kLOV26bzzjj7blIG1DPSYenG1Mxphtvz5nO6tbvdvhOnwI1OjG21ZG8nSTCnm631dmtljTRkxt1N3bQ66rF0o9H85jX8p7r2Z7k69azayBIjZlDcjkXsvH8C/67AqbC8Phrz++ARJOffwJfTJzkxxmJk58Sb0yHzu+a3rOOzrCYj1Tw6FgIHbhHo3oI319l8PreJM6NTwPPam60tYhM6LueglisIZBG2Adu4ndMRK3PX+9YLxC5Co27gjO3sFTSKb62Hh5NJBJxJuVQPLaesknymbuDkE6j1EKt8aDn41O+PZ+MxX1pnlgMqzN50ae3HM52Jh5Yz7Y7t3qAkXC9xAigX3wRDdNVwEwzLymeZ1Iw+tJxznfYFD2SJ0sSyEmVN4EcRXnjDeNnI5VcYtfxFrYePvcmzQCwpID92A0f+gOzS+8pDS/thodbDp65wv/H41ZJK9Odu4OifshB0Gh7UeoiRlMBq7qHlpL/N9DRGnZkhTaTWQx6oxIeWk/42080aFhOp9VDvQ72zgNiAvJeuD181zQE59G9qPUTcbmNdb8OyCTCzdAPHfNUVbG/eWcH2Zq6C7c20Ai+A6Fvo0eQ1n3ixiJZWVJIVKixJhoqzjedS6+GyDflMH+wljT4z4ED6W5Zrb99RsL1tlGxvG0VXT3mWQRbNptuLP/cCD/ee/ompL92XmPTSfQkNLAEBy4CAhAKAokOHippskN6FP5jrhTtd0usXLhAD4NsZc5V1ogxZfeFCHOLu2EZ9yRfulCyFU4C0PLQceFDroVQGe2g58ge1Hspr4KGl1Nqo9TAjYGAK0hf9RcIO/ZNaD5Wa15IRqq/dwFG/ZInrm+X5r29k7mtYvYzIf2g52Qt8yRH58DWXIHP4cnBlLamv3cBRv7AEmHZjXfCDWg9P+LLyJxzKnnAhc9137U64MNbuhIvla3dy7kZ8dPf9tpAP+lVMg/oQ/X9oKQI4TVm29y1redcwHfPJnyo1RdAeWk5IrYdvAMlJ0bOHljOExJup6hrAdpWgM3jUepihDktGnEM1shdd9InvXhhNLK3BzKcrMtNUfauBlpFD1ZGBrbdeColWlM4Al/EGpRUL6qHl6J/Uevgtd98vhyjqazdw1C9V4jUfryjxmo9Vidd8rEos3/nqqypxwsWcTsoslJFiLCJBKIWJWGxbLnwwKB9SuZ1bhvSn7P7JSutyj0oKLkOnlrV9dx2qrNZLWtEG6YA6e8QMMofMC9WWHLis+rCkeqg2xGrBhDarOJX9XEhNwWhOLxHVzs69eah7lvFBBraW36yBRoVLsq/IeMoDEXk8XpKZWup7sZiyAVhaTH0vFgMBy9Iy8LFYQAZMWlpEfpaFzF1amATz0+JkFPH5npVPumfJkjZXV1GsTqHn+XpU4kJmjZfnc+vUsux8IetiFxBE5fMdqdC/hYwany5k1skLBUwUOl/G/FJebHtzWbHtzSXFSvfB0oXMr/7dC79kzT9uuVPEOl9JmlxWoL1dXqK9vbRI6Zxn6QtFAOnF2CKYEd4sjAESZ59PNEvbzJLyuTHbC3eab1GGFs83JXHYfD6ZtpBVIrf5rMfabexCVgk7NLt0WWaaOozIl0OgcjxeXkxlwFIKKc73TCUudM3MfCrOebA0N0Y6CMxCp67vF7K7vp/LEXFFCpiZZGIhXwyB4RcyYirmzPD0/MCy9IWx5ZH3QrHct5KigKsXi0DaQtYTXtgEJ3xxByygzoUixc+LFeCuKJQSKtZfISsiz4WsmFaSNUWui/nTDwuFMlQ7XyZLLy1S0kqavFAgw7/zJbL08iImpl1S0vxcWkEpNDM+lBYqhWfGh8VCCvMulFCpC9kV1p3PrRJLMy9sSZWYzzynNyyyke8OIZgkd30dAi8pS7aUuQ5vtCgTkoINQo8WsqM4YSE/hK4dckKvy6tXAtQsvxJmEnp6zwKxrP+ErfXePWz2+oNBPen3ILhdo9sfQZy6frM/qpMuSeye9dmA2PCtW+2vk967fn+Q9PtN8qhL+uukP0jsLsMSSb/XH5DsZ/IQItxN6CFb6/ftfp901yb0fYl77lOb0xbIoCL82WhLhz8PlcM466GFavQZyz60LS/AOOQVL4DBxd6wIpUMaIVfT9HipTL0wxgcCn3/8HvLqDHSNYqPrjGc8iCrUVIjvVSOf21zemI6M0Vl/JDcBr1ACaoGLOpe2yE9pNaDtkUccIULsYSCOX1SroDI0GHxmX1JAxnVznpo1e2IXYLGTq81qFsPISyYfUEDIgu57ALMA8AlJWPsHONyTeyAGNqLbq1WNUfv2VY2agtEMhV+7cUippWzmUhdKV26ng+a8M3KKx+NfyCydsUNKl4cz3g1VWy+dX3PjZ2IBtL1qXTD5s7nhfnOtzgKuWwHm65aZF4qFIW5KXEHBcrc0pBNiQSXjA66VFDY5hdTcaPcRMmNshAor20Ee0hbzM/gZxa6tHvhxbDpSgwf3Iqq4TPtVB7cNNKAGfofoI+IbvPYE9it9QCWlwpQUHGlgs+MudKZndZPcZs4250p7o5przWgR3ZEr+xei7YHdEpIJvsdszY9B0Oy8b5utDOus7bcOCMW9cYDOmGn9kiex0v8qc6j/Zn1GWNskiTWZ5b68b36IT9d6k+X+tMlqdUmVXjmln6qRHi4GnHlyhPnFQxqGsvZOncveeUCRGlw2uQXdYQNSSGc4VGtdg5uj5j0onpBY3lAgjqzmlZ9RPB8kBm7AIUbzQiQnjRmykMnHPnKTDorKx4LCG5vQIRxGJWcD3HOK+mQFg5LeixAv8+v1cb19kG65nLmb5hvz+iIdGbMPmfV6g045ZtwYXlB5Qbc56s4266PDgUxGWSHpIsPZ9YbDSSX45ydYU10xiCxAy4NhuBiqBcP2IzM55lckqI4crUcuZSFighSrSafNISbcWuzvUfKjusKxT1g1JQk6+ptK5aYWFbAtsZhaJGyb7IUrFcEGUKQEYDzPuzZor5HaU/LNSxkzaXOtleZjeetBcq7mzJ2dWZp4YmR3wTTQxI8FhbcjakeRafM0ZzIt6hVTGQtAyuz2llUSVF2i+S3rSEyagCQI+BgoUL25jrld9lPGGq8tVoraw0SbE70gblfLSVW+blKl/gDKLYhT8Ed7gGgJ23UHpfFkwQiHyCCYXa2ujB7GmY8i49SK7NMVfWTRpopRWh25h3ZsCloYXMd9Ifk3ZIk1Ra+ZdZvaW8y6xSpTXWnJopEm40jF5ThqAuTc+7GRofJnG7u7LbW8SgbKq5LggAAD55Lx2egKIs65QwNxMp1QJTt0IU7BfgdcXfUCAP/xiJzJ1cvio9stJ9hC/Wvqjnmolgz0WMeR5x/QE9qqzendt2SYZiZqS7YumaBmDXATBJVoxd/HoUfeGAHJEmEHYApJOVzZdJKA9D60xb/LFD+y8K8fyptHIhI1sgVLs4E/JDR+kD5Gd9kokzw4hdwjT+bBCGaUbfnHv7EVO2rqpipNZ+XKkDzTG2ztjahVs29mHYskqXuY6ovcokHmDjJJ1qYCJhFLvkzTP696/WdjmVYCHs2p81mU0hVQ1GJyvT6EeTJ7xLwkUjZomRmyCVrqJSVywvCMkVz+S0GF0bVKm++94KRWiJ/iQuxM2SNgBYzvmLmVxEfe9dMmG8UTOn998p80B2NIFIIdMmopc5gDeZA+6jINogoxVlUhY7PRUUw1TMOXqJ8N5jM3IkMtPT9g9tiN+YPbsX8e7Vr4qkbYPAaP4x19JzbWLpNz3XE2l+DvAcWBBT2Z7ygkC+zzbG64hi+34fkCnaBWQ9u+dw6+F57YpvlplGVjMIQvW6yW3T0H/EAVPpVj4U7fM96uXyD+QSmIZwWeoV5e9nP1ChDFoDihRK6Ru2WDxNFOG3qjmg/Z7lFUTuI3cIqOJyave6kLuO0tZzsCtaTm/lU9zPX2YM2WRiSMq7Cooe+r4JYKLMsudxppR3SmYsQmM3ZUAsK1rmRy5Avm2SOu5Pnp8dYq+apzEBzpck8Rje6lfSrrmARa+rypt72AhxI61lD4JZOL8xaNvvpIRZMeizM2gGwagxcIJjVvRmGPngHw9VapF/BfD3Xetoev+TRDfqEWug/J6Sb5WQ9o5RUY7XQDeJi1+EenTXNLkkXUvJADCv8WvBgVDwacC5nUx4p11/NcAowLGa4VZ/zm6swUq4MLMAXuT7AuYlUW1GBGlrcKzk4pKtJ9y/HzycZhJHNabjMcRN0IgRFTNDIBEZo/qArishchOhMNec2SZpS50ZHmgrYzMdScSuDO7mrampeVd3Fpepyhzdl8GJp3CE7PWZryHsDzlp/oHhrwGOz+91u0u/bvXZjb9BrNfYGjwh8XevIgufM6rmND4eN7wb9/tUji47ShFOVcsGsfv+sDzy9fr/ZR56eRSfMshtdYvf7Z63e9a8GPbcxPmx8Di3UEztf4BHpJvJXndg9fjToNeqDrqyJWPQSG7BbZ71We1AnFr1ht2d84gWO1e/3+71+P+73TwYWjbjPLwGLclpzesZucZ/JKAFqoqgq95lFeTDCp+f7fOL6UFdg0WEYCNcLYqd3M5jTqxV1fGZ9hnXAc1UdR7qza/0z203cIBHnPHEjnjz77CLx4uAzkYxC+Zfj2xW+nc1E8sMsFkl8Hs78UTKNuBA3SQwB628SHoSzyXkyCYPATSahF0ySKzFO4jCJZ8Pz5Mrz/eQmnMG/CBq8SXzvPU8uwoiT/tnanF4XPO8CfqV2eMA82xz3MLyQsYbkODgOWxjDHMzBrYfe4E39QUL+I0KLSWblo3Ao3Gxh7K7z5vjpcfL5s1+9OEpeHr85Sh6//SI5fvXm2Ytn3x0lXx4+eZ786le/Ik5+uZG9ecqubWttzaLWAws4z/DW7z+yqNXvP1qzCD2EpN9T39/nFlf5bVD9uMjvpScrsk7yWY9XZL3MZ/1hVQfqlv0w4RcJv06G50nEL5LLq+TyPLm88ILk8sK9ToYXycVF4gXJVCTTYTK9TkZ8kkwid5TAP1iMJE4u4uTLD8n7Lz8ko6mXjKbDi2Q0nV7DEc115lm6Te0u66/13q31g8Gj/hpZMxba7G/EJxzkb3pzr63hvljrr/Um3sXsZvBoLT0Xa/3ArOaGpkeip0sN1sz+5M8Q/Edf5mZLRfeU1Zznh/Jmec5RPucrA5A0+/34UQG0mZmR1nzN8vTUbao3L10Zvjh88+TL05fHb7589vKL09dHcOj7j9fos6cQS/f1kXNO3758evT65Mnx66PTNHVEX7598fjoNfy+oE9Os7cJffzs5eHrXxtJl/T10enJm8PXb45en0CCVU2qLKkyljxMHrKkltRqSY0l/f4j+Ac/6vCPJTRpJA2WrLFkLXGSTrK/n+zvswT+TxhjCfyfHBwcwB+W4OMggf+Tfh8gdC/p92/htkj6/XfwD+pO4B/+gN//H4uefHn0+PDlF44NPCag2DXSuPbu96q9CmyqtSye6ZkXuNEN4iK4FBlYajabJlmmXVbCDUhIinnMbUHXmo/6Z2tUV0bX+mfNR2uE0Dwcu+DC1RtByG33IL/rrDBw8LvlaAecwKNQ8W0gtmuOspzPKSdz+vjwyfOTrw9Pvjw9Only+OrIuaGHr45PTk/evIZd8OL46ZFzRn/59vjNUS7tir768vXhyeHXp98ev356IhOPqIpO6FzTJ6dfP3t5pMMVyu+n9Mnp46+PnzzPJ5/QL6EDubRDvavw7X22r/D9SWFnYeIxfXJykkv5gcpg3vLtGX3z7M3XR/LlpbmXjfQ39MXRmy+Pn55+8fbw9VPnFT16+fT05PDF0enhyenjoy+evVzh6tKNY28Cd9JtyWIIpPqbp5iMi8B4rz2Y48LxYLQqJ6xjrz0oWUT4PzPtfaFwyyZ6oGl6wXQmemoHNMrKpyWfypICkGxsVyGrsbG7EZOx6oUMKI0VtlWx9MZO0DIMxKH9JgGI3D9L+v2YWOCv8FTGgXmMNb6gvPle1cOyn0lSaEMHZy4mGwyX9Chgh9M31iLGKL+Wo5TyZC/WjtMUqCe1WvZSfpYtHH/pcZajtuaYP63SaPxxKpuDGsTwXP3GIcGQeTAiC4wx/FqpVXgwqrgRR9lJrMOySMkQVgbaoWqdVPXZpOGrgYh/riSod89emygk/APrWeEYw1aPpAakRS0vsKgVhMKilnwfa9UeVKpCvAvdc2q1ImuQ7dW3Cnez1KpbGQp3q700l9AIoR1Rbuw54hRWk8gsxCmwx0u5g/mDG9C3NkdHQzQiyAIMOoYjNQ6u/wTquUbpHuBNEX4dXmkrYiBwo5K2DBJM6N4noI3bA5HmgPU4/dGGnzTqtQdkgK7Y0rZ/zFkPi67UIwOCvAQYfWh6wdCfAbeh2Lk5TE/LaWc1fwPQaurPJoCviLnh/zayTUfF6PcLtZbsKRCp1oVVBxLcjfmpF8Q8iD3hXfKu5aHnqLodda0J/iSKZA7KeEi4M58BeOIxSnXO0fvGhF8bDv4x06FgKh7BNIS2woC1gNJ9PfMViSuyL7l89TpdaKtnVjxgItewRPR7gmoXKzpjvcxJhzEz3IhtUIflbfJrPoSbPuVpwQrU23MwkvZ8oJPh/OUaTx3wYCpUwGzCDiQLVe4hni+RbkXYOaST9ZhHrzmLcgoXDLp163MhfbwXEZTbqM7aHY30RMi4DNhURjGxLOndPUjl8R29qzkby7EGMkYSJ7dhnQXKdRH8BD6DPM12i6orCYT75geVXOe91iC1XLb6fbzNeq1BrzWo1WCU3bAOt1HqpFWdB5yAuiCOHdaxALVsXbZWA9dtWZwoQtJBf28Du5N8r0G5wHOCcUdxLn03FrhvWGuOg+TkNj/JRpZ8iXQqC/lVNR0pUdc7aeb7nQxKjL1ghJWkHtUFOH1LvdhwgE9scWsbXj2VppTdohGheVgnaJBys8JS9u7MN8/gzBfe68LBHBrOecxZMk6Tnjf00YejV/zxhVqNCDVl39KZhPMW5L0OQU9VIAUT0Ns9TqMBzFszAxQRQGjRTA8gLWsMAMI84vHswgsmJ0M3OBQn7gV/pSBKxhFLPUFmg8UL0xvx6ND307k0pyIPtLIBSKjDKfhZltc+7F3RlAGYsgmv19NdmNtdxhQXGiUdsXyHSlggzC2pii8fProxq9UieV4ZK9SpvPst611rVX/qbWr0Rp/XqFYrDqrOogzEtxe2HGPZjKWzZywMyI3miH+prRAdAUc3BoZmkpQmQxwk4F4r8r5Wy35nV64Vc39skUVE7uj1a6ei81e+h2zfa2WYDKFzpbaMCKcNn19yHxxpuhXNoG1WKiecV3R8eTSQNfxl8mZKMR6CzhOPmWcvJiYJyCMNIXEAEQ21EA19qAVNL34iJyD1Dx13eo8HOQY5x5KSpb8wW+U5gwIFID2gPKVf088Hy0pkXQHVLNiv0mlBJxeTUMt0UyqiVrN9lr02H0xdIXgUaLy45BOhWSKoi6Uv7K3xQhfxHuynz6856lH4S1b/FXqBqny/0O73FRFWvr8IR1xX8j2tPD5+82WK8qtwsM2KHXNegZyV1KUUqWRbwGc+eDxRtSTJWv+qvkZj3fdXsj1EC3y84Vwcp6JB9C+21n+c9M/WCI1lAhZQH2GoPBgBRDiMcRWxCh6MWD4HdARkJN964vwVkgNJojKm9eNrrWbH8FStAB0ELQseXXiBK8LoKBixqfqSJJZFixXXam4+O1aZS6kzWUHXSiSKWight5ok27C0+q06lRJ0BkcYh6NfEDrkXrXDlmazmaVLrKPM2ZuRxJuXbuS56Fq4CrtteM5H36RJdjGJZQUK9WdUA8job3UuKV+hAoVaHW+8UCPJQEouHfI+yCTbstZYuJGIQXiDP7qerX8qL13YRFHVIldJBsPmCoQC3tYNHCl3M9nwpSoCwuY0liSbarpWE7b+TV1CFZaUc2Sa3aCoeGnA0YXGeA6NkPuc3sLNrT3Mqzt7LgFiYTeaZXOf6K0sDBwgDPSebkGziErUmdUrFBBzOyY0Bow1o+oeGBHuqpKmLx7EB9kaGQW/zQnKQf8qdnqWvn4sag0x/K7lzkQoIydYA4rxuRc0Gm91ZIWvVWnQ250F74PwKkiTqu35nMLdMRN85GQMz4LAv1Csa1mOde7/EOtARsWm5hgQyPcm54KPzIAH0O+nmBkP1oQLXUQFtlNv6dbMBVT4Hrxd6ywVS2lt6IS5VbkJZ5V4yofe2AP9epB9VVTo13E4C0ZNrdFRGBAIXl1bISkjTpTCSMoHqRQ630XPY+kYD2ciNErT0jlhIhudky+fHztNa5K/JMfwmdxy8dLac9MHHtCA4TOnWaczoXAuLyBbLB2frJcqw1AbAtUiJFABvPmc5rvjAG2MYTx5MJLEhT7EoEbNLXo7pz1uq40rd5iTadvAZqOj8OIVbvXbNGqqk0YjVLtoPicDkoYPu00dtTiCfjPjr5CDAuVjgfqJAMOHOo9tpRX9EFsAdedzxVf7kt3KYNROmkWF+bcc+5b7DqeAiPvQjuDXwokMsUTAnmsiMsirZipqKWQaVVSaWKpuiAF9CUbbWZhYplZMr9xCcFPJCmhJPoCsHhxGmuF7zJCWOqCZ+tVFmj4cj2MugGbK3swv+7l0R4Dc2o0w4jR+Qdeh+IGn9TqiELqnTPsRArrwNNBx/TNmfVZ3bS6HSuqfWZ8Bk8Lat+pfAYOmN4BrVKmaGnH8qciEN3XrwMoF+EGWh7W/purIfUZqzc5Go9yndn1nRmzZMTJH5gpPlWTTAB5y7gWLbVzrEBTOIoNtElBj2iRTIHunAo/PrdeMQE0G2IDppTYjnVF4O7QNPkGboMdnbGx+dQ4kMlaQrWStZtSO8U46ZXX70q1A6QKq+D6YgkN3PKksRUv6krJs6tmo7QB4mQF9bocE4lgZAcK+yulD6hXP80GzdXlu3neZpVL6naugYGFkwxqELDKDOYcdiLEe8Gtx4p35XjDB6NNhGn26G9TVG5olNHVkulwm6bMRVQtwdvSep3KGnYBCTicENQHG7ZAGhH5lh0SiM/baWZScR4l3MUlQ3LMGCqzF+sJpSXWZ1sMc7MsAlZAz8QVwYr/DgJD6+sNQAYBi0F8zrevJDnKOJb//9vD1S6cCrLTvZYY5/SXTMq0vet/DlzVUaxyARYpZ9CmfRnzoIgEcA8kLeZsVpQOZL8vQjfKvmEs5Zx4VPDUECEItCUG9XV6K6JU5nA5KU13YELDqHpCdmjxes9/Z+713B4P6QdIXCakn/YCsTS6oz6wn6YUPvDsk5FNs4bPb+We0MvJGiCSMw0gqQ1b80B2tKe6BQekDiTfzedeiM3Y78mJQMT9M70cItYK2Y9Yr3/WCCtwMVk6pBTs+ZbdB+KW+V15zZ+2dHYSNbnrVkAdrHtVNylsacvVRI8zuOpBMug27179qDOqgfONRQ0lVImENiwr37LVUEZaqBLOYP34NEZl03ZLgoKenKu6NMzSO7Ng4stNmrsfKP3A+GJGO4SNPJFqDWVZngRMgunbIOHKT8jgDdQ1ciAYqIB1x7F/aVrvV3Gm2LJrd2DbkpBXEhyrNZtONJjGpnLtx5YzzoDJKt27TItSsQdkAgsp/VpmsRivOoeo8d0fNfnAuxDR21tYmnjifnQHusGYgDdnv5g/xGpoTxmvr6zs7FuxTTkMmSHop3w7xhKeT77jzznvbkmyXDM+wqEfSbe01JZrR1T+cke1lk+RJfBCmXUMNiSIy9eW9XURjLIp0SS6EU0QDGpPbfFi8TDJ2tChPwjsiB7wdSMq7Tl1qFaFv8JRzE5FaLXvtRYN8YDRp93YIt0xKaxQMoOAqOzb1unnGiypKK3MVKSqkl0tMWd64B5+kap/HpMPZyM7lpce02qLvixUspDC47abS3I2zC/uY5r5rxCmX6Ejx0mEm+sX4Mj/UTdkwoU+aRd1SHbCO8gz5n5uhKOSwD9M5Xz5gOZmtzuECs8qULSjSqCQTsoyPwRBMC6pEh4BA69gUMVGhZE8djUPP7ENl4hOordjjNBxIRmjWw0jWTH+os5Bq2hkoats6tQg4L8eNaXC+cUMXeK8Yup93sFqtDyxQ7EvmcsnSitiqiaCrpmBuDNrWy6YGMbcJPWaWNS8FvwazuFZ7kqkllw0kS4IhZW+gqpi/T0HEjGwH51aaOh9CcK3DBaCuiYy8MbOeTFQAxxGKFAhFtZoM4IFrOpd8jtcg5VHrCaytntawGWSVSUNbTjrGVxR406hgboNmwxCAQVaokHRgp2jWI2/K0cng6+p3ys6SQoY8+0UfgnNb58fhZxMyMdYEBniomVeGiKNrHyspZ5s49hsMwWhUcWliPZgNtb9nPlx5cvgY5bMX5VnyNOpl2kuDgWG1E6EwQsl+7AjjYNOgMF0p52SSX6ZokWccKZ6vIUEXmalRr7HW77978Kjebdok6fUHt/MBWB/1+w9qFiogoKpF/N6bdo/rTDhQ3zXiUboBSAaaAvLJnjyWfO58ziSxjxkQTmM7KmTtthxN92Qze7Mws2Cjro+bFm1D3IBDCnPUMSN6VQRXkMdlhx3X7L+rWj4C1rmru4hvxlDMD7Wa7DmQcYf5o2sYDAD8f+9NkyQH8pMEwPuhCd4P2aHajor0O0QjR5lkBMrUDFY7XFxU/VWtbSifMLdhym+kxkjNGYbTfwZcr3SqrzA44a2esLBWCwE2Aje5zgSVAdrdlFlnE9qCj5n49EyJT5G/inSXfD9LRZehVpDEq91FkHEMwkUpUFafqXrW24RWvTzfWIl4WpUrb6TVsSp4Ti0dQS3DN2VsEiqaZy4yd9mZPJNC07ltABhnLDRkwLLT6c0JUXdgkJoLbAyrGmQaGVnXPlOYb0WKhSrA/Kh/ZqG1PoqSrM/qtrF/ksTanwVAYIwOLOSO6HHwJuRnh5RDL/OTmrV8o3rIgdOT9pvPl3RaTbwZHPTlQZtv1WovDzYeqYkvsRmdhoIHwnP9iheM0XNuxQ/DKa1cuTcVsBaoSPtxQLUr4twN5NLw2EqZCsd1DHCt9p/s/xE4WZGH9kgJ8r6z/RQwWbdzi3IVFEbN71vJzk2pNwdmlMuJk5Ves2/so0zTyp1LfOcEMIpDFieJVhV5D8T3E1y8aTOll+wp6VQLER1gpnsSPiucqAOstaOOYEKfWWECBZ7GWDaSQW3QFHIYlz4HgydbdvQYUR/Wos9Yi75kLfqGVdsdcPkO7WfXU07G3umQ25f1On3ThdzOklw0S8/wvGepqlP2Fe/+SCsYqYAf6hxe5ThizzINI046z7QSdD09ZFl2+xkhVIHLzEqPPmlmtkz0hD1palsoepspXWPIhbEfhpH9A1FeXE4ysounNgTVNtWE7xMqwikgQNITvlQIFTryYq2W/jRUCtTxtfTdepvW29JNPL5xbi/iiZMWpypko6MB2bNGu9Wiz+rtVotQOMWQF4woaRyO3cg5yWmTy8H8Ci7EtOdzmHlvoQ/tOwuaU4Kjp8hPeu16MR85Yq6B5Dwfqhe0D1mUJNMUeMZJYmp1igyJL2P13Jr90p1ZPFhplzkxFww6OssEMJrQyVnmoZ6YZAr6UNkp0b8OlXqZYAcjW8gQXgQY7voIBiRFA8JmHEYi1fKSWsFp3wGGZm8pFpElNYzMUpSrpwuZtib5eWp8JE20VIy5OB6jnlH6wQTEp7ZYVoIXSzTaWeRmQmjPo/GAudRnnp5FvxnzYRiMTs94LFhMtYnfhN0usCcKYhAUe0ybyFmSetqp5ML4naGP/QCQxZzx+1nUq6wNHqG9ez+wMNbOctmLYoMsNizFI+pZbHD/LDqw0LPKJVszOYWkDkzCG3Z7/yYzrtqKdi+p1ELO+iHWJtQsLD29ZDjVGZwReWPkNA7NIyQ/G0QdhMTviHpK3wCc7JovTcNYxUrrnDaL3MWUggMyIjurpzbqPafHLUnsXxcvXcxB6K9t63PXB3Z75cwdvgcmahA20vmUGA2gNhih58wPh++b8sYWXajCsczs1jynLCnstX5cXyOofYkXIpDHSXKqbkOJGIwzVy5lnDW9pFQK8FLoF4G8nvFUOCeaAKGfAKAOBAQM7Z7bIb3N8heFndXWnDgXgF0t8tsKjaq95EqpYShVC9Ijo31kFYV8ac+6ELXeiTpqXSFMIVoFI8fXIjSs1YqfQpgeGgE6lYqBueImsmxQ2WcawVsKu+AacfGXmQoBCQ2ggSfBeM9VbKQXGjG/lDdYmmOuzR+uUAcbQOhVUwa70suv34H8ltzfbeT+Aj6aMrJRIxyU/QxucaXy1uQMIyqU8oMt0kkltz/OeHRzwn0+FCFiTCDXRn60lYnbzohk+R8BWpYe9WvFtAQhg+RMZgLhiLujmxPhCm6y5OwjkK98fONpk6cmN4lJAT7Jc3CpQC6Y6EGIWYMDewKMIpNOQoOERRsQ2AMM45/mUdfboFcQ9A2YmJvqLYcmenBqaB2BOohoLshZspLvC7yojluuhwTmI6CjHg1sVK6al3kIu/KCUXhVq8nnQvz+pR9s6+nxCwUuvg7dER9ZhjtFcntUq13b4Din2i6qe3N6m24155zmVEecC2puQ+eajr3rF270fjYtsy+Ru3wdd3masQJW26DnIkOnjio8EF7E/ZuKF1Qu2+1mK5WOrJvSkZjzyqeIPrY2Ni0EprmrLknURd3NGEkxXpBw4aNGmc4gb2qHO2b57sp71OGEOELGwpvTIuB1zrKkx3DpLJ+7goQJc98xgevGBC4XL6muVILwqoleL0FiOwyDsTeZRdzJqxhqjEZWuoGVfoaJn33Eam78TlZzaw+4eVPGuT1Fu9Qi+HSuFpKOAzgDpqRhFfyVuT8WCtMjJpWLJnAEo1RckjZqCCIRmQKKOGQww1moM29sf2dbumwFwYEnWe9hhILhvKqYbg1EigUMiCD3SfFiOt9J8mM2D9H3ZZLY8geLAMZGA4xRG7lXT9MWQUMXEBvoK4XC0jkmKC2f2OlLAQpHcwgwGCyfBE5ulU41QPacn6e83zo7IAQQC6lRksZkF4M59b041cWLF7UJK3m6b04N1T3nNF0gJZxAYvbHmRfx0s7KfbKJ+6SQ786dn278zd9m409nvr+2vru5aZFO7j4CZkBK43UWWF6ggIgbRi+QdJqGQxhJZ5uwiXy8HIq7hwPFk+njeWHgHFIvOOeRJxzOqTsaKT02c7Kq+ettAdlF6GUNQJ+ytxQThojnqz4zwQ5WVG7nL7NbROod0eSowww3Hu8V8eFcv5bgyqpby77qXpXXfGenkDvgpr6o6GWqJwhqts1UbRBx8xE/m01eAHPVFJ2CQzgQNrpjXvKtBd9A8coLA2kJx+T9sGEZh5DD1n1NFvQjXmMg+siGZyEWaYY1vIa+pRvDnuRfv8y/3hDK5/bt3HRmGPE53W5vKSejhm7eEhcJKsUWjJNuiTsq4QjTR5C6jU2nCuWRYjXmdjunQebV5kH/VjvxmJtePqQKeurqw2mYHyPw6dHJz1RETW8NqGZ/5nOLpgr3PVUXEJgPev2r/uj3fjHA5yl4G7HsbrXX71/1+yNwEV7tPRgQi8xpMJiTlF40m0DuZebB5EHf1gPJeT3hzaKHh8GcuukE7O83uv34ERjpX9XJGlWa/LdmBQseEGxd2paFsFn1e9Hl0JxAnHdvhTeiNUvVYa3uOY1oOJh3woJHHkOLxVhX25iQ/JSkmUb13wOvUW5jPKjneq7c2cDpMtxJ0AiuKcabykmIfStddjhgP9qzxl58blFwiAyPD/gX/wzx73v8K+TLSGaK4W2Q+Q+Yk+8NXmobPAPNcvM2TkOv6DFc6S3Ujx/1bfhD4M/tGjWkmMAnNidWwXybNzPPF/R2ocq1ORnk3cjIOm+l6tljHIRCGeDI6HEPqFb2cG61hRMolYGDmuZpY1AHhycqi2N544o450FF6qv43rgy9hAxQhEkwK5RWBmFAa8M4ablsZtFxrKojyIe37FENOOVsevH3KJnM88Xp17gWCgkqAxHaHjnBTNegfCZFeBBgYdqUZGgojLhIpyKGNS6zivTqxE6IgU/pDoQLzJtK4LDTe1d8LgiIndamV248fvKLIi5qOBEYBjkCrbvBTIsdlQBH1RuMKqM+NB3I17hw/OwwgMAEJVz7k8raNIWDkFAF07CmahcuFN0HS9DeWNn8I8LLg4qEvxVACTiH2h95nsXnqjMAtkNSIrPw6mowH0P6EDlbIKde89vKmc3MJnTyvB85EWVoY+TC2G7tVt3fIPeyx9CPkfoFPdM5oX+yXyTKJxN5U/0RI6/hDuRSSK6wacMZlUZeVFcUXQ2PEFqBvMhhvLhVfjFzHcFr4yHlfGkMvZDV6TrHcNCQc8nXPhB5dyLRRgBaib4hEeVH8KzuPIeEDg5G344qQThxA/PKtNwOpLTWQGgMcK/flCJOC55DDZOlVhWHuNmqMTCBcvMeMqDUUWIG5iGWZDprgVYchbomVfFLt0IHHO4nqhcnfNgyOERwV9veF75oAwlKx9ksPjKh7GYVj5AwkU4woX6gPGIcDt+mEbhuPIB3O9/kD6r4FPlQxwO33NR+RCLGygphlNrbpxwn2ZAitAZjSlvLrjYoS71cpdWBlT61tqcroDXnynI+tnaHIDifE43Wju72/8PueGtL9+8ebVmryftfr8JbveIBVe+MZxUoV+PSNjWO4uu9Q4b37mNDwP1bDX2GupuZv2+gy5sSMkVaVY9hQ7N3ByQdip5T026BphD60HeU9ccLsuQ9QKaefcK0DOfLmVq7fUGNK9YBBzi+SAPp2E6TDiNdIo1MBycnZRcj9Y7u8uselS3Kv3+6HZjTqzU7VT50E0XVVF+/+T9wymXi7cb837/zJoPchOinI6Vdy6cz9N5sbvsHSzUoF6xm4+6pIKdfXBnN/N72arI/Pg0dILgysz0bGBa6T3Hqt3Z6AZkHz96mNktHZgi5xaiU3O6t72xuelww0OZpXbtg1P0hZm+gCs41rPcWDvsCcfaVY/04oP3LLyBwNu/QV89kB5gpL3sqrVGIdLLaE9DLeAWW9RCIw8qL1pAdlADn1qZT3BowRPn6BkoCq8wDxZR7rThQwStxleeLKvvaviCQAEz+xzOq+Vj7KEbj/sjmRW9C+H8Y7az2WQCW03G0IQngGKoHp3MQtcuAHbA0KLwAkZwrd6VS1cL9Ll6iFBAJolSWACKLGpwc6n10oXQcM+k7glEhgsNo18gIcSzQPDo0oWCMRdvvAsezmR3uRsZH/E9+6wI+rRvMDIuc+oY0fhTdiALgqxfnqHjpTQsufk7tVuDKoMsw2IEckiMhy6Ga5sF6ueA9qwUS8Al9eCBcmG1HCFuJsnBxk0jJQmwdIDinIgwkqak0sLBohZc0K6PdT8LBIzSiPSYhswDZQv5CcqkAcvSUGppMDsjPmEWX1KHw0qjl8kYVjLsVRbTKguFhRGesX6MvmxRHQowiylXCNKYi1SYi0FoRj3MRwDLh/YqBIs0M+XKF4OP5aO9ydj4Wb8ee5O3XiEBdsiA5kNa4n4MsvdcOLyFIHf5sG5m+LU0+NjA8Exnuqn3bADeFuXUInkv8v9DMIaYCTDe2T8AWxtrf+3AosOMQM5wgX6/eeo0gFTUvkIXPh0k/bWDNerFb6KZf3Mso2G9cSepa79UfpR5OKor5SQapB77IFbBPjh2DrrWAYgtQLHSBhmO5ESJuVkXmhxihVLRp62ZOY12NfUCKNs4HtsRGv1ndUVzNBjLuQUkzqKzyCnLaDmRUm9RSoAFGdUVzukY3DM1bXTKbJ928UkeEYueM6uV9Nq55KTVazV2Bo96u9qNM7hqLsMfFrgp34M3ZvvB7fmc2PaD2/EcHUCTbiJfCDpkrjcG3YWu9Ptn36fIxPdpLfBDlpVeC7O6cgWkU+fiQEiACI2ZSfqPllyGw8bnOrN6I4+CbkmRs8dQpK0yt5dlC48HOHEy286ybPCprr7k6Xl6sZylBHrW/f6two76/bmVUfXTvP/kiT4t1rm48L9XRaw8nvu9RVOdX1CvWsnouRhQE821ri98C1VqdEPDOP79aGcYx9DOzSr327rd76276i768U59UWfbmDcVSWav9df6j/qPwGsm+C2WXp9zmN8SfLbghPoXmoipW0sKyBCs6Srnlji3O8o5mrJgXJdUUfzIbpBEYd1xSoQUKsq8M/fe9YMB6cc5igidJAMrucw9LCYv+JJFB+c93iw6q6W8ueCslk7oJb2hI8qbhj/YQecic9ZypdG2lAtm8oVLN75kEaf43hVJGbVHBhp4RrNWIGDlkf6QsSFNxu19Wjoi8wGEssyRnW7kXsQWLatzJVlT2uJ1gdP3lXvpynhIJh0JrgysH+JrQOXw9/CH2OT9Tam6c53bV4evD1+cnD45fvnm8NnLE+d6nlE+v4fc7tPDxodBgXOd8ll994z7jhWf8zMXT6Lku1oB+nTINtLWnFCdeRbzUxVpiS4QbiaTVc/ZO3Ch/Zk1AIG6LUsmbnxBIG1tTj9qq53Rkd70nr3Wu6X9YNCPH61R1/bsNdu2+2v9teajBySBX4/s/qPeu7VB0nv3aEAe9cFzOXBxyaM1GtctOGSOhY5F7wQGrhBRdkRdWxcunLaMiAa3CHmX3HUrgcsNiLRE7oIECTe4AAlUZ1wEVuqeBOSUkq7LOnaWP3B0FSfbsiGQwzubDB6V/er3ifxBCj8TGECJW/K6hX1lB1aREa6Anfy2hImjTtMCulHa1FKgiUL29EQib36NgiWOZCf8rs7qwFjQNbq2HIZn4CHWTcaPzB4tjNfHbLO0+qHye2S4tx42F7BdLDRs8mA0L7nHF1lNuta0mO7SIgyEwS4fYGFTPU+3afYBh9277QzW7jG3qlW6WmISzwk9NdhpD9f0fGUdULKMcUUyOSrIJgEph7VyBM6Svf1pZwU2Pd73S0VDp3cPdVC2TwDZt+qxiXg+gPcVa6U4NoV5Uql6ldjCMqWz3HMsiMu8yLnMKtP8nDnNTWM2LOPc9M/sLjO8oJK15VvlvqI02BhqB52aMHfCRRJzAetRRyYrAnlbcy4B9cgg7ISLSsyF9VGtZrClT9bmZusgfLabg7U5chF311vrkm2/ikAGJdtFMRu6q7XmwFwsxdCWoHPIXCu7OSG7IW0FbprE6Km1yORetiJBNnPClHMvEfMvuzrvK5Ee5KPnmFzbMJ1xB+SoGnmLiFnkxELJeHpoeikuPihf8JAMCuWz0DUoDHepV+6AnJPbLCwaIVSjdsjUKp2/QkPzOd3e3ti413bJpL6Nbu+w8aHf7JeIfVHiC/o+LtpL4jBGoZL7zgLh+ZjFG1dwgqROP4hdR65wK6ObwL3whnhXo3d8KeuVqIhMTYW+irUNwmQFgFH4K211KiCinEbhkMexAszn3mjEg4qKS4aVcYECjFSy7A4rbhxMoQDIl598fpKKU4cV5OdWhv75TWXoe5WhP60M/bgy9C8rwyAOlLAVJKXTm8pwWhlOvcpwOq0MZ5FfGV5O3crobFoZcb8y8sZjEJpWRlCOn01RTJq0KnwKcuzpML6s8Cl8ilA6LuDndRyg+PT8ujL2K2NRGV9VwPxzcjatTIaVyfCsMhl6lcnwojIZxpXJKKpMeBRVJuc3lYlXgbme/HBWmfiVyUVlchFWJtPKZBpXJtPLCkp7K5M4gH/w47IyER8qk1llclmZXF14lfNMLju8qHj8uuKd31Q8r+JBlz3ssje9CCse9NuLLipezCseFPWuIiW/nVb8uHIBJpujygV341nEKxde5SKcBWDhAeGvp5WLy0rg+pVgFFUCrxJchJVgGsfDCkxVcFkJJ5eV8FyJfmMt9wXhfiU6m1ai4Q9nlWgYB5VoVIlGUSXiQSXyKhEkX1SiC5j26CKsRIFXiYJpJZpWIsgN445mkOuyEsFiRdD32P3hrBK7fiV2p3ElduPLSnw2rcTDSjw8q8SoQq/k8sOLSuxVYr8S+5xPK7EfV+IwEpV4WomnUMsUaphCDcKFdPGhEkODkAJtCc4rIhpeSB2AK7gjlJD5h7PKVeQJjoBZA5bvl4QZy0tFVygtgULN47WVcq61/gMQBqzlbhpQJFFKTg7okwxAtlnGW1lsMYPAWY2/0InvrF+szQemShYNVrIroDNAYepb/R2+zKUu1L36Y8q80/7oxHef/QIr85h5N2vWDtIBAfqUWkSd1n5vTQsss4r3deLvHeTHWcb4KVmtph3fBOE09uJkpCIZe2GQ8Gv3Yupz6SgsTsKZwGcQCh4nvhe8T1KXhUkU+jzRwN31PXFDzLVt2ilUTMZhdOVGI1BlEW404QKVkY3UoSv4JIxuIBRYKDgkRbMgnoLGPr+WUgdIJP243j+pryl+UJxbGg16SwZs2Za+X63D0Sh5AuA3eeKHMU+ehNOb5CiAfh5deyL53AtGyeeoe5F8wUXypTfiyVehFyRfh8P3yYvwkicv+VUC5ExyPBXehfeBJ6/CafJqFp8nr/koTF6jbnDymuNAX/OYC/gLGU+4Gw3PE2kxk5xwkZych1fJCfhfOAH7suRE8GlygrdQ8jYYhcnbABRHk2/h5kkeu8P3s2ny5JwP309DLxDJE3lX4DPicZw8CYNLHgn9/DwKL/TvN2Hy1IsRSCZHI08kR9dTNxjBI4xE8gXA7uQZyj+TZ4EnPLRwTr4GDZbkBY8mPHmBhY9nInk1O/M9HDJAc56cuJc8ObkJhsnbYKq+vZ2OXMGTw+k0ghk5jGPo2eOZ54+wwz4X0PNg7EUXyVMe3CRP+dQPb6CbcD6TI1SDSp5Jv5nJs+AyfA9TKtW5k9f8xxmPcXYBEsJzdsGTE3w5EeE0OZmdQedPpJ5O8jZQLjiTt6nCevKt64nkKYiGkxfyLkleQdTB13zqetBIHPqXPHkDLb2JYFc+CYMAFvCpFw/Vz9fcHSWv+ZB7MA3Q1rcAaBPUBk6+iNxAJK+iUMi8OIy3AeoEw3ypD29jnnwucbPkBFbhDefJtwC6LQpRUxpEK4HWLTJHfcN7Ek0VHswurJSs790ukC3l3COThBjM79I31AlgI5m21b9NHiw2liMxy9vWxzdrJpdv8a6Z06XR8bTe4uCRjbMIP+vAQSvlROe1ZRc5P+X9DZDVkgkHZ7EXTFJmyoMVOpchdVdenjZWlbhxzC/O/JtEqQwmUkaeAJyR4BIdbeBdM2XmZWLUHU555IowU7cxoGMDKm24cdI4w19nQSiSxlkYJY2za/gLPLzU+1rSGOohJI0h/zFpDCeQOBFJY+jDLwzM2Rj6kIBuGpLGMICUIBRG0SAUKmcQCp0v+6ysFJLGEE1wkwY0xa/doUga46QBqoZJA1qGhr2soAf5PPjg4RfI5smGPOiSp5ryAvgHqblueWm3vKxbXtoZD7LEOEGe6hfIk5MGNCPLQSOqHFSPeXNNBKGAEmlDWTsw2ajSlzTSFuNzH/5ESUO1d+X5o6EbjZLGNYrWgQcLWuHmcivSPNtK6e4vyljo+A5l4l7zUR/41L3+1aDeqwy63b69dHPfzYwuakJl+1Bo12VGwCTD8i4GS/rEInrAq2RLy5ghuSLItjpnvTH1aFTQ6oajGdNg5Zz2HyASlwD3I0HuhwxEa5aJlZFsw5DGrf0ClLxyqwCS7bIAoqs5v+WTnXIVFJfp3OTr91Jk1hqeo0LX2Y3UG1LKOPjpLERtmBEfehdST8kLJqiVMwpniECjxg3oJllU8m8tV+mRgP6rkFi21AYb5EPh0VLsbSlH0JRLrvX6zbJNTFJ+xzizAtAuNUYpV+MVmACfnHPfNwVWUxBSTeO2NaBFP5M5DrARHvhcz7BPZ3RIp3QEvrvp5np7a6eo7vqRwa3HWaC1rGSwRDVmaURRUYgoOs7C1S2PWyjK4hauZukENqq7nA7WKPRRKcu2GnunzcbgkbNGLdK1SD51jRSpK6V8pda41kM5dSepoR2G/HUNwbbl25rBHEOZxXK900bxQv29LlR+CqG7P7Qbe6eot2OG9AV4GBvkmleKG8wJ9Y1MJULAhZ6kZi90liu5yPFcUXTIbhd5nmn399c+UhRoqCppHaZStIihQOjuujVkycPlVUS8UZFrcKMzqnpJht67fmx9xvYPvh8ounAwL6o3v/iaVn714uu8jjNCqmv1jFA51BWo73n9wxn8jUf4Fz5PVYzKqxhUTePLyRIIcbfe89p+FTe1GtbBWl7UnFXg0Rn1aVweTPpT2jGrjqFyOVfU0DDZrzYaa3St0ThYo7cFO6OU81Dt9548PXxzaHSoPyiMY17AZvM963evL3xduLtQ1CxoXpX7aMwASiVxckCygWW2RIHaezc+z5k7DAuq1fv9Ncx0sGaqArVyosge6vrIG20wX9Ur5J7co1uY785+Ya7VHfvB0Lawzt1g5PMzN7pXZw+SfVBAXJojsBF22IEtaAgqRwdrFP/14zUMXao0G7sHyzYhDDaLQ11i1TBEafTy5vtrugNrB9Dmx7aTgYaDPKTKI3wDKeDa2tza3VkhsUBNUWZKtW54XAlCKdwCh79Aj/9eZ63r/KLG6g9o8//z6DMbiPQBKFgFpczDvDu0+zASy5idkhG2WscsN8/8Yuq7gjdW8nFvDUlYf24099AUkUnQEbKcEcKnDwWAOL2dg7wWgXgRL1g0D0F84HZzbjfwF/4hty26Prd7b0Sl3xeD7EPXdsxc63PStdH0B1RJSdfuYX7yyP4u6TXqSwt2idKe9O4veDRwxtweje+SPHoF0WG+uH+3THB1BTNWgguUie2vYH9XnH5/DfE40NCr9MUANPrS9ftsIZ9lZvwszWh9Vsz4Wb7GwXIrGutdo9EADYUH1ooLo6CL2ev3k4OBjWq4XdDzJV0M0t8P7Eqd9N5VBr13/X4gU/r9dflW7/eDLnmUDdDaf9h7yBqDrprq3sPGoPvwwMorr0Szsxvr/nTacuLGqvb7V/WqVY9W5dlHe6YDa1WeO6pY/J6b8dpS3akH1opi/f6jexc8m/losKOKNnA34F4oUvGL9okFdQ5hXLlaHUHMi6hIHpQYOgVSbeas0G5MfRoM6JD1ms3mLA0rMNThaKTEPCQYAEHpig41lfnrQ0A6yxDFDBG9gSs7O7Yz1LrY3tlzlMc1dnALqooVpaqIpgsBi+yd9b2dDYLSMgg180Q68wQzi1hjouq98IqflWmVDGoF78DAcm9wiqotOuHiaSEHJkXeJR+hSzAQKqARx5JvabkL7xrGVW1RCBaXdkDIjqF4LVCtqug3MqsM14B9RyPp9JecP21iJF88cYOVeez2wQNJUMtRo2TkHgMu71ucczXtm2Q4ODXnF6HNSddz4h5v6nYHSRLO417Q/FyKt17z8SDfKxmI7CM7NadQJzQ5YB5ugpn2oS/tzXRECzrU6RMujEgXUGlMp+UfpdFTTMfln58qEWEY0XMjxyu9SsdjOmLFSBudRYSqwm0VmcUIhpE6PYsweSRxLnCOHpEO+POuMjaq1aBoSAMyh68eQz+/01rN9pinWTBTOwIXquMwsiFTzHxbEHrBfDsidMJancm+p0Nm1esT2dAl83oTdBhetd3e5SBJglotwB8XtdoF/ohrtbh3OSCyxA0DI5lLgq6cZrYAhV7DmROEblO7BKDPbmt7cys175Fe9Qqh02hAQ1k1WFzHbPdR2Agabeozu72/H5NGm86Yf3DQpkPW2KFTFnXDRttp0TGLuo2206bnjPdEfSo9K03rbExddl6D0o0hFD8/OGCNIR3WWdwZHrQ6Llvf2n7k1mUpiiWGDbYrJ89jrlHWTcsGWNbDsl5JWW9st9BRt8vajZkMdOGNbZcx5qeRPLsv3ZdOe631yD7HvpOOV2foJ3kaXtnrNCDUbbCZmkKd6ZH3yMjjNgIyp6KJigwlk0ldOZ0egu8Z233kNsIGTB8MawajmrIhTOiYrWNErq5Re2N9kzTM950d4rToOQNn/26jTUcs6LadRpteMLHfShIYtKjV2mtiv9VtOy2cRCEH5Z7FsAnRFNMWYEnFGGuvtbp2zHQilKEeGxLH9pjhMhp/QvgrQdbw99cv1wkVj2zfnLCGR8h+G05Co0H9R2ydUFvUmVefHrB2d7zmO2Nz7tqNKSGP/AO2DkXqdeqvQRHMPoReqa6o8nbMbPHIb7SJWQncd3U2JY4dM2F+mJZkZC3wa3zAdju8F9XPB2x9a6sW0/M6G9F4DXYTDY295+3vh0lMZ3UWdmYHLbOQJwt5stAMC+HnxmiQsPb67qML4L1ubOxtFFzZLZB2ZPFW5QztHzMIJs3lOiYHFyOyo4vRNFchlIkBATHMa1aboTzKeDl314P7hDtf5QJame4BY5nheS5DaGTwZYYvchncQhO1WnUm832Xy+dl+ap2lSdJlfemAyNDXJJhbGbwSzKcmxlmhcEkiZ/rwrCkgtGAzIHAjAgVdigfro7E8kzwCIhZ5uHrc37DRyyWn8CikY+Yj2+HcRwOPRcwMDbDlONoxCM+YkMaNWW5gEZNXSqkUfOEC+bi5pgy6xe/OD199uLF2zeHj78+On325ug1/jj9xS8sOi5+fn7066On8tv5QtGXT49+pb+Oil+PXz89eq2/XrDUsH3Ctugla+/vT+gNu2y0wXYMbJxUxBzwA3ZkvJg+Xk25AGZg4G++4JLV9CFdNePEnNjkNuchFQ8BE0nSSu/aSEKkC/fablEdQaohiIokgwcLbuGQtTrhftQJ63US9MIB472wLgZZoEHTn2raaxl/DT2Kgw4NdhR+MN48PZUhHLh9jMcNkrNKnsjOArah8P4U2xDyioiYODg4kDFBrHoEvtSTZHN9b3Nve2d9b4sxFumr66X7siNYlF7u+60udLIuzACjx1kQ21aW+oN2nyw/4TXJk0SOq8pYVKvxfdaA4GN2OliRzyAOmBkA6JkcmurMG2zAXLWXJd+F8f1NvkvGHHcjh++3usaCijonTtavrgpscOEFtqBcomSvWIu+Zm36gq3TpyyTHacCLolj1mry2ZTLFkb0azgA+s2ij9nTJPk6272fwz7AWLsBvxYm4PyQBfqTGKPsvXDaahQ9QaNsa3UDtblDJ9DnJKSjMJBnx9h6b9M1VNnk0FXelhGE4EczjPa3OUD2jXnqarWSCeE4okJMbslv/DbveVioMHXFONwyM5yHp+BS+CmE2ep9PZDBXxYbzPxGZvV8WeimOiZGJ4vBjcz4qTK8Du963CaOvLxAYM9/tIkz47kOP19SrClChL2yENYR5yviTfDDcRSI6EYmxPmav1jZIVUZz4pDzQrIywQ/X993WX32veqTFRDssMC0+efGva81GNiCz1Kr90zt+4E1p583nx/9+oS9op83vzn8+u3RCXtNP28evXzz+tnRCXtBzTq9IJ7yoWCFdlB6vNiOjA6eaVLMzbp6jwdLSsypsL+Ce/arZjguyfOVnZLhZE6/yneF/7i01q/uNTmy06enabetE/5j5dai1twqNDd0h+f8tfQjv1CPDCZ+inlqNVWpujTeBpgMYbKMXDLYeLbCTRHKC0yFNserx8ivjoj8mu9Z2lIhZJ0a4lRGlacAyDBqbVnZMCovPC4WFvZz+hWhz3PTqw/XijX+Akp9Ub7GX+TW+Itc1dkhWlr5F7/FUvcsag2sQqsfM6HtJWXvNaFtnNDvYGq+K5+a73JT811h+4vVJ8CL4bvL6VcK9XxOv0J08zv6VYqCfoGo56/pL+mvKOdFRPHk6JeIJBoECk8vy1PUwWHc2LGLoDzi2S1iuk/mpCMrkR5hdS2n8JEJ8xAsVBkYXfA0al7WC4gImUfWwmJRWChO8wlP8ID2jKAALi8hGTg3iQ6A3noBfg2BQxApFdzuDUzn/7ExHZIU9GJ8AshXJTgp3kbf6K9h2dcf9deg7OuCy12OeXFdFNaBnClBMkfPqScd2zq6hkuAjyR+XQmjip7yiqwYvGv33tPK5aDCAxF5PKaQ6z3sOJXFgcD3GbJhkHLGbAy5Dnj22/REenhc2uCs2GCSLM5PrZZO0O+oP/efkaGx0xBxyjZFYRcUll0tZVbTlBdxV67uEhhUKIOIwgeXhZqYagMnpePtM7fj1euyXMzCXtR1G57jSRYmoL7CjnvtAQ26ca81cDzK04ibXj0NwOTpH3zxNrTzoUbHd3W2tKOqepiDz20zBoVktRj9TjtXP3C7b23ifLAFDboce99oUw5RfSBSxkKPw2hJl895DrCL7ohjjC3LoreW5XAIkpNH+kbGIFWpPAgQgPUhGh7QiH5hCxW/K+/oXxXF2iIaoCslQpwJL5R//nHljU14YW7CBSgFuDDWe8ExpooXCxub56T7PP/thTsF5DWrecLztICdZ1kxdUVoqhTJcSODMZmXPKW7uSRkeRXIFFFlmgapSsAG0DpNai+hWxRX4nhcSkYJ/RXbs9MAWMdjmxDGmC2YMFLu2xe126pGh6pZh/iPM9ePk6Tko0g/VnU+W5jXzE1hdvK98JBLnBL+muORJgiVID+kCbkip6egeZsrpJP0RyMxSeDGrzIWY9O+fPHxZShfhjACc5VMZkyL6U5kI5GsFeQyQnaoRp78gEnayeOxTYzYeZc8ujEOA06QBDYBksk2kWuoi4S12iW3QwB0wM+0oyTB99ZAutYntVpaEOj2uaxM9r7ATyJmkhpJ6TY06IxaLfdqEynuCJkavMt4hwOuxNy55B9WQV4jDIZVNlqhRGJRt4oB6m1BnLBbvUSgBZI2O6BnhDiQkr3TdE2g9jattuckndKp3iGMsXG2986yvVdFZLeS+eGsnGU3BYBtlRcmTOJgKr6cgcwZPKH2WssxWUaEYrrOik3+Ulf/y84v8ZPBSrkyesZJMa6Fybk64pp1VTqKo/wo0twwjituIzeNWk/cIAhFJRZ8WnEr6MmwcnZTgbA1HFhzLZqNDahDkNkQGmUjjrptJ5XwRISKfQCZETDxFMqK+m4pAsuDkUadT6FVFhnzaEwc/hxyz7dt0eBkLWq0CcQUXpzNX+lh/qrzq+JsXgPGKyfRwIoOIZ6pOxSmGvgpz7F3T/Kvh/hqUKWAWcNWFrbg9AtChSnhmHBRTllhx2Fjc9I1yJPeE0VwkQF4Y8jVtYzQyzjORkUYXztFPySTmQXIZdZYEbejnugGjdAJBzTEuU/3SVhvp3BlSTcWaMZ79GEpCqTbOggUvsNpSFX36nUnrNclwkOFHXH6nNDoXrNsco4XZ1witz2OU52r8NzN6zzmiHJZDPIYCgEYrST6lOWS1dGAZRQlBRifTpzLWh13n4UdVyO5Hgt6ohs2XMcddMzlBA23/EK6GYLrLungyoW8V+9WYra5zqa9qh+E6Up7FHou19fsYW+kDlaABysonV2N7xbGkAsKBF3PXU1ZcQnQ5Ygf2HkqHWQ1iIJ8Y0eE6HULO1U7ZJG6SuVdWqtV0XGoHaogkUG9LtehQzJJTvkIwui3G0IYrRwD7o9vsuCbapXeEqU3dcfqiXSkGWoCI+4KXDocpxyzPp8hLlb4+7dY6QFKd2UqOQlZCW9EHSBNjXUMABj23AF19VqlZwab6FTtiAW5Ze7oHR0p1AuovZ47YB5VFXpGbehcxTh84e//6hemIyidjpWAGEZ0kJ7vhT0AvcKpSLvWQaGl2gN6tBJ+Bz0Tbp/hvjjj9+N/5i73rvWaT7krKr2B5aS/K1bdwMLqln6HEnVLxRwBMcLZJ97IWK8jChXoGONlF8QlNxFDjMp3xovqE8uhLfRcL40WkGJfHIV9mpW/RAq/8Qyjfhcbivglj2K+lN1aGBH6Ob7XgLotp9EulE9D0N+3jnSwi3XdfWu2OtG+MVs5hMZsJ8of6ijDaKIlba64CGlwD+wl2I/kdtWQMVJ9Ic5bWx6DXLuSFC6bMp4nQrrFKUzrvUGeDRX2EZ6uo088XYjsy8Mlf6ZnC1H2utVsNnUKD0Z1G267DHPvWkApZEX41LHARBJP39Ennj7ZcooPPzIqF4VaS4+khFw2bxjVkbWslvQ+O2CtWk1ke6pWE4yZWmeCFNorO8a5Y5vWpY6vzRmc0yyZ2oLBAc5SyD6TrO4jbrdoizjqN+YAAtcgmwhNU8VCKg6OkIUpWjyfcoZyE4QA/mFWD2Ot9BiYcwekOc6bcRbTkzZPY9vTo4+AE1iR6ifu6qNPQKehI412evNBZwEryO2p6FHgGO8awY4kgm1gB9RdQKY7YZ2JbiNwAuNmP/pYtPpTe3kHoh12UvJNdZK6B1GKZwNq4kk4dPQJcOiIm/1DXpH8WatlBD2m8mBUq2VD01n5FOCVOs8AtK65VF075fSaw48T/eNQ/rjmShaIOVIpIGZD2eAhR8znPS/Tr8Ej7F3M/Fqtsa6PNLzbmToTXSfdNN0pW7Dtra2NrZrNE9YCgkC9CnhNL5RHQd22+cHBQXubPArq0SNbyJf9/fY2aFORpGXonz3J8ZUhZ63d2tnY2Wzvrm8mG+vr7fX1rc2dds3gRR9zmxubM0mUIobem627WcWyaIEfnFWUq6mKzECd1O5IWKHr7Bj6Y8iwhY7xKnSLS23erDJ1ayccsWrQJ0O20DuWLcIjTjr8IHvvkOgd42tGDj3TT7gdkbmhK2+wi1PNnIPPefcHlDI901Kx0okBVu+TcMSz8jpFYrqZrC1r4+XKGvWVq3ND+81My4QsRqf9BhZC+qSz6qJuVYaSDXfGMbAeRKQ1WFM/GALBHzkEDl5gdCBrDsdN38JaoHLgW85a9EfObueQWq9TLMwEoYYcBQul8FSwFpXIlp5WxLUE22g/EnXeBAcZMFWHwo5I0jIWyORMvsw6DJP21OTbg0K45N1yQjJ6ImU9yyy895gP8p+rr+RJyGVrThUn5ll8FMwuJPVbq5WnL9Zp1vWa53oE202wev1rTrNTWvsa5vVrDkDhKScveDOWdzRJtfzTKt8gf4DBD6h4YQ+8DIMGOt6NvUwcG1fciGNkYtf3wys+qrgxCGXjpoX77xUnpdYuNqePOb3l6VhVRAeMZ67fwUxA/9ZUjux4xez4x01qUfxl3DBl2cmSWpbowty/4qY7nfo38p4x9VGWbwUmCouGQwnCEQdW8eKCvZWBKEWIzindSgALmNZY0c1UwkAtJqyZaskwhYFj8SbVlvfio3QP0Fe5eQCzmnyw4cKa386p9QuLwvGutjLDm1RyN7eNuEKv9U2CK6lHedAi0s+rbYz8FuOHtp0UQM4C78cZf/a0gx/2sg86ZpUKBF2rLSSlZec48BecPi29tVU8qQ7ACvsFR8UUlSbZHHDo6GPOrNNT7+JihhsZYOXpqdVZoWVrP+ZM/rYfcyKr+pyz9jb9wMGWgmZAMpurb3CuQBJTxWstFZNMeQTRKHFvVlwlsPHEecUNKp4Mb8YrgObl4PcDXqKZGYJA+lsurRCG0goB0ppQ34uZwMiIsSkc06hJAHfRN9yOFFFR6sTY0GgWCKQiKnl1pgT22zKVoa84mhk84PSUE/qAF5S+FPkgRfjpQaMZWrRyEHyBps+uGbYuhX/19kGqULV4Cl94MXhekkor6On4Pb8BVRWIBkU6EiLDbyp6UR00JhD1fcA/UQPvhTvNlC0f3J+qPY3CUHSzn5Dd1tI0CtkdUagwXlahK1KFPLJY5lmwohsz9Dv5DL6c0RLuhVicHRnQv4wwyPpxViwkrTOeBWXFih0p6cbZYjdkgYJVW1oALtZ096nN0pVkBnHyDfb4AIuW1v8sWGwhSewIRdZysTTPfKJGfxQBmgVZU8qMMXbWlbmdoNAOuri+kxsDvxThdxpeBTx69rRrp59Zi2Y7iaGbXJUXQKDqp05yfYHmPFWZQhCsFDp1waNJ2aU7VmNUNZoX6WJ58PfATPhUqETQBTDRJqU1mauQwrmSwp3SHSUojJDai1uvFF3HFrvqqdAGgJdO1MsEl4N5eU+fcj5dMW/n4o45g/IL83bneHX1I2ELQqOlNf/BzyO02jV+f8R8xmEkysDFj8IeRpphUFbo8U05wDMKipKiuTuphCWGk+HGLyRukYm+OMy5aF658aE8WTbpgv4KD+JZxI/hsNr5kyshUKH5tOblt44++CYwMFuBa/CELNT77OLirprNWhbmJR3Y8vIKphRK3qVIDv3ly++uZcy9cgZ8BgBrtex3s0SLKGu/XqfcFqAcJUAjKiJzQgWhwUJHjPkp25Jcw+rCGvFuIDIobcBoyk0ATZzCBkm1YTJg3cYUOUVe/MKdsm8ll+tLTr9a0Hl/cfhKGkY+58wcSobDfqH0iLCdQrNK8YwZbIDvUmWisgJnnrhwp1pvBwiFmEVZ4V+vLDwMZ4FYWvaXK8u+5zdfwg0n8h03yv/qY8vfmKW5yJU+hTnUmU+1OC3KFJmG7xmXK1yrRcJWvw2UWojcCUDbPNh6ApV302xRLtstzIrDKbLBnRY9PZ1G/BKctqQFAlFUPs6bWz/PNLVDZWBAQ4UvCBoaWy+iodqWLKBhfguGWYNwFaQ63jxJ7C85C4TdMukHN5096FJAQ2BR6DlR6tDX9hXYvl/bR8jACJiXThvlWbdoihrDIDE4S9VTKgb6EHZCpahYt135qRsh5gWuEZwWmWtyHlPNYm1lfwuHwmizh5aRg5xGdYp8yaZYSLlGuygvIFw8j21x4gQAEEIaKKQrnSlPmF4ZqEdjwweqxkXtfAbHxcFxxz61Y0JPbY9QGMSvIGNAwVV23mwj51m1oLP8K2DSFtJ+aTCafWH64MBl1GeHscDUIvmlal7Z/tFwIBFkl3rMlhqBaVkn/XVwcBCR2g2NdZ7ACVSaKd/4Dipv7+97CXj8oB5jLO72dOfqE+zewLFdZsxESKi3H3d7nLoDp+dSnpuWmXFyYB9L3sJJplES6ro4PeZ2RGgvosGAKAFRSqAaelii5w46IQv1uvFs93pw2D087KkmXdqVodGVrPUWtES9vIE4+J/wGTg8STsQ7886MTiq2N9nbdmVIRO9eNBJWXfDWi2uyijIYcJ86vXcen3Ahmln1BxzGoJMKO3YNLf6mdFFvluX2K0ONBRhT6KDgwPWJl4vHrB2LeoKbE4b7KgmvV4wYCHuXLwn3Ho71/Y4BSLZlPQGavajhdmPWQTqOz4L7Jh0PDsmSWL7zC9YEWQn4ZzLQE6EhtJJlZ/OxoVsOjR6cy4KhCayy1L0tlbzpAVDmgI6ykrPv8tNA4WReRwNFWft9wftmXM0AVSeaWwVPiJK3ZHTo90HdQxdCxqSrnBC48q4KA4FDp4dsagpwzmVzlamaw9TlhLWTpaeJAaYTBLQCYjSfPdh/ARMdPPWHikADHJMijz+ddaNHCHNQZCL5ZhzSm4l4weDdmvvCnrvgPpr1AsHKZ8MK3By4NCOei3zgp4s3LfYB+oyYWhFuVIrSgtiu5HDacwC20uXJmaMeV3uxPMrbodJIi0WuADv4peu742AffUKIvbLxfWZutrojIXdM0eKSnxQcx8CB2JG80s/ZIzNutwZyrtCMW9snzh2KHmMnODM+HRoDO8yd1cwmzN7d2t3b29jc2uvZvMG4yie3Nxob29t7WxvEVIHSed6Lc2lUjZJbX1rfXtzq72xRXkdyu3S9vpOzZYv7W2j1ZuFSQ263Dk0jPzDnhgAhpKVOVsooyVTdbRhCGo1UQduUJhe+LIO3sngWAbDpCMehGPxfghQjMSot2+7AMUi6rFGmzj4wntxPbPLcg0d/Tzek/aogT2KpCpJemly5SOPF24c2aGAKPAPNm0BmrR5SqLnsjahYc+Dnnj1TI82nD9HNi3gHM9572LAnuuFp+mvZwF7nrHl6BerOJfFG8nEtVXvUj1Kd99DYAxSE25HFDQh4eiQdAXdQa9teDLJN13K1dNIT9aHmIV43Px8X2asRYfM132Z7Q9rNTAGCajfm2E3OrN6nShPNbP9IazHtIsf2wPYJE5sqlfCZ8SrbDBTmpJa7dR2Ca3GEq75qUIkyB+ntVo1rtV04gE7SuXDiGP4eHti22OQdqdEo0Y3z9m46zuHtp9Zp3Tj7owxNmy0u+dymzjnvdmA5V56AQ0Hzrm8vfCF0HHXNmeGnSten8Zy6TmZz+l3d696QYaMGJC+Z9r7+7atvYmAbgMntRvYrwZR2MkuF9uthaSr9FeQ0OtdCkhstMlAinzrE9VuoW93bIu0kxHalhxzO1CdVPik6EYOYCMCcEyfIfI4M7tJh6xVZfas5kvd6GGtFppUAu4GuW0uBWRrtAkdyxpwKPScDbvj3nSQekEBSuaccirqE6OvUDsoxJwX9xk0OarVxun2uU63D6JfYzqjMR1hBbCtR7UaqK/o/LVaLOxxr/1ump01+aoKjGq1djH/KM06wsFdlO7LSzbsjrozZ/bOd2aJT6/w/UbYY4jjQC+IcyV/XxDnLEvVK3/RtY2JZpcmoX9lbEvEOy/pFZnTX/92+3JhT+otKTddmMHsrlvYd05QaP13vPMk2JqZG2fIZoCke2PbB1FfyY7zhD0s3UigRLVYYMwyvgoufgqfGo3x/mm6rZDcmNExjSVpXBnX61jBeek2GLEbwC9iOqXn6eKed+2sMTY213ZkrC1i9mMI+kF/+T/upvnl72hhcRHxKtcKr4qK1U3HSsET7w68MvyMzUkjKoF0RmL+j7nGYglBhib1LtllxpCo32u/mw3+n3Bv/XKhb+dkTn91937KyKDI4PPBPHaN1/bACQrV3bVHcpgILlC+ejUftt8N9aSlbcXE3CSxFA/Cujh+t2SWu3auOAuNiVlcNDmbjr2w93Afq8+EFJAvzW7/ZVniSgVbvXMD1qKhYd/XCcBELViwLwxBDXWQ2UoXrnvd5q8/uiMSpC7vhuI6Zn1AMk1pYrlN06Qo61xhV6z0rMONdUJ1Vi7o54Ryw9+l9NBmyFL0GLS3JMhEBTO4yh2hrIzQfAdHSUMmpGJ0vQ6DCFSbt8rsPKV3kOWsv861Oleg1wwLhPsM7ZpUWjpxpKQSj8e9HAO8G6G1aFa3rg0Xw6hLTb76UFoJLgd2yVU9Njug0zq5yWGRsF0g7HWY6nn+s/G7KVnnmsEDBhgI2I4Eu1zbpNfwWKen+JbJSk5EJgV8rUj7gqItXrSHkC/jK8ttGdpoMhjl7HhggYKucOxv8DwGB61aLdi/7L4CHZCATqgMdcuvKsfCjjLHWoQ4YhUPBXkHJ94HrLVM8SfHuspYIqj4Y1Dih6JE8+e9QM2fE0FPOKEnotzdE8x2zuPTifhE1RrwypF5tzoRK+C89mmRGoYQtEPghh2CEhs+1sCwrm1ZI2/iZQyTqFaLmtLqmtduBpleXr4DS3VxXuTkmbkyyxVnTDsXhbtivzEaG3G4qQrSaMuP8iKVyVMwPbE5bWvJcq5dL4h5tEoXKS3fWuz070Q9xZxp9TJ0p+7QEzep6orPL7nPJqYii/wpXM//aJ2W18IujgTQDLbgYCdTexCL5naYsOy4ReT2czg+6PdTcwI6GRez1QkyLWm4eyI8b6IeUN4LlAVkvodhme7I52pHtWijXSyhIvatGNa9hiJwKII2FsdRpu2t4AbvRWWjWOhRYRwLg1imbPRhubLRYvllykYf7lA2WqxpiQrPh3IVnvLyH6/C82FBhacIbwCslwGPbH/whVKfalmq65R2pMqqtFj7HTZNLRqwN3r2yV0G5YFhS84YeylSA6UIbKfJfGnzSzFChQYafbDhOibgqeelSK3zoyV2+Sfid6MC8krYJvyjefhnQj9TQSSDfffTFZGz48VwabJDIQ2gRFEv5OtnJ2+kYsgTwcwBZsjOsTAUQ3LeEXWDYv5EADIA0PaJAP7yE6H5y0+EUjl9rnRPISVV/kzzyQz6N+RRpNZzTXRlaTKv/g3pWifweaoemKYqLbfnOaU3+JrX7npekERBjkwB67mhjSW/ZCpUz3MaVVhzph/13NSWoseLl/9jPg6jRaVVpZMguu39feGkVyvOvobIRS5PwJCtVLtBbPRgeQmFRfYGlCsGGXBSAAGFkuKglYqt0xp6ki6yQ+bVal6u79DlxgSgAUiuajXX7NhcEVPhQm9j9rVGjCSD1SUZ86PV8feDjg+yFtW+P2B5KXFYq9lZ51hIaDwvm+DDseDRsvm19QQTFYzi7kkGMrLRziY6PLijlDmlrjmlkraxA+bWaq7Z29yMupLvvNBGo52bZ7lgxpSqj54qpDC6EDwigbTfSzvBAkI9SfT8IOgzQV+KnG3DG2HCcJ5CrYBxA2iF7K2QoikugVQnC5uQKe9IoNYyrEy8EpGz6MZSH9RXH3NxFDI+i4cBSHD2cDAOykrxJ/WZdxB1W07U8OiMBQ1P92d2cFmr2TN2SWjen4UPgtHUfk+oyBai22jMHL9e1+UxpA0fzHOhG7LIKTH1mdGNGXNVN9yDg5AOWbtuB/g7E8hih4aFDqFrkQ52LNYoXCwJTY63VUZVxogLw0mbmcw7NYQIhzB0ZvV6J2YesJR9MHYIGxPq1u1ofz8kEHk5G88rUc7WyuuPPclucI3TNzjNkHr8neL0gqYIfURTtXSqkfmQmveXS1Ok3qMFncc46+drQ+HsB5Ek9g+CIbncohOTdH0hjFNvCyZjG5CqYREqD2mqryD2U8PUlQoKEMngc3k8EANuwabFBCAB2jIRNzDYdNazw9PRYmB5n4daR5C6Uu8t8znwFpXf9DySbsCeCjsoKMLBYrnECeFbmPuWnjmZhWo1uILimlyPkKoO3Utz7ZWw0/FQExSkbaJam6Ey9lSURhpiqNeFwhBeq8X7PAfn8G7wtT1oTmwv3Qso0Dozjl0vhsBzT5XyQ2OiGiyqQNg2QkzcDWlBNgRlI5XTN6tEaMMVCzUtmbm7C2s1VN4ogOmuTpD0uZfVl1ep+jqvCAq2cVIvgKccX+6oqxsWUMF1BOvE6Q1yQSoei8w90MImyva2hNSQab+9v68Xrj6R8CeF+Lg1A6a/d6JaLThodUjENGcE5GpB7WZAgwabZA5Usg59nmlApL41a8o+nprROuwIkrIDkikOoRaePCtq17m5K8hjYV3Q2PQ06DrRfqvr1iMnrEcwTi9bJze3j7wDFmfzgjwO2xTHpJt6lk7IEDQw6sP9VofMmFqYWa02y61/t6cO0GwAaxQQiAcGYnK/ziakM8RYUmxIQ/gTwx+3zoZZy1NYPBcky28F6K+ND6C0X5+sbjRtDZrRIjwFbUZsvD/t4g6JQXVlfDDtZihhQBw8c+e12vgA1Nf23VrtPFd9tjsu2AzOwQxaumR+5/Jg0rlsMBUh7oxNDw4OLms3nQt2oTbK2QAKZG8g2ddvkHtSuxmwc7jMYmjYHkEIu1EOOwpoi8aEUO+AjYnXYGMawx9gFc0kWyhXSGGpUMrLjJe9gzBJxvtTORZYypliqF8x7+DgwJfY3VWVsbF8lY66OldwWdcxHppPHl0R6jewYbUCvavBfFareQchYhmzYid86jWGhNBZrTben+by6NH5dIxZcG80YFs0DIXMMpXjuOHR9FQwz4TGLE7hMfNTzeRZCulH94P0KiAcyGuzMDILepiB0sNU2lKpPl2s5Sw+iwA2z1gIssEZ9v7ARc0l+UKoZ/ugoAnTcreCZiBFhbN0dtyDLNIRy3jfLiFUaTcGxgje5pTa9i+7LYcjbj/Z359k2X4UJea43wIf9hsh7XC//QO2w/3G7JGyCR7m3HI/uEP9/0cz3FrREKArJ9Fpgbo/qGigaYDvxatNAww7YQM1ewao2TPBHggbrcUAbTPH8mXRJkCCdVDB8RiXzdKYSb0MQaivNir4Xe6kuvuo0OAbIF3uLXZZq+mf649c/NW1AwaEbIlW64JPUOmGBFSkBaq3mjF3lu5PcMA+J6Q59r2pnXoMN/GyWs0OsjdmTqmRixAHqEOtoikIDZnEMBTb30txCtgomi9KMrmbn1K6Hk5fTEDRPJ2kgLk0ZLo02jaostCsRF49tVl1PvmuMndWAKVAZpRLKTFK3EHhIsQhzgNAak216q9MphPy9VITqFnMnxfjaRRDYjwXNi8rXJr5i4/J/N3HZP61ISe8iczYVFlhsyQVuGlKmM18TkWZuz5tXa8+GU4tjLaW1alLgWREQAM5h7bCyKhdp6GTM1HiSc3MfO7qfGZ8obOIilUuPg31WOSbLIZZKAeTyLRFMIl63sgpFysdZ2paEP23ZSq5WRE7Z6u9JBBDYKh1V7mh1y2046Rea9DJfqap4Hk9/clEerEvidSA3XzdfeW8lkMzLOEKWsW5HRbk9lWwfGn1agV5GWoWWpdlntN15dLQKHRUfLWIujRAYUOwaoWNKu+zwkAemWsc2Glz8iMSdXNCw1zDYbSy5dzUvqDhHescMtdY5zDn1DTsKDV+uZo0ZmDMk8pv7IDGNO0ymPjQmHLsLiG5uHm/yrHYcssYpYAiyi1otMJ3J59TjmceqKmFwy+36K+F0YhYVZm6xmDnAcqS2ySmMpXcJaDd2Gg3pMZulN91UXHXqbyYczVIycGfqAhXojLRz6fCk0pmM0Krsm9lcq283kQOdshS2fJKjq6Ji+VPKhhf5WcqyEwXikcPonqf1WrVarqzQnX2wo85wLKWwvntuk4I52nVIc44NzitiOrfMbUulXiIcXwhKdWNrNdpaHMadF0nbqCtFwFeVTyn4cpTbXSlcKpdYvZr8WRr7qqcHm/RQCd18Vw0sfHBXG/IfNAjNYc0pDMjXtAHO6RBd+bA0IYApPDEGwiyiPKg+wG3SakrgdIpxYEHqRFothFcgC+tcouoeltSETQw5VWmzWlU6FRsI96HQUi6PwK9A93M97O8g8g1Dhc6CGaqvNRfhI2xI3oDIgm6oNvzwDbTJZTLXqvYIJfmwQkLGHgGMEYwEtcWBbIpiBZNg1DkbXozW8aWUuHHIxY6yKKiP6BRXVjQ73LZM7Stox57CWsid1WVuUniVZmnM0NXdKDOvM9uba+lpQlew+34jIFrqpj5+61uy/Hl11kejsyU5xc0gY0dTQjHOsINrQa1miuJxRi1oexZHmQYMA355EpKTpTr27irYHzdRW8dc0Jn98LopNq2Dn8T5zxIRvfy6C60LaOHZq7VFqhE3wOkI1WYJFXbZ169vu+mizWr16nCHAHyRM4MYk+QWm0GdCUck9mczlaCH41GIiVaq0X3c8MuMqtMVXAB86RykHfBLxxQh4QG9KrX/YN0et/aRAmxwoJv/kBG03/d5RgyzG+0Kby/0h6JTExVIiuz7BCF0Uqs8yPwPwyCdp/VT82kvLsXPTQs5XP4YkxqtXrdq9UCJa+DRfY+AnP8mD6HkdnpBZyTxhDuaJV3/WrJMpr3FM/dU7yjwZm8p3yGAZ1mLDTsHdLZmFGfuiBKYuwFboEAeXucOHYMMra3NllAUd3VOMzHoAxSo/c+6w4FlbJANXcilmMaMzV7cOZjlsM4ZpmzTt9AOXyFchDqfwTC8TEDCSNzJIuoShGeLaE4qUuHnVGoFFvjXMgJkp3sMD3ZIQUoFy492cpcRKV1XDaVyM0UPsN94zMDv4E7ncyvzj2fG4YdYbqJAPsYanhhoDpeZBI3Cqfo8QEYUw9dsRjPL8NZMG50hLxcSAa916iLwUYhcvRCDD/u9PhAYgz3tljXl1JYUN1AnKBtftDRGoHEg4WX7pWjWi22XRX9rVbz7eyKkfHLZjpkakZpRl3gbufCd/s211zvLBg2oZgw9l0heGBXW4QoFyPNiI9mQ15kXeacsuoZF4soTnpV8Xo0nxPaIiaAj38XAB5kANV2J6ckMUt9UMDhWQrAUxSkKpJkti8IuDngpItV1NvEQdMMyBp1Qwe8lQ8JIkfVFljiSoaAPuggiyfU/VQGQSCBdW9wj/vY7aS6tiZrqI0i3Iw9NEsPHHq3YeyFlAIBZ5aCEqKtLST2IZBg1bNn6ZaKNLiu1xFgd2KJL4Nkn82KHZesXJcp1XDTxqEA3f3CkufQ7AxPzZ9ThIZ6SopUCJEM8HTnZm3NohXMDoPFUautP+LaBX60atsZl5L05nv3RRFkm8xNEkQEI1ug6/sQQiCqFK5S5oQGuIuilbso6wgt7KPXUN67OziIXQ2TxHu4Dhs6Yz1JGN8NHe/heveDHVEPNWAd/VMzoBRnyWA9DNOFBSmMYFeRFq0rQNzCnpYvsMimqRdQQUPw4NKFeQoAWRAo5EgtUfTQXHS5t5y30tsY0Ki3MYCAor31QSPqrWNFqa+Nbh6ouT0xUAeCrc+dxY8MrjNYoe5zNJaT4Vxd4nxnu6bLmshQA0pnw/TJcPcsCKpHj10ugcLZUMeQFW/aCI42uPowwkwGtVoAYiKDFX9nXTTStWSjGhdOLlo0kpxVUa0WKbJWyi6jJImqLAKX/gdGaOXzSMlHy/E7KdORV1mwQvalL9bmhRfYOV7SCq1spcBsHBcUdrVKgmtlWtp60y/qaod3IXI8A/fB8qEAACD0gR12DUmJwyXhoG+4lThbpD/H6CLWXTFtcoxQdcyiZhxe8CUZYRJQ+B1nkfFgCpToB9aYRisawlmDChYwtVGUdyKMwY9Fwe2NqWV0EWkX4VUd5Djvub4ssHjvOa18M6iI2dTnMmJ4VuEkMroKTrWV8PF9TrZ9aeZCE6lAnvrQMXyu3Ji58sJvGws9V6DC+Y4YsvCsgrOoaIoGG8rE8bt2ebpNaNHUCbOkPh+J85VpUZWVlJcoZsk6cpVfF34gum2H7wtwY2ccYHQzrNj7D7Iw70gPfXn3umRx3qMKqrI0fO+9WqEO1AjVk/lidPdr80KnhkNZZbZpBocJMs5qaQzaIBd/EKrAfAAJdYBgT2syQJQDGOb7yJbSIDnfnrosAAQE7gWXKguQmXnwa8TH7swXGFEjZnwu1wdk1A84XBgYtzJdnILaxGlkgETjWLCABnNh/yjoA07oj/e1hPzxUy0hj6MRj/go52v8x9X2kGbovwt3qiJqdIrqDpFyQQ6yeswTgc6AIwr1/06dVEN3lModNVo3kwjq2BQ6sdTm8suczeWP97O5/DLnrDxX6B4eb2EMWTabJIkxEvNDoeq7fdrm/dlifWVIbV51dNGJ7ZJ2l7niNVobR+HFUSCiG4keFWJKLtS7zC5Kh23PbYcFAwthbNFcXXAXR8zoVvFrivN3Hwhkzd/Tpa7hqBYrVu5b5cC8ODtq7BuRG6sKPJtLuhiwku1Ghf2VgHjEX4n7xwPAK0OFdCPzfNGVkYexoDQeLhTDu/+E/7h0F2NRncsuFl+ifyLvOCrYr9QJqrbyDsO1yg4g3av6wFUHNCKeqacQKgp9gVVb4QX6lwYEWNaXYFVfokJfAKHChUBv0F/d+wjToOQQY81pMTvXsW65iFl5cJw7Nlh0TCIVuaCVGY2ERuaQgtVH5ICpZKDP/1f3Ov/eON8fsrzny6LKLqDyQa7Ld2lcFEMJh5r8BVwXxhU4wSLV+5UoCQn9XEC8y+finoFZjSHobHiInovf0gt5YcHLoDasMAwrBdfPxcdG4V4y97+z2M3a6lbYXwj6HaFffBxIyk3pF7/NJXjP6RTZZH7xO5nM32oi00ksTuZ3eDV8Z/aQq+t2NaBWsGmeL/u7nEwABuT2IrKF5ht5djYN3A66EkkEPxOAcOj3FnE07qEW4Lvf5wUwVTpWR6KW4xAGExZHGGixrGdnzj9g3cJuoMcELJv0vQ3vbbS7kfodz0U5Uce+Wvbhi2Ufvlv2AbQo7esIQy1dR59GPRwqSFy3MuLhOrrvUc7RT5D1+CrIosoVqyr1y1I13Jvk1yViJY0YAQxTjK1bIGSkQkK+7QUaxRsXcEBSrLZWy+o1yY+O4Y3JIPsMNjO/mIob8BaufrITOcsYIoUUpyW+c1qKcaw+UxHFYi4qs+B9EF4F4Am4Yn1W5/XPrEoYVD6r65WV+z4bVDUblaxeWpaVz/UCSh6x0vlJIxmWxiRJkihtQa+Xo+ckKs5HCVW2bJ+UEApmr2RNpnJjoVtiebdEsVufQPcVargHpOtkMpTF9VjgtBpkQqRMIoDbukCUlfaC/8F0gpf34XdGGJrrvZwKPDFC+9yD+FOOv04jZnbb8PlV4LTnGUPqbcLFK10Uw1ObglPZVpCznzGEcIdRjk+LzKtCDIamTLNe82EYjays7HvFgxPRza1IRSlPouaZF4zs1M6CZEEfDdvMJ6rwskChgt5OuHCWnAC1/DQ2s8hoiPk5p5YBwsIAQyBqNcRKhCNqWppzCpVSDthD1s/jqMQO60VkE+eHKBcW8UV0H3Ms9z7mWNlYm+5oZC+aYv0QlThGewmGNKcRcANOI80DOI0yvydpasHvyWmknB9pfyVpCjoQem74Nkq/5P2enEaZo5Oc15Pcl1xtOiGXY9FnymlU9ORyGi16aUnTCl5aTqO7PK6cRss8rpxGpspq0eOKsI8jesgJPY7uyXY9jpCnhVZES/AcGxKAxSw1MbDMp7FqT7jI0Kzje6NZ2V1dKOWORmWlXmloV7ifQWukUMNyFuhiJel1WqxkGQN4sQqFRxUrmAVeGHxUoFAMRyGY+PhwFHjLt6oGazpJ8sBJ+m8XZoFPiklaJy4GEyUlwMQ0aABgot0sOkW00hYYWyI/X14geBTzobjfnCm9JlHmkgbmcJmA0JUwrrOEWlwxJ2XwMyK3oglcvJsl0siUJ4BOMuC6Uzsu0pA2Pwvx7ExE7v8fTsIKiW5+Dmq1u+ZgmXs87Bseq2WxrRer+Xi/dOWtRAsLtSRY49MoH6zxOLpnsEajoCgp+kmo+nH0W/BNJPZZwjYxjaLsgAYZF+o4+mgJDLSyHPsWpbj/cfQHJJDJkG1VyYX7ntv3Rriho158wgX7IcK2nkX0ZVR0UXdypDzUvYnYcSlq/qogIC+xRhY5a2TsofStglQgly7WpNASpwxIeJs4PB2TgfK9zmmw5emAN9GCNltmTR9pa/rImBhh6mu9MHQOnkFc4WcRex3ZYcFm/mkZJvwWMOGvI4kCv/0DRIG/NnujUHH0RvAmAkwYOPFvEBd+k+LCb0wsVb/kUhEwvTGQX/h6Bwb5ZikG+WYlBvkm0kvOXkTyDRadvY6osJ9G9Dgi9Ol9kcunn4BcPo1+Oz2AHI75NMqklnC2vpZn63FEP4/Y09ID9GHFjv78t9vRb40d/Rh29OOIfYjsbws7+seyHf0cdvQ3akfDi3Zve+j7OY2gb8qIsG+BCPs8UrKgz7M1fhvRz9M1/gBr/GOEHqx/vO8a//jJxAD4HM9cWP8Y3Vtl45y7I/QKZPi0BkdMvNGQjpiA4Z1pmCspriMKjUw5f7+0o9BGrZb9lnUUayh4TVY4VjG2eu4eKfizx5CcxQKpf3tomUYLFTbanegA0O1Ggwh2K4eXZgKXdjADjpiXM/5MvRNOs5aYMLUE7vIi/VWq81CckkPfL16uSK9w9PcuFfdzc5Kpt5nXbIzG3eaaLzprKAfSAmSHel64nAzQ7id0+UQIcyKij5wIGSL/x+hOf9WyPXRU1i6WKPFXbZaCmV2GzJbVU1yFYl0KcOTLru5BOL1XB36nClG4HvkVuM+iPI/sYrfKHD57Y1s6d04bJiU8/2e24dkESSlw+pylmNS0Ln7Ci01nCo1SFcOI6agLNyIa5jY8QLRQGS3efZgDc87Cj9zDaCaRn7HfwrHzV9FCbG8EZp8e2/vH+xAoKKQ15ymDFKcZRz7nwT3VucYZD1KlaqUSI11sS6XqgAW5lYiW9CqMPr5bIRIRpX1aZRwZpD7ClRBXZ1ZdpYaX8ILRy49IcWAUkG8kXvQgot8u0hxvDp88l1THlxH7sRRp+ipa7WLry6g0wra6dD4hwvZzA5V6AKjUg4h9FeUjbH+Rw+RMFoRxNHvRgAGTKr0qTY1akd0vEaGZXMMQ857cXJyFflyrrfpaqIjPv4wAJYPj9+WdmPyXSzH5L1di8l9GBoVf8L4dNZ/pjfo5/SKyI3qrjFdM0cY33DagnnL1kwbfNPiHBR23TGluCVMAlgAnHq5kPqcifAaxetACsES2Am0+F0oVnIrwq5NlAphysxVuBiS2dLLFGGyAcIxWXV+ddOUDKF3JSZBvssHjl78fTR6/7OofZc1qo8glU/JVpmIIuV+405KMD9QaZrUhlSVCuV/vWu7bIgp5v+XMFEVLuvSjWNallFArKfU0smMsR7qFTeakG6O85PH9Si6f6C+MvVeeyzfrN3ezTZw4/y0bs5PuH6F0l4A4cofvy+bsHmOA6AYlRU/EPYaPNNliYav3TJlCDKw5PT1dzGkym/L4Ha8Lh9etilVfOCcFWvCFO53yiDR/CL3AtmjFIrLYnEq76JJBjRT701PPEo437CnNTnaWIcIFVrQw4+EJbcGtAns5S4nazIYdcgOLvzBBi0crMrweLD1ayjm09KqGAxTKf3NqBQv3YhXc2dBoTqUwqHxx9IzxKAt4JwViYy8YOcWoAAZiCt9R5z2n4hJ0QeMLFI3UzVbebG7ktDBS0eVSKi/QlozCBsgtVb40z9xd8q5l1bljUUtRjJZF7zel5Dbqwqw5os44FXV0k1tlQTdIuRc2cSwLplTMKeAB91j4V2ROL9xp+RzoqTcVscmcSjPH8pmnYad0AoucgP31bsiqLSfIaPcyCC2dEHXtELZLwARxAi3XiGhAZQYYcaC79dqbnItVuyLn/jNFZzMVD1mNtsTLEYsq94pjbWjPgzoFUE+rpzZY2NVwskvLSE0qKYjjsMuQiRGHkSiDErqBnKAGQeh9tsVrMqdnM/G1Gy/VGcHB2Sp+lRcfAVuuJG+68zMAmwO3TrUMnqVjbiEgw0C05RP53ubdHJxWgmXIo+8JLP74prwCEZl2RvzHmeuXgt0bridSAtabwo2a8cakb5dTVPk0TeEEz5I7WuBpXi+/ikjhEjb8fxoGPKVmF6kisdDw9GW4ZM4UdJTzlO6kFFwuOT3RnTAqSsF+CvWjHNQPWC+k0UAB/kC2+ZwXV8bgmZbDcIjnB5bXWD6/SfOhSFaceKxaZc8qKp+Ae9aWdjRX5eL47lndc36jOJVjL1p+EHEcx5DLd8WLu2C5H+VguXLvsAqCxGkJde0aO3EJ4vldinjmlM5K9mAwWhLHEuP6Ah6jI8UJWduzwFltg05Dhla0aHce5uzOU2+BUaY4bgdgXY/a4Eon3KVnxDkjuUDx8yyC2CQKZ9NlwCTKAZNzdzkCJzWez4AFd4Y5nwWr8j4LjNxefDI7K+rqpZytUqpNI5Rd7kS2RmiKSh3LvHUiRhqfzKY8Km/VXtaq6qhqlaQJenu85zfH46Xj1ofgLjxXSuHuQ+j+cgHCzqm/4pJbsGJr4lHUxZ6v7P6So41jRg72hXtdVngaZTfNhXu9bK9NDU2KOb3wgpV1dQUkO2Eg895ZKeQXkB9rj/hdiABgAfF7b7qcYsFsL1xx3rxwr22pyopFCgB8AQAtWQkoanMjRVX3Lbi3Wg0E3UWs6703fRsIz18BrdLKC+jX45vVrRVUXoT7nt8xSy1anCco9InzBEWL8wRp95inMH9ZQKm7Jimt2ZgkTbkvP2X5Ewlc1CfhiC9HVCEHWKJmb8yVau8E9I0Ru/ouYibP97uoN0XO5XdR7/GAfafugJh+FynmFaQpbqJONOl8FgWQ7AXxlA+FzHwSzqLhUj2ujC6bQ8nhuesFUE5d1JgWBsL1gph9l9nWAWMzoLfgs3kFqfFroccLBNxRkdRfYc5Yul+W+4VKcbmeS8NBat9ICjblsh/P+c3yTqzsgIq0kO9HQMPFfgAnQfUAy6Qr/uuIBWVc/l9GBYWrzGt37ktPUD7IPnLzkC2SJnyJTDGrQKysoHF3BZFRgRXjXjKu1y7wX5sy2RvfAHhX+83UqwiMvXNoakCkOQrHGLyStB1+UPRN4qYKZ1ISgr50Mz+wkqAZShN/9EwFFsPtzFQ4DmzTlVfUFQUvUQHbaD8K6n5gH3Mof8xtQUjSKjqMClhpJrO6tDLMlK9CVqA/kFw0mbgA0Riqwm1sbqzvtXc3tjYIlUn7++2tBAJmNdpbdHO7vbu5tdfaMb5uqK8bdEul2oLZor6xu725vrfe3ttOWuQdJ+8gW3ubrq9vbu+ur2/JSp5gdqhKft+gG+vb25u7e3utPaLLGN32Cyv4TtTXt7c2Nze2drb36jbf398mdZsfHKzDkFWuX0e9MQLEX2uAqPiF9Nd5iCg57jo1BxJL74HCthSkboELGtzMcwBuIb3NcKO7hARt8gn8wbbmD+LF8tHkZReoS6fRBkbsiF8XcDxD1UPjcnkXLBj/stF2hMQSn62uI0UkV9XzEayn9qewnmShaUkpOVtF/h0aJ7IMS0kE+GiEHkdJss7Q33Y1JzrucFRH4PutrtKVnwUiDXaf8pe1UoHCg7Ip0d0GX4xRN3AC7XzUAGp0nVCjOK8LQgxK/B6bIeUBLNsQK0lxaWL8sVR1m6wglE1dMbLfSpJ0viQATpJFDlutxg9Epq/i3EVrR4wxnqe0y0nXXGek+/ES/t5CD/l+muQ02jqvOlrSLgUtI6ZhzFfN2czkZWIJn7uXfJEL14Nsg5INQgC6nke2iXnQL5rhmOK1JUx3lxnvTc6p0rBVKsaP0qj6dGRYxC4lRY9gN2d7fTXZCfuo0f5Eega304eVuKOaARoEdMVUqXpAcbcEdplZ06mCGDlYc7ElSUFgYIpMheEcr59wwefHF5HtFuwVF+gMaVfVRe3IO6Vl2ghrNaPA8JkzJ9QtWHmZCLr5LcXg3RIHJTCU59RASgmkfEHDQsp31C2knPKFYid8odwhzxe81WJPB7Rhf3S+ok9C3+c4WueaU2APPuDUEHP/KCjKX08ElTJc1KMVznFEDbH204hKe1XnOqKv3WDCnSNOX/Mpd4VzxqkXO5ccOYNfnTjnfD4Hkmdja6e943B2cFvCHMrp2XR5k19Pw0jEBYwCPF7yZgycp1MmKF/q1M3wtUBvDTMsJ1Wm5MHsQs1NtU2vIk/I3y0Q1o69yUx9a6EjCGdZj6Q3jaxPnYKWDgHNA6ObZsfM7gOOE1Ge30i614zP5zB/u+sbOH/lnTHVlqC6x354lsHjoNsLKB+AP216Cw04UZJYQHF4Q9TQWQuHgotGLCLuXlhzknMvfeUFo/CqGbiX3sQVYdS8iE/cSw5NkBXf7BBuTe7HXHFaVd63r7+u1bLfatXkEr59/XV3+Sc7JI76esXP3nuiPA/12CgcIjhSX498Dm+25VoEQgmKG583R1489d0bZgVhwC3qNc8jPmYuxbh4h0JE3tlMcNsahVeBH7ojKxcd2Gvq9FqtWEK40YQLi1qnZ74bvLcITftzFo5ugNTjwejJueePbI9Qrzn0veF7m9CYizfeBQ9nIif/ypeWJhdpaWO6In4ZvjemAqNprbda4NCE7rXX97YdJZFgB0qQs/auH9eTflx/sDahIVt712vUB63rXqux5zbGg/qDNY+6bO1d66zXastXD17DXquxI99jNnWjmD8LBPWZFWLr2dmOmpNaTf9pyr4x7V0UE+lssVTM/XGtlv1dLAipELgnSWZJ8rmeK8uA4RaxCZ2qAiX6/XSc4qz0XP30AlpmbzZsPnUFbwbhlU2MUPoXBvYu+61VF6tVQBKMYSVJDu6ZPlIVJW0Fs4szHmVzwHO+6xevMytGxTyjQJIs5sKeLMwvn6NVj9WTHypSx29gMTaVnBVO5oaTjpfuS+gDjFcPuJTHj/D1eNxNf9nE4R3OLmxBuqJuWY6AUMCafVFdGCqcLN7lTh1K8WbEp7475HZALUurnLhNwWPT1WWUJJ5O68a2UlS21wmNuuvOLnHC9OtL96VT5/NlINQIWUpVhFzWokPQN5jCn0tQzoD+p2M3RrDaG6ub8nwskm2gGyOYLHVZmCm+hlrZecYExk2V7CEXEMu0+JmB28wYpzEzAMgRgKthF1lBXlbmyk4jCfCGv0Bj+kkSHcB2jYC+mII9yuyAGW54j1LEeiTdMV0Z++QalqXQiyK2GDHRsHkjCzkx7Z7bEXUhcUbA6w9SAZk7WmOQOhg4vazVAjk025grM/z6qdlPGrEr5UE3yMhXpaZOfaZjC6XTkHrJO4PYGN7YnpK0C8U5vrH9VC05qwAjGBSyEurNU27SxIZwAC16YUfgHX7IqtWo6XN3BKDJZfaUWRfu9beuJywvqESkO7YndtRUaVhUEMell8wSkev5cKAgYxfq0SnOJaGnzaEbDLmfM7FOQ+7WamjpoLsZE9zzAfNZyPR0z+lpc+wX7IQWRtz1nGt7hMzf0/mctne3ttYX7pvIbrd2t9aJHdlbW9sbe4RaT13hfuPxK4t0soMZzGl7b3evpPxWe2d3j9CQRfZua7PVJtSF1J3t7R24+SN7vb2xvoNut+3d9u72NslFrdDwC4IQMG2Y1nI0BYdK9ZInoWLG1+tiP+qk/th7YtBJXZqg/zBQISPzub9gPBJQM036CmEh9Qs2Yi71C+SFR/2Cb6mYZlPjz+nG7mZrp2Ru1ndamy05N+3N9vqWnJvd9XZbzc32zlZ7V87N1uZOa+v/hXOztdPaad9n371wp8Utt7uxsV2259Y3d3a31Ly213fVvO5t8w05rZt7e+1tOa17W+vb/6+c1o3d9u59pvVVFF54MS9O7dbu1vrWfcqfcLGwLLvb22Vt43LJZdlrbbf35LLsrG/smivgfcQKnJ6OXOGeniINFeiFwE9oK90TAzL3Ct5bvKI1ZUi9wvS6xmR6c7q5vbG7WTIgPNdyQBs7m9tqn21v7Ozsped3b09utM3Nna1dQn3Iu7nR2jNGPCtwlfOjgovQMBnEx3y2sJVCOlvcSi6dFbaSR2eFscZ0VthKvjH62Zxur++0yraC3AFNiYsW7oJ2e3NveZG3XiB2UVibL7bT2trZuc+m+5a770vgwd727s7mSqqb3MZXngCTmDSu1NCNeaXlFCSVpIPp7WI6BW6y+rhe+lHGG5E5NpbnoBB4xYg4reSJ6LKT7uxsttdXDMT0gNMA7dqSQ1KvR/vaskzYYCxBI+DJZ14jgE+xube98ds1pKLY9AayxU4aE7YXDTrC9rDVWs12e2G9PmBeOmYXsI7N7Y3tMgi+vrWpIPjG1vbepjxZ7c1tdbA2N9ubm/JgbW/t7Gyrg7W9A1BltkhE5v1kdpYNNnPLAGREVKuF8HOKP6tDGQiLjtPX6hRCjnFCz0F0MkySaZKM6YiddwM7nR5JvRKnN6AXbGSCMGhtUvGCCidViDw1U7QcnZAkOQeCVGa2GJsgcm9b4XgccyETrKkb8QBfSJKM4fPZbDzmkfp8diP410YFmHCcVkCSJLYn9ALc/owQENqTTGIzn9P1vb2N9d96b0jDrYDo3RGi1Y6xH9OgMQBpNnd3P6ZFkbWT3/cdwnthXdnZmRt+e317+76MuUabusuuH4jlimFpea9eDwcwvBDCgUYyFn4vHNDQGF00n9Pd9b3W7u/iUHcIcDKzKSRa4VpzM9rzOd3cXN/dXtqaGTBo6nvCtiyAO5t7rfU9LCSvo7Xeu/51q9XoX6+P+9cbbqN/vdnqX2+dNfrX263+9Q782BkP6muTzh3NXKDnQyCEegOYjO2trbKDv7u3ua0O/s7ObnuDdJaDctuMEFwN8bqnEFgpJXIg3RbyiJFaTZdEwLe7vXWf9qn7idCERhkkFIOOqw83BNQLASwSUwQXJYnqZ5IY3Wzvbu60Srq5cm7MjZTuHDTrBoIa9w3cQiKz6FEbpyE3Tmuj7BLe293Y3lAgeXtnc3njmRFiYAsa2oKgJ0m6vbG7u7264t32TusTasZFK8XPdnZ2Vu0g6/QUV/X01MIQXUFXfrotyBVyEoiWVIpzIlMSMScOLDPDg761t1e2aIhG6r212V6XlxruRH2rtTYUKYyTJW+17c3t9W241YBg3IWbKbLbu7utLbiZIru9127DpRTZIHPYxMvI3tzea20SOsLp3d1dJ/QCUjd21zcJnUBNe+3NXUIvcZa2gN68SS/Ys+yCvYJqt7d3dwk9gu8b60CPXiPavre+S+ip3g70JF0/eshSTuWh5t4MLPo+S9Yc4IFFn2Sp8qANLHoMRqDHvcMBO+4ZVUXuzcBaTHuMt17hi2ZTFJIfh6HP3WAxMy8kfe6HrthYL2sUP21vln16phHbxfT29pIP5Y28cKeFlJfIcJaJT3JfXvPJ0XUx+wkXxRTERIqJipWcS8wQ9LIPT3z3YspHy763t5d9MUZabVHjI7JfZYH3uVIK0ccSbbp4kFVgjh/oM/qSvpEA9xV9zdq1iL5g67WIPmWbtQhYgj/UavYr9rILceNlbucHWxAjzvwrDQ9fQf7qkS0K7tG/ZsABho9fI//xFXLKafW1zjazBX0lA39iVx4zsDOkn7PHjL1PknRYX/BAWmtl54Cxx1DxmdEo0AuvsbnHjD1JkseMHSbJ57Va9aVq/kWSfN69nTuX+W686E5tQWP7FcB4Z2gL6snfsmcwuOPe49TT+MuucG7nnVfA46SP6Wsyn79JEvuNpD8lI/8De6NcDUN/PuiiHzpvlLPgV4Reo9igxD1OQG5fSYLcDnC1AiroG+l888qGC7G0FCiQvsLqQ6pLhmlJ7NZb9rWOrWw/7b7onjtj50X3xDklRpyE0H6bJIIWan4LERmZ6IUsGBDq2q9oWSv0FSAL7XJeioSGBccT1DXZrsZGJbfzoj6pcjwfZIt+O8/FOIc7rmPKnrXQGpaGZ86JsgxyNmgEcnzj5nPhltzdLeO0be7strfltbS3t77XJnZglgzndLO9u7l7J9KskY4MF4f7rW4H3bbTaJNO0HUbDaded/fDFIN1BzJcbRqWzkBE1tutnbJpRypBc12Au9dZChdQLiShgg849YwJE4l3k8R2WUgohCOCMMOAxvv7s04aKbnnDzrRQatWc+0h6UYH7S63hzRqtFXVTmDHdEgcDyroaQ27ARumVG4MI9nd3N0oo3K3WtsbxC5wMXA9ynJDLR+LgEEtIeBIezs7pdjXTnu33Za1braAE38PpBLofVuwAM14tJffjjRq5rVaBIQQZ7wX2qIX1esDMjCt/xhzu9xRooo53QZ24eplRrTkDjTbZaaQEej2rusEtktl3EQkiDb2StsBxrpEP/e2WzsSK1vf2EBmsWmj/cadqG6vJnY03ZaSIbybXYOB9O8+GliOcbP7/sByvFrNAxogDeXZhWE46A13Ttur+TRG27gIIlcTbIDNrTIeJk6LPk2t1hZZPbYwL4g20DuMCY4NtfY2yrnye+t3NJQ/tqnsDckjFT5XJEkaR7ca4uVRDe2IdEUVMPioyiInSGvhFK1KKLS9GiPf3mm3NZtpd2OrLTHy9nZrT0sKJB7tp2jyLEOThxnzaVqO+45ZEY+l5yWI7+iTiU16QSf0Up6FG4ZcqTMGjEx6xW66YwfV+o/YGf4UgMPbV+yKsWn33LkijJ3TU2YfsSOZcoQpJ5DhCC6kk1pthtoEcF3Nsuuq2u7cAC53zartucxXvSZa1pgk9qVCIuhNkgzVlja6C5ubCnqVJsi4pO1apDQXDtl1rTbStLN1enoVgTrO6PTUIvQ9O00/isJHqOgwSd7Lap6wQ63lADoO9Ji974r0XXTKejyxn9Bj7Nglmc+1esYJUO1mNi83INxsW1u7u6WELu6gTzxqiAanh2x9b2t39YbGY7gcaoKVkGRPaFY4RbESq2JsWAXD9DLHyvdlClA6HvATVET9qOcNoJBfq81664MuBNOvMsZ7s15rMHCqNjwlEyblUEF9nXrd24/T29aWNRFgTkwZ7w0HdIyB+Y2683J/iK1jDyuFqlP0+1zJhSAMLoGEEXPtKR3TIarbnqvdllY36ob2mE7pBnXpOXFGRp3z1KkBXuelk7++sbWtxblbG5vbCppILNFDATimxqDGpZe1We8+ybQH+4MHa9RnmizIwEAJUxvUqTLtqCmbFUAFHTNJGNrWO6s+lMdkSlItnbVev//uQfNRvWuTXn9wO08GaxNq9fsPapaRK19pkmLRpPmoa3dZv9+3SVIZhxEMRSYMCFT0oN181AW/Og+s1Tu9alfhjksS2PHAFYNvAKeI1ATy1A2+vre+3v6dnyukk9NztbG7s7m3+prcbO/sthTvBpujHrArvOW8A28578BbwjvwlvEOvGW8A28Z+e7dQb57S8l3bxX57vXKLrpcobKKChwbr5Q145XzcbxFjo1XwkQwpzulr3PJiqXglTFXjER9LecSM2aLt8Bs8cqYLd792RmFGAgof9KCylqtWvV6kAX459s7661SZmp7a0cRCe3tzQ3FY9ze2lIaJRJ1AXxmY2+71V59VMqUBrvc0fit6ywqKnY9dckDEOcgCXWgQkQ/EHS2SumgnXVFge5uo2rGpzHdNTWdEZShnfpr6Q0yD5w53JikrHlgyVuGLrdVBQmCkMKxzJ2kAGy8tdHeWMUTSEeFcGJjcw/vgN/NqNxsVKE02jQGF8vr0C4MJE4SEDJ6eqwxQNpIDi3OyalwC5Wt0t5WSo/tKeWNzfXtjc1lmyjroa6+nQUDqdXA4gSwBRfjraBEAp6wZUzHmaahE8hGIumhA9YANnjZGUDMR67Bzsa2Ujnb2WvtKT57e2uztaFUzvba2wqrl2Ohs7spYdUlD48oYNNd355Ji/lc1yV+FdqmnDPTSK/VPLQSdPE7Ei0e3cCRba7v7d4hQUABmqJVJWMARra1t7OrRqZ2op+x5WdIpWzstVaQXkM6pWNyK6RQDbqWsc1c6uNeHCeJPdbob2y7hHhY1ke8Csp3DBRs2p3aM1tQn1CX+nXLgkHRMdGkdDohIPE+Zy6hIeSm52jK4wPUwFGVCevkHISZkAQXeqe9oaYD5SVxJuPwM32CWQoMh7i31ze3pTxFkXTjDKE7z2YTBCrbu9sbLSlQUTTfJJ1YFKhsAaNtBeZ9Q8/oFT2S++OaTRDy0FNkv0YgSDlCVuspoqcnBIpF9MSY1EN21b2yr+kpjWA+odYjPZ/0PUtn9BAqSCmgmX1K6DGrPqnVpvDzB/xZPa7VLqCtQ3ZKnyTJcZL80J3Z16R7yK6dofzh2dfEOe7a7+HaOmShfYq+N5wf0iRXJx2y3sAZ2ackSXz7lHTtQ3ZNfVnNJVRzbl/DXTa2r0mS2Icstk8JIQ7UM39fq9lHyPE9pYeEntmH9JTe4GTRI6XUBNmpnJNDeVhaGzvtuyXdJY5oFEdBc5BFjw+gwp29dqkqLLLQSOejW1Gh9ECau71CP+nunmqWEooascJWu7VzB2PWcMMAtLcZyEa6imBB1w4QHyEOKi9gunTviGevlDuHGAWiw1sbW4ppttFqbbfvBJuuLXkAAaG8LvUO2q3tdhlmr2SjYcandDONHy87lvFdMJtGCnZVPeMe5emlOQPu8JDZcF2ZTE06ZcNGm44ZV+zNca1Wr8/2h4poPWexLXqzAUIGtDUwJcrsPEly17BKSq9/TMjZjcyqbCqrvmDj3vkgA4/2iPld376g5xnwBGJpxDz7gnQvHLhHZ/X2gHR7A+d2TuaBPabndASwDKqaG2owW9tb26V6BVs7Lc1jRuF5Dn0Mu6ULCstpaULUWi08byvheYCcKVN+PnfcTk7rsr25vrV3hzaf7HejbSoAiX0wbhasIQ7CbssJ64JQO2LRQdgNnYjg16jOQhimOIi6LSdqgFuGFoW/rJVuClcpL4UgFghAauH2ggHjvaAuBh1Di22rtVOuy7LiHpB9j7LAr/mjaRzbqh0xoZLwWFarcDBRRe5j1InkYLjUxOIdEkhNrAyvDUB3obW10V7NJUe9sKJC3sbG5iYeRGCYp/tb30k+i7uZT53lTHRAQsitYXKUEhaZPwLk5hhSUnlg65YFHzzjg9/1tTKnY1k6mBxklBmsloVITntNMNZ4Foy9wBM3XavRshyc4J2t7dKp2NnbQzYPGuOt3aH41OWpQwRIr7cz1kpILQtccM8pqPl/0r0A8wUXwdbOZusO6U67hQobiLW31vfW5brdD9PVsh4q7yGbM+TbouogXsoV3vNs4MYjcdre2dq54+BmgShyh5e6mU4fclXUwcMBxSzYd7uiFwz0Dopsj/JeMKBxKm/zYDo3V3cgmz40LxeocgszVUbQbWqddUU1oPnK1lZ7U07gzt7uxsadExhIg3e8+Ei3xweOm7K1NlvtUmQf4d9dYi8D7qWEUqb+BgCPAtkXHbAQKPdMs2xnc6NcqA2q26spOpQ95wJm8mambErM2BGwV6UdBL5wdHA8p4itG41zFjWDi5GdOhJJVb1DEOpURTMIRxwM9Wo1QV0W1mq8VqtyI5lTj7m1mpv2m7GwGzQlp0nDoZh5Xa/p+n44fBvE7pivkucVg2jog6wtG9M9GwB0syPiLE5MBlwhfQqKsDTA89reKlOyw1W548pgAtWLpa4vcfQvc9oLnaC8men+0txiAb2/0d7cMZU++1ePHqyt2AFR6Q6I0Z8aFU1+zYdm6O2omXrSYTz7jdjlZmuzvVIoW3qduCzshtpm9V4iWber2D2a10OIc4u4PhCL/8MWwk8XYX1ntWqwcY/nzWDAlV6qZJ1qWYPas9TAN3hWqGi5GsuW6q/3EBpVow7IZCN2O88i28TQQT/TsajX430/lRKJXjygQ+YCw6M3A+7gbEBnqMG8wAsYooklR8za64Z2RGegZhGoH3ODXYWqkKvVSvf2trba94HRNFR+D8Gcsd1eXetWe3Nz/WNr3dxcX24Y07NOT4dhxBs/xKfxuRuhJHOQ1w5ZB5OJshr2drY133e7vX4HmRoU3Qmld7GbyQM95h60u1HPbbQHGQR1D9a7YLui1wwW30s35cFGaewRr2u7jQb1SFoP8LYzY5gYKBmPufsbmsT1qMvahAotchQSCXc7Wp0n6gWDjl+rceQWBTQzLBFzvFdRmal0Cbfbd6+b6RlbRj2SWgeZzrTkykYZNYlZDerBmEjRdZ1Gm8Z6NBHp2KLroT6Ut+8SZacT2nHPG6D2Q8fkyYJMubXCbmGx1ws6Wbi06VxSjyEFFjNPH9Y4Fej6zOvxbuyAYQOOEv122W7PH1CfuoScRdx9n802gpbyQ4i4jeLQrW/vKq7x7kZ7s3Un+lSK9woGKL66gQHhBPZxtquYcvclwGNndCjsFjL9urCMbR3twyKOSF3f6lmOe3xgk7ovvbds7G2U3krb6eHf2G0rfuPW7s4mMve1tLX32f/3f/orA4taE+tTuEUuItIpleABlUAop8gqAcnPzmarlCxZb2k1Ptzh1F2pKVa+ZzyNamfHDnYAovZqc8BcbpCOYDDztDRGm2/DdKL2zXwuoT9X1ev5nh002t2453eBfeLMBqlC2Jxut7b3SnlOyHi9C7BxknLJoK69jVKzdGTFEfv2N/+TYx1a9Df/s3z8L/Lxh+Tjf5WPPywff9uxXIv+5u/Ix9+Vj78nH39fPv6BfPxRx3pi0d/8I8caWvQ3/5tjPbXob/6lY40s+ps/5lhHFv3NH5ePPyEff1I+/rFjcYv+5p/Ixz+Vj38mH3/KsZ5Z9Dd/Wj7+jHz8Wfn4547lWfQ3/6d8/Av5+L/k48851kuL/uZfOVZg0d/87451bNHf/Hn5+Avy8Rfl4y/Jx1+Wj3/tWKFFf/Nv5OPfyse/k49/Lx//QT7+imO9tehv/qp8/DX5+Ovy8R8da2bR3/wn+fjP8vFf5ONvONavLfqb/+pYNxb9zX+Xjz/iWIcw3H/oWC48/6ZjvTm36G/+m2MJeP4tx4pji/4kl+0nuV4/yYX66X/G6f/pD8nHH5aPP4KL8dMfk48/IR9/Sj7+KK7QT39cPv6kfPxp+fgzuGw/ydX76c/i6v305+Tjf8f1+ukvyMdfko+/LB9/VT7+PC7bT39RPv4P+fgr8vHX5OOvO9YXFv3pb8rH35aPvysff8OxJhb96W/Jx9+Rj78nH3/fsb606E//UD7+gWOdW/SnfyQf/xh3xE//VD7+uXz8C/n4l/LxT3Bj/PTP5ENump/kbvnpX8nHv3Wsryz6079zrB8s+tO/d6znFv3p/3as9xb96T/Ix390rK8t+tN/lo//Kh//HR8//8/y7T85lm/Rn/6LfPw3fPz8P8nH/yIffwh3589/WD7+qHz8Cfn4X3HL/vxH5OOPyceflI8/hZv05z8jH/+bfPxp3I8//1n5+HPy8Rcc67VFf/5L8vGX5eMvOlZk0Z//D/n4K/LxVx3rxKI//3X5+Jvy8bfl4685VmzRn/+GfPwt+fg78vF3HeuNRX/++/LxD+Xj7zmWsOjP/0A+/pF8/GM8FD//U/n45/LxL+TjX8rHv5aPf4JH5Od/Jh//p3z8X/Lxr+Tj38jHv3Wsby36879zrCuL/vzv8Uz9/H/jYfr5P8i3/+hY31n05/8sH/9VPv6TY32w6M//RT7+Gz5++teO9QwW/t84lveDRX8GcMEt+vOfd6wQnn/csT6D6f/vMPJ5QW8ZGcZLrbdpaKrFi+jmVgX3s+VNQy2pGKtF4VZGOdu3c2pZ9HZOKJ8P0Y6Qk9uCgnsIN2O7Xabiiv4IFH0DNphKTAhMohWkDnoU0rQL2JMMM4p/mtE43tgeVqUi2qxWmx4MDdVIKDtmvgwYAXJE37ChGNdqKet/jNZn54zxjlRTa7TpBSjZTMCGpYvMExPf9pGnwqkgVP4UFPm6o1QwccnAIyO9YaI3QiTOQwW4MzbrevYNvaQjKiinPnE8+5LeUIySSv28J7kzRHzPyDAMhBfMeOeCVdsdif15Y3tCbhVuQO0FtknVtSdo62hfSsl9bF9SjvPqkxRnnkgFBAxUQW6N6lGJrwKdqTJ2U6tVY+ymKp7LqXEOLR/khKa/BaEXSCNubN3B1ZZ8L+qmxpXUy7SC0SJvN7UzX2/vtlGEXMqdGLJZd3Yf7oSSz82kyF07JpAeCUr0nxz0353xbqpMGG9JYvIW9Df5lu1IzjSXggom1M9OrsGcgpbyX1Bd2XB1iuw9pHLlD0EIydeaKnc5xdHxYppWxXJSeWGd07ooVKi0vTIHC4F7wRkT+KzVePOCx7E7wST1M1+B1uPKN67VttJ6mRQZ5DKhCpej5H/5T6gI5shD3K6h6ilEOzhnPqHS+XqVaa+01VEeVFywsQIVUOxCfaxcMCY6QcLW6Tg99ph/wjz7HAEL7HO9kTTYHBvnYVLopLL5gx01JKnTPMUZY0z9TMP3okE5qsKXMR6k1Wn46ZrrOSsdgLQz1MaEg5SHsshN1upDVT+bPU3mTtmwM03pWFAenkoK1va7Y9CLEk6oxkbHxFDvVWupIfWIxQakPq/V9EpVzhFSjzSkBiDdiTNoHGfQOPXwwPxOvT7NQWbZMwmex4NMP/mM+V0XwfMYwXMMCvIA98YInuOiwvJZF4DrTZJ4Cjq6UOQsDyAnSWJPWEEOPSYAwS9qtepEduuK5XiV9IgJ870DlgBJUs2rlSmD9cVUsPMvYQNd1WpXFS+IhRsM1XtJrqNa7cjMdZQkNowns6kytnZcAPV7e61SJeHdra3tzY/RWCjoKyjgbigtbLT3Snj4n+BRs+h5aX1js5T6BedoBj/zvvQ9HDNXGoGhWfjquiVXk7qp2tj9K99qbZUxJjZ2Wu29u1jpPPV6lEnEBelGvUVJcFcnORZEj7EGDrjZmyJ3dW+vVA6POn7hHdOlD2yqVClyvhO0lRl43QCfDu6gA79ZD8BXYHtkMM/ph+6W6hCh7YDahju7rbvUA1iYD4AKhk5RZju3u7W3vlnOTt4jdhYe/JWGycdjFVK86LILLN9WIUgLxgcuC4v2Bh4LM3uE+ONM5+TEu5l2Ko0Y78WDDlAK8ENJNZV8sNoyCAHJ4NSardlkgRZIF8tGTiqmjgeEhggntrbKVY72NI9vp7W5s1eqizxVQ34WH6W6LZl2b2k8dpQ9rjAW7A0c074GdhQ8yuKnGa5EAG2eO6ExqfGc4hFebVGJG0e5xZPAxMsGHK8cSLf8zPQGHdApsQV1UczLc4q/Yu54+U6i0UYpY25rSx1WdA2oeKzgzk6puIJnOomSo78wpbwr7TNmmZXNkOXxNdMyTzm9y9vlIepmWuWl2vp0xErwcXrBZiDPnbCZHRJ6yWa2S+gNm9ke2N7N7Bhs7/wO+Aq6UgLwNJS9RLDtNiGkykZJEuo8IamyYZK48O42Ix6H/iW3Idc0STydyyNVNk6SWL/HpMrARMC+KjlVvgRnC2YNjEEwZNPjur7kWNSdgUBbKtUERNEmgfKWdqGx45F0czbR70P5fqnfp/L9Rr+P5fuZfj83RDaGOcQVGkG32vc1eF3QjKTr6+urtWFKvYopxcI4M/Lx71YsJLeLSoRBUYmw2u6YaoNjsMwE4abE56Yss522OWKmiLoBudYbp/fKNEnq9VmVDbtTp1q1hyUOolAffUjAU9mYwtM2LKuk+6td08FTKrLo92frrdao35+NdsHhU382Go/H/f6stSFfWxvb8Drm6/g65utjLDPC1/XWWH5tcfkYD+6SfQjt5lmqIGzlVBB6buPDoHfY+G6Q4N/b9TkmJeDpfAA/5Tf1A1OT3jv52mrsVQZr928dXdSWCQo3tzbK9X3JbcFRYzdA+SAoFWSRQhhe0eD0doXgzvD7KENd1Grqrso10eODXAxfqL8hg3ShRg061F06hk+kzkq9UkqIoDUgsn5Zp6d+OHLjc4zpdzrThu5SEzbSBzTSuzklxDjpQjUZVoMegf9gxpIGls9i4UBXjL7BErbLPRQs3x/F8ETF9tIlrJsr3205bRr1+ADC2hphpFbMrCNDsAP2Db6djCP06XOU02xapdIU1WqLke16rQGYTGmDbYwUZKFRp4waxLh8UnifzgS+T2dC6kWhW6ql6jgKK2hr6g01l7Qe42ZbGbZJ+4879Ob8PLnbUTecKHDfyphh0iXrR3C3DH0g364XyxoMPkOfOirkypuUOovftjdLvxlGpQsf2tvLvixpyLQrLfmSNyxdzNDeXvopa1CT+CVzINluJew2c4I75bzElUw+uTLFhUkZhKbVXTkfzTAIQ/0BsDYqoy3a7UX8W5oJ3tMOMwtgYO7eJIG+gSOowNY3PbrGWc3yLsGBgGbz4icYUepkGnF3hPr591KxSxGNJKlWbQ9VNDnY8wPeAZiVecG/s7tOK+m1G3uD/ugRWaJvmGkmLQTssLVn6Ki712rttPf21rc2dzZbe3ttB10rZgE6giTRwTeqAFrTu59AtLVGu1bjD9sMQqLxfdQoAwWqpU4XixoWC9YoKyCPNECJCiKiWI9OZKPLeh+D+hsBWzxBU6/FTgZ4Y+XrJUIHlxH4wJT+YkBdeaVCs9LZd9lav5n0e3bX6b3r9QeDR4ndsz4bELvr2N1qv0167/r9QdLvN8mjbr9N+oM1jCqzXE9UyYAMm1U11GhhIdORoq1ttk7wcibBqnxLUeQqbvEksdMwIklSdbPfMqOo1bhh5ivUoWi19+5GxvJdNO64JMnWRWS7Sr7o3qorW5sBVRnTJtOM47psbC5gE8qsdnMd+Q12AJ5XmxCvR2rThrVaCAErY/1sPjs6ffX6+M1xkoB2UNeSoMiOoyE5bTetegC020qnC1XQloYZ4qhetrO+vQJ7WI0u1Go5cNTJZCfMLuHvRmh4rKtOEiF9eLa315caVN9hZMFwX2jXKBBVYdkiFzH33qCAr2MghlK+xOYOarLhRWV6TMfIm/fHOWmkyMP0BET7aMgVZcbRjXZXNKfh1CYZKhrRNqGNRtpbMLdEr8Lt9s7S/n56tyrRfiszjIzALhuVz7baux/bWsbQzTXIyQH6ecPIFh9X5xL0moaonJaNIdxvde16PZszZfDe41QMCHGiXggDYykWjZEjyvqyp4OYKJ//bsqfWkEfyk1F8zvuFpB4NBUIIOo2/AJHdCGhEs7IT2jd2S6/wpHRvnppAx1tM5OUrCQfITbGx7aUW1ZoSgrP0I30Xnv7t65ORX6cU4zR8XHVZRskrQ9WD8ed2SWkYjuDHpN5wHgEqDG1LVD2f+e9geEqlJWdCoubSqcX3Vty0M+NevW6GDAM96ctbtEPwX1YXyVeEuTdFwE0AToShZVGnNckMe/ECJjHuOm31kvN63c3Wtt37bOc2WLaj60W2h8pQXekQ6VQjoME0aI7POc54wQgppfHftBKQtLH5kLEke3yDZeTg8C9WS79QPccdy5vb6AdY1UZJ0t8iZQ6C9lot7d3Vps7oTDxtzR3qtVQG2XI45gaAzC1rbBIxH+ceRE3ftrWTHi+RZpwo6Wx/jkynL3mmRdAlCvjp86/VBsrnlPwoLiKH6FFQ3fx6XR8uzmF1fzEc8Ft3O7oLgCkv2XcHAgeAiugowyuwON151hoG3want7eDobUNlYgMwrIwpl5DC0CQtvV5bCUr0BITLR7NL/nDZjbE3XwssYabcNqXWYV9bbMLDpkJjN7Kbtwhm7JbV86TZDBmOVEgBXoUjcHNLzLBNFYI9n//XVlYhjagrZoo03UqpW6g0x3/KdGk3SBsAtXRJPMu79FBx0rt483totec/KRIDPqGwIL1Wo5LD+zE5QiCAxxdLfFRg45UDfSCoYf0VcShk362OrTKxU12H7XF1p6nXF9laF6RRlc3k495a2313e2Fl0OY7IBPnRAz5WECEiuWp0iFJBfQyZsQNva2w07bAQquGBI3YMWrny9Hh2w3VZLr2J6TnutgVRGjLK6dbgebY+o80ruz86yaASAOd5LnIDIYZ4uwahS95MjGCi9WIH/KT00XCgMVPWxuynD9yC21SfvRQyHtTq6liEJlkHEPorp7o3tyNQnCjLPl2aeagiRSRUoa+/t6Z3gmTSDsSr1usRvMKkTlcTucu3MKK0M22RGBUDKgVlUue9BDZANI6rtne17ODrsAlvOCfQFulWqII0IIHo06L1rAgeoLtlBdqPbH9XtrtNv9kd10iWaMaQ4Q+s5zhDpr5P+ILG7DEsk/V5/QLKfyQMCbiGB29S3+33SXZugFZpdimmpIWxuMwac+nM3ehKOOBp0pR7ZLAuExpk3BdtkHEoPGipn0A3TfC54prQIhNTm6E4DzAXznkdQ4lu2I5FhttxJ26I0JEkMHliFK6dtvOCEQoATCr7ghEKg+BC4RRkcXPQMehciZeCsiAguIFYZDmd8hi4aX9QutiyEFXt7LaNLa/147Q6VqnwcmowDq630IpK3d6TbOW6x1SvIpQcW6jN8lHh6YNGAWVjRxrAnKzozKgyZ1Xu32I7LLNjDRrER38bvo/F4QG7X5xb1Cj08G48HmDDMVRVjVVY9qluJVQ/qFrHqVteivixuCs+7Fp0xvx7XbdU6COexcA+jHQ+UKWMC/lWJVYesFnlkodqLygftdC2lXysKJehUy/6DumV3mexPYtWH9dk9DBd1PKepjue0vpMT4MM8r+/I0a/vnI1xvdzGh37/ejRu9PvX4234s4s/x7gyh43v+v3rIRS5HsHXEX4dcVyafv/aHfb712dtSNuBYvAHW4DgVDI6FYankvGpMECVjFDVvz6Tm0H1p7U9rvT7Aoqf9ftQ1m3hxIMOQ9DvR5hpfVc+9vr9WXt7F3K0d1tcVSQfbflYl48N+diUjy352JaPHfmQdbb25MOVLcjObcFjo9Vq4Z7rWfWwbg1wb/X7ozrunl5hVgdy7+COGsCOWdzBUI1XF/UI1ndgqe2xejtP77edx9i0rPZc7Toft/YMd9hIpY2NtAtMkwaydtcZJb6fXCQRT+JEJJccONl0ks/zNPn66+RF8vooOUneJN8cYZ5LmefjAECy7PBDjTelh/CM3dQvSw5hKagY0mnxXEJpeS6v9LmMy/Kd0SN9HntjOLYwk3WrfqEOZ8+lY2o9sArl6AhzTYxc9fOyfLLOc1knHctS4Ot59MjuOu2TN8n6y6fJxuunid2t9trrGwPS74/efEnQofMZKtqcDoiVFYlFsh6Mko1olCsiztMih43vsIhHr4zufARwOdLAZXt3r9RrwubuZuqtDEy2SY69lqfURVOEX4dXPHrixtwGLW4bzMIFcVCLshDwZLdcdiat1ZW90E6rdScCBm/5htH3DnhZ+whj/jSFI7tuY31nb7Xr2Z0djS63N3c3UbtTczVorH56wV0qEnQm3YGCEznWAoO0Np38fgfW92nAZhoV8NlMB7QZMUGnaWD9YGlg/RHjdLwQ9P1CRp+fLgmsf77gnfU8F1h/AoH1RwdsWBZYP1wSWH98r8D6mb+kCTgRokNIHN0RWH9sBNb35dBsY66mpYH1w1xgfd/gRM1kYP3zxcD64yywvvSWP9EJuTD0Y1Iy5zf2+WKgfQhTupCV0Ok8PaiuCrQfyED7F7lA+0MGpiz5QPue7S4G2h/+loH2x4VA+2OCZ8Bn52zGxh8TaH/cnTrXdpgG2kfPEWWKGeCpQB1fCWZcttaTGBEgRIgPAd6EaNP1eNyftdpw/8xa7Z3xAKkpU53zozDjO4GyLfW5QWPCoKSCgpsKdKi0e79INZxJgYSMVlNlaDWxsdEud7m3s9kiNtjr7O3tkiL7HhLLrgcIW6Wju6BzDOn8bmtr04SId4DBuCwOK7ALYqKDVXWUYwylj9JyXEMA4KPDSZ95dlz3aUs5rw1tQTeUe2M0TSojyyEA1h3Lstg3gjEs2xBwGPHyje32Uibvnc7lCtrVQc4aJd3iYTdywv8fe3/C3rat7QvjX0Xm261DHMOqZDtOTAXVm2Zo02Zqhg7b9cmmJchmQ4EqCNlxLO3P/n/WwkxSTrrPvufe//vcPHksEgAxDwtr+C0Ezh0ed0pPzZm5v/8FeElWzcOCxKL20C1wfuHhSFGlqVtV8zD0ENFh1AEClaZFS83yblsPQEFpr3m3o25SQiYi2+p8wqGwJ/DLOShI7tStwM048ukPmjvBHUsrIhR105G6AuOKbg2mwEOJVnD/vCMrOxaBGwLngmb/8GgrvtJfdMchLCdxdHj4hcCAMj2+MzxCLay20AylY22hWY2w64HQLG9hBJYsBbDSoo6CQbUtHo1DMIjttPAaWh0xdEuSe0272AGD6afSY46vvNeAyKPUv+w1oOHFB4k2rWOO1kWgwdTmWHV5fTBaLoDpDZ9N8S8a+ti83dYYoP4DidhwX6TW61h5kznByY6WMcBXq0BxDEnohtd0qX0cFPN06X03eE2z0FfPQTfQceDP5VYNIw1w0FRjQ8G33Qc7nI3E7ez09hkmeFBfi+m2yFey+niNgbA+YNV+disMlNTM+LUVHVlTaxJRoY86HVehQytDmtwZGfwMFGoTK4Yu6uf5Em3cqrQgmYhlwdjFf1Xvzm8/aSAibMxPo9U3/AJRmMuu7bUE75hHB5+ZKIHSrnP80+GpqW4fLSUrvGXkitXNcwY8OeE8bqsnNCejVrNt2XLtMNExS+0qNOqHym8E+gaycr7TQrEnctjb+nxbNPlCyYrs901DJGFsimLK4/3OKQW+nD43pd5wtXVKHd7tdlMeLmzcavMvO4e6dsIdfTblDYdRRnvc+4wCscB/27VioEzqKtB0VWX0zX3JcEJ0Oz4/PP5c7769tsr6WzsZzKW7VYgPjEBq37rCugVVMVIRB+qUZFVqLGvuDj9TADra+etFgHakLQWRrz+7+3RQ+SZPMCJWHgMT6g2aUV0aU1o4aTPvVU6xoItVEqgqd0Srv8JJ0cu5IfIWwRW/YmqiDPMEL/uCZALgN3MmjTIWoFCgVLbyjoZQxlvp/AvG4wy8Ih1mwHLjq7kA2oAWm2Y8aDpWg4fwvF4L0BAwb0zQiP12b5u7TnCLY9UGDo+6+H32ocXSA5bYVuDVbqG8PTcGVvkgRSHq3Tud+kMGFt8cFMdDqyN6ODTWAcb6tPYosujdH8AONemH0BWa9DP++5dslaZdAm7tJjom73pyrJWCdkZjbYLaZTyOAJqUE7par1PvD/mbkRaE0jrldIoGw4SuUCu5SiW9S0tCApgV+5lGW8lTSdXJ8jQQ4UXdv9xQ9DbWCRB6cNeeA+B2xXAtNdT6ZwDqm4seAdXtor9z2K16EmB4av8MNPf8ASTQwaeKcaH0BX6HS4ameyIr6MqvN6tGcOC4ACmnearoIaGSrjRgxsHR8VGnMjV4QyGfVz+zO1aE637nuHtLNchnDWYIeK5o3ktuwQ8Om13oZueBNWBtUqD7DXNvoqXjdiB7CCAGtivn67yQc4GXu8+l1MhE97rnluF9V2z09fBzXhpSrgko4Gas18Cb2ht9PZyM7h7fPTo+GAFE9j4YKfP94/3/TPn94WRvlI1IxvUADDPt55lnQ9Ssu9Ppaxkr+lmFWlSQ/dvInT6gWignak9mCnO/e9CJD/MlSLptcQRaPNw77Oo+9H8RXpGsKzuNdvv1f53s7Z4OP4Ltdb43B2OVAl2bDs9OhiP9WsJrdTLcu6vfV2yZy5o/FeoWJYrW5SVykJMHbPYX+QsIwvuZFTx3XV4NFtvEPaUk42Ou8YMB3itTm0B9Y6dVsh3cXfhKWG6EZLW1OnKLYL0ubdhklVqXAfuEysl+do9kzmZp8iJ/ke2iL54tfvkDqPEvReKxxn84F7o2AfDuQr7Me3mSWLUhFHV1+10d3iFpoqp3y6WeUE3FbMRG7tr2j4f7lolz4Ox5Dd9be267c/cLtkB0REKod5KkvKW50v7T0SMlNiWzgkVlBYt39+/dvR0Qf3RLPUIWFmRIFf5FluXx0eHdsM+SVc17ML+mKnGsLAC52Lf0zGi4T8ZqcFGcX5TF+YViBQ3eHqxU1aXqRmuKcjq4GN9swF3VoF6d1RzcpA/KolbPcnG+ys95nYKMZjpYSj4vPtILtrRG3oA2OdajrqUzuebctFeDJkirgAj9h070D/T/i4uL9s4r1fvH3+p/JGCIU7MbyUt+CRfEbEhLUxs0iDOuoE5ON1R+UbKxcRo9u38xJiu2BIRLASSqbWS6grMHme0rdBNJBjYrtqLlwJXxTe2f+/20ZiWJomUjWlLJgAKqXX5AGg1qPq3E7FteKxb60KRqIPl5USsubc0aYydaCTAcvowGrZMcbQxrWNyDssg7VSJpxfgYjEHSClw2gHGI0gQd8tIqIuI8eJ1WgHR1Y9v7Il/wTG7IhtYBIzKfzd7yj6p9kFFJhdaIrFU+/YATKmGeWAUK9UQ4hflTmqJVyEVRziQXJ/7RJ4GbsOIfgW/DJFoqTKTe0HcZz4IvUOXvBhJk+gMzg3hH7X/k11eVnDW6DCteLbl4Uc0QWQ4DTGNTbt6nZVVzTNHO983qrOwe+kBDFbuGCiZPZNATFeMDWVUKcnaNAib3xLSJlxyIzISq/ByHJamXuUiokVQUvM5upmVe1xgJ2ujUZpNVgBYlXLbMPwLHZ5qrNG80xnbDNm1j3QjTomoJKaBr8rp+hVvNLoeZcKKiFv5rLZFhS2A/EI1BrwhV9iluhRurtpEe1F8bW3Z88qAs4aualVHcvBB5CRq8cbCqvn/7/Fl70SbJZqzNP5KL8o96L/EsgiKkqoFiEgPrPw+Aj+FmmErY24nZuv+1vVnki+bWjP4rot2TE5/NO/FBVFei57Zi/LBX1D1RqZ7dK/jMZtWqkvrLx4UKeJVw9VyxnNCoO96/54tCKS6zmgZTLFttELzAH5ep8jtXxmlxLirJn5YlP8/LGt0NRhmX2MG0GHApK/k6L2o+M9WPwswWH5xVhT8o/KlVuFPC7DzFwNS7vbA3XlUDLpI34TJiWjXcfcRuwtlP/fRlJ1G6U59nmYL2Lt2/e2gY8C1CRN0OWyc/J32i4svw8SKuWCSd6uRzmTzzui7ORW+aC5h2Z7yHstFZ76pQF0iq9irZcwYyHjTcWeRtuu0t5DVy06NSYu458qsMszdN8rNpgtOTn9w5ZQlopSZ3Esa6Ow+2KxjNk+FpG6RWsRsgeIZjeX80HMvdXaJOkvfJri5qMJfV4qHRd08lOTV+RYej/YPDO0d37x0nO7eWqgigYaZdVpno4JZ4NyhxgwVwlXQINHfG5+cXxR8fyoWoln/KWiUoBFTwYbf1jzjhxvan+3vfW2CKmUZ9DxjvIqia1z33F/2UTKJvAvk6OKay/YvA7RrDc8VG49X9JldmvNrd9cmnQP9Y7MbUpQV0NiK1MKPQUGrlyRS8+U81bJsiNzVTaRGyxYbj5X1XynJ3lwibQQ3sMcwDniAb/CUevhyZI0cdzjc7rrTP82W//zxfBiKmqntOPOL1VBZLVcl+X0w+kySNs0xAHJsQTYTngJxSdeL1VpDhBP/qtAWkjfKyEwa0mNsZvOGq33/DY4nZZ5tTf7Y5cZZRc1aAm1J2NgdB+ielb84U0kZ5ueYsu5pjcCP7ffPgv5u0QmAz1aXMt2WFvWMeGlnFlXJZXWzL6jWf66xe83kjqyhkMOOSz3VmM2bQrYJow0ahi+1mvfT8NlsVesn0QxCHd3N63Y5AHgo9a0cY3TN61Y4KeBL0cVe044HRj0ZtLozmtaLvW1AfmkKmb1oRsGvRB61gXfEPWtNsXlaVpA+7hubb4vypUBP90+5mPQ4vbz+n/+hcWBjpYXUaEYNCacWEiXlvD5Qu++mtmTfF6Y3M6YvbvjaJA6Bg4B5HGQVxjLGnHneHTNopdIXffiFm76tOgJrXfF7yqZqY3waCctaJqwyARCenA2cRzFhzOkw6Vd3dBxuseSA8fO2Eh8COHn099HxprTa5Xhu9Dn4AGh0jfrBef9QHztf8a+o9ISvDrvwacTK1gR4+3hxsyC6o78MLmOeNuxmw5iRCtveHdI+T7IPVLAB4I6staIglAdy8a6taUJnzcNf7WjvTcRWVNPmq/x5sFAbJrgk1Pzn9OnV1/PrcJKRfv//qa61pGuelXF4IB/0crRqPQOnvEXs+mK5qVS3oM/Zn+ohMHmFP+47+NvYmnsyq1VmJvtlTOfhzVSn+Rl2XCFQ0+Y/kP7LkP6z1Xk/s8l3hqe0nwdCaipk+4YR+nUAz+pDhOAk0tD/5j3bSGHkQ9DrYL6jx8aJjofF+/4XWhPK5vevMzWLZ/eXs/jS0+lPHhO/+kIdqIHpV6lthU6kh0kXbAXUzk1soF9/5w5OmgVHiH9ZmkUb4345C7HYobm1BtTIv3qWh7K/SmiZ+dEHrJakLcV5y6KU6GPh+306JRkTnzeU/9OWtF+bdW6xqvL3YEuDWYjP9D+Kqs8g/6vmiXaskMY6d6Z960Eg2aYWAynErcAc3kUzrHLBWNLm9Nc2q0V4x7y1ldVnM+Iy6Bua9ZVUXqriECaH4OZe0Z61aKbT6H1D+P/6DGJ3pHWy3Xp9PRQ18goSs1/UgCsKp5NHgLKEW4sWxsvseaer/e1zG79vq/w8lV/wftPePeV7W/B+6yv+hS/mPfyR+qAox40LBCLkO1UH9fvK7SqKAndSKvVIbSEdDkDX6NPbpm+FnBsIU7KfU77+rhPZyYTu8901vGHe1rTOee8X0DV/meDLjnG91az1opru9Qq1cb+1arJjuXDMJFqxd4jg0QrFqa4m/53cotSXwnkQzhXlelFPlSaAWSZZgFZJxl9W2S/1Dan2utBahPpjD2vVGXw+/Vt8MJ8kwycB+e6zdqpgDwKvNLyavU0WvSHaFQsez4rwQKsoZPvzoP9xNRBJ//JFkH/Gc++CdvdeDGV+qi8mdzDxFfQjOEAQbEiq+YR/6/Q/fDDv2cdeUTyAYTU4sJmtyYtUMsU0vuzja2Eu/K+Txm6lMJIOQMS9rjtyWdjdyN/95MP8DSeRYsjd617c4JDrZ7ojQpJdYA6Obs7zmmaRLyS+z6ANMCZoRKaoshX1SkQrdFhhPY9+nFVXkG2YhQJKTh4Wcrspcngb82kep8YNdzFPA2arYA11cRYjFfvJusm9wJOyIOI2ojrMnLYKDJT5lKBxiBRW7I9Ab8YhGtLZhkYKZH85+f+edc9z6c1PQrl1mkciBluEIXuomnbvz9uv/sh//Xv9nevL71Venu+Rrwwdx3KXRaai6uwHByY/s11TRR15X2l4Kk93050mS9ZLdn7Okl+aiEteLalUToAZPk930R6uZNJwkvZtesmuG9Uea0B4k6m2SDCjBYp7+6VrJOXs6iUkvBdVPNU3yezr4z98JeX/yX+T0P7/62kBBGLrCLdEWSaLW66cTzrPvUs6xwKZWbicd42kYGB+/ebkUICx4rEUgDQoqiPEE0zZFVbRweIFuzzrVH865eqCULM5Wim+wpxwrkrPkfrL7OO4vlx8hVHKmBrn9utYCbs6GY8HvS6coKThwMfkuS3rJruQngp/idNpNWLL7bfokNWF4oSXUUj7UC03x428SasR4KHPp98M35/grxbSDwSAhFB/vf31bE3aTbxIYsk/Ycrtpm9zcUjdbW8Xj+dp72e/vdDs8GY7VfdcBaneX4BYC4E80+V0kwUYSWiSkFYedNdn9Ka04fYlzPUtO/PSuuJvfp0lzqrXoeuMF70vJerdM8kY7k2m+qnlSCJ1jiM66k/pIUJx463XGMZhMoENz2xXYuODogubd9Bphvrk5j5bzLSnf65/kBIs9hZ3jUaoG+EZozkmQEW6IrdOt3y9xAjzrXCfq5Nlpv//c7mbPQYSkd+8Pe2JDwtsMUJvdeQwKTV/aXFxA2rlt5Ov157YOuP7kzrURvK3ayC1ueplio73keb7suiy5uVDwAHCnsKObxijcBdeH26NUUgXK1btJj32DY4B6FsD1/y1NnufLhOZ2O6UFTPGuhq++rOGrqOH5X2z4G65ubXgdNnza0XByU7tmh618w1VCV66V9bZWLr+slc6GaanbObfv8y9tqWErb2ut+eTvaWIS6ntMs7rzL6tuUL1m9f9CdW8ZnLi60Nmd1b34suqa3C4+wztoF/yaz9sFt3Zg62Hgr2zBppzv0kep/lw7TP1SumK93nnY2caHX95GKPuhncHk8810Hi7+pXbOXEmfLcha1/yL/enODl3Uzq1laf8cf6GkiKAu7RlKV5y9mrxKFfESTu8VWIUzX8eu15F9lfuKTjnrSA6qmHi77blcE7rkbGcFtev3HcS9xvvF+qqJYcD+AjvUPQDjzKZ8YsTqQDjTOWfpinfiW0bVg+KjACTtJu2g3aQHGZPdFOgGKO2keXqfnNKl1pWc4g+hcAXQBz18Og4UbktHUsz5bnKzSbKX+gmIp1ITT5skwyBPJpQhQWF3HzcrtBrOz5/TaVivu3j12K8A1edvg19FeqA/B475XJJfgiwW7gRz0d97Lr92jPNy7q9kJiBVXsisUS69idJ9gSoE8D14owz4EdZub2/kS/shKM1cr1SLEdjwArTXSkEF0t89BJ7qLSrJewDklk8Vl8luKr8ZTZI6Gs8f0mvbN0PaLpEqsivsYH2bxpIADpKA//j991MUA/z+O1zZKCBjAL7FxxFCX/xIqGWtRp3/o1dWa6AIgsrpvSw5S+gxOucfDbNEJHS0nyXzhI4OskQmmxPl6AM5SX7/PdmVGUCRJbupuj860qyehOxemf3NCaLS0VGI3fKdnwNmBabJLgdkJp/m7+FU24Ur76S3CeJ/sw7Do1Rpsqt2E9K7SXZTMfkphXjLAJF6JeAy8fn85KbAkDE3yPYilBhREVxhdmGB8MtdNQDuiusIu9JgoSW7kuwGaX0xv4bajiDioMIgaIOVlinVz2M7vSs2HFf+XlWBDsVJdcpgoVVkolJ+UoGDkyxJkAGW06JLuvhy8hIUvnWBT8lNDrottoyaDcf1fQv1Oa53d0l+knyV7BYntVbNON3YtKXe/KH4Ep009PtmhM3JXaLNSNnvl67W6/XTfl/nWJ62xCLrdWqFdCf/BYyUr2lJJkaBUaUl5WQX+SLQ1hLaSjITWzYj9CHX1X7iMaKH49X9IlR9eWvHrwAll37f5A67tUoxbDc5tQXBO5blWDsI2Tq6E6DzorKvF77cbEJdL3LjuceGJ+/hf3oXuVYoPONc9JwWl59Gedf3ITLPZ3MoDC2lgEfqCrabpA+BrQm7E1NW6/UOnPftL1SQC21+HgJsYoghw2QX9iZKquNEcQLtuQASbDY7De21TlMXX52Jf8wqTwsqVmmUz66vw26dhC9Z7nOQLEesd1xItAQHKmBxSKcITG47fZmSm1W/X6MW586IWu7NpGS1VTQuSQYf0dJxduZpuGnOU3NXtABWRbok4xVgj3kuTOk4MGPNnAFNXajVeHd3Cnjodb9fn0xPB3IlUjLGAv1XGw3uo1vQoL2BLAs7oRP4SgvBMS3c5cH0resbGeVEm1ngiPh7rYomiwwnS5AkjtZTBSYK5OM78SJQaJ+vhNUozYE3zpRPNwM9UTEQ/KN6W0w/dFmmWde1aVOfbm+EvdAM/mZEPNEyGsu2Hp7WfZR7o1NvOXkiT8el3pCgRF1/QkfAc3H76wo83s/Jhl6E2kAr0VLunq+ERYyD/vNtJxsqBqpQJWfJmayuai4TKgbmke0MqRhwcQmqmmKQy/NLmOhicMllXVSCJYl/qXWiSrAZpJ3NnhW14oJLfK/ElOuH+Rx/JV9UlzxKo4MelKUNrTEYdIbxYSn5kos4XxP2UkzjvEqXRZuEBaVhYX04RPGtLdb4kbCJrd53vVrCHo87LBWD6dWsQ9n96wTjLmaF/LJCMOmWIlaLvP7QLgTN8I/370YwjZ1GVneGh6NDEp9I8fmyQT/LXP2SS1GIc22SXtFOxeG5VyzAYinC8eKGUewwJhxmmG/lw7wEyDkAclmC+LQGzfdilqtK1r1ZIflUldet1vfOrnvqgvf+AXpLezDB63/0lvn0Q37OB713Nff5DaYXfPrBvaakpyrUk4YMFoPea57PNJWeq96FUsvs66/nZ4MF/3pV8z38eM+XkpCxHqgaL3UseSouc1nkQvV+LqoyxzOD1sEeo0KASj4o6tfaq8iMcUNN3uCayzjVwk54qKoy47jnZpxqsWDGqb5+Z9z6QuJUs1wzTnMBGWBGL+eZosZ2JOP2CRoPuv6G4MJEIAZw2WJIJbj/xU8UrS9y/OUf86nKFI37M8tpa3pkVYBu4FIyqR343Dk6jty9uIkkU5yyBExNcF62VfJ94uTN44evH799/+jl+xcv375/9eDNm/dvv3/65v3L1+9/e/nu/S9Pnz17/+3j90+evn78KAF097vHW3X8t+pOSvb13/aHX5/Dne71k4ejuwf3EHD59ZOHB8f3jsIK3cz4PF+VKqvovJKLXCku6+zGfJXd4r2FSprswno2uXaldYpSmw21WQr3QYVY6KMIBrZ7ud/bt1bLd+7sH9wxdu7QN6Ed541uAQwu6mZklZlyxfw6E4iVv39w53OFjfbvameKn7ORyGO0Nlqwm7wsq6tHlaqznRHFF6dN6IPe6MrBK3z3rFgUKtsfUrjK1lxlyUrN9+4l9v0NF6oQvIQPptVikcPDjE+rGZeZGOgnOuMl5MNllvQTqmUcd4yNzE8rLq+NVc3OiBZCcbmUXL3QChqPhSrQLGtnBP2WLziYMGKtRvxAdyW2EIxs6LLMC6G7Br/Q3fdiVZbf52KGOJw7ow3dAgJsZmj6df//ScH9wPjrc5p22t92WU44jRtUtQHO/YaWW5Aou/wYawykxSLv9z0fJqEJOK2bcGsKQROS8Q1dNeyTqdA8FiNsY3LgRnsSNOz3QQoOF0CUfk6Tk69Gp5Ad2Nanv58YX5zI9aiZ1BoEoLYRR2oHkTnYOtST3NibD6lWJ/pIspxOzb17pclpOQiHBRxKBshTfgZTMKXdkYPGzLTmm1NNoq3IJrR+CGpptKLSmhW2iqTfX943ScZYmeUuG9EvrlF9Mjo1DRyhd6EvqCB843Qm6n5/6u+6trNsV4GsktD2QHrWhJioDFVNYVPx3p7G+TdsON7bs1oftGb8JD/V8HeA1gT2A3IQLA5SsJNTew+qiNGQYXFHWPMG7fLM+Zc3IC/JCWZsPSiAW4zkNAoKyPNJHfYbGM85IIZ0BetjHFUPnIruMLaa7BT1i/xFCtYv9Q4a5ZldegpMj1W/P/0G0OSij/v96X2Y8G6/mqTQWgLGM1UWO0YFKz9gMLCKZAW7GcI2X7HCjle1SadmFDabz7h8aUlOnPsW77WNcbMFSutfPQ7r9LNsYru1Cx/pSGRCqEorQNpMBkmgbrRj3ZjUYM1htu1GYFFXe/fu3TneG0Ux3SW/vdBM15qrntHEs4p2vFAXXPawDNrzudKmrZxR9rH6UK7ESWGfMhdmTR/9sRV853e3YhCcaztBTOuIa2vp8eZanrRCsqIZEp2TW/PU8ZPoLSvCt/CA7VBTC6Zz+JIVwYs7lVXrPO6qWCPNpBWSFc0Qc6h35gYxE/ObFfrXnf2dekEmcuKessI+hTRCW9/IRQLIRFFr5fI0CCeT4CUr/LOhNjo6GCPW650R00sSVBl3zQPmsFQXXTSKnoCtiO2US1fnbUs82R6VFVujmpRRR2vjFJNmQFY0AmLKaoTbQxAUU1pdLYwOlvg1K6LXLiqtK8d2sklXYFZ0BG5AmOoxITQQXohk9kUHobPgZO05OunCwFgBp2bKVHu6hFTZf/0+QROTjNMlU42B0JY4DmKmOUyAsKJJQxVM+iWhF8B4nCHEhd5OgSPdXN7IL9OWwXPLI9vdlQRh5E/kqadDV2p+j2nzAHz82+P9vx0//NvxQWKSTmbMHDKZSbF/9Lf9g9FwODz428G3Nlm/n85YePBATSWVzJZPxlvqBBzSHcaMtHBBwaQPcqTX7NLX85QlhJ6xPVjV15MggiUku94djTHmbJIumLJ7T3pJg32IJh/4dULoOVNdUw4mA4jgsjgHRwefke7MxGCRX5/x5/kyLV3yM9AVVqTTo63PnMc5ok5jAhcMQs/h1rBtW4hPeMbYrN9Pz1mdnhNCg645gU5DtN70nOXpOZmcnJ9m54QaunhFF2SyOlmcIlTE4qwQPIVXek4yDD639NNqg8pbOJG/aEnReWShPYXZMBxfhCNvBnzG5icXp3TBVumMTgGIR9L2KiTjJfQ0l+c8XdIF6GFbxSAEcQnP5mWGzVnmU5UuEckObvKfu4Ef3D28a2DtzGXcX/dp8dl7ec1uzmQ+/cBV3cWT4LtAwG/ssYs/CQWW6JTXWbzFBJ+gTBa+k3zJc5VtAasvG0yBVYfpK+wldNqyLIVLDF02COGpYXVzWiKwGUC/EBhTxK/0H6vq6ZuXuih6wfKBYevQGbvJZ7MGByBiU3wB2yFmLnAB6wR4AfpJZtVAP5mAn2H11C9FeQ0fa6ZMduH5S1k+cM81zDjb+cBO4LJAFBZoX1cnz526Ba0/FEvYOG7jQyzgeDhv2o7ltKA1naIbnHN6Sa/pGb2ij+lH+t7fDd/QB0zRD+w9fciG9CVAcDq6P/3APiCO6gJvrC/HegX9YUL1QfgQbsLukz/wDvMHY+xhQP2/zsW5Jf8fXk/LYtozikxmDxq/ZDvDjaPLban9fvqQDbdZCVxMHrCLVNIHJHsQ6h5Bp04esOv0AbEzH6Rc/X6ZPoAsH7DK76EPaLpFYybOD13VcNwrjfnOA2yqvbb35v3+zuPJPJV0ZiaKpB/1tk3PSCbHD1iSbDqsdtI37AEAYzdprTfrdZt6eeNtiOOEDVucN+t15TwBpA+0/vhcj99T9ngis201ReIm7LXHfrK8sLjb5jL9AFSXE0LfgljpFRuOX91/4TfcV+TtLkNdjVeoCEZB3ySdpy9OXp3GRetZQM+crP7kKn1KdtPCjhmctS+8nvapVl4BHf23p5vwEwiCMh5sKyBILm1y1xpyiqoZr+lzw3lyM/KB065u9Q/UkLxmJzdYSPbAW388MKAgFAwD8bzX+W286U6ZXsDHFxiAo/MoOsgekPFrdj55NKgrqdJzkj1ybKtnLOoeW+5E4tafSfotG46/vf/aD8i3evyfsNcn357ST23/1U8C7sITbfMwMb/Zg5Mn2CU7U4v+zD7p/N4xqMWkY4Xmkzx9Rp+Q7Fn2bDe9nICx9JMMjpknyLMaa7/Rij7UV/o/GYi6/8TABX1P6DJ9Tnn6ib67ZTP7k3ib6uefYbbQivGI59lgucwilouZQBHLxYV1slxM7BYAbHE7yyXU9kIcxFlIc/8fzo2R/jhu1FafgrqjjZJ+eDRSl6K7ghbOSyeydbRGmtBv0hWyMeDh4ckrAbVtNpgXpeJWsTDt5CroJOgpxDzDsZdWzL3SJpnRybaJkkyaAdmsEUA/w5iabWVM/bu5NrNmSEATBVVzgZOZf86CcEs8ddVCR03sQzaLqaotDB8T6T6Tmd/eW+TY9mJ9okk7KJu1gqgedyc2zGRA34kGGddZ8SjJpPGezeL3gNbraoOLnQTP2cw/UzgkttSjkmqifzTOyL+ZVzLbyivpqM7KrKdJxdIhtW8kTRJakaxMXQiiuLs33GemdG7O5pZ5QWW5MazySqFTUCxXmtf5RG8hhejVkygow21T0+doLTZRA/M6ceFZoi9FSeaCsEYXDNS0oEaQC1IGr6uVmL2VxRIz6zJVV4M4Yffe94840T8CsAKTI+7F+VmNFv3GKN1TJxf9vnLiQJfNWK7XqYzIjIoQusLZATIR+E31q1ffvoSzmV6z4fj6vvQ0xbU+Wc+YPLk+Ha/8ZOz37WCcnJ2u18t0Ts9TeKZn9IIu6KpjxtDge7qye8XKLXqt8mbmg6kwXQU74qqxoFbmJHAP+rvWOl/ZnY9eEo3DcsXmmnpbBcxh+pjh/X/V3OSTCVCkVrFi1dxHgdsVc1BcksnjXdbF5+onmY+xPLI+uOP19OXj3asMHZsjA+Gz+gWatfCviPxDNSIzHcACjWpb0v07R+PdXUWMxVnyt2Q3jbXMG+rlIcZV6vVz+SYltEm2eUU8nMlfwgoSYOTrNbF3dwXx5MiJANahPBGn+BxgH4c6Frg3vK10AVlN27B9nm8SriNFBpLPVlOeNqwQbRPB0kEBTcI3BLQeqeGDdWbtJa0aYtkwmbJus96Tm+rsj+ymArE+KEVlSZVsTqmEgdJdosIu8cJhdSJOacGqQXX2x0mFcFOn3hcUtqsgtGTDcekxAnd3S73yV6wGne4pA3HouHWlmDqB+rTfR56pdAxDxCY0sLdY+UJXHEBRpQ6eEicDb7Z67AxBRmNvKoFwuFQyha1RujUafl8G9uOg0k+1yn6wmVV+osiT6tQpmMMLGUdZMrHB843yjZFVZV3eJgQLtSZ2QVuip6WrjQ1BOuwIn/5v1kPBzf7m6/OCrgSvp/mSR9rauvB3r58+rBbLSnChUtEyZ0Tddy46qglqgNtsKnpaC65mvBsRaVKzrfBrloGVtRF/EZfcKU0Ruq0ndGPTmgQ9snJdcohd0sG8SfRGmuw6RYHAmcHoiOwmIEbYBF5hSmBfaHODYH4b7e2p0Usw9jcrMj68AwrW6/Xhkf49Nu+jfRMw/YYd3kNtgjt38e3oDr4dD/Ht+C6+jfb31+ucMSYGRmus308PhyZvWClTMil3vVrEimTT+6P9exAIYJ7Z9P7+8NC8jo7319Nvvjk63S1ORvv31kcHfUxx587+8REWe+fuweGhTry/fwiJR/s2NXzZPzqIP05XwNqbsqM7dw6OdtN0NNw/6E/J/fuj4RqfGx1DqM78cIiZ3wsyH+0HuXcVRjyaKHUOLLtMILcYzvb7OzspDw37+v3o1THDtoWjAwpqZcIdZbcgyTpsJlvTf0Mtp7Gxw1uHIMEB5/Zpd3SJXTYi0hrY4HkVHFjeXAOKAVFF1kQPM4pTHk6vi26WpjZwA7dAMB76RnX0tzUPOFFUno5ThOiJjmYQrzfVm8Ar3Rb9KH3RhnOR7QzdVFCbz5fvTkhpXX1ZfY9ers1ec+2Nu2B1qqgghOpwCJ6ksgMP2GLkVNauPrdwA0yhclSHsW3R78uOYDmBLxhPCxyMzHXvBvl/GAkep6giWXjcyg4yQnlsHgnVMK0MamkLgx9aYHkQUIB7LFqAhQU92r9z725b0zZQiO6gbLYRioHNaCfTzaDXSUCuA5GKYGK9TlhiAOU1mF37eFivu46i3AwvHqJjzqwioxn3mo34wRhQfptsdOD1f/yRg3pXWjP3ZmHc3FqrQfev/KZGl9e1Pxz0uVAGBwJdIhdyxvjJ6jR0I/63/WFC6ILNHIUjyHjxDRtO0imboXsTJdMhXYB/NPe+2B0RkkEKAOUFRT7WcaajRLMjfEmoSnM6J5OIaE/zk/kpmcBfPecuSAYv7AT+0otT/XZh11qOtwjwuL1FDTvClrgq0JTHgWxO85rbgcwc7YCh9ubrgps4ZpjKDJpNVNRPClEoDk5wOFysrP62c0qwGd865azxUmPmWdENkCDc+flqLVs+CRcjb8KSV1Y5lovWcCi4Su8KuzrjMQELUDKBv1uBzvPdzkw56gHrC6kk2bZUkH+UsJpsr+PWksyd8u7R3a0mKlanQGuZ1Bzd0925d5dQZS7XRukB1dJRxH1wNALbgbtWE77znvpZzH6/VWGv3U4Hd5HeHaQxIH5tYoz/IN+OXuLbMgnbHB/3Cv3/6kYKEGYAEwu2mLxXiNYeqNAPXTJJ4I4I+ip2q821IC8VqKdLrCRG9PtG4VUAmq/AbSRnFWhXC1alwgktc8cjE2RaCVWIFR8XeovIURwm3PlrTW8narcwkqx+grOD2mHvvImCZUZ68l9s8v/0T3cJm6Qn/9U//U/QBgcPPmPFpNar5mRsV5JIAYMNXWGk6mT/lIxb9S3W6xwd/qzXaYXnGvHatuAL7jByKIsknvabODw6vmv8Bx4f3kH3lgK98NTBJoJOOXr8JiANHaGGxnfva64e6W0I9gQaiYg1SUgwoVbRAt4G48GLNuFbrEpVlIWAOPdM4a2uVnLKHd211VFKYJ8VeFSV/Jx/XAIz0HxHxs3KKLh/7zCmvMJOkZBmtTpSLRKywVSq+sBFrV34Rt1h7TQX+UemZyQiJUzwr8Ft5RFg+sdJ4z0bDYe6LmavR50BpqLXSfyatdIPpmUleArbkMzF7KnAlQSpzCtzEWRzzgOrPD3GEBQ0lZ6ckg0GBiJEQSuQRY7NKchxKukzsJcPXr98+TYzz9+9fvnuVYaQFfMKqGE++/Z6veYDUaknLsDzqmEFwZ614IszL3ZEYuhcVqulNtyHsyt8Z4aqRP7X3ghWfJLQgg1pzVLJuPXJMtFNhOa/4SX6GLFRJOPaHYvzV1/cr8cFQBjsMt8z8qQ4pR4ONKxqqk6iSp0y2HPGpiNevXzz9O3Tly/c+W0j3jx+m2k6TJfCPy5zMQvcElrL2UmXpUyrQSUeXjbz149fPX6ry4WerVg4EVI+WBSC4twzqpEYsmsnMvrc++h7c1zcr9pdYqDug14Rvvgnj18/fvHwceadmOjUe6NTPAxMwoffP3idaVKzsWb7fd9EwDFKiRlEVb0E2SyksVUgmXkY32JbtCKbTfxxoP6VHt+9D6QRR97EZO9gPzu6Y0OOh5OD/WxINkFl7KU86tchHZFNOChbtGqqibFOShufe/MUQjJ+sj32dOPniwaGgZWIDBWF1qXQsY6txsGhk+2rcVfy1w9efPe4mR66j8K9noxvSq6MOXmFaxXetYIpH9RchcbhmFY0ZjUkOpHGCQuIEOBgbgw5sRkDVCW/b/FGxtxmKpkw/cbhUGvPB0nGoN9aodhrNgOHYhu3YkWlJlt3TbiRKJkjItP2vVWridYauGmzcespUiX0jiNSfIRU1SIl/5mOdtUekLPnXPXC7JtbsdT7v39er/WYHOzT0f4R2dTNDKwPKvMp39QqV8W0B2Xzj8vUM2W3AZJqugs11rRlAHwSOOlUeinyj8tJKjAd8lkI9RFMkCwVQUoyaByV6PhRpMRWr16d5zIlNy1fHudcdJiP84Frz0VRw60ezMn37+1/VuEUoE9gyiA/j+bscP/48Pjo7v7xHXO3Rp/Vx0dkoDliaLx3PpjK66Wq1mt4XtQP8W0M4BSgpPcax1VL0ibbVG9gsX2Tb9EHlPzPFa+BilFV1Vvk4rqnJ0vv7FqhUbe+GGibl+k7UedzbuAr+DdDxI/6piKelwtiCj4ud1lFWlVMpWEFl7TcrYhmc/U6km2Dp1GeTW8RJ4J7HLlRGq5Bu3F3nLqs25VXk557w6cryW3z9Z24d84Fl7kGhOm0tC/qngF/GPwuwLz+4YWswGfek0LyefURiMKnsGQFV73HH5dlJbnsjUYJ+ts9PBhu9fYa37Vsw/9b7lq69NN1Sr7p1DbuRCu1xfEGGJ0L32EtycTE1i1zBVKkZM2NU6sz2Xtmqmjy/j2vn1ezVckTapT70P+eGjysltdvq4dlsTyrcjkznISxFqfVKToqPz4EXiO+7Q+PDocEbjcnxq1nUgnIAx40BZbQxHrIS07HsWM93xV84Os04Zkza+eb0G9eYFsY8zFgTt/miseKrG5LA9kohGQXRg6fdjnr/5yDK6jjgDt3NqhXqxndRhUcTSfdAnKtW8Uyz9FYtcFZANLYtF9fPVyKE3U6CV/AFkf9bX9iWdKpBADKLidxitxc61WCqumEZJ9pYT3pmlcFr1NOP/cpSCqzoE5bKtQ9caGSn+t9aSBhHV3gO3jqtuzYssmY7+L1p72935L2ZkPz5jyEAdSijtyLOnaJZDmIov3VUwIWNd73JYjrpRfXVxss+8tmdP7ZGf0XK/RFLpocggQqEboWeKZFgDll+3EnjWjkbTqRD7VTSSigl/c06yKvA3XSJEKjaihSAMEaE6t62QNtOhbBqmThC1g4Bq5HMX5naFWrC9HTUqArWSgTR+i2GSpgJqBtdBPuyWLe2hGrI1dVIbplkDrwRcUUCOQvGkiWMw8GFZy/MbZ904fWer1jnvwhE+Hit2IH9UW+iJJ0HF2vZPXx2iPjB4zGrc7q9ERqFZeaD+jJKQ1JENxLQxjd0A/luKlM4bga7NKcEObwqNilpjDDM3YsWbsegro9lVZGtAW3FL2RQx4+gavAQkfIcA4sPMcNNKYcW54JdHzfSY5Fok2nvaG2Gb3L4pLPekGL6t4iv+5Vorzu2YWJxW7xznoeQaCem5unZ9SERC6fc8nF1BaOlNpFXov/MJB/hSgU6KrVfNbbA6qOy5REKbTL2CTQkHJFXwaUwWX3apl0unvLbnfmtl53fgXNppdR46+tnosdAQScnGw/lQwNJanfVMC+KtxR4N3uIEhsAQPglEnKN1rLMKz8TusY6gIEdvpHW+bEG+j3Hv+4lLyunfq70X0/485Vb7i5jgPmJYu10LSWmo2kIT85Mx3AwyY2m7/ZbN82kwBF+cbnMQKatN83mx7icI4duiWt2SwNnfOVZsnzsb5B0pIEqIRNYorKAD4P7q5akUyfjqBE58kp4WTS1+l5ykFvJS9LswvUFBk5gbgeFH8q8bAsph8SGtE1FsQXDtWaCiYHQDTTgsmBJpvxYur8y5cQrqloumKV5VkMHpoEA1jcaU3oFLSNnZUCSQU0vej3i1TQKaGrfn+ly+y8c5i4galzv98IAGYIAXUwuxxYSVPJTm4+8GtQHRYzLq0//8bua9gcur2KpVw3mNvmOj4t9d63CZUMaLUCxmRboz1X0qdALo7x0JIKukpX4MEYbBs29Ma0RTN/zAvQiRtAWwWmVaizQQWGUbFtxqrbZmy5SX2lXq0kd/I10LRr3rDO6HV6RhOTHkqoE6xutbw2tgnUdJJ53RC43d6781koLLwCk2aJY9GqgwiA9cSG3jk4PBz936vzX7w6P+Jn1UpM+VOxXKnPXZyPR/vHR+bibLDy9N35AhhIibPrSxaFsN79kpkpwcCUJjSZV3LKX1SqmF9/e/1YKC7jwJfi23IlMecf+fWj6krgswksoKbgUuHfci//vxer/8GL1er/07yQ6f8SXsjqS3ghl/+zvJDV/x5eyPL/L27O8+guPP/v3ZznjZvzxf+9OX/+5nzevDmf/6+8Oc86bs6z//Nvzovo8rj433dzPg/O7vP/oZtzzDa4/J+/OV//35vz9puz2fTSVfPmDDCh41j2gNHjdgf+Gzm3en2vCL1MF6ky92gdyAkJ6d9Ig5QPllzWRa1SKzJVg1rlyqqoCKbMjdVRy2Oc+G8gUWq7eKByec6V/mgT7Y/GXCzMdWyd5H8DGKMDgfQ0mP1Yq6pvKuckwUVP0yncObm+c+ryMhtoytdxuk5JssETGv/rXiERsR71Q6IpfFSb+sCvodyA1k+57x17fzf5jLVqletFKlPeLFLfCaLyPps9fPP5vPUqwFwK3iwDhNz3h8T2YIdaACqgOlgRs6Pa5Gowq3T9PM6IbHBDGm0qanNTA8D9nRH1WcBWRmCltGvT8eUQWwoao/NyVV901FzqmBQTTWH9lGGqdlWkSZWC8kPQg7aCSWvS8mA09NoZc3PIGt6BP2bDHIOBbQxIPMaNK6fW7wmrDc5IJTiIvrFN7Pftk1muorFcq47lKtxiqybRgGTB219dXUIvLmh317BjnZhJG2gkYoDTzjXvgPNjHjfj2/vIA61HEx+uHcrz0FYBD21qGUSPitm75SxXvMVPM3guWqcqaIqlYQM+G9TMb4yN2tGK8VZYbltJC51RuA96w9F+P9c/hf7RSRvbrNwQKoyWFsQ3OkGgtU6j2b8UZflOLKqVUB2MRMwGV1K/759T4vK5nQUJBEnYPYLJgWG50Jyl0q0cKk0nSD8xqWx1lhy0uS4EGbctxgtdMel3YUT805smnaOxsGa/0AtWphL4nbN273OWT25cFpZ9aV432SqMXG3ASFyxenKji7HJ4XmTLV3wcmNBqxdsPrmRfJ7NMajNU8XR80zVaTq1y/DCMVYvvMqyfTNjMcPVqghdWFbrsoPVuvyXWa2rW1mtMUfuml6m1y1Gq3VZoZlhCYViMqPL4nvW8mF195m3cOOgbsZkQ9qYMagA3p4z2U4UqmcMBNp5EbJ7736W2YtMWxK3eSwafdBg9B4dHh7e+1zGmnGJVgb7dw9H9zSc49HBvcMhGccuvTzLJgGfJnX29deS51P1Rz2o5PnXs2paf82lrOSeAescXKhFOSmsNxOW7HJ6m2OiXZb0c3leAx5nl5VP6LLI+dF+XgjYe2bgdGWqeliB3v+Dzv7GvcuiLlQP0SF780qif5c5kPcLXtf5Occ7X80xXFRib2Ezm/HLHheXhawElIgf44eYf93LxayXz2YF9E5e9i54uZyvyt6V9lhSDxAxb0cYYloT0UW6v3+XWLs/ILDfcEXLyHub4fxpbRY6Tflu8jBfqpVsuFmcehZPecJPmaJa71aFerc16tGqE36qsUGWbKeT/3FViFl1tV67U1IHDGbVFPt7e0y8g4AJ4Nf/dZI92Pv7+3zv0++r4fDhcA9+Hh3h33v48gRfnuDL/pMnv6+GB3cx2cHdR/j3yd7vq9ETiNkfDh/u4c8j+IvJ9kf3IObhEF+ePH7y++pgOBzt/b56dBe+eXKMMU8ePYSXR0/w5cmTR6f/p1bs973BcO8Yiv72LhQz1GUeYTEHT7CYw+Hpf371Nb34LAjKjN1oXM1xyDjQmP7WdZJ2yjWd8qWqDUupZvuMga3Xgf45hB/jvUspWZytFHr3Z6IjsF7mU84qa5JTq3e12+GZpO50hnfMxBgV4WXalFLnolDFJ/7u9TOW6yDtpOvxYqmutU0AKzYa0edmM3Zqh70ZnEiyWtXl9RuungrB5fdvnz+zOtaoGWtfHoKfIT7rFS4VKKVKXtcPK6G4UI9n+gAyrodc9PfXM63M6iLUdckT65Wkl3Txd4HehsUJS/0cHbHujChHkzZ42hkh0X5ykuixeKiRdRJq3vcM1E5ySk8SvHJD5yVUP2MobLBPKiMWMiFq+fjPVXGZUHze4/hyerqlfrif85Ph6fjyRNmqKjrCqp6MTlu1TaZxT4HkSubn5+a5XvKyxG52Yq7TL+iafSxvoKpn1ZUF2GkXna9U9ZpfcllDUfyj4lLk5WuubdCcFyzsjumqNlWCAeQS3L0tL/K/UJtm+QkiAzxZleWbqeRc9PL6Wkx7UKknUBw+vSrz6x50kazK2k47+OWyNyuwSjP78KqYws7+VJgHG/6aLyrFIaezfPoBEQRfVD9rF2a8d1HMZlz0yqpa9kSlhXc94eOrJRe9ZZlf109FWQjekzyfvdTsTt0/Pan7cNarp9USfni+KHld9wrFF28g7C/O7IMvGr6pXn0g91yVqlji6CxWCoNqtLrhsy8aoAMKAMcdJdizMplVV6Ks8i/L7rB7YSbTqgQNZ9BShxoWn/QMz8UX5Xq0JVeJCNwgq61VLtUX5XXn8x081vzSr09+38tO05N87xN4MvLHwFnI/gXHPhGalSctrrwjHqNP1zhjAOoaNgs0kBxb2NFqAvRBhZt6tgOioX1HjSBvHzhb6mQIJmQv7TPi6Qv9hsZlL+wzIWAR3OnkSV/d1Xq9NVZfYDXiOdTHi2hiCABlIABsRglaQDqtASez6UIEwCbawiY7snmgZsksV/keQJmmnDWGzqHl3wEEkQToY0zJSQMyAGU4tnleiATtjOROtiLENFCG1p0HNjOFLekdusyhs3XYHY9hAPbYiujgo2bwej36xvI5dkabVEMxCQ15CLUgVHg8ww7lj52dC80YXlAO2CrmbUYBDCedDxSvFeAnLGD67wyzdKYfYJYDj5mYbofBnXBDJDyw5AhYgKG9WhBCE3CaTbKqSZsApEFEmJwyl/PBjp3M/X6SZDJLFatisoeKZggSQvT22qWSAY2VViZ7oomtqt/XEP0IOZ3sSiomcUNevEkFXuXaDZSEELJBskGovQtenF+oXl4W53h52QNn4ngS5DI/K6Z7cKD0bOBefVHMgbO/tB9Oy2K5t8zVhX6ScL5Mq7KSe9rRgnFJ2RW2p9UJahO3lNW8cF9rRg4QT7NqUYg8rBkXcPDtwXkH1rhi1psXZblXLfNpoa71C1ZkXlbVbA8zNM8uTSXU3jxfFKV5hv3aP+3lsz9AzqMDlORqemFfrkuT0PrdxJcr3R3n5fXyYk/kC24eK1lwoXR7LypZfKqEysuOyEsuVTGF6yGk2stnl3sfzXMlAfh872OvWOTnPOiakivF5R7MJHyFKhTi3LR4kcsPXO5xMbOPi8I94mnSqy65xHFdVjVeT32IuiimHwSc88u8EGqvkjMue8tcVDXfG/WWFY7lHr/kQtU9VyccYqF66LQzqGqtqqWpFz7agaiVLD5wuPmuzi98NeJgX5dayeoD35vl9QXCF4YB1Xxec2VDoBHTfBm+AryDfUesS4S8tCFBjeD1qpipix5wf/ZyMb2opH4GboWxVMN330K4Kzc60wf5FqxEAbyKvbNiVrgXNOaEN1XvLaFXF73LvRxI0DOuimnvcg9YaVDK5V4x49W5zJcXGL7IwYtsrqfOJZ+qSu7x+RzdDnCpcB5d60c3jcK3695VJWduCgFPDUZxUc147+OiFHX2sSzEh95Hs+C/gNYzIIUWluQa8PY77gstihkLyvKpWgFlat7kVFalfQse64vqyjyin2j7fL3k/8YqJsYf79XV1eDqADlXo+Pj46+xsCQi1D4uygx2qITiY5mLc/OIG31y+u+vyq/Pn0F17n0t7HESV0nlZ09B9QxugbKq65c45F9EQo6+gEa/HGA3fC/53HyXuIBE52DG9AJDPteZeGrTk6SWU0isv8k1rYV31sUD/fLfbMAQVL4sDfyYicH798aX79MXbx+/fvHg2Rvr1ffdm8ed/nzpR3Y0HA0P6Hv8PaJv8PcufYC/9+gH+B0d0of4fkxf4vuQ/oG/+/Qp/h7QF/C7P6Rv8f0OfYW/R/Q1ho/oc/y9Rx/h7zF9Br8HQ/ot/m7TIrJ6rvoXess6RfAh44/sSZogT9bKQBJC3/vAZSVVXiaEvvFhc5mfm5QPfKhmE7+HPSMh9EOQhT7SZULowygUYO0h9KUPRU7BR8j5j6C8Sl7lcvYe5gKhT4MiV/WSixrKe9EOfV8WtUKXHS5qwRdVQugrH1Lmn8DZ1GsfclZW0w8JoT4/vN0S+tynqZb5nys+KGYJoY988Iyfrc7fK5lPue2HZ8FH83mNXICE0G+DGvDzfHr9Xt/RE812/UTfsc+PqNWX9he2Pxs4TdpxWxtE0rjkapWQcvau3+cn707Xa36S/L//ry0iATQvvL35W9/PTcWmTwQ01UL+dYAeZbc4BF8ZKFksUkAbg9ivfxdp7z/TXPXIhHxNxp8QAFnf7hJzcUh+F8nup12t4vMV+O5x9fjFa6bw9forDzLzFdsZGtEsVgf98eeSv4EqvIVRGm8Jt9ra0B7UdoM/bYN228htkqpAsJUsjXyp5irbmtGmA6LN6NL1+21NQwTvagWnCsF8op4XjG/aCTmoBSqtjYd5GV/gwcfwoVFDDpoTftI93PgdtMiFgGJHvy+6/DjrSRF68TVB9gz/XSQay6odWrDK4aTQmuXuZTy6D25jhvfBs291UpzuMJaf1KdjUu/tobZ2kGJc7O3Rem8PBjpIi7UGjKhivYafmswqCILUw2/29ur1OkwdTFUIdsd50ssVqkclFB8Tsrm6KEqe+gqQ8Znk+YfNZjMvRF6W1zcwyem2+WkhUVPOwNfCrKiBfQcXSgRdyhegSkQmPyMsceLX7PcBqCEfqPyc3MR3+Z8typO+zI+OfETyDPdKE3EQRLxx+7CJPO6IfIa7sU4w1LhR+/pn5Ern7BdTPlABJrNRO3agCW6faH+/I9H7ZqpRVzlD0oG56DrsBzN1G84v4XXLsevSdA7Leu0+bS0D96ED3DKj88bW7Ik9eXV73tvwV/qU1qEfXKg9eHX4Axv+Bk/q53BA6ZinLsYOpA5/0QzHMdwEyLpB5d2s+uorHWYq/zKzUzXuj+ShPet3k8HDStSg72kKfui/eW9Igls+fmVJCf3xH5knp2H8nf4Na2SizIgkCW3ULUXf2mqSPNGkx2s+T1EkTJIsCLPT+a2dVz/Eq+d1GG5mo4l6lUEF3y/za2A5Iyze+0IUKlT2/iHlqQphHB26UXwQ/7gdqdRxICPsUXwxI5hFiKb44uW9HuH0liXyXXi8Q/ljvzcNRDVDPhfp941GBapQRRQ5cHSR0X9WfUy0MDGR+azQ/N+Az/x3rfz5HoVEb9EnJDj4aISw9s0KqjhxwoTMGlNJ9lmDlQhA2x/nSqOl7YLmMboK422Od+BMS3YaqEkod0tMzZ2KPSajOcPAcWxp06VB3dSVPo8pjgaaMiIr0YgqweMbGkfzSCH3NrVjRW8CjW0ZGfnQm3Ouhaod9RBY+M8t5TZdgw0FPhEOLPjeCT5vDjre6ma85Ir3YFQALQoVT93s+a3pAw0cQJppG+WFA6o869xq99lWpHrwx94qLhV6ivGBmWMN6F+rKUhoypkgekakqJKsc+RogeTr+lOTtobFBPuSX5uOmreaFRP7YPSECIlOq2Bj4QO4UF9aJQw4m86q2bXZaEKbGQz21fo1tDJTtrG2IyrQAlP0Jpacm9rQULjeqTFlcjMgmnIiMz54fyVzkDihRuHAGEOYjDdBf3Ee1syIewZhkcAkj0OocAiedtTck0/60LaR/Zja5FoB1PxmktBGRdlNXNNMUBOgWy+pEfeWfJbFWx9uoNH+hyGTRk2zqCrBCCnTETo+Vf4T0u+DnM6LVBWycNyX0nxpcjCz/sdU2akrTF288EgSj0HOxAR1sWGrS0K1WfMAqVExHN8YClgyF4fvzWin453Uq7NFoa18oG94zfWLneA4g9qyE+v3eayau7ONmVTY2oFRPszaCcMZA868ww9+TOMZ5Tymuk63hiXN6YRNjYPYzk4zVTA6gltLGtS43tKc9bodFTfA2fG5odxx3bvDAF7ZdO8O9rU3ltID4w1l9LvdYsYKt+vu9apXm1yvVTgv3FgrWD9hLZnaIP2FkK5CEw+pfkK4546eu32noJid9LnIoGcr17PBZFb9/k8pH1RXgstHZlslmptirgJy4qrxs52yt7U/i5OHU76ZjQxPrZzHZoysSm+sIpNVCEV/6KqD7vGHlPB4CZZtymM9fz2wcJipXcaRt6s26P9ef0f0KNhXHDZfzYJHInXuwXg1Jjhol7nbfeSLqAI9zpPkq2QXfA+B3DZwZ89Dbc+KtWf3V0CDyVO7R+Gz1QvRSudpFMYqQitkQ+hw0/dvbDScwsjb0HVIkt0fUU9f0xe66jysOrb2pDI1iJz6nFRBuTtDBNdMdclVZ8lkbFfXeq3TGPWf9ToFlavqlGxsCkM/BNX2g1HzyKoet59ulbeGtunxiJDmaR6d0V2HuJuNn1kB4Xld8piSwELG7p6ttzlNYujMqWLN09scQUErm63ZJ2isGrsmkMRYsY2+YXYSkuaXB9APTJ4MTzeKyY3Z1TVWfYJOtzbbznzdNzBrghFZRe2NDtXWIWImAewNegZKsuP2zeCMlP60Cb8HjIbGTtOxzUhzViGifsceJILBmvLohsc/KqP6ONYb+vYhB1pAT9Zg2vp9X6sYc7ZVJgTKigmdd6bYHw6HX9eX5wECyQUP2VvWWcel89Rxay6YGASpt6RGORug/OKf589a7jpubUhoxRzv6nocge67NQPo7ckF6oTc1hTt9gNkZbw4N36KIFBNbs8+09z1Badgr8FZes474G0HTs747vXTHcbmUG2nHZsUoscJH7gApsZuP00XnAF7pVsXPE1mxWVCSPBtcr++PP8m2VXWzD0l3r8i2U3uf43xVLEFH8wLWSs85cbRC7GEIb6mYZxG7RirRnKYzWKmk4dxYGHUdQN7/ubBctnv4w86fNDQvc+qaV4+MR04aaue3Zo+wtq1Ntx61oANW3bOA2OLa7/hB6hSQaNgN+33JS7XMjeh/f4B6ruJasbfep03pKZTHfyzXanRumfa/fQZZze5KBaoCPFUGezeh9VKKOA7nKGyyFPQVnm5UjVvBr4piylvhP0C+hY67OOTkn8MHr+T1Wpp3l/KGXDJXdC0KlcLX7J+reFxbjKZ6xyu7PMr1M645Pb9zYUEGbV5e8HP8zD2JVQQXs5lMXsgeW6fX1dXweNjMQveQGMzfFW5VPb9IdYwfgu+1gFhBibE5jGvhPoFVTHgrSwEf1jmi6V9+d5FGX0WfLSNqOTyItfdo/KzN8UnbVJfzKorDPyE+gL4VFULLK4oy5c+J9SiCt6BRxO9gu7MI6udEwdp/Rwf9typ4PiwVl52WmzoFWcnyS/87EOhQBMYlGyfV58SmrwMQYse8wbugFMAbXt+hkCzOyYZeFEyt4CdIMFQcyXPWlw+uJqc8RN+OkmTZFcRI9jM1G6y/BjIXT7yBsAK4iCgmOu6hJiuW510t7Vh5Ew02dtLCK3YY55Kii5WBTifLqsct3mJipXJtK6fYBCxeoE+Y3A+jQ5aq80mBAs641t0Za54NxiNYmqXW6+Nw4a7211uHH+hN1xCzxCnAbtLG7gjj+E9XmkWXKxAlRwH+SY3SwxUafBXmmUNP+CHA1fKBYYWi3NnJAcPH/j1ORdmJeCKXnCFuS1zmeN01sr/8KSA44ezH4vYBHvqm2hPLebpe6i40+D1pOl6/Tkiu0Fdjg7uUq5J0899GZLwtrxGbkdDnVXbdeC2XMFEO3n/Hs/8QvS+uN5HoE4bVAjnbr/fUTDGNL/eJyFF/MB3L/rK5cH0Tixboe22YFDUDelYgjgTWn3yI1AxGBhpk5owo+45bb3v1XLaClvJohWmPWu3goEiMoGLoq5BdQ5VO53i96ipoz0MeuJD4JtE632j4Tpwzmo5dTxabUdHBtNKSl4vKzErxPm72lJP6OeNb4sl9AD72B714JMol1yoF+AnV9N9D7m+5L40v3/oX78innIrx2eiQo+e3XAqD5tDv39vaBa70QAB++8ZH+sLVV6litCHPA2iqJHOqmjOvMAKvOSTP+C/9gXESfYHZyf8NHvJWcCQeMs1etNLboERXnKq2B94y/wD3nQrsVFUoVeFDoPIp9xYQ/qcXzU4MmkoonrNvelcmMSE+ITPoX7Y7484e8XpMw7y/m95pNryBBIZRZ6X3PmZ/wPYUJABhVYGmX6KrplhV7urdeB+GQZXny95ZfxAmDQiSiMZuB8d88zKGM3Cs5imWfhmbU9t4KNqdVbyOGEQ1kz+vFrVHKFOWiGdSZ9Xlx0hnUnfLZvvnck0pgp4NtkRjgGjfc3tpMnZSimU1BkTDa2OH4g212tjl2TfgG6G8wzfCaGc7Qit7BGIVWHYYWttKRhEksPAj21jgR2MqKIuMvDHAeP7DidVMU+XqKAFQX9y4Mh1C/P+BG2lvK6LS7Cvb0gPIS/EHzJmvfls9viSCwWaAVxwmSaK1yqhf3L6J3epjF3m9oRG9HTOdQmjQMuMx7aotKbOHbtm7HjDVjSR0SJLjzZ2oH2IK4cwuAok6gajQPcjSDhRvQyX4i9mg/ge334wbz9ydmPSR+JK+GYI3/BNYEf7XWfl4/x/tvgsP/IQm8ULvUPuC5XaQzkf5CWaMirtRmmsBoYRTxRzz3jx5kyNZ9XNcIeBW+sj2HGByivz81qb4NjkMDXts9FP4g4r8ECLovLziWyoAf5mT4WRPmJQqcju9aBwWXziM2QNBRuM8pZQuI58a/BIaHzoeFXMOaWBWXuBFrZ81q0V8ZOtF3bgjsddc2TYvXvh3vmrP926VLNdDY102CsFgZQNyyBdBdilqKAGWu+SbzzOImKyjMde1m+GzudeEdwsjCvhKq6ISZOHdmypYJUdUnIjmRhb95cbzGmjPT4DNQmf6id9NYH88XWcjzHLPDwwfuJpRSgfm3ARh6txzvJBXZyVhTjfdHU1uvfU34B0ydZRsooKlns2kUY72BnR0lWn1NXR59dNDWvNfubapA8uHSlYRaWLLFnpKgajV+vWliy/LfscctmWfU6li2xn32z9MZAx2Hw3etADzXTHQ91LYEkmcSHdMqGkP90H05WUXChtQpapDeoTxGeJh+LgY93aO3ZJr9dH9jFCSjRXDmJ+zXgxRZW9jTgfngoPNz1RcT/aUbZLxgaoyHy+Xtsn1tSn8zvXxn3tCjUPWLbt7O6Fz1UTzDRcvXYjGRNXby2YlAHgpq+IM1vEvlNUKioUrRTNFczQQoEv+9qIh0rzuzK/U+1h7Xm+pEv/OMdvLhRLFkBvgNlxD59Wy56qVtMLDZaln7mY6QdtqpWvPk6BbOrNzkr9YEywzDfmDfM0z6tlD6ztISP41fnMZLXsIdySNlKC2OBVJ/rArzGjD/waEQ3gYbXsIa2Ddk+IIdObVsvr3nSlesu8VrynqzVF1J+eUd6D230P5co9I2sOrHP8cTlTbeL5BvXx+eylyDidVQskIkCjLFMUzc7eXNeKL57AeZaNjtaSCmTdYbqsovoyBYzLvBBc1tmJOA0dcZjJ0rhTIhhAIdxNb7qqq5VKMjPSeuFrkYHuXOX06uC95DmQpWU7NQ50delS63fIetVObEYwSG5D4AOk3EquOGhm6/CnMxJ+f14pNz1CSres6lbEsjO3oKfOVUjLdJgZ8EHQ86ASPUk5m6nUf0MDKZB5TBXcJRUIl6WCX8pJlvJBc2jXDJY+HzSH02ZZgTNfFjrzrQiibMIdEU6tEJRU+YNdVqnNNGDIMHeH+jtPowhJLKWTKrNHR2evYr9xdPngVajs/GVKu1esVApMeKC4i0oW6joC8csHK1h+ZyV/L1fil0Jd2GQpHyy7vhAqlRY00mrMHGgaKzwjDLUUMfnD2mlZAFJ4wVdT29NPxbxCyo9swq/irfdaBerSKLR0Kb3OXXAStYZzPPTXbzsEv0J/hSuftqcHBWgAGs3AeNSC2epmnXSzjoZNAoVxgAsFI+fUEsA7Q9/KM2W5y9he6Ga7dEJQBJUaogpOivHwftFoGWcF1Hrc3V03njwWVThQUGkF5Rrq439Ld95EHWZq4vvMQSW6RP1+EUeDNYXq969VWqOBvtlZ3R5R6sgSI8tG5EpHrjByZSOnyjGpzxShy+jVD8xjs+WHTbCS6Xhi01yt1zh+Qxqsy3p6wWerkj/MyxLMz9Mg7gVwCEu3sq8UCe8YH3F9uFcV6Fo+ho2SI/kXTBVy81ilhR4N77xGI6IVXfjshQFoD5uGCqqNpsHOXsk0GInHMBKURwPwGAaA8qjfH0O/Ux51d9zbilCtvTOPapgKNofakc9XTtOQQ5+BY32lEjIZhpmMySVC+JsUMpx0cz/pXK+/VyGTzIMcypOGXvopU3EAlU72tMtPWXJlnsE/AIqhMHQBD4pq3ssbFchIuZhl71WaPLABCfXPjwVYE7q0hZWnbv/CiVzD75B02/4NShATQpXMhSP9MPlbF5LQ4AWrtaEPQIWLflARTNhDu90/QB6p6Ud8wVvymzCYW7xphsE4xAgHjkeq7NKfx+gPKsqYgSsGj3W+7PfTD4rdqlFghGxBV8OGBqIPC2OXGsXxN2oQDpZ/oR0J3AjdngwHxL+SqHObFfHfRwMUvGk++kvFHqo0CSsLNrON0CKYIE8bcbWZCC90eFQcGM36+8or//hasZMkP6skQo7p35eK+lxhttA/whA/SenTMFxPRJpMcwF2N/rplXuCMAP84KPeuoDZSmc6te5xbIDzl8MXS1XwWfwk0MNzx/Ms/pWyksFvm47GsFc6zKEtJogeFf3w2SxXuXt5FL6AWDKKfR4H1KaH4PmNe67bVYHAVl2gAwtxHj/J6hwucvFjzfkHHR88Ke1VIHpCI7D4SRULvjIAxfhi0YpfKBrMKZwWyVWOsBLBUyCyf666XJAE+p9s35mygsi7gp/d0em4Ykklkt20Ohk2AKp2KwOaNCKEvkJri1RQBZPbPFeErtKKnggQ8KTRIS+qK2Lwqh8pds9X9Jnd9JCj2ncq0JBsdIeOxiZqvxF1SPdt1GEj6oAeGunY/mHfmk9ptetJCgn2qSIZfHkAueqwET3Yx0CgZkfHPmJoE+/fObKBx3T/zpFLfXDn3qGNuWdTHw6PXfK7FN5c+sPRvaPRyJVwRJWLOto/Hh3eORrauDsQd3R3NLx37+iwzzHskNoAXdLo4HB/dPfu/j370QF1QS7je8M7B8OjgyOXyPXBaHj3AHBn910bRtSHkUy3KrzwfatisRhM3kKcP8sFr8cGQ14GAzI0YrEhKPwiGDb/uCwkn+EXtADJml4CNqiGXAtxzmc+U7gCE+AUVgynhoeshxbmzLb5bt8qepQs7/+zGEN8CW73n6m0JPi56Za6z3JEs4Ko2kTpS5/JVPb/WRDzbR5+Wzc/sw13bFyNEcZkP02H36SCHYz2vlKpIGQyzEb37wty//6I7I3oUN/glTYXGMJo9Qt9C36Gl6nqPuTvOImPFKs2ttkKOlOoXJyXpq+IEb36cC23UX0m4P4yJhUb3b+fSlMh4BGItd4EVJ/9sxo7SzIvN1UBEMJQ36L23By504+nAEAbhJNq4l+y4Nr3qZNVFFgvj4yVsQVL6+2bAGecbCy53ql0/7D/T0XI5JNK9YLlJu2wnRYWt018L0h7r50UV/Y/kZ2S+lC7fk0EZ3dG+8BpMbbTUTYKPvCLz3yi2P7RvcODO4d3jsAsIGZLH9yB5Rast3cqchq3F4jl/1QxLvTJKd4VDkbfSI3obKX6dlRVIAp09+54/NZMmQWr9kbj5trsI8h1sDghQM83oL4Ak7smJ8rNrlOmKfevFAMl38G0/HSwP/GPXb5xhqiZe7CfHYz20l+giV9/r9ZDsh5u6C8mn7I6p9+b52cv9rHGPygWHDnvai6/hbtLIc7dDfJHxbazhOh3Cu0lnKxQee3SZ3y9Bh2BsZYs/aRozp7xMegZaGCL1zytqE3uYAfSZ5zlZL1+gugNXsoX5PyjSn9Q9Cc1OCvEDK+QLpvQhFE1UCFhe/kONd3SisG2cdjHyRVcd4GJd//C8/A4IchCjMqghZ8kzsdHjnwNkyKSjFX9PjJ5BXHSisqoHTWKshMWSsx9cci0C8os5i2QSVq5bUE1Ocgm11qxc33Ddp9QixMZsJBN6hJTl52pAxaySb3C1KvO1BEP2coQHYPXrjLtFyrN6blKtT10CpMg7HdaEZ9rB2fZ5BRlT5dBvstb8w0AIn3fW+syM4Cb57rHhcZwAguLQH7bnG/sA09FyLZKKyartCLmtM2BuVs1JovmwbtpVbAcWKJjy/QtImZvBcze3DN7q7H+PmLD6k/y7ZxYSISlTPJbGa9R5rkxbqosN8dk5nqoooYlgpuZNZBW5lfKhm6XkFpdSjo6SMpx4C5DopsMvUppxbxHPy4n3PjJyLgMtcRp7qBZxl67SvT7Eq7xjFXABQAdq7HuabHHMZ327wjAKPJE7CmdNN9Tp+ji0XFrJLPkPaej+2oy2nOGz35SQLFehv+BXz8EZSidRQJqq/COzZhYK+uBDSb9/ujAcgbZ6IBknCk6GhqmFQbRg/37IPvAhOAQNaAXcuk090PucREEB3outWyyBr2oxR6XBfBDUGXlPWJHITK6QUN/r3m/T0WtLOI6gqUbMPWAk2th1/UHFjrdiJDf6kC9QElL37kw+hknxSl+dlKcMjVRaUUyQLzxp7Z1S2N8a+BRy2fMLB3no8PFTNpBGYLNWlUGbRs1yWVWmCYVNVQrPzfshGq55DNmIjfOiC1ytLbUeZtqtXzKNGtg8aM4a/bhWBtixflNmgEpyZKV+CCqq0B3i4cNwlyCdwbgd9s6L5dE4yEE7d7iYLtd1cZ3k1bIlspqwfK3q7OzUmcUBqADz+2DgRU2jsDCim5oUb/SwVyoLAcXQdqkpJR0JelU0qVkN9juVxegAz6kZ1hgnQ2prgDiTAwp8BzeKDB96HRZ6KLX60e54gNRXQFeV2uqDWlRv5WrGp83dC5ZLdOlJPRCMjSEXEp6c1nwK/TmovKizMDJ8wzTXUhCFybdhaQ3GuXt12xI9dNvUOmy4EL96p4gbJmf81/NL6ZRsvyRX8N3wLPWj3lpHoBdpJ/OOYD3oPskVI/KHkqq9RKzoXmAfpK8zBWf6RXd1T2Bi6so7YQP5rJaGCYqxntF5AkfqMo8Z1HCrJHNhoLS3wKb3S49cZF6++UDnzrlO4xNwY4B/miCB2INdgzoL6cl6rfqnt6bSvtIVz78Nx/+G8lWkpWSDelUMk5oCVPTlvjbbfX7rVm/37KVBA3Icxz8hST0Ep9w/BeS3gAPD3m8cy5hmhB67RPABIlHBlOc+RQw1RybFDUdhpSX+bLmM7iswISp+WrmBgG/vwrm6dT6cQd2Y1fTogS2efFXRnEzCoRGP8Z6XklCP8Y1hkbrqryX7OZxPc2Sx/U0Bx9Nb8BY8CyXWdJL6DM+V1nyQMrqCh4T+m5pXt8tE/oa7Zb0Oz4nFP0t6ZBH6ALxES+z5BEyyhP6SyGy5OWbhD7nYpVZWCh4SeiD5bJuBL2ZygqQD/XvM0B6pM+rT69kIfCCBQsseSeKGRfon2yWbOgbyW7uZcm3+fSDgVc9zpK3+VlCR/tZ8rDkuUzo6CAz3hjp6ChL3sACTujori5fVmVCR/ey5EEJocdZ8ipf1Tyh+8MMvPXUuib7d32nHexjdx0cQNpzUF6mB4f6WXfDwR0ocZbQg6Ms+b4CNx8Hd6OePbgX9OzBcdyth8OoUw/vZMlTUXPgMh8e+f4dQRufjODhIEue7MPDYZY8OYCHO1ny5BAejrLkyR14uJslT47g4V6WPLkLD8dZ8uQedNUwS54cw8MIMhzCE2YNee9D3iPI/PAwS16sFro/Rod34qHa3z/MEmCTJxv6QLKbB6XKEr1DJtR0dJaYfRTmhMqzxGycCcVByRK7uSaBJOtDQCe2DlCnctrceCftILBH2NlJOXsAVC7p93d2QGrl6byHnvzrfdCchpfB2QH+65ruMIFutZV7D1LKD/waADrhFy1/ogkbacla9EyrwhXsoFqJhTMkkcnEzN5MG9fivv7QEMLQpsQog/kM1usE9cGCLN+YysFHAN4Z1QtwCOkUzE2GtKymmnr58kNP8iXPlfkWj/6uY9DS7l27XkcfYNvhwDeV3vLZZxvuWg15XV0U04u/VIG/XMaG0D9w+30pCX0anz7u8p2hTSc6oNOo3XByQPkriZQTCMqEKkBbwQcWpfrV/AJJoq6AdBtSkymYDiGt9EoWi1xe6w3/RXy6ab3FGouAA+6te9fSupkP6BjnzvnQMdRQ8Nv4CAr9MnzJmflKMtdrM16qvJNO0TH2lDTpkqsLzstHQdQeHwRhMA8w6W9bs/wtyvK3MMvfOrKMEnTEuxL/jiRqqfLneq4Q+hq76ZUk9LlkJ8d0dED379KD/VP6SLJlv5889JqfDYk0fWYYBZDMCtmfmzuzfe/302fSi+DDZPpu/60u5i3/qBr59/s7zyR9gvHpziO5Xj+T/f69+/B3NPqGPZOEfpKsa1M62Cf0nYzMpf6UncqceiUZBtUe4Lg+98a0yi4rMraJcSk6Hv7+McIdmVQukV7JgRLnzBsteX1Rp1Lc9tHi2doymBoNXE1kSOgLByAozgJ6DZ4zx+D5CnsCnn6R7AatH8GsFYSx9hfuQvCc2Jc93EoTbVMLVxqwla2ENsDXVtDabLauwT0APKOLAnioeS6nmFDxUv98RPNbW8pKYvAV52BfGxy134csmX5f2yTCkg2fY70by7BxVlZqsrPzC+rrXC/5aRbZWAUCgx+kZwm+4KkgdHg/VeyZSFXgW5wQ73NIoqrDXKZJoEpglQw05wxYwsb2UN9QM0lLY9RUZwq0MKFxPxpO23dNTtvfsf1/CPDuFqITSnPc/5ZWaFxJ2v78f5Lei72pVKhEr6/Qv0pn7YW9zEXwogRLKqH7MVjB2qbFME6luF2dZixF7E3G5UgTw68FBSPRhWAuxcAk3nDBlNDMTC6gyr9KxgGMZqdzJ1mvj+93RgR9KIC58CPcG3+UuGqmF7jfQA3t4WAHsxIExsYMU5CJo7sMjxNP4PBo6fd/k+l3VmYLIizovh9kquh3knKK9ryEcvZ3SZ9xggrEyFD2ohdjQuqELtr4U4tcgp0hFw6azIoTkM2YQkOh9pKmP0qmyCBXn2ssyfy2pDmYkIkvq3bN1uaLXrnG2DF6ekS/OILFTj/dKz7HUgSzFe0/G6lDpcxVkDgypuyY5/pT6Pxp5yQzZo1FPXFPWYxj4yQ9mrsLEmJg4n7NGRt9DR6rOCKhqR2mNnQpPudC0y/uuW/GVD9GHrjaSPzr9S04/crFqhiyVLIQtQFhpMIALdy37Hq0tdKPsRK2YMOx8EBsYncXhHM7S2FQX6kExZj1egeacgIvp1TpX+JzanO4L4SV8Y5xU4/AdWJcmvYeNxOBrgYVDDNz8gOhzXbg6iI8bg0EScZ3RSh+MM2i/D6MsfzG9eENfJcJapBI1B7fjDmTG57pOpsyxEDwj+qNNjUiN4JFAdaCdwPh3pR+I4zrgA3WXIQi1EU0+XbQW8GO0gJ5hBfBkIOd0E4fYpXG6VEucKJzCoolWWLkRbVj55j3VMGFFN4XkN6CGb4y9B5cUNPRUX9rglSRSIX5XKReIMGNd1+q2E8pGateIWqVi6lmHQOGxNMnMl/YA2SsPRboGdyB7WD8hP6CeQ7sJXEATmC8ya40ptI70tibQdkpZ43PiTsknA1rQBlcin+VCOnBhhFQIvCGSOTh3U1TR1GQ4mX0vpLxu6WyfCBpGI8DUA2iCmOKhk9VvR9eC3Y7oQ40decpSs+MyvuV+X1sfj+KiMR+744k4w58oGcAmh5Kl2V2HCFLTWQmYzDN8Ufn8+9MrNdnYoexn1IBdvb+ANJaj4VIBTsDf4GXsKImgt1oJWcxiFNSUGUOAh+L2SYToH89vagkIl2kgqUirkm/3whwWHgFv/KwG+fcQDWiCIMMfKZUP77U24kYhK8Uz1wsWgzcsw51HwRvG/oYtNZF+lhQ7AwYBqBaBVCtVwLIVl2NiGxVAdlqoq17VEO2wpA1yFYVkK0CJDBWIngmYMU/V2lirArtj7bsM3+rmtu/gZHf1HNbjWGgtQ6crgLzxXz18WFsxzjzmBCRveLjht1ifv7GWzDiH0Ma6d+eJXJ6Z+VKGltF+/cSHN6638C88VHTzPFVYO/4gV+/W/YatpqPQqvNhYaTMAaQ9u+qdn/Bw6/+02Wt+XCr7eaj2I7TPL1b9mSuuDGxhMeH5hEtLPVf0OXlM/eDVpf2p2Vl+rDL4vRxbHqKj7rvLwGMzJavX8xlKTDrpENCYQ7BkPXcH1RzMQNrn/jHQo/sY/uA9pP49Mw9VZcm+Ut4cDIh/fTcPcHQ48NL+1C59Pil6UT81jw/D57he/P40j9WwZeYS41cafujqvPzkvuf1fQC88cnzB0ZNfpv1Ekj7KTXiu578543ghmyt9egxSOr24a17nZrXqOgHRb7AGi/B+L+G0sZjh8A+WeUpN+IkwfilA7JeJrGsCj0xFus0kAT6ZRQnxTH7LNJjca6y9f3f0LdS8cHLvfbPlh1XODNzhVtF5XbHYLdYLVs9nvYdyZzt826fMJt0O5gtrA497bd9+fL+5bPK3D8jffskyQYXq2J71hSNHGTJEEgF8V9j/ivtEp+Y9K4tmwx/faMrnYFg7ydbUdzjv6b8rdGBklrkv+LJeCl6oNg2qalZ+xPerEdSi82OukZu5KesyTpodJpD61Get76oxebe/ScbUfzdADXm9YuI9zhg628QCeqaJHRM2quPW+DEW/M1sgiXPcPBVIKb3hwuOMZbg9FqFy8q4XfA9U5zVX6QZDAbP9lgyb0NK5WJ9HuYZMxbygZSdrWo6Q5rWlJV3gF+85C46ADEg+OQ78ynjy+amG6HN8zozllv/BxE8lnvU6/R6CeHzibgsmHoMYoe0g5oc0KxsbNf5j7m2LahkOR8VZjld2GrYrQysZjAVdxS3WNeWYUEXUVEHOD2DwLd2vfA+thVoCPMp1tzQQoYJWsHtgLF12xhg4X5Faz2hVHQWUx7/erTl2dlBCLSfVSpBWt6YrQnBltQ6hTwYbj4r47LwqDfV6yVFeH3FIX+m+oCAKofG8HnLMfeAOaKWAiPA2ZCKxAgDnB+G7y/r3WI0rGaHCoLxyvRaoop/voeVQCpFUqDBf3hWCJ1rXTuFWwnnZRhVvmYlYtQjzigyPrpH0/WBxv8abJT16IU/B5AL8wAetuPM2HAqsFLKhXUCvnIpdQ/T6075voVv5KNJVfD++79eLuCc6bg4s6OTydhC8gk2NyvI0N2O8f74T3un4/zVnjZueMhYG3oPp90yQLF6j3Fu253nCLqzXbpzkTGz3tiwosV2o9XMluqiaJUzJOzPCRcYG51jB+cAev1uyQ0NcClYcrqggtcCDrsJdet3rpldZJVsSCSjqdqGqyn1XGxGOYVezvKkDW6I2yiv1mQ6xsB5TsN5JVgV48FMYJrcwKpzvvkBfgqGqDjp44ktEGaEpRuwJIK9SzExM3ftWEt5HXoKwb01NWbgMYxtWGZFuS7wxJ9gWZfklOoSuZ55FivN7ihLE3Skd94HnB0z4+2dlCeIbst3EIrWWNk6yKsFXGRsVq7Qa/cHviNu1pvRMyVq3X9xhjdTB964CJFmBtFfNUZ603PgtVZcGDirG11ypshbBGpa4RiOrSFCK3VIj4ypRBZcpmZWzjC1aYGqDRvq1GHXVVWjBZwXwnHkIMIJ5SU0uiYZ5KYGXmrHCgYD2+gd3ZF71BnqYpbyc+orHAb72nQhh7Mv7WCTYeWeBlJ9r4NhBtRJjmBnmSop6+JLQAQYo5EWv2Vi9MbW7hpmht+30u6Yo1/SQGYlk911glU+nPk1i+G4qGS/aHDIFznPHGiunnhJbsup1EA/ewBHgN7SRnSLJjnC4tnysu9XszrRaQQL33NYYBqnHGVbfcE5ObZZ1sEUJ7rcngfbVs4Q51whIFdxmo67lsIh4FYEdw/8i2QiHB5b6NjOTegzIdl8e9Vzg0l1HhAe8iyXyIr4TfSoN3k2/JXoSZ9V4qTNP7w/w+VVnJzqIkLyDobVQFc4RlJZtF4XrPzkr2Oh7aanltu9V1uL6XZSV7LP9VxKbQvCfqkICRFIcEvRIBSd2KNaXXx1OtqjZ1tC9dsp1pv287gzHG6ZxNJ3ZvmtS7icNXhcCsHk9hiVv69oLOmNtRZ2ZHXbD0gs1IDFwLu9gFbGHusFj0++kFW1hKY+7wpNIF+8TTGZ2DYdlUszsfiXRGF/QC5Bh0aXb4GbN4dpvh/annpNZ4PyrTmq6svL8CWiJknNYB43S6MdB+cKDdBYM2TRT7BWZke0GHY1+lO2nNgnXXSGaDyHqNNs9HfaAJd9IVQkQG6sPrtQyVsCGRrNIVaB6uTnh1ivA56zVifdas8uz6alJlGBJTb5M65n/bk0FLVYxeMC0nacmE7f90xbor5jTFyQQrhRMBarKCr5ZggLUiZL2+s8PYSo/wkX3EZFo4TzIoDUZjxQTB+8NKd/SUnUu6YA3O05w1uFYz09MBo2iFtBfAl4ddrgXB6ZQ9NdnGTKc5azGuZsxmkMCa0IdxOamzqgLj7QsTstIhK0LNFJumCzrbTfR+SPU8I5YDv6R13Jnsgi50D6AFG0PqOk2nJqs5ZKV3XrqKs7qg00ZWS7pgU6jrgpb9/ooYueecreiMDekFm7JyfDG+YN+K9IKQ2e4urtkLNqQLNh8vxguIWRByYWLGw/uzvYsxmUL4lNCZ9a89vH+xNxuTOYTPCb2w4ZDADB9j8/XaL2MMCNBg3flnsjZZbaaBKZx+tjtJ2e8/ESki7dIp3uhMxMrtHkuTZElXkGRINv6+k2iKCQZpUlWpIJkVAHkJYb1FQhgBQZcA3FyUeG/SplgENrhz9pN0QADfS6DXinn6qyTnbGUAe89ZrendS5ZrBZm0ZHW3J93ydk+6dcudpKlKv5+es1K4a885BpyjlSWZ/CDTgp7jPMrSy37/MuW0poLQpgpJesnq2P0TSOkG3rtlvx841dNlowvFmtpwWltPgvSy0ee0hcYIPXZJAjnoZUsO2u+nZ4JdohzTCjFJN+H2WLArkCx2gzTq4xPEn8P4NA8oow7CylFEKDil72GiQUeGeTSv1kD2XQsSpOikUl1eWtYLR+MjCcu3QQY32a7a7veMdTFpxxGF2eAqZ2eszTTe+oURM7Q+Mtxa+93mzKpI4DL4Sk5QbRO9g3aV11CP7vf397Vw2Whkdnymm0bGZ/1++gQsiD5UCfIttO54v59+BY5eOr7ZYexs0q4DY+ys3/8KNMuumZApnEhKeivYlEtWka2GsKCcOSSofXgJclxBz0Lp7Rnu4h9lemaYO11Ex1lAdFxuCL2enKEKKLvO7Cl8zX6WGtQwtXFA8qTX7Fs5iRWgts8YHHfrvV8CZ6RxtTJxB/uoFovK7hrWOgVt3CH9ZFycB2KIzDm3UFgvOL8+yX7/ndRfNt2La0DfDc6KrOWB7Ctrotystiahdh7Jft9oAgMCKQwXlZIpyawVNCrLUq7JkeYl0lDmHTUaty+ZO6kaGI11ADbW+uzwZBTa0SOrSdDv2wTGiSsYK/T7o/v6KVbR6ulADcOMnWwjunShbZJNq7ZbRjZYFcqsCj0UeoBM3yOKiNE9ELQhhApncGVncCMNNXdgox66bW5XDU2Eys3ezR+w52mXZ4699EjE3p1uLOM54y6jTNGIA53JQBPrmWhiTSl/WaECbilmTXGHzM7RnN3fS+6gbXJ4KcmxI3JDb6Q5+8RtL4rBSmgcQqx7jpgHcTql09kbi0lEKGc8xqIOcW6+FQHeagtPe1bdBF8bRP9+H4htDdPv1O80CRbg50QMPNtNOVOB4blm2Ji2y35fAhNvbJlxEqUTjpBDkUDLG4km1zSfTx9+dzSZEPTqCu8tK1qZm2VaQn9JmkN/FWG/SlrSmpCsWq87k9quNekIlQ5ufwPMpcLN5+16MkXoxPSTsC5c3hllqT9FQ9n75+791ngR0ae7XhxZQBkkmdtAUfnLWg3sqEG+UtUToGA2bQiBryIFw9jxCBx3S6Mmi2+iqqeyWHqnJW1tPOffytNwXZENk4Xt/qQC59GfSbE1wUC7rcJO/6VT+bfmCOlTrdTEP1pXtd93fjIteS7tR+GL+cwP5w+43kZNV0rBWc+SJDuO4j1vgjPtUF87Uo4/Cve3H73+rP6Sj+HTSB3VQlrYUhChRCsJorKoXk+btn7td5g5ZxqroKhWtckzwGUejrm+n92LWuIB1GB7hhKTr2D6AC311Y57miTMevAdBqrLPT5We3tI9CVff2Uc1and3U1HXSJgfyj078KAsv0m2BeI3+hPILfTe9WT4ozLr5Ld3wT91YeC4LHWobxyoQ45WccoH4MSD/2Bnw6yCrRIT34Sp1qC65DPQg8Enr8+ltb3gAQGzXot4VPn8djvmW614GJz12Tp1pHUMQZADUc2PLgwQ6yXrZIcm2ReJVbCvJQk5P93elVAj19Od5nrfMFNNfCY1mt3qGgOjnkcHfhn9+icoNis0d7FeqTg3iMFDz1SRH7DYvixg3Dx5FUIAHHyK1QybkkRjZqqHJqsk/0pA3KiqlOrLmEBKuoKIMvKiu0FSrGroMwbQ3ZkPCA4pphg+E1Zod97k4TV1UlZnVL9Y1xIVHt7QWuWld7Sy2p316Zz33t9BeOQdV4BSO9FxVZVOq8IXeAT8EDOKzavfIUvqxgQEW0azM0WVjoCGO44KKB5NbaaHfEZDhSLWSBPBU7b8rlx3PNOLPL6A9dedI0NfrgbbP3weeszLLyiuXUiX2nU4PykOmXqxI8fssR4WEnyl2oHHfrFlWI5obkfqOtw2gX7/TT4BHs2GNyzKiU30ypdVIROq/QidA93VQUCt4vKDjRwyaqmzssR6LwsIQOQey8hQxoa4jyumvo50ShyptrVpB1exwSI5MI+8NuKIxFhaARrpUyRzbWTYjxvOUoa3qM/oNJD8s4g0ADRFnmi1yB1Dkg9WuWsMer9/vaB5PI8Hsj1el7B+vCdTHVfctuXCxeDQGVeF/72ft1p+fc5OoZGwaXUDMl5Raj48qoyTqPp4ipKMhPuBh/1SDU4GH1gfj9Ut0EJPqzYLRj39GUUreVNLvKP+NuLalXOfit4OaNP4zL5nyteq1d5IRR9EUWJ6oq+jUJgCulut3V8xi95SV9FqZ4uFnxW5Mp703hdfRZU8XnFtmP2P4oin1VXLuZZXPKs9IV+i1vvE6voscPY02rytIpRjz6ZgXhnfv+sgA/xc8VeVCmhX1VsxA+/+bmavIi+M7Mc0uz9XAWWtL9UqbtZvK1SYrRVXlX2ln98rGWYr33IPR3y3Ifc1SGPfMiRDnnmQ+44Bk3z6I2dun5ftR3w946PbT6vKp3zsUMufW1D7tqQ5zbkyIY8siEOaPVZ9YX1+aFq2PthBemHyphfOoq7aviLtikfVk6fwRHQVRqCAL6rLPDWu2psB/YlfLz5exUaWP7dfLfzZ+UIuE8VuYFJYGHGtBLFJZImn6rxD1V6fBz5etFGdbGLUnOKI6j+rLqRTKYg09D3feexYwOIB2YCGmMq0GXE7nO16ffTTxX75ID1dgE5+2GVvqrodxWQQFarA2fuRrtL/K1ijwevkXzWy/VbyP1hJebFuZ+sP1WOgYdGVg6Cq1rWDb/YSsMyKM38iBJ6Eu1Egi9m+EEdywAvNraGRhJIa9DxXI+OMr8yb4Ig5rDccqZyZtL64atyTzX+6g6E8bRKf62IcVc7eG9oqMF7E2/85/ts8tyznppMJtl0M5ga+kHD1iqC9FPA7pHrdSo7kmheShizZsqYWseB1N8p0kZ6Mg6YR74BhWkAzxmnuq+wM0NvjjOO+LtiWvDaa3hZw0/dRdrs1nhCqlETLH1WoKJbnLBpn13nbiLJXItwd0Zaaw62XQSYbTMs+n0H6nxgtOoktEAxH06oYjdmBDNOq7Oay0s++7ZQdaYoXL813oL1NKLycDB43jjuD4ZAl6mcKcrzqE/YDTY6G9KwoZmixnUz8kGRBY5jBlnkeP1nDoyIx1MM53qZR6Z6K5yxfKDlMj+t+IqzG/CoriFUGj42dVW+zWsD4Z/p60jeDgO3/nyW3RgIZtMnfD7nU2Xr7W8+drqwqCJUhW9GmKi2V9U9t6rJB42QZp35IA6w9ecD/eBqzgfmKeTxLfPwBLlxkNHAas4FzxRV+Tli+F2D6rzuoamhi/Sbnzg+27mfxOHCCdpP7J6AEbqqxMJej+0GMFFmXmSpeZL4Q6UJR6M//RFTQQUu8vgGGI6NaO5DXqFXMvQvKjpqao4+s6cGDZNMNseIkJtZdaN1OoM+lR6SW/euxN0Buxhdk7lulgPz5PtaDuxj0OO2o/JJxXJWZDkzC6lA7i88Ng/K+BPlPzE7KIbaZSjDaSpumaZVc1rmdh6K5jwUbh5S46QtXBbSeddKOZONuU0mra5mKuOm/rSZPDyYZnmsG53Hk2KsNxeLidxcczXLm8usZLlpmZu1Aduf3DRjmfNHvmIlnbIV1nqsf/wZw0C3rGDTrNYRU1qzFX623DJtDfTJnKVLtowmbqPK47n1k6DLmU+Wrd6cZnNb7rLZmyutCWaKLYhRq8mDaVGzIV2yKdOKTaDrXILcIRfaO9IFK/wSQApA9EtitHad8gq2AysRrJ0LvWKGuFiKaLEU7cVSdC0WYtVvZ4zTBSusZLRkil4wSRfat7NRgI98/zu8ohlb2NIIuZmzGRaUXtA5tWoYwI1ms0iNoHeQzbRLarZ3ODy+2zdv66PDsdHAdwctKNfdXu4kKjSbeQ3guaYsw7pYHwgwv4cb65rO9w/y6nVl2MG+nYLoO9gsUzJxj+ykOM1KLT0qrDvGi45hKv8bw2TrsJykU7ZkF3TF5iRzc+KC1mtWjiPF8AKjSKQu3l6fxGq/sxLT0zJYe831zUra/F5TzHZ7WqL+3pzQYP6zFc3bS6qd95K+q9espoY6xKeIWmHzEFgjD3hlnCk7GtQ9RSSq9pgOUg7lTbZUaLKltMmW7ftgL9GeA3yUyZjJLn5Zi1F3PAKZ7rjS0xPAQZBuO89ZCmxmMUC1E8GFIgPJ53XAsA12Z2lUCuFgTbVX1Ia39InK9A1KG/1HsUxSDfmr6e5+fLwEQ2WYSNc5uynq59UKcZLbYHYIdskHMQsLqH50iQ4lbSgXf0Lmb7jSB2XTpqAjA8NwnpcpoRW7KOEynrNljk6bxrldKu4GAxeY3A+LJBRJrJzQWZlyWgH30NbjNV+W+ZT/2+qi8nM2ov+9Oj2p5NSSBrHGy7YKSV0hYSpUQYXQ+QVqJbB9Uwk0jvKVUKYSlamEQC8KfqKd5ZEtZuGkye0tt8Fp11w/N4N1Wya8OzwVmHm2E4AqrdfhGxgHriTXfAX7MWDkzAU2c72Gpwr4xAHXPI85sjsjWrF5RXOLDqO52+NusL1e7nU5Jjmr8zQnWVqx6ypVZHJeZQGLOEcyGBOnIs69JmSCApaKZCCF0eAcChURWqvRyfiwJwMTPRMyMb/6KmFvSJJd5zTofaaoak4Sxul/VyBS/VWBSEBPPg52LG5bQzuk72owtcP7S1GWr/mUF5cc2T39/i2ROAu6M3z34s2DJ4/f35rv59Lo7E29NZvhOh907CCpsqlog1PxMW+aG4YSggpB5WomqVErau7jtMJDgJ3nFK/yY6uDE87kW+dwZZPauZx3zmWfDKdtTgjF24DELWpr9Tq2BKjdOVePuCwuTbInslpo3h2oC+seyancmu2WId2W63rdkbyC5G9EvqwvKqX10fS2s153HdOdcwFPvH6/M307IVihWvUw2lmjrsy7QlPS/f0tdbwlMiUUZIaV3V62TOGKVuEU/pLhJ59p5qNiZqrn6edDTVK8z9kDKfPrQVHjrz993oSUnGeMSFgIpHMwwICjBaqHn8vBe7Rs0S8seh3tGF/nLZbdsYEPECzwgr7plOYd3qXcpK5Ykuw6x43ey719gvr3+x0dZmLwZ/Be62C95nM0z8HALFUsJLo0A1po8lAxxs5zjf+EIexm4/wg84lxJgsi8gz+ACmmwlIqEIKg7QMGhT0Yt3X/3iEhWifAdmMjwfEQXcC19Y0eeE6X10jbcRhnjf4f0eRED6dBcTxNGGvDL1qlHwNUSCZmDvSuCnUBSB917ybZjSERB39UhUgT2kvIbrJJMhUSDh/ytvcdrDO3pITCO8pjvE9Yxr2YGJRAHcwkDVMxSTKlbzsmII41PDrzqiUS5hJ8L7jdSDyJUHIUq3eGIgQxJgrTUcHEoDYaXd2KPF4Awa1jX5uNGhP7BN0G1BtXGiIZ/Q6aV8ROBvkMNKlRWDD0kfAt5ez7EgOI/p4NKbcftwAc8tRedqw2tP5GUG4tDJHs8pZCE2RLYipyX05SZbpyH3TIRRa/S19SHfhg587NeJBzv++/jSicMriS+Y7WOnhH2IWg7JSmiv29TCVQftUM7WuMUIVBZ2QQX+F0i8J9MavOYvT+ogZc29cBLYBGEdo5Sip0nprEwJznzG2uVPiSBNRAsB/KVH9L0a7Cfqk1tqOab83H13h6S8cc2o4Bxfgt5vFasy0MiRMXi6VuNeKGYOo4CDv9ty/p9ECP9eR02wgs3ZUo72jR3WiofwxLpXmc5ecHex6cf22V3C5NXLMl6FmWgON5U3pcRog+G0rF7Fzy/gsHX32low2n76O13pAwS5SeJWZL6JglMpwlGKEIlb4mUjPc3meu3r+V6ZZKw9n0PkfFoD9TB6Wre9l/QrWNaNhYc+h0azJeNKly2wcTbFVm5Ret/pcd/S9JvCi1mnqm94Yk2bXuKFt9H2hxur6X2/q+h6sSqQK9TBljbyZmXupBcDMZdfYzv2tkzhik976d3TROZ3pcYo9L0t04vx60cR32ttzS27PQkqCrV7v0y51PYhxnxhELQlrHkUmyC7l1dqsIAEVst4qt3WqzNqtZ4KEnM/y1pYmwwxXlVDQ7vKL6g2yl46FucX9Pg2bcWhYY9+khEDgEzgVmb9nVE1i2HQMFm3DnGCzSiqJFrFe00AIIqm1n6ZIVwPBnQ3oeGdMu+/3FfYsfNF4Aj3Spz9hvFpP0nC2NxTPJztnSUQLadvUireiS1ieLU+C2e8bzJbkJOMRLdm5Y8Rve7y/dAXwZHsAKsiK0YHl6SQu6cDTudLJil9nUlswu6ZRd0iU7h05coC1JbFUldU6roD5Lo4zRaKilMpZsnla2GXCHhVosW7VYBrVY0ilbOoWUFWK2LJnAorcVc85m6ZJWdBEWxd1kPg+7YznQlL1twTlOpUWGv7qbzlsVPA8qeE6nLLD+gRzbaFRevxK6n4MJFl0FONBpZZHaNDDEn6ke5o5L2rR5dbozJMGUSEs21ZR8SVqqoXdGxOOsgegMJ+yC1fScgTTtUgdcG5lF6jTgF/3+zvVgVgk+Pt/dDRKQm4WZw+eT9JItjGU9yS7ZIprDZziHF/RaW3XSVTiNz9w0BjCKBbsMpvHCTeOz5jReEJCVpme0pufES3Km7CxzC4id0SU7owt2CdNYtyGcwAtCp0FNFmYCb2mttw6FiezbogEh8vS6VZXroCrXdMmu3VSZ4lxe4FxekM+XOEsXtKLnjVL9tL4Ou2fRmNbXOK3Ps2s9rf9qXTHHL5vWU7tt+lRAvMF+qffK27hs/X7uTgg37jnUebxCfLS8cV5YfMLbMoXhnRJzfuXt88tgNkx1QXTlkVRW2vBjZU74Kb7dZRZU4wZ9Edv6khuZcrqyPUgoXhxWtFXlgLriTDg5rnN0vGpcRHQRn8k+pBRXNI/uE2EpmIldXgpf6Iq5bDe+PXjz+bFMm/W3tCLILGEyxc0BurwE4lZnpNPYSkbEbRlWGch7WoY5le78rVPuaAAzViszKfyNXYsS9ThpwMtDJAy0+eO22xH2bhQSJ46vQpg6DtKjIhqjImi+9TLUHAwRDkbMc9ikAgj6PO4xn0/YQZsucjDvIAdzu/vlwGfLA4DBI9thk3RLo9pjrVsACf5+S0UJrQ362Ps8zR0ZtnBbA0T9GcScRzHTfv+BEfY5ndUcMHnsouaRMgX+7O9nRtlBh5rg0Z2seSbu0x9S7yTAiccSz4HTjdTy5Yc5+5CDTjB9qZ9GhP4B1jT0ac5WVfpHTugL9/TWPnm27KvcuiJkjP3R1HMc3T30thoBD+d1Htm+Lqv0bW4MVF7kxrriaU6hTB54t7Ba464TFEsV894KHKKQGoh8wdEl57vXT7OZPjhokpAGLqNiM54qlnKG9oyT0HNGpkiUjfVNr43PEG9lM4V62uqGt/XnuTbggdgptAp/3uahrTp23as8fZs7I5KxZuK+ytOnPpBKrKXRJCZjZbRyG70lQ67lM8z8hcvE6FFGNdIs929xUIfBmD7JrZGprg0PeIA31t290pPUKJjHAoBAK0J6nSnktM+4cXE/I94gFI1GvaUovhLvusqeJKNjW24kDLVFo9BnIPklz8uXcmb4+aBJfHTYN3y6jlxjU0pyYx7silfIycQgh4u4QRNNxgwIkWe3Yv0snRiq1CiT3Xptn1om+col2rg8XCXMQ8hVbRnCfjLq1u/M75+xzu/PkVrnV2V6hzrVF8Bzl9E5nTx6/Ozx28ePEmq4CmFAKFwO+TeWRe3UaQKm9iQN32LWOG+wxnnEGo9jA5v2eBMJd807WWi+2BC+pIqhUrjbVjQcWYCV5GLbQE4GCoMYx+m+H3aG5lQ/ylrFJcZTmNaFMsLJg6gOt+Z7i4fAX+z++2duJUDvcm1rbNfmGOGwdWdh0h1gk4lURmbjoDVhEzlFcqN3Nxru3+mbt/W+nlhaAfVTzjgZ/5ynn2D/2cArzL8fRaoCX07EGhjclh98G9oLuU3I2xo4q4gAqSKwHg6Nisck+Ayz9pY/7sgCw5bcu6tCCxz7riuAdRtSo96q7IQCg+QdZ5CcXPB8ZtF5wYrfPO98JTQvMtygiFExe5eP1ZiYRUmV7rJwQKAQXQfcb7mj0XdSpxuStmWvEx7ssRpUryVJu0tQn9N2blAq/SsW/sZUPzDnv9Fjz+OGWPrQ2fh/leiTATZ8+zTBp/Xamv0HOWzMjmbuFO9y9imf/CjChRIVqNmabedjP8KZjF8HGyRund/lCD/p7LHyyJHVcMzvf5eHdlXf5Sf8dPD+qpIfnopXBpf/Zy7rohLG/y0WMXafsaE2iMobBlGPinoJNlFc0p/y7cZS9NcchGKFrrgyv9L8igLVmYpov8+LlNw0Rn5/FNIIRRFZGsS+5GL8euUAUBpY9jAftQ84dAB3u/e3ugg1yLDgX3OWQ7sUVR3qTw1bEBOkFT+H9DdP3XhPefYpzm3yqsheF5SjWmRFaFWQm5wNwSiumKfYc3Qn3b/zTd5aLUPgMuW7bATdrYqt9Qpq89yXZEwIqgKvNEGatwVVTrxQeGmL1sfV460L5NEoq1b9hp0kdlmk1vrwJuoKrS4WmCbYV2yLfv3TPwYGE7F8SxYTXjTGTBaMZ7JgUreCcSoLX6VVkUaTrbAV5EWgne82N9AQbNfcHCW2o8y+vL1OmalLQJMqInHCFYxrWMUQJam5V0LvtrowhW9JXFDQpaoI9Pp915pgfDFdrIoBPnTocH9R/7o7XdDN0yLC+unQLJmolJMskFcvC69AAqNEJVO6YgEzUbb6BlaGNhx5zcWMSz57zWerKZeMG00ZVaC6tG92zmSHzUceavVUFiiq0gOnf4ypDdU/rN4EubKK5VTGuuZRfpXJC9kRbmjGGjC8ZrlFkK3GxvZoylba9AIMLX7N+1OCDDOPTJWWhqmpDfbAOQJ2ZbYa6AfK83MuTX9kq0H4quP0XFkN/EtocEEFiz/C+2mYOuOpoLY440dWc8J1lab/5irZG045SWtWsiXNgWfi+mFJuTZXqddsikr6083KmOrEhlSrfn+F4+JzzJnITD41nYpUNI8DcOZgbEBbBwVVfkxZTlUwMUoaz0/zhVk2J42sqBzMzHl8Ggje/1ctDl8arfyyQI3kbdfpitzItlVUzewEh/mbM57mtLYTAx2sBINQ666fijT/C52cO8tW37uo/hL0POns7Nx2dk5F0KsXRayPrQaA6mBoqLFgQA6/r6uVnHKrSKdup7jCbprAnsCYyDSFvEJYhtc81ybMNOUMVrU2QkBHqp8j5uh3ubbdUYi852UuQS31DPAJqZsMINTyTZ8VLV8jdTCVmrYhB4dW8zCPewnlHr58WjJPX9AVKwcrMzSRcwVT84sirTR8AUg52OpkdEqXbHUyPB2vmCzG2i6uqdh7weaoTUhn7CLUpAX454Gux3gOj6uzeiqLMz3VzxkvvHVyPLFuILvsguqPM0Xdp5nYUGyDvvpHjYgKZ5JeDOrgfTrWdEXYO2NDqdYUTEw5C0cOdpwlxek/hQsXB8OJc9Bvbc6cNeP9yi5ADIHU7XS0GnChcnFecvehI6kF87HoXYcCJTK8732HHIz2vlJpAbKM0f375ViclKdrxmnRZ/9cbTYbQk8kVVSckq09ZIk130zadLHBG2MoWdSNCDghUx50lFWAxe4Zd3WPaHRP4Jw3qp6e4hwh8gmBBunmTEU6Q8DfqUgXVOmHuXZ4mnIWWZZTu316g/TGVptNC9rakbLlhridl03ZiyLwysMLYKiu9P7OQELlDxOjD+FWDl015vIq2A2XhC4DNYuigSIyK1I8S5oQIufBYQMk/HgrFcfROzRPSXuzDndlThEDyZxY/54O5GEHdnRf61zlwcZ/WbR0ADm7AZtHTqeSQ/6KznitZHWdSTrjyzoTHfRxquDeENrsTlKkxiyv0CSPE+FF06cxjAZmAH81lzpMQCbhm0uepaJhTs+piRONAkiovHpdROBUASIchdFujGPw4Vnh1/SqldKnu2qdLTiLeGEV7Tmt4m/ZZZGO1vCJQcJ0QimhmZKh/ubjVvZQmbFgrY/GTfd17oJLjHW/KtpkTs6KgRn7QJRXFKmgELH0DHzM+LJAZeAc5Flf1MQ8hgqLbkrQd3dGR/SQxoqX7+NUj7tTvWmlOqT7jTQPPn8z00uawgWNhttlajy7GURvn9AxFHiUPuKMbKwrr0CrvbkhSXPtBlfdxpXjCT/V5VHTmgdF5L+Mk2jreghT1L++LEJ5A04T5aeJMgxvRwrHUyFmoXvNPYWTQVFxMjolE3EyPM3SxrfshFN1Cuob3j3j/1RV9Jh8vkJPowoBWBcCOt37Rk4AACs6qTlCNhFCIcXd+3JyfLeRQufyUz5QMhca0HscvbERnuUcxbsq9R644lRyE2Fpv2hQ6A0jV33JrOwlU8a3S30a+Dtlg5dEC6aafADGWDEx1/s8S82T4YcVJpxQ9x3LgW4KQFE5Y4wXDgy16PcLDCFVwYB5NnTcntjM2Ra+XkO4ucR6cKK0MCdC42wkxCJy1Y0E+tArWZHWVCLlmcdX+YLmwR2blUD2IPK0EQA6omnjzYGRf/y2AII5t4aVWZ3TVe2Q+LK8wFcTqd/MYahfni6WXOaquOTf52JWchP8LL+uVipKCdac5tGOqX2bmyc9svr5ET9bnSPekAuYcyn5LAx76+aaLUTTj2807a/DXi7zP1f86YwLVcwLU6wF1ivqF/zqNYcNqii5zHZGG/rq9j6JTaat8nD7sD3htLUlnFK+CXtUZ2366WN3jzatxr9kf726ZX9tjU9ng66C88YNX5xSbxMBUdnr2ATprVvYJpwOzYbqXaIr/x0GzU4VyRQVjZwDfhxTmlYV/yKtyjtIVfU5UlW0SVU7y6+DWX7enOUPO2Z52xoPyXkKCHzDUyqYOhk5SNqPRcvnofqCTRwy37J/K7wY8lNC5aax3Fo3v/NCHwaMnwxdlYA6ZU+jToLDDTKl6nTTXrGfnwKi87p/E1w74cofvOnjwXADeMANkBs6AzrQ3Jg6t4qgmYFIHN1MxraSunY3Vnkye06t3WDGKSqkvpwDTHPXnRVwmkEoLNNEZslu+ndwjx+BjZOQ9XOH4OktocsVCSaA9nMKPH4NTJt6ChYIzMsivRMd87eUt7GqZMbghTqoQyiUbf2Oqs3te+vr2/fWl8XW3fF99+74oX3evPHnzR/RebN0581ZsBLbPI5lkU4LsvmXFqj+tnuFvv/fukJ1zfzqPIEroMf37lqOi+JzqyLqsZPh6WeG//n/zuGff+Hwz/8bwz//P3b45/8Lh3/+hcP/qGhoJLwE+276LFY0+DbgCRhNOSePn7xE4yPt3EeQ7GGOGjGYqIHb/aQIzcG0AxY4yR3nW/K5HZoCsqkIFSxQKcgxxCkCPCucpe+ajaipJWROTS1J1sCVbOJQ4sd9tndndNfiTfXZPyua15hXFd6WPrV1G2xdLMNDhtpoHbYwxXr9S5kWZL12ZFMRYdx6Pw8IppBLHiSVUdJJmvLAdNeYhKGWBag050ZbHXuV8kDZ0Qwgx85B+KI7VFt2soK+040sTCOdSnFh4ewBQCqt+jlBh0dFrPpEU8lC5EfTBjKR2VyQFG45AP8rEeQAa0YmpqtzkoWDqS3GC2d3fEsz/Ai96x4hrU82F2lDUaujNvAJTn5sZt6vvDqpnh32UkldtcfaRevBvUOr8ObRbG3vfRXM4aC+f7YkZKECoYYhs0YM+e1KE6iudVHMZhyd/AicBOt1Ylf/3gz2yT2ASKhWau+imGnFe5OSGCe2oEaL76TJcUaoy2cauXZDP5ba+Xdw3U4dlu5h3xuPOl2PfJIPXBZrmUmn5qMC6GEPyHtIb6kBNzXgejsYf0Flu6qRSaO36CJTwaJqduoskUwwaXJ1ZLDZfSqQGpgW+YH+OeIG6Y3ObR7eFtitfm7mJQQSj0GwZqP9e8H8+apou9q/rlLZwPgZOwOKyyqFKUvt7ir/8u4q/1ftrr8UDVthbIht1c5w/LFKlRkuVHsu5qk7JJxitoO68h2ZBgwkq9rVCjDN3Cf0yqJP0I/mCUuwvKVex64flEvrprr6uDBQT7VRRiks4BJdMXk7nJP3WruarFidgxPllR7EVddAW+MyuRUxiS47fWB1AikV24CUxstOKKXiC6C3Oj/bmn69Rl0GsV5jH5B+/3Ge6sNpRagHpZ239Sn0kLA5ncEXghYwimUzHTXZw0Ir12vv62O9LvNJ2tVVGsgKJsaUio4s0cdzma/XZzpVTQVAjoL14yT9Kx23HYOq6Mag6hzCriyLL0aeurVmt0SmZEt2XQhRyiNEkSz9Vz6jjTXHWspFrES3kybSzo6SusXIVkBq/qvlA9ig3ptu4u3AIKC3twW6YspaLkZALpM6+6mySBu1r/WKLpv0wTzYS5p7R1rGm4tndpeTEraSEhS7cCspt28lF7dsJeN02rWXXPy1vYT8z24lS7PWw62kNFsJbW0jtHMbGWuU5OaWE2Q/+6Kt5MJvJRewlcw6tpJVuJWscCuZgbXiJJ3+hY7TXf0lXWaR8r5gM7GZdganAuv55XuKz217rMn0C7YWm1u0SP/KtAw/3b9zFG9MO7cVul7XkXK6ma3ztsb6eu3LiKq389nq/WuFQEO+YKucdW2Vs2CrLKlgq//v9IjevK1NUEwPB9ZKjbulIekNKH7DBlF7AOv3dwp7D6r6/fcVrmJgyvvLo4iOi0eFd+pn9D6LzmXbuS0jR9ooZxjWSiCp8aS8p42RxNf36IhvIxD0m9AoEgNrYApk5jJQ6zRNcQ+2hwYtHnZciX4I1KAi9472jDNMx8lVlQ5pM7QVoO079TPJ3GO/bz4379j72jY5NqPXlrI/FvS7gv69oL8V7KZhZEUlV/L6GaqCB5jJP8V3eFo1T+mcfet1NpGvpkckFc1ZAxpwgqWxpctOawIT9HWT7vdzQqiYpMBpCC5bR3dI5ke421jGMpXQjfXcMHvX6x0d4DiGOaR6e1HUT1ySNF+zEdojf5vTUT8PECdTx6jyefb7v+Sp0hqUASvDJ6AF6Ln8WsDk09Cw1jp3641eblpz7jeQ9GUt6ICgKfzjkk8Vnz2r8hlg8f+bSrU8jIODO3cODw/2oRppKtl3ZXoDfJQsuSzAmpcn1DY/QyDOTvQyz9WScOLwJjUySSumzN3Zd2fQmZKg2gVG0dyy7JrZdLA00OVJ1NoseItYIhta6GyfGX6Yf+n/U3Z1UkWyVDLeqrgk3SZhkZn7r0UbRxk7zzXPy6JNlxs2mO9xtaFaFFeRACA5zcN2DGkerVumSJbDOAJPx0jdqNQQf5VFP6N5aBvtHnOHSiPtlspyGhg287rdqKgtsF5MHlQCJ7SiW+eT3BDTPOUEjdLMSxHA7ykqY8jNiEfSRCPlztT7FhhT7o+IoHWqbrOlzIx3bOQxZ0WEt1RvHT25ieWpwHo2pQL/fJKiQie+k3hEZTyiNfX6PjLSAE3jNsrwLVb2LIyeUtBVtwK96gRSM7Nr4vp8IhgiogqSadQapDfsfmDJEyrCwesaR9//VPgRkLVxH2DNddS47WMu8v5m0xGa5yl3YATBQhR1TABpwqexR42dmlfLCqCov82nH65yOavR2RmQJ071w72+UbnUzlKGqOuRCaryogR2cV6Uz6uZ9aZkVZk2WVoMgryZosXAZafncjFo58+GtMCBQiIXMmfSPEAprDLRZhxDtn1Vf5ZtH2BU0BxBagpk0VsZVbQJ6tM8FQGhQAgRbNQX633PnDw6jGwZ9co1JISVPBCeaZtvC2gRewQP7My7aYt+H6eOJI7ZOTp2nzSjXBYGV4PHuBqcOh/IEa4G924KezxC1uCdyBrcIWvYpyiDwFEhb6Jq2BisiYnbiD4bQT00DSMamyfpOpJ0kw0MRaUhKJJ5JXHGJdjlbg+iVYSiKMckxDJ3y4842gzBWFCaJgk6JrOd4PXVK4JHvykgFMGijwrpz4pohydU1CnQvCCWoHm0k1mcHGzKmV09ti24bLpLtA2rxg2g9mpr47zUuLI4ecH5VgWnpWQVrRjf6HoPDYV0W9VVdc7VBZdJZtvqMU+MxkzXtw4eqGO0nRPR5p0lr9sI9ZpVEDl4bPjAREtJQyjiWpf9UORFGrn5o80urCYA1IHHTwxhbfTIwanixi1GJAkO6+A0cVuEX3zBaqFpMCP1kdXI1FOv43j2NbuxE520qB1CASBzBEgveh82a80QBRnic8Be6sELwjkZQpiHGN6SNfDKnQtFnR1mksXVDyfZtCrLfFnzWZLJZg3EluUuoxqILYtbTFSAaAAZR1XSz1G9MhH3su/L+tZzaewwnj2KjsEcGx05sLEuDLK7+ueeCd3PIoyuwxANZxxCmo2cX+dry9Em/T44vqc+7YFNA3haDQ/ngNJB04hFQhr3fuhax5gSjUjaDLC7YnQ99ittvU5/gOvqxHOhMjEwXIAm3yhsxJ3sGXxnFFkagF/ogUJ5LY2w5LBl3/mbDrXSX+MiIhYAOzJgR8QIVD6vpu/5I48gYW3neRODDJtObmKOlK352GrBx8JNM6fEyU/ilIGlxK/ilNUwBXHdzIq8rM6T7KlIE+01PoHW4WtZ1TxxaIJ6nRVzmS94gtPHSjT0C1+cweqDL8sqnzU/vCxmvDJJ89WsqBJDBwGyywcRIrs8FekHccJPGzlofVRdAod+a9VtcW4KKBb5ua1kWYgPjY/otkrOOCzmWidX1fl52e4AsVypJOM8FXBbgISFuMzLopVZzUvsHTF4fyXz5dJaGtxc5fXzVamKZcmznZ16sDAvm9tyc845srK76I3DjgYn3W94KikaxmqmIKkHF3n98gqMq5dcqusUhLVpzuqT1SlNLLGbMJBkt1EfJ2IAFcBFKpTGVU05O/Ef0vy0g7+T9/vNLwEisvUxBJ6SrOyqpF6HOXiSEW+msipLrGW/Dx1Q6wAqCLFT3U5tM1J/TwWhAvsMgbw6+xQTTXnaPYL4Ui2RwZvF1EmHyKIeVOJhWaBvSjGoxBSe2SdBNoh+H6PWBBZ2IWddSypNg1bsGHFCHU5ZnuUDdPPyyIAuotnNUlujXvBUEmJCJtBBxVIleJSmKWergTbwNDiNaTIrLhN0+iG4/P7t82csua+/+eb+71+bp4RqGn5RXXJEE0t5CC1GstaMEYOinrRLk/SmqDOI3AD7rB1PqO11pi++K8apcEtksnKPbGeIB+0naPYKH5h+J4RkraxfvMGrEeVmI+S4EQr6Y2HQv/yWyjhdsQdcO2y5baPk0UaJFlniX9ws2x9v2TABMSkPNszcbZg52LeNG7l0bJod9fyyjZOHG2c7l87Ns6Mwt4EizmvOftUP0XbGo3Vol17Och7g28aLlN++zYpgm821n1lBb9DMwBpm3lqHaP81Na/5bVW3W0TOxAa349xq/+RImtawT09Br2/a3PZqo1a1ZNOT+nSc1Oq65An6Vv4IZS5Jlsxycc5ltarL6zdcPbXLV6cyrg+XbDlZDt6/v1CL0rSS9PuXNotw16/bu/5yEvuEAgcbCXps7vevbR7NHX9p4pJkF6Lr1XIpeV2b/f/xrEBu/y+5FNqlFXh0dqm+R0KuqEQjPl+p6kk1XdUmIC3bPWbavGycE3V8TnAreln2+1cppzVdguLPZtvZwfHs4FSAXGrb2cHx7Oies7o0oRHWQWmw5uqBUrI4WymeJhiM59+PqUlEtkxvv/EFk9mcH2ltiyCTAuobpamh8q4mZl7+rCvUTh2nCM7MW868PDjzeHTm/az9gTaOt9jzWkN7snVbOQL30p0U+d8LkB3Sttqwo8BbztNE4EXqM+R4455Au8jxSdomxxuVsaQ3HuDYqYapG+tVpYKlx4i96w56mcn4oCfmUHvLPyI+cioIMdmHB5jo6MSRu8dNga1GqGgp0zSknZPU8ESYRO9I1qMqE1Qyw8ZBueJWIGAvZMS+ypzueVNwBpJ4aaWmXkjiFXB3urK/TQyKOt/9gF87AdHpt7B5fFuzA5IBKjG+H+Dveg3hh05a+qpGU+R0dHC4P7p7d/9u/x2ojcdBf9Zkvb4u01c1fV6DyVkq1mtJmrpn6AIGB+Ewukv75r0V6VZ/W+E1djS0GVQotQ1ijmGR2KHVGaetIY5cBsG9t26KuN23KyY8U57AMVWTok71Rui0yk3fbWd338bsNm/pyjAhNYcsYKdTW6CTC9VsFeGNYFcHAaymza5HFXrPX9SDE0iDNMchlgkFL+g+XDZbIIEHJ2haM0msYH+f1i0hXd0qitZN6VPQ5XXkMq+OhWW1WY2c1gG3l9ZNfaE4MBDb1m3EzDpmjJqwYCfRHOyoJqvgxVVKY/XVrmomUat2q/i9VdFVU/83qnM0+LTW+pkr/KHwEDamq224XcFDhtAJdWaNRfRtxljR8UH4uonZgtZ8z+hX+B1mve+VaCJphtk0kVHY739VpeSb7+twjzg6pDWoiLjZ3lRasJqr83SnJhFL/0merohmULeys6kk47evGRmtGV2LYbB4dMUj4xXL/QWfCSEbNQBvtsU35a3Bi65KU1pLPcbo/n9Cd+2JDvEg9qE3RmkeqZ/v07EIpZKT1HksaQlVQJXOd6ZuAJl4ZvQqcympjmWr6AR2wz9JpXmiQZuYpDowkBOF3RR1IO3qDAbd1NQeUKFek56v9WTUV+v9bNRXBD1vuvNj/8B4uXBH1Psy7WTERutzh3kpR1MH6jOmTTvWtKnjuNw0JSpHVHPFAyFvWYN+Wqe/juvK+t/Q3GyHHI6l2CV8ODw+6iMovsFEPxwe3+2r9dEh5WHXHMDJuo35bc671OXe8pG1f+9OgA7cUZblUJsk6DaC3kK4Yb2DAj/fgtFxIwsf1aZIOiiNqD63zJQGSn6vIf5YGVGSxWyRLEmoYIiYKXfZ96lxVqO/toClFnpSekiWiiW/C+zf3jkXaN4sznu1AoPoXrILc7Su83O+m/wu4BVjzII0d34eoB3id1VQ0WlQ0Wkl6qrkA2SCpMrcsnxdao4rsFqpreB6PxYshgHxsrE2WQFb+R1mHGCv10f2kfABcDeE9uqfBh4gvLz/0DrOdstVWtmjjEX/Es+0tuhfesl9JPiXnYJ/6QT/MhT868exdPEb2ZT725jwaN1s6HdxT3n9q7ypOYyIPtqPJI8uYPElTWv/Un36b+EQaxYU3ogML6oGKPrtDChMmvPutPbCbphMeYvJJLZxn5o5eRaDYTVhuXW73PbFfKfjYt5xbRdbr+0w9ksnSBBAAhmVY5RqNdkvwBLKuwIN8/5keUr0ZdwwspbWdxtE4UwroLQVWTVzKVBbbr1OJbpPlyfFKUsSTRFtZYJp55iewWXeP8+NWn6GG7VscqOWXdyoJZmgPQs7OQXimdXosktj0i6NCtnYdbGwPhoF9AW4azPX5HwCvZNZWJGu/p1qLGdzCE/tTWxFmt0N2ZKboKN3Onp6vZ72+9Ou8I4R8KM2JdOuUVudFKc7jE1PitP2EEKoMRaFGNddtLbdJBGxZTq+daDRz2A6ZdPJNOZz0hWYZDbCTC9p7Otpv98amilpsEWXkxYDadrvW67nTmCf2corSXanX8QB/YI51znFUteciOO5bHI8ab1erxhjU9vHJGsZsk7diYEzwILyMMaeT6YesKZjNk8BgqDVlWbqgTKaZmTX48alY9nkCW7o37t2f9khHdN51l3Wbb/w/MPzfDkxvxm4z/cw4k7/IpVsmad7I5hlcGCyA9BFza9BuMFujLWfwVy3uhq4XY+dj+ap4TWx4NT/O8zjv+PF4zfU7Z3WwKAkm1C3+OKWWpjCNPW61YQkduTqt3Orq+zq6prUBiAxNaMirQywXR6ZecTXl7zz/MgjY6KHQBKhrmpX13QcTsKz+H6qJz/VTPCr3huu0hN1UdSnJPupHuSzWQpvriuJAZZWmqobQ2S7GpZQozcu6g0kzzxGQ5YkG0Btktj42dbZ9IarifnN3nDlZ9OiDg1kJJ9H/i26h0ghYKGFEtVEpCI3n0rtER33wwZwqCvvvI6cbYVqQm2fhJHLQvNqlIgOG8o/ViWomKf7d44sJ9Df+LzboQZju6VbrDTMcaALtMUYLFUNj6Rah2UiA9NaSbSezfv3kudT9VQgk6Hsyo6pTdgUd2FC/1hRm364jdFKmtfOo4Pwwnnp1q1zCP8lIxDwa5y3mVQxGeMmh1d84zIKCFumnE+BYp4eMJYe9PVd1+qQcSMSGHOL2stESpz/JnOL4jgnN/+uuhiTiLGwTj3gGnwIGrKVrpxmBI/QniN9VqYSzqFH+he1UuJ6xRPRmVgElxx62Jd2EJ0+1YS3Db9TooGh4/mlMXgmDQkCzDYd07S6JDTO2tm3tmC1v2R+gmgA5+K2Lu/3F3kqEW+SBEyHbcmNt8/QRsRe9NzUxNfIH5/pXW7T+s7duNKjkbjTPRKuJQwReIOB+VnY7pSN7gTJ5xxo5pQ4H33RZmQVHY/d5qTXj1lX+6Osm/nQ5JGElZNN1XlUlfVgtaF9RTNxM9LbGgYxH1UqCSGf2ziu6+a1n48bF327moN+1h4E22dIiv58gbwig5orSxFORPgGej31ssyvE5qISoCIuVgsK6lyoRKSiYGJZjpWC3Ti4g3XpSFzw4LHldk8qn6/alKmrmQyqWwxBntYf+3KfsyDagIVYvkYnukRVMiLT5maJEnWqJljgqT7B54Nsu9YIk6JVzYtLaXxQPLfYJnw/x7LhP/rLBOPTu/1tB9UndTagwqu+9ViUagnxRmX78QCNkykSLbEpW8qqiydAljIX0B7HN52AnKmGhufjwmdjBJHdDAenTowDhUT9qxDkkv7mKbeupUYp7OHfUHIsxAJSzDtU6NKg1Z9KlMBe99GGocC5nCSSP1sPEtGE0mLGkSvHQuzQfvEAAlBZ3NjvN/UFDDkN2ufMV1ZNVqgaFzVOxnWM2Ao9Q6zB3qSBNPmConYFgITDyWMvENIyNuCTd4SbPIu0STvEk3GJgs2zMx889YUXvpGPK4Dlw53mPMKeuAfD+2j/+qjbnoWullueDk13pYfQ086e6bQS3Bj2x8ah86SKafNH52dsuUhF8ANwkE6sOeiYg0LeI1x1bCOaVQAnEuOjgJS6ZqnCpxuW8/AfbY3uguuR5UxJMKj6K/sW4/r1L4QciMDUwzwP+83MMz+Szax8R2/Xx/5x9E9+6wrsm9bRezu21Oh9y9rIXDoDo8g3fa9fIPuXH3mGvfSH4O2ZRsxeQ+rB3Vh3tinwEVFw7YDJxutGExHofn4AqpbEQ6+sXwJWfA8KEStcjHlVE3uNXSBArfokIxLQ2Liis5kR1jayALnos+FND+RgBfHJIkEDdwKAZEM0rTu66pSD+3UJE79Q1kWMlpiBOzkWEIh4k1fn7aoKOL6MDQHDJRGOuMD/x//7iGQnR0d984XNu3NZ5r25vamPWiSkBSBLCgirOn1sZODP9XK7mDaznQcLeKcdGxYTirC8mCjyqONym9SPd7YpqSBserapnp8kzNrgb8BkMCNIXorL92qdEk8s22rGaclq+iKleMxHOVndVrTlRNGG9WSfv8QX+DrVby8V8D9tWbsRnNixRgrO41aV50b3spteCtPqPkMVi7BZtXc5GwM1sIOpQCXjRIaFvQyLNDaL9A6XOOhun1JsroRADMxDAmyJY6QPvT9G3jmcwRuWJeOQbRQELZdFa1Y1aSAXVlIgFZulFwpX5IJLMotkseqc3QqNzpVh+QRmo28CP1O9IGS5ogjVDWHyybDirUJ6w9fznZrk75WyhvQLgGX0IPxgkuKNtPFELxMOhJYc4CQ3kW4ek8JU/fEIidSBqw0JVq2HroChXKbvJf4Nh6zCO94Sh4N5vyE00Zp8X1M3x87MHExOhNjHlqu5e1O6nDw7H3VInVhbDiMIFerUiYyB2MJraV0vXSaK2Ig8gVY7xqB5gOup6tiD4xEtWLDcXXfORmvdtm+dYObn1SntISf3VFLH1/S8nP6+Jc2Vaxyf22Cr1JJa1oCPWFmGY9F1FJXuls6vGpHOq1xJmOziEFgFUFvietUMs8jJXMZq43nqGQO/Lww2IkmY63yju/baudZVyL7ODk5zZIEyiSbTTSHj7Ivs7jc91o5yDRS3ayGBiOri8PcWAzWHhVFIeYZ9KWBW9TkOJMm/6pbm6rfT3+ptZLXdW0RwaCPjNOJh3jVa+j6NGPaijuYAjKk3cV+hq/1MBKAdG9xiHHSXMcO68ST2ZaKh04LI1AmNEPL+HklH+fTi0DNRtkt8s8ydJhCFRlLYEulCkXXWogEWagLLlJBBfoDCfb4l3XkySdADjD10j5sG456bbKAOUj6/YBr29aJc/My+ARvjH/U7HmuLgZTXpT0ad0A/X9k3OdwSV/UnQ4B3oKG8Ctzc35tfp9D4CP486xmqyodEvotvD0x8Z/g5R38+RP+/Ax/vjJxv8DL9zUbfT30Iq8f6pTcfK9n4u6d4VBjstX0O/MRiD5H9Dfz9pP5/RVDubaYp6pkx0MqS3ZySgX+rUxMXgLmi3mpS7Y3oiUEreDP1IQvy8jzwbz0Ik1k+dzrv63JBCqY7Y3AXKic1GVWl1jnQAhbwtw1wOj7fT2+eJRZ/dXR2MQe9r3e/DFYaICzuMko2zcJWInOyEv2qUZ9QPZbFXicIDcQtIIkq9Ieh1/Vk6/qyElrNgTK3ywNxQ5H945Go/3+P1dlgK3EUtVne6g2q99gXrqknPT3tHYvuzc63g9d0HCss5HSvAV2/PE91IXm7JNKR/sUtLzxmUc+euLTqHfs9pbRHb2nHN9zIUMTYjj3x0c25p6JcOKE/aae4HCzgXtmWUaOOtHvmgXzuDO8n5dmD4/midubUM3Sb/0pZwvMgMRGDz8rf9ECM49+P/2zXjNFD419yDV8BeYcRhYPPTceobgJ+u+e7j+cGHquncFUytLzUluYwsi8hWxhsdDvqhTsUc1EegsGI8f39E3x+FhjWzuatpxUpZeBg2eyqsS9C2RmJnsCCzTopEX5BUBSQCxtAZOSBgUo5nvxMQlh46B7Qm3yEEYmlXHCVpb6p3dg2TKTgLuSNSTcZUtQ4nQJIDmKnOtVjcxI6+WZ8cGyEOf2PWd8wD8uC61GA6qZNa0ZjxbbeHi/bjh6rq2jZzpl+UmJelh7MOpTt0us+uACGebACtxO3EyZos9UurIqLo/UGL5ko+F9tpxMd/fvDLMj/XiHH2R7I3NXmt4HsVlqqumcU6/IuEbf0sjS/hamqZ6hk+e4Oyj2SOHkEiQcS7DAqfr9l1UqCY27y7FyTdgrWVSyUNdsSJpAVXqRdSQN9TnDojajO7gkUsnOosPX2Q18qibpp4qdyFP6rmIPq/RVRf9eEZJ9qrSOEOKtfFuRbHSIWUn2Y5UeH9NGfohNeMumNLrTxDk5PnZUVXydGWUN3eZjuzdFACp3u9Ql3O51fDfigNi8XbTZGX0Zwy3c2oM796B5m02Ki+bHKpX0stF20jV8qjnOMiBlLu3Bpg/QVcnwDPVnY9O1/f5d4hXkw2xhCTwvU5TdhuE7fk70AmquNWPt8ShbibUQ5209fgs2n0fmAvihTLVS4ataX+We15pxp3fSNxqNj4zHKET5o0zNlcer0TwwajQwr0Gd/WntVGlyoJAqu4u9rieCDbP0VUgoCfatPsDTTzWY9hEsceg5fEOtbwy8aKaV0t5qSxMekPw8JPl/EGlLyYQO9Tb6RMG+jshDD83eTugI17ceIcWe1NTUgV7rJPoYAFKGUCdiAFmMKOoLPvulkh8Yt40O/bK6JM+MRado8DCak/Pwjr2cOCWit2Vs5KyVFGzN0qP949HhnaNhXxJNyY+G91PBfqmBUtzDOhNnNKiwWYbfA1LbtGrt7aQvid6b0O9ttM+vW6n7DrpsoLRCvnZ4xn4R6dvmFmWnTqtJh1GTLG2lWxRwp+Ag4pegX4IHTMX2RuPhfTm2DAN9qEgyzuFQqWlaM3FSn5JvKsSRqwmVffbPXMuPKwo9JVk62h9+k0qkVPckmYz2h9nhveE3cnJ4b5iNhvgIP9noeB+fj/eH2QE/+EZODvhBdniAofCTjY6Phv/5R53Kr+GJQH7k5gt6Rnb1zJb9a/8Ydi/r5dBPzMbuBHt7Y19rnPvXwbmv+uyfP9cUfv6saXOQ10zF86DP/okOU5tn/nh4X42tEFmPhiJUwGjIMT+Rp7A5QiEilKG7S8Htu6XZGB0FCcnj47z/3GI4KPa8plIvcUXGfnvBm64O1ssBKFWjER2FD3WJyMkzWg3mpvyvbz/Kbz+ubmb7kabJsrX9qGj7kYFN01/dfhTF6RVmF8+IqzL0x2RPChTd21JT5X384W3oba19MXm6Oxjax+0M+2xvn0K+9/4b+X40+S6r9FlNH9WEPsJJ+gn++mRgInXzqGbP3IkEVmTP6tB7vaPko+500vaw/4aOyI8WtCZaDWXYiIP5/r1AbCB3CBItAn5dN8h/u48JJu0ZIyIDO51Oa16AojLeAB56Zya1NbyLDostNnTjSGvhWR6jMPUO8bswxNF1x5m2Z4sih1nVzCLgfMFAhFLqVzXjwC8B4EQ3NNoM9XnNHtXsE8zWiGvyc83+rBmwTQL5XLCDjd2+87rGmWXJkd+cXRJ7W1BR+IuOYLzowOkFemNslbqNS2YbgQeJ88Oua+wY/AJg7OFw+TVnQyoLpgrGC139CuLoizrSMrZEuwzUpIzw5Obbmo2g8crylew5m1nTLFq45FQLuVAyj1tf7SD5hof3Gvbw3abwO4yBuXHTRKHsVGoqkadnrYnKsePo1IadgzHT0LJ+PAXT+pAnOW2YlUcjwaYtk3RtSTzVv2ge32FY3+GWbqMvii1sCjpnhVVwuoAmXDAEAp571cAZmzcmiL+8zciFZSzNAsaivuPBxws2j9nY4wvvFnwRwHSkOzrkNmSNnSXZwNS60BU7Z/Mu1i9jzNT80rI0xpfIzFgRGn3BLvWhd25i/RDO7RAW83QeGnbbZ3TqaF9hOz++N8IzrMaO81UJRp/U2qXmXd8918YKY0TG1xi5T+d5WtNrsqktq8SJvzella/VhtdyxnKkSB7m04uw/WeTNIrCfljW1PF46Nmg5ipdUZDmOn8PacnOQG8eDPuBr9iVmu6gQRACRWkWUa3vcFfsXUhp5XRFazJeacb3Fb0iG9eRYEZM52YuK9e8OZs3DHD1zJqTcck0GZT+kGroBfAN9aCHzOieU1FLyG7Sc1RbDzPpOZN12jtbqZ6oenbS9d497V3lda9e8mkxL/hs8Lv4XTyYzXp57/4bzKbmLjUbDAbf+LJ6F8X5BZdghKYueE9Jznuq6i1lBfBpvbwHxi9gG1yIWTHNVSV7lewty3zKL6pyxiWkNiqog4Rs7ux4jJh9Qku2qtOS1m6BmsNwHhyGB1nOShr3KnJpbd8CNXCRp3M6BwOWHE1YIlWKEeSAo/eYzbX69Ec2jxWBYUEcHfbn3n9px174eKvBkJNVfOzcRD922vE405z1eucnxI9MPxJEafmCxl7U6Txu7OaWqbV5UQJPyekzKn0oIyETMLte18wf3cSrD+jzKBDZl86Hs2cBWHL1aR2dwhYV5G2RBWzVh53055HhXnRzKtR6bWg4w6J4+QUsCiAuQz6FCKizBo4AKPfZRkSMi2/rQJIFTXf6E5jPmDwt09chqflHO1G/v/MHEGyttE9LL+T7sQ59dVIO9O64qeQZq3JSZ6vxAtnkr4GOaBAfvrAXQWHcnogyxIoeF1rP1lAbeFAMD+95Fz03kXpFXaNhw6PaSwFQZAszCU8aVBBH+GlztTr4vMI4nt/el+4jYOpr/r60J5Yn7Ia0cmrjHphdrFllVkwVwukEKijjkLHOxCbAWLKNDtwZ23kc0ld4CYgdXwRvxAt9I3QkW04cGr6GiC2NLPk22BJCR/dVZMDTKGOytYSs0YhGIcSj4/hxL1FjlzhjTQvRNDy8S4MJEDlYCQvp0mUO6NiGJZWDLW9NMkXG8JfxeMcDRkAAR3YnWG9vgyWAoieT4w/IG3/VFHQ3L86vzMY1q26ARREXy0sy/jx3w14tw2toqGjbxBD7ogsrRTMJR/HG5Y7uQrkdwgvLLTZLRcZLRdDc7zbGv1E1jkNY1eIhsWHMQsL3iHmDHy20Y//XPA9DuVC5OC+DlBXzoQsuVD0OFBlDJmHZyaXKx/byorlUOaFT4FKtxtXJ6hRgv+AHROHmN++zf06D+VeVZkcAa4d+v9KUIdePM15yxVPueVUwK8PTAxamWR8TZwETLMtUblmXkorYDw963c4agXaT0XxzYPojy+Vgv3UDfSfYd4peirRm58Lyio0mU1EJxDpKCtGrScluanjL6kEcTbmYhYGPxWyjufcc2LolS0tWNxAV+/3SaR4V/Gq9virErLqi6ZSVaL5qM+v34/fUWDmy6UCC7tdDtO+4Kdl0kIvpRSW1Hq17fTmf11zRFZtqSziMnto3HYvsgtIphNKVe/S0URlr3JsrJZ3D3LiAPzM2pAs2pOesppc6sXLKwHZuXo/PtUvlobbuhmPv3JW2XqdzttzNCYVUK51q2pHqgi13p4QesDC830+Xu+zca1EZlToP43fNzkMA6TG5ZOf0nF2PA53lc8ZYbfRtkZ1wyZAxsLs7Y1hlqCKhlxoMfHd3wbCKUKW4IJizb+z+jPmNL1l6zi5JoG67OWfXG5CYMcbm6zX+XhioOD3Z5ji7LowQ14xCCV1oEgwxwTBO8KdgNzjAfAZI1FlN3ex8DbMmKzf0OwUcmUjBBTVqgACCCfG6DG19/E78XZNEPDgAXvGnMv2uBl7+dzX7LoQk3MSnwXegzWYKDYuDMaiZYzR9Z0XlZ5CbRtACt0NH/TPE+v0uwAtES5PR/r3+mf7kCj6JaDeT6ZWOf8yu0HDehD7ecq+ZPE41EOHjaMsgG6suORoeYJlGXvWxTr+rCbXV7bO9g5AdeNSVgH6o07CyFLon4ivuH2bBB6Ph/p1G/L1m/OfyPMw+k+AeWGzByfGdud9/ZGXQn1d16p1lfOz3r+CGtmkP+/+auQOGUexPQa9wv6Zn7PEgmOkU9NTiuU6vdhg768O8OYt34X5/IdJG2GBmHgyCOz0DNWNdfN3vX4r0DAjfK4bzTyrqOSiPWT3gApXxHrMrhykfniLAnGmcHuyKnkVnh9bIWxQifUzPBpfhTkay9DFLr1ijzuv1zJ8rV13nCmmcKelj9rhxrFBgAAVOE0yZ9KOvj21ySWjNXLux0ZOPWZCMC/TyvPN4wD8qLmb9/sdvEECb1bRmH+lHVkJ5M5Ge0Y+E5vqpJrTs92GLBWHC4+B8W68fB6cbnCC4y/tgfY5hRIWP67WZFuaD3H0QnHsYrtPDoKVX7MrAGuDESQmaOuMopbpEavMn9LGxg3hQlpgaxDQfv6kn6WPgjOkMrgi1XZDqGlBXIMnSK8j+cUccjfKAf/QKgLdgj3zMzsYwev4QGRM4Nh4HB+GVVnVxiDL/P/b+LclxJEsYxrbCwFQz4UUnE+CdYHhwsrKiunM6b5OZ1dU9DFY0gnQGUQkCLACMyKggx+ZBpg1ID5KZ9KKHX/a/yEwLkMnsW8psQFuQneMXOEAwMjK7Z/75xv7pmgzC75fjx4+f6zkN+TLzzlvCPc9LvsxoFm90wod4sxeshQo8eCWWrF6XP/DQOeOr01uls37VaBAA+enVjCgPCEZX7LwF3dPzUt6HeMPOW1m82cNVdPJjRH+N2I/a8FLh3OTgmriquCYuC9dEp1+/rNdvUvuKlhEdXhSX5P5WQjAiuPdQu+hL5b1o9xkzb5rx75GPS2/Zs6q44e8n7+1b4r3XY7/d/6fhRaUM+0Ms+DuxIAiMdYTJ/TklWi+W0yxkmaBUYVlxmY11zYp90t8fOhoe1vXdU7dt2ANS9AZ6WfbqC1PJlI4OKjSY7yY4hlJ/V2qlTDhjLAgnfthoeLbSweTEw58Fo0n6SAt0sCOsND+HDLA9T1SEuH5XMEATLVyW7B7DNh1oxlye/C9qI4VOMmd/SbVisvZupBU6d7vfx3b5Lf2uzCbTMM6LdM0m1Myiebjb2aJZtSFkwjOAnnkIiwrknUM8lO8IGK7XUf3897DmhyWVbpY+VNA4+rQh9fq1rIh8sJ7broPC+5/BCdWf0QkVKNQNqH3o/emVctm5J6QKqPUaQEE4HiN0wB6q6Y8Gp1k4GQ28TOsnC73uf4ptTr8LlVnRiZs39b1kTSShQIoZnKcvHmze3kvZXvR3au87OVW5kzwkag7S5RQX7A5JMh/nonRcvWuSc9xpj2UsvHCMOu9GcDpnHJ0mCoFH2ugoZsk0mlEf/jTcGU1ZbDo9iMvWXhXHLcWzlVY/HvxjeM5HVwhCMyAJlaJ+1TBLQxwrv64hi5VTonyY4VcPIzRUSgzOgjqU4ZhwFproMazAjqFGjsVgfTQ8wIsh0/jhQ8oyVPmgJ4aw/wetmL7EQPUMJTsZCIsSCpxOQiDR8J+slNFdFOmhCrqr1WkyEwilJzDp7UnGdcUOITm/IhST7lBxQ9aUngZ+QJ9LVDmCACTpfsbpTAUkJQ/7o9vtKupEjxUqRYQokF/BCnJYSDzMLj4ylr6dgKVeXFzSBJY0gSUlf8rgB5S5DrGsVtn8W8cFIF1RAYdnXD1SAGXoleQ7+mNYtkrPhcV59K9IseoyDTuf03dMqBI/2a9SrXRpCzsG4fwCGcxaL/NVCmVepfV6z3HOUMvwp3QiVby8P6U7lmiQzMf/a0EUVrbZEpK5xBi9tExxpJUKGNdk2rhmkjHXE7ISiOLICvY03qE1jWjrxyyfxL+GIRFGLl131O04XYJ+QqtOW5aftkzrzhZi1/8pzB1NoiNBkP5zij8/8juWiJ8KYeCHcIyCP1VcVSyjsYho6G4jf5mOyIQBFKQG0YJ/Yg6V7SyNrGKQe5FWcMCCKUX1EkwylSoKZQynKiIdkZ34jWhRDcSUq2BmkBQTyog1XxGp2CdaEVx1/Cg6lsmX/htj6ZVAgd+aO5KX/SnM/buc2CdorLVJ4iyWSggnvBWkwiZOnVSj9h9KAJzTbYYgQwbISxiMC18WGeUAA1SBbmEjuflFBXqUXjupgU7N40IN12OMU2McDBxvJOV9LzeqNio52ITk0BdPchDinhbEiaZ1D5XiFaaCYciSqlDZmduBI8iSgPQguk/BeV3RUpRmpWDMNKmM2pEVonZkVVE7smLUDiMaAjeib4tzx8VfDLK8FM47TQ+t/xQeRtJPWVs4m+JVpBYnCKPoB9c1rp9yTDVOUtZTIomSIct7ZTHyx9BO8vjzMapRCH7g917KhjRGVQSDTfhMJ5upH2V7Ngegdts0oRkd7uISKH+kAsTwhwAEXwUleFFsooNNQANY4QUtHAP24rCB18UGRroBs97rw3ov1Vr8Hi90Ywm+K7TY7la2+F2hRaW+DhtS1io0Ilvksa2V+2O5Mc+9lLlOUWnnjZeyUTHpFyhWcpPyAdK6xbS3kNanUUGOI7LeeSlrt1Va2fq640htCvBeq8YPLHf12rIzWJO0ckk4Fd4WAO/rtTE0lP94gI/FCg8opxFcpbJSYppo/v5IpXZH1zLH8JLyqlb+RVNIZht9KhwPHOv7L+VaOPkuLUaMTng0yX9605nE6majZui0+4K6vFd2i6KiVav2cIDBeiMmiYJdj7eKCXtzmf9Zj1rTG/KKL3SkiJCCZB1TcnVCUUvylEzKQY3OuKIPVMEL5QXKZHoYKhC3KKUMCiQxdGhhWEjOjQxFei4AZ79mYIEuUotycMhquiqvIGJnhXUwksryeZY3XChnSvoxoaQNYK7ES5NyKQj1zcHLnt9jAJFz/5on2m37937mlwidP5fo/86pn1yjjCCVz+l6XSvj6qxpZzYxP0Q0FxnCROEn75J+5MK5JjDo4K9nWY2IanjntAjPWRlYTdNBvi0G3YiZdkVNfUFhp2wV2vC+AmQKQOzBe/NfuOEcTDnCTYmIGeoqhaoDpQ/F3ApZYihXhgXlypCFJR9F4AlHSYVzp4x3MVQEcpDcF+uU/PO+kqTJK55cc+HF63mx1f0eGqhSVAzJuDwH8D9y+KbeSofDYzGyLXqVO4/thG5paGhEJixUFj/LuESOZmq2E/2LJV5WPrQJtTPQX/ZpSsihC3u+16+iKJcUSViRcTnV0WURAcXnGDgTi9COaUp9Qg3lwmyb0+HCwZlU5xEIFgIRabfDyoVkvhNlq69kq1kdptO0KpcZZS8b9wb7I7mDUzs2fjNHvkpPs0nimdzMSHYpu6bYoWnNLjPyGvG2eHrFaLRaqnLwEsTRGwwSk9brh2lFjCHjJQJsJKjcLe8E3fSJg9AkZ0soXmwdqg0kaBsp4oEnvBJ0wDo8yW+Cwn2W0C1GiuRTHs+YLkU/ROgdkOfOx7ghyPLQRjIP0F+Izy9xg50xiNJPWpfXPPsTT9IgjsYxi+2sdSliKylT8ORzGHPy2RJsmtF45n22nOIEx+C6Jkhbl4E89iBRYAaJ728LT0ou8BQ3RHej0rdbLmAPCwm7nVVDTNNE96zNTRxEWVNZUtQsXfhPIspvPpZgmz83VLijSleLADLyORIwvzi5I5y7WL1e4nFshmOQQRS2dkDG4vK2gd/Kt3ZGA8phAZVW5ZHBlGJaBUs7A7+PDB7oGeOTURm8SvJ8z4whLzyeiU3IjCU9yYAVZwRMXviZ38TBJHGcWcD8OcmI5oiOE6m6ia2OSdGNXqK1KeHY4dF2aDa5lyfNO3H2Kkz2XngJO1hmenyNw4fWOMzX+DwsRAozl1xT8jWslYNIujV5GO3HkRBtk4RoSxICPUVu7ewgTJ3j5Orlf84xEk3I/o9pKTCLwobyJWEIazlBjdACgwD31ORu7HbrWN8dLwPwF6kjyjJmJ3XwLCvJgZdorpYViIJ/CgCl/fHAdvB7v+TxGEMBZioU4Kf4wCHyO98u4MuStWzJsPDAyZ2Ms6KIJeindamIlE1s/zmmcetSzlSc+3ICi0qmjflalt1HGULLpC6dgBl8HDL550Buj2cfxifN2aMZ85W3UQj2IJkj4giOKyoWBohxhiNWGITonpZj/+LNrkYdqy7HRoxOU406rnCXZcdGlExhvSgCZcrfB0wuMfrCpKXK30FA7EN3aJnmXP6qFlIdR71g+5eBsN8D0zM9VUHEIahqv8+6MRN2hTN0CSq0oH5e8sCdHSYoBXRCeelA0ZjdxHZGV/mxooGPfs9pzNLAFi3QCNAMJOrGXHrAEok1zVUtxVd7onAOuuPMWSbouRFN7MrBMtQ8Dowl72IQtsib78QZ40EVa4oObqvageGJc2vgPpkykX/F8xwJoLG4BaMqGZYwiayYKaiZwSpGFHU/YjnyhN35BSILT3nx8cMy+klURU8SGfuDsQknYAeW6HA/sFgO/U4XEDukY0Mq6JQHsO/BSTHYKmPuKWT59wQo22cx3H1BRuy4dSlfFkSxkWLqMzF00zdPNRGiURcySSeu5+Q3hqiEhLiGIcbYL6qKi37YOGPsg07qqmPZ3tsxDP6fYzuGHfK1U5OMfWOsJ9d+LXKju4z99HAJKPLDw0XAS/tvRhEYhzjaQPwa5UusvI7Tp7HJuatlJa9BGkfE5Z35RuCmiJbAAJ91MYS0ATIS+i46Jjre4k9f1iJeUeIKjopeW7U1nVaHiQ6C4ball7ZDgDt+EUzilhmSjc598XxbiBMmyRR6GO9eVQOIigkQDNS4/DTRYfui10MfnnAH/eizPz4QNspUZKe/+eiU+1dAYwiOGs5tzuLPvV6Eq3Dhh5YX/NDaPuPTGB5bt3Hy8QVEW7lOeJrKd9fbJFj7yR2UAV2R38t3kC9UCRP2xpc+PhEodWDrZJyMiZIsNTvKB/4OdJ0LYUYQUX2nwCSRtJdGUSUg1q6ykB7TQFGv/+QroKkGRP8Bd8Ew+JTFuVzkmwjBciJkRF4eqA4z/EJ4QbdP6J8CATRyGqmxEEotvMiAyQdcEYtZk1qlMMqfISjpAdxrA1S1Kc8Le+WZq14YrvuIk/3Dl51s5e5ZbXWptfIIhtq92kPV8h07mIHjce8+KhPOh9M4DJUCd1NOe4cHtHewtAX5HZbJ71IC8xUVhpH1QyZpeqF64LN5ZIfUJxPHc3bV2iSXcz+cb0M/489XoCe8+C7I0smRdGzM08ajHWnmlC8SYwaM1+snxkPp3kRdmoWoNYIU+gzVqSSo7KR0FIihL6W8ZRRErcYjbkvuUxbKrVJv6znbFiSsqr250DmaayYl6rMAjTxvxVcpT27E1Os+IffAsQqlO3Z7rqJf1psqACZ6XgjpnMBGSYvyRD9c5qb1A0xvrssQ6vt6tgBpW52lTLDZXDhHEUsGIr18MIJ3nAciRJSiViBfmJRopY8w18VKWagWIxWLgebgsIiGu5S8FYQybTF6r/cozX2oqEqpTtyHLN3LAxYXz9QRNKydtcb6vQEQXTxbJG9KPRgiFtkxS4ER7OeeQMytJIUHxHEMpbGijxSaGkZpBPQ3OSsqiolCviSglI9H2dKPCsVUtGRWGHweOT6ME7+alFaPH3zUTGwOqqj4oCEeOuVSy3wriJeYqIcCkv2lZwLPZ5RvZ1q4eg7fsfnrtSSt6PXlY5TsabzNVWjkg65AyQth0AEPNedCl9vYirBUFQyww0YyVroYx3yrbJrxTyGUPrKupb7unmZZYZyuVpOs1210GdylIKqCHYQPqJJ8rkp/4DrDYb9QU6VBA1FWfuXkbShjbahKE4aOpcfYKiqDYlsJhDeOsxLLVB1Xm+zpc37AYlOMhGIoAJAaiGA9NGMJBjWgecyDpBDzQDqkSpgIBZybqSSFQECSSEzAwVRyJyyR4uRZGNqi0yl0wqzGP71/87olNFqC5Z1tWY2MNJ7MptAlk2OYPYFxOeMsVxbOlMwgYsk0Q8+6kThZUWsZJ2tcSPihJAs+nBwodhKXng8j4FH+xY4ITTge0/1+fywmQv5cK8ZEkOQ4LJ4IZlCvB1D65CTJAw1kEFlgv6dvObsN6Tt+EJg659RLFe+u6UQOjPWHlBu2+qrSoW85v+xbjr7i5iFCbcmR8DptHgvTAlwdtTgcK2fqvMJfPhzrktfhNjCxTOm34ZMPjhuMaW8TCjrzZE+/5yUYLjhIaX+5Iz1Bv23Z/fkNj7LUm0YxjWPqx/Q1px84fRXSe0kAeSfufran2y27XwbRAg1Fvrv7Q5xmL2TIJi+J6dU2WoQoPfAceiOeR57lDlpOq21Rgeh48taff/Sv+Wt/zT1LSG0W8dra0/mW3RtNbLet/Es3t9225M/KBrfbVkWyLvo8jpbBtVlKpND4hidJsOB/iOOP73P+1UHy96hr+9bPVkcKvONwYg8LGE+pQtKxBjGz3BgY4km3TEKdJJHp8xVfbEMZ8lakzcvhC97xpXc0tAHsqrmd393hHnsm/BafaDZnf+Y26BVDghnGa0+Pwch22zqStdsd2orgtXMwsvSHGKaS8HRVnHx1Yhxneu1kicLSXXO1GmLC2CkK9LbRgi+DiC/yqOmXl+/Onz3/cPn9+Z8+vHnz8v3l71+++e7Zy8s/vHnzx8tLcSI3W/ZwMcSum20rSL8PUqDvFvX6ZttKt5tNnGQpDgOV39/HDIpFwCK251tCn0GCVH2/Bt33fda6vHx//vzd+YfLF68/nL97/ezl+8vv31y+fvPh8sf355dv3l3+5c2Plz+9ePny8rvzyx9evDv/noVbmkkjkbdxkvkhSyEFFvr7N69gB8s3rthyXvY+4hbEjCqXa4/WJb4sVNFs6+wow1IezbLKynBIyiog7f6QvkEOOuiVpQCMJA+7UIDTrBJOgXbcpqv3d9H8GG7NfbYk2sMMNGd4RQUeKSm4iincPsa9gx6nEK1D30q5q0x8PEpMGGiiLQNiNQHq7JCW/NoGXdmgJCy1avezrAo8TlCiX2q8m7d9csKrpNhggV2Ux6oxyIGcuAVitLIReeMW6FQQTDty/PIJdYV4TsYST4GwMDILZ6GSThQyYPo3CH+LwxEb9X57lSWcv4iyuFq0L/TB5Aon1duXH8/dTp+ug7NXqtoZFjZedHXiguY/zdQFy9TVvaeDzqjT8yQ8sbN7awvP+CwJ5pk1Psm12ARt9OWos1LY9XCV1nzF5x+/f36OuPKRZW3TdGgeR2kc8hbHRQH1ALT84Z8QD7PE7ne74G+KtjujjnNs9khd05gldrfTGXUI9Zl1eupHcXS3jrfp2ZlFA5OmDKIbPwn8KLNPXGq9WEu+NNz36L63BrOv4YDBe+I8XvBakGJ3mw1fgL/FTRIvttgeOE4cB2iB8esWSEsWSGnb4XUa7PPQPYajK4V2FTg8SxL/rhWk+BdERpYPvyyP11R4znhZe8evzz9tJkqAWcyMWy8ynsCsJvkEW1aDt7JY8N9t0ko3YZDZVs0iU2fmGWq6qB2kvzJbGQMENM196ISsfBLplokhh2f9Sdjsg2bWnPXH89NwPG80yHY6b/Znximd43MoZaATRmMW73aKHwmmlRrXQ8zR8E5eXHSqxzKDV/TcR1+hMgpJoNYwm0RcHTZLbU3NamwaVu2vViNtWH9Fx5tRnOXON2Fn/2o14ob115alnJKqkMbGhXLi6qObmDtfKANqafmSzgvILGGW2h/YFBqxCiov38NWkKqfaGaRwVGhW/NtIzhINFAKT1y+NU8iW/li37IQHKUaOkByeV5EN34YwOr4YnUCWJ14Kc7BX63GFr6BOgphkbJYLRKt8U8bPs/4AlISsWx7k3aUG5PQaG9H7D4M0uzN0kvp2t/g3ziBJ8DilfxMeWYmv5efaebPP+KvQC4DfiR8HicLUWLlb7iXKn3fVHTxPP/KOzISYTheACW9wCjhBTCQPOk9fuAgMOtXL5B9e4EekRfsSSvQGwYGNnzB5rC6+NOixf0UqYRGeaWP/E5U+SO/O6iAaSZyjPZ0OOz0RogVK3Fiwqp8Q9ytr8BpmvgLz2QasWSSf9riRaiYgxbx+o7rdGhcWWqDV7Ys1Kd+ZaFl4l8bbQ1oUFlMjP0SDM5kySFNq3tN4mUQ8kQUc7s0PFYMPOHKYs6IbiuLSda9bMyh88pSfnoXzY3BuS7dHGtOvvyKpZfVaxMnt36yuEz4UpZs01X18sh3pyzWoYsHi10CdIuybXCJVlUWxEqyuR69riwS+r/dySJ9elNZ5CqM5x9lTy69q57mNlr4qHgvgcUd0KvKkglPN3Gkd80d0tvqec7jjVqLUX6vnitFjIdsnXTYRqV0MS6yG2uRNk+TJogyfS64zRvxxxd/UvEnEH9Wnn6FKdOrvK16Pe9TNrkV1Zbiz7X4sxZ/wsO2ZAL6b0l5LTYSjLgMxjUCC8IY2+yz1jMA4VfwfJjTrPVcwykmbUSSdL2Ubtc8Yds87a08SwzodqnEitZUPwgAfseXbAmf8qwzn2atl/5vd+yaZi2wO2BrmrUkiR/DT3mIWUqz1ns8+ziQAD4lELMVzYAS0gOvuCdhsrudnOZ8jxVKc/ukEguzq2hLtrLdmxX01I9XCEUFtS6HBR8CRxMoQA4j2jLX9Wi/S1lWLfrxkr4oiTtyvNS1KIXbdbzUWpQ6eK6Vy8WynNro4yVTUdKAguNlA1lWgcjxkitR8k9A3Zwbsq6K7TkwVa306sB3Ow5rKf5sxJ9U/AnEn5X4s9jtHt5yu7Dn17td4Xtd+g5L39vS97L0fVf6vip935a+b/DVCZ9vlux8Twft0aBz7L1lPtCQBCFQoesMH3ygscRug9gfdDIFReHj3/7YQBqCPCigA0EHGOgCb/yxMAMQ13rKxMUd4t/22MAfeFOOBfkrrrk5/u0fsRg4JI8kT5HlKeOYbQ6oJOrniYooMtHh5pAYKs5zU0kGmRPfHJI/NCikSmqHpmxzQNzQ0BiDSXSY6HZzSGzQLdsU6QU6Z5sieYBvpOUjyE2kdbM4ye9r5AOoN2XGrFWWbVLv6VNs/pe0FSfXTxfxPH2KPILmgsNzPGmtsnU40Q95Bo+ohLnj5IA9NE4aDZI1mFX3k+t0OoOiEbTx47sXmqlm52/SZKaeSNarIBLPQhHjAQdQ+werwRvWuHYTpEFWsxpZwwI1CIzAsNyGYW3N09S/5hBqAc4ApEdx1Fyrxhb8psajmyCJI+gRK2NFbD+t+dGi5i8WGD7XD2srHm6W27B26ydREF2nLWsvIqLcB+mreBtlfOEdcBpO3D3lEUbM+SFO5koqYZTT+e84BoR4nx0r8J5nh5l7umb3BjfjumDXuxGOEmjBpDbT3j5Stha/lUJxststjBiO0IH+uvvbW7425PRlTxnsfk/N/FTO9tC+R6HzEwOd5wB/coDkizHXVzZG6DUH1yotsA2ZyPy11CgsUhzdMt/MwiVW1ayx8aply6gPTd/kTTOjGxnX5c7IBW7BzfgKljzNku08ixN2R8FbplGP0Cu47bcJL63xiSPDs2hhphAsnTMpO8hnuPLTN7eg4LnhSXZHP7F7sPEF9jpfwp/Ly5SH6hcyssBuKQfEy4KJDvVhf2XoJ6mPpCXHwnAqAqaP5iBnAEXoxwJ/EWrkfOR39bodMJD8wwehGTkXhk0ZBWPSk0+l4duQavvTaMYyYGiNq/lmzbYS5YTEz3XhEu1Iwz0NcxypmWyAhx2TuVZgrTXas7HRGAbWRUpTPiSE9pFegZCVcjQjG4avZhHiLMp22DGFHx5Hc+wAdyqleFI9n16ii1jvVinyGa+U919FHsd74Q/z6cXTxtPrfOc/mhy2h9sTv5DhMjGPkbiC7i1meRZzLGp58KNt7dWN8A1wUBOBMe2nU+bNnl5Tu4IAzaZ8BnIQUBPBfoiXAesVqUy70zeM255rpQrNvNMM4QIbH/z+WldxHHI/ws/dzpZe2yRgnbhGpAJOQmVaVpMP0FQq00hiF9+YVrRdX/HE8rC0oTGi+MrH/ILE6g0MFTEmlnIZWAtYYIcM3e5bFmqWWS2r8dEOwdNWRIv87YBAGGXLkpxfjkEZ81V+Rq1v6k8hrNJTi9DndoCLZVmVy87Bp6HUcQnqdfu9HRA8s1UipUMAFqpyAMUZQrFwjSMgmYu7R8Ezb4kf+70d0KRhnwSwy7tdCNqU4DyLMUyZWJYHQIAfpHpWDY4qhai0HkAcStjFEGPy5svnRQ3Ls2hZNkBytOCMt7ne/FapHc1Z1PhoA0N4O6NbMg4b7Lmd4iLOaSCtcECDlR1XNOD5Yya/56TDhQOSz+ZsWa/z6XK22/Gp9Y//qGg+a0YmMua5zUmVKelcGl3PlbkohVmdwOhRedUmpLWIIz4mYhZaXRlnIyZKYeY0MLwQaVTAUh1bGI4lVTdzx6XWVJSqiQtpZqFtuaxauw2yVQ3k27V7q1EUd7d+iYPItmgN9nJveVku1TOM/d8YcuCSJJ/L19F0RsH0QaYCUogAyo8AunBbgM4N40YD5a1R3t0vkgfWFOoBl2nmZ9s053pdJjzdhhmYMKC8TRbAUCAyDw3pMW5a3n2G6lh5g+iHLlPXhtGOa7ZD9oQ+2EZer12qp51L5HPQMiE9B9xR/Y2Xw4sSoZFfEq/z0Fg6MpaJMoskW6dtBJ0SwSY+sPtq/R3vBTUzvoNUqet0nyV+lCJB7zn7QrE34nKkL9L38Zq/kxpRz+YZmKSaql/UT9PgOvKi/ThraRc39yC6eEOlrptX1joAsCvokkpJGpKDmlaADQJdgzm8JrzD+1AD5UFzjQYAXranWYz4qEpXqVipiKp3u+lsT+MoLNYEsdX7sjrDyna7HXM39sibVCQmMBmB8sxT7uhXqeZ8oFlrHsYRP+TlHRzh4vja/QHlUuDls8iGJ5S4NOCZLhwvpdLdWsjUBWLSo/daPeeQFKUhu83NWx8kS7lUgxV/S2QdXhUVOagGO8dYgjlVO6+kaueCqp3Pcpcq2XQ+MxQxthMgRz1IFbyB+QNE7/wY0Tsn94rYnRNtibFhznhzOh9vkOjdmETv5oDo/fxNf4ReDRG+hFqKcjZTqZeSLwGiQ2GIbXOW95nSSmMYL6MFWxyPF7/bkJCtEu4vMPiA51DFiBZ6c4qP7QlJa0vlGl0HVNkEgSsc3lJVGM9np+D8Uqf84MP7rsgmFqjgshDHXMvapa9GxASyjQLbWlNcBay8p5oRVc3kzucRSvVRj+8PWbrsPRV8qAebmFNlxOvdy8vEa7pUXhuwPGjt6/2CPayLDPAqonGrXj3gH9dPeB7DVLprzLClbcqfKw9DlQD02iZmIcxTFR8CPFVPlDGrfc+vttfCvqvA0MFM6TDgoSZFEbPFF+sNT/wsuJFKrwdIsdhAubgsJRt76d/F2+wR4zALmqN5dWxzVEUoYFZ4xxfbeYVWXrGWLFUc7BHxi66ytLkqWuYblQtjvs2P6Hf1B+1R9zEcd+S0kz0dddtD1+NVvPaswJtL0BgjIvfRbmdHIootkV47Do44jfKKsTTRuddEl7gWgHooLGV0VIySTBIvkWX2sjmy20ETWk+EcWpnLCYGu0nS2AKV2JHJYjKZRSY3KsPrHvMuL1lE4z2YUcRGabTjiPAPjQutLBB5TfmMxTkRnXP+Dt5c2gGXYjHL9WG8tfY3dhW5I1/+HEn15Kw9seKIg46NpZSYMgqaWOqLt9IwmHPboUnTNV8Z1KLAWoZX4zRpujOvjc57P9fc1JlRS1TM09wZ8ayHKyl1ngeK6amRfWJb5+/eXb54/adnL198f/nm7YfLPz17+eN54RGTn9cnH1a8hi+4mvWkkTWeWKBnF0h1JGCMx+hCDHJ544n1ZE8oCPAECNNSb8/e/f7yw1/eljtTzEBUj6KoTnwApnB3+8yK4qxmURB+XKVZYp8Eu11w6kwcrxFQX242ylLJxI6Ztd6mGSqQXXEL7RA1Z+hnSH2KLg48VRAKVSOgXCU72e2SMwVWRHJC5Bflclyw2ElTB0ZC1+fZ3ubUqilSyCIkZRasrt4zXtizuPAVwY7CUliE5IHBwyP4UnGMNCsgwYE6hJ7YSUONy5xGUzgPQ6WoN0s0AMTxtiwysTaSrLQ8Sw9/nDKEDetJPvwnVu2JHnH4yNkoJJM2mNWqveNzDr5PhGJbDs5yGqQSvN5/eHf+7NXl2x/f/+Hy2Q8fzt9dnr/5waIAQ9xfC64NqfnLjCc1yNEVX51/+MOb7/G18eLV25fnr85ffzj/vvI9L7YKBEu1Nc9W8QLOAcCQ9lfJF9ae6KbVmN6dv3r24cd355fPX755f25R623C1362TXhtHsYovSvW+P78/Yd3b/5ybBjP/Qh6BTQvhyPm5dfEbFFlUsa0KI7o1Y8vP7x4+/L88vmzly+/e/b8jxa1FEmD7fFFTdmX1bJgzdODsT1/9hrW6u0LOMJqKJtgwykuBRDCoAt3UO+ndy8+nKutef29Ra3bJMi4HDqPFgc1Xv/48qVATO8tar3y77ADUQtIN4GTUtB0FPO2KuDix9d/fP3mp9eX56+fv/n+xevfV6/oj9HHKL6NaihzhEc9rOtDcPbj6/d/ePHDh3w+l+d/On/9IQe4bZSugmWmYY5Hixq6mbUM7cDWryzb015/0Ot8Tijf6bq9HgjlDcbWbndAGJhhWzDkPCeSZZn7lcv2BqUylw/hxB6MukMX3sCgz91uj8g4sTu9gTsg9pz6xIgYG9uBedeHzBmHp1qSGyqe5pal03A2nudlp9vZbmcXE1hQ+CQFZVzBZUBaxtSbnmsLE5B4Qbmxb1A9nNCg+Cm9/Ifx7R/8cPlmwyOweAYm9olgXCmwhdfhKkj1NztxCZVlAPLMMurbLFPoQxUsdSwdG8fRnNsWAD7dFGL8bGwpKrxUPUg/MdGCL3a7CJmsH4L5R3uJLRlVl9J8MlrYZC8hRYgmcrZAvtzUUh38Ibhe/eRnPHnlJx8tes8jeH9Cjnfi0mueHQqva1VDXJnt7PeEfsEYvtsulzz5+s7r9aoRXfNMNGyTLxzPSwTnv2ExxHl4ZK85un5chzlrCXtW8Pq+5Gyscp3sijotPYDqddTZZE9Tc0SAef7DxqJ0CI4NhoExCtnTYXvQ7n3+WRZrdDro9p0eMR9Rx3BNXMA1UG4cFZDLXqPJmEak8Ga5RJYy2o8fEGmKPbPfU8S8nzebEVeAMZ1n9Fnrnblw7P04AX/PwwFpoa3y+TrIMp5IHF/5GOetMEgzHvEktTOigBavgXa753RATSmxu8NBv0ta4ijRkCWt69aPQZQN8bVVMEoV5tJbOmeJPer23T4ZbyHo8by1AI5HGF9P8p+2vC8t4h00saFLugL1abs3gKjvoB1t91131COgBW0P293eAGOVFtAXqD/b+OwmrV/pHbtpVT0/6JXMqCQc6a3MraYO6Xmx8hFqIL9An4GoS8TGXQszqjfJ9wKI6SWbWphkUUuQgxobWNTa+FtMSTiwBK3Z2JTLYwiF+4hFu11iIxlBKAc54P2eGjLo3GeiHbPMBO5IXo1CgoYKbmCDmH/SWB1Ro0jpe7eDOuoEv9Hpsu3CncCutZaLqlC6e2JZ7QpBDVVaFiJFgKbyfA8kpxkvCL+RBatKLMP4VvvkxBS8QfUFzKOFOB95EowJqqhvUGgGSgE/Is4X73K6QDYiWjhIV7N7iYfLbFPs5Htpd533jTu90L1Bw88BHNiJeJfpBJHvb7NYghDumfEtCuRIUvUgBQnnkshlvJyy21nbbDm0ZA+3fpB9n/hBxJzC8ryKE240iqp+hVWW7WMS198QLnK3szeAytq9Xoe0UlJqg9/WNnZeg5QaNHJymueZsr0uHINDRP6sgMihluTClQtG44o7Ccf2XrqboRmhJTJRUJNHzLT9ReGik/RmpXBdX266hvxmOocUyFtjJT6ajj+2tj5hzxaL56tt9NGi0seoj5Ku4gQNAWtGAvMkHOjYbW0rjs7jpWgUmH14sOTyjtG5qtxSxYTTCYI2HWM8NmUZbGfytKuXClXsiQbLDEQzcT1ViewzdZrBeS2c08kLmxPPzg7PafmQggvww4Pr0NfCMN7mpmJCvNvZfpXR+zhiGU1bQSrpy4jsdpEJR+Fud8jCMmyPM9DuNtGodLV/Z1tzsV1TVZ9aijq28lvXmtFMCjIjbegIii4ZIdQnn2xO/XweQaGrrF7XLCCHVDLbdrtiFUnEXvPsrSJwgEuEdgE5zQNrW8VdT1vLJF4DZ9WG4UUkMPDvBMaKpo7EA52KAG31zbELAFPFrnK3mIFBmepQqAXwDRTo1esnycTOmE7AFxzGAyzOVEhsxepM9Hhc4n2A38QYoys1c4BnX+hUllR2/XICIAGW7Z4GxYtxt3NQGUmB96HiGSgGyCutXndYPsR6/USeADsrIm1xaQiH9BZNCB6Pz5wsGk30cVRMjIR4Oi0/ouY5q9fh9BGcd3bs6fnsP+7B8+Ar4vDFUtnG554i+IA05qDy2Fr9KuRebqO8wDaqLKIKFNGLMBw2C8KiV+EgGlUthzYeNnc4YSeOd3DKYdp2BqgoKhMDEBonMi9vztQhxvuPWcCxQx+yH3OlaOkR41nB6RpCURVW0BWlMTaeqELlIH0r6KIK7XlWCQzqkBTbwdj5kowwB3JAkUiiQJIiZFwNFIJeyWhVriZXHqiqCxlBlquKy0O34v6CxsyydDTWMYnhCAsMFrXghKNXRGQOjQtcicoW5yH3E5tQyxI+1B8oiidekeWlIpImj+UPLCNeb2+Y9iDaHZs6b+bb85Q5AvHluEze6xPHKyEofoIOaDUK1Bg6M1cJV0LmeKqIZ/OzrIhvkeoopFTBJz9jbyacvfFs3mxSvmP87OzMVT/a6kdX/RjqMn0KYWgo3yNa5Kf5hcI9NcV8eGWixaGOyRF8YfOCWqB51re2BUhe1bVKiPmQ+imj7mMkUkXDcu0JrSSf4pwx+ZoWgse8fvz4L6EfjXQ1IahpTDMXQ68Yd6BkkNrq2jNkERUDdg8XwoAu1VwOZ6esBDD0pc2NSX6QyNt8JSGlWXg1FZbpLS1F1n4r2sB4/ie6pjkafYGfZmUC4gh5QMY5ES5SxoKGX/t3VxiaDkaGgpuag9IJ+AmB5BKzFeHrtTQbI3L+uwf2OKt4Dud8J3y0m7t15tCs/EzGNRBP5BwJwJV20A4SO+TMAc060YhtrPArmxfeRjXYjCyYf6xYgLzW92JfsB60aFG9ECT/CfS8Wrus6p2fAyY2QhCCaBHq9J5XDOPlA2sMMLO1LWjKPKpjo3UdpQwbJmMyzpv+roCYTfgRalJ2ViQmNNKVYi4PwlPxs7yWbTz6chQtNCQsIsKCqdQyIkcXzrZBdCoRsJayZ6V7jHjGkOZCfw5mpN+hVCrTGM5efvgMVooWJu7LXyuk8CUek+opahzu32hWxIK/mQ9oo3UQG3KjRZorE5yY6eJY8/zZzAssLIdmpthKojzsCYHaYBCRHCMUOetj+wSUG8yy8FBXkS3BqY1CvyBPyePH53hLNAxWEbnizWmE9p3B0ubTZIYcBvVelaRK090/K/j69ReF+1ieWIuiucrGT1LwBmZz6jomG6e0jTRhXDqmQ4uV6lvAEX70yw8a2xbvwAKW1Xf2WTnHM97Tu50ECc1ykuP3auUrtXy9VRNDPwhWj/dC/KXKRM4Rfvt+UYHki5yYWrGxH8zKSqxQnPU4Hy4k59J8YMvaZnO7nfrV5OWriNTrW9sSubWQp2ktW/lR7Rby14LJi88GfWNrrDeR6wQOvWIUmqOHHdgmDzjRW9taxDgqy8C7OQ8IfhUnfUhamYRsmQYkuh3XROu4xAkssY4UYUeMnzkTgTaF47pJua/jlANnjvEWb2LgY/PlTg5noYibivkkJxKAJY0gN1r7B48keW8yAyIwRSk+Rg8O3SdsBzkut7YlFoyAuk6hHjDeK51CisqVD1QVCsjg2uuoODKR8WKQNJU8lT8on8kCyueKzBDvFU72ZusN5tKtbUFCDS0o2O8WoLaWst/9YlGzpOKRBsw+yXa7ExHtj0eA/LgM37SIt5nxxZNkEnqGkWOK0R3vkU+6jaBtAFeGAT/jeh1frjHo6/+ImQsMIpZ/AhRvbQuutmi7kRQJRAh8KSkdWwmKFlV5AltbdF2VuQAAs+i8Kk9KoVZVeXIaNCU0OchEJYbwaM51VY4AwyWhG5juSWTAP9BRZTnxSTkJj4EsPrfNeza05dKrm0+oQ0Qmw9G4pwPiJaYyRgBV4sic8lgYR1Q8EEuO5KspCWjmTfS9WHnzoJPCV71ufjWbEgeYBXybU7FyKnSMooORlATPjAkZi+HrnZZO907csaEnksk1Eo1JPWOuOaIwbLjoRQKifwG1cC/CD/PI1OvyS4ZVMrLOXKlX+KOtzy1cVCcbgc2XPrBPsZOacCmVclqTQs+osFTmFzytE/EcKGz9Ss9LwnJG6LX9MKzDKuO6iiSIwViKr7tAn6oPHLNr84WxVuAnCzx8fAtVr0VVjTKSlvgJ2Ex7MsS9VYenwuypUqi0STCKihpBbqBXyrBFzKnWJeqqpfW6/jnNZpOSirWRRSbml8k4NtPZNKHm98xDUNWapiYCEifSxHMipYDdxI0mjmkCICIPhGBfQHpNv7US/RoEtkyJQ1m6xNRprqYp73NUDUZ/ihDLzNvM4MApC6qqfC6uEZkFlAb8v/omVP5QETyKQu78+LsgfVTLofAW3r8JIVQN4oTnMVHFWYwLgxo/tjfNtPSZM/ZP47HfaJBo6s+qRlBaLWIyJ/fipv3RzjRy0A8C4axJ5aAb1Dm3A+qSwtCazKXl1UXcKKYi/4KOPH1wgYoQEUeVJE1QKGKqAVERNOwoM14cWQyiZUcV7BCs+RBHBAmRyGB/5roE8PzNC4vXjB0V3qgVXYKZScVAogNWZGTCWVSl8YDozngsRFqWlHNJVNpEvmG8KKevjdv4FW4GENpJcUf8hUZSrLhThWJFTPvZTSwWL22o2rrS0hqjfSdGWx6raPVZGKqG0wrcEjxYodoG+GBEgvLXsjG+21WMLiuPDoDmWAycSkkSLyJWjVN5Dhr8EJZKmgMHLDHJHS2xyQpMlO8lh1RuCqBurSjjVpxbzKvw6QzkNKjLY35NDRpo/wckSEQcugdKCCJGkCvkoaYK+kK6RnWVoiJQcX63ib85ck/RpPK6ikx8HaNKOJIQSOuWYrVsbQs6AO/ZUiHflJ1L3oKElqSk0YEXUM63UI8w+Ut4dieKtBb0S957TO7NzjG70LsdGx0KIjUGHGEwJQV/k7GYgCZFQaAfw6HNzXUyJdJCSztQ3dGkpBhj7lYHVnQazyrdrnPMsGURZjpPOHwdyBRw+CyPNjdt+yFGLCldq5eKfwa3K67b5dSf5WAkrHzhk0IGIYdiv8KgjAW+FMw0uLPgqhC8aX2Z7CXgPeAozpTlT0WicL37QroRmbFjInzGwPOIvQSt0t6w5xBCl1KT6ZimdEFx4Ije4BeoaReP2yN11ivH8BU6649QXBBs7C8cyg8C0fwNC6Hl5l+vLaGwndKVuAR9AbgP2HdfMpevUL2vEkvDGB6GYRjeZxwGAPdtxRLb7bn9ASF0ZT/DOwnUzlGL/PNq53Otdq7VkmMWPaRf7Kvssr0WDWTOh3fPXr//4c27V5fPXr47f/b9X/KUF69/T9ODYj+9+PCHy5fnr3//4Q+X4INTaUqOzXgCReadob8uqYGWTpAPATkxgZLnV4YSYWQ+gZQoQrzvgKHok7GqBbpu4smh22E6uskJeGzAJhBnA8ZSmptxJcUSm2pYdlygZoE6kizZ+IBlnTdmlzO/0iYprDBCKi0pu0fzsA8q0dvmWJ1QGHueBSSEsfzwLZdLeJrIV9P41hZtwpdf1WEp0/tVZRRT/KiSqx6Zxgw6hRm5R3ReMbqQrolfTKYqIgnIhk3C1fN/UzKYyknYcYW7R6Pd3a5qflqnYCLuIiOEpGdUNt0sAbtlqdwQkKIRlmbG5A/94hHISO5RJgHKSanV8WorIunEBkALn/K8DEiFk2mUDnIS3iDEDMu+kND5w5pmBTxb6rUAoQAgYamt0pNqX+jsYfsYNLex8jJS9lBoAGGcVRmSR9UoDFZdY5mERiYO4uoz1+ACxnRhZe+PYx47Ki6HeIv9HbBPac4HchqTV1WartLoNqYJ4jZzSpOsvIuOZxeL5Fgh3wyzSZoV1w3Y2gW0drBv1YqPYUWRAgAVbHcz4adhv6dor/poAyp9UP3i4o2llcenrGDMkCV3xrfAPyZxe1INfVxUHXOjCSTwx9FYQZHgIIHx9VjH/Z1fNZs0tpNcm2+fteZx8hEA6dctT7P0h4QLT3uMg7cOILaKRmE/mRiEvZfyrPsF3yR87mfcA0pk1B6ALV9u5xUe2HltP2/nNaeb3Cpr+YBV1sokfxZsVW2VtWarh+iia7Y6QhfdsJVpkWVYqdO7YpY2rqdXxQzD3pzeFrNKxuv0XGaXrcvpJ7Yp2XeZTm9Nj8nCfCt4vPlWgP7DvtB8K3is+Za6dD5jvrXU5ltHTIcDos+JHz5HjwKa96EldqYJVoE5UrDPUlonOsG0aJLRu6TZteAMCBcn8hwXkthJ+lUmUEWTM5iyOVxxKlVuwVZMPOHeJvGcp6lZJ47KV1YJg+Rua4qEAAUyG/qgfpHeriB3fIMAuIYyZm/cnAcvUtw810gQGSGPaP6TOXtU/ScnB6GGfXLfbBpIjCYT2+Dj+cI01lQQBe7DAbWDZ8dQaUKLD7Bb8O3o0cXBkEdwDdGlEs2UHcy9QIS/2AkBGbOGp3EASk9iO+FXefd2uxOVqLHwbvdGRLiOTGHycwpdBtQXRiLi515i6RyK9ILnKbi8JvDonoySoZ9m3x3N1YuvGtL0snGIikvm/p2MDUtDLthhHl5eqF3vl83XnhWvYwPRFW37RNjtrF4/kR5on0mZQdm8r8J0vNqML/fk8IAZHxTK+R74pU7JkVcN5t0Uq9yoOjdfbf5XXQ8Rrq6FX0ymEkLTzxkMiqh0WQ6LkSLq5lcskL8PdK0MZexJpnCbLY3Y8AN8KyUTRanf2DHV5VA2LGpA93m67sCtNINKDDIEEWZZZ07fM1r9S9862iIKEiyBIgAKCtRXZCsUUvINDAt0iNmdsY49WDwF8qGmwABtHgFT5FLYqmMDvCGAYJSxR4QGrIr+GweSrkwMxyzA1zlxxsmYxNN0xhKaCPPE3c4OUdUxYWIENG0wdxy3/DAU6CRlIRXQgLsqxXcxODYO5EVcWKVGg2bHsVGAnUzsqoEzkSnLMPnEfgBJ5Mq+ZfSCtndCWT4ZK58zSUs8SeYsN7IBGjUntYOlLacKSn4FG5OtmvmWzunGWK/qITSb+cGQCvLywZUITf0jC0T2B+2xhFbBlltyF51zE6KF1vTP5S6yd15uHfWn1FWAH3LUBRsTAHAucYfB6UjIvbHzzSZNhIoMGgEWrpjc4lAnW/oolQxCckrnF1twQ2DNyhJDo3k0cMipyt2uivJRw9/tTNRkP2acqJBZBPACEVuQS74QckmFP6T6h6qMm1/VnV6TrKiOnT8ayzyFkwiE9wV9bFOyT0SsCKWRra2ADc8TKaHvjXe1dohjvmEVCpGPgxL0UHQrxcdEi/Uol77ec59S1GgOAg1Xs/vfm+z+KyU9AcZ+0NLP00Koa5P7ZDrzIdQqEYOiOfDJpltatGo/prx2zCcQkhjcX7Qsan1//tZxnI5F9kbkY4x0/Pl4SSs/VVH3KyWFP8iUQ5mZUXM2sefscSWPyVLoYVl6j17SKnx+nyjySfKnBWOUMfYMTAtQ86uwbObD8z3Id4hXqRJZKFghoS9oWhWUjG9IWdh9lLlH/Sp3QjSAOz5lJ35BLmxHjNPwAUv9rRadpvX6iVGSg7i40ltsmNuzc1IJIxn6W4TDI7xNp5OMKZD3MrST9w9sbmkFThOOGy8J9SXWn1S9F1FLeyzxsoGphHKdVHXy7LRIPAmKClrwx8VoEsnExzavDo2GE8CuBccFoujiAccFM1S0OvF3O1s4JjDHCM9HNP0V4/QpTAKW3i8g5OAg5jvQrUIeIwEjOACXoouFKn5B7qbhxHB+YGdM7jE0YzjPk/5xx9EJw+glCV4NemtpxALhTT0tm9grFahxboSfyqB3x6z6xtvdrkjDOkQ42JAX+G6naCcVSKSS7BhXprJ7KS6iWkwUUwR+L6GKXvJ8ChslBUjzyVwQbpUNeod0TWW5I/RUg7nCn4Kmz1K5x2r5txpEhLk5AFVQxBqwGiaCqXIgJlas0SirgZbrmopRhRbGqg00wxI/wUI43xau+Qm8ip/Aq/gJSsnpwHT9+xLbqhSHodKsnzPeyuKX8S1Pnvuo3EJP7Km14p8sKnlc8KcJf/10HgQWta6CyE/A7dSVn/J+F0rM07b402yLCm4/5LKq/Jn4t9ZM+421ecOySKnrs6ZLDKYURP4cP+A674BNJzVSHqFD8F/AneCz/1QXi88+Lw8TwrS1LSgmXmHEo5gT+HwzM3i0eFC8VjwVYtxV/AmwB+VUBMJSV6L3iFtTh5vKOWWC5I7yA6h+MsnNEucYAD6SN2b50oOnheI9O/KBQpMC2W5y9VCNvah+DleCYffJTW+gEkdF+EL6Urj9D3c7+ff0wvKIE/NZLyzVDiEfdPhY6YVl8zkvLJuv8MKyp6ij9lWyxUL0ggw1LyfVWwJFJZ2eUHMbHDrH4ERb/a3WBeIpEg/UCVlCudSjT+xh33UITWUYXNuCu/cdT+PwhmNs2WI6jAVDx6pkaQJD53kKmmCClZZZ9W0SrwPUeV3q9BUGkchzVjpHuXgcGxY1ZrCOGxlYZRFH3DNDD64NC+1pOstVJlguJpmuZsqeXebBOeYQciZCkcY01b9C+SuzF3YCBCcxjZivoTfj1K+BZw+93LBq31fmMxX0Ru9UubRUzrcjBo9c6ae6eH6nq9leUFiVhAcV3PDptjh92YBc7laCeykZKVh+Pjssg3BgL6Qne3CxlZdfzQ48aeFVLWuXNV+MdTKXAVw4TxIb/hAvK3a1x//GpuOi6UbMiiTM7KtS/0Qn4pOFl8OekXuIYzRJin162XQ5wxp7Av/s7UgKC3KBkM/UAkg4ytfZP76GPsLPuDhwbGipwzHU5BxZQpP9nlbpydIjvA6AJ9+OqCXSiqrahrLT53eKG1uL8ypqUXDYriN7tUdPbfTGdDp7GIskKQUCuaO2b2PI3hXVZ7uAt6hvZzRVmVpZrZgffiZ/+5n8ue780EGTdrVwWG15yDGhmmOfmNhmYicayyQayyQaywCURALLeDYW4CIX1KCKHRvxCPM2g3JMNhEb9qHQAii7g6etQpvQn/mwPxERsB4eeIYsDYAGOwEP7RINwvTT2Tg3Kv9MM1ERpAhNIBgZzFeZAeQWQ9dGyCp408FxQa+/x27ecgAYcRsUoz7CQc4xtxEbTRzEVO3qQ2WgmQxZSFFrGYQZT4qhFlX8vKomvufpPAk2WYyxdUgrv9n3uBxCQU5YAyCK0uxb0y/1fyYZUSRfCg49xslpZgZrV6+AaTIbR8bUmPkBDBek1PPegVq2cJBWENUiJN8N+esxqpXTCLYVcbgidorKSimoNLc7fZe0gijd8HlGQwjGm7bm2zSL17udJdOtKnRG7vXkuX2gk4Eqv0XOpvme1XEi7EJwDr82D/00rflpzdf3l5VbM0kNG9Dny6X4mR+ExqdSQdlLZEu3+uEM+IRNMfS2BaBk0UNerww0BRY1HjcYOWOj8TNnonuWzB0vH1lmDCujjYZRcb+nondp7/vIAei292NlY2N4usg7M9fnWM/V/eKG6WeKqFLg5mhXcWox3eJAJkbPhU3xShnYEMyKNptGfcr3eojooqhiiMe6KO27agZcJ1UtsLI+NmYqaQZLi4azfLA0ETEocfIZ+n/5lI1J0mBcpWo0pGeA7pe+qPNagGEv5raTmzVJli2TWT9Gqb/kNrjLcwj1jSGmYP80Jsgg9zOfJiymEUtpUGDzbZRuKDRLQKTtm97/qM/8gowqNucjvHgdu+mVW6IaPy2Ci+ndqpihAoGZpxpzqgtydFnFGStnm/CnfGtl4vPymmc6jJenk7SsguY7hh60KmDO5BvoPnWtvIPjJ9kEJBfIeAEzsMe8yXIWt4YspTEtttJn/EypQU/UDw/1sn30iiKzogaLPfjHXFiHMejEJ+S+UNhuNBIq+jMPbquIbUrHDOS9JpITm6X68wkR7mX2jUZikvNaQy6hUWHhFMPx2MKVoJ5QA4RoxISRZiKAAIDbzgjlTZaYYDFWCgl6WZPPLmssW6O5wybqUP/B1YxoUl7N5AtWM6HJ8dWMKlczoplazbDyWMpKaTmwYU6kZMwdZ6flCLbjTBErwkf9iRGJNptNzA/vfj/OfteeRJJqtBMClGtLBm4uEn6SMgPfFyAK/QwBmFaSbAFPbU4/V9UGx83GmI4M6DhN+JkOhGerfR6Gje8hKnJGQWE8W3kOFQTUC0E9oRMHst/PgGHq20akR+B3bjGNbtH1KdlT1Ax/XHCrcZHqJfcB/qF+wc2mL3RYP+8fSKswojuhkvXiyeGj0CzOUVtDBmfL+w6UjkzJlsdQv7+XL23vqEes9Cs8WNPwKxiuWqa924UTO5vAs847wmGdVLVkqoqCGPIzRYSviNyvElXiLK8ylQo3EPbX+OLOvbh9Gfc4ryfTwN/KIU8ElEv5JCmvUfLQ7CeRqe0MovbkCxYrpkl5pUSSl03sUsvife6VUvdqRfd0Gx0A4de5PFfqwaUC5Sgph+yV43ULQVe+chNdejQ82gOZhREXc6uMFUpFqlSpD1t5ML+keU32tGgp4h0xAyj7c+AHUjdQLDVUxHa7CLTEjJSJXj87I94h/trvKUgOPoenlf1O6zgLykTi5H5/+NCucSTY/WO+qnLfVHZGJT9onGBwjHtg0VVYvp2440OHC6gjTu4zUIjN2Rhl6oBGhrJtQmjMnHF8moxjdGgUm2Hr49mYm15ZgBOxt/3dLlYOAxPtAkX6DUyMuIK5c1bgVGiWhy6ZRxfM9G8aFhQZjEpzuFu3B/5b6/VyioZKOjfbAssZugUkFOx2KnYi2dMNNFm+mR7gnxbCx6P2FbrzSwuNrgp7pjLQImJxoG2htjIAJ3ETu9x59XDwfhIawxGhRg/EA42u7cQur0vFSj3cjuBk7um6uCUJ/xVZmUouPCd7OYOTKt24VsqzP3AffZpUqvH7V3GS7e2MTFJ0B32IG3PvLSGh4kt6SQuFDiumxBCSNuMwIFkIW0ZXajjqyRodLMfIhkUNFPCpRqjR/lJ95ZOjEloRddTrIjt321YYzoLQ0lKVnOAVx1jOLo63mGeMWMxGnK9fjzrpq+whX8Qj/vkq8z7f5LIyx1iko+4AARGjowmMGF/JpTSUaATDUZkXo7Kaikjs3/hBCKnAL85WvHaVxLcpTywQafdGo27/QVw/lo/L3GIT3nJoefni/fsXr38PFpvvacBi8yrQlpWmJ1Qpt5DMUm666YQBoALfo5G60MtDfQuBiaV8IfekAyI+n2jrQOkLUy6wXcSAKInQjj6EJxwpQkc3E/ReIRovyznm8f5QKqPuLHicjKFl6tta2BlWzUXylFHfVNUG4w36t2ANTibyt12pXKOpAIMekJjNtyEGDDgMkP4UC6LxLYym4Ed/XgoiCXXsrOCUwZyAZC1UaexPVW7TnU1Sj7c28cYmXrqvZtJrTfWDqzwzrnJkr4DMgqOwAqQUxlWezLTmxkbKzIuuLTPwFwjIluEvzTo5bRtMf19pNqSWogCy1trf2Kb8WqpL+SwXnzQ1NMAR8Gly5hQhKkK/VJyALVqgH/tbQn0MM2UkxHak5eqyTcAui+2c23M46miN/UVknTLJfvP2gzCGNoQl92Wrbq+kkBur2VbHpJciyJIS24SXPalPwE28kFPYgCISQyYv9X2D9IcgCjJgLe12r/xs1VqGcZzYPoQt8nc7/9Qxtiqy40niWauiDl6uVlpoQLNBCtq7fc/td4ZdwNBoL28sar5AldFX97Q96LaHx3ahmm+TUevykqev4sUW5KNSrAcoy1A66nY6ow7QxHbEYgI0f15nEnn32jO2iOc6GPWdAaityfQKrFs+VOC/V+t85QfINRln7szTiuytV/6GHshhq50lR4e9OZW9OWZvzszLbCDUzQHkEa9ug2z1apv50E9aEtVW8M20NiMwmKfJjNjIJ8OnABnbDg1aN34YLPyMv+afMiTCiB0hqFNU9JHKJfjfPg/tzvQq7+mgPegcdU+RtX5kYs7GxiLAPGpjsb6f9zXq9iSo/c2ARqsBJfdca/3jPwKu+fT0xesXH9A5ZguQ+sSCkxn4oTAbUftU2/hpyhcQrl8oirzP4oRbnrVJ+E0Qb9NaiuUTPufBDV/Uru6QchHoLLGOLO6o0++5f9+zxXxbnS0ENBtQZK89JGXfIflScHODeL5BfL+vWsVcj9YvnBVxF534JUEerlRtEXNB3K38G17zawiYanlatVf+R15LtwnHVata9Xh9FUT8naiQAqnoRzWB42q3qzjlNVyJtOYnetnTlqXc4YMBnJwKsSVKVgmtIH2xXm+RQpqcVCYD99HIQQUoIFuDVP0Etpac8YcVr1mNoPEERgkWOhH/BMxnmAlI/2rn6lNPNItrVxxmpIT0UE/33noehyGXnInESH/H53GyqAHewIVbxqFwYlfbaCa9V7OeNHwRvOaJRWvWE9J4YrWeSDKTt7L4Pf/VJrCJ4kcWC0KCHCqN5AZYGRhqGSx54c9Gs21z6bz1Yz59q4HOpFNNUslh3lme+hnw1CKNJzDotDzo2jLeRgt4FTyB5TUWMotryyBa1OIIVw7W4mMU30YKEtR63NUif81TZTt3ZG1qxpDzYdVugzCEbQquozjhi9YTaVZSjTfhvvp7HW2aHWJyhsg+H+mL6Cae49Xx1k/8NQdKgaepfy1LYqXX/poXMDYLcgRBY/EFSAk0+PBr2Ou1BybyCL4AeRS61ceHPn7ksVHncAWMqwOH+R94dWinaPrJxUn5NftEYicAqgzAVawSX9S2keh9Ubtd8aiG2sZwSq0njQSvHSjtY1et2odYQhjgA5FIa3fxtrbeplmNfwJf4kEW3tW0KJLXitdQ6wk5duEMBoOjt3nSWtgZvV8vPJuwszX9/hx/rOivz/HHgr76gD82+rJJ7O6o2z6Ufv031Wjz/0NEtvFjRLY2iFz+hfznCm7j/1TBbRWKsV4FUbAM+KIGh+tTDTlRtX+wGrxhjWs3QRpktVWWbVLv6VOk51q/pK04uX6KRzKdgD4pw9K1ZZyIS3IbhrW1QDBwncIRgPQojppr1duC39R4dBMkcYQXNFTGijiAtFWzpJnk5w2s46uUJzeC9W394z/mn1aRRW4+5xI/WsRrvIul0kynT1rp9koYy9kDgjEFMhus1URcupaFXPV7oGe9InXbCG1C352/ffns+XmeJRNE7tt3b7471y7Bnj3/8OLN60PdG123qjg2tN+PD/yNWoJMM9gmQm6JSFTHvc6PFVdquseidoN21dG8AxRg5nPCGMsKjB7DLGxcLdXJKhlXyW5XlVpZNj/1ndnBtRHY0pShumt94STaukwkKfYf8LRLAim90klFb66hr21viHBwUV2dV1RvEx1xh/osoyGbzuiShXRViNIDcWeWjLEQ3WWHUpeGlGLNBEt7VdFHJx+iX7JxefxAu2JVq9rvERV478RRHS1sQpfaR0RRGJcdHWkfTOlAdLSwRZMJW2prUk7GSxX7I4HYH0LdaG8wJ2/knE7gsFS0PxCTyKkOJBcqCg6Pz3YEY0zu7lfI22Wx7YP4CuXGIaa6+/zwhWxJqzSmbYdmyGOoQth3X7YzwKMeA/zc2PdQzNu2JDLaG/BxpaRqNGPXcpdszu4BC86T4OpAmfNBPFMxCtckaxOMkwRaCfW6+Guv7fx2SmxC77dR3jfcifv9noDtwBErGMpV7XyegI/3hIJN1SJIN342X3k3NG/2miqa2VvThG9Cf64evd6d6O2KRiV/vQ/47M9sXh2Nw+SQr7SeeBXfXXH4RVfjr1joPlFn436v8XyE1gBKFZBPo9m4ovcYLTWiGVvaMRIMh0TZ4u/IWa8KIVvFP9p7rlkkQ86eYl5/xgQsb8XOHpAElVHl33mKn48Hl7CjgioaPSTVC2y3BzbaMbvXwJzotyDVcH+4ItGxDvfg7PVAQGGsZGxyHyK2KDaUElBOlf2CUp4PSoIJofd7mp/DaA8nek+Ho1H7YblD4b2jRiEc/S3iOY5ZGpSdhxy+bCvjnzI/4b5FSCuIIp784cOrl8yqC0rWolELX6B7+Qi7xIfWcy5fWprTluuQF9kv47Ken/KTw+t1qQpQ9AmX5nzz6UzczqKYXnTqGnKZSuJf34wHCCGrtC/JGha+Ya+45NxZZFzkIFZzuTHwLkDyXhwMaTMcsqcXF/b04uLE+odvfld/YpNvG7R18dQbn7KzyT9OL2Y/X/71frf/1+aMPL0eFwWCWk6iLmvr4sIip84EbO4k4rVDan3jWmUX+nJpbX7Ger32qF+v81PWG3S6HRC7YXq/OxqI9H7PcQaQ3u/1Or0TJv7WwTkP/OoWUkRlBysO4dt1ZfRfzHC7mNNxdVG3PcAktzeCwIQ2P3Ndt+vC1Yb/VxJpgrj3DLtTe48TaNigSw3pfXJ25mL09F6/03Yatuu0O/VcCiIeIyitf77yk+fxggsnPQ/kSwu8JXtat6d+87d/mMG/TnM0u3dpB4KqPb0O6Io9/fkfbHvifZr6zaXMHe53U/2TkKfBgRV2HrE5Noj/DGz8JpHX6SGWnsuhPMtsh9Trq1bG08zOSL0+txNmfQL2fzZ1Z0W/HxMdqTmT1GubULdPvIN0l0AoZzLZoLOFkgn4IaDVD+BsCeoTMJVr9nRaPz2zZk/pjf55Te/YvVW3PKvurzdji1qn8DvM4OcZ/LyGn0+sJ55V/3UbZ2PLeIVdGYO4m/JZPrxbI+darAkK4dWobugVTAfGdY43t6r4yUQxjUZ2pr34NduTzLM2fuJfJ/5mdRlvOFzmDEMHAW6o18XP4HoFz6ogCoOIyxINV5Vx8oR5HGWATpWNldG4UIyQRdtG+4227GGCI220iZftz1tXYTz/COvDcVyHZJt1mhc5u4isPS1Uwv4q4wtYp0+Nmlbj0hbxAs7RnrWyioimhLUm1ukm4WenUPbMatzamCUnThrW6VPMOX26SfLGPetz5XH4Sx7NeYXrKSnAoSkNcXPonFkWupoM/ej6bcKXwScg9cIWrPc6RdyRMttnKkUyIJ5epI2n10RxIWoWoYEdt5JtyFPR+6XQwKc+aCMoYrKqxBRKzIwxjufsibBCZNaTxqZxa6/trZ0SAlz6J3u19nrxrMa8YZ1ZDTtBoXwogMz4UNy9PIVOQ7VyMxWp3ydkt7u1dQY5tgd6geUEwGz8HK1Igui6BGMFaFlZDdyz1Ut+w0MYs1nxQTg7qCrhdJUc0Vuwk9anVbYO32yziXW6SmpPAWWcrpIzixjzuNqGIc8uwyDNjp6ObahPhVH6weFuw8JqYYUg4+ujfYTBmVUsWWrfaDwM1HjiZMETvqgavqmPPM0U/WudxiECCtY8cydPgHWeZAxZ8pjYQIRqkXyNC708OO24OO0iQnwAHwisZcEGbc6sYtWqDsW8TmwTqR7g1abGqyfySwG2flWX+n66AeCwk4nCNZbcvejj4QyMsBGimSzkkyc1/AvLCac2zxFn17MsGgOyCaKPH/zkmmdQA3+IHcgzZHE51Cenfm2V8KVoWJyEhC+x0UbUiNVZwqEehxxflArW/rVRoDCZJ7U0mRvdpMkce6Hxl81TA1ywvrYaUcN+UvNDnKUo7YfZRCA29Qk+FwDunoA4Mm4UDnDtqSVhEieAYuCjZwlzFfCKoseXpFgYcNHxdiG3WPSBdguFr+LF3fF2IbdY9IF2C4WT440mZ6rIA40l+XQeiT+yFeIPPwyuI8QedwAG8MxrYpoHYIy/DESiunhouWShxWPHsfjqcTy4bQtRKM2S+OAyy8uJ7ELR422ahR+4AvhaFzneliq04OHRhhY8zAsdb0oXC6L0aFtBlOaFjreli6395OPRxiDTKHa8ubxgur06ctmk26tqKhAzRN3N0bqbY3Xl/bPykwVavx5TiyyQF1dAXlxEcIdc6SOVxsvsM01gdjp5uC3PUmcU3DxUXqKlmeAEsnWIZPYD164sr4pn/vXjCvtXV8nxK/3JKeQ/eEGcPdHNPHBZXV1JHLaM4yyCpwjcgFWY4fV2fcUT0UuwaLiGABGYdtYySvjSauQuAqBgur16sQD1RTtqMMuzGnkqoU8ARhQRrvpvQitn+ir+h2X0pAH0Ui1YMLyHn1hnU0iyZnDXGuCkZ4BbUk1MHNKtT4BuLY0hbaZ8YyGIPPGOF4Bs0nhymkr9qXIhKHAah4eVgcjD2hXDPr5XMZDIp09lb+oE6OoPwEoY4OrBUj60i7DKB9sBdLKEJd3VY8hmXdiP5qu4OuK4gpmHBnUEnJIKcKrlUCNhpjwZ8NuL8PXv/8f/FaAHp7V4AMvrV8ni+NNlkcmb4DhVs5A33uLBu8Ls7IFimS720CW7EIQRsngv2Tl6aD1AlPpAZEwwXshpHjTBKr2TCswWJOaxAyNyl7Smxec3S4EPfi5NWXXv+UDMADey4uUlVr28BLmvTJn78xW/vBTSzaoILbIuUNQyKg+PbphwZ9eKN1A8ZYn4RPZaol33xB95lLJY5sWpjg8Up6/8T0y1q2UhK3TMcMNDXVDaz1pW4fulWQYnoCcUpC+il/4VD7U1LDwnChUg4bm4CHTDIVT5MVqDMIEv3s/jDU+ZUwoDI4+VcA3Nmi4NGJdzoan4CSyZfAwiEIv+lAyUpivSq/rMw3ZW5TabtCm0LbAzljVcarQPMuKEge3RJk5PgzEyfkYg7bJjxnGlDXYqliKEJI0G+iSsQeEOYywWTLxmMyH34PhbetDgcndb6cdg8wG2FtjDcrzRxPblCvAji0m8IzlJ06ViPmlhNiH1S3Fwcq/xGDcIOGB0C4vZbQsPdCazuLDaI7dcwC0WaAoH7ZrRO/OsYgHbZ89tESInPxzTGaEuIafObtcblnvwG2YfsGspixW8BMxvtMfBaVqvu6AGEpe3JyDjoNHQ6BngSnKypQ8VZm91mt9o04C0siQAwbcSbTZdz46QQuE3gbBM2O3KKWjdnBujFTOncAeEM3SWVpXBtoQGpBQrRkgn1qjvl9zdZ0x4vf/x3Qs7M2NcKFCPjNxyWBjzwGXGeYMd6QulitK6ZUTIW7NGY5ypQ+DieiWVpXM1JmizzRjTpuAx+wXe9lhJLbSL8VfoyYk+D0q59WUQfbRjjNxgnE4T28SgIzgetYVGUNZwT4NJ1mBtL2s09moYqNARMQdGX6932idHRw5So+S0097t3PYAxz0mAOtm+wTaFz5H4ZxIZaRGIzpzReQgPD6uSG42o1NHJudDqvnSe2PMSotBs8+vRHH+cmWEr8/KsDw0YhnwadRB8aumDoMG+Rvz6/XOSPztOobjVKmUljUaFOfsQ1AH1nXJODuNBUwcWVSWN1I7to/FZYgETNATp7C1cdXWqhl/KIiXxLnVwhvBl6fAkG9l8Y+bjRJr5fXfHkWHEECKLqtxnvP3wnnO34rz7A6slB1W4T2y2+F5DSUGBBWrF3ZMg1LLcxabu0K3LBB90QCijH1xP9AmVtqKCBuvsc+JvSn1I3shnr0B+iFgWyI6w76qEDkJGg3tX+AhlI8oe8k+2Aqxu9Qv2Aq3Er7kCY/mHPz9Il4upLB7fKB6GwqEujffI3424zAlPFrw5AUymQ+eb1rBh+WEJh5G7drOoQGzrHHcbMKsWDTlU19QrCD98SF8FRXEoL66gj09HMBXdI0El2WNGw0fznCBUS7HMAkasroxSxuz56sgXCQ8wt68iqFXDrzgrx1CfC0uL1m1E7Aiha2GnbCmO85wtZZ2sci00UhmLTBqMbRptQvAplvuHfwWBCE3B1By2D21LHAWkg/iUCUDnVjxCBjGC9CW88OsulRmqEKeOuDnQOpXSv/M3Hg7gNeuajUToxT4ep3O6MPDAxZCPjwbRYB+mI8lQ7xTbFUMLGkttU1yceX87MFIEnJXbQ5GNAkEQda4MTrQiLPe4k1XgxmgTRyaNXk1q6HjfurtjWatZaTeQoVkECLE8O9ud+QhVpjAFV/GyaEkuBgT3piGz6LCNOK/ZRpKCTamDr0HYPUyKjcIHPouIy+hfph5vprPnnx+Rhil/X/rCTXcv+eUAAiPwJkAqtIoEGhF77zUe4a9R1/Su2igxHvgrOhdADREvCmf0axeL43mYUTB0PUe5dXFCuhPbxpsVnbqHO7UO+iytvYj/5onXi2IpD0p7py/5pVnKZvlY3HkgjxiWRZBWloXcm8fWxbyX2N67uOnd80z7K7KTloq9Rbb0Puu7xK73M2UzxDo6McScL+VvJiybzfB5ZHwDKU9lGBZVDLbPZNzQ5G94x0wcxSM5wyfwwGwgxguGxWNVHzlg1SzMsfGSQUPKWc5FfvDxXjPy6KK3KFXznrSqvanTOrt5lnYsUPGedKUz1hW0dfvebW1++lBR5NCY54jWJDvmFW7iGwynT25sFr0ZNI0fNW/MlvMnxn2tGmTi+nF7H7fmHzb+ubi5x31/uH05OIClC6pdXGBGpTQ/Pfs6UWjubtoXbR2FxPxv93JycnJjtJds/mUvmRPL2x7vsvWu2S3IRcEFAO/Y/dzz/of/6tFE8/6H/8vi24863/8Py2arT3r3/8P/w/JPf2BPZ0+AbW53+SPa/ojezptXqRidLOn+Ux+LWjIwgnc7QwdNngS/yjV4dDQTlf8U0luxaVFFthGkEbS0N9ZwxVT/oZNpxbKDCxaxIqC8ET3HLwK/EV+fgDEYzF/3UX6cYfvPnkmHAq1Um/qUHdGFbnqAf73uNA2E+9s8fikXDFfKeDpG6qGQvYzOrWAW1Mct+EJGx/VojS+rswpEXw3M5cmzM8dx2SnCVpHgsLwoYqgbyqqGHS5n6sOVun++bneoDhakagh120caVb5iW3H7I0dUTXS8uzhPUrGJGKRfDjFanXHZpMsoqpRiB4phw3KM2hfI8cjv9HX7NTK31ePXU8ckfEuY+UEoAfoF67651a1ei/+9lV/+7/NqqszdGTF8/WGGUgt4hwTR4iIjTWzMxaDCZRcFzUheaAyNdKDQ5Xpd6M4VlrqlvlB+AA85NwY5oBrQpcumSBgBSyodlLkkYmp6Dk94LvBMsXIApr0zksvKDZ6QkTTvi2TLHeIBuNV1c0FT3llly6RvbudMVHR3tTrob5ETzby/VeYByr9SdZrwKoyaSWurBAqWxIhzhsNIAsYCDUCtbfqSNwLKchDLYq2goWXFRoM8PhgvYltp2w6I4X6pSNFETAhMp3ZyMMI39JTyPG40e1B/fIpLffZbM7BZBybwP2s1+2UiV3KE2eEHoKS1ItN4RFVqX8tC051De0UTUBEvsboHg09o8YsEMd7G2VnziT/8MAzvzOOTuNx1GiQh/dHCK7lDqGM2YvUuu3J2K/Xi/V98hkQkotnrNn+MTBXUU1foe1HnXHguNJVxR1QkJkQAbIq/R2/Pv+02e3sJbPsn3dTq/HOMAwHc6lXSjMb9L5mxLYaBa8MFT0ct7JS5I0Lh7eVxkl2xNYt99Yvf+yJaFaPZgfDIfY3jxiyRcvzRWay+GkvqXVtEULnrFyKCoy+KmP04kW4mibyHhO3px2LJI2yjZv0DAQqzSa2gM8TuBR8FgOVaNyFITI4fX0fzDGK8AvgPjGHbvFyvuEhBbQx3rB5i3/ic2TxGiXPwnq9CHHFB1Egb8uQbgRjq7EBCxZJwkpA3B5gCa2xBPgBmLwVEIAoYTNtz2aqmQp0VRwNlH64z9IBgVIhM+bb3Ew7avhjI6ROeBroj0ethzn3wk6yGCzdJC7LQ3NkhKZUfQLhTjTNhrQ22sI9eILFUZV3PsBBjPiRJwhSOVmXQ5IPkOQ3myVY1HjUL4OkmfMQZOZgmRhgKZyDaniMmB2wiOQyGxsNkgIv0O+Ll7T6YH83zYpGUmCSR78Xr6WISM8s+Sul0YRnyv/4f1umYKp136Z7SP/3f/tfzAx7OjmZkX//t/8F8r5xW63DzPuuqPmNC/8z86lqlBZq/byb/tyckWazacOP3Tfk6fUa6v/7v/2fv2mXil6kpNm0L1Kz1P/poNT05+ZFOiOixYt0dlCa0CSnWgXJl679JEProPQLLgO6oGt6TW8eBrAboNLWFVC2BqhYH4eytQFl14VkLee40aGcgIYaZ6fXppubAh5M2LUBcCc/CJhIUFlT0nQpFpHYb8Vu8qGu0OrxxL6ZrmT+KUvJeNVskrEew6rhSuGRCc7qGIy5B32MfZB4278ZONenMftNINmICPF2yE5+tSMaizPQdAn6CMaPhktBiRHmFE+dGbW3oixaBYUo9d2wky1dspMQl65iIvacwUxoYUIpERMKlva8BZHUQ5DZLOr1vAxjLCX3svJiYl9P52JX8mfQnyoS6VwqzSgQEZA2bc9MUPyTrX+riR9W6czAD/ff2K/z5f26MxBR6p0eQ4Ug2vIa328mNwrvQ+9eRjdx6qmWxEJ6C4n10z3xlvU6yH0eHoH17//2fwU/wai19Pji+9ksZyT9pNiXcjIow1KCRxFc9dk4t9t3xvz0Gw2tismHZcUEv5nyGYAc/nVNztMfDi33DFyBfZQV7IpqdCUdO0P7rqhgdwXeZVOtCceLn9n7VbDM9OdV+BFOWJQZWnHc/A0KevJTPs1dPTgeZWAZzqwkjjOpQbdYyPaaboUiX8LTbYjqdvpBnLKQzZGosgOtCqgxQni6HYfwzsMAXYEpJg/xDQoZKHD3yf280dBQt4fn7x4F7H69Dq6Itk13t7N1Coio8+USu5cSc81EWkjMhRNpcyLGDuNOWQiswoO2Ak3IHTRZyjJbdkhx6c1mFYbKYeqfStpRY+3GgMtK02zW4LIHwO1nghThKpPsdt1jqkXwdCP1ercnglp3O/B3t4tOY6mPdFgDNRcM3yZ/LAzw2KhoYUhwVUYN94zFRSWUYyM87Q53u+SsNyiqhYyFek9UbufkeEtnrDus1xPwBEDIvVaI2u26ffgrNaJUY3ut9Pj5BfnJlGEk8ZynlZGMaSTwAPI6bEMFItEyHnhSkTIXJJpmMxDJ0z8Y/QTp+XqT3VWJEwyomvJZw4DCKZ+dMQNgwdq80CpofWK7L4OoJHEq6UBIEB7z0wzoggf7PC12SRC3apX0wxG83/jzh3vP9YnFABBH6Bxji/hnOwM3COnDEqBCb0m9rhMLHcEIHtXZd/78Y5XnSn6aKyVyAeX8LBE6JSfV02s2tVfbGm+4R3q+5ll5P4/olTEM6sfPdAx4y4KEbQOOikqTMc3Vdm9nDXSJtw4i29z37YwmhAYsmpjbv501XK+YQPXMlMZhQKRWWe42J2tyEUBne5qNt40GhSA6dsiKPZIzcMIWsoTQEPRZ4KlP6MF4Q9DnarinEJXgs6OD4Dysaojala5ihghJ1++BBzy1/CQL5iG3qOWnwQL+Xm2zLI7gh3ZFAB/x4s6i1tyPbvwUfyAlAL/iUPx7ncTbjUWtxQL+CW7gX8haZBa1+PqKQ/oy4OEi5Rn+vM5bwdjI0BEwxHiCP5K1Ra2VC/+04Z8O/NOFf3rwTx/+wYgQ8EP1voKvYJn4ENvUCgOLWmsfMqSrGGrheONtttnCF2RtsOdNEl8nPIXZCe+S8EMY4MAvMIi0qJUJl4tWJlckg1lprzzUypZIh1jZCv5J8Bf3odAW+r0JFjy2ZtXi/d+DRBZDcuAW/Qt7+vMp+jp51vwXcF7i9vZkepFePD2bPaV/gdyLpxX5Z6aU8p+LkbTMu0/TXXgr5ndfzqdL5opXl9ComRiU5J9Lt/7Ra9Wvajo+g/gEbrsPt350eGXF4tZHnc5ot4u1xrRGu3YM76jkjPlwuSUoJeUcxKTowYFWar/QWDyB9eiaxiKcdov6ujGLQN12HJ0K5KYvM3j9RdJQQQbFVi1GhRbPWJfI+zpmRtBPLgjcqJqFLMafy2sVWsQoEN3CtkEoPzyloKwjJbaZ0A/mio/GpUoB6AQLsdGB/PAYlkXi8ti+bsrk0rzROdsUFNjl/lbYe8xhd0eQGxdqhGxObZ/Zc2ZcRPacxoQ0Q3LaKRS2A9mwQHZzusmtDjRP6q8WOWNOoV6kvhyJvrPxid1opGcsQTV2e87CfNqpMe10Rk5tY+IpBEgxck+NzSESaA5mLqxaDKBJy0Cz21UvwKlv5MhjMCfkdEPI/TY3jtHXn7ldEubShr2duJ5zTHwBsGFR4ZnFCyqhsOHSlPoIe48BOTrNRT3lSwVtI2cAlGbyZwHT5Gsdhc/rMnyuz65L5hVVxPIaMI9ZTE4G9DoUr2Htf3rN0yyIrh+CKyT2Dtsn9fq60UDbrPwg88IbOGVTY1YzanywNfWZvWbr0+tJAQ7WxFuTM3ZNAzY1lmFGjQ+2bhpN4QmWxhJl8t7YDiBnAA3GQFKe2Lax4rGx4jE+64xFj2fgnFNw9x5YanxTSyQJq7YQ8mmHrthGUbPL09V4KVraTJegIB3TBM027hc52AdLeyEbkkBtDJTQQKXlA6bGB2u6nc5AsHEe3rmqxo2PR+zQZ0Zi7FI8w0XZMm6wOigv8D3Mo1N5qEv+qix5bOdsmlGndGZRQqPBAhsLfuN4EmPy2eZLwpkcDxRGvKXzqTtjAmfAXo+XWjiD+6ynv2xkMwamBPn6YFIwXRpUSn50wscjnFXyGURjIhYa5nAtkImdNliBpREWUEG3feTiSw12Rlyvj3rFK1AIetxxehpKw6DgSBuiPj74A6Nrcbn4uZ2P7Z+iO0GIpyXvAGFDVLGTsCafQ+noWv6Ra4y/vgydg5iC3tErekvP6Sd6Sd/TZ3DEYSk2THBy8EJfQ2pOgdkb9k95Zr4ga9Bh/SIsvjhc8A0w9kvY/aYsKKHriX2cWlpplwomUT2nm+a86ZIjJ+vAG5VFMclbyW26OnaEiVd9VEvOuazPtQO4n94BSj4/flngNpPxiX1ix6cJ2e1seymuAgP7bWBnjMth4nrXzQ0566Jfb5fQ5amrfvpsY6LAxrJ6eYpOwNRcbo/OJS1cuXCkkZmMlpz5Th3gWp3FrgvXp4l4fMpzJbIiehaHoBql4iWmqtbrJ3e7nf0MY0LfSfTYzCD6WP70kKkuKXSfFoYZ6MGEZcT7mXU8ir9jlil8fQu4O0byKl+LGGjn3S4fZ4xf+k4rEcbIuXoPYAV8ffBYILH/p9PL8Sdx059PPxVu+veFm/59bjC6Jvc5dohRDVAUU4bkEjcYWXjOT1j1SScFKrpqxdYT69CFm1U8XkeX8kqsn8TFMX1Wr1e4y5BPagG8balYmDXa+FIvCmjbWiNF5YC2gpIDxpWKmWZJKdnPk7TbSwHMRkmVmDRYm+xtTm8+Q+LPTD3Fxzw5j+HPefkC3ja6Z/MC4h65VTu6LVLzo25loYZbLPbI60I8H7eN9jg9nY9TKSKqJh/T0kA6RwoVoC8FkVGjjW56UxBHzLWha6lmo5ESdc+njUZZJRJv/0IK2tqX0loJX1YUxWQsHxZe3NtGm6bNdnUzyiq/6X5GU66kPEpRf9ALKy8jE+cU37fBcbRduIdS0kwLD6rUxKQN1i2h7xx4jSZNdCaOjspplJ91qp+mmUE+cx8YAwjM4TVxePnM/MJM4kcv9DHkRIQtfOE8C2pZuBT9ApK5cHoPyOf0jBUJ5k7vGMEMbL5icUkk07j6FIzRW3Ncr6enYb3un7L+mIAt8LHimlA+6+92WAep6lidppCVhCJ2SDttmhJqB1VZPZoScqZMsA/6DJrodjtkAaGfJcgNF7AWFa5aPf/zbBecxReYg4jjnNKwZAaiDp972GXBJuTh0Utoy4f/ZS8K8ZQ4AoGasQsQiHwKtZ2BYOeZ3NmgSIeUMwtcOOmD3TZwTmAAdYDsDoO3HcwQ/drdHjvC0gZA7gtLXvRfYTL4YhoRemLr1JzHfeqr0tVHS4JQ8EUgJIYxcb3252Hpi8EopnpRstljAarp/g0gZU7ngAEpHdgjFlMu/L766Y8Uil58xGQNtqUnOZkAfRQw2/ass9uljXYZ4/Wdk89TCZ0OO8ZHcBGeOmiBfF/gPu61h5WB4BKcHJqQdto7rr3cn7HRoF7PTpnbbu8B6AqsjIHu4sT22QHCQP9J9l8M3zWa8H6g/L+UfN2cwGr8fuofeM+fHWGx7oVxB5z44JQrOT+4JtZvkJKzC3VSjkBWDhsFGKrAfFWSmYA6iMYeQw9LCeIX8EVg6lmjfZaUhCXy4hDwGBax24GkRIJ0aOCPcGY+ysNZSXrTPXInByYLqzcssrBQRwqFjg2X0JOnP0+b3q42a3zzVGhy+sWNt0GPXert7yxCTlm7eNNvQE0sZc44Pd1qvW5JaZ/YS7adpjMl9sFE8E2S7nYpY2yr1ZWIUskaayiF+k9/9ibNhjdRg1sag+sNGWNLc97LvLnJRkDPYSGHTKw5jzKeWJ6VwIvJIl5lMdmEFfIllFGfFtkr3wJqJSvkWjuruI6wjFq7+OeL3e5i9w2oMVvEWF2qWOon+do8JMKoPCm5r2jFclk9wEmuakA7hTbYjWB39+gGkkfWrgCbIyOSDQpPx5tpOvu6kZXvRQM4H2rwMVdftjpKtj+wV8lDPPmje/OFdbQzbrUjCwbBM6pBArBWexwKadIx5FWvN90TDf9hNfwLnUwhH3kM8H8Glr4eehYV0HPQ2lxgqjz2ptvuMiYSi3jB9RxanYnfOQ4qfHrGl6a6HgWk868AxuMwMv5SQHyI02YZ3tsfD4+5h/qqOitgwC3gn1BRz4dyI7yoF3z5lSKMAi61uVZIPoW4QUJ1BowqQGtCO84URMucgEe8eemq1+dk/sAlHzNoeY5szq9gZm0PJBqVq7sIC0c9PIr7A5ZRn82VPYXk+t4A//AaWL/VrRcFE9OABl+NfA0CLaDigWSozrife5pg1484C4vjzF6p/ltdrXgFpmw6r17HtRZULFkOSSi9z6ezyN8l/gzNPwvMMF3QaMGs0WhT44vFuaDDnx0XbejzUcXN8g+kG9e7HWy/S1B4gK8+/7h0Ix/NQo9gXRzAqjCzDTXmdkRktHjgbgPjETaXI6PzM6VrXeMPnUCjSOEAYvrYZ3Mg5vLGoFzA5tR8peQ5xzgVugCYHzRcUmgubwpYBcDp8kv5eimPNCrG7Ruik4dQ8iI8voYhrqFCq3N68z+zcGNLqq+F/JMemZy6GMTzdGmHp6i9KB+ppPBKDQkRYoSj8tW8PyLpJrM2UkFFLcSwzMoSqDdmJ8rwTa0vWLmNfdEAnHUgtpTALS4I3OIiZETMwK4ZDY/hVn3B5p48qtHhcU8HfxcGVfR34Wh+zjdCgadZMPHKuOkwvdqmi/OHjLo4l1Zd4oc7o/d+mHkyvY2OsogKj70n+4wbqvUKLx/3dEnjY4YeaNIoKQK401OIzx0AHCqFEsUGz00y7IBQyYIlBTo/qBQFCz8Nfr1+Ek8jAMIAgNAl6LhB4jBxPFNacVugBwq4a5DPAYkEhlfAsSLu3IlDGw0cu5DLI7bIb5RSHYEh1ST3e6E4n3D2dHoRXWTgHCri7OlFAp9bxxn2ZrvpxbbdbXcvtm2nPZSJT69pDOW2juM7ZgRRnwNHLr0NpBvquZ/ymut4+HfUln/74m9Xfffk367868q/HfG3o/4Oxd++bK8v67vtjvohW+rIHjoDWbKrCqiuZY2+7Ko39DSfYMGX/jbMPM1XKUIe4rTjFh2IjRzh+EmSotPZ2ObM5iz3DhZz9FGV209HnEJAAvNBeJGhpm+9XqiZcMMC3YjQL9GYK9x2G0+shID3+KQB/l3BqN+zI2bVarWaJc+WnTT9ZkB+1wUKN2mCE+4I/FiAeBJONZpYogtUnKhhHAl0UUxjQV/E+jbIQ1kHGDogBWyQ8tN2rz9OARMEue82DTkhNx1qCJsxjB6720HY2B5+jRz8GsnYru12rr6/5dU+zU9A+xRd9min19tKp9fy0j5zJoe5TZd44J1ASKFKuT4oQG9RJKd2wT/D8P4B0oZ2zPwmPI+6k4ABrvFs4Xc8Yv5pOKloz0MUgDaVIK8TzWCdRCYnIBTH5BGIZ7Ygf+M2iodE6ZALrwNYiBB6P/cjvIhAB9qPBLr3UrrgYbBOvXi/ty4uTi7MeME6XLCOFmwZ/kgqzT0CPi25xJ4xVxl/zLkIS1x7bFxiqLT5mkpLzsBqAo1kFjEYzPzi3/jaBMb3ffEvGMb4VzFazfhzLC7/DcC8ZZ7gn4Wf+fgH7X8CtLhZRCma+KDhzDKDOtc8hn/FP5uVsOHptNHEJ8s28g9UC/xP8K/oKljjP+J3tITqwQY/kiCVf1pXnKuk1qfN3Pipi4S3v4Gq4gIbWvtBmEFTa5zBOk028g+UX2e/4ud2s/BRwTHit5AeLfHfAP8BPn8U4bjjjf/rlgPPH089GBbF0sgIKiRZukHDouQmmHP8labSxEjOPA34DeYE4guHka7x32iNabG/UdPUv1O0TrqGf3ko/o3Q0CoTSw6YaM3T1L+GxrOo0x448AN7yWC7wJKOWjd8vYZZ4Sxv0R7q0zyO5J/mNuVipz+tw2QzV8MwvrDGGrflt86o1XMS/Quy/EWwXX/KEgSr5Qb/xXTcXF8AXZb589WaR/hxC1IUnq58tNO6CrJ5HKCFWhzGCIWh2L/5KonXXP9o8k8Zj1JlprZu8hseZVdJkPGmn2U8WnAsLGhE+HWTotFa5Dc3oX8nfAKYKVDUDyKE1kUGzS5urixq8UX7I4C2P+dZgCNYcmnuFl1j6WWQAoxcw/ZcBxn++9s63iSxtEq7zvwQ2ljNEXbFggcb/DcRMDzviz8IxRnCwy8+NP4L/v7I7zY+Fg39NFuuJYAjDPvXAhTW6jv5KL41PKyxjXXaXPFQgH+E+7HeroXB2/oGxiKCXVErRhDY+OEa/wBl/NtvADWb0M+k6d4miT+BidwmvYNR/brlCXzKc8DTeJvgCUgQ3JL0LoJiSSZAnM/jaBEGSzwJAoLTazwmH+82mLiGpU/jcOHDVNJNnAVL6CDFpU4z7sMoUhw3fKQb7n8UByG6hjFsF9DqNkq4Dwdmm7Udp4snIMqSAGHrJuC3TT3SW341x6K3mY9H5G4e3N7Bnn5aBgicnxJIv1un14k1oytumvA5zVHrBLHyt42Lp2yiMXHjH/MSM3vi5V/N2b1D++7eyCcTe+JdtL6oBvmWnD2li8JoWhdNsBls9/bEs6c/n55dfHKc5sWntjOD0jmtsebF4HVAD+CC0Ixlu52VP4NriY2eJfJAPDGLZeHdLqYmZQYFaUI8wwcX9LIX8cs5W4OXnIm3jVByutiJw3gpvxbx9kp/kafEtlRBiz6d/mw9Yadnf80n1IAShRYs+vTJ9Ocns2+fQFahOYs+taY/W7NvrafEJvRGj+UibfhZllxG/prj57fsIv0Wk278cMvJBEeiy8BQxFpferN8P7zWZXP2rS6JVS16zaGzO9HZ6fRZ81/85m8z+Rd2ETsKrrYZ//Yi/fbi6eRMNYGJFr3BFq5ECz/bEw8ImMvMv94h+SJ+xWtAqjtp/x9E17sFn4d+gi67dnO4vXESqq5F7zixLd2CRZ+eXjytGt5F+i2OSHYBBU+azWbzbAd/7YnXnEx/PmvOiPzZnJFvm02sko8Gak0ns9a3k+lkhnnG8LBJ6HLWuEgb05/PZrJHQXM8PT25mD7//tmHZxdTsJJ9P/t2cjG7wGYETXULB6D+D7Y98T5N/eYSxn3v0uF+N9U/CRk/Deg5lsSjgjsnszvuHrKxsU9obSq8hx2zy0ciepycKmK6Xj/x+aFRYALuhJKcIE5QNWQTg0fODPUxpaPoRkGfAovQBM0HNnHKkHGDmi4Rvy27LC1z32BgGI2oSg2lpAOSMD0CLW9CZp18IpxkwEBK8Pkltd5U8eIsRSn3wVKggq2X0GdJs13wcyZ9XFTU9Am51xlGkVzk5lCICZPHEDP1P1R8UqvMyxHqLJUNS9dvgmFttqZDlR60NlatPa408u0ajXF8Gh1TKIzJmMSmmguAQqysfXk69zfHAEHsoBHhCDWTjzgRKUBEoxGdxkdDF0WEwPO1XgfgCjg4u5Wjq4LmaTSTENxgbSqMffBhLvlT2W5XXK/je0XBLlu6QmGVsxij6XZhrSJYq331AK2LC0sNrqHWFCJLZsH8Y/oZBnB+ytDU+UFlL/GeTil0k5+scQr7PupX64qPSdpoSFt1Ey8kFF2sBiwdKzG6yDasomlAFPcNQiEBR+9oT0qRCmQXTR/jChV0R2oHO1S2YTd1wHzDDd60dhHNGiUX5NKi3a3g9MJ2BbAN1bsVazhSI1RbtuDhozYLsFloYMd25caFxcNQlMOGje4ZSysM4Q+b+UqzA1SxCqtYL6FgvdCgqrM2oW67zwqBycTgWNGIUJowBDImVVCE0oiB0CbC0FHt/kOnDD0fhY1OIYRZg0XNkD5wq4U0EgHM8tiSYaNNYxVMsuGepjKSXnX3WIrU6w9lN5Br7B/JBYQu9syWYf4a7dN0cqSptuJAYQ0fA32JH8LP1yRuNj1MCmRSUK/HoJF+CraK5D55ZGDLZGIrINXxLdXyyAU15XQ8PKraUiGwJfSw+nE7AdGxGg2Kj+XAUiBCPLVxwMWXPtTTrzmA6O/rbz5/3c5/jePX7Rycvm6neMIef/i6nc+dvRMGpf+jTl91/+rwdTt/w9nrdr7w6HU7/5VOnor//5UnD6r/HU8e8Hq+5uj13b/H0eu7/zWOXt89OHp9t3i+Hn/0sOb/hkevun919Pru33D0+u4XHr2++1/p6AGw/y1nD+v/HQ8fX29Wfhqkn1UVEXbn4hDO5UQ3VY6T8DkBuvebeh3dTGweOJAJs0OGsr45IS0hvaInYUuJt0oAmjwEnnMxWQGiX2YjK1Zl3kjAtU0yk0HBt9UekkQvOLFKwNBeHyI9OTkwNUExP9xF8cLxmQwZQQMWjSGEsIzFHJz65F6FdGgGJNd3CZrMx8jWSlVGawPgSvlUt7lXNgeq4H2CrqJN8MbTn7uFzTdAO6OP9NNFuwmLj0D+vCH2SUo4pUfUEkshS2Jt9XV4EtwHqub+FB53gD7T1lFdsUcM/9EHMckP4tY8iHN9ENPt1We4YSrA88NPP/+h+88HM6/4ay3JxZDhLS7OR/yIV84X4FHQDXQVY3FiR4XD7TdcfYqEhZZwUQ/h1+yLi4sL8u1F+pRM1CBFLJ5K8ISmDhgCuPwlNXOlmqXZAXMO3u8tsj/YXzff39jcX9/Y382X7e+o+19je0fd/w67u/n87m7+ht2FkNePuUPpHHQcN3LEy3zjVzKpwrvPimhLywW63D9xqoo1GitCKDqZWHwl6Zpg6AE7Ys9tTlcHevp2yqKGS06XED/+iHcIEf+g0RinUExGFN8eMZtHKnZL0HJFWt0vizC5Yil9YXOaotZTIbC8ehUQz2eWRVcs/bo+oQ7Ym6UQxBx6IiosV0VnX96FsDVllqUmuNt13QeZrZLru6EnLjShrWXFgNCi+MwpLtPXz3zkVm9kvQ5rD9CQIjSk6LhqUuTjroAbTLyUgbUPjUF9XgVfj+t1CS60zPvFRwR6LSgG+pt+sGMyq1oFn4WtVcKXNGBhC8P5lPirKFvSZzSi80npxbpGHYI0mXu+jAcUYNzgCheyZa6uV6LgYROUtXrCl0aDVfSI3jJ0eVJJ3usizSat6OoojaHQVJrPfKl9qCqfGoehAQ8wU46EFJAruyC0Cg8AFkbdo2ByBILES+7kM9gH4lGwtNEG3wIC7UBFgmZIUrL4NW5iwiCtqIzJEMIOQfIwKzcrOgAoftRjCYZkiytEAebO+xWmS5WwcDgocLgoAoKkuc80GZXbJ/si/CiQiMwrK1BOBoqQgWP/IrBAbwKdsyJH4uRgh06qvO08whNS+hhPSOnf4AkpAkgbR6cBqm0f94RUEiW6ziMKjY4wO0tWCXDPSHdJkTxaUaNBk6IbArA0AYwqsCkuwxH/Rcms+px89gQ80OKpM7E/d0COwKlwjZRQEd/Q2T/UDYuJFz80M+of5MqORPxE+mBuo/H5QyviKFY4fFFy1/IZwhPkb7MYztzjhKty5/vOUQRqxNqQ+2+qjZ9ZEhtiLChBHC84IZMTe8l1sejAL4WsFbBfbJ9FU2cmG3cp+nM8OdEYCGOu+xl/GUQf0UTLPmRblS+9oEr2eTRmnV82bjkof3jTlXejISahpaaEeCcndqzXZMWJmq/Ul/WsBgYtLE39+NyD/7pzj0tzNxzGoM7TUUgs3uglPzDScY9Skjni8sUnu518WSK0djpHQuGA7gowXDsyFM5A/P0y7y5okGOfnJRfiOpheCX2+VDnQi1FlVDfp37DAB9SLbk/BDG5zDzKguzu2CrLNTZuTHyvDR/xfPcb7mmASkM9xo4sqOAkVq7FbR61BMA2YdYnsBgCZDB1SvhgghD/IsoErpAnglC3T7xCFnWFizfFYp2DycnGToi3sfu9Xqdz7ERqtzpHRnvOiVi0lMXYE75C4AfcikcUcNJjnVUXt+olbRjDxO3yESZunx40cfukTNw+SRM3MsYiJhZh73luxfKe5zDPpfpIcVt0oCQRd3ttZyby9yyy22He1Lq50hYPBfMHabWAKoYzXTVTth2eRSBO3f6Sl2LoIIemOr7SUUu7mCkzSZNThbhg7s9X/PccQfzMIZKGFrxwGa5pnJ3GKp6iCMaEh0zuJBI7sp330I7kCI3Vdlbk7ff08jNmhEbMoy+cGhxk/HHqS0WkhDnj5BSDI2QsmiY4A5cQtP8V7DjxUN/E6RnzTTevBzplclazvc4BWyQAs7fi0yalyT1oqYbQ/LFo2jUumXbJwDrPOLtXRnH38jrw7gF/gk7TJ/jxZotGozj+FH6FfnT9NuHL4JNnwe+tf82b6Brg4wc/ueYYxtsI2Al1RMRDz/r3f/u//fu//d///d/+LxBakK6C61WIVqkYHTun1b22s6fzeL2JIx5lqXc/jxPu3cNWpZ4MwIBGNeJBa+WMA/wwY9gWw5AWS8pA1aXg9LO91Ooq9qdchgt1MR2ZxMqdbuaeJ0VgH8NNl+ETUDpZLnjdEl62ZnsqZpT3nNOXpiKf0BAz5HX6VtIqlCUSN78SFXsyV7sVRMpsv9/T5TYM/yeAA71B+YLB6EGZO45AKnowB8eYg/M/ESwLCP1CiPxKMHw8+D0IeI+CtH1+FX/kyr5bR/PM8oCdSTGUp6ZZC3E8VWxOMfxX8YLroJsiiXH5Q8XwjOcf0SVJPP8okhIeLXjCE8b1T9lFvvmMm1/5zf5c2pxYQnPbOmGAo+NlDbw5ZIxT4X4EkKxFaFavA4ScsAy5NcHyrl6fx1Eah7x16yeRbcnkmphqLUhrCV/HN3zRqv2Y8prK3oTb6yCqBVGacX/hXUQXUbDexElWe8fhEABOqS2TeF17kuiEJ2NdSrVTLvJUZkBRYfCicmzS2qbclvkEjJoLqwylLwtrDCmZTAG4x4SfSksOae8PgsjSyiiz8zgS0dfsZ0B3yf5TnsEr4H5P9s8LtA3PCtd/apuNUk72tFBet35ICyGxIG2/s1US39ZglOdJEie29RNIgGt/zdfwr7VNwlOe0dp8xecfa2Bh81TZ7gEZI4dQr2c4eJ0AFGuOLOr1NxgIrvWR36W2mVNlpZuQe7MIOArBg1yvZ+p30uIR7mRlQeEVsrwo2xKlkRtX2YLAEJtQqnVIoai4bkidyLqQPDZjbAKQqICfdkKoZIqWWxewUz0sNPRS8KKATP6w8zDBWKcAZGDRVTGJFwK4v2QqSQEVOfTLp1bZ6ZdNUDRRPc09HfW7/a7H2dm9tU15DVDXPLPGkjgGGRVv8U+AKAoxLiOkag8xnXEmwDmFPBf804bPM76o+TVZA0lj4VhYh+UMlna7kNLgY0HIqhfYt9FYRuzku52WK2UEkKsYq3xuJrJCLvquJUqk49BY2KiM47NEe3iJztwxcesRSB8ajBManZ0xl/IG01yJhIkss6H9nnYHXXd4uIKVyyYeAxkrPcXoic1ZgxuMAOlfIxP+NYR5OfqxAAtn6beiNkTezFiXSHWRvEy328kLgUWqymi7ebq0ZldZA6NdfErmjjJkCSyw39O20xt0PHlxl1cgaS3sjN6/dT2bsDOwDILdjJj1+s2Hyx/e/Pj6e0vubzVaga01CATfZLGAMEJFx2QK+rJJ5t3zX7d+GGR3zwHhetmepixoFRJpyDTspJPYS+mWBSAheB/8xuk8z4Soc1u6YYEMv31eaGVZFSC46LcDHrXbMISJ7HbyV7LbZblH0+TAo6n2bsNUMYzNGp9GKjLZCbeBp00TjFemK2ovw3s7JHTF4HTNJ3bClvQeKNiK0fr1emL7cKtQTiZ+C806vWhPN1uzPCy7z+4/8juPU1Em2+/pNc/OoywJeJqXzZueTP0ZuCWi85D7iVnClyu835M82cCmU4NBE6uLF7i6yyBCX2mfiped7DGzOU1gLnB3AZ6Iz5quqOwzWKs8kCd4XEmUxCymLiDhbZSCtx/gVVC5ElpEI3/gMsbFxcmoT+5jOyOAubBZ1RCuVybXy99DHxIp8Xo9EXp45DOrmFQsHyzQfr+353RpOFdZ2IpAWQETw/aT6y0+fXEpMsSrAvfwlr/ZhHcImTQvRjdq/VetfEw2oWrli35A5AA3NhdrBbcJBM9DUjcWaWS/am22xligkNot9WPRwhk+Bz4OO1yBlcgGzsciJ7WDnB7DGMatIMW/NgfG1gT+9fBCOcla/IYnd1WDt1RSjkE4yNDlMmSttb95bL2JTqxZDZu3gNDb7axtBD8WFmlYNrE8oxcZ2ZjWLDLO70x5X84T7mf8PQ/5PIuTmrg+05ofhrUg2myzZiqz0loW1664RjsprV1ts1rC5zy44YtatuK1ZRyG8W0QXdeg+9SrTa1G0rBmlrEXevBpMSK43jmFihIzbvSZO8maGJgzYhBzNkMhajKNmu4srzqNZmU0/2AXkdkFkcgvQ+QXgUQxbzgWDfvg9ZaG7H7N13HwG38j3/wSy9Ati8RpA4A4uDTQsQ7b5qUIzTdYEzZb8uhNimoiTnMtv7mWGU9wL3D3SpuEG6K6wX3BWc1ZSDds3ipOii7z62kzSbwNXZVOwJJMlh6EH1ywwI6EK05x4EVFOj08ZMBK3VajhT3ITqO5Dzpn6ATTtiu2EZ1AZWyRgwlwRTPpBBEZ5Qt4XlR2oclln63NEpzs8zz5APLTNLiO7Gt6L67kH7bR3NtSuUiLd3nimi44cFF5NAfcuqChn2Yiv+q62tOEw1Nom/kCeipWCcqkPHv32YIM7jZ6vdc3DjqICFmK6hl9pz8aGEQTvPqzWsQSe9hvd3uIce2O03O6YBGb2KPuaNRGz132sN11BsSgLYHsVIxseQpQ9hW33r1584GmmT//iLfwloV0zsIWplB0UL9kGTu7j1oc4ZlT63WMXkYApyR8w/2s5me1eRxu14jVwEcW2dMVi1pplnyIRRgQLohpuHeU0+nTbEwkAZuw1RRY2pKOvbiwvOqcK8ubC0AJWrdxsvgu3kYLP7mziVRwF+Tod0axKI5+Ol7yVpf0scG0lP+TkR/F2U8VRRZGkSDKytnfF1t4cVgiNcewCjKebvw5LxV6XxpIRTnFmn96sZBO/xMymZuyzrj17vyH83fnr5+fS4pDC/ASkN7tiVcq//wPz97JoknRodee7I3h/Wys+BW/DqLS8L8x8nm0KOVOLQ/AczG2fgYx5GqaziYQL9cRaoIQORex3ZpFWirxPPTT1F5pzQfKQUGRrUEQWZrE+/MPNOWZt4ZHVBRn3mJf6L5lrK0f3QHElgZoiwFe63Pz+3dvfnyrDw5N+JpDEEzvxNmPrYklorqvRBht8Qtc46MbAItBdjK5bok7ly++u2MnjmedqPQozn4oZnmWkIjnx/CvLyIUHtauk3i7Ac6Rn/hzuEGefHOf7J/I6+TJ5IlxPP/asFNU4rhuqRGjkzg5+2tCZaiGLaFbdk3n7FqgAnMtiOXhvZI/inPc8GOEElu+qJEiVhDdzkHHdCMvUMVhmGzVr6n+pX1xzLzt4Qh2lqdL7na2/s2msvCMLnjIM16T3+KuvIE3Q94FTvQGBnVjNn4vthojtbKnP9sXiwaxKf6ZkMnF/mmLf+JzA/DIWHBo2e0ErWXmemGWAJZ3TJ+xWyUkv2K30/ZscjvtzCZGbgdzPfep490BrNwaguuDc/z2/MOLDy/evKbrIPLuQITgXcmTOpfmO+Sh4+y2O8VTMLG8iuE/3K+D/brlfs1mG1/RrCuafeo81PC3Xz/eow0rFPolWNBgdhw5ExlP1kHkZ1yeVovQcE/19YyunFMW7yle3JV3vrjox1nh1mPAMZGDjFpv37wXExUDta6sPaFZ+f57uM53og5i8IdL/ixK8mjxcLlvrD3ZUyROHpgYjbGVqWrl3bPXvz+nIGvwukOaxV5vsAe1vkIhY1dGvT2tqDsaQF3QDqrK7fcgd+TkxGsMdH1wvJc9rUh1nepktzq5XZ3cqUzuHCndr+6zN+g7lVMduqM2THbYdqpbHLY7RzM6xzJGRzKGgyNTbw+HlTn9Xnsw2s8keKdFiFK3t28TvLxPXAnX2U+PKOxgYaDLqgvGB62++HxZ0WhOq1UXDw4H/NgaogNJi1SXnX4BKHa+Ys9neiR7iocUmdf5m+Ieng6eQ5ES8lyqDr7Xpu/PP3gdiuDndWmOgr0e1fSn16fQozfY7yk+aB7GDvp947N7x3No5o1o5LkOvfFcly49t00TmOc4M14dVaxX01HfU/tienFxdTEjO/vigkwuLsAtnz191vwBvaJ192T3Kf9s78nOdiZTpzkQH3N7+o/Pmv8yvbi4mP08gVamThbdLJMZIU+vDffMUuOGxsKaC1lsSS7SAHpjy7LJ0IsMZTtUsIvzhBgTgjwhoEPipRPrH5999/z78x9+/4cX//THl69ev3n7z+/ef/jxTz/9+S8wtNnPtYmltbvA6GkaQtTh9yhiaQF6eC5vNHurHtJPp9OL2f3+529au8m3DZvM5IMCYrjYc/Qd1ZgTOkf5X1akyZnNxTZqT2cwa3hKhujCd+LZt2RnL8jOTsnO/ons7O/Jzn5PyA7c14H7wQtit2A1f76YgSEfaYrECabMoCDumU5Eh7+CCrPB5AcpNA5uoEBVEtTtJLkX5488JQTyp20jWz3g8tyOWbnw6srLdI0y5isxL9Erlnhx0E2/1MSRnga6WAWWt/3pcLbb+dPRjBTJFMD9/tR1ZmXqRZucn9gB86duW8sKpikNW8ANQV76bFzq1kAXwQFJlEnqJylRP/kjZQ6QYiHwYFGGrH12dp8z0N7fRZn/SbLR1Gsn4dfb0Ec+WiK8C3u1p1aDN6ynXs1qoLCy7zi9jqcaPBBYSv3mSjbfhifg4dWP5rxer+DgGvmtKL4VTOCAGcnjDFj7IlBTFN9W8KqBJXFrk70wzxNqrd/7GachS0XW59uQBZvhHmz0rW204Msg4ot8pLdBtIhvd7sKLuUr4Rn3+cqPIh6KOWwZMtPm4s/G7FKKpyCwHsmSu3vBySsO0SbjrQ1hR+DdCKX3c196/hcbmvLsQ7Dm8TazN9QhlO/346SAn1UfE6NoQqFJDwwTaakFsqdRSSI4Z0YZjvL5AjcZRQQqf46wp+eQruJtuPhLwMPF4XKfuHvqmzNexsmc/5D4a/7OzwryCGNXl0zsQSsfFV2pNHMs4+IW6n2Sej5igxa6JkBZ+CwK1shZxFGMK3ZZFk/4r1ueZsXyuQ6ROKfWh1WQ1q6S+DblSW0R8zR6ktXS7QZVfyqbaNVe+R95Ld0mvJat/Kx2F29rYeyD9H4Th3fLAMUQtThc8EQ1nbZqKIH2nj5NuD/PfklbcXKNKkQioamqplYlg33xZSOvWqv/+IHvBavqxKXX4jjdQKj4O9ajV6DK/Eigq5VP2Bm7AjA0gY0+AiY5uXfO+G7ntnunfFJavWKlWuZ/5CmuQxpkwQ1IIrLaFc9uOY9qTs2PFhBag9agGrCAl1CzlvgZT2ug0ojyCz+CQrXlJgVltCjW2wESLu+OOad88srPVq1lGMeJ7fLOU068nlAxvkWxThFD0XN224IG2mPx123FkXTvXY2orskxLHXFeONuDGjsWuCryTmoU2eyR2yAeLaxe6SMyioqUC7OPe76nhYR2zXjdL3bQZsOrahcxmQQyYMtCwIUbpcnQkBSXEZwyM4CYNvnorpPpsw8t1EQl3lGjBh2Qp0gabpnZ2cYgh5EckgZaGvCuF53Tp/ZMc0I0fHBoBzLKPhCZTFNWGT0fmlQ3loiZXMmBLAwfc80ejBsHqYOdq67NuagpXSJSIeyLJEzEbNwaK4OBF5Tx0rC3/7WBtNy4U4LQsAJvw8h49O02GFQrztnz+yAJoToxFAkhjQAlxIw8ZBCTZbQiKXEE2kBhaYxzTcprMNmkvI6ms3t9wdicLxa9Xo9K+5tGieCWGtm+e+xwZ5KJonHW8GimbWCBWKpj0CWP4d/3jCX/iLQ1QvWoa/hAHyAf94C8113+a4o+b20n2v2ZzYuqLK0IHYB6AGT9/ZzcxXgheYneCWeMq5iEUEhaoybZfDSDIR7bChLP9kf4U4XnRow9gqGFCxtGCiF8dGTDyTHBZf2R0I+wNlL7O/lOCoGX69H9itqjK3JzU6+FwdTrEi9LjpDdtFr8GMigOsFIhZYnXd2Qugv2Lfq4Rc0gfulNKuzhOx2vF4/qb4WbHRlK4jMX/SKIsFwSJwG5D4vo7byl9YmCeIkyO7Q0nss6M3gYCCnLCHj5ABh0op+0onRTer9wsQi1+vv7Y+EwtQFMoTPsViEfb4fvxAh5Txx8r3YFvZiK/Zia+xFQmjIcsWycL8MIj8M7+41yPoIssLH/kvmm9fsi0XI38pFYD3zznyxXvNF4Gd5tmtmv4xvdUbXzHgNxH6o8zpm3tskXgYhuNPGkRk5P6Y8+Q4UnYPoWtdtmyUEvfJcra15i/DizhaufuV96/wTn2+hgnkpfNjtXu92tj4BRbr3mmfPt0nCo+ytCSYV5MiLcr0fgiTN1Fhfg+7qYSXce7NexD+VlKyFnPeFChEmwnDJgF4dT1p9ltjzGXuxF1jvxfgFy/DYaTVjooHjBUv2hd43/jatXqVCMUnwvvWDKGMvCznb6KcgW+ndKz5AyuHOSnPBPzIAWc8rzoizjjEjbs4oe2hGKQjbtiGvgBnq00CZTR7QQXKoBw/gQNkbsGASMCvagoxQZ9sBC8AVnX9HgBIIJmkj8FIvYCnNJy2Od9M1ZDW1theyds8xk3peyFxn0AG92HbHzOlCDu+W9jxkPd7Zay7efbDw3jQaVJ0Kz6cFROdxqtGHF9AisvNCFjRCqi8br+nuaXCWgpsoncgC+sl+Tjmh8koTSI7jr+fACHs7iW3ivYWTBQgraKaEEK/QRojXFif04BgC0Wju5G3ibypPvjgCL8Zl3VFynwNM8QhI9RhUNDd0dApABHySzrDrHFPLzRm9iY0MFbKng4F7tLykHu3ucNDvktZ32+WSJ2Nk89TiGoYrWqRCKUrwd5PtPIsTRAHbDU/suHW5SfjGT/h7+FYkMieESq0e8UB+m8QbnmRyepaIRnIvpU2v4wi7sKgyzoC1BSuu2yTI5O89oVioNfc32Tbh70FE/CHxgedzJEN0FpN9mvlZMK8dGeq9sQv/9P7N65bQYw+WdzaX7wiVLdivkLzfC363z6b3Gzk5T86LR9s1l3MAeVKer8MbHS+Cou+HCghTMDMffAAF7P3d+ioObauVxTCLGpwxeLzRlNn3yGnkNOU88jKaxZdeQvEZeZ63E4OY9Xu+yVZeCApW2crb7nPO/hzD1pVUMslkOvPu90IRVQYGJXR7xkLFKJ8foXu4HGe9foIa59NABWWwUWQxDWZAbYi+M6aKaxPqmlQWgII02xOlrIQVpgn1Z/FSaZZxqfDKCakYSQSC3yAVwG/7ZDKHR5E1FVi2JtJnllfB2QA/s369foCQ/QlIr+bhdgG9QrwJ1ejzIJkDL3RmefYWMCEkp2qDpslM7pGKnPrIXSIeNuQba5CDTGZCS7KH8RFl3pHvxzSb1evVh3ZOM3VYoVgB+E7i3S55+OCqHZvvDQR1n/Ik8MPgN2FIghiK3efwdq+nmbDXeKFJKfWfzi9fvP7hxesXH/6yZ5ls+2AHuL4S+SQtwv90drCoJ06+qolcVWdPvEp14L9Of5CpXu2be60P7EdxdLeOt6m1n/3V43u64H+nKYKNOdoAAqEZLwU+LtjWPDT9ev2kfGjJvTpWWudV7dHhWgGyyCqXh+YvXH6L6vxwQbW77nBkXDj6jnE6o76+Y0wrAKDC0HrpUloUtvwwjEEWJIyaLvEOBAsOZTYqCmKKtDy8DHnEnH1sGrVhgMQStXcI96jVDnHDttlyaFHOIhSvYXEyzmMnGR3T2PyCYVBfcy5owPSIQG9znDsUEERW8LuYbhky09ZBZPvNlMbNEFSanPH8dDueo6J12JjPgL/RmM9AQ25L7aDBtuR3MYOoRqIHMUN4upn2ddAxeATO0GLPXJFFcM3T7IjhI9b7XWleY+N7ms2Y2x6aO9AC5qnt0Awc/2VnrLRdsLTmQI2apKIZGWk0YcNv9YCQY3TKuu1Rd9QftEc9YtYDNMN/fBFlnfZ353ZSBo5m12AdRMzOW6kn5OzsDBhOdtKMyFOd0x8fbz8+aH9I6PHiUcVw9kfXQ3IjRNLKT1f5TccnfiuLNe3h+cVdvSwDOrk/UGiXZWrrbQq84Vqw3oToPoEvald3tXR7pWV7OYoG3aaRM2gfHOYq+zdyzxkvOTsRwBVNuWBJZgeK9rxhHbCba/Ytr/nzOd9ktc02DJUkIyWWXhFoIduPo1a68lliD7rd7pBQ/HRZYrvDTqcvv9vtLhK43U5bpfT6QB4PuqORTOkMoUzP7Q9VrZ7bBpXswdDtAwkN7VegtE5v4A6EogNiPaHTXUB0NGBTt+cOe47T7o6oO+yNBoNeZ9ShTXc46jrOoDcc0uZwNOqOBj23C7zN3EZiWIixrPzZBFGQ2QrybllKY2QzCHK336W9PjEiK5tqFKenHWfHz87OjNDL89y1iCyGvssmWT3Z/WtWj7y2/szq0S6pR172c/JztI/skMaEhgYowsiqhCI4Up+5g067N+y6o446GqzrtDvtTqfrDmTSnLV7/XZn2HGdtkxasPbAHXQ6w4FCPpx12sNeZzDotR2J58KHTkTRLYw8ZLc0Ys5Ojo3G+vcV9fXvOU317wUN9W9ON8wZb07d/rjR2JBkupmhioq/UKe/++1GmqJuTodOXiqZbpqd2c/wZyj+uF35tz/T182SOeOlqLcUSHrF/vVf7eXTtkPogjk7285YRE5Pe7sMtnNAGnN7hb7oUtIIG8l0OWsE09VsHLIU2OXUZ1s7BliNaMQWe7UlUUP+2jl6T2KZdqXT5syXaXOdtmCpTFvoNM5CmcZ3TmlPAKex0juYM1/c9T9Gqb/kdtvJkZ7Apmo5841Cd1WVeVe0ezRvTodH8xbUbR/N5KDAA6/+HOmFe4oY5r8HRugJhDAoYISHUcbmf0cZX4MyxKFGlLEkcEIPUMZSogx99LGUnbFkuhRYYymwxlJijSVgDXJ66iIa6OQmxivmjFeilZXSRfjXf7VXiEDWbGtHpLGxFybGWAHGWMx2joEz5jnOWP/vOONvwxlIgzyMMyRR4ufoIzhAHyY26HcfhQ38Q2zw5SexAyds4Dqdtt70kdt1e06/39FbPnTbA6c9Go30hnfdbnfktvujgd7vbnvkDAa9YU8lLZk76Dl9p+O0ezLpmrn9UdcZ9Icd1daKddpO1xn0uvIR8ihgCYrAMjwGLMdB5TigHAeTB4CkACJVWUvaPjaIa9ruHgIWQs3fcBm5br/Ta3edIXXh1ukOul2XdpzuqNPudAcu7YzaruOMeoMOHfXd0XDg9jvU7TnD0cAZjTq03e11+p3eoDuk7eHAGfQ77bZLO/12tzN0naFDO67TGw27jkv7zqDd7rUHQ+p22/3hEFqj7qjdcwbDznBI226/7QyGbadP2323OxwOXadDO+3usN1u96CpYafXGTnQVtdpt9vt7mDQpe1+t9MdOIMh7TvdoTPot4d0MHDavd5o2KFuuztye47bblO31+s5Q7c/alN3NOo7/e5o2KftXq/bbjvDYZu2h2132Ol2uiPaHvXao1F/6Axpp+06HbfTh8XodPq9gTscubTTG3Z77eHAdanrdkbtPixGpzPExe7Rfr/fcQZtp0cHgw405bap2x51B71BZ9CmbmfUd4ft9silbn/Uc4edgeNQdzTs9/uu03Np24Up9Ds9B1a4P+r1nc6AtgcdpzvsjdoujBWOTNd1aafdGw06ztBxaKfT7Q363QGMtef2nX5v6A5op+84nV576HRp1xl1ewN35IxoGw5Tp9Pt0m7HabcHg06X9pz+qDvsu33a742cvtPr9elw2BmNBsPBgI56Q7cz6g1c6nbabdgVd0jdHoy97QBYDLqD4aAzGIyoO+r1en3YI9qGUTrdodujbezG6fbatN3pu8Neu9vu0na3Pex2+l3Yy0GvP+h03eGA4nnvuN3BiHY67VGn3WuPhrOvwYCfoYeEczKJGJKfed3Ofk7IwRtJF+H1bJfUbb7LSIEqUgVsJJh2SDyRn/HL7cCnO5Kfbcx1HaP+slS/DyXafVXfxU9X1e/B58CovipVH2D5nqo+xP665Gf46nwVJTYYOZ3OwMkpsY7b7bqdbnuQU2Ku48LxbHdNSmzQEYdS3wBupzcajjquO9I3QLvvOHAa2l19A8DZ6nT7nZ6+ANxe1+32Ru12r+oC+E8g4Rb695Ku9e9req1/r+gNc8Y3gsy7Icn05pDMu5Fk3s1pv5uXgkcdkno3zfYM2FPuYJednrqwhUDduSP87JCf8cshDSg6mDVWNvxwezORYr4j75gzvhO93Ikb8YpdN5Z2SBpbO6QLuiaNYHo3ayTTu9nOobdsA5Th3EZta7JzxtdsTddsQRcspCFLG0DgCdowloThVeN25/xnEIcaVhYybanTrtlapl3rtBW7lmmrryIsO+3/DrRCVdaKtoeHZATyvx4mIwRD7PH0qdt3vphABRaz67a/gkRdMbg2nIHT6SuUcbVibr/XBaK0p2FvxYAy6bVHnYGGvRXr9HrdfrvTV0l8BY9Pt+v0uq5qbrli7c4IOml3XAV6K9bpD3qOM+xp6nW1Yi7czf320FXo0g8rSOjwkIYOD4nosIKKDivI6LCCjg4rCOnw70BJd4fGzma2ZAeUwY1GByCY0KjRzSUW6jCt9DLBsVLJVyu9UnSYJ89XerXwbKj0xUovGUK/SucrvWy0087Tlyu9dLTrHB4JhPe/C2Xd6TujQX/Q7RWI7L7THo7cQbtXJLf7XXgrjkYm5d0GTsio1+sODSK8C7Rse9gdtE16vOP0OsNOt93vFUjzUWfQH7i9wahIpfe7fQdoS5Ngb8ONPex0Rl2Ddnfdfnc0gtNkkvEdoOKcQb9rUvSd3siBGY26JnHfdfpDUBsadkw6fzRyO52+63ZMir/f6QydTscdFGj/7mA0GHSH/aH5DGj3gWDuwMoaLwJYYReocuNt0O503XYfyBHjmdB2nMHQcUadjvli6I56o5EzctzC42HY63c7brvTM98RHXfQa7tDt1N8Urij4ajnDDsD83XRGY1GA3fU6YzMh8ag3x/ACvfNJ0e71+/3Rt3hYFR4fbSdTqczGPX65kPEdTrdbg8eC+abpN3tAyU/cgrPk0Fv2Gn3O0PzpeL2hwN3MOr0jTeL6w6H7mA06nfN50uv23e6PXgmmC+ZXrs9dHrdYc981MC6D6GPjvm+aXe6nV570BkVnjqu43Zh24aO+epx205/0Bu53bb5AOp0u85g0G8XnkJue+QM+51u3ym8ilxYjW570Ck8kHpOb9Rr93sD863kOv22O3CcYenVBGQpPJCMB5Tb7bQH7d5g0DffUm63P3A6bm/UNZ5Vw57r9keDtmM8sDouULqdQa9tvLXcTh8Yne6oZzy7OoDNh73eqGO8wDqDYc/pOe2hYzzGOh132HEG3fbAfJd14CZpd7pOx3yitR2n43S6o1HPfK11+k7b6fSHo8LDze0N4CA4buEN57pwTtojOD7Gc27g9kdOt+P0zZddF54HfadbeOP1Rp3+CJfVfO0NOh3XRaa48fCDe7DTcwEF5m9AmFlv1HZgvp3OyO31R323C+hy2Bl2RrjJbq/dH7QHLu31++2hAyeiM+o67nDQd/q00+11nf6o1+nSrusO+x0HinYdx2nDIW0jtnOHMFgXrujOsNfrwnrBCwXe3gNAjL32AABuBMgK9qvtdEajjuP0abfvdEaddh/n0m8DYND+sNdFrjTtDQfdUX/Y6dNhr+122yMEwGF/MGoPxXEAIgYn2u/1ugAxsOh9x3EAEbbhjMF10gdqCRBldwDo0RkNez0Xrpie4wKUd4e02+50ew4cNer2nYHbH4zcHsX7Y9Dtw23k9oGjDjDY7vYBH4zc2VdRcXEVFfcf8czOPYmKt/AQHkZd+UzKn92Z8QouPLNL9d0uPqyG+VM5E09lbGGELXSKL+1yC1Cjo97mWL8tn9qGXGXx2Hry/Z6VRr4+rK+fhGLqONaO6LifV7x+dEXJeMiQ8ZA3cFP03gqqE6dQz5m4nvM1hPohM2FVwU1YVbATVhX8hFUFQ2FVwVFYHbIUDngKSKh3neGo3ekN2k5OqAOmGw46vVEvp9S77YHrDnqDdicn1d3eqDcAgmGUk+rtkTvo9XtuxyDVB+1ez3VdTeVfQ3NurzMc9boDk1IfOJ1O2xl9KcdD8zuSnN+xMpgfVyuD+zFfGeyPxcrgf/CVwQBZruid/rhe0Sv9sVrR27wfsP7T/YT0U95PSC/zfkL6Pu8npM/yfkL6Me8npM/zfkL6hjnjN6ed9vhNg7VJNn1zyGB5Q2g2fdNwK3IaXcl8eXPq9h3RBtL1v7Bs+qbZcWb0hfwFzr9es5X9C31B6Ae2sF/QXwh9y9a2KNtVRbsNd0boO3YtSrzCRJDcfi9/QUsvRavtGf1O/oLUH9iHxvc7h/7GXjdeNW7sH+gH4Lr8xuzf2G+Nt5DCfmi82zn0Hdk5pPFSpny3c+h3BHgdMP/f1HR/2Kv9/5E54x9xij/iFH9j2fRH6C+b/thwhXfNX9ncRsN6Qv/E5vYtPaefCP2GbeyE3hL6E9vYtzQh9A9saaf0PaH/xJb2e5oS+kcWQGu/hz8wjX9hWzulIb0j9C9sa7+nz+hHQv+ZPW/8086hf2ZXjT80bux/ps9hcn9mNv7358a/QCL758Zfdg79C87vjzLl9zuH/h5TfpMpP+wc+gNUh5Fzzn5q/Ammz9k3jV8bNzbn9CfIvWJ39Dn7SO8YwNAz4FnRZ+w9mgz+uXFjv2eXjX/eOfQSFg+YWJfsEzKyPrFzGrEE7FZpwv7cyHjjxr5l/9zgwHr6Z5LzuEKmfgDnK8cQ6kfjXKfOVeo8bHzSqQuVuggblzqVq1QeNt7r1KVKXYaNZzr1WqVeh42POnWlUldh47lO9VdqvKtG0rix9Rm9JfnoVZmrVSPSZa5Cep6Xmasy81Uj1mXmIf2Ul1moMotVw9dlFqFYbo2u1Y9GqsvwkL7PyyxVmeWqEeoyy5A+y8tcqzLXq8adLnMd0o95mZUqs1o1rnSZVYiA+BU8wX73vyHXQ6VfrzR00a7R72ql4QskJwdckg68Ij5rYuK0XUeyTNxRW/o/HTj9jgtcxMi2fqejCvwOrRYg6Sfuf3zlb35nQdwKGmJa/r1lsW3JIsZOXvNM5M+r81OVv6nOX/mpyF9C/pG2V4d5ut3FYZ5uc11SSNYKxjRiXBlT2gmLWmB1R8YRSwhGPfjI7zAgglKBxHyW4B8q/jAuvrjMo8m+KlaBBG2hwsPu/TTlSdGHPGhrRjBmmxNDaTOwrffBgtfmwsQfPTeg8ibYMvoB+Iz0bS49n+cNCu/kaR0jMByoqkemu5M8VURC5Gq6WwxMoWMOCm9MejGWdmbmauX4Khf0gorWgR/AX7vwCA9nFHzg+unfd+ibB4e+eNTQVUBPMXgx1HHu5yQtLDeNyf2XDnlicwitiK++lFB4iUE7xAsnGCNVmAqEhK7sTObYCQahRF/493sKIIeBsfaEFn1oKS8FcuWjSSRWnIFhO0IqtpGJJkSScty430sKhey1y+UIInO0hx3TrC0H81ijHHfgDgekdX7Do+x8HWRZ0e6A3Ef5g5XsFVfXjgF9x6133F+AcQhgqVF36ELaT9KsBVR1uu32CNK+325C/gn0h/uDXgdSPiR+lIJrIaja7Tvg9rn11k/TD6sk3l6v0NnzoI3JyyAK0hVfQFofEWRrE2w4RoZJ7N5o1O1D2vss4f4aRG5m6JpgUx2zBiZkzjRTVxSMvV4/ESHcIYHbGcGIBWBjq3/YxtPPh9cbEtGicoIBM9bGL5vsk1Yc2SImJRCTXHwmfhBZ1IfvyyB9ny2CeLfLZP/ohRIACMvyaGEBXSm+VHRcaRAQFJwZpOA0ZLezAzDK5NIv8b7AntDZlXZnC55mSXxXr+ufNimrRwZLe24TCmqNEQaa5hFPnsfbKJMWjOiKxVLIkZs8DLFeEM3rpaxYWJqDLL1Mh9XyZSnn6CWqqoVjo9uq3vK8I73NH+htXtWiztvraDxxZHbES9/Gjs9LOz5Xpc1vvg4y2wJgt2iCXluAm9vrfJ7iMMUxMQMjv/NoHkNwvN2uwpzd5syyGhzMhUvBtWWkHv5JRuFBUyH9s6l+++k8COTvqyDykzv14ae831U15mk7/9ls5w25/ZDnX03jM/FvdaQeR1tX6wugGEpHWvXoQEMi0J6YeIXxT/niVxeXmKVWJcjG2vO7NBuvWIZCza+Zbd4Afos2Qj8LIre0sLKkzBsfrrO5G7h12mJOrZ9xD485s3HziztPM4ZRd7gIMFGOiZVBQH8DrBhj8W53EgMRdBhV4sfoYxTfRjW1F+D7juex0XY7vtc2b6pMvtJifTATYiyyUBVcsC3NWNf0siw2BvPBtOqln2YsLRdSi5U3Oc+b3NBDBw4mEw8vD7ak4B3H1rVW0sAp9NPsNecL5lD9/SHO/NBMQC+pUeF5lZFSHBithc7c9mDiePzsrMdYf9KGX13G3O6kAz87jHWcSRd+9hlrT5qu12yXQp8YXDE9nmZhuDRhZcoFQpm1hyfMdkftegYOkLThpTlL6//3//3/WGMMBqxSz1yI/CfDArkHLbmVLbmVLbWNltr1utlMu7KZtmhmvxe3VQ5k2pkReBUqzP1UGzBOMHLg5s7W+bBTNKMOLdQgxa3MTdQKAEzLICCcLRzpgOuQ7Tlgqf6aeoTF+16FXrNVdjMjv2sz5uROlvTQ9EECQhTJbSXTN91hqmhOTRcLRWes12uP+vV6dMp6/Y47IuZRMFa9BOzd4gqBxys+1aNsz0rZbiHbndFEWoE7tOnm1+u+snO33Hn7wc7dGa1eFpoXKRNF4gDxel0VmeSoAOwSLYTbIowYFOmxU6cRYOMIPOWjc2hSFdlobhK/BTDojA0DlWRiTlmiP5oRrzhm1mkm5cXsUBcbeHhJPftv2m9CK8eX70hCyuqmj9iSKmiZHF9r1a1DO8VtIl5Wqata40dOfrVuau2hwe6zFuoWGo8cedEUaRQHHzCF8HqWDmxZAYTaRZwIu1+4GPGu1m0krFCVlq4z8U7HEEjyNJ7mk1GrCncpeh7zyp+7nWXtC9ODW/OQJPuaDUWM72XF5rOi56b8lFS/0DMNjIj3TjU3wpFhvgI7m0YzGYKPOaQQds+4gOImvJehWLMZnSa7XRNMwuK8OcDXujnycFPtv6kpLD2JmeMVGoWXukYOeyO+K1oqF8DnEM6lr4CMjHVJgSeSsbKQlgtpJ6VTlKuUVlyBjuAcH/SDjClzXzVVVzoYR6508lCnD1JEf6c7f/w1PevR0yN0APCB4JFX5W0CPRrhq6/sccIvMV7BbywnED+ZgQOWAgVaUEeI5fc+Rg8R9XosiFf9Q1Cxpc/3YXw7ydlTkWf7doQBe+XIWEBokO8tkz5YRAw622D4EOrbMcXS0H8VrVr2/XUsau8z6WBKOAQAFu4Vr/k1WZuMyzOmUvnzoT5PvqjPYn9i2+DddECpZpMDnx3JJMITgJFYPfVb/3IIjfSIxSaUz8nfY8z5GpFSb7Dl/4E9Ri3oQAAPdL6nbdA7+0ys3t/6GKt3Ta9/wh/XNJrjjzv6TYI/rugfRTzfWx3PN7E7oIUoIoUCO1zofjvdQRd1v1uR7YOsJrHbA6fbARENpKUgm0nszshpt0EMA2lbkLckdm/oukMQrUDaBuQoFfEeZJgD4x7LH+WCZwtrvqiqagSKmP58cTt7eh1Q69IiBotkbVy0rXjDI38TKGb6SVavhzaxMyL6yajVsciBKo24RMvxLeFxpuFWZ0LQKPPDsywaHdbtVNbtmHU74GsLcdpN+82GC9d4LxbP4/XGz4KrAAIYQzxyvMPAJ5Hk/oNjQJvYOgg1eqSVDkcwLr1uCggUkq/gRfr0mlo55PmKKFkUqxHvBmWh9w+Py4v3h0pFSvj1lYv56AWJyX0e12JuE9tnlqViCGUltg9AjIQAn3JjRaYX6ck//sM3v/u5/q1NLhtsen8x24+907Nd6+mEXlw8sazmDBbtUq+anbJ0t4MeA6NHLkONw+XpFjoMaEaMHm37kty36Z7IVvOcn+1L8q3YIKP49HJGvv1GJKu3EnSeGJ2v7Iyo7hK6QJmd3pc7Y1/GDzRAraaVN8KNFq6K6mJAzG78bJVOKoOBV1wplcC7253Idg4zRfohfEdMZmk2ZgzXfUTUp4+fEG0bcPTbZ++evTr/cP7uPbTst7L4x80m5wILt5xQeuqjS5vU9P2GI0llsZDdpxs+9ziF7l/7a/TcxrNVvPBK7VJ9kLwUgnlmdih4E/X6lmjfuXIr97hKJw4RQcfh0y6/HxI5aeiVwgqIftG7tu4LcUR8sJKxEXVcIIjYPOgSHqbXdoyqD/QORZHUn7XSeM0r40hz4X4zg7AzhHhFb9i3Ji6GFaMJy8SWgWx6j8PEuOrffBOhA1+IBZs7Pyvso3zHpHALyR0SAvUQ4swRO2VTNV1DNDSTMJxSWIBQRYnesrC18RN/zTOepGZ0DbXMNGXhlOtuGBzzkyWelIf6SagEFPXqVJ6AxQt2w67tlALWgYY35D6abmYT+Ef4V0yJBx8MXJ8LTw/wCWVXObdx1VrGybkPQo2K19+Yty4v4yS4DiI/NPAmO5K+2xWwPS18sTJu2ORIAfyC7Y1QOBqfp4XbQ/qnWIH7+MWRoS2ODa3QFF0UhrYRkVbybQQwl9u3BgfqNwBi2lgSYCgj1iaJF9s5x8J39TrsD8g59XfK59skyO7we7ezb6Z3M5ZN72Z0reJT4t5B+OkbA4bY1ihA1+p1I8wy6S2LbWKviXZHfttKbTI+sa/YbSuyCWkt4ohLx+Ln7ErI0PXgP8HgzwGLpdNPM0FyGvNmjH0SVS/pe+zpHIqNVWyYZ4eaT5eyB2ivfLozI+BFhLFJxF/kywiHiFnrm4Qv63XxFzPghzjKKifPknnwsSdkt8NepUNRsUXv5XpcsvfmejyzjWAP71scyiunue9bS4jZI7g20CKDWe/z8rfF8reyfA4TK1jWkGzsFckd+xdwEYqh93vqgnFAKdzawZou4jmSLqBHJMKLY64g2FqJH11zlDKTQ7/B+ZiyvBl/ngU3/Fz4cqMJgHTEnHF0ajYmQrfLxYSe30HWs8yOiPYpnbUy/xoujPKdh6KiF6/f/vhBitI+nP/5w7N3588sL2tdhdvEJgd+vhHFG/Q4CIyfhSF2m9qGegi5t577Ccfbk2NEzXq9qoI5m90uqUBuqOLgLxZYBeRIhNAMQGwZz7cpbirY2IzEDqkLp8qNneFiLhGwJzPxU5g+CPUVQ+pf4qNNwSHvFAK2nThlF9A1bm9EuSVLJrG9IRjp/R7eM+CHlN1HQCovKfy59DZIQHhTHe4yInTjJzzKvGAaGOx8kZh6AQVlmkiKDpougfjJQfoujjNP6jNgHRqix239OZeeafGCpkKz3CteHuuWaAfiorREfy0Y5XQN2mkzxgGrQQLjsPgQDNqFdxp66i0omImkWlUrlOc1BSQUaqbFrsmk8NlKNzhtbIq6xHtkRx/5XSomfsWXcVLsctUSiYzvKQZoLuViGmRuDipuRK1NnGblnDjNICvN4o1nxrvCYDToE9JMhnHuBTU0V8hhPTaepQLjl9W9xHZoZ7DiEzYSJlyvi+9LpnJwYyGL+bYsC1sapC+5v2QOYyJXxWhRCIkzZ8xPFTCOeaMB91Aw5TPd/gY6VSDGIEtgDRWCR/UBJ0CMAGl4GEQmMlQRBLEMwFCkwa/9ta2iRYsQJvZaQuIhP+kGpi3AO/8FMXjUNtfr6lepKbqY2Dm5XFhhQY0b65hPFnSRBO5dE3ptExrKNTYI9wxU9iJRKoORbMQwNuUxgPflTPkK/f9z92frbRtbwzB42BfRJxS2N40KixQHjaDKfD3IibI9xbLjJBSjQGSRhA0CDFDUEJHf0yfdJ33WV9DX0pfyX0k/a9WAAghKspP3/b/uPHtbRKFQ46pVa17cNYWkC9yCqouFFAy7sgrMV3PwYRlBchwXdrPewneYIAK2OqYSSHEccWrW1Sc0MqmqaSB/EqqOQbWqfhTXbka8GZg34OPKDv9bDrkZ0Bp7FqBt0CAVUoJrktLE6YZsfAPJhmEdQOBQJq88SCDQM79c4vGMKl3H/qbR93xyfD03za6ypuUb92HNoGyv2Mqtigbv8Yb6tXpIW8/iOOR+VDYm9eqBg5Ihp8vakW8e2IyU1ZU1YyLWmmZyom0TbXzCxTst6Xs7JqIgAS+tBb2YZnnDSk7AmPoelniVxf9NWK5atZp7zGSNwOycn+Pj+flyeZtL9AVkWJTVZQmVU41MkhvXdzmxjnZCbjPVArFiZvNVkrcrv8uNCwkOinpHo3DsJrWaJjigdam5Wi63Flp0CZbIgknMp9CtYKAmy3ToNMkbqf+3DMJIFHK9bzXzvadcbLKNt/vX9K3RVEZ6BCr72UJznzEB+9J+PICg6zRh8FPPPOlD3QETtLAGM39eSg661hA4CGDynymKtOzT7ENWbKVFaFaQbzDhwIOW6m7BFKEoLaWRtk7AtjyRUxOX0sv4Rt6f1SpcUxHjlgdvBGJIgNgoPzCUzaxzNv3B/T3qBHZQAZrB5gvzhuvhH2geL52S5odhHPGy5qmw+rBI/igDw4A1u0EG+YEkczBTBWORsQnqB4PSmPyRudEiLUqM3chSCOPoI1AMKkEPBWwSkbyEL+3zAUtciKotJyhvYCrU33RlzOQtaCMrRTQobAoXf94IVh70/qBbVIzK0SRmoMJiiWwjPdWyrbRRehytsZGTzmUjyI+hcLVgDbxZ7m+bhoWzgveCgpNM/60KIIlihl3WEJrApB0Updpd2ITE1sLmtkPAdthQqDlAyYDJ8ZnTSluok0beMnNCiYFSlul/YlD1r5Th+0LPe+qnb68inY9juczPVO8L7hdkZt/Z3du51zoaUjhJf6zd/VYbQ9hs/94/u24262fX7ebZotn0m2eL1t5B82zRbkIx/PHh3/YB/nuI/47h393x2aLTbDbPFmM+Hg9q2zRg2/2z6Cw5E6APSaHxp/Xf/PpfA/W3WT+s1RuD77yz7bPtbcgz7p2Nao+26YJt/+72saas1qjVB995pOdCTcgdfna2Pajhj9OzdPAd2Q7oEDrw6389rf828La75VH5pHrLGC1kahMftSWSKe/3nX851AF3QGdA+07Poc6fC57cOANauvAzV0HmMA5Jz1JAnp2BGmbbIZAOpO9sO9QBtIdZgqDl/3Ko4y/E1KGtAe2/8d9QZxqnwqHyyqYtLN/23LPRd+TRNnXmcWK9tb/BNu3vBiBTgDl4LYqj91rWuR2ZE08jVprHWGYi7sk/XmY32pj0ksbEK/sk5eG4B/9ARqBGGA9RDIskVAzyDV9VdDkDhkmaVF+E8YUn5T9mDeUJuXQXEU+H/pwr/Q7MkdDbVUb4ZZYBzEeVDp4B/TmHunRKlAwg7guLbcjUNj65NZ9ygn+my6UL1ZnkrYztWNxIQz+d8rRadc1vlso0+7wxTfiYGKVbvMprnGWxMw5CLicMrlhibn5PReEhNU9X9k/1e00nzZnLGYB7BtYBgDXF9Ca3K51HnS10qn1ARf0WKBdag7wCFHTUPtvaivptiAUCPzoDCARgNMG9tOcmDN7X4F0t6u9ABShQmBTL1W/wFtOVi/WIp5qyW7G+hFc7A5otXNwLn7B2teomTFvItgnxZm5MerKyF/f8tffyo9xhxTrwAaG3utCLqdpYz18uoVH9jOJHL6QJTwV4puU12Up1uXEXtvA2tvP4XGb6Sn5lGpH3NAVv+gWd0ht6wcYmG9SVPmuCHiNRDLEV8Caz9HhX1ao+GPLJTYDOlYmRaWLnyDdnN8FaMYjJUpASsC03ZROJMalgI6kw1mtUrW6lGvzpsXUQ1K/l0gfZa1ZDf8hS8xMUAdlvx6GcpQ1YWupmW51aXbptVBzZm7FcDtXZA5VBNqZq1bU7yn901F4ut2ZuNihCABAu+p0B62+7je/Ito2nSff66EITB9e1GllfPXfBLkCb0nNDtgBx6w1b9FsDCprH3jFoh7i3ZsQU9v6XO2XOf8E0w560TcT0g2/BR9TjjcA8wPDWLLsWYArhytaNnfiUUG4ep7UF+gd4+Vq5OvAJ1JiyUGMF6A0/mMIcclXlmAih+B7+wZ1e9DuDalXgo+PQRX9noNuAfwrOVMTjDNx5j1Fo5R438Hpiif5FCLWhp1p1tgGOj80dgKb6T4XbxHXJv4MBgNGYKcDZmKd1GtFBZGqId4sqBL9XuLxRpixc+TOzx7cMOcx7AmyY5shilvQjkMz7kHYYmIeoXu8SpwE9Jv1o0Eu0uDoCUbXTyN64uVcUWA0vqFbR8Bn8en0GvuSFSvV6ZqgD7pmLKJ0GY+EC9nEajkwF7jTkL1CjIGEPb5PG5ziIcAora7WotYyE3rUNueOUX3Fn26llj4RG8DJOhIUVgE8/bgAZw+Qf7FxWYzC+48Yi5Qk2B02l6VWcjJjj0OMG0E/VqgtnST6Yc+N4DiE9N/ctVrAOi/USjVf5x/cnYD0URzwS7oivFWUfwCnIxpJrelpr5V4+tGn9AZyRbxgZjABHBT+sheplbdUcz6llb6xe4DupX2cK/crNNui3sMVyo3pZWc3Zxp2GYs+Buwa2B0gidmyR3KtLS3x2m3Nct1RoMhFWt+BvKKnwdVQqMv8ozOYGnun6KoOLi/rApAnb+Q0paU+W08gV1M8m1wPdlS/h0TfwiEsHziJrb2TzYCZndaBfmk58BGcYX41BU/IZBPLYnCh+bD4M5RUnSM8VTGhs4zlENck055+NiAl1oOEIeK79Qn9UGK6evONlC8GKVnG+uem3ktzH+rJUzpbAOUnnSpUFPauBiWGcbcdz/uV05QQzPCITGNeEJ6RWaH3bNLSqnjQcq7UqOSgiN0tktjydXdlGFN3/lfZcPztzwmCJlFCr/GFn0bexhJ8hAt1qWmvlyh/aqo0gvnpQIM7Tt9uCNbuLo7EmaxZahDpk4/5i0B3KG9zvD/utwYCpv4V7fGWMQhHfZKPr+Xl8k72xBk39dXTj59CNb6MbCcI9v4Bu/By68SW6sbLlEeqvqH7KaWC5TQZnWicMghFnKYBJ1w4fj9c79Eh9lpihdCEFrJqAgmU/c6KDdcQTr4MZ+DU3sSaaZETzzHpBejBBzzK+Tcza9SAXZPZIE7PA1Sq8gkXPyoB8ADqTeFlZb72aqZXtR5Lbj8Tej61I02WJudtV5//lEApnCkiVfpRZKCyXCpMlBh1BQ4nEg+BJLZcJBhLVsmapK9iaMDWRtGKPu+oX8fQPNYyepAMNcuk5PQdQC1A7gKFkNfmb0GC1opcNfi0SfyjeaQQ4oZdGjsFG9LIhkmD2io8Fm9PLxp8pi61ISJcrutvebbVKLzRwME8a0QwEL5kUKSa3eHeBtBp8ACjav+gnJbEtmfsEwGbS9RuTML7wQaTswxGRwhr9BJIYCXI0Ze3Wzv7OAURfpSHr7KGM7Tqq17dBcNb/HSR+9bPr/ePBNp2D4O66fYwCvfbZ4uXL5jH8u9cCQd6Y3caXPBmH8ZXnvNW/KkE0X4hKxPkorVwFIw7CY8EnPEkrIq7Mk3jI09ShThSL+oWfBkPHc07CkE/8UH37hFWa1wfNihvFouJXsFIFkFhlHgeRIA51gujSD4NRHT+ABuSzbMBZ0anMqjoO4zihI6YkxOAr81w59xacAKQHBtoMSfeLMUrVi5KVjCfgmboFtAVAz0eg3ZMCY5JFqCm1cmeGV4AzErHMmS8xpptwDhi4jtZAPMgZuIkSGtUmrstZJlucA0VPDG/SADEPUZd+w8lbc+fSYODIIfKjlbK2G0OKWlcwbntBx7UauNMp12ehXJ+r1fjI7+3uddpNxty9nd1WGyQH61+SnjJocN1Ws92pCoL5TmryKSG1vd3dzh7x3MzuIa7XiWceSxbzwlI3TMpMn4W1pvwJ9LArKa6R6/I6k11i9owqDGOJkwN+GCe0xKFxQvED1B+pJbVX9Cpv3V5rt2v7u9+5HEKm1l23ucVgqnbg1uO8dZgU2HCW9KYu395vNgnELWhRXmNQIEiXP9nZ3e1GNRYSjmWdXbMcUzeqdfa+49sur3UObMP960yoizGTKPg6LOiQzumYjuhEGrwaEL5hkO8DAvVes/02jgk30hZEoIn/UROFQ+BWhbrXpFurRSS34xF5Ai3BNWEdcUIn2uowV1fqXmKWPGn2klrLa3bjo0uVI9lnNzRgLbpgYTd+gtY6buHgE+q6Q+aOSoCuvnNw1Gr2RvV22xvV93aP2ns9+OuN6of78uFw3wvJExYul8MnU9dN6zdkOyDIwzoaszmE3tTY8LuAbrnDI3fOFkfsutfyFk/Yda2912vveYv6NSHdBWxR8GTqptvumIX1+XpDwXds3L1mx+5N3aeCTbR0tAXRhXwIqHWzLciTtH5V/PKqxuRLevNvJuhEc/s3tRpt0itDgF24tq/1+V1QMKGX9IJeUzAC1qo+EFhiVnANGAKBIkEUsd+mUu152a3VAuJOZKb+I9zsU7m7I3eitjRiMTvVzcSmAkBRNzJbvGBpaaNPmKhWJ0dgNr1gE9nioi7kLiVk271gUa21vsJJjUE98t0FFWyRaxp8g/WQRbVaqyVP0uLnE8YUjh+yhM5Z2MVNH7P5EfN7LW/+hPlq0+d1n5DuHDb9nA3rY3rNwvqYmnW4cse1839f0yYh4PY2dc+3r0nXej2Ur3wGESEvaASOzLjStVq0qtUSWqsZA5HTDPcE7PaSJym4iDitRqfRdijE1fFuJZnv3VDJAngXYG2Jv6510TkV8dPT5ycnXoltwmURiapyI1btOUAlODWAKg8ss4Gc/hgF2PRXNLgwDV67WqC4Uwi2ozpY0cxXPyoJIh2stGlJQgVFya9r+deSlUtW9GB3f/c+FShSayrxYnu32SbdXNQzaaagSUE0DsUizQNnJcj/ZI/Iz2ePyG7n3yLXZhVB5FGrA+4nQ7tACkitFrVsLV9ktwh8EDyuhJSDsAsqGglP4/CyKAQ1WAQMUsCyRdcDM+rsK6mKLv+W99a/lvXBLVdAKxAPz8/bOMWNIJU0motiWs4u0KxLN2rrSvweV024xPPzhjczX2RmAKLxMQmZr3gtpUhWOuSaB5rilG17/Wb9cPDdI9Q6/45q5Z7b2zrbJv3fz3qoUnbPev3f8VcPVdJ959ahzsqhztKhztmZQ53fHer84RjD7L5z5FDniUMfO4/hBXUqUDOBfyL4RzgDwAp953H2EXrF9p1/OxTVw6Br7jrU+VdWYwjOsn3z9l8OaHa3f+/X5Lye1n87rw9um3Svs3q0TUc45ZJ3BBQbj7bBvvyzf+mnwySYCw9i5FmPjgdGWZP7q1yyW1RXbjWp1FRuNelYPk/i+ZQnWAB8JHyOVR3zM1W/x6ZUfaOK4TPZzQ1Gcdzb71hH88JWtoGvfZAqUAMgygNN5icmzzzo2fzMjRpPhmqORis/Z+UEh6bMNTEHteU+3O+0303l8SIJH1uu3FKuQNHj3qkZT3Bt0JuJpHoYArfeUszckfXqXw7ysiBBowvDUqSkC6on1D+VWCB0Ze43zhbyVklR+37BLpCldQndSqpVGRdStgfd5DyUrrSG6AI/vcrFQEL0c2HhnosCproCXdIVqqxsHAclNo4TvRu1K1Yt5SnrtgjxNhUDlW+1C0rLrFUwA4DHlXSdCux5HMvZXTP3mB1DfK/8ndTN3wLXFJZMdXys1weczJLl8ni5vGjM0LVo+3fAKf3f/wsMVf5L/d1WnoXnDEQ7jGUtNWmbdLfOl8vjanXSPx4sl67VT5vkLx4wPgRIhJrVqis/27rsHw9IxuphCHZWb0Hs/O7zTNT3HER9CFfuW3ZhoGrcfz5AzV8dgODLcvn26As8fmFvyUpWf8rku95FnkUAmdFaEf2CrZ2yC3XZN+lTQi/M49Nai1iXZ4m48pQQnACSgXIS89wkYJZvu+tTmd89FSjDp4t8eBG8tbPRfrFH+4VocE5S/kOcCtf6SMqL7SdQQnZlTgGnDxude9tvDqpVZ7BennvK5GZoUvvZeCyf5L9Sx3X7rLFN6BsGK0VP9My6z4/eZIv1gZ30n2NzH6rVrQ8KVKcW0LyDg/OeNelr9kGv9fuj1933tRr5YDNb78kTCMj3rsaca8d7V2Mf+u/lQN9ZzUKTL9iJWdPnhL4yj88BAp4xPYwR6T6rVt0XklZ+hlKPV0aF+QwV2fRVpuW5QMXiq0zkUbso7smL7KV2NlmVrfGT9u5uL/+p43j5gvx3ebXIZ4iPnKsdNRTNnS9XZn0vmaELe6hU0k9gdfNXCSiZEvZX7WWGZGtZVfpZI8ANI9a4kpYCWVtrdy8QONXiXhCJZ2Zg26BOIX3DhuYU2sD1kQ0VcOGJzI7jRwUIf5apKT6S7p+MsY/VqvsnU8ZeH4k6egjYH5UI5k+yQvT9s9U03FESAfxspg+ktMGcP9uHuEl/VhvwyG6j5xAz6kf528m084jY10lWjEhMdy1f5u4vbc9gD+LRg66rC13D3KAXhF72r8HOwt7BanUrV23tM7j883fxcml1TW5fssJbhbs+MataBoZQj72sfcpFIcQrXxtmI42ustWsU+rrttfmFsBOODICbqlSyzaq2v535+l2QFFhwKXWoWv5KuQsi5L1KdKIGXjBZ2WkYZ+2ns94zTx5hXV34a28Y3JvcmrFPFrxnL5Ty5XUnIHZHqkdlrqiDCcQG/Ry5G5WTKpVy6zbfpHhy4DdZFqtHHR2lT7U3u2gWgV1SYBbondaa7mEhn+p3RJKbWPTKMsleoFcgvkkBPwGMrbn+kj41FwfmpWWaEqFZFv0JIh8ElBxLpfwDcjKq1XnX1A1ylWNmPMvpxYRmuJ40T7Mfp+CGqiWgkAXtG4ssQK59P4F6ozSgBVlkLcihNTclKWmCbQS/ne74xBSi/Kgvs5o571B8ixyxjsTc35K21tjwZEjyTMkSgwuOR1hWBzZPuVMrDJtBlaiESsCD1EytMh2dTDRT4JBN+mnAwSYfjpA4lfiXfQgmlJpUiWtYY1+A55YkuGHRMa4NUZeW5b9r6FHwtzQOKFSbx2W6K1D0FtnZgxbjA0BlvpDMOIdDoyw9LKf6TEHoEazMGlOlSkfLPWmRKUlM1nhTDJVafY7p0OV7ONlP3s9yGY6L850DGqCjOId65lO2bw/hh2YwsSmmUNT+biy7i0Da8px1svlJDcYa6qZwbV0oNPDHDHLFlta02fWb92RQTdbruyCjRqSgCOkS7qyDMV1+BJONrdIHZfbFBihaMo3QpJklLNm0x0dtYtvrDmMLKM2XAl12XL1gyqFMePyL5WwwPQo4eKQ3AnHPzSDFWaPWv6mUo/NuDRuS6xVSsxdK+OOJPmb6IYl9i0r37JZ7SbbWs35WbYC5uiUQqTk9m14dpDjTEqs9+iVmTJf+4CXfXDMrpbLC5jYFB2Es0qYRes817HVZQYqy2V/QE+lDieryjdWtS0RtnJHGIm3UzyvFuzo3UCJqB6mtPU87zcHPfhHmXJ45waAZAEhGhIch9rn2gZPbJhbnfBcJwC0PfhHLa2XQSnXnfBMUAxLemx/u1yasRJCr8gaaEoMC0SK6iDRUFgKo9YHUNDLfnrZF/T+I3LORsaFQh9Ccr5cuuesPyD0XFminbNzLb4ckfubNU2icO3NIgzfJh+1f4mrv5JpdU6JNUX5k51rJEPdz2xry9UbLv9mBNl/OeRJk5jyTBePZIc8659NW6pxq7vPGTLLjuY9K6Zn5Ca2SbJVqia3XKoazKrZy36CJVDNVO/pH1C88VLaOi/Ef00KqgOrOewaCS/dcmIpFcrub30nPGXnirlRwUa+MDcxCEX+Pc9CUwGYo6Hz0+VSmTw/JQpCn4K0ir5lunr37RNI9livK6tp9yk7778dkN651oW+tSynn/bcXDkF5tR7Xq0Wi8FQGpYHxGbXyNl2n9fr3eckQwXQJOluHVsHcbk8l6KbbV2SocTl0vrUIfSLJm3Ps0soRzWfG+NrKSiiJ+xBHXULqE7+ZCc9x/H0qvXMeQCBwv/UkdBnAuSo2dVgILBa3TqpVvOrZAZswaW1YJ67Dq8GJMn/1MmyKACYlqIEvvpGpmvaBZAjbuCGEZULlmqXjC5eLMh8ueBD3xwQw+hLC+BMFi49NrRcOQuGr35AhTVZEQaRRR2ox4va0ky1eqv5jBLF75pNNl9RzamWVb8j3MeKyh30ytzwZZQVU8e6JzZX5xD86XDnsL1vaYTt0E3kFsKgAUZIGhM0+AtPRZz4E25CF2Qhw0yJZLSKX0BkG6v3LbBpcESyQNNnHSy7oGRYlUWiUv4pketE8Qs+T7g0Q3RIXqmVQHKs9WhhOBndACqp8m0Uk9MI0oWKiT/kuYq9YRylccgb+Aq0ubrgyk8iVxCaQIYcPSTlxYy2ssbpGYNvdVqd1n0K+cO9HRm0e30rt4/Otmv9358Mak+2tSkBeJSW1cRqZ9tWxXKHdSM7d4/OtnuycbI9IRBFWvCkLJglciRc6czAPGzmz8vq3cooDJwCeHvA0IFTdyEhjRVAuuc8D+M0iCYf/IlTBskb5rmVWw/UgGL2oWp1y1clPeftnEe6aR9LTnk4tjt0PvBr4azKA6JJOF8Lz9sqDc/bssPztjA8b6JM7SMRJzRmApMSvIEwD2+jU3/GXwURh+zTNEWbSpYsl06lUqlIuAgxqkxxnamggQ60ylU8jYUKIde115IxtqhW/Xq9K8UEkZtQH3Tuw1qI/sfW4qi6tRqNdYC2oC/Ag2uKP9qDkqbl0jHGxip+XaHFqSp255BcbFibytHWxupvSFNl8FhvU1FvZXb181VGZ5ZFvMiAA6KQSmsPAmnYN4Pv1hbPbBrPIgfQ/kGzvbtrRcW7dUDMV/Vn8y6aMnhO9c9FLMAq4TG+mMcpPBzBQ4jlT+DnRHSd0nSjhdQfSnxm+8m7/apz9OTxgOQFclbofSGj53gQ3mC3s7N/WBLyv7PT2t2V5jw4JxmrHBNFEpVAsVsS2x/gTaa3iKhPU+ZGTFBAjBh9AvS6TbViEQo8IMY5724IWeW6IeN9vyDM6TcHcGGHkImXzwwCV88ycKZP9WMwjBeRYElWgIeHiUJBylKrRPAkWcwFC3UZTmkB9iYDMDEZdPPJW/IjLA+oMpSQWW7yU3vMnMe12BWk9th5vHIFRWd5wIorHVRSeboq9y21Xp6Jl8tCIp1zwsa5L0QCUXrVT4Dj8yGkTaxW53IYrnO01X/+4umHp32npt/awcoHZ4MnYO4wGAwGT0zdJw6pOYPBEwePhpwmWhhuteg8c4EMy0/Y+ib3HBygw9Y3uQdyQDl8TzWt4AzMFj13rtjjBYS5UxVkrjg4uIvlMhtPMaimqR0aBHGLxKtPzd57Wy0KnQcXC8FTb0iHcSR4JLw5lTDlJVSBjpeqX55Y5W6lLKtDMdCdiUnK3a2WDkIXIW5X/fdanmioPo3c0s63ipzWWhUdvCl7oViKrh0xOMZR+VmA6i6MNiarFY7HjZ60evqeSSVJL0PS9pyjbacmf9cQVcl3sipIfXuAChW5X60m+eynVvJda6pAQWdPQPXwaMQ4tQuhJLZLAOK4C2J4ZcEhV1IP2hqwHq8ea7armmNywHzILpc4veIQ+U3U043hjD39tL2+ALBy2QpsRfl9VtVMjS4syXIZ30G1roMOLBe7NRBHVCiKmIKPYYqouYfKCBV8FCRvQzyghnbobTUZy54kheDpZ2B0IXhkN59yatyTabI/BMMvaMBq7+3I0NgGyGCgixoTyCNtgQIhBENxHBoMqIkvhtqdY9G1sQW5DVWuUpntNZGnmjnOKhcJBDpN3REF1DCn817LgxzsOa8THFWoWcK7u+GE6gIejRCT6Wy9sIL6ncyoith5ZTwJ7FbHEGEUtI2NER+GvoyhLWNH2CUQysWyRW461KSzTEwCoOXS+fjhZf3AgeBbqfCjkR/GEZdRU8wjs98ROnNvnd71LHS8W8ShXrxawfotMo3b9hOwgnziSCbWRHPq8bL7K3O9SmotK2EY6g0n0B/mVcLIIZxOCA17rr12TRoSb2Hlum9wGWc5CxzcUJGX19l4GeTKCm5XEgyKUMFu8a72gMalgOHX0rBL7Tjil/WMougqlabBJOIj8LPyKzLM7VZOGa713zbx0E1dq2WExYTatfDCqLkJgqcd84rcanwHqAwtwhG2ciM3J4orewUVydskGFXF0IqV2VtARqfd1n7bK4l7RyMad9F7KbHXe42T5BtIT2fCBWh0F4KPTsVNyJ0gUmGOIGDKLI7FFCh2WdQo1oYr3kmHSRyG9Qs+9S+DOHEGGJLXCobE8vGTlsst19HRurP+9B2mgvJY8INOG+pGlLzQ4eEhjZZLwH4RBuk71Oai9jRjlInY6zIMuZ9AsNN4IdyYUN+FmIWldDl6zc38a7dJMc7mh3iOETbrUT6uWkRjmiJYBio5drxcxuAR1lwuuSsaF/HohhDREPGvrtTKQ8h2E4ITsP2Ei1+RBLI6jUg9pEPm6pCtJIvT2o2hD1k3iFz84V+kYDedZI6UFe6SW99NudATdgsnMmGmjRZ1y3qqD8l2TGhkDyxzJ3TD2uI7Nzlq7Pba3yXfJV7ynbtTb3+XEGDZSNfMOTnCvK8TLn7gwWQqXFKLjuTKNCT0yPIed4lnjzigh4d6yciK0EOCngyQEaRohhxiHFyVmSuXkyvRrKvPMKbos3gRAUJ+HgY8Eu/5ULikMcUB0IDlNrsGN1xu4HSe7deYzWtDILnI0Xy59GvRk2FvoTr0glr0ZNwL3aA+rEWyCKACrBjm+dFFML7Q3Qhwuf632zXgue6byHYbCDcgBRUauU25WMy9MrZFZo5cLrk0NOGEYolYLqUHMlz9t4rmfrFQWVkSykcT/nY8TrnwohV4wHgLKuJfvRCI7/jngF8Btc0jwRNvLkN6B3QWX+ZkpRkhGa8oLKsnV5fqBfCytViB2MRE+dc/dJz/Mq8YhbUkiP2KSmf8+SGeQ/g14d4CCHqmTfk2iCaq0eXSvIKKOD0bVeTa/xCDaJmoaUTUbJq3cWBBFPFEVoLBDXEf5fMqW4HShF6btl/E81rkknrSiHFvYKormZtSxQ4+xdHyXLpLBEHVtl4Vnp8uxhI1y8eEmuf63Li9yHctgkE+PDdzev/ikPtWx5o7zm1FHX80Or7kkdBJ+u2Lbkt1GcW/8UheemB84+Z2n2gk4kyDVMTJTe6qhLv8VPgCL1BVgQbgp6Dux/ccyqQEOavShQBG6ndjrSJz/IWIwetRjq84B9cJY3/k5AgRaHADzr+jn5kfLfzQQRS7ubd5PE9xjnkTMd7A0mrV+YtH6rDBHE15jBeBemxklQClb7WI6VGHFVCxCO64ujAeBWA0lzQyRARgboSzdloRdZ6f3ZyM3LW+Ej62/DvAyLqbxWlJ7KsvzvCyIHUOF2OsLoN60m0eQRiq6OiwWl1HDAkI/GF15ZSVfaEVNN1xf1+enaXEgkH37CxdPiIO6W7ckWEYDL8UtiOLUM0bwk8mXICqzHkqzRRVSpMuwRg5SBm/0enx0D6xhdL8q2kAtjdcyh3+w2/g94wLX/0ciiRUP/0Q3ktLhECvmoE1BRlFkVHufS/35N2uuokFJWaNMTGPrqr4HzxzboKhSo06arXScpgJF0+1PMB1YKsd1Kt3VcLkKGc3jSH8ZGChxjD00/QNGqgrOq1JFxuBKsplrkMAUraZkmPRScu6IbOAaAHygnnCYU9fyAtW5WwY2rdZAWJZBCREKfzPkTu3IBa8ryFynJtrUK+hQVru7Yo6juT8qDytIUWxw1DC7VbLCuHpEssbNWYlIWOSXqK1XjQiXpJ3RwV8fLjbarbXBNa3TmPbD8PG59TxdnY7zQMKBQsx3faxhxTf7O4etNrmDW4glh929pu7plwGC0/kJzudw73skxSzDMXq3V6z2ck+S+d8WL9K/Hnd7nLnoNPE74dxNA4maW48+8391o79csrDue75sN3e3bNfZuNt7e217De5EXf293c69tvCoA+brYPcaxi3Pap2e+8Qa4w4n9fDIPoSRJPc0Dqtw/3mWo1sfJ2dw4P196F/Ey+EXJXW7uHhWgWTbKwu/Aku5Zwnjc/pNbS4e7Bzxwe5ynvNg/19rBxfRXDR1ReJAo2DZgtXjidJbic6O4d7e/oFT5I4qYvEj1KwOeBJuj2N4y8ShPYOD9p3VMw9RLGox+M6yixwZTuHD/7WpBurxxGPx/L71kFnVzeQLff+/v5hRxfnYOGws9ve12/ycHCwv7eHr6SCy2pu5/Bw/8B6E8/Tl/hLHYgm7pzczdwitnd39nesd1mT7b2Ddst6kxvk7t5+23qXH+bOTrNpvwRg5deCRyAzS7fxvOkvZFcHhwdygHHuzBy2drGh2E87uVHv7Teb7ezNQkw3dqBObWe/3TEfDLVZe7oNAqkLf/glVTDb2Wnvl9WbCjGvQ0ey3sHe/v5uWT1rh/d29kqbyk4AnAnZXmt3f/eeyilPLuXyXzve4d7+4V5Z/YT/ueCpqAMRW+ejQC7xNWLY9v59X6iq7Z3dg7Kqagj1YRwJP4j02T08bN9VXVba3dtrZf1nmOlangXzxtr9/Z3dlinPgd9eu9XMPslv9W5zL9uZIuzlqx629ndaG+uWgNFhu7mzYz7ACtZ8EQ4DwWdqWs3O7t7Gyhbm3d/fa22s9zmFvR9O+cyvS5pDLXqzfbC/8auZn3wBTCrrtts7e83NdeMRD9UmdXYODzdWjKMwiHgdI9H4Ik7qF/5owtWiN3cPNn6oxOX1VPizucL3hztq+BHWDLngNvI52JUj1tCZRsF8zkW6PY7UTrQ6u6UVskYO9nb3y+sUC9Sh3mk390rrF/Db3h4uUuqPeT3h0YgnNtjLW+ICGHY/UUeqs9c63L3jm7FCQ2q/DneanWLtcaQPUutgrXtr5fb3JNpOfVhVs2C7+83dpl1urdPBgextzod52qvVbh+YNzbENjtZee5ktpvNTtu8yq9bp3Nw0DLv1siu/f1dSQOmV/5kwpP651QTPOs0WmfnYLddqGxdhwf78tZeiCC0UcrBbhu34TLgV3pldg46O/um0ELgnZ1205QncSzqQQSMjcZou/v7e9QZxgnfnoeLSRClRXo2/24DabteaY3KXa9SRvCu1yqlfUuq3UUG56rfQRGX1ishjkvrFenk0kplJHNpxVLqubzmBkI6V/k+mnpz5TXyenPVdUp7c937ie6HfFtGf+e/20CK5yptoMrX6jyAQH/INw+g1b+6mTvI9rW21ij4tRplxPxapVK6PldrE4lfVqmM2s/V20z4l1Vb4wHKKpWwA2XVyjiD0noPZRLyH5fxC7kam1iHkkoP5iLWv72Xobjzk3Le4s5P1tiMO2vfwXE88Lsy5uPOT+/jQx78cY4lufOrO7mTh3yZY1TWPyjhWdYrrbEv61XKOJn1WmVMTUmth/A393+2mdVZ//ZhXM/9360xQPd/cg8vdH8DpWzRAz5b45Du/+Z+Zun+NjbzTflv72KhcjXv4aburrvGWN1d/U4e6+5Py9it3Bdfz3k95PNSJmzjhwV+bGO9NdasULOMSyutUmTY8pU28G7rldbYuPUqZRzdeq1S5m692iY+L1/z4Szfpu/WuL9cxXJGMFelhCdcf7/GHq5X2cAprrr5nM/Kq8TNMnQnrshZ3ioDuKQRuxHNR7ZQ5m/P/SiKRWUcRKPKLB4tQl557NR4zXnskK40lhMNiOXBnNdvX3x8dXz+5u2H85dvP7554WSJeCGT5iqWScfXNfe2kTeYQ8UmuIdvxX6PwRtxxFDTs6Lt3db+XY50aLnpBTN/wrfTy0ntehZ2L/yU7+3Qdz+8af9286zjf3rf9F80g9efn14Nf5gEJ8+fxb99CiP/h58OTz6fXL3+4dnOSfB0wr9vpRfR68OT2bQ5+uHp3qubw86oM1yM/nq9uOj8GL366+Tq9Yunl8POb9FJ8Kztf/q589PscOfd6cnVyYunk9cfnl6dvDi+ev38ZDL84cdw2P75ZjT7+dmw8yz8tfP+9Ndf3s8v/moG/Lg1/+1l+Mb/9FNw8v2b9Ndf3vz17vTH9LcfXovh7DC9+D5c/HZzMhl23u9efP/x8GT24/TXtoiGs8PWxewn4X9qTX9rf9w7+X738mL2cf/k+/z74feHf/nQV3tn7+T7cAG/f/10NfE/7c5H34fTixfp5NfZy89+e3Rz0fl58dvz1s1vvzwLf/3lp031g3efrz/7v/z4+eL7j5NfO5PDk88fr06CZ5/5h2bw5sPT4OT73+YX318dnsxwTMHJ97vRq08vm6MfTsSw8/7moi3Cd6c/7vP2m8uL2W/z3252P1+0ry+HUevwJHi2+O2mNR398P7m1S9vmsPZYfLbacvsG++Izxft3Zn/abgYtcMvo++nh+PTk8nFbCh+/eV9cxi0bgpt3/z66f189MubYr2/Rj/8eOm3P4rfvn/5l//9y5vh7OVu4dsvv/7yJv71lx9vfv0lxO+z8Z98fvPhYwv+fxI8M23JMcGaHMOa3Lw7PfnrjfXdq0/vp8P2dDqMfpzCer3+8Gvz1ecvf73+69frNy9eX7/+66f2mxfHrZMXH9uvPn/pvPlr0nn94ae/3vw1uXn94fXOyeedg18/7c4vPr1s/vbz+5tfP+3+9dvs8Obi9Bmumf/px9bo+5/f/vqpBWvcHM5eLobt3y6Hs2Zw8sP73SHAUPTj5ej7l83fTk8mv7Zfpr82W5e/IWxdzy9mP0+HwclkNHuZjj79/JeE54/4/7d/vW6/fv60Jf9/MvHbP+/+/H0ofvvl9eHJ56f7r09PJr/98DPM+Xp4czL5dfZz5H/aOTz5/OwvWAsJV+9fAJyNXjQD/9Pul98+/Ta/mIUwltq754fTi1kI+xP+/MOP04vozeyi86N49/nq8td2ePNr+zqE33Dm3k1i5qxoa7e117kLJTyu1+tn0SIJvYqKkbq9DcSAiBPeUGi+EcTbl22N9BtADjpn0SienQcjr+L8S98Gi8A5iwzl9THXpinONaoLnbPo8Yq2d/Z2dzwXVNWQ0qKz19IPhzt7rT39ANGTm5ZaO5tM4rZ3DnYOyIruHnSahxvqHHQ6ex2yovvN3YO9DXV29/cPdsiKtlqtg03ttA9ah3tkRTuHzXZ7U1/NvV3oaqdzR1c7OOR2e3fjrA53dqGZnf2d/d0NdfYO93dgVq2dndbBhjqt1iG202p39jsb6hzuNfdg5rutvf1NM99r7e7vQ7DrTXPa2WvvH+I2tDYOptPZ3z/AJT442LR8rcPOPkzqYL+1qcp+56CFXe239w43zrvZboNBxP5ec2/jnPYPcRt22huHs9vebe/j+rUON7XT2TvY3Zft7G+a+kG7idM6bDc7m8a8s9s+AOhq725sZg94DQTAw52NoNORwLXf3Nm0453dFm7EQWuvub9plfc6ey2EwPZGyNlvHbQAujq7e+1N7RwcdhBID3YON+757sEeDGd/73AjfO11Oge4gHv7G+Frp3XYAsvzdnNjlYO9dhNgp33Q3niC253mLtTp7O1tOno7+/uHMPG9dnvTgNv7O3tNCciHBxvrHB5gT/t7u4cbof2wDVu+19nZa25EKHs7LZzVwcYF3G83cXFazc7ORvy23zmAaR0etDubpn7Q2sWT1TrsbMSTnbZc5cN256C1qU7z4AA2feeg3do05tZOC/va73TaO5u2YmdnB0/64e7h7sb1ae22EcPtH7Y2YtOdHYnhdjfuaWt/F5FcZ3e/ubNxfXYOAVD39nd3N0HYXrO9h+0c7LQ2Hr/m4Q7eEc2NVZq7iFPgOtndCD17uKMHB7s7myF1F4/oHohDN+3WYRNx3G672d4EhQc7+whhncP2/kbcvdNpYl/t5sa75rCzfyih5/Bg0yrv7krI6OwetDffobudFo55Z+N4Dvb2mk2EwsPDTX0d7iM62NvZ7Rx4PPcyb/ivfI3BxP8Jz6LRYLYj9WQlfW1SGSVeOn8L0k2ORDep1WSaJ0jylKUlsjzDzs95+ho5UPAby8qVK0HmMQagvJ/DYCb4BRxvssGBHuN48Cz0xzf23do52Dvc2LfMhAEL+sBhxBiE49tWodk87JQ58QPokJysAKqoNDapjNSi4j70g4GbErpgoYylUIzRUkHLzQQGGTZGccR7wl1Agimd32JBGmLKIzemPlndGbmgYJJt/OqML1/XyuQdWSbcEfXlJwEzwVEgyXc+EkjsBhSjD9CQOhEElADvClMnXKuDwg2slCrvVenZ+W2b0em0Wod3HiK0mM7lVhAbch8omQzogSp+BS2LK35a8c0SOt8KMoftvb2dEpBBTFsEGTtpWxMOsfY2T3QgzZhBUIlu3ODRYsYT9Pm0H5bLrRaNG1Iet0i0T6iDoAYm/zG6s14lgVDvCIXVQkmSdEwvXUw7qgU4SfHMS5QK9ECPMfQFNuaYdw691V154KhLvxUHdPZ2D0uXERCyxAFIRcjwGQozBHA0Dw4OD8sxQ5bhzjh+RjgPslzyvvNf/xUIniCDKYPEJ8qZn2NwLozwQpZLiCRZra4lOjdo+jbB2NCJ9iho2r6J5DZz//JCGpU44aRPDMrv3QI+gBwjnvrVoipkTz+t1QZwknLuNhLW+YqOvXC1kk8FyNfZCH0h+GwuKiKuyGnzShRHdfx9EWaHqHEWnUSVOBnxBOpe8IquQvEDH66ginQmSGUOkal/ySt+pX96M7uIw4Ze1YFLKjMupvEIUv/puB8QYyILDnWb5laEJVnKnNxaXaLHSYJu866RzQ4ZRwyKUJdbmDn0s2C4MlYzgKaHy6W8e5OGbGa51L/ADz+I/DDEsFtzhUoWq9W3orC9Vqu9uxk53H8UKwGkMZLFKnpTQjNsAJlpbFQAz9l5bK6IB9FPWPLNx7LdaTVbJRNAilUeSyTKC0mqoJroGmcwPUufRb3YFSwicpsF8crdZlpdcVQM7dQVGkdaF1xfDAyZFMFiJUSJxjMn96mfvr2K3iWgOBc3sueERpjbqR8NMLW98a34toWi/oYQY9bcv20Ddjutzv6D8SIybHeQSTYelHHgJDaUvws4UdNT/rfTUzt5ScjGwd+DvuV55T346z0Il8sJ6bTtQKOBh34oscJWC/2YAG4sjNPdckPm+gbJEEQtACUqToAv6TlCwUdLQ+UWY4J0oWHSzSg9DKUTMG7QCfQXPhTxLBTiCUxMjPRbEdDu3sHBziYaitw+9MYY8VQki6FYJP/Dt8Y3zhoEUn9/1uk84f7o/ycmfHDQLjtoyDrLg4bcuMQSKCKR1BPy+oQi6wpCEUJD+Ay4e2BhEhdkCoQOzZ1F5/AerjWSC91lHVaXS29nWZAiisFQoSnz3VSh/pTmYnopOMe4VMQidyG/AU3w/CkMm9DUIOtkA3v04IuERjRmElfYV0rPfgBnSPHvdi91EzZ2VXKEmMjECOoyyc1ljvsQQ9gxCOMSYkSC0I0J8VI3stvQDUT5BobYQODGVMjAZX+Tvz442OmUo2EECYQOkHZJ6DhodprNzRjZhEnj+WghCSJZHyshBtZOmwgPsCMye0NoZ29IWNgPBjR2BTHZM8kT1lwu127xubq/T9JjAx3yG2BLALq0KIRkWPNbpQCdZvOe04Tr9Q2L5NOA3a5omi2Lz5pd/8gAp4/Lkvb90mVxAzPLrkk8+o04o7VfRli0mzv7O0R/QOPsuvYzJCAZr52WxBwoxJOYQyERwBwokpOoQ4pPEHWgyJTQMZbuN3eIHRuLbNJDTktMIMQ3zVtFtsEAoEUYoyOWFKhFOitzro17MYQQnbCZweLLpU2D0Es2a/jpTTQ8sd/nSiDFwqwhYhl994M/wSrWs1OSXTIjyb6RIcBwiCsgRy6ky7HltH2xiQ2RTMSqkGzcigkEIcqshbQlMuc94Z1TnwVunFUAuEHPe4ik0s9g2W+cB9Fl/GU9x6UETyddpBDjCYIf+Ylw1kIMg8wM4wTyaz5cCBn7mUXrMaq+55HchkqQVvwQLvqbSrKIIvhEeo9r872RbAKbxXbgOVZNmrydL4CGA4qyIS9zFtOk4ScT5ne7WtaWNEY85BNf8G7mqZ+yN24Awrdg7MowRilj7JoM40gE0YJ3MxoQRhCpwK66H5I0Uh4JljTO1V8/mZjUBdmATX2cR2Ed7TVi1sTlFLpJYxSkcwCT4+shn+NK4xuygo4cOUK7Fwjd7l8ki7lw9VvZFulGzNocFUxXp6LHdY8gPHkIrYUYpVaiU5ZIYak1Oi+bxq8BD0cODXHFS1ZPHRV8T1G0IptbrbIVCnVM3OISqA1VNdW2hnL+qxVGEEoJ9Usy68M5UwOAtj09N2ARPZ7h9lVRSKyqqx6xNqQJbID9Hbvqytygt5b12jmImszTae7pKTzBJ1/gkwv3C53kApZYOaBWKvHWc4Yhn1L3tdsfQCSp59Xq8y3GEshsg8N+TicyfeVz+cVb9jQ73+zc+h24Xyws/9mY2XVDV7C+EizrqWpoGZibL0caSUTobspgpbCHKyimxsoFtz5RAlj9nLhGem+AsB8PKKe+hEM5oq0MDKHaQm48HbKFZAeNLMoOsRG5Q2JWakid83P/yg8EZF4zYv5hQ5UqcX9+UuqgU44jXBFafK3WS78nntVyWYtquIzTwF2sN6iNHdfaXaWuAnUZ/c1eZxtLR+Q2JwKyNA8iF/8whuFzGgGNbFO3MYt7sRy4T33i+RBmzDT5xmYxuLl2+0KdzkEWlpUxJiW5wqBbmachO+rCRoZZa4obxzCq+tBr7CXw1MseqBxNWYNaaHLdFUW8IVsosJ0fpopzhKtoFPMUkzDPk/gyGAG3+Bg/fqw4RMes1rUKsAIZ+7MJyD5s8MUIKwi+6sNNw4J/aXHFrrvyeo/kNaA2qhdLVOyKPiaBW4QCwrQMWCxhjAoUoTCOf17FQ3Oqt7KVyi2yhHV7icn6WIgXe+7DVtWsqBxdJZDL6keKI3fKms+A7UNmCgwo/FU89CA/7qrbQnksDh0R9qt4yDgmhW1nb5QkR76DRNINfyx4Ip87AxVtUiQ3x5FIAq4DrVv9v8v65w11FQVxtFwCK4q7ycxNArMQvILLQO3aTGQNvpfKgly3rK/n5iRxLJzVgIIYTPNUH6jMuaczA3KBgTVNk6+VSDEbaX8ykGGbDKRpyRqA4zoVLcEjyxsBKpggfeO/cY1uRR/4esuKLlcROmhzrZYccROwGZM/GY4wG4XCe/0EtgIAFwLQim7hvTrZukaTChP/U0brZdHKhLjm18J7YWGnF24hxYJqTatz9Kk9ta7Fp/TCfQum6pGUrQFHAMmo3aeFwlNCT5EAC/2bN5hz1X1Kb2hGwr40OkwqGkG6Vl6SN6FsOyB0rdVx1wSYBqiGNCHLZUmfjDFX2MNbLmVUZwjBKhrg3FOmul6gVOQp8SA2+Tmuyvk5rgnfMDdCeY6oeIvKRtHwkSZa7+JWXa8Ynf+ze2JzHxf2I728gxqiovHU5trYCXQJJWshJFGnrm8gH1OxDiVtFCB+OnGvdFUCNIYBwJItcxPSC7xAiaLLCAStW0FMrJJNmA8w/u1nWCKAMXs9HVl0FwEo4VIzok5JTacv0WjFNDtw5Frl3RUMuPUzLU2CiJIIifUSswzzTNhhRVBNsvjsubjsERMyaj2KmmSTJpuYPNARTcxpT7Kkhfp4JxAxU1ZN2Wv63oKtW+sUeO8pIj9vbVLQs0rqyy9ZUyJKRBTqN7Jj+EtyZhrDQIlBQ/iQu4iwqHAxQpFFfgDhXMDmhlx+R+gWJ7nVhqrEUVyjlYhWYUuBRiMQek1i39rYTYxks0V0TicQPGmjDhlMNCdSN5Nqdq2UUdkAMV+YuZzyFIrMkKIYUG7TGthIcumHK7rGghaDUpsx6IasYNNdC6JQbGEEDvI21YwWrrIhYBIa3UumbG1FJndtVJy0SZffjSBlXL2eCUwKqxMNqM/i4grhxcwYixvyqiYZnY5R1WVUUfnuiBlo1PIGtcExdTS14oDkJSvOSBXZVlCtptlaQlNHsaF0ss6zMpCCdwv1s0btL6zSlZZOBF/dF34KH22l6zIdkdxUMH4hiO4rV4GYxgtRwc8rcVJRI3C+YcBgACDFGYX4veacbd75RO68kWEVdz6RCsv1XTQHNMpvVLWaDT6yh6lAK5JJOlYrMAVy8Lcj4ws7WiqCz5iWzvRarYojZs8a7IhkTjsV2Dvu2fDpGcsWfYi4Oj6CxiphfQkWg5+5boCuxzeqae76ED5aPXhrcvUHYA1rzlilOHEs7GXDwW89S4qlargG+zCDgbFuHkcbDtE0KA+nZ0myuJItmRT7WE8Qer2i4yAKCiH2MwXWRrASEqwMT1wEK4FgldjcSKaOqORXPLH2lSaGXSH0nZvAEFcUD9F/1xBFYoan1RAbr4rIksVovhRGmcXGXK3hhSCE+zVUmEApeEHXqi9elB56m2xwclf0rWYtPWCAaMb9eoIqbtdLVtSIaS1I0Vtv3x6wtsLSYk6/0c5ov7NfZmeEVr3KTAfckahvLOZQh4PmAZvVWJr/QSsLacC4XPrqb+B+q7a6tbt3UKZ6QjtgpV8Ds5f14YJen9ydzcsMVg71bw0UdWF326+gMXjO+klYxHlm+FTCcEUQmRptAWzNUsmU1Du+8jakkChtO8fMMTSX4VtwgAyh29Pde6aHbzN+AuHF37Z0wn0utb0GNSGNjXkT9e82CDdyQhWMGvUdxTygxNakGS7NXVcKGg2dlmYY0viA1luafXHeatEvCwpLX626AcuVSPaYOq/9OX6wXDqnXH7bA8D1nKfa/kC93/7d7Xkfg+UJiYTb8w6Wrb1lp03cnvc89GdzPiI9dA14pLIuBqQnJ6YEEd9sgt3Muz6ZA9Da3yeuvfxRV+o6JpojfL+IRDDjLMpUG2shqCdhfOGHH6ZB2st+Nsoa8Qxj7CSoJChWqbBK4hDwhV/RncOd9qaknnaC1TJTS7X9MnGK1gL/A+aXSWPkCnr7G7qpRit09trZe8gwM8ymYVPm1lku/ze0nYw22EGuT3+v09nbe9gu3WVPgZmfVrSY2xAnFrFmNzry9RpEaFnhA6uVpZrWdhXxml1FXDLoVt5baeOY11L96qbeYVsjQZ8eyFZ12rGY3Qbpm1hMITlH9O1JikWMiKDkO+k81AhS/AsJV7kHr3v9gQexJmjC59wX5TmOmON0pb1kU/kesRaJaoxbfkdB+gZopOCS/8aTuGwEmOakWn2DpvSNN8ffP/1w8vPx+cmblydvTj78yhhrbcMddC14NNqUbIn6UsqshkMj5uZ3XxCitzw5iuRQeT9mfj8ZDJjox8ZZiq9W9s2d9QOZ3iLGGwn3U5C7O+4i+hLFV1FFlhBjecBRwtlz5V/E7JDDpcYeB1HFeVyzymuPncpjQpMac1ynpr8Ig4jXWqTmeFnZMA4XswhLiUNBAKteqGA8sgfnLDqLnFr+FaFRDbLwJcSLVvm8ouQW6eFM6KOE+zA25vz69PUrI1pRzIycLOPyCbphQv3maepPOPPlSd9qEaobn4tFAkkBhl8+JJBRdUO5/FDxIeZqVLxgCtVk5iX8nMgSyBuwCixJndp2mVbFlT1ZYl6rqt0JC2hQcsGzTVpl3DzHqzg1NV3I3SxNN4Ku7Q4lbWKyZEeOQwP4J2VWyqZ4u03qJjl2VBdPUhSwR/W05vrMqTQajYqjQZjQpB5hjYRFtbTuBrKGVeE2FYnn11Q2N7QnsNKPCsg9+n/8P/5fDqkFdB6nXlQXNY0TLRXGIkfyxw2JDSB3IxV1o5Cp8VUhOYLGz6KwGUJaO4M0snGxGI95QiycCNpR//oVNrpcutYTAxM77W2T5Wo0eSFNikjWKq0H5yl9xsdxIrVx1jPrbP7iKfC82Qf4yNqWDyaN2PZZ0juLlmfJ8qy5PaE+6zcHNADD8pTBjrJIZcbXE+6SQIm55X1DqJ97riUgFdX7yBvzOA1gUY+YqlCtpkdNaTmr2eo26a6VqWwmQzqnY4C3KbMy+yCCsadFA92lAX1XQxMdMWsv6maxa9NaRy7GkLW6IC/KL/SWm9aHR03SHdZqZM5CswbU76f14YAG8k82x7rr99NBXb4mdAQ2gXmoU12T2sJV06gPay170HRKak5lWXFq80YqkhpkA62NcZjFMeAI7P6xx9q9Xd7fYb6VukPtNatBct2U1JzfoWZ+7RTIbblpbfiEmU0pXcKaXMLahiWs6SV8wIQesIbGrM7CI9GjbbQWhKzNzpcgGjnUmS1CESA5joYnjqW4dKijjQDfjh3wGOSjYIiZi5yEzxOe8kjYv0GG4lBHMSEyEyJ1Uvj7NAz8lKfOgI4ZJDv0Qx+YgBQi1EVDqDbz53Pg7wZ4DqZl2EmA9pzm6YTy/NLB2K23GGNzQyTmvEpT9/FHRQzEeFnCNS/gcg9SMNMAShrZB9yjSjyG93j5wxWLzHzjMaZ5wctFtpHqi1X4E33fwiIzgX8kLjW6eFhsZux9LEbAJHNrrgpXKxPZ7zznkBHv+EW2a0xYD/YIzF4ykf3OD1FtKhPZ79IKsOtM5J/tijY4MJF7tKshIDIh/6KLrCIjMugp0UpmEmxNP1er9yYgT8gtsAnleblFXx0rTsBgVmbQFitX5Iaib0aEsnEGZXrTN0AbvEJY0/UUzKVzPgzGAR9VxnFSDmzdYnphSeX2M0q4LzZMSS5VYijqsiVBhRqCLkq6/QmYfyL4wjP8gAK5SVCCvyTRgyuUAJfM8VcuJ3GeDJNsATijzSzqTZaW6pwpzhCEQLZB8pQkOu+rMZMtskYE/cLiaOgLXQ2VTuB+XajbCGbzMBgGkKlx7R2/Vu9yO+qcYrRUNfSKZpMr6TRehCPwJfLRmIlW+vi3MqCgv/IrMsqqjV3c24ru36v0G43GgFb4da6gsoL8Zdk4cd2FmV02fkKzASO9mS2BNZGVKIeTdV/8aXHWBk7DIBWAGA2MphVXTjCIJiGXk5ZsLqmoyMHgrA+OVda7hlTl8QbEQ/8PQpm+HeAwm+L8MD5MecIrgW5PflBRZusVAfZ4akXMOPXKNyon+pXEvUE0gffpYjhV81CWZuliDnIxPjJjRJjfOBJ8+9BBvJaV/UlaGfpRJY7CGwAaqMhHFT81ENBw5PH632nHtIVxVOAXrDNNMsMrvQpMYkf9iE4DGjqB5zUAqiry65KKCVQExVIQ8pHeSTYC/apu2LGrHF/bVfh1SRWY22vb/Ei5qgMnyG4lbIGriKZV4LciVuCnDm6LxbCrnv6mP8i+6Q/MN/1B9k1/YIsvVB5IbKXnKmzbl9h3YBJdq/KGbkS/IF6i6/YRgw9YYirpEo6GDhzsW9iahJEfiS4HUYt50+fZlRKZPU1W7vomlCw6oZFksidsRi/RgGrqOsKfeDf+LGzEyYS2m822l4rEobcwcs+QhobSKRNC6bu+xz3IgU/ozR2N8z+zxg25+dDmYYMIvdjc/Myfm+Y1Cfvg1m8xLeAVtj5xbw3W71/SG3oxUHLF4829Q1trS6doyqJtjZRjGvGrMvLJghXp3QWKBlJd/i9lcbAjn10HO5Nlzhv798dXr/A3WZXNPDfxFTXU5qa1YSgENeSkdzv0ozgKhn643qbzv5wVDeMrngz9lJe8xzGv6GI+31gFhw/q8RkPN1WRrYDO+abktbNaUZus9RwzJEft4fXmPbyI42/ew9aGPTR7JhJlMLFcOh/s3+8/HuNvslzu6spjHzyBVI2XuYenr06P79jjTLJ9b4d3Q4AxS3wWxyH3owF8c68+LwcuJeBgsUg9OUJPTbYUNHLVceCeWoFSMMlXl63L1bsbLizHn6yJnYMj4GH4EdvdtZ2BSmvsoyTv6WbYCiLxN0GLJjQy4EVj1qQ+BF8AwiPKqoJ+tg4UmysYeOCQatWpwbMAySDj/VotHhDqNGE7JX8e11rosmawEbRxIW2R9QdSJxbXat34KOrGtRoYiDvndkfYltPEvqpVp4U/soH5bKupdVuQRhq/FeiFd63G8rAutlxYdzdhHM0vn8cj/hT9v6vVBHZiudzbPQLfquSI7TeXy8N9/dRqtsmDBhR/3YDO3eJQ7u1lJZtiuSWC7u7r6/RhfW25W/5yqXvYhCkUXDFOI4agU4dsyUmWRPjcIYp5MbKrcxCBgwG5ATOQu+bAzKk7CpFFrN4iVDBsQkrUW4T0mwYCjRxbQp0FlRICE/AHUVWi7+Z+kvKTSLi6sTahbdI1MHR/7daerB4/rPoBWZW8pxht8UHIU2rnHoY7q1X+7xZjzWp1K27k9X9FzHoRRH5SqpfkT1iz5zQvnFrWgdsmnlNfKzMbsqLxUPjhHc3FuU8PsLlimdXciA+D2YYGs09wFaf82r+ruuz/OtdXaw9krh/hunjup9zF4dxTJRtd8SZQvTvUliqZJe63qXMRRM5ArVH/gDrxUDgDM8l+qwmS1qEzyE2m39qjzpRfO0i3fsnlRgcDlH69NujB32b9cAD/nA++AxuUs7OGfsLX/HiAVbFejfSWpkKt9DU+nZ013J4XROPlCfz/zUuylEWRHy3f+G+Wb56+IeSRo/1Tt3+3GuHbygd142U2DmP/YdfZmkhwy936Is1qONEIive5Ub4M7kNWhvVhEs1gkM8idmqI+FV8pfceEE6v3vJa1KnVnUxECHjoCWsqGY5BTxSqjKFt0YObMekpHfu7t6cnOR27t0n57jmNyI9kE2/8N14iscdLWDdX/LfhD5f/u7UFFhgl+COHQDbYdymHMULSqwDMjAS5RRIpI5Y8NT6cXheLDNmWvXzz9I16aYg066X/xjFG65tWFumf+0cB+7RxFCdvXm4exUk0XhtFmQ3FA0ZRv3MY9TvHUc8PpGzfdM1mo+lkwJ/Ho/S5PFMJ6WVXtQPKnAZ3iJcUcZ5N/dLP7EqLg2+N/LN/TK/pU/p2sCL0hH2mbwoIzKAt6x9Sdzc9PHII/fC1TfQKT4DvPojBsl85OxODGsm99Ozucg8SqcqS74jEqtjCd+5vSxcQHyl2k/ue9AjpaVz5bjNWBMs5zGP1bZhRhVljb5Te20SSYx90yX24UVr0gLM5DemCNekQfQ66GVPhiqx9GSE5a5yqOmLdGeSFL7iS0vIK5qCSktiE1US/NaAR/G0P6i0aw6/OgG6J/k5GXPGrCjThwj+Njx+euzhUgm348MUO6P5ror8L6v+a6O8NqOjvDyQJvmDwWyHoJu2Q7kLdF0edLlnUmNN0ugtWW2hiTfQPB9WqO2R7fOc7d6/5HYyyOahVaq7ot1qD5bIpCViYra5bHxJCQ7ZhrGpZF4TQYbUaNlIuPgQz7oaNifpF6kNCwxXN1HweNFGKdXPk0MnpW60/Xikhxfs7xFw8mfCvgDDn6Ehx/kaksyL0NXOePnv+4vjl9z+c/PifV6/fvH330/vTDx9//vTLr7/5F8MRH0+mwecv4SyK538mqVhcXl3f/NVstTs7u3v7B4e1bXYWnSUy5siLO2QqSEb9E6xvk8YZ++uz15YJXYwhf5E3dAXzzQ3PtYNeQgh5srcjeShx1Mzaj2psz9D4/z5grHnvGbPpjf5ZchaxgaQ6aMwia4A0YE0MlYgjFazZFUcxGo+Kf+8gnS+yeIjBkyetvWp7d5fQrOQgV9De3a0GhNCABUdHe8tsmpGepiCka1kHugmL/73z3R7pfX0vXusASR/7y2bxyzYWEK/VZhjNJHuzI6sCWHwMInEgdXjpQ8me7JuvFzuV75njKJGJAaGAvbY2xlcb0zEbE9VY0I+fPGkdVPc6A2oe27nHvexpr1MF0UrM3Pjo6IDUQA1c2A7/3x3S+9aWvbbcEVO/mau/Yz0dHVlt7e0MiNeSO2Q+budq7+RqZ5/RCJDFK3aPKTN9tnmL8AS/3IwgYltun6kFvk50nl190oCM652lCcvMto+SrgClCjbEwGQbRWjUgJ2cxADkGM8kaEUkL56JQaMYAap5pSrANQYN+gU5DGAjPyeXQ+FKYE5tbDWtLNtisspMTr5OMfLXPTvwcfMOzP0gSf/JLeAqFF+WZMHYY1G5J8GGPQlgT8q246/17QjGLiyoG7N8/j5jy5ntB1hE9GMwMIzwz2Bw7zqvT7Q/+EcmqiZZHDUtGaRxCYUN/vO+Q4ij+/ku9ZtY14997R7rkyU9HIKx+6d2YwCTDQWVYIGSrf7XQ7RUxj1iJyVsyTv6fkAz9dwL+pJ+pD8Dl/LpXiz1A9vun103m/Wz6+bB2XXz2dl18/nZdfO4fnbdenl2vf+yfnZ9sHN2fbBXP7s+fHm2ePny5TH++3Kw7J8tXhzAx4sXz16+HLi9LSh5LkugBlkCZ/F7vtryd1Kotk1/xHEc7J4t2s32Af57ONim/2HbfXrWPxuc3Z6tBtv0e4aeSlvLra3lVt+v/3VWH9S2yKPtgP6mX/V/z74AxuXfwLL49fHgtr1ayt9/ndX/1T3bPut5/1VlZ7WzR/T8rLH1v86+e3zmnhH4ekC+e7RtGV//am3L/Vev+ewn67NWU9KcrQ78zer8YtU5lFU67XwVzjfVWS43NSvsb3Z2ZKXDlvrbUR+1zY/d/OcJzwJRlOh2ery+c+CBHsEVrNNegqhFoDqhJ+qH+7VW06u3stai3GCAjOI956wJ+bLl7/8LZGdVxReO12rtqYGqMgFlTfUQwYOufel4rWZbPYzhzY56SOBNSz78Xx2voypVIKks/nzsPPZ21AC2IR+y+vDM8fZV8/+f/7vjHe6q3/9vSLyu6iCcOt5B03o+dEDjbyYd25PmR2xvd7ez21OgMk7i2XOltACXvLLi3d324V7N5XX4cg+oG0J39zrtpimrtprtDiEmQITPLcTb3t0jNFgrSTlrdlN+1N7d66a8ViM+76d8wCLuppz0Wl6TBnZJdghCLoVjyo5zvhDalHQchDySppb6p20+KQ2LmFA/lstHsjyOPvlJBK4RIvttfwhO0cMbJtSPzPISskAygX+yQjRQinjChPlpN6YxJtjUpMwa2Lq5CL4UyvamrKayy9GjRMcCQ0ZjoTai1tFawiDi9m8MjGkXnEivg6Ze0SQVH/yLk+gVfFhv6bguQ2n8wvqDDNIW3La6vIXl97jZCSrNvD0u98wIDUAPpUfpWYbrMBhPGnNT6Slkva3zbPTGvDXR3kFsiEZQaN2FcSfNEIcGdjDlhXzKXs/Va57BQbVqPShaB/ZSfSvjBY45uwWbMa80nis6c+lbtKESq1erOBZntIDN9rUR9b+hmcooSPgQRI0OoVLxJ/dUf5SvVPGHQz4XYBDnD0V4U4kjbswtHSM9ciO4m5Q6QovdauTRtpQzobKQ6B6CMKyP42TGLcNNcCOZ8sraCGNmNHARyJxaGIDdKmvLMphJrDtYRHLUGG4em1QLo7vRMOYQahYNNZoUhAd8+AUg8hmEwEiZf9TG1v1qtS3/zFUnxkLxnj5W9MPT7zdtX7ddsgcfnn5/xxaIq9gsXAp7IIceo2qTfi+FwhFZLteWW/iTytSPRiGvuHj8TDtEDzvXtUPoJ3Xlg/0aoINIb+NjkdleQiCVIF6k4U1lxIehn/BRJV2Mx8G1NqiOwKA66/4xob/JccabxjlPOHzvpnwYR6N7B4rO0zEbcUgL/vH9yXOd/x74q6GvhfnYk9U8OBz4oewW3NNistJTBefc2LYPnPJCzGYpHkRfyqNExR3OoyCB+Z7Q0VIKIgxfGh/53RhYA7j/3YClBQU/kD9H4AkfHLFWq7XTarX0QvHrOR8C2Ml0Cz+evn1TgY/9oeAgokW9wg9yeVNz6mDNUpFwf5ZZl4Jt6TwJInlSTBspNKLCUtZYarm4jQpLoMTOkktuaJdbNzGbOpRZw1B+WVEMSNrFDVQROkeVNF4kQ23fCjtin18MtwQJoQouqknmopoehd0UljJg6Kb0SQfyCiALlegH4GQdAIcVDFguAuOMZx6HWoQuJ7agw+6aVXtMiJRMg4zdjZl8m1HJuOey8zgb3uJo2F3A8AqN9RcDszkRl4bH8Cat+AnPm0BXgiiFYKYwc4fQNf/luFpd458ZY7/qTvAvW6uirCIe3Bi2VNIMjZmSZceZPgH1DLcrQjeKs9H+aG2RfXuR/bVFVBDo9xcDmihY12WmgCuy6ZPmUwHLbBm4gCflywWhIeQval37LF0urUfLYYyFy2X2RPP3LB9pEIedQnMZE5gRZhtviI0gaExvi1EQ8jESrJgIKp+ZvyIeeGIzXwcwTSy/bCty6cTic1pNrTyXWMrCOdmsCOllD7Wah3yX6Ll2IcWW7m6mWs0eajXiycXyK7CuFQwwBYddYzO8i+FVjbVye2EtN18nGrN5XnJ9tWbB5KT64K5BdiEPUtyVH3V/cWPSRZQcV6voXFTsEvTtxVFYzZX3V6vZPcJ9Ua12gOeKySi+vf+T1dU0CLnbakoqp9WRf/ER29v6CTAOriniYtzze+dOYSN5jjTvIg8Zd4ldXKs9YFpKuCaNyeTYMIRO1sxRomkngP9hwCNRkc6Vvoq9avnb3/CcrZrpR0uW3J3drU2gnACtubMnDdPEVimcJrUWueNlG1BEUmMd2ry7my3OQQNjXSoXitBv4anJ7lFgy8WTFgKQLstcTcG5VdRbdkNXvBC3QXqGoTrDj4bTONGSb0vUXATOvCTbcAryexyL/AkEj/49YAGhUdmUiwcHgjuWdlutWnXX3lJNh11YRIdM8QT33gWvLFK89fLwQejOLl4a1Srn7p3Dq7UIwXjGqYzQY2EuxBNbyBwW4JMJ7W0uvQvvXQJtwZEw2Q49lzioQ7daEGuRqub0hhO783ubp/qOYixZLu2RPgG5K64/kSt54Y/spVLOTkqoX+GRSG6cbLi5SQuFNnSs4hS3DjwRY6oBAgNSoTeipSnQk2IBzRFVx3zNOAH0LYGC0k5Hyu/vuYJyIn8DtugYWcrc+oppkFeqQ+hek23qJ4e3SM8F1c0DMBzxOh28xd0APkiYs7XlPOg7qOrQ3FXmk9uvQf0K8++1JcrPKpjo3T03WmM+vu5i0jf0IjIcBjhXKn5L8Q4QEzSIKj6wuxe+CGaw7o6KKop3qBoqIEa4TOWaQQivXsZ9KeZQcQeKH6nw62Hoz+SWQqiU1CGem5hxq1nVWzR3zjXHmzEdka+ZSMXsFvpBZ0Ob26G4oYLl8cdD7vK71/w/mhevVu+b+ziEdIURasnjJDe6VVStbv1W1hRM9J7JAVMbSe44KuOOo1LuGBsu8sbmjq/4PYkhIq8oHkiIemW46GRQizxnC02v1Ttny8GyfOE6mwC1pKxhERm5grWtzuNaUnvsPIZMchn+ubbxD2Kcgy3GLDi6F+OU3pTlWCeqyPc25knuBZscqOGBSeSBwRjSAv52yf3NZBGUdJFkvLJTkB9jFI+4vGU1sPiiEnIfHFMjbssQMsx/F3jbq36esdI6Mbo0SKMYU4VO6YjOWItO4C64ZAW8roXZ1Wr223XiOY8cygmVEAK1s4GpJ7yV1G91H+FTwFIWsh15dwIKwkjUubvfnUjawL5XezPW8uwiJOJmrOnlL81q1Z2BmwOhQObNkGXt4s23XCIAdonVV092FbLg73bnhWrpwmrVDdlkufSl8HNWre5s4WTdKWvJaUvTEeGJWouOWLmEW46/B80hvTkidmyLgqSZmj1VpOg8I0XHEERuWnBJlnSU2qCZ/KM2EmCA3vzzpOuY0MVDSNdFV+bzXS5L+bzl0vrinyNg76H5aphBUhGTe0ApLarVXcBfi+USEEQk7U4CXSdl9l6GNoWxhUcyoW1FjCo6z9B/jDFfm1res16/uAvSJYsHsba7oFdcEM7d++ubG9uvXE0DwdO5P7TwkC0eqGBIZaREvvCbOspAKimf+zJLjSFLLsJ4+EWLYRxCL+HoQB8KBVGJHVDSR0dsxiQ4gg/zVlMCZcy25HkBCJ8xjVeyGBY3JDPk9CdsmCGlOd1qdo3kE2EBE+D6URaNQIuIwAKnW/ErwziMI7xsgzQFOchq9Xd6yq+ApP27Oj4Cil6+8JvKzL/RkGqPDcVWsv/KHmDNRc/96hUEWnqrSbzLnqvXs6lpyyDSIbNNiIW1BYHx4VVl1qSLwefjECzYR5WLGxUWQfgXixDFbugajIIlx44CBqKkBcP41xbQl/BSOMkHHCqiObwdwOwoEL3sZSDiTZjF6i2X+XWblC2azRDedw4JvXsWiNbu4ghzQHEPQ6hpvctqdePu3xheMQeZmlc0Bkfmah4TerOCW4ZOSeGiycWnpGvEA4DVTJ3JSXbrXJZdOLDfaAfiTu8l+gJ22MGE5hCMbmDOeKsNyNcyJwtYq71LMSu5z25Xq6+5knxCp/fjTti9qbqUFFAIQu+fAmMsIGuUIEr2YGdm2c5M9M4semZvvDKO3gfkMuqhTY1RShpMDDgYzydQkTwStHKxEJVxvIhGlcf0saPPujy7lWE8m/mVCy6uOFd8zjAOQ64Mf2Qke4fQsaQLgGzbalHEPtOHCXowlF+4UdAjiKTJ8WxHubMd2xemOtotLbyZK3AbmkNtNUnv2xe6FWrxkqTL8BqHUI0okrofIOzOikMbW3hm0cPz6dNLOqdDOlYBNL20p0Il4mtJc+VqEE9VGOb6wl2/H+x67uhh8yDeiG21Vl8jWygAiUMAZ0xJDy4Xz02r1XLEodAGIv2tFh3CP3Mm6JjBOJX1aHtHKlzvQwwRfKDRAcpdggwbRGDsCyjAiMWUi4M5Qo6DBzpAIeROZ2OfRXoIJZwBAewV9kKGXwa9jtdWh0rKiyU6H07BVieaVGZ4WwL2DsaB0QFLv3nXZ+g6n7KAQDBMMG1LwbSt3gI/SE0WYsatXnZ3mNvZvkSugpGYyr4llSGn3a0EQoshIIoST9OKmGJcJXBEG/bWhh6VNGuP33PnTNT8Om5ik4Bd9S9uQFBmFjxUZoZfoABArunXfLv1kxuoGzWQxmd6O28z/UpedULvg6muuzXM39pHc1KtosYlWNO4BA+itreGeRn2k3m16s6ZXUQozIWMazUDFYUx3HYQ2japJRa9Vm3sjaU7QVitLnIqDKhCVL4cvBR7sO49dyrx8d1NEm+KNVsba44BwXsAneNeoeOKQ7yNnxHvvr7lZQ7whQgip2Syt79LHrAVU8lr2ewXeJ0bM2jEXxsJHgTSw2+QUt2BfvJ3YcTiosjp/r4Qd3UOTTZYnGSUm2ST0DsGXquVDb2JUlNLqWuN0x6lZEN+Av9W3XWMPaKGTd5YLbzd87MjXk4gZt331SqqFXt33UXabiu7jVSAtj8XMVRVS41pFzcMnHS/5rIrb/4eiNF3HUIOCH7ctGwD/gHISVi0Bjnp/ZBziwNjjKWaNi05Ik2S70uGoUEr5TSDuLVvfnLTB0BbBiCGz0nBJLhaBXPgAcnwAxgDD3IjMV+4QPejJilkKem1vVZrH5Hljnewiz8OvCZ50lRhY1hAfdbsxk+a3bheJ27AEv6gwULEC5+5/tHRDqkFXoHitkJM5C29MhTHXT+/mPL0aECU8U95OvTn3CgGHdLN764+cak+cQmVK7524vLf/dMnbhQvLu49cbkBfBV5Wd68TWNulfoTAnDuACXoRl+pTPgGjUAkNQKR0ghEpEuif1AjEAZ++jUKgbs1AUYNZNhfatRxjxeRIe1GquNMc2Pwjs06J4OcXATvT9J7gFgaeTZAbBaHBLvGuTu8b8uWS8HdIRhewsEeLpedA/l3p62eO/KvZCGGy+WefnGo/qryzr56r54PwaVimNNou3uqMaT3IdU9jPF+6TBZLpNqFeHhKxB6zHz7xkVVPMDYUOJpFNkO8edXD0ISfhpddnbtlu5sB7QZ1tf4yd1IRPc6tETZP8GTtryFD2iofuCXdKGeJK1rUBhAVY5sxvhTkh68h4jXeRBXWSHzVWMszVmuhbk+2EJnlYTAzYheAXa3Wgq9yrr1lJRtF6G/IGy6fl5JTYf34oSVff/qHre2NHwslxp05pacDslXQIioH0pQ0AnLo30EpSWGEv45PYcQr6yCeZJn24j7M+yjIjYD6zj1wdg9utG6VBQLEfpQKZsevGIXZjjksFpVai1CcmMjX92soQmcnmNauc3JAWXNQgxlGbxbo0LLMwHnD2/RSH7rqPcElNvITGdxrM15xiXCdDgqcPljoqJ98Lzfz5rJLKCbcbEWGAbr8PeZfRK51T/ZOIt2n71XMsUx/Pv1G6Ph3yzklrNlFjIYu5kxgfQ9UvF0l0tHx9F1BnIEhIzZndVUwF25Z7BHSrooV8ydZ1/nwvzm2iBlK4ntGgejeX8xwAJZkzDGdBG5HeNvzRovl3laDOwYto4wfpk/qTlPHAvSNRyNcVBFKHLuhCLd4uNSaHpcG2v4KYWo8RpMqAXvuXdChqr1LYc15yigekcFz4gjyZZbpUwuJc2OCn7EGwwJhmGccrQksC3JTKidDD1dWjE/S8zYineovHe1A5GyQig6EHHl2ke1SUypjtxaprL3ku+612oOcJ5FOMX3yqhzoqAnTaBd0MKMKEFX8DDTuHXy1bIAu/9zRST3B9S9k87UJ/KoZexxjJcUEre2Bj4nhrSVylFFtuKUGn8/zIBbWW9/iw2ftNVWaAFJmJzp9t9cyUhbnG5exJVqVQoxFdIdc7DdGkNiDsn3EW+uriyJrwyXli25oeJXNtA1DfF2ohJOIY17n6/A/ZVqrYdVa5OcrwLr2MwE8YI12EmRWQSLw4JDwnlGl8m51Ft0R+tg7Dmvnfpq9UcV8DC3ET7Nn1ZpDg+67Kenz09OLNcI6QUURIIn84SrrARK4YY6br0fadHE+D5WfGfvAbtx1wKWGJ/WzYFcZ7sxyYGBnsxkI7fUGd59qt1oVb4hRHycmawwxPhKSn8Inp+DHg9RbhKbX7tcCa/p3m57/5AV6jaxCuNZiEgZpStBb3PlKK7seVTg3LOmI1FZvaVs45NsL9BZKKEYEr1ycSO4TnThK7MGNBaaL4SD+SbwJwyw6UifjGR9xxLr3JPEVh+wFk1sKwgcVTcrOkqyHOPkFO0NjaOzASx0P/7C2S1kAXkahmsurOr6BIK36EQmqlWZN1dFIEqYoMLKhR8x3OdEuqDphq2cciaKnMkfJx0oozUHSvACjiGCKAyzLMglUyAFXTUtb1ukOVvMKtBr0G8Ourk0HpkVkhbXGoAOIgvYbVX4LE44GNOs6HO+OU4PfcvvDWHymTMEUXrC2W3Tc87Omg7dh7++Qw/g74VDD+GvcGgLK0QObbXgx6VDW234MXZoqwM/Eoe28WPu0M6O9/jszHlMD7HO2RlUwlpvHNraw6bOHXrQ7uD7V/gT379zVvQNZ33nxqHOrw51bngKv+S/x6cOdeLIoc5b/OeNQx34gX9j+IH/vIVaY0hu9lb++/KlM6AfuB2aVoa89dRPAg9WsNxH25l/7ju+Jq0T7K7wwJQfMYhYljDn2qERa2cGMSqWBbxawKsdS6PKj9hO+3DncG+/fbhbyPcyBKp1Hgc5/QF2b5tcTZCySyR10rx+qf5DeazzEfo7UJQthOqoJbVMg9V0aFQXJpmk5ef3HqdvR6PgxWgUKu2jTG3oX7ugdpNly2Vb5zKV6W+VzILnn63EYF+C+UmEHsiM209ZFdDiv+KXPGRxw2TmdXlWTiA+r/VspRzLZYTZrPowwStNTmMZH/B2pQK/udGmxLYGfwQM8AdN9RUDDsOEKuv0wLB6bYImYqVm6oEJHk6oG2Kq8lwQjSwPTADuuG+Vk3CYy2lGU2XIkisFTQUF92WWWvlfrF2mXNY3OdHkuzgR/+E3KePmpx1FJOKfQOfOePZ7uTxoagh4z8cp4+pH9l0Ug9+AL17HI8547jGrBN76PMKwx1eM5x6zSiB6D6IJLA977DzGu9cqAz2LgqE4GfKfFrHgMCDrKWtLhWVMWEkSfxO0MellP71vD5jCr++rf3xt1zdm62qkWiCLj8ZtGEKdyKJFykcvsmL4NDvgrxVlZCdUzeeqJKB9ogHEUkmhmzDLTOIfhV1Sb2V2KJpmiRzqgzVPYkgdH5oJiWcX0UCaJuOfLFwFfC69F9y0xiJC0xozwbrTbOgvuJ0bF76q5Uau0dB3dsSUV3Z0IR0M6jAfxOlZvo4O39Rq7y2Xrb1WlsyjfbhbrcI9JuPuwjW2hQ3u7nd2dnQ9QPqdapVvMfaZQ06J3c6eaVNGYcj6fmn3jQPRX2ras1qVJGr2zV88p71gL5WjLmh7cL5q8dykF3lRtbqzI9s5bKm/ut22+QF+sNB1Z1cHW0d5voCMAmS5fMldTKz3Sv5FxhWmrerE2dg+5v1N8+SwyFKKPcHl3KtWoyO2u9dpHVarotYyvABSe/lvQWfwhGFgJ5Wvo7PT6bWa7Z3v3KiOrZFaUscKNVx0O/X2n9Y6b/9+Fn1X2dbB5LNKP6+FcpATkd4KTTqX53AMtiZocDJikkqn4FhBJ+wZdxcMl6AJnNlCbSSs2wL5TmmMnzfKVxu0UBu0UBu00Bu00Bu0UPsDf/WXbfVsKqqm9lRTe7rCofqr3nf21Xvd9Z78ux5fT4KUGixfuR8VP6uZIOmLvlwG6L8SsmY3NNvYHT7B47DXC2us7YW1msxF8oy7Q7lOoVFBVXa7EzapVv/iLhjspmA7OcxcAh/UsAxNYDU9BtHTCFQlYDUZ1mf11pOoWnUqKDTuz2qtAZ2xMJPL49DuHhI2NapWS1vTwsTxcjntJU8Oq1WEvN6uF/TaaB6367W9aW/H63hbk+UyWC5jeJ+9s5DDI24nM+eN0WKWy3EHvAicwALr0V67Ch87zmPPefzYQXOv/K2rva/fcCvR7nL5gcvzIQi5q12Zcxcad2qi5jyWYZ19ZpCxRScmBK8VxmySAcg3U6eQLxsr0J0modZz3cdg28ulRfQ9AdlB8oRZRV0Vff9n7goamsuBBjSXZVhvVxmZqONESwa9TD2SHEXdxAj187qRZGDE4MIyW1I/WivsakVobkVpjj6pVrdklNihn/JKS4X+rwjMCVBpq2dc+iyw9GMIKf34sUNwN7BmR9dcOrVPUpKnE1L/wN3XUOITIuvu6LpPNtTdQFGzbfcsgsj6v59Fg+8IJmZXVisZlUAwRfsWY2kv9bKMVI3QTwUwB9cspT9ylxuCOSVoKRKgkAUTfjQHy6VT0b9l/n8pmQBCRkWk7yq8zTDe14JBiC9gi/C7BQS68mssrLnBcpkslw6W9hzHwzHWfuTuAuiggCXGWXWFoYCyZdpVywQnwMaZmq4S4NrtqODjzW6cYa5IY64YMBckbIokvopBlC7YCe9HQOA/424EJFUNkzhR/Vm1qopqrQF4hddAxPWO51M7CgKHsqtSN3g57jKYzeM0DS5CFY/fqwSS6VJGLBXkBEDo4FpX4yf7XmcSp2leh3g4U7NFVk4WM6aaC6Ks9Spt2E5VSnpOzfEi3Ig6wO9ZZMWJ/MG6wcu66nE7ZJ9n0Us/cpOA3NG57AwEaeTGuxZJDOBc6f9eGWxPFDXcVBGkHKeb2HDmpjrrFan7T2TmquCJ3wu8lIZSNljLCOAIyF9UuQcZK6aqZXcqtgONhDWbeCa1XGtARXt2DULDLFeRmfx/ygOOacoe1HtZZLGkhLGFiEA0Y3yUj5N+1MGRsthNAfCvv2GvtRaFuA74P5R3L5dGoBdUq6YSUlV2PWBi4WKFTUrB26HGFPWPUuvFbA5UMV4k8JAXufbSGmT48fBPxQF2QlYDWzdgpkLVBjTt9AcWjH3Py+yCQrpQ4ZoBEtwY3c1zXJxXQP4k45iCrq/1527I4r4/ILl87mGWux1mvRXm3pbJRIWdK9iurr63csFbD3iRwxFIeqHO/B3mM74rJ/pCKZxv/QLkFsZcg1oVseUF40bw0sfKAxiCnUAmC9H9Uq05xgV7boQZWYskskfiCrqwPBLern9AFwUxGqh8Q0uNrfXCiQmGqHTXC4griAN/TLq5XvuLAXa8UgATZcbY5h43wPNbgXsgt3KplEoXG0jo99IkcgsiB+nfTSnPDunCrISCWDpkUTdCrGLRNkfNPPUj5Pfo5cbK4q4tlktTnCVHWAA1CKzWWF7KaNGQCRTMzZ2A3UshforTczIV+Hi5RJZYUQrVqnjSlHHewExoDHr0vFCiPx8QOUPmfJfw8blTm5vNnVarY1BXrn+DKqW1UnDuoKWzJpE0v7eld2phTcCTrbxCIo8mtT2g46AtIBisTAsRBJGilqhIC8nIFH+6lrVPmVSpWN0qMR9aZTnY1qVSWY2p6i9ktlWpKjP9EQu7T9ew+xBmphHtfLl0hxaiDSHiIZv240EB/4cl+D8Adouuo32EA3fB7oAejdDlXzXGJ8DYSx3ifeh+WANs5OGfikPoUON7Cr4e9ozM8PC2WTy8eU8271UcOq8x0wHRN8pYn+/5cuncrhwk7lUZ0HVj0xNzqgrea7oNrwiDa1apsEsL40hYgD9p9gBk5tCQmEcxpKdpEbzmHVjrUErXHFpBxa8tUZVv0HIHbnufDfvR4AFXvk8Ds6ZoEQhIzUzU3kfVN+6OuY1rbn4YGGfcAbLPc9beAUlYcUxvQdZbWGPp2n4s9H44t04trDn5HdmwH07FqWXt6JNbgjY1WslNtOcW1BzV6laAmLAnSTAMOaQAwpNF/wyEwB6nFikngSFZAwafJf2osK/+ZlIuQm6HuoUNtkg4X5NwioDD97AnCHFgoBoAxG3cTUKDImmWmo3rO7Wg5gy+aeNu7Y2T08FcIAuSe/MxkjE0R2pbbdvqnDoqe5NDwjkTOrSek3bxqnkRV2BMFae2ICsL41Wrj6QIDfceVnZIVhvxo8S3kYq55MogSPgGclPaloQtRaeRTBawBbKAf7dbDmDzwqcQQCn0ylRRjLHQ8FKtA9IDPVZNF7UgiSvSVmBwqPcrtHcho5KyzBBrcQj7A+ktDyD7kzIrJlSKW/w1AYuwCROZ8qbv95PBgHTFmsbDRPKP7CQT67S9HT6oxP6AEx1Vxo2ZMOQQJ0QqQbJ0xxDEIVHDynCHkCWc0Hx0Wk6sYM587VKGgQLPJUwo2qx2QRXJCSn/PMJbOx9b/pec/qRiyRHXsvZp4ryCkOHUeM0BS5OEz+JLaWXyOa3Du8pOo/Ix5bqegHpRKrg/ohCNZTiVBipXldQfcwi6oZiBBsgXYB+4YFMqBJvQRLArGgn2mcaCnVBfsEc0EOwLb4BNBk3Nz6dhSEPBbgHUSk01ZLJIVxn/kG5ilJC/KnsRqTfhmb2KhRIjlhRQ4q3jeNGKAqkkL7yENmmkyJteIm8zzLfhOKuVIj0ES+lQMJ0N+AXF1LfeWzrz594FYkzvmGIGJe8jTbnwfqYmD6T3jgI5513TIBLeU4qxjr33FFJeeS9pyv/0bmgqEu9yReeC/cJdBxb4VeyPHOrAIkEshPyLp2Go3sEvQqfZ6xcLSD3pwMAddE5IRWUk2C3wsh4X9BTVk54Q9OXTk1enT18en58+/+H49VMvERRCh+vHSNDnb9+bt7GgL45fPv346oMu8YW0sAkE1QZBqaC4k6GgEAL/+BpQKuRaWAgqkLkeCqon4c2z3/DxWFA9AW8qwE4G4kVn5hyJq+l5JvrRAA68vL7QQFVtP4hz5nEiUiW7hprsNhh5EQ6SjzzwLZZVIMWQBhsQ1EkI8XUL1KfZ74RQvyFbwJAf+sUqaUSMsyc4MIGawcb5OU9fx6NFyHsuYU+45po9fLIMq1xBb31PrAgVK5o0Rgwhnz3JAhbD8RQkacSuwEj7W/CT48/y0NGcRvQ2Hyp6woUHy7CCBHmNSUHpsIYpJ2F84YcfpoFx1MxKMGygKgX99HIJ51NjGNexXjnE1ZEEeXlHV0E0iq90J/IJBJU0acR6Je6xhJI7BlVp0khwH5yFpgQy47HTm9lFHFar8q8xAPoAN/KmZVyvS29loG1H7i0kzd30sZOBgKM/22rKDYhmIxioyxtzX0xR1Q/K0SAcJTxC5xv9wPoDQrnGcLcaVl0Ao1tnkaKRWTAUThdgKaK3NpxdRSqLKMcvGwncXVCP09tnCyHiCKsFCX0eh/gzxp+hP085Po/hGb0AeYIFSUJPovlCth8m9FUQfcHfw4S+j6/wp5/QUx7yoay0SOgHfi2eJtzH5zRRgxJ6UEIOStDbH9M4kshJEt6Cz14GoRyKH9GS19C0HLn9+mWczLBU2KXnGEofy6NcuWK15fBybyS8ynnkXkj7LbkkepVjlri7++29Q4Ki1wilpyxxWzs7rQNQlUBZgIy42z5ot9uELrAsBLlQ4nZ299r7oJOEsiGhY6i3v3d4QOgUy8aEjqC9VuvgkNAZlo0InbDEhWp7hF5i2QTibiVue6e9f0DoBZbdEHoF4zvoNA8JPcayK0KvZb2DNqHnWHZN6ClL3MP9veYeoU+x7BTS1idu57AJY36OZV8IfcsSF+zddiBzNHyyv38IOaITd6fTOewQ+gZrnkDC58Tdb+93Dgl9Bz/3IT3Le5a4B+2dwzYkoYWK7wl9Ad3sHO7tQa5JmP1uc4fQZ9B6s73T1pfZSzg9vDsM/TSt/HVrXE/ixJXXA++GXFQEMylRNPva1DaoWyx7CeSz/eDdrrpZHIapS9C2it6mwheQlI7Ow8UkiFKvP9A/3+IdB9cJTW9SwWeeCt6PReMI/h3qAKtYlsSxOIkAvORX0PY71e7takUvwGD0VLW1oiKOw4v4Gl8JZdA14UJWYDOXuFzaHp2bUmJQIyBybUgXJ5y5CXtJI+Y26Qnm3vrxlCjzMRgFoTHLt0+L7CqsbsT6bpM+a5zvEjchA7U1MXvV+K1xfv7++MXHX85fHP/84e3bV6fnx798OH5zevL2zfnzt6/fvT09Pj9fLj83/nyuEVqTfm68/kCwg9jFx9mIuI1GI4JI6jpPtJzFxSII1dq4Wy1VmPBJkAqeyJmofSErmARM2iX2rSUXYmW+4XJOgm25azDTKoWZ1oAsl/ajBBn2US14tnouofaQFKiQ7s9qzeUSJ4SCpLU4PdJViRXtuus9kLJPV7knnCAvm+CmQ5GbYHNARQYWckUboyCdw/1Ok7VX+NMXvCvHlUEzu3SJCxCtPnifHQQ3A+xPiT+f89HTaPQMPn2K4Je6YmMNeeXESeomhdWxTgsM6MN0EX0BUZspfhlZHT+Xx9YlhHK1qAnHhXzPR4shT1yyOrdWPgdV1jRXxalJxATnR5N/uBCmKa9w5vRaetbh1mWFo43DNsgF6mdblb1QH4nsI1wPb9PO0Ww1sM0kQzCqmJicn9jiycx74xL6nvtD4b1dUQtkGxa6Qy5udW6vdf5oyi8U/lyV1DO4taz+Ks0+MMbPuSqMr4pbqm6NDDVonlHX0DugWrLRNV0LDIG3T8LOcSMWsH3ELL6LCaDYE1f0ITLtev5Tizb/W5cYkOUnDQy7sN5Eq2ejLk82J62JiDFLkVie9xP0i0VuKyLytq1w9siNCM6lazlcYl5I4XGTgH+1csF/TNp9IPcuX8CqJJnapkk/ND5Cifdy5eK18vQGLgMXLvpGIjchJfAfAARwshnOBr1/3oq/doGLrk9Ji2TXzLPGT23ibtxLF24aIK6haUifJuetmcvbflQTAy9ereRAMqyTh2E9RifVFRysr/GYxsb5yr586+SGmy2EGT2nrmYTQRENxa9+QijToxQDD9L7q+XagEw1uOIoFMzqARUqFgeU4AgSvVDRxsPRv5AbrRBFk9b3yaBxlfhz1biBnp49W7vxmEX9xJiexD332CWYI86NGXj50HN8ll3ExYFZx0m1kMDa5S5PIDPMeSArPAdmVSNbDw37pIRq1hwqEeejFCTEqotMYwf+HEIVQ1o8aMS8cwOOjkJXcn8qcv+J2f8KnDGwKVsuNYOd8cHgdbdS/6zuug8tUZrZ6Ci/0fnKxc2OEGlZpwI3NSI68XmT1g8JiH83AkEst9x00zVJA0wnIt8J5G2LzKYHctMxeVvA+oHa9EANIch/69ubbpLEriFBGliiZR9zynXTI7+b1mok6KcDC7emZiQRoLw88Kg78wQZvkajERQhyL8DgvSS/LeBkA+ipQ3wI1bqn5W+89O1/MfZJbZpdzfdbRxWxU0M6l8VKLB/ricUqrmkpL+X2bm/HUc5emEcrVY5+sjl+nITLE816BpwGajBAjgK0sMxW6OSWFmU8ACEEM/c2ry3sQOv/M2q/KjeFo6q6dXUIsUrrfTwKu7Nt5cSAboE8XP2pOR8rRMZNLLOV0KU4WTSRRtJzFJnzlc86MpD/whubX8+D29k2tu+7+KZigZ6JCW2F6iaZI/cgLgCFpoGANKEmDWzLjwO5oKb2Jhubvvt65qiZLHEKZX3Cpd0ggolz1ohecfCdFB2Khgv3DdGRCrYLTTrvWi8Of50/uGH928/vTk/fv+eSsvOrSad+zcocHeb9F0j5Ungh8FfEp/A/b9ajYPID8NMQmvE2zaiFUhLfG68OCYuyt+4IhRe+3N5QmOQZGZEDUBFgW0zcK2+e6HWUH+aEbSCPZnmv72fuUOWDOdjPFG07EFTPP+JCDoySDz75UeL/qm8lv1xNOfB959C+/1Hl2NvNMlqqBZwuyIjzXCGUz+IMCmNYuBBV4HW8iLPXrnEu13ZuCxiT3NUKECHngUh5gD+TGOtYb215vunPN8hF7dTP30lFRfJap2Ub5dyA22bG2iDSItGxg1r4/pVq6WmTZhnAYaA+rStJn3kWoUkE1Ag6rXWu2cJL6hacms60Yp4Vu+99fXKfb/2MSG2T9DPhqr+ZrEfFezB4p88B3Vr3fn/iSxYK74wzgPAbiJVlIEQ2FaZY1p4l6FxDZrcuh6qVfsJKKe4WpV0cs8tvGLPc/QycmcqXWixUzBd9+Jqda2JfkyTwV1frQiheJUX3mmWb7nc8LGS8d7mBKLJivGuffATqXPH7QYFWaKvbt+wbNmS+4ToCG2qcYt29wKaI029dMX8XF9B1hdGigmIukkDYEskugjGBa6EwksWS6FejogpPvf5oKTIZpHueQ0beF8F3PMIR2wQz72NruxVSAurkGopcKpXIdarAHnGIXRPNKDYd/T3VsFszb0VNq+EXUXCP0uztYjpAxpemaB5Link8X+kZBG3YTzBuzj1BESD/xvIpEjsWAQHt4kLoCj+KRLMBArNcC7K3zMCRdWA6KFxlMYhbyBl4qooWitpBPIJdTz7oAL6AbQ4ewftFqE/gm5nv9k5IPQ/UKGz024S+j1ogQ4OOoT+Br/2dvd3Cf0VFD6tXQjrC9qkg31QEv0ChZ395i6hnMPvg932LqGCY5VmC5yQ4Xdrbw8SaXKpSjpoEhpjlcND0Ib5HLVvu3uQiZNLDRuMLoXfe60WNBly1EelnNAFttg6AAXZUBYvOKFzztzEbXcOO01C4Udr95AQpZAaQx4bwaNRWnnbeLdIuEFuOU0VXu/pYs7lTxpyrWhyRDyZhPx0Gl9FDpW6XoQu/yZeaJrU4xRsvQQFjTPGzD8ZeQkNUvzMi1aSiIW4iamhZozMXZrOj04XFyLh3CXdaLnMLCkUhZxATOB0vTLljXQaX7l9x/SdOkDWDOhWhJjfmkscPfejIQ+fh8Hwi54NNp8qdtAFID4RbxfiOAKDhZG3lSmjGoV3q/X2PySwLP997b/nKTfNc3MBC2uBG7Gfdgy2aEih3wupin8vV/FZPLr52Q8XSPxz0i18rHYVBr32gbIeEBSsBV5zMY1HHi8Z6PE1Hy4E37AIXL49AcuJScLTVJoj5BtZhw3dmrqV53yYXZUcR+QJOpODSii8fwdlefgzMj7egBpFiIoaIv7x1MU4UmXv+w70o2CsOORyKF0fdnZuvn7QG06CPe4NVdaGriiQIkgChZNTP3UzGGXrANyU2fwckSwwaX5CS7a3tVrNCgwdIh05gnjuJVSilGxHI1vpE6+YoLejeHh8PfejFIy4fDrifA5WH2D+EFDgnEP/5q2Fg1JdqOD4xUK+8kKaLuZgMYWrMwuEBOXUW6xY7KJvDOKV08Vs5ic3rjSFkIIIC9U4YFoTJ8EEOF2rYwf0leX140ItiNLJG5NPxMX6rlWZUIFGOVQ0JHzoRl0nQCs8VsB6MufhgE7BQhoihaYczaXpiA0BSBeKxlhkLT5hTQjKqO2uRQPDfX1Qe9wTjdwpy799GSducYReoQVpG1K2ECkfLpJA3OAqFPvRL10tarm1r5Y5DdIX2d5rSJxSa8u84VcCRG7Y3ojqIXgzGqRPF2IaJ8FffAQTXIhpNlT7pTsj2a3XUL/cMXXGizB0MKMh/byYzT/E/+E33h94HBuPbuUirvCXXMfVHzTh6TyOUu4V10a/KFt+qo5+yUdYXvbNamX4qRfB6HW8iISrD6aeDLexkaV8X7+8uRVZTRildfnlvfr4Bi0+Tf+fgjB8z4c8uORGciMHkq2GWWBAUzS6Yyhii9n3om6iWr3/NmqtCE2smUT3zSTh0QiVt0AcxXNDESF+TzR+jzKoivNQ5edopyAHyamZcmhBz6IAscMMYuYGDMZlh2C66RCMys/VLLuVJjR/615S+zq7yWn/vQsbgV+t4fdjmicirykcLP10TnPHzDulFmniPaU5Ksf7AnZNz0vozM/sIo9S32wGGETGqKYmLhiK5WySYFPf5Dc1VfvDy/GbfIvozXHoiM8TPvQFH3lvJA7PShyyXJY3YtWBdrZaXw9JJdeT96a0s01X2TcC4z8Kgetn1KKb117SIpFyF5Gtiau3KqLxcciBDXU/0+zS8T6Un65sLSw+SfZmFdAcYyBf54qoTdnr91kJzTEu+r1VRA3BrV+qx+LJzU5q8RzffbqKJ3X9LN99du/CC3hyyWqFdPSYA9Ajs4I3gENvbejbamb7gEqZPNhtNTVg2dC21dKlRXADPK845anNKWdc8oSLVzhXhdpzE+Fr6yBKUFDCRGO4SBKAKYzI6kq/bE3TL5fIHhTBz5m2HOn99rjyJq7InirKmByDqTuPaUIfO5XHxLp/ZJ+ZNYce/SYol6kXyWo15Q174cEiWq7MqHxl8pauUniQ0yXZjJGKcJ7JD2xwyREXXcnQv4B5YizYFMwqV/YUjVXtbR7MRA4IkzzQRZQnSVY3LhwAHw1jn55+8AJIn5ojd1ImcEyRPSht/xuyyHWg39TZuMbOKLh06C0uJ8Q98JxR4IfxpL64dlb0/toQmnGUxHOsTx7wwSwe+eFDW5eVv25EuoN6EEU8+bpPptwflX8z7SiId55e+kGIzo++utkUk1My+wt0H3Co1FeaR6trhL46DsABXJphUXxTMpL0cuLQW0z86DntpkOnPJhMBf4uqb4A4L6dJnzsOf9SsH4dBtGXH+witIH6mpXS8bRXFLVRLG2g9OWU/+mSTDXlcpqW4I+Q3n7hcF8jUNNRBrsev+do3H2sCgcHZwX/rVYSX8zK8UWOQs5RLBwJjHfxfDH3hNmf4vktFSKqs2cABBtxbPR6/3JDA3Vps1IKlQa+sm94z7kQkYFNXoFso3zkeIXiRaReZLMqA7a5HxnA19865J+ESt5z/mXG+K9sWBmQbqgBuwsak2Ljsbw2zKZP7tl0ycXlsP4amOUB6679Ryd0TQDnsDL1mXKAkiUfYrOiLrjAWADDn4kow9lxrzjFgN7qXZN+c8V7ySd5yntrS8oG5KNLGmnwlw3b8H79HilOk3hSfSHX9fIfuHzjyJYY80Yq4jnc8f7El5obvEsNXswdNGDh3fz1u35+y0SVDzxGudN7fg7Hx0BhybsMch0/Cfx66F/w0FlrqCI729CUfrt+OjVlDQ+r/23On4aFm/th4Q5FThzBlj2f+tGEK1CAnYxgIwTotAtSEWm3W5RwpygthZYMOM0TfgmyI0k8KmiyT3oBorIz8ikQ03c8SYNUSE8U2/On0HEYTyzNykN7zl17SQ6FSJOYzBzYGMwR0hAy2oZLCotynjNX01+4vC8GmJhUGvuB3FYOd32CazopTRl/41qW08rUFtivyihne11EHgUlBcRcJA9KLmKfJS5eXeAH6UhXQyeGZtp4GaOfoSP9PB2cVGXBohyypEOWlhowbm0tUF4i4CaaFyuBJZzqCMNaQE0HCEEHqo/vqM7Wqn8lyTDU3qjOim5tzRHbr1+UzjhOZg5cJVLRoEQR5hhJ2m6uhjg30y6240tyTlAZ0NvjVJ7c4t7Zx1zjs6yEZkvuLYr7SmzIpw9chQsRWcSTXAVIsog/eiVEqc3XiKgiiV1NNhVQsXXoV9R5hU8O8UpalbS/XNYc7b/eR0afOau7Ca77RluByY/iiJdzFc5z+CsBEZKlla6IXFYk/u5f8XQYz3l9xMdl181cNeOcQi2ZoGeR8hEYck8SPxKYWHUuY5nANTgKxmOe8EhUQn7JwxRjzAyHmJcqrox84VfgsuRTPxzrpDk8GkGjSaNy7A+nlafvTjBxxIgPQ+gvjjhECZvFCa/gYNOGQ+4YKnwP0rQg4Sm2P45BoAP5KNTnFQmhKuRHHPG0chMvKlcwHzMxEVdOr/zJhCeVjycNhxDNMH3F6c8xVGUnUG0HnsL15Q/obe5sFY7piuROlyTyzM1+8QCW6WvOfWSf8zh/zv0S/I342p8H/+E3eLWjh7jrXPhpMJQFiLNDVX2RXzsdD3khowmrhhwvZCWLVI7E8iPMj35tonqSK5WlDMPmysGWdZr+93SqoxuE7G5AcT6qDGWaX6lkd28FFrDiQBjIu+8eaOuPR7diVQeZ+x8rmsHO1QNgR9psF5V4ahPx8KsUylgw42nqTwAhRroojReJTMj90CsSu0xL+Wl1+COqkkLcxRAnGfl7/DfJ3zXS12xqjqjCdRB+MuFCiltolHMTRqpKR9GAABJ5EjEiVEgqr2vI60SDXpGJ1UJaaVuTt7MofEllh/FqldXPCPjcxS7K+DG0elRaH04dbM0ZFKWqVHQtXHOfCBW7LscnkeucyGxhFCWk7+MrB2JMRK7zPAZoG2pWHI0UHSDtItd57SdfRmhqBlTjGIp+RCUT6DCwEBHRtLhydIS4nrO44YchNpnaMjJ03URIhi5PRg5BZfw98Fx+K093Nr0ZxiOu3vnGVAMWCW4k5//z/3YlZiTO2qdjeotKxb5TItVwqD8Aon5aQl1O99YkR6NSSqZ0xHN6K0+2l2idZDpMgrnUla7IgxvKbnQ8/RXnzsXJr8y39HISPbCPIPq6HqRAQfWCwOVBQKveXV05le/wv0oJaboo7yfQFKvg18LJrhctgDDEevwyHi6keZyiagQbrYuBxUaqZUg19qfyQrJFtdf/QyhVY0uJMaKVwbAaB0rVLNbqxmDUn9A8Wr3V6A/Qa15KkMdYuoe78GwDKFiouI5wI2paUj32bnVtL15BzJMiAvZtSbYWp6wjYIzFpRCwyBAwRCD4OiQsh/IQou4bkXAB49LxGmYuR8JmWQ029r8CG8d/Exs7z4AAzOuLnDKMdxeijf+bEO34H0O0OQT1UUPmA3CUQ6fU+XYUpbi0kefoX46EREfv+sPR2DfN9Z2fpldxMvrvwscwSjhmIRfccyJ+VZ+rHvVEs2e5MNlz+cT/YZRtXDzOeWYXx699GDJwN9P46meFZgsYI29Hy6m/TmohspgGk2kIEm0IUZ0R+6L3AHJfjsNZUVEC4OsNpBznUtrI+bl++xC9cvGjTL/sHMtXlRf2WDZTFSUiN3VoRfmhlVw8GAqKxtRPXYXU/8+e7s9yFGVAbkOCuuDQq+rdX8RVk1RzICs1PfkvOtOcogfKAcZJeyo9UE65NqD58jBXEy2ELtNZSasVm8Q4jyN5yTn53EpSB3V6E4kpF8FQnb6EbbX+jqNTqcOp8otQ46hWiyWuoGVDWdOcnMfRi3hmZsNlwJSSLm1vDNVHFgzAsKbSr4OPVAgvCDsDTuJCJMHFQnCzj5LEOb97tGAHtVp3v3gurZYUXOXdGBQcph6nw1y1/yDKKjLUUoKPAoUv/AbxYGMcJKlwCY3VeyvP0HIZL5dPObLdqH3cbKKsZ+ZxasaUJ8DKl5lbtlJmKGCr9YXfYCjm4solpSsHfitfYcdcslbZsLVraZI3XNbvMd4q4BlBsrEnZuyCJRvHLjaPvaCiv3tfaZAidnkdj4JxwKWXl37AN8ZyB0jpaXz1Cu7wotbo4VIkNZq6BHdnRePeBnHRHZ9lyFJSFBmuTL2KIxFciRRKHdU7Gq5zWblIAdhnXaHZqFpNes75+eu3L05enhy/OD//+emrj8fn544nwEB4RaP1mcVzdWvINko/X1Gnr3eggvUGekoFH/ZNcm3TixSTyr7EimrZnzJkBjcNEGJblIy22vyybrVp4OiNS8CkGg4yNWe1JGjHuqs8FZbHKMcA7t3kiHeTWo1grJUMf2cxmbQzaBhPXOfF8bOP33sVvdlaleDL/DyT4JJHGFVfDasyVHmQHXA2FWRVcgRwYS3AhkOkGK7nHAJznDReBaloBCn8gUgC1hVrLsu3D7ssTVgaxYAT5XqRu1d0KJuXcaIQNsBqOveHBZQ9LLwtNdywmOs+H+RM4kF/IUMMu2uXW3rnIPjmMZQLK/EQWW2a+q6g/K7Oc1NXccm0Ge6dU8M46GB6bqWSLVpE8EHZvR6kasbAjSG/ferP+NNUAY2kxso2wtTHKuVmNFmYwxwkKo4bkw0XhqP58ZdxYl3c9lLc2heOWC7Xe33OXTexFwisGkqkx6Sk85KBlkGAIhN4Wfdm0oWJwObb9UtIrPxRt6lGvpFq/DskY4EGiehiPvKFnLYX0/Kd9inwzD/46fEoEHwE3rVesGJCS8tCP8VP5Gv5TQrv7zrrLgh5ROmyydgzpbcHQJAJVu8+526K5it3nWf3tnDlnypSVOLCcmoryhD9Yh3RDy1Ev3jS7i3qba8J0qh2d3606M5rNTLsz+ttG+XPB93I5eW0N6DvIVndNw9Y5xfxFeBb7s/kOod00+ySajVYLre2/GrVh7RH4CK2XJbMNa5WcSlDkjnZVuyIpWVHOSeK7PNGEVEOPOs0lgGIRTCWQh0tm22ycbZWc2vAulze1xkkXV/ZnoVAEX+MZopytz5HJ3PhB1HR1fxl6E/QnOmrievCabMJbGqf1LUziMKR0sX1S1cvWGWbevex3ITQzD7/h99QDrLZNG+UlolKFT8HbpXLZUZR5F7iW5k2e4HmJiosaVewBXIOdn+kZz95i3XGLLqDb8DsMQUPy9L1r1ahli//QHi0h29+UxmylVMC62ekfO/4hvOwCfIh5IQ830W3mk0Xt8V4UmtF1+RwpRBnq0nKgEyCXnFSwcbhpw8Fysh1CrflRgF8SG/Xp2gRxda50vxPvunNXOIWpDILAELWeEtzA/NqFaJKbbFsZqU4dLmMjSPZ23WWZH35MfmKmleO2KFrdKpzfq4Tzpy/efr6+PTd0+dwgdI7YRgtx29X/+OMT371cThykDzxKlFc+UMP6I8sfCewRfMkvgxGfGQYIJuc+d9l7NaY7hm+yobxGYPr7LYg1cOJlFt+5oS+geKD/Vab0A+y9A0n9B3H3BQtyJPxXha/44S+xso7u3s7hL6Qxa8NO/fqb+lR/3tMgK0OMoPHTR54lCeJad/WF6wbA+ddQ/MIDOyAUZdQCB/SGIbcT9xbqe7zUEcodSjS6FOJ2eXTitAi0Q7la35I1pAjOjRSbaikR++zW8PDn/LkkidwZ3OqdasplVaGXihVPAs6DAMeiZORN4SAI3OWyvsVEuLKiBsDbe02V9ZuRgukc+gj2koy42atuALGUtnHGRtMx8MS2evzhI94JAI/THPl50P7xYZenmaNZh2hMScqc2R7OeXopvJzVKV5Y5liztH+tuewYWwoNUOW3Z3OTr3pCxF/4aAucWSemoxMHlar+gs1z2DEnJpJSGjOkTskWuMcN6T95ns+ChI+FB+TMEu8xRibEnt5okbEr55K9bYBvoWBNkzAqPTEaASnrNYcqizgPGett4oENOVALFPQiQrAAB81Km+xel4HXRn6EdS54JU5T8AanI8azop0s+WSrZ8vkqB8/lM1/xGAnwpsF5LeiIUoXbPlTSEkyRyx0DI1pSMTetIEpWwg3J/yuZ/4Ik4wa70ZEL4rH8mo8TkOIgwru4KmZgwp0aRDXMD6L3wheU3TFPIzpU3NCKEZj91IuB/OMojAx/IPVV1CqFsC0DLbcBlE6zfZoYASUq36wOG8+zJEf5SnxRa/T/xIZPJ2nO/HzwSVBfjwywUK9gwsxyN+Ppz6ISw6zD0htPzduYzlwE7bu3sY7Ade/8wToH4SxleI+UYjROt++NOCJzcy+9c7P/FnqTeB6JC50JMTKS+87JqFnWAwQ9U/yIMvWZ9TKNUBBy/p+hoTuc0Oc1D1AjO/0agwt7Ifk1DZBl90L1jQewGXDq7KmxmBRE+QrZmYtGUu8ayX+N0VvWb9CzpWPVYdMpA/MQ8mhi26USO9oU7PIT3IyAz1ulcsQz6wlb0ELk+9gfxEvfNwf59pW2YeCYUncbsNNOA+92yMal5BxZLvvY2VIemX3CQq66ADoHtN9U2G58Kb0STDLN6UavGzdwWX2/MLz0ZgK7Jy1eeZGWr+bosbKf44Ho/5UASXii6OjbJQ1gOccMftr69SP3eVBiVB3tDRIGcDhtJqmiDNIhWVaO1Fb4dTDv5sQC9E1GdRY+QLP9UmtpjWolrNtlzYZmHyjjZmE74KjBx0JTKDEJrBxvoB7fuDopHurazicc3IQozWuFrFvpONbSXUJ0/qLdl9uKFNYODDjU2EuE7AyviErCtd4wj5yjW7OrWWt2rVPO22R7UZsoyFhE57yWrNJLnQiwQH3L3U3riu0S7r3fHDsFc+zRNo7oO1V4qjR8JKYQpw5eAj1Q9ZLjfUS1UFvcOwMF55r/3Bqtwx8Gt8Ai1oL7MX7CZFcvVOWnWzr5+KPVxmB553NsiT2DlbPhowECfqdAIh83OkNVgP0gWIVQK5lsYYS0YdGlqvNGkLr8KGfsJq87Vqp3yYcGFXlSVYfWxV1+TvB3QFWS6VHwadWnVSAwGhOg3LZX/QXaMIp9WqO2XTRjoPA+GGZURKwbfRn89RMRs21C9aXGgFOFOLsqf2hLw51WvmLejcGJk51J6ZN/5KI838lhatNeUog4LHe1hixLmwjTiHmRHnPDPiRPNM7VtJp0WTzlGZSeds3QJsInsKhIzjP9Kd3gbp26enHe9yxULEuDfgDtHLohVGJ6PncRTJW0ypntXoL6wbml4xy2LuGBu56ZWyHmV8ik230Wv9dRmDVMZN5XgunMU529py47XDRO6nBSEpZY4r/MJOGWPH1ep577TmVK4CMa28+8/zY8c7pc913TVkmOTPBv3MtrbifPwAqBBAGsuvt6YNCIQ624IPT1TVE6xqcz2ZE5z27yEqaMEHVman+rfscwPqVNy3sKRtWnHoF+qQEhP+0d32ucEg792sj/266cR019jrZlsPTgPrH0uzWKz8Yd3yd3aP6S79/HBr4ZtqdaP1ofMWjtKLijpLlY/vX93n43ADPBDA3sVyCX+OyV3t56D5Ic0nmwh+3e2V6lb+ub6z9w8gBsBeHXqfobLqGCUHusOylm3LHDyLK+q8DOOr+yb2hRB6usXYVQ8evXX721JQHt5tpXw7FbPwZZwoocG5uYlX1NzKYLv8+V47bQtC9YfllttzeitAiC68VpOOePpFxHOv1SwxIw0QmVPIPF4cHrWtvB0g/OpYfpdht0Wk3m/d/ZXrZu6IFXXmls3352+2+f47y7TZ5ju3VJtNwa2l+nuro7tAqZqD3uUBj0TFuvsg3onEc18LZzat83dhzdjNwSrmR12+aB/w1V0LR++1jJNU56qI5ZRxNLm/ARXTsX4Rj26gHaXNqeAzIQbnXS+XNsaVf65A6rb1ebn8XK1aq6pJzhLE+OBtN6JRGJR5+B86EBO5idkYaKlLCC4BDSTtaFkj5Fchv/m69P4TYy+7td5/d0VTyeGsaL7g/5SVVWO5Zwklw3I3IrJr3r+0W5+r1efV6vO7QlOsxZ8odeqetvXqSAq37Jb3nSyilpycJYdQ0/DD0IGLRAspKlBAvrKt7OsojrgOB/Fc0cHPM3M4zFSyiaodboz1sL4wKN66iMuCJy7orZyatNHwBA1Gypf/0e3pqq4/rT+6tXYctnP1B8QtlQFsP1MtQXt/n4xKEAUiZlBrcGAJ7lbk/iPzwNFuDG1nr1Qg+OxhQSwRyazuJfwUjSXuJxFtwn2FeebQQKsYlUO5bJ18jcvWtMRl69si6LypVt11/DO+LxKNpeItiaBzV/Ccb27alOej6BD61T08NIyOdlt+9o8GxTMa8TszcxRi1KKE8L1SdGICUGoKkXDAspwJ0VcExsNFglXBFivx/ALCwWHEqSQOMRTepqB1znP4xIG1QhHMSzRKx/lJYiiV0d6+hZU3LLWeuKKvSsOyzhOen9MsGCYxuhficSUr+lc2tpEOyfxPDE5SbrrJbxgcdSozEEjrEMgfywEuncaLcGSeP6Jdip2baj35AAYp0w85iTjAG76FH7k3EgTxnfyZe1se2hprl78qGrXp0RTDwhbsQMob86KCEDOWB8fXByfIW7iBscrrhfAFH6mWvFTHUz+Ngvmci1QHbQ9XaE4yZGkvbsxyH0EeC58GxIsbSb6Ezo1MS/hikaKAdChLFiBLoFNdwQCvSp1DR/pNFIsX8RCxBsptZvoFV0LViS7AS4rQS/1sAd0N5g+eEnrBpn1HxfKtIx80WC6nfee5KkL+Z0CvmMhsJ54h20Gv2dOcAjTT1TAwApj2+YD04F+pMyXe1MoL/IDbWC5BGADuhYsL0G3FoZw6KCqDB9S3nDNQJl9rG69TJori4y9Q9D6/kfLNc3gzxLV/wMEeAicFphyhlVIoXLsQv9BbtfHecLV+qT23XufAeCWDsf2tKGyaVYQpre6KSKAx0cf3r74eCY1LSYiscanLrWiAKesAWZHi2GX1tI4vK2FwyeuFwrJJCYCUTSskkk2dbI71LUb5b4ZxeC5rmynVoUydYrjY0PTo21vKk3/OCy78IEzLhYsC2f6vnO5Dplk+tzkd9R4Cd+rbRTTKsFNJp4HhVD9aNSuOInDJVw4zv3CztZGeGgn5H49uHSsOp1RE9v54dJsrWHmVPzzHWZliE3Bs9YcKf0wna71c0VuFQ70JVb9QNXdBF0nojakmb6b5eyt/p+n2z9faf8mpoZCudbWoWr1cq/kXpxm9cqmqqhDogJv/RAvSvXZH+378zFnfmXCQr6J80ZnHKfyRaWcxKZjSbzjymDmYvtoZ0EfSvOFn7cX5M6d9RyT+ELyvFHHy6R+ghiVJYJJmfPAna05jd0fnLpqFrmXG8As5RAJ7l9IckYCm6WYsz02YVbxNFrmXMFCgEHghd9odyRgWkq3P6tadmqDCn7y9+JwlsvxbsymuDSwdWPgkjUUSuuQhAoVsdMKfFBIBDBUwDO0UkCZUH5BfTuZU70g6zCHUZ9pYMMsEGNF4gOF6G1LL65LeI+79rCnwzCAnMOY0MSnXmoQmSmEEsoJ49UeWfManmBILOvUiTRnGcq1XBR58Pdh4HvJ4iZZcxkWYTCAcgoaALOWJTGxXLuLKUk5AkpUMfkyilSCqQP9bJZyzRTQ8zQUaVanCikfKmiWV4zlq/Y0RaaWlwjo/YNbYzg5kk/1RGqj/AGaJ2j7+PzZnwhszzKO7/bvb8/p+/a9BzSO9s+2z7e3ASib8PZd26/qrHjbScxPGiWlBfQVo/iqIRvFVQ6shYI9EPIzD1aPbZPWHl3gRv3J/5C6RWYsbGBtdoI1+kvX6G8/yzect17yEOc7fyDuPubi1vyM84HyyJOj6KH3PUY6mAeg/3I1Izx57pMZulxUnDxVktWxqv/73TQ2yL6vxygWkaz2sVPpkVU+LU356UO6GAoYcToNwlPDISwrIMlpDlnEBWfo2sgzyyDI1yDJvDYMyAjv75yKX/XO4YoFJyTNnQzv95RDDgIOxTOjPkVCesrTIvkDEMkdnHkOOL3Ud+Rv6rkw0i4dZ04Q/0eQjzYkVB1QSWDebKsNCJ5EfvoiHa98Cp/iwz4DzGJDuRBogfwqJG4EGyjwUDD5J71fuXtBwDSKiNcvQFfEupF1uLrko3EIOFQN6zGKT1/Iqy2u5WC6dMEjRDnfx8Cixxz1Hy7bgmlNROipBWo/nPHK8srebEgflknX4Miv0Fd06JlY08Mtcf/n2K1Fch+1wQD4P+PxK4fMrvGZxcX/cI2j7royV6w5RagtsTagHM/zjVZl9C1fijbnJHncsb0bsIv4RbhIKcgW4G+k6+emkM1CKlDMnU0OLX0IAp7u+JSVE9johEkTj+Pxcw98I4G9F72qWlhjQIK40lt8TYmSXaLu0lo+EKnNX5/wi9KMvzoreLJcTE29rswRVJgXhgCTAC8w7prnYLVBctzIeikCECIQaN2TXreM5iGtGVhHdBGEbU4VYvftJEl85tDx5iMpmMg1GIwR7lHvQMVhVoJOkQmZ35xg5hgwisHB17Ku+mDtevkSiuyznyAO/KM9bNUbLwDmP+AgAPTHBYH5a97xUdwfQnzKfJvpYwkXiOCYD3y+bY6KskYNIVXIrR67JyRjlMjHGhdSLfj7TYlDIrJhaiRRDyAi3yN9OOVnSPJdRcVyQg05zJvajgkHoLJdvcVK4Qi9z7NCN9dAw0EhvrYyiFwaVXElUcqyJ7GugvM9xqU9ziUG/FFInPt8k4P28lkzzpCQb55sVu9Fi1FvrVvM+UPvu8t5Jy1yeeu9X7Fxd2K/ZO7ih3gF7RKeSSVq7qy7L7ipHGnO+YDdWRlO4SV+xF5L9MbIoh9CX8r6czIn7ggIPlPgzLniSwhd/sWm2uqc4SPeYXhP6sZBm+5R+GdA/ZVPRL8R9Ydh8Nsz39wgKrF4I/QQlavUcQn+Ax6FU2NAf4cGiUv7DhkUq5XsoUgvoEPobPBoOQy4LlP+aKz9GCfVP+bo6phP9hQ01oSOl88dwBlOES85XbO6iQ9erahUjiAPXkvmRbb3CRVbOPSIvdyekWlUVND6AcHTAiq3L2CmXDmWCs/4xvR6oJU04mzYyi1Vl/Io1Hk5lXJhb32jSsrPjeFe9P4qvH91erzQV8odX+voPIBMMUfAxowhKboOfrEyyiBK9G+vA5vFVAd/cjUQyVFiiUf/RQtBXD5M5yOkpE6gX1eoL3O/lEq5dxtiLcs7fCWZw2en7rNOeX5uLTj6kydBDD+x9Qks6DGN/FESTuh8FM3nVrgi9KDOr3SkfMV7k51GczHyM7lb55CcR8AWVF9lGkzLr3s2rYCGxu7IDPuT7ku/+Y+i0D2gr8Lr38JY1Qq0DNXbX2B66XC+DaCRztoy0UJx+43CcFX3XsKZerT5AC1Xa0vn5Q1cw12FZLOVf6G2BoqT39w5UkkPzlOtrsqKvDR1qDsja3j2itxna915a51Q5guYuhSzL9AtjqYPhsfjXUi/rN3Xxki+jae4mY4CeeC2JCcS7eRLobjJGLdTJ2gL9Rm8Lg1gnW/LXmqfu89TccvCFfjlFrXnqmiBlx9TUHGCKxdO7qQmarlWZNNbKZC349TMQ7Bcq8oiuuvaCTorF2HFpPeO0qT8IF1ntvEenWVe03ntfrb5/qAGfhnVNQ6wfq+/praHQ1jYlDyna+RSre3/pgMF062S53Hq+XCZcCbGOWHPDzbE2wOyur8sMMhX5x0JzzruQ+ymvDOMk4UNRSBuVNaC+rAATJ5Kbij/xg6hRYpe4CC0pbqJVLckdETPDwMrvI/XlFWUkfO8UT5CSeo4ZZOuTJEamS5GE1ixhb9c29JOdkv7FPQe3cBgLR3XtvFmMj7E6fGNBmigb0A/0Nj+IQrMrrXF88wDg1GSAnVjvwV85BvxerXX1MzVGLqn3ymYVFWJ8n/GR9/F7xRXeiPwKuzNP4tFiyBFVqZ8qdDNYrkiK1lSSh3+qA1e9U8VWzbUbxeY7inu7kbOD20BvMefV6p/leORXCP9kuII/C2uUKUCVAOCXdQFABrXYVz6Hv9kPeDATw3hMoK0iLqEqBq0UF6D/pWCJu3940OkQKgRqO7jQkoREPEySoGlwnqPBS9NhZpFmip6dBcrez2ZQNH1Sc0jzWYNDvU8LYNWHGFtWsWfeXG7lOMe5T2mcBJMg8sO3VulI77JdOFsx33Dluv/Jig3pJdPO18rNztFc7A3mgtPjM61hsmR6AS+LXORrrWG8Knv7Tuojj1my7oZ6DYXP4/nNh/h5GMwvYj8ZQUf48pxdVqtbW5eNoYwaSE/ZebUKGslLlWvzUkeqawTp8WwuQLH2lG2dL5enD2YO/zBXohxupfBcf3S7KDVtLggdVSbiP6B65dHtuJHweegPuQvasQl1/o//2/9zGwwcaFFGyctoUd25svi0jD3XR3JBbzUElXCBVwVKK7kTYAmdfw0VoUaZJ9SFcIk7WS5TjZBnEJJmuZyS3lfwArrtTBGOLjDYzqaA3f/zMlf+1TJX/nCZK31aTjXd0NsCBrEF0yZSTWznQX8Zo2FykGATqXtJutFa5DAO8SjW5ntNb0Eb8SGGgwoALr36W2its1b7WDnwBitzHyRi832ABQ/G/BK9Rw9E7+pUPCw9+f2QKJtzVpQ3RPwRyLTnfspdM83oQdNU91cM91fn8HCnSagv76/Y3F/BAyeYO9i8eLALKbgscXFipE+RvGCkKYafu2YCGqQvMhWrZikxDrG8UtSFEbJYRWzYdnvsbJtsT0gWloi1uvwoVIxAl9dYm/gC41fpgCicNtfP2NVFokhsnsXQXTBuK2kfvpdJb203Yd7n59miOF5pFe1ehb+9uNS9Ryv00uKyGoXeH49u/dX2o9tg9YdS7WF0UWWinwqWhWCBfbWIraKd1YpxGudMoI6vBYaEeHhSBTPPr0g7U/jmDsPOzEI1kyQ7D/KGQePTu6l/ZdG6wS7zPhvVDW822o9K2RgPRw752s9UDp77bEulq1HS4JFIbgquRso1oi9oMtjsExHbyWHRzIZey1Qd1z/7oYpXD/+taCiMu0W+CsssX5Jegqdb/XGJl8iAHpug6+51xTeC3PFyjin1wdUCY4MDWjzYI3QoceJCEDqHssOd1sEOoWNZPBeEThGDdnbaO4SO4Hd7v7PXInQmq4wEoRMs7uyCSdSlLJ4IQm/wy92DdofQC1l8Iwi9QpZi52B3V+Ob42zBVFIMOg5CLlGKRbZFFO5sYESRcohtrtGnQz/CmzOgoR9NFv6Ee2G25At2CejQJz3fVYTNkG21thibQfmCOulNJPzrH3RuLodUqxteNXyQESE2Qwp6Dkqqt41Fyt/zMXGhcdLVRVKkRFzp/ySTMmlrOhlvBEM8zTUT2kCLnzfxiKf54CNbW7wRxSMOxriQHgnXBWMSqqOcurZlu5VofyjW8nPzhj8aHV/ySEALPOKJ68ziRcqvppyHDsVEqWkaXMqkReAejDRPWUsJn8WX/K7GkGcltC9oRMOB3vYxy7IVKLmxoCMeCv9XPCwgIUviMPxBEpMRjcfjlAv1GFP59kM8x1BO3ehJDLY5DINgJ0fN5TKu+U8YJIR50iTV6nrMqtXDcblJ2VaXHspAYs5Ls+SsfaphVluXpnkDh9sLXFPwYgNreVVZB0oJHqJKGcbzm7qI60PN25Wg9CvRKDCAktz0xB08l5I30OHaNKeiMerQ2+ykWcd0DBOKaA4YaSpuQszQMhWN523ibjpaYspnHJiGiS8wPRoVxLvXpaOsS+n81j0WDZtaZLcGtRhZTkOAB6yCymvBjoUiEM/FP+IAiZoGLVz6pESPeHjsSG2ZGKsxzH0howv2Cz5sdM1zbYC+tvm+tcTteWbIvxb0TjG/KcRWnIsf8G41+YmyW+vWFsMZglZ2XUiMKqrVSAadX+vdNTlqiw3kDZIlooThZSJFsS5IvDMHY0GQ6IMcLigI/9JNUjuLUVrIsQ71WOcFGeQ4J6Gc5viBkTRgCH4iGC4YQQyjglmuFY6KCgZOQu8zP6NLKMv8jlRQMBsM1Eyq1ZJCKWIseeGd50+EeWGCifmZlToO/qfvYfASIV2xQtDoivjWtC7OudM1dtJGjNP//eyqPtieUEFWQNDPV49uh6tzAwV/gIwLbKBX51K19MfDsXjmMPUtmuavocnfW2JiaznLtTPFOADHqztz9ZvGKwqGKkICUYlF4KUCcj8J/OfqnHtX+IiJtbwNjeUN+qTSJOdaabsGgZ2FQiInI+94Q5rWAgJ8oOt+tmVBJJmVpPcVXoWTTCXxlbgCj3wR5yoEsIZ6NyKRFXkglGwStmnfQiX9DC4xok8cBiK/SUXfQjCbuaJJHOK7yQb+8/8f3Q2zx9zRe0gHlU2dgFgRR/sKf2hNzP1MJ2dinenkNtMZDhinM5ZUq0nerosx0ctivCvuwAFTvA347lJnFizeVtlFJpVYgqDMCa8AbwReO/JmzBZjRoHMRXNPdXjCtQNSSjaA+dIFld5kOXpD44KNBEnO1zDNn03kt7hOWAE89biRL3vNZxc8cYd0Ti0VHbDEuau5KOUp+kQZIeP5upBxjfaQfLW+VVGaKq1uidu3A2Fuf06l28EGJAEMFnLlp8AZ7+y39zuEPpUM86kg9IuQaTUOtNzyufg7aTDO42gTNaizrJXsXvl258N1FGIJJ+tbjQZ0QtOAfFOjZD1H7gdkEC0QyKf6s4Ir2HAkSkAnN2Ya2RF+Ska8XArt44dmr6p1h0YDup63zzWZSxXztJbsyU69uypE2S1bMMfZEBEXD3miD3kkD2ycHVjfOs5BhgJSOOph/hws7NM2LBz0eW5Bx+VbltfRj/IS8SAa8+RUBvCdrVhIJ2xu8LLiLQoWsZcrNnQ1xXvZMzbAvromL7RaNQuMcqWLJK42siW2cM09a3rAkA8L+/NzfMwnZqenUDiLRzxUAIRRaBdFc+HnUGRk+srI9zMU5on8N1BUzAVFP1iljkQE7+h7Y7J9H3iO6Qvmr4Pn6yJ4ZqbaBRAFs+NJlnjhhY6XC/vlkO47xnszlysxpVr/94z3LPWR1e/dw6W63QHxUhkS/p3RkKse6XvmyzTvuqSXmowZssBDR7XKS/oX/ci2WvRPdhtEw3Ax4u+5P3obhTcQ20xNDBb0Z/j9F1P2te7PxVmS5dLkNvm5J396P6s501fZ6uD0ytARJoZeyyLXfcmEK22zeWE/dFYOxtjLatWFik9BivFKEQmvSCPi16B0x7YIGMhvNeWaGeYqv6MgsnRfrhXihzIA/+1f7B2sVqPR+JOqJfuUBIKbNdMTzUAqA5fXA9Ll2AVXbcIuPGJov0UTIlOtwyJvMcUcRsySartN+kU03hKXw0Aj5uDFuIkLKCVKRVmaaCcficAIpaJi6n2uZAyIgaWWUGbRuA6I+xd9Tf+kH3svVUI0Qs/pkNwpkC+jjitOzQ0gfLv2WZOxtuNvDloR/704El8R76Lw8fm5ZrlKosIom2C/NFYzoZfV6o2UQEgq+OYeKvgu1cvxQ1QvKpxFtapGpBFSmTGE0sdtWgR5vz1k6VDsuPZpfcZHga/Y5Nu7XtfrPt6i+lXIE8ebrshmz7+7hmw1fH6OtufAsbyGwk0Sgs9aQnAP2s4x+2srXCwwRI8kik/5n2BhsJa6fZ0FsMQSctjwDpwUpr2/vSBqpXUMFOQ85U7fE8XakZQOnGcVh62hGcASG8g7j5cclUGo37jN+ntrk/VFVLLFb6yckq9KckredaOZdIqcPRk1Ui6e5qhpxXTJpBs8Z8GeAH2MAHGt6FiLK1PlOL14RXJp3lv6LMt/6aPl8l1pJBxN0L7/KlpW5ZGQFgPfE/cd0ZkpvUe0hIbI8Mr6Vn8wK+vJK/6upSwQY3eNOZ4FKuNpNoCLXom5kA6jc1FozlgDT3pfd3NoMcdVTyrMryCjFHIv3644f66lEgglgkIfRVHcQqLwzQGW3sQVRdabWBzIHj5fZ9MNA5RjyMEPtoyvlXlDV5pbeHuH6v6rpczSnVvyGxjsEGK9KR++RGviP6OSvNVpEXoiWf7PgtA3qCPvdHYI/SBL3xirpXebrZa+SjGF2N5BD5qUj9TSKNspu55MA/rOrpVPNK++zOfLDMYuJpIlwdgFj8ZKEKXCj4aQf+ZZGF9IujzBJKYvgxAPHU+6SSNG/SfDjSkIFPIjTeCmWoQQ2IbCb3/0NP0AFLPQWZPv+lpYydKk6skkvn4RjF7bCbRLFkDq+HOfZFEpN37EG2qtCoF39AoWZRcQbysx8bYiSLKZE4vlzlCwHq+nMGUd/Qk3noYsWGd4FyyDXqeW5RpErBbMuOLJh3QMG5ywBPMUbf9uSbvOtuOh4KIucytvBw2BUVrJchlloRhfBOk8TgMZj6Na3faF8IdTgFH9wabKsh2tihg9pJ3SyoXx2PFBqtVtgMjKh8SP0jFPyoZk1y8M6YFNldYncFwcOB9OEFVkpBvJSMWQjhzDYG6DmsihAePFM9XjHuwY/HT7fEBvMT413rAMoucozPX24jMkEXr/yg0g01U/pkkjXVykInH9XFZ0Z9shtRahqQp76XgIIpuWdPMOZ7lDtyBuifJqxkv4xXviLkhXMo2QgdoNGSerMXvV+K0R+ZfBxBdxUq3mHhuz9NS/5G+Tt3Me4cy/JtCkr62K05whxt09uAENiWWgUQEzAocQ7xt71lYhXljSqMRfY/aVoSyzdhTOAD9TdKurXCxE5SZeJJWLJL5KeVIZxVzmVE0X83mciIoeEDh3XQQRmOhfBn7llx/eV1xE2A2HdHUSPxRpZ2dbK4KR189z+UJz+Rg4aQh+7u6Pp2/fNBA3uZzoIFwVR0VNcgW5HTJn6EePRQVrVfCDSuW9f1WRON87i84ip8ZX62sUWlYpIm8wttXM7MrQgaABQ/tDSQmGeeSqzclMFv7t61mYTbnnDtkJsH9c2tGgwdbb6NSf8VdBhH0F0YhHIk48mBxkWy4b6z0DvJ6FDxgf8cbMwg2MsQ/SUGu53Ibis+156AfRthn81w9kKrKR8M0jkeHkt4fpZdkohunl3xnDML18yBC2fw9m/oRDtDTT2XueM1xDHwZyF9bI3A03+eGDs30pVgUpk7f9u78YBXFuEF95nNeqY4sONQY7sERfUKOxXldlGpPDTGSmAgyX762lA+TfsBXiWjxkK7gKZNFLvwpDFxIKBIDbPkYJH8aTCFwxjHIW5RrdilKsAerSVgx+WgGYa5RwxF81u/SuU/e3B75Ak/aKiPUUtGhBcxvj3t+MHC/z6tCxbFaGMHyPPMfe3i6hryV38d5wFy8eoFs0msVSQzeHWvLgYrz/W2Xa5if+7NnNCWZUEjdetMp55Oey3XcjN6aywbUsrvKT53GULmYF07pNXevKKvlLvuOCPtNNKC92Kl0YP/gXshs7xADk+eutcx3q9c9BGihw0wmITVELMKcuLW9o7ZsmLWkbGlLi69KlQrGaTATlFm2uLfmNMr+1Vm898qyt8fNLgoUGLAY1zseUJ8ejQPARxFoHdjQBejRuyKj+77nwg0ipxKEG7ous1vWlESG+K7EhLAwZzT2iQOQbC0ZwQsBF3b0tVA+WSzddLu1eshGoPuRiFjuKCokp5KyifGaKXKEeCUJ+ivx/Qfu7DhRlG9xcldlI5uNXiHz8isRqx4sKoSritWAWtqMiKIsLYXZDGyEuCgK2fJCLub1sBSPJAjiNrOgcsxJgmrDQiuYhc7NeQpmeiEnMegOleb3rFRQ9N6cLdbbHUGZtunaU9avVmJ6yoVFQ0y9spkNcZbVBv3uOmo3XQOOco3eFpC6iQkTnSsJUxKbAyu3J+8mg13PhD+sPCIUfxjqHrwhFHaHRlKgWIUg1N6WCENof/J/lkXTae5Cr0R3GYrK6zYPhvhsMW4jfYjkTQ7uB4LNKLj3S2nGpVh1p/+GUuhbfGbfHuccI8501NEKogpEMh5PeN8zX+vz+6RYQxn/nZLPDQ4x4/u/u/n0Dyq9wiavFpcmZbJ3WtRvHG5XfQmNCucF4+QA/dhxA+wW9E83CfibumBjp+12Q+YCzk32T89X7rIyse/+ko195v99kMbrhzXSjNWdunuG5zuUK9Rzyd1q6yzL0IbabnxWi/bwxQM2E3uIFmV2YCoVbwmVy5/WZ+FcI5qg+g7/DDFYgNfZrLvyMTnbHlBOqlOE8u1RWjWLqhz82GGHTIs2cf12gpO+53e++0HN3f5DqsDfXyk/S+8dDxM0tbdE8h52lDm0z6nzAWdJfWIex8nXjg8QauhEvUweuXxxW1JmLXEqAJq23iDKFsuob64fTavVLLjFqyYX41Rd+pUgAFDK4/tP+E2UxQ/BKyPb0HJFE5dHtl4wwCxI+Avun7AFuwUJ62Y0pGEuR1o02jhg1knUeRF4hOcOIL3k7CGoHWMiMIrKTybWKa407yyxk7VMEmi47eV08Auf5xJ8VXDNy3h1y/jnnjge6XnwdeB/TW2Sjyti5l6E/gdlOG3dW2TRrukh58oOfPuhSf8DZyRPy1pP3hSaFcWWbn2NOx7nvTsBwIMUgekIE0ST/UfHt+vcy3mr+K1mm6lroM39/rJs3j8ot46Edmps5LayD1Fg+XWvQQKmiiDKk/zUWIQVPGllatBCxBmfbiOTewCEwJ0gzWqBkJobd2rhlEUOk2wjS1/7cTUgvsRCx4aOm5fKA3H0mARWdHE8iV6CYaDW9S5CwfpT1LE6k2QlGVjIzKrZlgKh0IMq6giOGsHTImzHXynJ2eFESUSVH6b5ULn8y0QjgkALFXFKhKFbYahUkD1vNnOitP8iObn9gLDJe/RMWGdbNcbdJBn0m2G0GXWgcQoNU7dBIRZLzblc6Y+XLf8hDWGb7LXfGkIMRBdEkb0if+YZKWLzRhkG2sz4JTsvbpirfJmhwQR93EgUC8lJKAJFIMcLlr1aFGxVsGbJ+PA5eRjrEoXhQdKA7ZNvaSbJofWnvLZygczwR55KpdujtKBtBmV1lEGGqsNtiKufsM5MRektUq7yErrb3bkUgY2A0quBYKsoMXB+0l2sH7ZmQFv9/gUi+ddjcO9SC+I8PdPKRubUtVx+Zs7pM+JvJx9d8eGn0cC/eatV+ohmIxhk7469Ykt9rwRyUa/9/uXvz7bZxLGH8/99T0OwcfuQEYkR5Z4qtk3KScqqydewkVeXjY9MSZDGhQBUIyXYsvss8yzzZ71xsBBctTqX7+850zcQidlwAFxd3XSyw44DWu5ANCZNYjDI3RgyROrf9IiPybpH2EuJs/EfuI+MNUbmOlJofnynX7iuWSSZM1L5ELMHkw4+aoyMtLNAsouYTD8URLV+AGspS8Yj3yAdYXhwEZShuCjRyzJ4bZ7seqbl80lXhV651IzJR6+DxyoctSHcWC+Mylu5xY9hJbhd9Y/6fHjjhkRwfrDk+wMePsjbKWzTUNMFCeRT3tdq/dtyYoPs2iwgpj0tGbqbOQxSpDuX5dhxOjNtbOgPWRBwtClVhuCc38fU1pj3X82ikD5Ysf9vR5hr9ZlJYLc7NMgR4eMwiUerc66/MDWOpHM8LqEgB56VSRwnT0t6FLKUmGaockOah8J7Wp1kzJ8FIwq8y8tb8NgDoxU6WwKC9QFgHfQPwLWB3Y21YoHO9fktiaxUF60oVnRhmtYRC7zK6WIgHpJ9wTz8uPyQ00gYtVJsRbUUR7VcekxLbu5SvvJ1x/QRAwXIAwiTAcXLH2cpqlmGe47S2VR1N7vXz0rgm505+FNuzilRcr/j49uTZyxcXmkL5nKTpBzzAyRzzu7C8ihohME3EWKKdDGSUsRFSkcdXXIVkCMpAVw/fWBw5wMFMoqR0atrPwgTFMvqNwD7YwD6Jxj5xAQE2cF9FqyaziV56qXsaJf2kJZerT0LoTZ0tUYjK16uZ9vMoVXvD5HDo+CCO4+YRU1jN2Ai54+TQwpJFFHp3j/iKLVutykm+19qk8aTdzSNuBp/tVDmUl0L/YIkpq7hG9NKSKs+05uirfAxL+WSDp1k3Vp+ZW2hQvjMajlwqdPA4mq0xUx1y04WjbDLJiJEM7oBFOBfKjyvx0BbxDLMzLdTMXPvXXIbBiV9mdMLFmpkrZB/iYS6tYI3LHl1F+r65a/cpMkf3q0DIwabAPlBgm/nqp+Eye+DpAoL2n/nGC5Mn5e7Aq4hIjyri13ZOtGIxtyyeucCrFlI/Em+irGGn+yLK6na6txqymGEq4vVcVNIq9BrY/2Z1g10+YcHCwpSbA2em9S6s1RH6gt6iU03BvDdwCNU4ZFyApetsJcIaIFKjiiCE03sB61FGJzFTjsKelxkCsYvklyqZ34gJw5PcRqLEuSzyLeJNPY9ZDLfDHfoY2S9VQkKs1/6f6K+IlgssEBH6FH3of6jiMNtGj6KJoK2mGVjXiC4+R8PShpvKxONoK1D4o7zDHOe947hHkZyLGLGJ3I767pfoyESt6G10VLtCvRBa+RK9N8t56IvjfJGo84vUTHMc9xhMWZE5BBdqv43e11stTXbf8gK0UeA0opWr1DbqnDqOq/MN6q5VFgXWpcolKnB4OVYNqy8AMz8hdmigiA2sTdvlbmuZKX/17TojXm+KsJ4F7noqQ/6rv1LO/D///V/20uDpq9g6gq+NnqOXjnN59uj+ZXF+id5sFBUHkH1HHqYC2e4jG71Btmd7DxyC4eO2QGPtzsVI9vrm1/dMM+GSTNdG5m0AYwXn349Mg9tHf8Pg9nZzg9uh43wuu2XR579hKbZ5t96Dd3dVFkxbrJb7bQINYeRMW42cZRCWK8dJFout4/Z7+MWyleQoCckO7J+Sfz6bx0nKtUE5Ns1/epL80wot+3FNFG2ybiQgccWLjbTVQKBQ76nxlYjrgeNUuM0cqqQVxUD1ON+29Ha6tDejOXl76oZOCw99c5ytj8ukldLtMQhIwjVWDfoi84wjWT5zNjQO1/BYZR1+YhjaNjoqTWmV9XOFrSWFL59KW+w6mVSj5uSynGYvgfqXbXHVUmHgu8JHku/7g7Vvd9UOiNheAYtUDO2TPvhX7SurNCJqwxVSik9I3RThXyWDdStBxsmqXXGraUcReSiUVIj4sj2k6S4e3e59f12shsx4EdQ8jSx/edQJU9Wptie+QsvZSeKg6A3JBSOc6JT+rBtDvkD37aAwKVZDUhEahGVd/sip+tq9bNwnnsm23+Jk27ue537SKG+jg/S1NI6mLbydv7M3z71Vz0MdJkfouv/FPV3vB4cHHvoklN3/0sruj36A9GYckyEPL1aq98qhL+ej1vm/FU9Obe9r5s/NDnKXG9h7COv0n/Eoo1huT5ldeCtGWtF9LYc6FRF/J4rLW+fF1IPQmzpAFbV9FEf3kyTPE3JdCtO3AiTTZJSM4W/4Lgd539Osojy9RHnbGFkhHluJIKjhSaWaNJowxZ5nGDGI6BSRpnhY+EiLyDJwiqqzsqrZMFSGx/5Wqt74sV+feQTSzqZ4eMX8UD0cK2xttBXwnhLZ05ZgKA2MkZ+MOTZRwHC5297VoAkTpApVpxbOzIxyGfNCCRy2BovFQIWfCxYLl3sIH0hyZSBIPw0Oc9EFohXSiB8DmZX7PeG6miB4i/PG2VQb2IjtseZcVlRE5TZxnLZUF06pdKHq3q9qf7Pxv4yTdPn4eZtMtVk1Z8GtlgicKAfc6Cof9diftxUpPLTT3WyMUtQlbViWr4PrhStKwEzdFskaaBwYEFBeuZYhYu6Zq6WEsd9ckJrC+j1dMRx3qf3Rf8q1d8k9FdxITUJtFnpHR9IydcgYseTm1Ip+ksa9uLhiRjB+RXXABzJ6BuczMs52UYhr9XP7tdpit6J8G9RjwbVIEAnEMANJAaacN5dFtMbf05elYpBvoNYpB/CQ6L6yiuF951jOolVdualgLRv42/54RTsdiL1VrNeTlqUHWfoQ7epqrapz3QdWFtzgH+M0l7MR4CJ863oVja4WLnsctb3fURKVdKl2StinTQmqYpaWWTIF5RFdJXBd7TpOhjVdy8ioAJF5D6wQ91sC2SjfbcXG3JRKmwk3jm5a9BJ0DwcWnp6aI44gBbg3Of91BE3q5hSoSpK95o1X2qwKrHK8BqsAPsHDhGW0vA4xpSWxylAag06bSc6KlNLIsfqwaEFDMfD7j7I0jafcKT/IzSG2yBcechFM/z1Ab0lkJnEiLY2YH6ep1OsEajKvxOfi1qRsTAGdcfUYg5m/WIjXrZGR4jlOeZQXOAezxWKmAjj+FFROgSAQqS+DZrlnoqn3McH2uYxfM/PzjLKf71y3dGGZJgSbUWTWW683Qgg3cakIxNustQniFc2bXs/Ug3/zq042wQmhTuV+A3JChOqrQ2gASpV9+zgZYju0AYhtvOGYS3ammGAQMYoQ+/CA7m6iIi/GZRcc/U3llpiWhoSwhrS6JeSyGDsGnPHEMzbmvxun81emHHHxzkJsME1gg2FU7leIKhjaQLa0t/Vbva16XSkKlx61xcn5tdTkFLWYWYsrdMJOZo3NSyJm7sjN1T55N8Z2ZA8zpldu+mXv0qMCSOArJ7D/C3NrRbzHtmU/rhYD4ZhM4UHuvKUuDaX/iZhZtRpL0XVTqCHdHFpydxSqIZm+mXBDwI9DvUAQR6nf6tFGnaBJxXkQnwUBlzkQjRZcHEBDlo2IwvbiT4F+W7sxUMWNrFUFIyh1m1opVZCtgXITyJJ97rf69llT1wsrO9Vxtih3dLu6nYwo0LAq5kX/sS2+2RYG+Rys3wM24I/YeQ/fdlU4NrdgNb+6HZ8qAYv1CwMZiwgXKXeeEHK5mPv3ldE4eUj6SkS0s+55NXTp46uK3XbgeWqbgZDm13qEKmP3cwJIciv//IHRqJY9VVV+qV4uvU+v8I8napqWUI7T1uSSwlqBaSMVMbEcuNZd9VtpRwmnP1E1Uy8VllNbLLA57dah1ZTcK9F0TLe1zLDPolXjMGKEyFECkbjt3U548KzNnq+m3ZfCAuCAmvvla3UjLLWoZTwZLc4KMarE12ZoSS88sEy2Ri4jZweDECSN4RsBN81o7WwqZW5AVGBZHRec6WE+COR7gO/nP9uMVqqGG6IdEevRXIr1oTpkQI4/RGDLoBt46F9CePAH89DvXKYQ7HX3PYQpT/6deSXiYLBPIDKvVHJrqNYj4fdSzIuBXVL3KfmJPSWPH3v0jJyXFc7IudoXsPtcHOUVdCcDQ5r4ROZhT76bKF3/bhrN0jQfUIxJiBF8hAz5vk8bLj29pbGAldyUepJos3Oh8GwYoD92Wd/uQPt2aK8KLKwa+xdwJe8LRM1dz6hLff0pbAYUkUlodD/JrpIUc3oLmC8stEVMJGaDoPEry6ah3ZG/bMRDgod2Zzy0FaLN1kCMd3U/ToY4xOgrxlPJMocnpuycqr6J7jOTXcUA2aQBWccpSd8VOjICunl0dl7GfrYSYhHqgRXclnBDVtphgZ3kuxuiGGfyGFI4WrAuCZmJ1ymLCD3D3FMjb68cnVRjN8Yry9GfAu9ehWYgGcH2YxHijrdaqBzOVIQspFIGWdqxH9PHzCsK7DgqWYaI99RrmbqJscy+7+cP2DGJuWNkFGi+uPGaxV35ulCtm9ZSlY1p3nF66LaizzxjHMl3jkM9bb9zKLK6V4aNT2gDkZbtiHDw/GjlNGrD3Qzfspji2EbYQ2l7GWlkhZXcdUY3s20S9hPtYYwMd2Py9QCq4yoV5CqzlCXTFFclNyiLgBg7O1doUtE54g7KBeKPn5I+i+DmijnCzWTprF3/xhfXKh6WPnviJSX5nQRaFCzSXfO0evQkadhZeAhCg7mMCx+AB8Az+vJviH01z/6ZbZ9zrGc4lVJBPDeirWSb4La53Ds8yXFaR6en067XzQWReCh0hCsrgmoaD2FWihQqRBFKIldFbcFmLBxFu2lVI7xYqIIctNmvJ2Y2U1FdmLoevcWi3t4K9CLJJuOEtR0vY4YCQskyPxzGdNuiCGuKSDQDdJTd6Sit3mdVGqDcaHBols2hQmSp8K7S9hV7BdI/uW2wRA6zJnLQc9wKGssICi7yjA++E7/F7aiN4jS0SZZNMcHUIhnFI0wpvGA2QHopD8lkkAlTqlkOPNw2xQQsVB9gUkyyziSm10IhE56UiL/l5ORHqyb/NmPPJHuwhIMxcMVGbOyKqXreGpOUo2/hVZBMiDsk3VB7wSj+JEaqO27pKmFB2408XBqxPm2PVKkGRz0p1G2ZrNxUo+am0oOATaV5p4HeTWO6wdtXyBXbXsAKe53G15wJHk2EmAmSL4z00rXaOMm9wswSh8sAfUV0IBjHUKSAX++m+gK7r5SrQ9aoV1mfusZQXXLBapKLemzT2pUHV831Ndh+S/WDXJm0WHFEKgKN7zDNbnjymmM6T/BN6crrnUyxPfSsep26GBHF4c4Uh1trSeRcUHdmtBhf5zz2Hlg+KYlGYkp/lxxdQHplM/ZjskzgYMoD5PIkaCs3/bFxfGKZY5JIIO/bHTu0H7cyyUwBQV4VENRggk1dLKKUOTJ4eccFv9sElc9N3kxggWUrSg3InCUoXy6IHFIBlxi19EQe2x37ccYtnHg0bL6tX8HY1c/3FI+S2zBB3Kf55T/0SDqP7nPhgoqDUoCxEWyz8gk6QMk3/FPQovQ73tZM8beZVc7XGuJRQvAQ3itwYLYsu6Svh3QTR7aCnaTd2ZpKDhxHsEhHK4I0zTyS+EEmq0OrQITrIGIlLCRAa2p+oGODz8FMrOZOYVhRxuNVqYA32UTpY0Clx3nSrshhysfgnaaVQPgWB05S3+a98gd78ZBQbS2xjC6vsnQoWEwd0PK/BO5whVO6EW+5bMUWPCK15pNla76Sc5mQhCVx+kkQuoKE4C+WD3gk6MJoSemGRo5y9VJxlsGQWSek8JTdaPHUu0nRQwTIoFGoOLZqjBE2npXXG3B7xlnOQoyu4hxzpefNfHM0xL1QvzOjsAj2mfVznGPr44fXIXecDuTQeakRNN9gVGDMpJyJq3uMIfWyOsF0jmlIeVCU5vVm6DJDmIwxtoZ4jtOM87jj6A/syjIwXg8gWW+Y+xGQhfAkTlIdQjSPmGuLqJ6b04cJGWUXF5z1NQC7onilMUM9K5enlmtfv514buwh8VgM7YurNCZfITAgsq2O9Rlf5QnjcpXEcdY2dAlTY1n46D4pLj1QELmU/kriJAURyaP7rLgML4/EyMVnub3uNljINBlgEYr3wWupvUWUEE8x0Cj1FX4t+oAwKBut7UMXTs4BtF3aNGhqa4GqME4Arl64BJPxBY9LkF7RpfGcatgFwFUHauUk0PU7la4bPPaKTXCwPPdw2Mup3GywOwC+IeZLzxDHRLTERA3Db2iOkjh9ng3AAKO2sAlvJq/AIFV7ZY6pDPU7i3CbBthAJQsyFfRgyr3EMJ3k70bQUTKAzLyxrRIe0wOXrkdiHs93rJLUHvLQEBouXT+Ifdre4CQqy1WGa9qMV82aucG4WHV0B78/iZmfsHgy5dbimWu/IqPsI+/3Rn3+LKH+ULS2qWLPJE5aXUyPe9Vy6pUwQKnjtDlhFNPhXFxEFwvSZtDT2EnKWoU127xq2P/w3VhsJJpeHV31WmvXzaC5qeNsBtOLC5a1aYTO1x3XKYi3T2GzWtnIytV29dDIcUbtYsQ5bcyf372j9uPFDLufcXuLd80W1SUwXtPosM2tcwM4+JYNs0Fuo9XAGHoFmiwWQx0kUKClFxvJc+pP7trlVTV8qQeRxz4MFJ4xEfZnNHXBPxP21XZ0BXExzqT/IeybiM0F2xPqVwHFNQQ5DTjKNnqWc7mxVAFsAHWGBOIlHPqZQLxJeVziKqbN11zdaVED8e1m3D5Tn/Pi7whizC3CH+FpQrB4tAyy6V2HZZ1BmkyvspgObcQxTGhD5BYgccqcFi+9zIdip9mRKiTiO5n8Xvg+zaBY21U5h+BAN8mQjUM72LXRGEM8n9AO9tqQ4QyIGEGj2f+AodvoFqZxbKRIX40CbCc/CmyjLGOY2oVu+Rn9EfolL5OUYdriyVAeXS3YAHbrUnaasIcVbQnZR7HmuDY5ZMtNChA3JjjKUm5HYMuwZ0K5Vn6c8PDb/BjbozhJ8bA9O4mYL21l5WjhfJ+VXJnOiCd3xFtOS/PjUu4pm/dQVqapIa0Mji7lHWB+Hoi/9ihOcwwjTdrtc1u2gRjeKi/xpKWCpWSaSuId9FZ4Vyyr56WiApqm8QCPs3SIaWgL6FlXdxbw00TAKi5ctBsiFHOLSTnGVlcCgNGZnL9th0kpZskKUxf1K42aLkslq/mIbhRflfN320WThjF3bpvsPPC3wwwrYlpzrUMi2wZ1xywyg80R2ISViHsE9l3WZ4b3lYvbCSiZmklCO137NUlU3MWtxHHivn1f2GHSLnXERckKk3pRwLz8fQJXR8L9MP+c3XKPDNzbVVb1PCZYKDlX7ndtPrAl1cPl9XgVs6ea10JpX9CA7oiEcNVhKpwqgeyzfvKZdPmnblXtC5AgvMQJ4BLLL7uiom0OGIn5wut2CYyrkECaz6gA3uYLs9Gr26Jot1h8pR4v0Gqs9460IOdBLSpW9VVZDX3IyZ2m6FoPnajFpsYUDVGrTizaY2u9UvnSyK8KPhi0a0B6q9Ig/K8iADcKBkgLU9cF+xVnVzJ7YWglODZTU1zVFq7xeBsesDBqQxXVC4+YnrBq3r3WxQW2coj9qyMm8UjAEDn5GcUxfy63RAYeQGIlrBLnzEwjl/TJSidWGWJeyLyKj4aqa3w0ikiLU7FslVOxcVRVJ+WQc5yWRPFkackIj6ipeKvTtdt/Y/tOKrGTr011dB3vdAjxTq9VvNMNnpKlA38bNX09sZW+npjp62nSppufLunr4oLfqo/dqX4t2FZCuM0v5/IjNf3qvVvFHoUXDpuP29nSPpWtF9LBWa+rm1Z2WjwMcB2pttOuJr6qIh4mbUE2K1pUkz63GhpwT+dWy3REK9K2aJiw5WWq4pcaoivQpG8Lf+p2aEOiUktf7gd7zCbpSwguu3GgqmoQiCacB2rjj6rqsuNyI+CHxp9Y0rnpff6oqg0g4puKA7peUVdiyrZY/2LEX1s8DH6lUr/3HY2oe9gLtneVnsGXDTiYEh9VA5/Wr0y3i95R5S3ihCTTKWa/YIJpzDJ6MZjR9OIqzseeiz1t4Mw4/2AC0h6C7PyOsPj2WOFh4TBF+hGrL92Y+cNtI/AxyGbGpmqMDT1aRnhZlLO7FAPgxsw/6nnusm7ZGE+EFQVtYWmX2n/3FMdDSS/VO7aR0kN7AKsRKnYG2WQSk+Ey80AZnUwwVDdossEaeAADoC3KrlLGFBYkKx9rWfmEf0V/nCGH6RAdaCQgehvWG02P36KcXdM1KB1HVFzUVEU0xOxHhWVtJYoUYaX96eK85qqgMezvsAUxb3bxEBetOY62/5CdV6T3bVW0SqAxIlm3HFmLGwM1OYYqzbWrUa3H6LI5eyNBjCxc6tqcyNreCuuPJUqJ6pRqK3ye0LTCX6euiHmMshYDDnUC3m7MkS1tuav07xr2DvM1A0auJKBWplaT83WoqyGt0VLmOFk7jztG99XFJUgte1Y5Lbio2q2fbqjs3M5LEJSC0s8aKr8nlWffKS/jOC2J5vuQO8jlCpFbxmsM305jMsRDD1XfWfcqI2wr3fIifp2B6wqOUIQ9Q4O5p1dPLrDFouVlgL49gesqPc2436+n4OshyavHVzluUw7La+ByPbSUxQj31d1pJvpwW9pEPGQBJkxuAe7enJ93DRpg4Miu5POkzU+QfIqurLVYnFZeI7lfL1OsiO4CtignOB29Iy94JyFGujeG9MLXEBIWzsRbdg1FbDMUbFRWHW5FUblPGorjeliVvVQ7+IJfL4lNnLcrqLTsyspk6jDpr0emTFisbaZf214bAd9eYlU4ESuIBmmDpwZvhy0zMikpvoydq+xWme21Pir03i8Q3sz/bZYQLghAeDPLXj4M0RlYdDUHDaxX29L7V/ghbV2uVQ8Xo0L9KFR4PO2rrxdOvjJOW6xc6ofQvvd9v7DLo7MVSNGRoODk6VDmhY1DtxWUjiQBWXFz9DNtTfhevDZ6Bz0PfRBmg++peni8+Vu3BH8blEHpOcWl5C3AOMjhBw+Cw4pS8FKeSl2fR0mSWM543VCDE1VVvrqXQH0Du+IDP8YQAC8rIsov2eyp9ogDITccR+wf+eHGkc72EKknmNizHGK8qTWLHjPY1pn69W0O22SvDYRV9muX/mqqDLwKJdIkVKRnUKo9gxIDmjXWnRF6XfKhP6hnVd70J5ouXwqxJZ/jKRuHMxGPQNy5gwi7Ni+ibMBB8QU3OX6j6ANYnO56ZTBbrvK3t8PZbysyh6syJysyOUvxmusvy7ALG78WBVbSK9QiaE0bwZFtRLNU/EyTvI0bZKdJS8gxFl8lDE9sJDdHuTOiyLRR0lun8GRPU4pzTFi8LPp67WoozbPH0jxbyeHttX2i6lxBppwrNqNgMOoWQN17VL1LymY0iEDjsM+ZU5bwUqu9PMlvj5uqfS8IBVr4twFw0gTgyh5Ry4glDO8TIgedFV4VoqJJgOdwE3he98VrLbZDcSS51491K7tUp0jMUJrRhibebZ2hYfCf4uHVnR2OWvcH+EESkxqXo5+KRBZfvSJDfBvaXbtAZLGoD22qTdhcklmyRStWzs49u+a810Or1+Whk482nvywZSnLqU/WTL2Fmarxfu1+aCB887LAJt5+8HVgPLGf/xAm06kMo6gFjsueU46zLEfY0Bi5YP/P31c1vY6Wq3QZg/WpCvWq7jJ5d5CnTXO62ruSO+RZnq1NZ1oGvJF+oKbSWzYFbTVtNCdZ0p4EDfkmCEq6Uz4rxFdlpzSeYTx5seiKHQHU52ugPne39w81r/vnH7BDriWnLNbKpIbMtnxOm+AVN3sf4rPJLuFeEoclt89DHpuOW0yAXOfcq/d3VCPbZY/c9UVT2t3YwOKFuYFR3pnv+8r7eHWOrge2OsxD1dez6eZVMv4/4DxL53h4MrtiFON1bbYzVfgGV4ok2HEewtFo7WdFNwYDp2TScH3mZ4zR5GrGsGugSe/phoNZOXEG7m2LYglvdSXbr6H2RWqGkTXn8FXTcuYbWw00TobZgBPOXN84RyZBnZsUdaojfG0lnF24WKQ/dVucj86iZbPWjrsVwpmhFMIRCU8aEMVNUexTgyBGI+72tkq+j6U2mzThRENdxkicQOKv3LOUOKdb3Q3caRiYbdAXuC23kryTTfktO5Fbs8b0EABbJbPZnCkyaLBA8ib/AxBAJo7tTDhsXMVZmCqyK1d0Vzu33NTk7HVLTU74XSM3uNoZGmWDGdc3C6Ui3lKFz1LHc9C3/8Edw3RiSrObzmxqh9UUrunPJaaN1sam00nFtk9WOs9NwHWuUgOBYzlDyTkaRJphkcN7kHFsVkdfOew1kQVh61xPur/NPXRdCa0+9frTUPrmdcFCwcwce/2xkXkXXVfMMBaLee07QVfGOcnB1fvTKxFE+Fr6+prrcF4PQ8h5GUKuDtsREhyTpHLTpuo5f71YlHMYJvk0je/4Jr1Dil4I85KAG9QRVyteU1TheqxWJwe3uu3hRtCLaGOeXjvRsqy4JSopudOdt+61ngzDS1GHG581WZulDiqXH13K0y6RkaxlEulJK+LZfAp5B1ztgUSY+29sVpyg+3IF2+xDhrJRnJtAbPL31S1QJ17cxKvRdybZIrmPL2r7q+TnJ+YGW79pmhzLbklw8tvnqkA3DT/MSmv2Zek1QypxN4RwWKhW1y+fLFqposJ7ss64Z0apkyTsR5+udojVCOPGo2qBZ2Aym4R2yy1DRZdeq45zfdEybtgKgypDaolxaUcf3+gGru5BSI7IUyOsbIY4aokrC5ugJP+AR2FaherMRA0D+SoYlbtmXC7hsNwOE7Ayvq7F9a+gnHkFr901MMpNE6G8KKJrGQlVRrG8LaI5J4OyJvFTj5Z6UUQDSfecRFmbRd5XlTwV7soSLsY+UqnxcMhJtTh9b+Z/UfnlhbFYxOht1Aiei07r3pzUq0BE0RdT6ARbUTQA8phGZ/YkvjV6Q/YkIZVvmK/w8W+4fleueYQK7vtytmUsQPShEhS7NL6xETcQPIdgn0tLmHA7V3fY82jWIPHQ62hWNxh8Gc3kEbU99C2aNc/rR0g0gi38Fc20ieGnCKitjS+VNRj2uYFhJwCsRytRxao8+x6Op+/79upihe2htCEN/MRzPa4A+Tmalw9VtfnI3buRrWOXNgtkBJcFfm0pQDIdrfW36IvjbAxCdcWmjiObevSI4pHtPaCNcUKAwV2t76GHjUGqjhboi/cQ1MxbaFn7b+i+vMpiedn91orZtraGi8Xop+iuebU+2ui+v6LxAPNniyUjoRdiwyzfCas2XNVEkWDa0a1uEgJkGUQqoTBO+mtDgKw2RuVhHMSeNwq2hylZFnXktTZoPSmUB1b0wXHWDk0hrA433Fw5uIrp4YNG99c689gPXoHeLBYf4PH0fj08pwrlrQGmQuOrRytehQpoXx3nqxmhNefKaV9XBkxFrIyX6m4xdZ+JGxpuuhvQOVcZN+qihpwXXuG1PP9E7BREolvXa41Ri7KaP/W3nuO89SVtnrtMeGRSkOLhZ84N38WJtGCrtlmmG7fxisAoxuMgMVxlrwA2g0420ZdjMeg62P/Viv6W7rSXknXLHybirHce3ccF92NzwaPVFuga3avphVmdiiuvOQkJg8gBZmKFytOyA0nuPQ6EyrRJmIttddG+q9uRV7k1/+e/9ca84BuSRllzK9K2oDv2bQf4U+BGk+kt20U9FXynDNjUp9zSSv5xvVD4jtsgMA6qYRJOQq48lWzVak65D+pW+B05ztESd9ubwPEn67+sf4b292yl6n7ZCtZumCX0b9vGOaptHBmH/fvmyEkfq/PPlbOEPfRZbofPmrBe4Y2NFT8QMpw4W3aKcA0YGh7H3wcPTultAo9jCY/j/zQ8BC36YHj8+n3wIBlbB40H+D767klz+rp1wr/WJxwuVdhfRjkO0ozzc+H14KHT8iIn0WkTb5JNY55/bMY8VzG0mI6dRc3YWSWJUoZDVzLfj3TTwHs19w4VVS8JNCWAzCp8ghhJpqjJuUjLxZhVWA4g32h5509VcsLwJLeFAWD1CZ8C23fMiSQjeED7cz0ynuvCAkk2XH0nI/nsqRKcjdf6MCLLXt3yXT5ZXqL1XX4dsabPHtZ8dd/pRO61h1We4Delbyf0Ihp9x/PxO996ox/y1gNHNpKZqR912U9R3HzP2SCUOOdO5Ww0Ninm8SZRAZcM9epHHDQ06C93+DMovHC8aeCOidoN2g/OJp6C1r2pbtD9hg5yPPQQLsvdOr0FaiAAiY3V0ebogrcq8coUmUhd4hiBmJF9bpfs5r9opBfBmtJkkrBkjm3Jef20KefV5LuSVZHD2zHbUKrimEhw0NRFqTM7p6WEmCwWWxxheGud+AjuaIkNla2z/B5ldBIzW+Mo5Vxhoj45/xuEca3odN6Oje8ivj/I74Bq0dXDcS7vFSnUKwf5PbjXF75F3mbMVd3eQQALl8HmuFmLmF88EDHfNp2pXUASiA8UHj6Jsgoe/srr1DD3keGC7Ut0/Z9Dztc/BDl/Vcj5S4mcZz9Fg6XIuSlJGmxF0WyjacChAb+KjjP7Z+A4G9YQEj+Y8KZ9CGNkuL/Ac9mmleTuLZDtPrLRGNke3MXmLXT1N26hk4feQn9R4/aZOs5dORIW3a3kHf07RzJvXHG3+h6cqyvt5kdcaUfrrrQbr0AvgBHmwR06XHIBrxIhLBcKNjfJX7RAgHRD6cmcRsNNuCZrjmh9Jar9rRiw/T//Df/x8APgeFcq1NO6CFcsyKS5IBdKPXdSD31rukt6VAp+V28SUqyfbDk5snJuzSnJm//zRsajGTmlIP0WCkIYVIW4gwXxzRAP645lNkWYCD9RBI3j/GOOKSi64yFEh+dOi7i0s64/FjtOtrGJQtK3Gb3rZDNmXTHSEQ4zQ5UG2tP9jePYykoXF6V7CsMYrEDKmYQXfleTZVsYvEvSOythFgzcbnUyvNkwKYC7bBkOEl8BW3uC+ExbQrqZq9j02VVb1tYCxjq35KuF3wpaVn4rUEtvxKI43thh79XdFE4FRkl+IoKh9UKmWqQoTvMMdJraXbnjVaE8W00UWWuI1qa/ROFPtTOl8fUkho2HHlrp4kIFmF2S3unEk6vkepbNWmU/q4JJaGf/H/nKgMNGAVau1G2VGpttDKfpsmYH2RCrhmVgOq7MEZOhZa8unk0xiacJLz5KcDrM4cgB0+sKW9IgRYRMw1YeT7Al1xriyL2bYvLs/Stj1L71PsVxji2KJ9kcWxnB4LkVKovG/VXzsk9m02lGGR4qyKshxRRb9kbzBqz6f+ye37X/j4IAG2c5ttg4ZtYkZoPxhiAJrW2/6xMOGXfEw+ZxDQj0oPpdqO/5NmfMscWCPnjj/7+y5ydJnoPXxv8bO94+HcNuzObJEA+NOtYww7kFuxWe6Mnozoot7uupuoFW7zq5ZRMyTAYxw7qJlp1ebdX6X75f5c113Ly5SuQOLejISvxL3gviThHB0Q3VOgGn1fGozADS6yM1yCYroaog8HbZqboFmQgFQxF3ebOSnIvrAbG4RyGDDOlDLGR/SvEcXBYI6CipG6fhWf/yH084xcsTVyttExjxL7Rd+WjF6RJa3LeTlOShPWZsGj55cnNz499s+xm9ftLrdrtPeBle5HfQy24rFxweHj7hWtsVt0r5/LoT5zlm7dccHuVLx3U3ucrAJhXcF4OLObtrda0e/B83OLNnBAKx4FbfR1Ou3nU/DO03wa5/YB0cBzufdv29o2DH6vn73W0r6Pl7e7tWYAVdK7D2/e3tHSuw9mTunrXr733aG/fmHf+gGxwdWNv+/u6OdeDvH+5b21BpexD4ve42jMnieT2r5weHh58OjncGHX93d9vqdgLL39vZ6wRWwLOC/UHX8nd3Dv2d3gGkbR/6h7uQu93dT6HMvr99sH+06+/t96zgwD/YC6w9f3fXCg6tfT+wgsPxrn8wgCasrhVAMx1oxQqgnY5uZq8D7Qz83d5Oxw/29v3D3e2Ov78rfkB3e58OYUhHwb51AGO0gj1/e7dnHVgCYN/sVnfva1fl370m/0ug+yboWQfHB592ebEHba/vXRkloVu5MDv+9s6BFez4BzuHg46/s3cI/98J/F5P/do73Le6r2GJAv8gOEw7PX9vNwDM31tZhWcZ//ACVldkpz1/f/egs+0Hux34ech/9gZtlQ5UJZ1s8WT1Uw/wwA8OtlM+vM62390OBqtqWGroOl/sAhgdHxOsUrDbs8rfg6VVvv/8lIYt69Zq2+/tWkH39Z4fdA+tnr97MOj4vb2Djt/blz/2uxzwh/uHKsPf7wb87+HeodVN9/2DbWvfP+weDKCE39sP+N/9LswEKqYdo0xHFYKmA94Pb0f1u3cof5Udv1bj/AEQkaY+q8Gy7+8EBxYHysDv7fc6akLix+H+odXN+UT3uwGf5B6f5GH3wIKpDjh41CzED15JFuroQga0eVMcGAI6zS75iQFQpHyAnX0/2Al+BExm0zUQsSRIgh0+xyP4hu266wf7e4AHg96+8bV9uG8UPfD39vj33o744O30uvu66LZ/uH1ovbaCrr9zcCgADzW7fi84tHb9g53AOvT3D3r6924gS72Gs9LtqTaOAFVv93QH6mNvR5XTw/IP9rfVmHv+dhCUH7sHgSoIg7L2/f29fet1DQp/PgjyO1ZvR0Beqmuv24SH1v5853jXP9hOt32O2HYPXx9Ye2lnzxL/Bf5O0IF/XkMpK9g+7gWf9r9957CEWGLdqLpWcDDemXd6487OvPftzba1N++Ng4NPe8fb3ybb1v446M07veO9ee9BAwl2rWBP3jI8IEOzIhCZjMYkBwECcPVikqcxw24PWZ3AWzHyUZKmof2PEf+fjeDzwyzFoY3nmGTDoY1gbrCJxjvz4Lg37wTfJrudvePePBjvftr/NulZ258O0s62xf+D6e/CLA+/vdnxd61DXrDn7346/AbN9OD3vAMtBd8mh1YwDuZwEXV7PqcHAn+31/G3/f2OHxz6AVwiImff3z4OOHECF1TH39ntBJ3g086gC2n80wo6wXh7APcX3J6HnZ7V6/SAVgnETX9wCBf9eHvAW7ECy9/hBNF8d9wJPu0dB/PDcdCdd3ow1N3xgWhb9dUJjg8aA8jL3E5gqWHwduHX8bauoTK/2VLtibtl+hMM4w/2usGhYvD9sYETWEypGX3iQc4JqWufzK+fiWcDKNxSEZDpqLR/2+pKD4UyltN7zmoQgQ94TFRaiZLKy+fa8kompJDwIbvhPr11wItBREs/3Ftd9QKd8mHx8C55bSDc5li6laxlcdPjZzM2zmjyDf/MSC2fmyKLYVdzuKB7IkKVStaoC4Jrw/ePGNc82hIWpyeMcmtRVo/BwZu6Ei65QT3VCOdx5zjuVfT9Ya0aBWXbK8NlLK0ljXiNeCL/d8ZXj9erXYe+5AMD/hO0Y9UYl60uo6UinZ6V0KbgczMdCKRxzl7AnnM9BEGkpca65JzZYHbzdFNAWKKnzkB09R8HC8WTjGFLdD+j8TLgaPYZBUYRaIJcOc68fcVLZ8ZvsxrcNVMPOE7oytvYJZZkn3VmyXfD6Mor7aOZnwvs4IKihelK9Ta6cZwbLnlFF9ELx3khfp9EW3B28WBGE3b33HS18PTHzIIsEdzyWMta5DJRIpfrUuSyZCcXy7Y4Wu1ePyFCcQDYwqs22Qzdr4iXk6njhG4Xi4vF4mQDaY5YhnWdNirkrQF8bvtN/02GMVTT1mBkZjeHOzayl3m0Hy4FcGtxE4JoiPOvLJu2AjNZ6pX777aca6wnfev8C0iI/f1dD/0u/Dr+i6pzg0mkHGAZgYykB0mplXZfgL27WB27oh0nrunQjJahpOCMbBzrWAgjQa0NeOWvzGDEyuczK8dW8xHbpy7zQh5gCjsOdSEMdDVUvVIql4NlssnS8X5T7W5EhO2zYDq3hyjBjsMdz/RxVflN7KK0kS+U5XgupwhmwNzOXIy2AnQPV8ZJkmLClF+EQZT2Z27evwRPFsIdyMWj+xRseXIIgFtLvvTCzLWNxJzrJZQm6oPFwh1E7WXaLs42jUoNQQ6fGsxaoKpBb4BSyyQYqcskMFEULiU/3AN9i0dnMTzHgZBhpSc2M89cub52Wg/F87PuedgWZqga66jqDVqeoqZ/I1DoM0fb1hIPJL7KGZk6KaU/T7FcBBlGX6ah5dotTqUFXp+a6ptqg9dzqwdg1sg3DgAaNHITog5HMnLZYuGyCEydSUSEjRZRNlpn50g76OYO1sS7ZmVI4fJ6IX6KyTUb920jpIzyoqvyCKTFaZrd4KEI9hOCXmF+rmHMM19MpuyO54dbmQHLRkC4cnkLr1DPmWSxGMDey+jkecxi2H8Dx9ly7ZcqJSHWTUKG2Q08cTC8waYzY54zY+fOWm6+exGjjhdB3wOBJV749USnRVO0/TtQ0KLn1HFskCPeZJQ/JtJ++alC533PsNQaTBLymieHXTTEV9mMDPBpMsHZjIXbu91K+L54k8lIxERXICZC/m7wPTNIWsNTfiXOHPcI2RogIyOvGJ40gq3VApHRii8UUkRKO1qlQFmXCd9m1Xh69Q6FLsgr7hHVxc2uWKUruKGrXVF/iFPMsLtBX/FwKDtyZdw6C0cD0gRKzUk0L66j1wktfx05z6hsYnZlBABXcKt7Ij64lcNtIu/7VeNqb890JC0KDoir5qmvUTn0zdxLq/vOaOgpq7pa5akNh9Iq/qmHVIfVWurWrFWrD7LVWV7NrEvfS4ZRl7y0VtNgWZSJWyFTt8IL7jeln4Vn5/L6Suu+VHD0T1tSPFEEGCobWdgD3lNp8F4trmNTYp9gPPzAT0HNah37fMQeUGx12KJptLXlDhxnINwG6R+u98+utirj5gDKIIxfsefapqKSxy/Q86oFmMqTl++5YWwhsqTTajSPtgJ0F+kbY7xYlNCIxo5jXyUkpnfwNYQ7eOw4wz6YhlVJzTFQoEOgN+2rLEtxTOwtUT8GZV71Id1NbPGO3LZmLj0PXS8Wd4uFO484G09tWhyx9bc6Nm/1bMXlofMySJvMUpZMU4jIKi+SgXlzVy/9UfOep5tc7tw2TsUv3JyVADHDOuIgdAQwCzTluvQDueUGHNlTZe5C9FavRiLzfV+Yfja3NPYTMsS3URRRr2oNqjfy+bohgzo4RUsGDhuxA1sPfK41o9SQMgaPBqQieM1rjU/TXBmJF2rOp1jhhU1l/+xv99JC2Vy3Pon+5mQk2qtpt4sHFlwMS8IkX5n75hK0l0FlO59YrSvRkaqcj+5nzXMC7ReX8qzo/Flol5pS2mFuSQa4FOL6Wx2uqKU4Jz9msPFwaD26T9eMVOen5kj5KCUBUSD72RCUZ8f9S8A31iWU5FixVOkmK2i97N/3CP0bD8fqE1BtP/0yN993tNxy7Rrci4WL+SuLRSLmcJ8Zr6wVpH2DemcrELDOYyX1jh9EvdO11DvR1Hu2YkVj8oMiyOPVq6oYBH9jYWtv+3J91Yxpy4udGK9EENstFu3PyWX4nSx7M26+vC2x0HF9sTK9WPGKxcp/yGL9UM6KXAWDWjU5K2p6cfWsRXUnM2fnnCRLIuI4nFTrkyZ7JY+2IG5+hlIeD/1MevCVfnvPtfvmDfgfFfY6XbGSOo+WB1WaNzHj+oprVFLSB4IjOQ/TBrmUr6aWxCbI2zeBoGwIcEk5fV61ZVRnziDRpelXX/8KJbHDwU2jJvHfx2EzUZBBfflXs6S2wHpFCbe40TYcN1EIZdHlk0f3uuPi8ukoo+5TRQXrxsnTksbVIyd65PpXSOTIdYB8YG97VxTHXyEteyw6LC7RFpF0m8gkkfwuxFAvH91nRWg9uifFJQROEmdrthkbQ52vtZE9Gwdq48juSy5D3dT6GAyNw7nk0hPdZRE2g4mrM9M8pfCgpF6ftt2EK/S5s0YsmXt50PTxKrz6eUuJSz3DiWt5/DgD4/03r3IAyZrI115RjKQhmTUgwAyQs6w6TsNeH/NpYq9feT9gryrR0Y1NiVgCi0alICcP759Np+EYo1jqWXAx4/tsOpuGwzIVtC/CCUat2hjhtVHwnVIcgRpzkZGHd6IqkHbhlUjkEvvwBqMMvnrha4ziafIbvoOC4QuMruI8GfCPW4wGKY5p+DNGaTLHH3A+zUiOw48YSYlT8g0P+eUZTigCgWl4Q1FF7yV8QVHpvzS8pagWihcGfAHUSpoQCBOfDGOW0Z/j4TUO/8L+n6jUiQk/4/Ir/B0jPeuT2WQS07uQskaajKdPmjl8QAlDlShZ4S1DVE40Dy/Kj/CIIQUB7RQifFcW4NaF7xnSgfPz8Lnx9SG7CT8yhGWQsEcMjXE8hFKf9UE8ZmaY7vBPhrI5pqC3Fo4pGmUZwzQ8oaimfRM+o4hH5eZDOKJoMKNp+IXqaKavKKrr+oRvqXA6LQM+hW8oMmMQhM8pqrhECE9lQvia+n+Kn3n4M0Xas0L4kqJ3HHuLz28UcWNl8fWRovfK4YdI+QQpwglD+IiiU3r3ir2bMcEECD9TpNw4hH9CjxBn4TX3fh3+QVGLElV4rJNPWDyZhr/Scs1f3LIwZ5VvWJGUIR3PHIq8Nr5fCe4mv5vDl0bd0/g6/Jex+0qgjjB6jvEU3EaEv4mT8JGm4ZX4qSJFhNcUaW2x8BeK1BpcYPUzFxRK+LWewomED1j29w4XBSIVzIILlFUSWCEx9hnFKhwpwuXPP/Sv3/SvX/Wvz/rXsf71i/5FEUH/0l8Z+l3/ZmUHpPyZlT/j8uef+pfbRYlO91zvXJA7s1ZyB9g5IwIBGPd3dgPvqca5Y8C5cs5TgkZEtXjORftDqLOzu909kCTT/S+vTi+ev/pw+kc4IQg+jt69efPqNLwm6P2zo9+e/fLi4tOLDyev3r0N5wT9/PHV6+cXp6/evAjvSBHd14vYO36w6+/aZkv29eBg9yDu7dmo7Gyra7Zlf8ZDZHUPrbfZ3Op1ez2ruxfubofdrvXLm1O7KOd3RcpQ2a/9P31pC5ZH5sdicV8gM8GXajcfk0gbpM0Juk7YBzxP+Oc1/3yewImcEHQ1S9IhPDBzfpzuSKGDNN0Ps8lFMpSOjrLJW0Cd/COf4gGoPwAKsm34k4sM4bk+tMtzbKNKcBabR+xDk/j2uXDXg4en8bWsLdzIiN9zdUvA2eKGXXn45IlOVRP1k6xMtOWF9wEPE4oHDKpeProXrzs/zQZxaUQ+yNLiyZNm5jjLWdFSJ2ZjEk+wn8+uBGnsdlHM3XksK6pde9hPgLHyRIytQ+XgfIgbfommsEo5e2bSCKBCMpDuje4LNJjlLJvAL+niSCOlV8IXk0j9IEJlPJeqbTxH4qmEXMMnk9j3RWk7L+NrvCIM0wGesowCpw7rC6+RAf6S3sxYzIDvz+vCFm+PJlpGKlwW4jJYHqsnQDXXTFsi5SibTDJSTb9J2PiI4iEmQLDkoXgbqMmdkGQ6xSxvTlvlhPfXmABM4YKGeOHpxVWcj0PpXscefPzw2nIhybNRfkdYfBtC9EvwjM5LT7MbTPMxTtNanfeQcQIZRs2ytKo/mAxrFY/ePG/0VShovdD+tbsojcn1LL7GuTTGzJXl7snsapIwQRrl4Zl9jZmNbGCCIHuawSm0hfTRRjIQPAhMgGCBAqByBLIVGg+wfY7+mmF6J5REDTByK3qWh2djco6m6ew6IXl4pn++E62G9+IbIn1wmsdO8XU8ADZ+IkhMLiuDDT4i8K9JQxcSBseKgAtF6MmYiekzHtTdjq9jhu2i0A/Q5oD7/NHw8RfP9cL7onz3+BK1PRXQsMoEUSSJxoK/TRFGBNSz7/O7HMjte3VIE1/+KjQUEl8Cpw6MxK8mIC4YC3kfEn2qv4mMzaUQI4gtEq+Q6Jf/K7BvmPgzmhaNbZ34tZQCJb4Jc6+AZ3MtDd7ocPEMwDtCLU/QfqUfDnDz9e5GhVa4EyivWgcNPMfRr/Vq3tng3HEk3FMhIjwbnItbPyL4xvrmpt7TkU/xdZIzTN0zDT4k5McjEib+iJh7JvHLDwndRLRdgBAHGh9GIx7ci6+j1lmfKLtqi0XDWgC8a8xeZ4M4FVuqvzqb7zBE1cZhKEGY39WEu5aDCMpU7bIo89DIz7VDOxdEImo/qTBIoOTLw+LDGd/iKn3uFoFFd5wGN4XysTnODJ7q/LenXtTusBJdaTYdxgx/pCmoB6K2vNcVnX07nw0GOM+XFD6Z4oE7LXv1vLBaDAh9mAroOPGxb/G/ENxx2cB4AQ+1tyNzPQ9pcHpDX3JFdBKyn02ntvcUpzm2kpHIuEiGnlSiGGYDziMVOEOtaVnsqW5SNVXwpmAtQGAoyy0WZgJ0vFjAvspSyQ5z7ZOvcAyHFtVXpEUy61LUvwR3DJey6qV1E+fS9UOChyVHZlhwN4FifywWCvN8pNo997XjVKBV+/S178Sf7z7StO+uynXvAbtcIwD2B64/LzIB87aQDonfTGwlJRK/JbVAEw8NvXDiesUVUfgzuo+nSR6OSYGuiDr80VDT+0rRnURXpPBcoRon8+AbuHv/35Mn/7CEn7M38XSakOuPH15HpYp652pGhin2v+T+JJ7+/wMAKqNz7MsyEAA=",
+	},
+	"swagger-ui-standalone-preset.js": {
+		contentType: "application/javascript",
+		gzipped:     "H4sIAAAAAAAC/9z9aXsjR7oYiH6/vwLI0cmOKARQSAAEwASDOLVKJdWmYklqCUKzk0CAzCowE4oMkEUROE97PN5nPHcWe7zvuz3e7fHu53Grf8j0/QH3L9znjSUzMpEAWSUdH9/hByYyMvbljXd/796pVh7HvDIPJyxKWCWMZjE/D0QYR5XFnAUJqySMVZLL4PSU8foyrCciiKbBPI5YfcFZwkTjTdJ4+uTBo+dHjxrinajcufv/qs6W0QTqQIIwfO3EJ2/YRDiUiqsFi2cV9m4Rc5G47saX83i6nLOhejR0PsoQ9h1TZ5Z5ymZhxFxXPRvB+XSofqLRmDDsb2t3qJ+NIzWsL54cpYN6KcckWxS7v6+ROAsTgtKx4mvOxJJHCGF6eH0R8Iqg1739bs+X00APr50lzKfg4UQ4A8jA6d1foNEvvr0c38HoTXARJBMeLsRqGohgdXGi3vDd8JxE9K7736FvL2sY/eLby9UAD++ekpDeHX27bDabzTo8vMfwv/dYvuw//nbZUl9azebDb5ePHz1+PL57Gp6TWLXqj2vYv3t6ThI6choOce464zXZ73Va5R1mjZMrwZ6y6FSc0WyFsRwqowESmHDKRs0xiSgbeeOBmo9K+w7itQjf7dSjNWENEd+/Euwe58HVZjWEk1hVldAYqlrSeOSNyYRG7LISIntnEY6vsyZYjUMTfI2aJCFLjMmMNsmcLg+bw6Te8ZPBLOaI0+aAH8wHvEY7mNFoJBqTs4A/iKfsnkAcjw8OvP6qmFzz5IfW5ocWfOhuprfxmExGs1ptTNnhodd1W3t7VkLffm/t7bls0KKULl0XlXeqVdqnw8NOrhI88HbW4jW3DK1TOrLDw9bOPmOzwhNY1xmPz8tXFiZerW5ERWMudxAJafQ7bRLT0Zgk1Ou2+22ypE0S0KgeDpYHwWBZowmOG4tlcoYmSJAlWdaSw2AY+MtagtVQwyFiVIyiujcmOisfscPD1rjGR+zgoON22+OaQ6mDsQ8zHMq5QVCkNT446ONaSWmvKYsfHqrisqaWrsnB6ajjxps4jJDj4PXADJHDeCL4F1JnGSl4NHWqBgR9EUaiL2domP305X8SU+fe/QcPHz3++JMnn3729NnzFy8/f3X0+osvv/r5198EJ5Mpm52ehW/ezs+jePEdT8Ty4vLd1fdNr9Xu7HV7/f3aXYcktAlnRs/yIDlYDmq1BPNRMqbxKBmTaBTbC53gMU0GZrUqQXagzUoNwhliv9M5bGJxxuPLChzER5zHHDlPootgHk4liIhOGxUFHCrny0RUTlglqJwv5yJczFklnlU6DtZATzTCaMrevZghmE89nXVYT+66iFOGyYgTTillw6bfqfPf6YzXaRcn8uxH2bYKSQwwDGAFGywPItg5bRzCKi9h03ddr9vzvG6/iWuQVvNg5d3uXkumwFaG1NYYk8TsAhTTEB8een29A+LDQ6+V/e7qn922G4/TDZFkGyIaOXXHnucmHtNui0Qj53gzvb0m/V6342uoVoS8kzhKRCWiHAF0xiSkHHU7exh2zOa1eHR1fhLPXXfrp8Ys5sPsJ3KieMreJI2lCOeNMEoWbCIak2Ui4nMH+9FyPh+wxv3lbMY4DQhrHM3jS/1qn/KaqFLhukjQZjojQSOYz+MJqgkMIOLJ86OXjx68Pn527+fH979+/eiI7jX16BLa8jq9Tr/d7fSy7biEisMZEoeJtfteBdEpU1vwZ6/PWOUimC9ZxflZTdR+5lTCpBLqfTmLeSVeyJqcJPyeOT/Dujkm75PsCCKR9vmFxBoaCRMveSximLoXM8RI0FiYd0zY2j4y6i4KZ8iJlucnjGczrrrvqAOSJTNrNK+vFvZgTN5KwE+X5ywS6XGC6q4WLD1tr9iEhRdsqlJVyz9LhzFHAq/174nuYvEQ8fLeCazLFfC4jVG4ruNUKWWrFWLUWYpZ38EALqpBI0weRZN4GkaniOHS0TpfRG+j+DKqMJ3Rrzg1ZhaI0+bqSrY7mDPY/EvEzbeQRo1LHgqmvodVBTgiGjUSwGRRk4QZmI7WOtsMyaVWW7cRJl+G7BIJvDlYOSsfIUGy/YHx9Y0bZ4FY40RWThSu9GI2S5ggNuKULskZLA8UDmcIjhilonyWYE/MQp6Ikg0RFTcFqajRkYo1VP1CKjFXv+rz8C0z+7ywjZxaugkGehasqvBqJVz3IyTMQO1vOJ0HtbegfNkdeHQWcDa1SrqubGcjfaO1zRzljW6ewu3HTQKP4mmL4tyJU/VtPXEGPouGrOzFzHXTnyhd4SqNXDeqwkKn0BFwJhSpjpu9XdiHcJTUYJGwdmFzNUXmhga8e4kydKwJN6n+tlqJxiReXCFOmqRJGCZ8Hc7QRRxOK03ojKlDlTVTly6WSOv5JmtvuERN7J/p7euo7jkUaoNiriuXqBEm+og0gKBJl+rMJJj9H5ov4dYto+80NY/qrSHilzw8D0V4wUpvOzHazDguzH1pnhQiYrUy/42dywyKz/Qe2Vy18g2vLsHtN0u6o+HKPWj+RFdu1l+4kwzJBn0nS2hm2PTlbsZWTthb6V43+y7LWtz4gMaoa6I5iA7YIKpRD/NRpAgVMYpSYpRnbSysO5AdNOGkZID6gG0ZvRNLqC4HHS9FEk7l/CkIVTmJl9E00TOYq66G+GrVxNtqVQO6qVY5xvRio+oUA57suulvPty4ofwdH4FTsplGOCbliFCUR4SibD6nKbJGt2Brzj0h2PlCVERckYhhIJg+GZV5wE8Zr4izIKqcB+/C8+V5BTaQX2m+c2pJQ8RH8ighr4trTgWmNnEykLcSWUfUqDaBp85rEzalGMFqVbz2sqLZkg4s1Kl6m5tmO2K3A14Y2KD7WIAKP7Nua4M3pRR2RE1LiU46bLluFbZB+mHUGkuELXJdSOdmoE251UJa9eTZGgxwchmKyRli+HoSJMwJkkkYOr78PQ9EGHn65SSMAn7l+Oa8DWSqxA1987PeT79/hwQ266E+T5KWyTlJ6q2slNedM6sO9aqrad3RDZ2xd1nbh4eHnko+CRLW7aRfPrFanbJZsJwL376IomHd8+2uMYocwE4bIn4aXzL+IEgYAkqs2lxnG+/UQBQFi6oezC3KTulqxQ6aWLIgmpiwQ+AgFu5fJ1+Er1Y8l00SyHYC4Qe0mS8N46ZNfEARU7+yz7CYYrVCIkXX05UV+HpjBh8pJqfCT3au4/NiTr1B9OeHxc+79syTYubC6r3b6NUH7Zl7VjXWJohuT60IPBAUiZrjFDdGlN8YF2ZjpKjiiI0H8I+KER8T+EctUHqp8pOIhBKONekGplb3BmW0Gx+iiHLCaRP7/DCjqYfcIrB9flBP3/pyS1nvmHyDOK1xtdfCYdM3Tdc92G5NmW7SahwTfpj17to6R3VvkGWse2s2BxnDDPGDpsxXzWVsrssGxORx0egaIxHGxILqLAXN8oDppuD0PrOmsJQoYKYkcwFLICUYZHYnZlee4V0Nw+Guz41JMJ/rVfdLM86DRDwpyQwdH7Gx6XrpZrwI5untYW4MNT64MDQ+bqFT9mRI4AQcM48ss8siSCdvYJMHkesidbwopSii+g6OCvsdr1b65FFKo9UqPXrZaz19xwpLMEhda7Vi6e9sPyS0RZZ3aYsE8I/fpa0C9yLFJIFxmAzhMPmiwVkw/eJJJLzu/UeI3UnwWu5HDZHr6jqLKR/EB8tBXKvhcCa5yzGmlE4QI5IPGQ2bflzXPVUpksEQYxLXoxokBOldcSeRG7vu6fmK6xTKEmhOfgGkGPFacLiUJ2dZD4B1xwfxIW0O4npd9Y7TarOAyAaDyOphLcJV1UfoGIfr5YSz4C2MML2447WZwWy6nprVh1LP5TZBQFw3U5ozPaN8EAEA0ZkijA9DOfAQ+xENdf442yvQVyVZiQ7juy01SXdbIL5pDpKDSDKfU6i3CHjCnkQCsUayPEkER607CWlh4nXlGf0GWtQDSQZixGsJgEWTko3o2BqR+vgp+g4xko0DEyG/Z2XOS8rYtLahNkbjdBWUtCjFF2s1jpniEEuyIi9fSXFRtkZss/mTkuY/Kcv4Zmc/Yd/LrsFZTtfD6rOaeNNn160ixOq0hQ+aWC4Gp7l+J7BP+eFhH7jKv9Pa6xpJSIjNrygdWby+YY7fFeRymjbh1vU1jPKSIiBPNpI0Hw+qsmp/ntZOnwXirHEeRikYyTgoIH3R2CtTyGt4wAfZ+opRqL7HFNgJJKHssNXeH3Z8dthqtYdtnx16+96w5csbNqwlB5Rnk74kwUBjTInCmCqezw68FtyjMWVYHUqJmFRaPof2at6YeK0+pcjbb7nyYg0oansuwyA97LZdToJDr9WTVQQ4V0c7qyOSP1rFyrK3SFft7cmqvdYKQeWmlYgEh61mB5oJDvb2Wvvd1So43Ou1O21c1nSnpOml/NHe3YfsbVnoUT/tke5cZDq3JMFhd2+vvee6wYHneR3Pa5lOrdeKR0rjIYop5GrDBYb92BQBqAs/uyRSuzYG5L/pes1WeyWHCjB3r9tuNVeQ5sY4zYlJWKPJuoQPXORASFnYAT0yQEpdh3L3PtBnqhEsFvMrpL4QoWh2Th2HAEyX+xE4FJjX6M2l9TmISFSjRxmE4WsU4TVrvH0WvNMC+YQEjddfv3z08Pjeq1f3vj4++uLlyxevXlNLPUHwKz0gUWRie5gwej2LY39DnaHSaa3XN8lGyhCcbQwEAGGk05IgYRbHCK8ngaY/VCtVb71GuHw8q5XFHkzxNBhVPGerVYbDVQvfGozzmK9WuVfg4oVJ5YTHl4lkQUzeJpKwnlYCGEwFZQPDlWS5WMRcVC7PwskZMGo4+24ZcjatnFxVfqlYNb+sXOw13jUqXyTMSuo03lXCWeUqXpoylXg+TdvVFTecdMrUCF/yeMG4uEIWx4U4i4CzSDjkmkXLc8aDkznzq01yyoS9eAXmx1mYZEwMoB5V39brWzWpWV4/tslUJALNBo1FHM+Pwu8Z7Xv7LaIwfLpFwSOVYG3ZVHZ332czBlZu6JPkSm3rxLZk4GSKA9ocLjNeG4jFhiXUGWRpzML5XF5uvvWqXtbpQHVnvoiSYMZyUgFbwpfPBqLZXVnN6pTlUbx1oTlEonFsMruuqFJqzTDUNInPYSPSPGayKTeTAmHDbCeiobYSsRlpGJOPECsUskg+1ohLBGmYVHPcvdWqmqcLtzPiTpYzzyHy2crYcUkpP07VB1RV1j/N3gXcJs8vyzHfUhzZYGYhbZI4w1wAcxqEB/GgVgsBxwe8BHbNKBwDhgOvUAtgKwrN103xA8mdig740POba2LLWnPrqrEUTbKJIslmcXu2sHZux+jLcWg+nB1TbaZMmOwakBstmgRic59VCzKmLQvuzMNElPBcg0jxVWGh1Rprrn0JqyVVY2jq2zwjkeUegAVmtEnKyIWaZPCYJIOh5g4t0jLuUOMHJdVI7JPJqpRUNndccFgzm+0wMmg2yp2G1co6URgTpoSCwA/Ywp5ImFBsiYgw4EMAIXttZPs3nLL3m3SrL+uwlp6bdSq/J4GtenhlCyAyKEWrzdyH5DJYeF0b/zG4j8U9lef4d1pVukXWpUWbUhCxRa3J69ZPQgGiCHPOGW0O2IEYsBpt4QvDZGQ1L8XfIGm90dt261a97fyY3rZbO3rbsXvbxsS81TzCaq0bet/t3Kr3/R/T+25nR+/7du97hd53rfcWYbU9671NWK2zY3RG8nTT+CyaVwwdx88JWsyx1HzyJhHYP9XYvkxJc+Ji60/jSTBnug9lHcvlZ98tg3lS1CLI35XlHO57G8c15STakwPDW63k4AwWoEYgCj3XqmT2tMGSCeo4qbCqRCcsbUtNrhkkklcVaRKOG5wt5sGEobuocd1a47unxPnIqwATnofnCBNrVQ5Bu0TUqFNpNBoVBxPnQO8zpyZqzqGzJjFQqFm/R/GYlgwD58ZWhv5Y/PoPQoJusUYKfxEgKhU3CBLLEJdd4sPclWZEVGmKUswUQ3Mr+fZHxRu1U4BpGObFVFLCzg8zfZIIEsKccKscLsRLAaPikFSR7HWlZRYdQjvs0JZa6uScjMTOIRPMLs4jbzFFoRKX1VGkfpCEGmFa3cjS9NZdZohcTBJMJmq0hl4PQf3cZmOlEEvQ5kBIrVwhObsjAVjfbCTG+Dqm8EoS+ZrH+uKDBLC+5CA2WJ+9Qyfz5ZQlW+gWyZiWvTNqtxmJsSG32FJH5VKfcUgk1WahsCXLuF0FXqECqc63cZrkWbIQrUhLLUlOBErkJaBFS/aOLVG7jCjbUljJo5LHYRQKlsdscveT6ioyUhcjDiQVdahHpKIqHmNgEURxZR5HpxmRz6YOHqitRNLWOB7qfVY8VGq8GPtaqqc+43XKuM9GUmclouNQC/FwyrY9lOK7g6aSRa9W7BYn0FLbkKNPVVRy+ikOHkSrVdZpfao2VAiiEkHzU2t33CRqPt7Me1tthPPNogXZ8klJRz5IuvwmV5ElX45vL1+O8CCSigdFeRuJpXy5gCh8evTiOd1g4F3DV98o6RHQvPM3kH2AU1oKCbvhOOB8tVKYCl6vNcw7op3mfjfTzn6YV3dwnMEWxnxGATPJjAeKNyrlfyJgggPpa6nvpu09+X1ob1tbjzYk9gbNQ9WCHgeqwlFT11ukzltkKDqtcqE6zAaR7EyEwxr9cgRqaaleWpg1fW+zaese2ahXXShRKmUfCKA7wvLhRiMxrrX2uneikah5Y1zS/ANLLU78jleloBm3TRlQswYVqBOVZRgJdTmLGjvkW8q85ldhdCqVwCYTliSVE3YVR1MDTdRAbPH16wy9IvFt8dmfOaq+MjrUYEdhlIggmjBF+rPDEFY23qajV1QVVrp6Uk/PUvvjtSjFcrZMgLwqKzZeY4/2hY1Mfo6YHLYgnPQMSNXiWebeD09BnNpp7Xf2u73W/h7GUmxaG9OYxIeHtE9u9zpQokNT8eGhrrndwqWNGBxdFU9IYtd206ulg/n9jx9rLz+abv51L//a+XFjbedH08q/erlXeOG1fjbWtxu7+AO3ykCr0HzI5voi7UScomaM1hjR+Ee8WkE/m4STDiZhZmchy7TakMprnazCV7evsL9Z4V4LUmGaguJ1VGD5peqNFsWNBP293xP4oDkEEo/jg6a2P/KFovsoxwQxS092yP3f+z0mS7C0BEBxn8kSDEqwAyF/Cku1H1pNlidBqjS7WyS2oSVrvysllTASTx/RjeQnMrmIRAs1mRpx5KuVgtI26qbtZCAJiIiQeiQ2EsdaLT4Aoi68Q1t7XTyA21BlrMXjO+HAZrVtdvN+eTfv//hu1up1JruquskOm+WdhGw3drNf2st+YR+Z06x7qrro5bpI9ByWN+N1tyyb/HCLxlqlja30UKVx4raW729r+f6PaPngoJ81Xt5yu7VlzPLDLVru5FtGZYM2Ly1ptIlrXrfX67W87h2d3t7aufvbOnf/QzpXaHdcQ1Yvva7dzbTP7THe7Nz98BS60e08fUR/jnLKRo+R7oKRrWaGieYAc/2r1hsPMpmiUfSvSgLvYyTsvtf7GcRiEs2TVdRqYlyTyhHWe/rrTuvOnVaHhDRNuaEkVyXMadRXZoRrSP8M8cFBdqfiNcbbp+b+H8TUqAFsDCw/7PSXPTW3Ksn11KB0anLzUUunqWxm/iDhf3iHeq0+UYw1FNUVdbWIL1GL9O+A3KgE9P7Ym4DprqagPlLKbIMofxvENTvLRqdj1em4pNNxaac/5GIALS89wcO6dwfUAOv6veZhf+u9UX477ALOAxvfKUJKM/R2q9ftu3wo0dWm1+s2V9znW5q//yOar3mmAx/Y/AfeEzdfExkAPjhodba0ff9HtA3Vrm5zAZTC/z8w8K8LdyzYJGp7OWAuat1xDXEYIL4dxHrPO2Ub5P8DA/yIycHeFvDfblLe824onZTH8zgQH3RCQlne8BqrTUWirctbuP8TtOBta+FhvDyZs9sNon/DICRZuK2J+z9BE15ZE5I0LaHLVHrZxaxFFILWRHrVqUc1wtevbZFH7lbiuO4BZ1Ux87KLWu4Tpq1W5a3N7StQfa7FYyruhuCOJ1U5r/EtQ7m/ZSj3f3+GwuswGM8aTC00w6nXw0MKV3pcGE8I44lvN55++XD628ROufGsVtY4PAJWP01McpMO+gJbmt4g/NLWN+72W3WgRaTu8mYX9LR4Ywpbug8qC1u7dH9rl+7/NF1SXci6ZGZpW5c2SMW0Sxs4wK261CEZMy7rV62tetbqmISWSvC6G9NXXN/O1p7f39rz+z9Zz4v99or9bhXmvL2751uJTJBspr18kXVLX1tNbH45zXezwp+zSbXlG7u/q7Hvf5rGbglwATyZCz4PmeoeHuQAV1T3SD3C60wb05OGSmWgNzzgOVAFnEJgISau29TydABddW/sughsEUgGzZC4Gx8eNnE9uRmo3RIWf/AoNUQujHMnVN4y1NpPMNQPgtNeq0fqXqsPWtaKw9va26uJmnc72P0jADRQOD1Sh0f/w6D0jwDF5Y2/Dzz+EUA3MxYmOdPg3XOwDRZn0LociP0IGLu1o3qrZDA4v2N+cij8XkC4noHDfjP/59iwsnd7wPxecPmnbr+MgLEW8Ius5WpzQ/Uoq+H+rWrwymsoJUCsKl7d3IlSAmNLFZu9ADXmckh+G/XLVKycnMXL+dSWU2uR32oFuuGYREr9Uinb5JXsMkN8JcHKvkgNcCgMXLXoQDlM45hEebcfBQ141dKmnwqVk20VQioVxSMRcJGXkafSS9CWoDerHm2Tf0Zbm07iJZ+wR9F0o+HIVnWS489NXmrYyg6iupqfLKlme5+r86JCbKkDqlK9etgkX4XiLIyGsvXsHan9coM6viB5WSQUIQyTML8XwaaofC+WuPsrTWZgCM6IMmywJ8rfyKo3UyEfsb0IbHjK2eJmw6g/WboauiQu9XcRuW7e22CE30u/Kpwhz7b4sEw+c146B0gppCnNPGnvu1oZXTNIVEq+DK+lyf+mC7qhkG4mfOckjucssLaJcpqp1REExoPUH1U2mQcs/7pNtebFFl1ZfpDZKkE9So1ItqSvVWr5iLJa8oEJ0CTKZ0wTk82BSfMXW9NK3phA0yu9SmOjngOBQ+Gn+tCRdPZsWQlI3HO7/vNO32MpFNXaOj9T2mBgWxMe8Dqzelhj4BI3/J1kvLZ1//Ux/5perzNVt/vmIvh6JMZ0Mg+SpMLeCRZNkwpXQ+TLiYg5wtfJcsE42mZYKS8Q55wlSXDKHHItu+mzclMAAreSMbecxNEsPF0a88u11nOPgnNGf/nRdfqyrow+uhbr8S/V90QEk7dkyuZMsEqW6ZSJygRUwjIsa52YNIGvd/Ue8jjkutAhkrcOVQMT9hDWeJ2q85tmy3ruV3Sinqb1L9eWM52X0D1l/OFIVeCidZVThxMpRs0x6EorYRI/pFGtM+B12saM/vL4o2ujVcfrbbjJP7pm618Osj6lrkTNt6wDn28o8ohDUJc8YBmN5pyEp2FkO58fOpHjO44+eWp3hzQ+bA+NbyhKaUovD395SCvNj66jdSWIppWDSkv+vnOn8tF1/w6Kax50K1r/0oeMdbT5ue7JDNiUL/uoSsPwspbgnauvBE7f141Hr14dv/ji9fGLx8ev7j3/+BHSZ4uEYPNRLeJKj1FmMmxdAuCgxeLUixGr8TGw6y2XEtIfOV4jNbPZjD9Ozf52ujpUXX3y/Mt7T5+A4fjHx2BCDt3RpYjtPfFjS9FRktSzeQwEMnhZMbVCw4Aklc8DX63MQIkTRJUwEuxUNbOJHqny9794/PhRWs39F188f3g0KGba2ohaLWX4ma0WW/8SBnYfOdtacAgqsfgVQ9jo610+B3/p53z2KXXNc3Ye86stSuBrTLILCRPdqeKa5DqU8fB/Ke1boFM3eqks2rJ8dG0O2vqXa0zSOyPtgj2pheYzneJfZhdMPDM9yfQ85b3a+CUJaYoBqnu7ESZP1OIj8IYht1NwkiCOD1t37rRbw5C+NJa4HGN/AzxIPj9NcxDEjVpiC9+5k4mdQNO4jko/YSzreIlCLD1aOJGDSVSjv6w8ySYRdos1dR9dh3DM84umAelX9O7oF7W7zfr+vfo3Qf37+vH47ml2JX5nOaMZMABg3t3mYENdWnGhpBfxrd5qtJsgwFM2vNPwQ/Desee6/AAclXSM4zCZ+3Cv2/b28TW4uGnjw7rnutppTau9T7x9j3j9fTkeEUZLBp6SEum9KXqPMpTnKuAH0ovITRWQXDmunJPUUFiH4XRlLAZeVzVpv2iuu7tG6Y1GziThgH/KKYAiHjj3UW5bdDGOLV9rrWYny9vazHt42F2BBwbwy7KCeq2ystdp4XZZYa+1arU6hCuv+FBBoSa5YIgbny54exwBwCsqizhVIoc2O6Vt9letjhRQSa/8stHyDqT2Uxnc/8QCgZEdjgQVjCaRoEhQ0UgW81DIWAWj5tgYGaZGiF8Rx8G46MFMK+gPzDmQZrsDDJaI1Empx3Xe6+2nGaGmsIQMdQU6B4U1fpjaR4PxXEa3SLyWjcIaHysPR5uK/R/lpaWp8jvAzNUqdf+gfzQspLY0UWJsrruZJh3xwY+s6W/sW6dKhbZf+rLMpNbJAlqooBcOUU7X1RpJHnXeQZfXVUbyBvZ43Tvc9rowCFUWmKBIShtHfFyDWVpnLrtwBtl+nvW2zNuMgrjDz3zL5exn4F9nw2BMZpSGEZb113pN9ttWqAcIYRFRjvrefhcPRBr1KFqTvXa3VZKz3e63Cznbe+1uSU5v32sWcnY6udY56u33wK0jarc6LTwwJTvNvX6uZENxJIBmW5NOs93JVdLqtXs3F9bOGuTAernye/vNblZ+r9fMDxCMk3lw5WDtDmJNWj2vmauh2+qBajra2+/2blUTiwQPWbImXmdvP1dVv7/n3a6KC8av1qTr9Vr52dhv3qo8MGnWpNXu51fE22t6ty0vGF+TfPc7+639WxaPppK/tia9bs/Lz0G7fes6YEO3Cvuh077dKsxi/iiYnK3J/n6+A73uLSfRWL9CDJVmfk/sN3vdW9YhbVfXZG+/uf/jttVbdpWsSbvf7ebns9e/XfnzYLEmrf39fDe8/i1Hwtl0OWFr0tkvzIXXv10FkuyFARRWtNts3bKC+JzBCDr9/K70vL1bludiTXrFtWy3+7sPxWPDf8WNE7kpu61OGUzsNTtdFUMH2gBTSlMyY59aNW9GOhOy/sxNAaXxahWhmAjsunD7xTLDMPTZek26zU57Zy8AFkIvChzfG7qg4ODOTqgsuhttr9vc2Q2Agu/fDQkBd/ZC5tCd6NywIABI378PAEV3dgEy6B60Ov3d0wDA+IO6IBi/qROCcd2Nntfp7ezG/of0QUPzG7qhc5kJabVvOCTdnvdhnbmxH7oL/b29GyZjX/aAI6/b9oBZXOgLWRpb19t2z9wZdhcT6GKSdjFJMw3DTYmHWK2gzBLKLNMyy6xMrDb8Xm/3dutrEPR+s6uvq50TnLqpVnPc6u+eY7iw3r8j58FiZyfOg4XpQLO1t/vg7e9/wKZX193OPqgsuhv73aa3sxtwb75/N+SlubMXMke6GLnbvWwxWp0P6ER8fkMf4vN0Htp7Ny1Hp/8hXeDihi5wcyN5XW/3UrRbXbkj3vNsJyLgIgGRqu7JtsO72bm0pAGOvV5ndxfbnf4HdBE4CO/bOSiTXqOtPHLZ6nr7BepLAc5eu4kHUQMcQ4CygPxBr1WT4ezKh/dG+goSpZJ+5zQgQl1LVopIjpTlvmpNvKbXL8Wie0DZGGyaaNpzB9n4DJDhvSIyvN9q7SylRVdBkoSn0Zr0vQJt0+3sNQvldRkS0htnINqQicnP60HxQyM5C86BJSt/UOk0p9/Zz6PVXq/VKfSlbCyKqoD7L1+62d6NVB9p2blFJcGxymPmva53u0qyA7ImsPXzBFt7v3+7amArr0nHa5UxN/aLrI18d9P6291WIWO72y+DacAaKeTs9PtlOLnX39/XSE+nt6dQjv1+qwsoR9RIJQpbDjdwZ+0oQ1qqk6AYCYhYpF1kBKkbWckK8vp73q26EjXU/N22LxvRr8VqVd4/50EQ/UxUEiYqTk13NkgqQSUFZ7Kn/U5v3xf08LqkSXy9XpO9XueGWZVwM76x/1rUFyJGNvsbA7t4EnPOJgIk//EkSCez29zb39mD/Y73IXN5q3WNKnrGsUTw22V7cW8fiFj7VKC8A+USLSI7DpUOK55yQ1Uy6uOBhhNh8gi0EpLwZM4QXB5bxPjECVLdg/4ar9cYw17sNrfFoE2PZ7fXT+eyrc5It5U7s6Whn2mkPEITTmPE4EQVXSOSJQ1RcugN0w8jb6y9KYMMNqDJYWtoR64yHyeZ7kow5H6IAum76XA5wGy0BJ8jIovNsCbdfrt30zDbXa+ZMqcUYujtdzBydJJjDzgcjsYmr1/mWdloDRaHXD5YCRbae52b+pht626/o5ei3293YHLNQi1h0wFjmQSQr9dvgas6jjqtfgeTmV48Modsnc4+Jmcw+E6vi8lCYoHNFiZTw8DdidYkKgD8RK/zZvQxckWjLct7SlO9gKvBqeuiKxqiKxKVLzhW18wFuSTPyFNyTM7JCV3ArnpDpV5i9URpS1E6dd0AnWCpo3RBZ5DnUgfBgwzoAvtTdIEHF4dvBm9qNXxOT4dXiI3ejMkb7MvnHF2SN+RcecCVoVyOKXpKzxAjJxg3IvZO5Or0R+NBFT2jMTomTzFuTOOIpXUv0VNyRUbPVHhQ8mYMvvR885o2ZXzm6Zmjb8ilxJj3y27Mzl5rv+xIkqTMzXeaxAgniVq8JQloBHMzoTEKYF+EKCET5cjJdXkVAmBIcdbkcDbAIBpb0kBGgsdVusTGg3MW7UZmnKngNRBwbFYJo0qAXTcYzca2xil8g0g0ugrhunVvvbY22rXBXPwEVZuYaLoW3jw4KXBSSyZFHQnFX2vtq0npNVvd4uGQEwVno9Pdl2cjRKOxlDPCNGzuco9SQThtwSOkbXjMaAcec9qFxxntwWNB96Sf1JQzlVY1JVfklFxksFHuYpqgKSbHNEZPMTmnEboip5ic0CU6ltuavKMXq1VAnlM2fIem5AT7fLU6k7+bxun84ERvY5j1xWr1Bmb9GNQCntFzdEmP5b4mTzHcqyGchuejN2P6LPWe+KwQDA6Cj+i1VRFB9nyzNdV717y/MQFPJug5udQi5EJlHVOZCspX6ZnMuo45eLgMV6vZcOY/z2+CFLqCKdF5sPBnELNC8dT8GYKAQ/E582eojYnkOPozBAAujKb+DO1hkvK9/BnqmpKvGFyO/gz1YCftdfvbrmx1uvrmdLXb/T3YSCFywDdsKCNRlsNGPbT4kO55q1U1f+GrLTVKDY2ZLUul12sMUfs3HfpBnA5wju5Jd/FijO4rlVK4sGK4yQG53r/x/igiI3kE7EKG6h+NR6kddLUKguDcALh2EQ4UIFutrC8KSfLWxMOqR93O/m7cDJByjXPL05mkJ9Y6pxa6RiY3greITPB1iLi6L2YK85jTBM3golvCY0HF8Kzu+U0ypQL2n6e0y1tYO60ErGwB52iOryd0PlqMyaJGp1noLXgjYrg4aPpnB3ShtRsC5LySjCdQGwL9qSsdUuQyFGeVKK6EUSjCYK6UjcBDFzQnhotD2vTPDhcDqBarhl0XwZ09Iap1Msuckk3yx2TOZsKfAGQkPDw9k78lPrHX22/ehBnnrw6DECzN5U8C7WwxeDfYTiQboBZRQI8lPGUkUleKHYXWl3rrc7pEAZrVJ6SJYUmag8nBbDCp1chZrYYTNCdnRIwmmZ/CubkRz8gcgH+vW4pmA8jPodmjseLAAZbb7pee894+3C400wQkcemRsELmhYjfzfyi84P+MJE5IZIHiVEk/Z4FUj1fvanf0IvicTPzJrVKszbAljo+CAcqQ0RjwqkYxeMBKKEjMYrq3phwfAiqJRDuWIyk/xQIMx7XaqBYDqk8BbFiTZYlZgGm9TDVLoGtYH4mtEmWUlErXK2WBzG0ldSWY5ochK67PIiHEWKjZEz4aDnGEAiFjZJabexziX37yUGYT8n0X6xFigEC7++mIL3mXkvtUoXAJoamWxr4bEFkEpituwtvHaSMLYG1zYwFhAkCmCHTKQ2AgmaWXze8WiXys45OhRhlMAWqgEFWieV+LlAMvHJEDoa/HSDrfkbsErYSxkjV2PSZPODefpkcByhiI8dp7uOtB5fEKj6Uua6GDEWIg74T4SNvjH2GuAnUBNQ5UJAS0DmwmyXBUsp80WsSCsYDEXNHhsz1BtAUZIlpkyT0GnDozchT14A5+9VqXKut10Sl2blU8N1BAjt8o7D2458praCEoI37qbXGZlQCX693MCOqzHXTcKVVb6BgQW4o1+tBXNqXnePjchTrNREotvuShvpak9Zeu7UNypGQRuh6nbrJl+wN5Dga3N2AlUjOBumTukSl97v7vZ3Hr9Xva7aY4krB8YPO5c6f6cnr4FSfQc1bndA0xEDiULiFNxYtJfbWCOcp7MTfOLkAuszpTY+YGDpfpCpbvj6XYug8X87nzoYcD/HCSltnAPTVrfVYI0YDmK0lxkPuT4Zw8n3nheGyoYhCCnbdGClXyozhoTViP5I4pte9BV7Wx42ZupZBlUjN+F5LAzxF2kjKvtfR1Eu7CTJSwBJ6PZDXAmXf6bQ9Tdr3Ol1F2nvdTg83ZkEiPmNXksTf6ygSf9FImCBXdNE4ZUIw/jjHELs+ZeJBBhb9AvSYqCWZUZEzPo3wNdyEC0ymSBDliJopCs4PJdYImDhPhOEDzIPstwz+DlYuc7A9asCrtF+UmogR0PWRxA3ItTgLhC/IvaPjR89fv3ry6Mjna2mmuqAzS0p8Sq8A/7vYwFnkxIPFAYUwSSShlzkPn8kwUfQ55T6Kpa97KulSOQzJCADC9C278pm2euFkwdlFGC8TP6KqCJFAQI+Ns/P4gk39qrcmIAvnCRhY6V8UuCdgUSd5C/A2H8Zy/LWaL/QP4jx2qirQgpb1gslVgjERa3JZhriQSA1P9lgZ06kq0kC2aT06DFGk+jPgA0657AwQjRyEEVboK55GB4zRglxP5izgNrAzuIWgp5o1lIqwpfGOaQPzhp4WCOXDG2YGwaF2+kKzn2p29CVr7JvYiKuqx8T0eaCboEItndlqQ7OnfGUmJX+vdUV+idgOeHicnsIeiuilZE5LWkFlCKlaL8leNn0c6G7pTo0i07nIHmssF1EOJ8QklCKjdMAxJlwPAHY9Mi8hpM+DLFn+jmGzcDmYet1n+sfaEHDRmpTxKc0SMTk+w78DXPaWHMsBo2zI5BB83cGBJKCAplW8LQbbRlqk4QFzXWYmYIAZZelw1+QsSEp4qNXqZRpkZg3Y9ILw4XUuAGG6UGnOlOnruroXa5Lkilh4lQ4HpO6Qpi8kXuNfB9NpSXdMbkGz/KpncyCRF8SBiXfIdSFEoi6t51iuDhSayX4dCR5Hp34pdKKs5lSeGCyKhAqUxfQKRXiggiMWDGq0rysFdCOiDLJ9QRIRCObLe/8tcEeYDXQBbObQpOzwxtbhhYLK8C6Ao+u6PFtNbh3RFM9vqOaBHlH7lFM+VMfTlxJ7wdSuwUMHRJ0OYLZaPCHhDVG4oLf2le1ZIYd8Znl0+kgV1Z/H2XdkOmSAgS6g31Q+NRl86GhFYSdtmlRlCBQCDLS1ROf77Zsu9W6/39M0TH9/36gzdXqa1JZMJrjSW83WfvFKV9f8LEW75obqgRt9v9lRvHqDNkw3xBdXKQpwml74F/RUXviX9LT0wi/bhafkGVXBZNJIMs6zYOFg8nQj/SsWvHWAofls6CRMOL4TTKcOOach+PE7oeeue27dzW8Aew5n6Mp1Z+gc6Kenq9WJGYHrVpMc3wkBs/081eFGig2P4NLHqq/vKDqlzCqkRoEmiJF3WB8LQSbxfM5kBl9WuTYIBgcEgxM2OjYIBrMRjGcSwcDWCJ4D3MGDKRqpkTryInSIo64Ah6SiI+KcSoPBsyBxiKOMB+WmJ+kGS/fcOG+4pwCcbEByl2VpSsWgigSwik7wavXUdXXbMssSvSPCqkXS++rCeq7BUDYJA03pPHXd6hxxI/SUHZZeDjSfWRE8sJRI8XOakp+TAlxpQe5LXiTG5OlqtUDvUphYNBsuh5GbnTPgUvGWTylv5JFSuJHJM3KMSdxgEdSO0h6doVPl9g/O7Ruw4T4lEbo+nccnwRw6MYv5BBCy5pq8kT3mjRQky7LPMDkFwW6v19pO554DubCVF6xW727jriQanbuNuw7MIDNkH8/RHwxoyapHNvNJcsQKktkp1+0t8ncLzGfzsyJQRm6KnKeKjBctObxalnxacHUPci9pM5uVVeLkZudmWsfrtHHDXGxptQXax+v3NaCU0E7ByV4P4OQWwn87oyMlVXI36mBz/DREEVGke4xq1Qno+GACSNHMbKQlCLMCIoBGb7ZK1VR6qZo3gOdsNFYHo+E2XauGCtUVI08Gwve35ANKlXLZDah7m5KGhe3kAjkjzwVObe5UohakZab7qAPv6pZkcOcBc/hGIXp/v3PT4MvumUQKIwcJQDQxVLOQkFja4mNfgFCEq6Po3f7aVZLxWHIsWvt6N3W8ntpO6mINJIux01XXbquzr27dPhjUkLnZfmfpNQ3X7n5vr4PJ1MAAcpVuzlOzv8kFTRovX714+egVuaRJ48GL54+ffPzFq3v3nz4iz+hpyf5/Sk8b97/4+OOvj4/uPb736snxk9ePXt17/eLVETmm0xxD7Zzq2+OEpvfHG5reIOTd+5+RhEzJKXmOr+UVSBIlPzkiD8kT8qioHCOoFLN/b+jB7+Ul8tR15Y302iS/BkFSQSB4LmNnVU7U441flOHYTM8KzwiAW+QDfta9Atb8AMDpaxtOkRf09eh4vFq9Hjm/+7vppI5Xq6nrvh5Nx+R7CkN5sVo9AvnsW6rNVKTX4dcG/1itXsCg37ouOqIT9FZLxSRgxAAaNeRM23XdI4mxAOG3Wk3QEaaUPlut0Gw4Q0fkGUgQjkbHYww35xE5Ju8wJnN0RO6B6yoAPUAzXo3ujSl8uXDdKaUnrvvCdV9I08uqfEXV2HUvh2foNXEg1QGxMXoANOf3JbsiRC8UgQaX9hRo/YdUYcaJ/widSBZH4p8Ov/cfoXNM9OD9R+gNXpPnkt57Amv+EAP29mC1qqr318AVW6DX5Al5OHoy1poUEbrWRAkjcmKsaxiKr8nD9PqOXbf6HFbpGJQHvodZeU2OyffkGoblT9eYXAEQ/J48VIpo3fe/D3VTvSot15m6XhNvG0HXW6/xyBura6/dbt9OCW0aTyQlLSXJMXbdEMWNCWeBYI/mDL7cwL9NhoX8EH/+GsBzu9X3CvfA9YOjo1fLOXsaJsJvkgdHR0fias4essk84FKHTqV+CQtuMs1DFolXbCJ0wsMXz/Jvitebvb+O37JIvnrkYSCC1zyIkhnjTwQ717keh2knPnn97Om9+fxBhoWrtI2ExzE/12PUKUcMcmRpz9g0DHS1z8JzBnJieVb9JnkenLPp83jKngULeI2nTHfxZRDC+L5bssQM6uV8eRpG6Q9Tx9GXH6tIrTrb0ZcfK9cP2fvLQJwdsVMrIQZn0ulrbq6OvvxYzU3MzcQcSc9lSqfPJMESHZ0xZqp5zd6J1zyYvH2wZMUk8x4vJ7qXgBy2O2UIa6vf9cDYEMDx3VnI2Sx+9+1d9O20hu+GuVNSjVy3FsHuJr3efm9bXVaRu8+OnjxavebhlEXibkOwRKAIS1O4MhxJSRBS0tiqyFnweMISIPYjyROTb3hNoMUyaVerW9A5joKL8FRBf8AP+L1TFgkHr1aOAyrMmzIryQY2pzUxgyNLGpvmSUDjxkMWxWRCl667bFwwnoRxlKxWgesG5pXM6MR1J42L/mAm/XKgiM6MK4GGg0G2Jl3xjZrjg87Q82sIfsqZhmDAoesC67MKcgWzTo+mp8wsEggfIe8h7XWw69rZHpzx+NzKKJtXFVsK/uGa9Pfb/Zv2xr3FYs6+YiefhULX+G3j7rYNAlrfJRUW1drLdCRHouak+I8zBo2R3l5R4XjkWESJIqFfXEYGRDvECROLNHGIs9CfniSPUozXIU4+qLZMSH/KC+/FzBmDpmi/d3stTGltQeJUMpekeOVSKbWA5EXimL3WntHDhJkhs1SqM09xS8nb2WtLIcYGDWlT88YF1VmY5Fw64GuNbRV5uBr5avoW0iSURpaXS0M6AmqlVUgmEV4XU0icpoXaSaFtFJKyBiwCy0bE2Hq3JhIJiVacU1qf1LDwyDEVDUXHk3Nl/iPIiambvKHHw8g/H0ajp2Mfwf/V6nptM27e0ePhxJ/IL3M0IU8JKF49HZPn9F2WTYpEpoDNMMxpNUDHw6n/tIbOh07D8Z3/zsG1KRENhb9g133jumfoDZkCGfAOEElw8HNBRSOKX8t+f8zEED2jS5kJXAYpLqX/ZjQdSyrCdS+GFz7TZbUy+GmqFn61WqFLbYvsunw4A31Z7IvGJQ8WkLBAV9g/cd0EXeFhDC9XBORoZ8H5anXlulf656nrnsqf2HVBAdWB345EMufoHZmSS0wAlzxDExLSp/YpxWrGQpgxMkeTUTiGVQIow1kwd93ngLeNpmOo+bn8JHXBABPbSqXaTJBqVSCL65HqmUpJarP1wUhembZ8iqOzCxYJ/SGOEnSt2FhrAge8pMl+C6TiYYkZOYlpqBz7AYonqQL7ditaaLxiM8BpXFf/UEXBcGuYyHVGMS5BPhOVD8X2eYPrtl9qj2HUBmyNOzUEuDEj2dCNWihSfmDptQg/NrpPmx3UA0EsZyUmjVL33n8JlTjCSliruTHEQmZCUbVt6hr5uwI5tnULrG6/3ezfHthnWsVqFvOaSHtGLN/r7ilAr2Z4RqOG2SZkrnSNlZk+AHz5+iYOIwD612sy3eCOAGW9RAvCsK1pNxoT5eWGDcJaDUcgCXaCkVMLa87YGSyAMpoh5wEJHOJYcPsBcmpnKCIOcXDNwU4KwKGEdHxnA+XJcCYnukTiFqe2HRZ4JxENsruHSCXawjJyOkcRsXJZ0VYL11k4nCJGeBbkOXOZKftpzoJ0v4bCrBuUg2/cNQH+z3sd3uJxjYah2mhh2R7X5xyFeUtI4DDtZAvKrVLmggJOY2q+c8qEtXcfsmTCw4WIubSZiTVxD1tuCZ5243MmzpSaS/6cQDaFtaJqtFpFcDmkpRs2B9A+f9ePfv7k6PWRvySKh+UHxOZg+ROAxu3W/nvNrgSNMJ0pZCRLGLDynYESkthdsFmkGVhx3SUSObao/W07oBQFONRtdbchrQWJnVF7KtWlkl5ttRz1JvhZxMkOWsNEBueWKoUgWME+vAJ9IMYjNl6tQvkaqlfgOO+XXoCgxKXBe8uAKMWPTMr08bYbvWmXX9hC6hIsbQiJzSvDq1U8gjLQJ7Ac2sn7yLiwGdhUOorL1NgySA2PJrcyFVSQpGRGA2CHMODMxZksq5KgEHEiQL9M6gBO0DJnyBcKTSZIPfpW6b6Wnb+hQ8CVN2BJ6aHxododfgTKlMCsL1GJL9/MogF6yVRqJ7tuTm822jT1VOjw67Mwcd3sNxCMm3kvw2gaX7quepbnSdh85rrwv/w7b5yCDsApzpkA2LxmhFcrAwSQY31xMALMam83pqIsAmKl6pi/yO1l0NBSZVit8quS13mUSrO9Xqdf5JJJ2Ua3fSvugmHgOST9qRlSjmRSdJo3iYSMY5N2u51HeUDb9L3YkzFypuGFg5Vl53Y2ZSOQ2A5YVuw8qRmCo7pp63qmKp2gAu84iqth9z8u6Xp1iZzvHUl5bZDkqAk4WAmQN0bjVJoXwokGb4u+gvtLYLY2Wzt3TmbJ3W4CeQ7rlt5GqdLsIJRadKA6LhQXDq9WxaRSDix0xOaqFMqA0V6pq59MMGXZp/U6/W2YpNHumMiv3ZRnoFgG3l5fC6b2O15f64N0lWJn1SNX9Aw550wEDpCjzZwKJAh5BLkyKjDX6mw/eeg7L5zaaa1GLlnwFji5krGMQbnQOi1Kzm5vtUste89hHtANpTUg6KQxI0zhurBvJgzwxuv1gI+uxtQjAnGchWiYNCwjvJx5CeQjKohTlPqOP4glBgy3FyDBlF7haya9SXtYW+xkMcQzAYTR4pXKUQVtgOs87gWs5MSfNWbyFGlFWn9TXTzJTMad5Or8JJ7nYgH4JWEYhs6R4zsvHVwTA4XoCyCYZXVzq7rHjvxsFDCdR87gArZhKg2+GjfMKq5Bu+Irs4Kb3dzSRrWZa6LqbbZgtsWaxNFjztj3rAwPW7juFIh/sK3Qjbku1AXS6kEMSw6TDDpJZZxYeRj6TU/jBwo6BemhmZijMkvZZnNzaM7SI7+QuFC3paS06pBdUb3mlWDOWTC9MvZY4fds6pBTurQMzS7osgFz+CxYwLQkq9WZ0llTV/0l1a+rFdK/pIX+BSbPaIAuAXsHFSn4eRYkoBQFPxMm8CDatDR4ii5JLkbMKbrKNGsas2ASTBkV5BjySXOitU1ZZXP/TFYE7K41icsyqJZUwAo5knO6gH0ZCAiyMh2dw+KQki7OkSDnt+giCO7Pd3VRVTQUo/Oxv7WbKtPass64Bi3OSCoOhVJhNCYskmfW34aZw6XvwwiuAYalGm/+ThNCjcrJszBB8k7gNEQM4wYcWstP/KnySnG+CAQwkypcOdjmpOLURM2pcPbdMuTg/nWQGXZIHnd/qzoRCVPUXdp0WDL/5JZ+mXLWEVWpwYVCZRNFpXZYMooBTArltWevtU08Y9Wfc+W6Wm02l4rIIQ2wrE5vbwe3r8i1yQCixL/7nZ33e4ZDZSYpigRaapRNaXW0FT2Ru5RmwDkBE0RHM9wckskXHAAhd3/xbXIHDX0Z8CMdKv725C5Z0AidNdg7NpGXrPqJJgBaCoRUNbYAqzIYSnf2hMyIwKTa3GBygmLX6Q1VaQa/vGskU9+5l1xFk9QTptSrcD5mkdo4hXSZt+Rj2gGrp9PVqlpdoDMCNFUpR3ZwatwcWdhQdQ4E4wb3zpyBK3QlSX+8WlWvNANW/baLCLgiQNwlAEc89a/WBAQoO221Ddp3979bfZudkm8bd8kW6nEEKGVKr3FKZ6sVr4IID8GBBwtH7FerYLq5pEkjivm5vCzKjpv24i8yH+ExAZFfA8ROl4w/CBIGVE9Ak8Y0EAHw+iY0aTy/9/rJl4+o89whM9AYevH068dPnj6lzkvHOn+J5CqVuSWDMe8EBJs+gYaKyqfC14cVlKMKhxUu6HLXSvsdPd2KMIjfSxFykHlHAZZdCqMQkxaueFitMt95xU4fvVs4lEofPAAxu93OTsJFnvk8QFCOh6TaoIQDmVGa1cFlGfmxgbqtS9ifIXKOVL5MYUWahSa2tag+OmsCit27R1DizqWMSZK5c5E227N0pPPUictZyktZGBtQ6c4lQ26uCudBxwyKFws2pTrYGGfJci4oA4h006Vjm1TlPbRIOwFxFgjyhlZRla9WVd7IdKoxeZclPzlKNd4weW6lP3/96NWrL16+fvQQkyNpXH6CycNSiTLoHEXEUUfVATALqMoVAgiF1+RJWaE3QwlNyfPhEYIAQkSMvDF5iH3rFWNfftbJWCquv8ORCXglwx1cUICPBkeabuUxQdkAXWh+/iVtkmd0Aorkzw4vB5falcdT+gSJ0aU0LJ6hU3ArY1C4gcXPv5IuUSIKCNOF8ihwrK2SBlV0Lj3SRMYjjRKzQc3nSvJoQXU5b9q8V+B1mTexp1JJvNCZ9UZnwLN4s5QzLHd2mO7s2DAsb9INJcsBgAJ5jcqZBm6DMHINBytiRvceZFN6CXiGeAEPQCoSpgNewrWVULHeUjScoaV+STKDcZB4SNPrzm7zj5SSycw8Mn1TpeWc0zdVKqXzFA88S5VWF3SewwOBuh+MxtI9oOsiB5baCSMUU52IMB6ikE7QBMXbVBFRREPsA4WujB9kZMmkROZ2nYpZotFirMMRQp3yYo7o9dpXYRUDFGFMlgiyAU8QRWRB0BbWILbZJ9cbqrB+RMoVYf2pVLvu9Uo4eN1WGQOv3dzr3aSGgtKIGlB7p1TfSbl/CctEldVSgY26HZINnp6aVvU1lV9WU/QBFChVfUhgSxamCsBn9Us7mowgrmfHU8LMprd/Sw+HCjVOaJTSuNl4YI+77t0oEOEFk+FZtDpXjBJopt254S7u793G4V/mZC/18QdxmECMFU8r05gl4KAQok8tRIWz0+Uc7t13C84SKaLP7l2h3KPe6MbOYsKm7Lfsss3OaUdaU0udnf5eT1+2vW5TH1TJlp6njmrOaM71KFmU82rJNCf5tffPWfHkASvLdcG46gxdn/jempyhBeiiSjZvPuJegem70NH6TlJPf+1ciD5P+v0j1yc+uEFonGS8H3PcCYN/PN2hJKSOislyeha+eTs/j+LFdzwRmfnEiI9pj4RGzy0L8IBydkzgtUhCDdKrwsDWROARRINbIvkGFDYIwqGGKg3zvOGcuxQ6UzbNG87mEuqRMxo0ZmQBLMdBeJgMsCl2RU7pPBM7S98koKZ/NoSb+hSTM3SKgc18Cv7iLkydz2hzcHn4bICv6MXoWa02BjvtKhijnpIryR0Ahtbp6Gpskfk+BLdobbrfKVx++/ttzfwFvTd9S0heleR3dbpttfukqIDMUp7WHET7Tx4dv3z14vULSbTmaNxFGRlwoOTHh5I3cXDXqTkmIa90gK+FiouMFrASAKgn8xiIl4FxZbwIOIvEV1J4ZHBqsxmU1RBb2zimQjsi5cJyAmDl56oUcs7E+XwWSsFbZucktyJhgytqheJJFTuM1GVofjSm8XkQAiQcTlGEfYQYnSAnnHEp48aNBBRqG9MwWcyDK+pEccQcEoBgmEXTB2fhfIoYJqyR8ImJxua8CS4CNUG+gwkSVNpGiWzcpnXciBcsQphY05b27LEGBrl5JKLxGFwQRiqmkdrSZg8PeL0+wNqE3MK7R8mIj8cZEY3Xg+VoPs6T3hryKPXwoixMtmMLJ+ESH6KznPWTdOcI63RG7A9yUTm0J7DP6RXKKQJxP27MEFfytf1y/dIM+Lb3271UyNQvUjrKsWFgwPCANWZUCsaGZWAVTBHyowRMfmA5VqJLWNuQBki6OgzNsZ7RpnJsGEtTI05D6dqQRCM+zt0s0MndQrNeRxOfIPxT41FjXKYjC1LLqJx4m8y2XBZzepMSyBl1mKXduqCOrcrhkCl1jCWXIycxHCbDEtUioFEIU9OzBPFKGVPOdS3NKSrtYHQ40DCCKIZSR5K7bpU3TKPGhn0ulwVcV0XpN+miCszXtA03p3n7UOkBDWzFc6lR7tW+1c5Mfistsl4smza4/wzL1zjx9mf+bSYmxhZvLC2cQS0gI8BmFvqirXThJzYaB849GUkz5kkxOphRYrOnNDdDUnJCQJt458nKFC0UupKkZpT5k2V2opaazNKte+O2U8dxON+UKglqT9gM5ziekCk/U9J9gaWeUQVh7EzJNmDkkutTygbOLAfUgGItHG3MSJK6dFvSG3QetmhZSUnfcPsnpEpj8Peagt3GrIyqAK0sdVVorviwXN1ViiqKzNVKgpZ4DZECfaW7oPTKSi2cuoYtLnEIjWAiR8E5h1gnV0HT7aNbrcqpIxJKor6vQ7ZA61sr0mSPpKN2W0TttTd5+MZDq0Jz5C3gdSWpvMwhPLNMHWFu+/ux0OpgOCtYLfvl/osH2u34JN2ObDQZa2dfOYvojNEHCofMVlUE6JP2ws99mg3n0icVSFv2yiUbmbvRjmfsVdstzcf02tKaoER9WXrwtQmHJZKeN+1ZWK2SYZlrFe36DlWT1cqx/Io7VenEEb5Vl4CbA0NLql30muX7b8Pv4vW6kTPJwKC43drfVja/GzqpoS54Pk6jtOVQ4yh113uD/UDqkLIp5T7KxRFcFhGuhmhJuLS/i+QzQDPCFeowMA4RDycDLL9TNpoAueC66PcSmXG1UgVSXGEGVGjeOnnLEd1UXgKuze7DBwA9PXwb1C3oUpTq2JDoRkQilOiVdup67fktcN+qMYbNAxMV/OxUq9LRTnbXrn0ONkY3LHdGAnky7uLGjCS5k7taIef4WJ6v42MnjK7XwyJHhTBQepFKJQBbkaARumHoqMgWI1YjGLqAMeJkNIZrjdtHWkW03PDbl/e3kbIMufQEigkbKjManzfSdmgE7ESEUzfwZC/PxyrlZCiffKERe+aUZi2/gJvaYM5IXYsVpxbKlcSgLQ53S9/zbqnEacFrpbF0a31NIn2NZEowgEfGMvyz6TGWXl8iqrU1DUCOtD4nFQ1tqbUro2mg+j4NKCxtaQJjTOLognGhwztURFxZ8PA8lCwx470XQqLulekSgtu+MskZsHhv4UY31BZHIDpZRkkwg4i7o3A8hH+UjcKxVGoICfwEmGVRK9BGGdLU3MVlJxBZ23VD6xgPFfrpm85Jy43t7MXb8PoUmzkN3Z/OdDCfG65fHIHqRJ6xt79zY5aST1t3oZQfoIgIwxxjpOiYJiMVwBdU6qMFNLIpW1sRJcDT4o2eKFobjkAUdz9J8fYljW92KJ7CXqDOw8ZskLgu+CYFx7OuyxEjy9u62JHs97VEJ5ulO6Vv8bml9mGc6lkl5qJeqktNqymW+QDd6YslnJlBzSgfCt8CwQO4XQOpcj4jweagjDcSTCauCxqzMZrZlpxL5ZyrVRpDud8z66E0JqWGjfSjcZPP1JEYg24obIIwlei2d3vXhp0LbTjHx5OYs/qb5Dg5CzibHh87wHofxaDgj2LQTsqL+qGfpZSPuT6hbTxAN9lrqV7DIwu1wYbMB3UojBxjw+zAFafCrF/rNN9pN1rNRtsh5/GU+dHQWSw5c3xHaZY7ZBIvrpS3cee//P1Kq+l16q1mq1V5yKIwqbxcJmdvA84uKuj7eRzyePK2wZfYIaD6GSXMd86EWCT+3bunoThbnjQm8fldk/Ounq27J/P45O6F6sjdp08ePHp+9MghidSxvVUNDqh9dbqt5k5cpNVpa6nIXr+pzybAOnm1gabz5Czg94RCPPXrg3jK7knM0vK7S2Y3Oac3MvIZmdMYSdex0gSMSylfygFUBc8OmqvV2SFdDMXQcTSC4EtJ25ycYXywt9fa765W0eFet+3tr1ZnNY9SugCPIipPzYNc3XaruVrNDvd67U57KIZLWdyPfDGcwE9yVmthf1aXGWsoqst6Dw68Jq519/ba3by7+Ykcuj+TDufV1Pgz7XG+3Sz15S1d7hhnOHkFrnan377h8JWpxFercBbBwMD57a/+1H/5I//3X/vTcN1nL/orAIiRGBv3KEIHSujfYHqglj+/JWRPzZbQOj6wJ5yRU0sAiyITqhRXkPMLpxbUgppzR5KqOlWlfORI9v0tNBBpLLWQ0tvQc0GTj9Ml4mRCJGu+ZSXNVBLPr5aM3ubPIXAGi6b+HKJmQCg2f47a2latbCrUmQhT5/8k1j/D6AYLGBhS5hy/OQwRrzGIVhIbBjFQeKUBFltda/ZvEtkaMAw99YWuh6leTlg4J9zy6r/jWo1oLRVeRFWQggNPOxrCSTtsDrnPMIrU3i6NWJufqTDa3W9xCDMCKWS/2ex5+/utvU6v04Rot9JzB7BAduPhemuWKDNtdfYtCcj99t7uilMEX6L1kivf7XYMP8bwDoFQ0LxDicnMcvzsOQheRPzS4MoO3hXsTWo2gvDTjrSW8e0FmRtnu+EsCyTBpKN9Z8pmwXIOKpucKpyOYVIF1gzUmJl+cY3az94HtS96GNdtRtL5CxhdKpYmhEzfL8OiYLY1pQtzeBNaRwzJgjNFE4aHzGc1B6gzQMo2WrleD6JROfY1ps73jiUXSsm+78dAdqmcsK0JwLfdbDqpV5uFz9sRfPDI6M1RS6yf7g05/VGczX+gdRrUpMM6BJXiNMRKQRHU37aSHjv7ZfFZoxI+qzECWStQ2N9xKzQNDORBNI3PkTIr8hqWQdFONUw1WOTUkO233vFBRQwfO7UE1WphLSbtrgRr5TZOoJxitwOsm5w+yIbyov5q9IfWBERTN1uo5dsotUrrtLaZpdmZ19jmQBu6q9PKy2QKXiRhT+8GVj2j8CnpkSRF6ZdmkpRFqdYAD5Fz+TbRfGM1I3AJu+6sMZOytGA48+UrROqJl+JI2gqsVsn27V6N0USaWlSl/bVhOehpn4A1r3HdqtfAqYnBUlEsAg8hB52NxNiXvwLXnQ/noHB8Bk4/9RzDJ2li228X+AzOt+Lb6NuLb2ff8sp/+Wv/91/41W9/9avf/uoP/fZX//1vf/WHf/ur/+G3v/ojv/3VH/3tr/7Yb3/1x3/7qz/x21/9yd/+6p/89g/91f/Pr3717bLVbPXl//1vlzM2mzkg5mx2P8SfcWYoqOK0LM0FEqQM/ZyyqgowNE+Dr4Ge215Xm6+pK2WaqltdgW1KmDyQIo2jBRjtKA1KckqL9ye5oM6z4F14vjyvBPN5fMlAKjll7yrs3YSxKZj4XNLQuqye0amO0BWXaGiNsog10mLJI8LIVsCNk8YmCQQFdFS6dH1c3CXLnPK+2hBQ4aBopsGk7rO0LRxYVmrKrmLDWV712WpVfbqWBPIkEOWBMrQOYqA9NSzpHCWkqWIuAbOJ0bpXErNxwA6iAVNqqMcopnVPSvkTP80IAjo5vLOaVDSMMT481QD/EkIrqlgCzQE/CAdcR3aSjPcY9EeX5IzEIGdfG93Zs0OaLw95ajXLv9AyCwK1lCaJ/XwQ3Rs2rHKOLaPE3WZ209CfsgQY9l7rCHNl9Mn7RvrE0pB/p4EohGXVGmqd3j7e0ec1uZ6F87kfShf5DvyG/np7zfeeHhUg73bzA2dWtiYAIVKdkHH5fqIJ6uRFCLfsv473l00cSaiTJjsEdH6lj9nR2HXl4JBnlcsL0yjcTJjcPB1LOfw01uBPMgMEtC3BQU37Q2ZhcwJ2jf1HDvunHXGr0+7dfsgQ1xffYsdmwXpBtZCksSVD2GvtVqm7ROs0tvc6Za0UTYchZ38P5/UerXBUQnkduoYX1XSvu998j2tXi0RVcNTbggeT/6dbJ8dUCSd/v9l7D8whU73Ny3cTE25ZurcZjc0HQCSqgGJ5d5cI7DI8Um/iAwgAnSBHZ3JuswWC1ao6kdMhy5RoA9xuDszNHQyXZvLASrTpx9mrgvG93E7Ws7BtE0HPlH2lr3EpqKPb6t0Y0zOLBaxOfN77iwr9sLQY+kEaMWqScpVnKQkw1+6OLR/KZzSRcSMWNMniRqB5Ts8AyULEDKoQmOTMDkwyN4FJJB9EhVRq6sAkG8FIFDK2sAKRqMIyFAmUIZEJQVGrGaE0A65oGtZweNvQH76JQ8JNlJGoLAZJ+hW89W8GIIkIpGcfgALSZZUK7ZTGjTREGI11uEKphaI6gEmI0iLw2/j1xqQ6cd2Z0b5LgOc5lcxNqW+1RFPj81l3xuRb58IIkH6v33vfa+U8WLwPZnAOMULW5FrG7f2JcAKv/z6QBoLP4saczQSJU+CSpLSFUs7s7N8GdFRj5HAZ2dXRBF9y2Nt33eSg316Ta/XpFgBlUBw9I2z3gD+IJlOUWJJGzMzTZDLoa44mUwAkHw9ekWILQ51NU8d2kiaTAg9Jhp3ZwTcvqDY0J5dZ3NhbTO/VmlzLKv1SrQESgoa7BgELOkFn0I1ARZkDdwuWLvICPItjpS9whiV3/Cwf3FO7i+OUQnTtGPF8cM+l/GyCe3LKR6cquCdP447pommrGbNxis7IFXmmCCCp8G4HwL3wOUaX6Fn9Soa+Bf99VwfPBle1GgEPJuCPonIGnjPkiG2LAuPthIYkkjuj22y9x9ZQJxh8xL0X8QMF5CFWcbZ/Ksze8/Y+BF+wfC5uRnJXcppJypCYSaytry111NmHbQ2esbVdbG+/p/e15jVA0AewxzgFFwEXNESnjSTmAqxB4LeKDw+Wmvb9pPIgM0DJESjNATtKfj8Gez5IknEcqpOi6c803UwHPeWSBZ257tlhW5Hci5y7liuT+eqg22wPtCqUIv6p48h9KGh3byAOet2BqNVSD8FMs4ElZvpAyzThQjaBGrpdFaKhu6+evaZ+tnxO28qzjg4I39dfPJ/Tjv6i+fM+p621Og3NQXTQ6Q2iWg2fanHzW5/VInIhYzdCJj1XqFSezRoXddG4kMSJrOzUgFWoktHTUTRuvNXiWogZH5rfRuu/7mGlFITCGk2DPToPP77/6PEn9x48+fQzGRxxDTqUNx8UyYJZrarHq1X1XJ4SXmDEWC44YpCLpUqnMiJeCFGvNmOZXgAT8EKFGsyiQ4/GUgmWGdAC45/IgUdSXch1L1EoMQ+VY4ZCcqM0utg4h2DoFjD1/GwIw5qQhQDTDRDDhwHieOj5dU/qKSvNFDXNenn4AEN/aDiKIP4z9Ep2GqdxGyMrLrSM8gTu2b2dpBh4bM0tTurDYmN9zBfpXVKuK7mGn4ryanW9/Z0tZfepVONJUm/fyxQsBSm8maQ3H0Scdz49evHcMVKdcHblqLDndxt3tfcQiERvqRYsslejWjCly5yk+Youc9IGckrvjr5dPuw3m/Vvlw8fP348vntKLujdX1ip9x8/Hn90l1zq1AdZ3o/ukmflFvz0DHHC6p6KGsoJq3lZKHZ0QaRbJ3AaHUpX0Jcm4YJEeOh8++3SqV2hBRLAafS62Bdr6QdpU4LwM8g8nTW78Oi3O87PqhQM23SiSsOrlcrHgqmVAd6kqvPMda3NoOa9QJYDmzRdCX978PpmmUlhTCcoxIPoIJSHTQZ7zq62SOmJLyGwsbSjJnEKVYq+vpZgZ0hOZaAXudd7O1h0wB7vt7GKtZePB7c1EI5AeQfshvzJutvM0fGA2nldKW9ptXafOjCvzZ26Lc7Tcqaw+sipF3Xoup293XzPzJhKU6u3bzQvhIJg+ARkYn4VOpH/CFHq1sTrtTo7O2M8UBpbtJu7osFBbrPHSil/Ta5lKJ1dUnoQyO53ez6C/oBzpvYHYUrtTievxmTwI035G5mYkzJZ7KEZVboNvDDI83128ZWq1d8DF6v6pgMDKmlU8F54Ym//5lBfoNyFNRQlYTrOOOV7JCmvY0n1yCzGRkBjydiY0NhibCzxIEEqL0lnAxW8J2bsjCVRJ13FV9XsjHI+BgHD1MxttipHQsrUQqTk4SE1Tq+H5awKX+q3cxJiosvWqHFiYGh/kXIg1Pbr9LreDS4r0v2UD2hhwlgsjT+FAsot99ss3W/zdJfZTixiqSgnAi6Sr0JxBhdcbOnOXaUaNNJM28lygr+UW+zOKjpbrU7BcT41q2dr9tv1EekIOzNVhPvrVF4SJk8ZLY80BSpj72rUjC7RFbrdpiaGHSXR18BSk1oMF4iRCPybS3/nhNcMvYcppYrg67X3+7eHBaDhhhuCh+e3Odky7ixcNlBAHm34Uebw3IRZk5zrnG91wJJ6HZzFAWz1vSIepXRJklSf2I7puEWN2NzOE2W1o5WGQwgBrwX4merwHLDsOWD4AbhhmpOATCBe42RMlwo5k65p9su0PDqd/ZweYgSoaLc8rk27U8jZ2m+W1dnqec1Czv2WV6bIst9uFevsdrul2ij7zf1Czr633y31XNzpFnM2u2U6YN1mpzj2XqfTzy0urHa2uJm/8cyKTzqhSpTrKUmdF7wVkoBe52KFVa2gXNUt3tPN4RMm6l7mZ4DSJXBvlhL9ZPCaReYLUUCgNB4mELmS7Hf2ygbeBtvCwgL1SuNndDbm0tsvrbLV6Rer7DZLnZf1vE6vWOdeqYFvq9Uutt5t9fY/dH1gD/7066MFajvXR+fZXJ92r1Trsb+3V5yi/XavdH32esVpb/e9D54iOHw//RQB+rdzfpQZYHFyvF4pxOq3+sXJae21yvZPv9naK+Zslk7jfrfpFXLutfrd0tb3i3Co1d8rh217xdb3vHIl165XbL3bLo351ur1ivCyv98uP7it4tnZ65dCVq/p9fFAbxNSuAn6pfri+xtz4O17pdGCvF5xZO3mXqmLtM7+xsha3X2/lFIfRJldB2+cDnnj1FcM2W1Ka6Lx4OgIIgs8ODpqsGQSLFiqeWulDQoRx1ThJt0gjy13wJai5y+zmn5pvOAmlSCLgNGwbnYGvoaM9ysSpZgvCUE7KaYOGMtwizWCmnhQq4UH0QA3qxSx/McQ42Fco+yQeq7LDmjbW628Vo9SJjW7Q9dlh7TTl9/2equVt5Hmup09ShNgZTg1ljJckNcFZ36Or2rxKI1UTgYuu6C9Vn+10gn76pFvih3S7p5822/Kt/2efPNaLaxa4ynDEvv277hGnf/vf/6PqculeD2wV221UgtLr6Vb+mz2KSNsLfV894HwFkXEUbq4rbBrSz5iOYScx5fGGeRZeHpGmfqtJRFejdXFOr5gfB4sEov0Q2mJAwF1rFamtkMhk/FaQLRKVl6o5hWL1b20YDCdZoXktmPI0A3I5CeyPCZGApXVTXQ9eJ0sTwQPJsKqTZY6oKYW11WZD2lafDga+0INpJDpwMqjepXvDHDTdLoeZDaveOwXmk7rKMu7rf7xOt2pOWM/uYzWGExatrGxb9JqTt2ppVnXa7U/+Jb9wYPolCXAmbb3RZPoIDKuK5PlkoFKxPFyMQ0EO1b5kNlkqpRVYUOJUxHS5u6illJOpKm3ANGkQEhhT6eghDYH7MCuysQUqIpGuueyz6DGOMCsVrP8MNmlJWmKmoThLdXuqFVQoYae/0CgNa6kHwITex65US/d7ALDOmtxDjM4kHdGIUx544ktxL42wI2kt0YBegV6J5EI6/qtY/EBM5zBgp90irdXy0vnbMTGDet4qyn/b22iw0gwnrD8TI/GJM5Nt1KhfZ/p5nJe9HRvKburqEZE8qAzzWBD18wirJjJhrSDUO11NQMRiUFQVKutbz2f8eZ8xpvzaZY2LF89M+eg4inwLXZzYTup5AMqBljUaelHdbAtsGtliC9rYhM6O6OKU7OyaueHpAKB+SpjZz2ZxxErj3OvDJGSAqQvh5/WbmLQlwE/oEyuzgALtTpcLkra/TUGi2AABqrG8mbOgwVCgh6i63l8qa4wArX6aumJmhffq6n3usyxlsFAM/yUr0nP6/c2URODGm4LmDnUT+laB9BG1y3xXMZV9Lehfm6g0XpYJYEAZX7t7lbmXQ/Y1kbiy+gzdpUM01/+Lt9IZXHtdricEqlPp12VSgGC/741rwcK8qio5o0weR48L3VEI6pUrAfmQyUGuUYjjEKhp0juymxZ44wEacRRLowSYbkd/ZLH52HCLImJdJ6SthQijq9Fg7Pz+EIS2ixiHDESYyIjquW6VLI4xaKuW0xBDuMcpAEhiMRGY3UVqHFZQSrXV3IjxOQaBiT1BYkuqXQKinurtDNx5LpQkWlT7izpzCOrVnLr48YjGZL2PBSCcRqT2NqcxzJcbWLUGUs+PYiXkaCFT+fBOzNoU1auf0K9Zra2S2PPVxJTtZTe+xn47XXmumYnpfIq58tEVE5YBUpeLVh2zCqvVKiUqUr/WS2tP1vPYCOWCaVU5AcxjBtazeSZleoXcmV1TpBWjtE3LpjHkIn09A0wMG0GxVSYmcRD+y3vYlMp9RBRmHXsZ5EN4kbELrPNh0SDnYcCOVaq3AUNM3/D7KfP4ZrNekISGkskMu1oglUS5aRWy3dD+qWv5NYx3YfJUBeLhiNOkrE/Sggf+xByeBklZ+FMII79JL0cUCgFFhhi16dSjhBc6TQuAx6xKb42v0zMr5n0IqoZAi/jRDosq9h7unLOzmN+VZmz4G1lygSbCDZtVJyaaQGo7Zq+NBnciWZekkownULeLxJWYaq2RsJymwDhiogrYQQLlbDKHCx8gesgtWWpY2d9pO3Dvgp4JOUjs4aulAoyk1F/KCOzxkSurukdBJYhQBjF4NBS/5CTkH9DkyyGVrYTZ0rXqyov1FnIWar1JVOUxKYIqNQnYETKXxD0+3GESVYHeIOFbbGhAiCzmOnLQLZuB/v57zpiapbBjp2aDmGe1xq5lj3wqx5R/TJiLy18EkR5xiPZ5pbxoVTo1ygzfE67QTmJ9BhpSMKs4bN8w+m2H9hW4pGez9FYX3GRFZozzRUCSV9yQMIhH46yrqxW4dgHv0s8d3dneKT0bCxNalJX8kQh74Z2HdRqHDNwlS0kIp3WDK+ZDhTQD1MUEqNKZc33wuIlSyS3OGq4hlQO8FcnxoPyw8/NTvNyRdNkw4Azu7aZdWFadMSdjZsZpTw2qNUizEFPRoB+jKk1q+Uqg8LlPYRrEkcScRgK+ZCaZz78lr/SmBtldxSQ3RLOmHOz49LS53zHnWVDrB331mCzWcQyBYBKiGJ8rYaUYSDF7BIBieX9v94S8JM4JRfeTU7h9RIka5KwvIYiTKF2apALnA6uZywjfpi6V4D1W3OnrPbjWaW0R5UwqcRLAd8ludCoPMnmNqhEcVSP2KkKMaA6YE3uz8BFOfCIEyqkwZHENHOBxM2utU+C69pvWdiLvBdQhauaXMA6tQqVX+9WBnPD68QcRrWZZHTE1zk8rHBT0Z98QaKfaPrtG6kwVEVR54Z1um1Y6QbUhsj5YnAEaTlUHY0Jp96AH2zaUYDCr0JP0m+SgaFgvcauAWEEBGobtIxxCKz89DaIG7JcijlVw8xyG8xzdDQa+J16CG26LkooGzXHmOSC18uVSePHKC1BCyX6IjoLoumcTSuyzYZTQ8nQqSBAf85ZkgSnDMLz++DUxzgnVB7o3wmakKV0kh/QWMP5dAxBrsslwDXAEQrk8hGmASlUNaFB5hp9ikB1wrLinsjrK0KzER+bsus0DlxuPYPp1OyBcqpvkhm/QLSgXOE4olvqymVbcAau+2/fTnOjnW00qXQXL3eM/DBPKzGcu7KOvIgmbHdnsmoLfb+5jTwSSLdZyMQkUYQMswmZKLf7sY1k5rYN4lRGoi9m4FQKsjKaBN5xk9J6fQMsDm+CpD7Smt/QFIk2KXMor8ij/CeHCItCWq0YxiXkTTXDcCQQ0QJEnir/D+JD2hzE9Tp4NuAqCiYMbhSP8+O7TmgukYQ01qGEAQ4cNHPTpBBJ3lCEE/Y3o5YMmJJqKeAFrhWke00xYjUPHIkv4gXCa6XPB84WeBYMGaaKcgleUrj1njOXrFbpaa1s7q94NqPb91vJVrw3n5dfXqnjieLWut0e5IWmTcZSiubDru3MnoGPxNh10YfuZL2RoRZMzEjKOppyGmxfz4jbZhxxRiJEuLh+EBwxpKB2jvUyby4CKKjnGLSbOYrV5qfntvOn+ehl9wpickoxtnpgwTiWHViLWFFzwLIDGsEBjer1bbVI85atO3leuit19jMDZTcuAx5cPr1dSXVdmWbUpJSB+1KaJldsWHgHm59Fym7G5eNSDS5y3+T6SHYuLXf2mlvCw+aQ5U4ORDJYS718y7U/Z8G06LY1Ihr9AZbZkvbvhPWo7pGAIu/gYInrHpnQ4PDQIzNa74EK8DCse36TnCmjIo8sJLibKzugeY2CacXChdL1GRRfHB7S+ozManQ5mB02BzFt7XXvxDVVisgSszrtq5OT0NgqG6dlI1k2kWWTkrL6lMY4pl59ktKRsYU5VZLh8+C5791t3kEL2Xc8SGpK2rWIL1GLRJjEdTrRINVkupPcsfLEdVC7YComUMlkkjiNbQh6qrR/J66HdZg+GNYERjWnM5jQM9pqyxvGqr3e6uC6/d7rYb9JFtJxYVz3IN6ntM0iV5QB7aCd2Hl32UFz6PnG/4+sIjhJZIwcYP7LgNeMUurdbQ7RkppEKANhdLGPEsuxolK+mMfAoLsrfz993sKE3UGBPWH1BOMDDzDlep0Ed2gLE8RqNKnND6k3PLsb+Gf23Hn1OcZ3gkPagiK1GgnuQhGZfQa90l3R5dGSInYnqHvYriSEAhSQjiVl9od5SUbaxHgQHtL+QIx4bTGmrb09d0kWNToly7uwm0ho7b3k4CBcLcmkRsPB5LBpF0pUoUQVmshC8nN9Ol6BjtAd0EJut/fbfj7m1obGFt4UiImiRqKSVWS8e6agkIywk+UqgHcrPq0MS5XVZul5UItZyXOBSQQeCv8TZPPqc3FPVIZPcxlCK0OgMnyUyxAXmnDd6kTl+yaXL6czVBWrVVWM5mMrw7Ikw9m4XK6QZljYGSaFwUCkB7sLs5IKpuDLSgaSIAyF6hHDA2RqT3TgV5rI18/YFZvSpfoEImk2pYF8u5ck8SSUVDmdyJQXfMqArzsjvKHKRYQ3TKmQ8MYREzSWm2NOnd/93ePjJ8+effH63v2nj1TUTPhx/Lu/C048Cp8/e/T1o4fq22Kj6POHj35uvk6LX1+8evjolfl6RR2FDDnklO6RC+odHJxCAL26R55BKMGnVBukVL01ObZesm17nlPDkhmkw7Vs0k8gh5DbWn9uWkvyBhwNpm/v9CGQKn+W3kmq1NAkBhevS7ZlxsXkyio/POADsMiPwOG+GIW1jHUcZe08L5dQJeH36vzBD+BOHyvniww9lMcNkrNKjlJvqBt8n4yZe3h4KK29HafGq5Jm6bT2O/vdXgsUDTPW7fPgOUipUzf1B80hdLLGfKvFh8gK4Z6mPsmLxOUtKVarDF92XXFA6zwL262IJzsDO6S2RPZRjgJ+IBuwV+1eyXdmfX+Q75JtI819cdAcWgvKagLblsvCT7VTGBFYckte0yZ5QT3yPW2Rt7QER1OidNct+MwkX8ABCFOrsVf07Wr1RbZ7v5Z7E5CqCJgz1q69X5A3UtV75nt6FCNGMu5/NIz05g79yJyTMLXiItbWe5muYbl9WJbzc9so76scIHtsn7pSzQYhR5SV+DgTQXxlGTDJEDA6MnCW+assMxzctxDE4u0YBB5fjPEW5lSqjWzt1+8K3dTHxMaslbwiLfGJVUJHnRgmQJmryws0H9l3CPtSPywr9umWYg0RS9irCsk6lvmKhHSi8CgS/EolLPM1f7SzQ7oylhWHmjWQVwlBvr5vsvrQrepTFWDZYSHT1l9b977RTaIlAWOMteTYWZOvG589+vqIviZfN7689/SLR0f0Bfm6oWO50++JXWcYgV8YQQvtSO/920iVTEdqbdc1ejXeUmJNGPoE7tlPGvGsJM8nlgbHmnyS7wr7bmutn9xqcjR9dZx22zli31WuHeKsnUJzk2Byxl6pkPob9Sgp8LHMY4QY5tL4IpLJU7hRslyKo5KtcEPE6gLTZLu6erL8qVByc3hpS+WMy7kosFHLysZbuJ5nxcIMfUo+weTT3PSaw7VjjT+CUh+Vr/FHuTX+KFd1doi2Vv7Rj1jqkUOcsVNo9X0m1NtS9lYT6skJ/Qam5pvyqfkmNzXfFLa/2H0CwgS+x4J8olHPT8knEt38hnySoqAfSdTzS/Jz8hkRoogoHj36XCKJFoEi0svyOIAdS4W9YzdAORfZLWIz0ARWKr3HSj3Q1HIMHymzqmQbVUZWF0KDmpf1YrUqImthsSgslCD5hAfygI7GWbFYlJAMQthEB0BvswBfrlboS4mUMoFGY2xlW1rToUhB7TMQQL4uIXDxNnpsvoZlXz83X6Oyrxv6l0LmletiHCKCqIyVmwI9ereQej0Kv67EvGKm3Pjfj2eV0VtSuRhXtPkmgVxvYcfpLH4uKJS1HIE1GzOhQzn+qJ4od3lbG5wUG1ytNufHddMJ+on6c/sZmdkGLd/lNkVhFxSWXS9lVtNcFHFXoe8SLQo1dFVMM+9KwEgZJAc0HiTgaUoJPcMRH8b1xE+koLIqnUmjJbjyjIZLcEmSkCz+Q1LzDPWSmB9i8zaUWiBZZ89u6mxpRy0t1K9LHIBb/U47VzuMhy8R9u8jRqKhkL2ve0SMvLFU29joccy3dHkhcoCdDacCMSKI45BriAqwxv5VHumbWoPUpfIggAHWJ9HwiHDyEWJY6WiXhiWUtXESAcWFMfZPRaH8p+9X3tqEV/Ym3IBSgAvLeq8EoC3AeUeyeYGHn+a/PQsWgLxmNZ+KPC2ARI5lZTQ+LNd8uQzWZF4IK3KxJGSFNFliVcpyDtYktGY3CdXTuISlZBQzX2V7SGRhDBHGICGDaypLuW1fjAC+XAuKfbcM5hDcdfMjSz9WTT7E7GvmsjA7+V4kkkmcqeBojkeawHSC+pAm5IocH58FyVmukEkyH61EFYe2SqUDNMWPAy8P8mWmXmaI4dwq2cyYJjWdyEaiWCuSywjZoZpMo1BfRSiDscp3fNEznVYtlGQywmoNTRHQLBEoBEAnQ+3y1Uq+N8cg6F+D15G0INDta1VZ1csJRdUQsJ2kR1K6DS06w3Vzr8hozRkN3ZiKgaCMMBqvFf+wCuIaC4G1Rsu0th4fVkXjLIAt4IfD6oUEWo1TJlBEnmHsQ0r2boX4ngNDT3oWN/MzNzuEUmqhZ8+yvaesQm2lmmfZTQFgW+eFCVM4mGKf2Mic7c3ubtO3WUZY6sqmWWWTPzfV/3zwc6piJaY9e2r1zNaEVxe6zbk6FlYg+c1RHOdHkeaGcTwVSHLTiAmXkwi2qARK26tyclVpguwWWHNNUghP5N2Vvkktb36enwp4OCbsQEULq3Mj3E1EwDPcmUVTgzofQ6uUW/NoTVwaYwshVhf4Lq97uOaVzeZnZpifDT4rzua5kCaghRhB904SabjnWLN5InLs3Tf513fy1aJKAbOGrcwQE+QjTJjIK6mVU1ay47CxgXeSkSejIxPweOyzdb6ubYRewdJRVWRZttc9zWSmkeQyG6xIID5iw6ge+uGYKEu2dJ+ENS+FK1u6EZfqBu3uw1YUyLR1GGl8R5CQ6O7Van5YqymEhzDEBfkUE36rWbY5x5szrpBbCInD1vkKz4LycGpWMchjWTgB6kR42TzdtFyqOhLRjKIkAOPTiYtBieiAhoPYILkQzZMNw3rsx+OBvZzJmCT5hYwzBDfe0sGdC3mr3u3EbHOdTXtVOwzTlU4I9Fytr93D0VQfrEgerKh0dg2+uxnujeVMHHJXU1ZcAXQ14o9RnkpXKubhDD1GHGOzbuGgikLK9VWq7lLXrXqAZ6BQ3ckkqtXUOgxwJskpH0HMf9wQYr5zDHJ/PLYC1KlVeqkKRDetHktHmqEmMOIhk0sH49S4pDmfoVys8PdvsdIDlO7KVHIS0hLeiD5AhhobWAAwHMVjEpu1Ss+MbGJQRZxGuWUemB3NNeoF1B7o9SVEV5hYtSkFvuzwhb//q1+Yjqh0OnYCYhjRYXq+N/YA9EpOhekaG0ihpd4DZrQKfkcjG24/k/vimbgd/zN3uQ+dV2zBAlEZjR0//W3MmRUWVnPMO5SoORURnrME7JrJs1vdFZv3gwn+nK/AuKEsuyAuhI0YgiAwX1aqT2yHttBzszRGQCr74hvs06r8nqTw6yD15LjYEAfyIWFb2a2FEUmvnLca0LAJHpjz5edBIp68Tx3pYDfruvnWTF0UqNnKITR2Ozx/qHmG0fAtbe64CEl0C+wlOuBquxrIyHVfsP8SqWOQa1eRwmVTJvJEyLA4hWm9l5JnQxg6lqfr+ANPl0T21eFSP9OzJVH2mtNoNEwKi6Y15KW2MIC5Dx2gFLIibOE7xqsAOf7A06daTvHhO1blrFBr6ZFUkAuJulUdvpvVkt5nh7QJfpHS+VDu0SylM4YL7ZUd49yxTevSxxcJCuc0SyaIUTjAWQo+oIrVfSxQEwIY698yBxC4FtmESZrKNlLl4DDemKLN86lmKDdBEsD/TlYPpU1zDJg9d0Cay3mzzmJ60vQtUPcKfbgBTsiKdD/lrj7+AHQaOiKjDGadJSEoaFh7it+JfOvdINhcIdgWdkDiDWR6AJ78h/XIj6yb/fh90eoP7eUNiHY4SMk33UkSH/IUzwbUJFFw6PgD4NCxsPunrOPlz9QQLpqqVBZNXTcbmsnKFhJeqfMMQOtcKNW1E0HOBfx4Y368Uz/OhZYFyhypFFBmk7LBdyqA83NRpl8jj3B4vpy7br1ljjS8o0ydibTwME0vCwdDISb9novECszwIvPK4DW9UO5ENYTE4eGh18V3ohq/g5h6OTjwuqBNhVdNS//sKMdXhpyu1+y1ex2v3+qs2q2W14I4o55r8aIfCiSszblaaUWM1GrhZlaxKlrgB2cV5WqqyisiM93VsELXObD0x5QFC7DEqtAtoZR5s8r0rb0Syr5LhadA/Bc0W4Q7Ag/EYfY+wPwXVNy1cpiZPhKIY2kRkLq7p5ZLQW0k97UYPpFSpkdGKlY6McDqBTd+WXmTojDdTNaWtXFvZ41ptASdG9pvZFomxrLOssv7UgWjBvNepwZo60Sx4U5YBTrDpg2bNfXEEgh+DpyLQXnkcDlu8hLW4j7M9kswpvhcSId9L0WtRmRhyjCx5CiykO2sSJuUi5wVJG17d1hN2D4QOV41rQWyOZP3sg7DpL0VFgseMfq94t0KjDN6wjbZQIyK0Ssxzn+uvhb4upitsdCcmCfJo9Q42XXL0zfrtOt6ke8RbDdGa7UvBMlOqfsFzOsX0jb3rcDfi0bCRGZ4kuveAwH8AQo/8ia1eg88j6M6eydYpJxWqF2XVALOKrAVTHThIAGhbAKWsuEMvRa43GZbkFcib6DtkUkczcLTZfpuBcMmhsopsZh5v0ktir9ydoub2fGWWrbowty+YsufhO1IgmzfCpQVFk0OJYqnDFjFmwv2RQQlKyKuJExo++ZswiummUoc6cWENROqpXWqLSulIA+E0TMJk0fpHiCvc2pbVlT58jW/XhPndx0Cx7vaLAadh5iACGe3zwtzk0iRjRnlYRPrSE3WyFVkJs9PAeQyCr9bsicPVSCm/ezDNJ7IiX40Z/Bw3Y2ktOxaDvx7Qd6W3tpfseDts2AxAFiBvhdSMUWnKTYHHDrySlDn+Dg8P1/KjQyw8vjYGezQskWvBFW/0SuBVVVfC+p1yX0BphQkA5LZXD2WcwWSmKq81lIxyYLxWczP5d6sBCozBHOvBFEljGZhFApWATQvB78/FiWamSFD2P9KKCuEmbJCgDQZHP7ZUgRQNLGFYwY1ieAueiwQ10QFT93YIQuTyTSapS8AxImKC2tLYL8qUxn6REgzg48FORGYfCwKSl/Gz3TecxXJ0KKdgxBFmp5l1wxtKeFfzcuiam6ewmdhkkB8DeWIYKZ0VkBVhUnTfAWR4TdhI14DjQmJ+n4sPlAD71mwyJQtP749VXvM41gMs5+QHRlpmjTD9lmhwmRbhTGzgr5ulHkS7eiG8lH4BL48IyXcC7Y5O8ogsowwyPrxrFhIWWc8icqKFTtS0o1nm91QBegWZ3peqQMi2T3taChtEMzAoWhp/U+izRZWK8SlyFotluGZn+rRH3NAsyBrSpkBmTFUuf2o0M5kzgJ+IzcGfmnC7zi+jBh/8nCI0s/aKFbtJCqdEeq8AAKp2VQqKZgLpt00qemAE1no1Dnjp2WX7pkeo67Rvkg3y0MME2rDp0IljGyACQ+X1mSvQkYzbxYelO4oRmCEZfGrStF12eJQPw3aAJ5/+CgTXI7X5T19yNhix7wt2A1zBuU35u3G8ZrqpwwBwN9a83/9eYRWh9bv95jPJOaiDFx8ztCMG4ZBWaH7V+UAzyrISorm7qQylhhMRpA8U7hFJvoSMOfgZi25p04WwkNg+LAoWXL24jJ1mpaeXAWBCs2nNW+/dczBt4GB3Qpcg2/wRr1Pzs9vqtmuZWNe0oFtL69hSqHkTYrk0F+x/e7axtwrZ8BnANB1s9+NEi2irH2QyiEGylHgOwcODiYMk2ijI9b8lN5gBlYX12gYsQxKWzCaCBtAY7+wQVJtmAxYK//raorC5FmwoF8pLtd3gnyyofP+7N5LZRj5qaD2UDIc9iNhOVEvNKsVz6jFBvgmVSYqK3ASivNgYfR2gFBIqOVr7cudhZVHw21lf76z7Ft29QnccCzfcav8Z+9b/souLViu9LF2w6hejDiNZ4pMk7dUqBV2Xc6Q/m2h1IzlToC0zYOtx6TybpqN57Jdw6z4Io1Sf3y84OwC4rKkBSJWVD7Om1t/KiznhsrAgIQaX2AktLYeJ6HeljQiYX4LWv4PQ8sp9HditULfCRox1LTph5jlnCSSUOrI6TnR6tDn6CmYvp+jY8nAiGiSThsRWbdIihrDIGOSYFJNtIqBOYSDUCsq1lCsPg25xLzAM4LfxGtDzstUu5in7W/hUFhtjqRl5DinUZ0hX2oaQyIM2kVEEeEqYFvYjwAghCTSSFc6UwmznTKQhCwz2DIU+gpG+Qx+LAcnfHSClpicoESFd/hMyEBuo5DEebMNljNwzussfwZM2kLazy1Gc8BsFxxyGc3ZydxcSoD+c928tv0joXaIFpOEIqURmJb101+Hh4ccu5dkafJEfqTTbPnGN1C5d3CQrMDhB0kopcvhyHSudiq7N/ZRTK2ZCDFJDpbDkSDx2B/FROSmZWKdHNjHirfwJtMoCU1dgjwUiGMy4iQaYy0gSglUSw+LjUADi4Zm3US2eyH8KUnkYU816dKuzKyuZK03oSWS5A3Ewf1EQMHfSdqB5cFksAQ/FQcH1DNB6thoOR6krLuZ6y6ryol9uKIBSUZxrTams7Qzeo4FCUnOy2pu9TOji3y3LmS3BtAQlz3hh4eH1MPJaDmmnsuHTDZnDHZ0k4mMzix3rrwn4pqXa/uMFYPk6hgFMPt8Y/aX0mkWCWikQmgu8WqFAhoUrAiyk7CQwnKMMdFRAoJ0Nq5U06HVmwUrEJqSqZWitxDyT1owpCkyjra6a4fCNlCYsi0RsbWSs7RnztEEUHmmsVX4KFFq7XlQ6GoGma4FjGLI/NC6Mq6KQ9F+snhjFgLQKp2tTNcepiwlrP0sfbWywORqBToBaRxRcRvGT0TZMG/tkQLAKMekyONfz4bcZ8ocRHKx/HyUcaG5XIBlau8KZu+A+isfheOUTyYr8HPgEEnvZ5YRyMZ9K/tAYsosrahYaUUZQSx4DCBLGqEkXZolhShPwl+unwoUrlZyOyVMECeMLoJ5OAX21ctABiDVzha1MuEEnPH4im8UgJr7DDgQE5Jf+hmldDIU/kzdFZp5gwLso1DxGAWWMxOQmW2ZkrsrKBIU9ff6+/vtzt6+i0SdCime7LS97t5er7uHcQ0knS03zaVTOtht7bW6nT2vvUdEDcr1idfquUi9eF3b4mNjUqOh8N9ZRv6hcjNuwcxnG2WMZKombRgi12U14AaF6YWv6hCDDI5lMEz54ZFwbHkQAhTDS6m3j2KAYpwktO5hX76I0bKW2WXFlo4+KzqHTn2LAfahVEnSS1N73iOicOOoDkVYg3+waYukSVuiJXoxheCmowR6ktQyPdpw/alk0wLO8akYXY3pp2bhSfrrSQSphi1HPtrFuSzeSDaurXuX6lHGB4kExiA1gfMGmpBwdHC6gvF45FmeTPJNl3L1DNKT9WFJQ3ncgnxfJrRJZqkP0cHkYOa6YAwSkWA0kd0YTGo1rD3VTA5msB7zofzogSPG0F8WPQNKvAotV6vqHLvuCYoxqS4VXAtShUiQP85dt7p0XZN4SI9T1FTiGIG8PWXbZzIwlSEaDbq5oGfDwH+Hgsw6ZbgcTiils7o3XKht4i8glGzuZRSRcOwv1O0lXzAxvgkNRbTQvD6D5ZIFXq/JNzevekGGLDEgc894BwcIGW8ioNsgsCuD8lhE4SC7XFDshnio9VckoTe6YJAIgcOUyLd2qtst9O2GbZF2kkvbkocCRbqTGp9kQ+4DNsIAxwyoRB4ndjfJjEIcv4kbKN3omeuGOSoBdoPaNhcMskEwtTOaDYUs6Gx4NpqnkZanQMksiCCsdmr1FWoHhZhFcZ9Bk1PXPUu3z3m6fST6dUYmZEmmsgLY1lPXBfWVs9RL55Khs5H3i3l21tSrLjCV4QLz+adp1qkc3FXpvrygs+F0OPEnvwj8ySogT+X7JUNnZE6m5Ar7T9XvK+w/y1LNyl8NkTXR9MIm9J9a21LinRfkKV6TL3/cvtzYk2ZLqk0XZjB7GBf2nR8VWv+Jd54CWxN748zoBJD0cIYCEPWV7LiEoVnpRpoDbNgocEYzvopc/BQ+1etnByfptpLkxoSckaUijStntZqsYFG6Dab0EvCLJZmThRU2HGWN0TN7bafW2krM/oxM8Zr8/A/upvn5T7SwchHlVW4UXjUVa5peagVPeXfIKyPI2JyEEwWkMxLzD+YaWyoIMrOp989SlVI9JBKMvF9Mxv8t3Fs/3+jbAq/JZzfvp4wM4hafD+ZxaL16Yz8qVHfTHslhInKB8tXr+UDBMDSTlra1xPYmWSrxIKyLHwxLZnmIcsVpaE3M5qKp2fTRxt6T+1h/xriAfBl2+8/LEncq2JqdG9EmCW1n1xGYqEUb9oUhqKGOM1vpwnVv2vzyvTuiQOr2bmiuY9YHSaZpTay4YZsUZZ0r7IqdnnWEtU5SnVUw8jUmwvJ3qTy0WbIUMwZBMy4zYdTiKg+MlREnEVCY8ZSRkGoF7loNBhHpNnWQ5IzekSxn83Vt1Lkis2ayQHggna+btHTicEklIUtGOQb4kEtr0axuU5tcDKsuPfn6Q2klcjlkl2LdY7sDJm2QmxzKGYqBsJ/EkQijJVvnP1u/G4p1bhg8YIAhAdsxoxd3O+QcHi1yIt8yWckbZvn/0WqgBUVbqS74DvJlfGW1LUMTUdq244EFiobMR4/leYwggkN0cDF8zVCTROSUqICC7LLykCGeOdYCHxe7eCiSd3AUfs9QVK74k2NdZSwRqfhjUeLvWInmz3MGa4zeMPJGYPKGlbt7gtnOeXx6wz5QtQa8cmTerd6wHXDe+LRIDUOwtEMQlh2CFhu+MsCwZmxZeXgaZgwT7rq8oayuhXs5zvTy8h3YqovzfU6emSuzXXHGtnNRuKvqt/blL2xVkLqnPqqLVCUvZNxYQTwjWc61G0YJ47t0kdLyzc1O/yTqKfZM65dJsAgmobhKVVfm7ILN6amtyKJ+iiCcv7dOywuGiiMBNINuONjJ1B7YprmdTNh23Di+/pohTqTfT8MJGGRcTPCqb9Ll3SPDwyFWiwjEpFImF/kexmW6I1/rHdUkda9YQgfK2zGsWw2FyaEwUt8cR5m2t4YbEDSsZBQbPSqMY2MQ25SN7m9XNtosv03Z6P4NykabNW1R4blfrsJTXv79VXjub6jwFOENgPUy4JHtD7FR6kMtS02dyo5UW5UWa7/BpqlJIvrAzD6+yaA8smzJKaX3WGqgxMGaHK+3Nr8VI9RooNUHBNcxBk899zLrfL7FLv8N+2lUQF4zZMM/kod/NvTLKYiksO92uiJqdsIELk36jikDKFbUC3n65Oi1Ugw5YtQeYIbsPGSWYkjOO6JpkK2PGCADAG2PGPCXj8zlRo6MyumnQv2AlFT5M82nMpjf5CgltT41sp4sTeU1v8mRPmYqWf8kR9bhs76oV/ia1+76tCCJIke2AtanInshR3kVqk+F/UqOcvpRnwrrjTzcvPzvs1nMN5VWTYyjoXdwwPz0apWzbyBykcsTUclWci8lNnq4vYTGIkfgAWpg4sBAGzJCDqj4a/lUVsNI0UUopAnEWrX7Dl2unwI0AMmV68Z2x9aamAo3erukXxjESDFYY5wxP5qD4CAaBCBr0e0HY5qXEoeui5Zp52iIyXJdNsH3ZoLxbfOLzARjFYviFpMMZGTdyyY6PLyhlD2lsT2lirZBEY1dN7Z7m5vRWPGdN9qoe7l5VgtmTan+aAppjC4Ej0gg7c86QSNMEkX0PGHkESP3WM624QHLXRMp1JIhTlOgFdKXTImmhAJSaQds5R0F1JqWlUlSInJmw6XSBw30x1wchYzPksj4I3L25GB8KSuVP0lAk0M+bPq8DtyzqJ6iPZPDC9dFE3qBSd6fRQCC0dR+j+nIFmxYr0/8IAtDv3Td5UiM17nQDVnglCUJqNWNCY11N+LDw5DMqFdDkfydCWRlh2aFDknXIgPZsaVB4ZaK0BTytsqoyqXEheGkTWzmnR4Cl0OY+ZNabbCkCbCUAzB2COunJK4hfnAQQmBPazyvWTlbK68/dpTd4Evt07YuyDJD6sky2x6UkaVefcrJUm0IGpGl2is0JEvr/orJUt9tNCFLG5n3yDLr5wtL4ewJW63QE0Yludwkpzbp+j2zTj1iVMU2wFXLIlQd0lRfgVmh1HYR1xDJ4Gt1PCQG3IRN+7Wmm2qeSpQbGOMBq2WHZ5DGCJb3eWh0BEms9N4ynwMvpfKbmUc8jOhbhqKCIhwsVoz9EL6FuW/pmVNZiFGDKyiuqfUIie7QrTTXXjOUjofYoCBtU6q1WSpjb1lpoCEq9bqkMES47vJA5OCcvBsCKxxmmFs1bkDrxDp2o+WYzOhbrfxQP9UNFlUgEFIQE3ZDWpDOQNlI5wzsKiW0EZqFmpbM3N2FriuVNwpgemgSFH2eZPXlVaq+yCuCgv2k0gsQKcdX+PrqFkMzSRKhRxAvKxek4hXL3ANtbKJsbytIDZkOvIMDs3C10xwXVW/NiJrvA+660WFzgDk1nBGQq0Xu5ZhEdXqaOVDJOvR1pgGR+tZ0tX08saN1IA5J2QHJFIekFp46K3rXxbkrKKFhjZGl7Wkw9vlBcxjXuB/WOIwzydYpzu2j5JBmEhLF40A5cYzZ1JN0QmaggVGbHTQHeEL1wkxcd5Jb/+FIH6DJGNYowhAODMTkQY2e4sFMhpKiMxLCvyX8i2t0lrU8h8WLQbL8koH+2tkhlA5qp7sbTVuDZowIT0ObKT07mA/lDlmC6srZ4XyYoYQR9qXfjoXrnh3OXTc5iF13kas+2x1XdALnYAItXdBgcHF4Orio01N1JJ/R+eHh4YV7ObiiV3qjPBtDgewNJPvmDXKfupdjuoDLbAkNoymduu40hx1FpEmWGJPkkJ7hpE7PyBL+BfSUTBRbKFdIY6lQKsmMl5PDcLU6O5irscBSTjRD/SlNDg8PA4XdPa1SeqZelaOuwVO4rGsyHFqA7zzFJKjLhvUKjJ6O1xPXTQ5DiWVMip0ISFKfYUwmrnt2MM/lMaMLyJnMIvdGHbZF3VLILFM5XtYTkp4KmtjQmC5TeEyDVDN5kkL66e0gvY4HB/LaLIzMhh5mpPUwtbZUqk+3NHKWgHKAzRMagmxwInt/GEvNJfWCSYICUNCEablZQTNSosJJOjvxYRbpiGa87xhjorUbI2sEL3NKbQcXw6YvJG5/enBwmmX7nJWY434FUPcxU3a4X/1XtsN9bPdI2wTPcm65P75B/f9zO9xa0RBgqCbRb4K6P6hoSNOAeZjsNg2w7IQt1OwRoGaPGP2YIWktBmibPZbvijYBCqyDCk5ChWqWLKnSy2CYBHqjgt/lQaq7LxUaggykK17WIb1wXfOzdSeWv4YookDIlmi1bvgEVW5IQEVaqbfaMXe27k9wwL7GuDGbhwuUegy38TKIoJu9UXtKrVwY+0AdGhVNhklIFYah2f5JilPARlnqexRncrcgpXSVG6glBkXzdJIiGpOQmtLStkGXhWYV8mo8UZl86l1nHuwASpHKqJZSYZRyB4WbEAf7HwNstNWqP7GZTpKvl5pALRP2WTGeRjEkxqcMibLCpZk/ep/M37xP5i8tOeElt2NTpYWZXZIwuWnKZGFrUuquz1jX60+WUwurrW11mlIgGWFrUnBoy6yMxnWadHJW5knNznwWmHx2fKFnnOx08WmrxwLfZDPMQjmYlExbhjgRUs9bcsp3O840tCAg19+nbVpFUM5We0sghshS664KS6+bGcdJo+Z4kP1MU8HzevqTps4wxZZIDbKbL4av/RdqaJYlXEGrOLfDoty+irYvrVmtKC9DzSLr0sxzuqlcGRqFvo6vxklMIilsiHatsF3lLVYYyCN7jSOUNqc+SqJujUmYazjmu1u2p/Z7Et6wziGNrXUOc05Nw4FW41erSZYUjHlS+Q2KyJKkXQYTH7IkQnYX41zcvM9yLLbcMvIUUPDcgvJdvjvXRMgzD9TUxuFXW/RLZjWy0xGovsZg5wHKktsktjKV2iWg3Vj36kpjl+d3HS/uOp1X5twNUnLwhxfhCi8T/XwoPKlkNiOkqvpWJtfK603kYIcqlS2v4EVcLH9SwfgqP1Npxs2jF1Ypfea61Wq6s0J99sL3OcCqlsL5HcZ+COdp1yHOODdyWiWqf8PUxkThIdbxhaRUN7JWIyESJBrG/rIubb0w8KqWaxLuPNV2V/KnOsZ2vzZPtuGuqulJNg10zDRtmNgEYK43owHokdpDmpGJFS/oPgpJNJz4MLQZACl54i0EmfE86P5YIFzqSqB0SuXAo9QINNsIMcCXZrlFVM1TVASJbHmVbXPKC51aIoBWVAYhGX4O9M7H0se13c/yDkqucbjRQTBTFaX+IpCMHTEaY0XQRcNRAraZMSZC9VrHBrmwD05YwMAzgDGFkcSIFcimiG+aBkmRdy7+/xa2lI4+zmnoSxYVeSKN6sKCfldMH0nbOpLQe7AmaldVabxaJVWamMzQFROoM++zW3dwYKQJST0eBJQGIO+iwUFz2PQD9XWShyMT7flFmsAufUMIL02EG1KNXDdWxOJSakOhSR5kWDBN8cmVlBxr17fLoYbxtVh661hjMrkVRqfUtk34m2XOgyS/lUd3ZmwZE2nmWm2CSvQtQLqkClerKgpoUqsdxOliTWo1ojFHgDzcn0DsCey6E6Ar4ZhM1mSyE/wYNFJSoq7Lb+eGnWVWmbrgBuZJ1CBvgl9yQAMcWtCrVgsO0+l9ibAWYoUF3/yRCqb/YihkyLCg7hF4f208EtmYqkJWJtkhCvlOrPM98D8ZBO02q5+aSSU3L3poWcrn8MUldt1aLXHdSMvrYJGT98Ec36PPMbc7vYFzkiWEO9rlXb9asoz2PSVy95QYGHCm7qmAyoBOExpa9g7pbExIQGIQJVH6vdwCkeTtCeyjJcjYXiK8gaLGu3GY90EZlEbvbdYdCmplgWruRGzHNCZ69uDML2kO45hkzjoDC+UINMqBSfA+CMd7DCTm9kg2UZUiPNtCcZKYzAbTWCu2LnMhJ3B2ssP0ZIcEoFy49WRrcxGdNojpXCE3c/gM901ALfwG7nS8vjwL58wy7AjTTQTYx8zACwvVSbhN3GicYiTGYEw9CcRmPL8MZ5Hqr1zyciEZ9F75cCld9gYCbcTwE/5IjBXGcGuLdXMphUXVDfjg2R80FICDJRdeuVfmrrtEsY7+5roByq4YFb9sYkKmZpQmHwJ3Oxe+O0DCcL2zYNiYyITZPBCCRajaxFhd8WGDs+lywoqsSxuNEWbG2SaKk11VNb5eY9LENoBf/hQAHmQAVW+QU5KYGB8U8vBsBeApClJlq9XkgGFwcyDwUFZR87AvTTMgKx+GPngrn2GJHFWbYImrGALmoIMsHpP4QxkEkQLWo/Et7uN4YFhDNstAdtZmD03SAye921D6vZICAWeWgBIiWuotdwCBBKsJmqRbihtwXatJgD1YKnwZJPt0Uuy4YuXGdKnYxLaNQwG6B4Ulz6HZGZ6aP6cSGpopKVIhWDHA052btTXhO5gdFovDdVt3hHGBz3dtOxufB4w1vvmiiLJNFq9WEhHkiEnX9yGEQNQpQqesMUg44zwvYFdHSGEfvYDyyc3BQVA1XK2S32nBhs5YTwrGD0M/+Z3W8D7iJJEasL75aRhQmrNksR5m6cKCFIbRp9yI1jUgbsqeli8wy6ZpFBFGQvDgMoR5igBZYFLIkVqimKHF0uXedt7KqD0mfNQeQ0DRUWtc56OWrCj1tTHMA7V4xMb6QNDW2t/8SCHOLazQ8FNpLKfCucbY/wbFtssabqkBpbNh+2S4eRYYMaOXXS6BwtlQzyArdI1wONrg6sMKMxm5bgRiIosVf2NdhJtaslGdFU6uMt/JWRW5LtdkrZJd8tWKVykHl/6HVmjlBdfy0XL8Tsl01FUW7ZB9mYu1cR5GKMdL2qGVrRWYreMiJU7NkuBamZa22fSbutrhTYicyMB9tH0oFGaAfIzCoSUp8YUiHMwNtxNn4+bzUrqIjXdMmxojVL2kvJHE52xLRpgEKfxeZpHxZHg1JfqBNSZ8R0Ny1qCCDUxtyvNOhGXwY1Zwe2NrGV1x4yK8aoIc5z3XlwUWH31GKl+OK2K5mDMVMTyr8JRbXQWn2lr4+Dwn276wcwEcG0bq1Ie+5XPl0s6VF34jWehTDSr8b7AlC88qeMaLpmihYDne8xCVpyNMiqZOMkvq8xH7n9gWVVlJdYnKLJb3mPy6iEM29HxxwMCNnXWApZthzd7/OAvzLumh725elyzOO69IVZb6PHyrV2gANUL1eL0Z3f3cvtCJ5VBWm23awWGijLNaGoM2ysUfhCpkPoCEJkBwYjQZIMoBDPM5R0oapObb2JcCCIiCc6ZUFiAzTeDXlM2C5VzIiBoJFWu1PiCj/ljAhSHjVqaLU1CbOOEWSLSOBY1ItAatCvKxwOTz21pCfv6hlpAv+JRxNs35Gv98tz2kHfrvPFjoiBqDoroD1y7IQVYv83DQGfBZof6f1Ek1dEer3BGrdTsJSx2bQie22lx+l7O5/Px2Npff5ZyV5wrdwuMtjCHLhvBqZY3E/lCo+maftnl/trK+0ljYOdXRTSe2W9rd5orXam3G4/NHkeBXCj0qxJTcqHebXZQO257fDhsGFszaorm64C7m1OpW8Ws6T8OPlWfMW7rUtRzVyoq1+1Y1sDDJjhp9zHJj1YFnc0lXY1qy3QhDnzCIR/wJu308AHllnOpwMet80Z2Rh2VBZTxcKCbv/iP23dZdLIuaXKhYfIv+ibLLJYx+pk9QtZl3GG5UdgDp3tkH3QGDiGfqKZiwQl9g1XZ4gf65BQG29SXa1Rde6AsgVHIhpDfoT259hElUcohlzWkxlOvYsFzErD04rn0EFh2nXEcuaGZGI6GVOSRg9cF9MJWMzPn/5FbnP5zl+4O393xbVNkNVD7KdfkmjYtiKOHQkL/SOfKwDm46NqneT1hJSOhPGcS7/JTdMjCrNQSTTR6iT9mP9EJeWPAyqA0rDMNKwfWn7H2jcG+Z+58sdjNJozd/xMg3mHz0fiApN6Uf/ZhL8JbTybLJ/OgnmcwfNZHpJBYn8xt5NXxj95Dp63Y3oNawaZ0v+1NOpgwvfX3FETN8owRl0yBQNJTXGPKwLxEO897EvsE99AJ88/u8ALZKx+5I1GoczGLCyhFGRiyboMz5B6xbOIzMmIBlk7578O5Juxul3/EpKyfq6CfbPny07cM32z6AFiU65zLU0jn/MOrhnYbENScjHs75bY9yjn6CrC8uoyyqXLGqUr8sVcu9SX5dOC1pxApgmGJswwIhoxQS8m1v0CjhrIAD4mK1rpvVa5MfA8sbk0X2WWxmdr4QV+AtXP+kb9QsyxApuDgtyY3TUoxj9TMdUSxhorKM3kbxJRjUXVWcn9VE7WdOJY4qP6uZlZX73hpUNRuVql5blpXO9QZKzmnp/KSRDEtjkqxWPG3BrJdv5oQX56OEKtuyT8oIBbtXqiabHCh0i23vFit26wPovkINt4B0KUxDJeuxwWm1yASuTSKA27pBlJX2gv3X6QQr78NPRhja672dCnxjhfa5BfGnHX+dcGp32/L5VeC05xlD+u2UiZemqAxPbQtOVVtRzn7GEsK94zk+rWReFWIwNFSa84pNYj51srLPNQ9O8KtrlopSjnjjJIymKLWzwFnQR8s280gX3hYolJHrUyb8LSdALz9J7Cw6GmJuzoljgTAQOkaVNDBjhcsRNRzDOYVKiQDsIevnQ15ih/U9R9h/wnNhEb/ntzHHim9jjmXpEgXTKdo0xXrCSxyj3QNDmhMO3IATbngAJzzze5KmFvyenHDt/Mj4K0lTpAOhT0X2kn7J+z054Zmjk5zXk9yXXG0mIZdj02fKCS96cjnhm15a0rSCl5YTfpPHlRO+zePKCbdVVoseVxh6yMk7gclDfku260MueVrSimgLnoMgAVjMShNDlvkwVu0RExma9fDWaFZ2VxdKBdNpWanXGtoV72fQGinUsJ0FullJep0WK9nGAN6sQuNRxQqWURhH7xUoVIajYJR9QDgKuOWbVYs1vVrlgZPy387sAh8Uk7SGYxlMFJcAE9ugAYCJcbPoF9FKxGRsifx8hZFgPGETcbs503pNrMwlDczhNgFhrGDcYAu1uGNOyuAnx9esAVy8qy3SyJQnIJ1kwHWndxw3kDY/C8nyRPDg/w8nYYdENz8HrnvTHGxzjyf7Jo/VttjWm9W8v1+68lb4xkJtCdb4lueDNW4U2has0SrISop+EKr+kP8IvonCPkvYJrZRFIpIlHGhHvL3lsBAK9uxb1aK+z/k/5UEMhmyrSs5D94ydGuEGzoaJkdM0CdctvWIk3u86KLu6JH2UPeA04elqPnrgoC8xBqZ5ayRZQ+VbxVFBfoKTCihZeNYkfAI+yIdk4XyvchpsOXpgAd8Q5sts6bnxpqeWxPDbH2t7y2dg0cQV/gRpy84Cgs282/LMOGXgAl/wRUK/PK/Igr8hd0bjYpLbwQPOGDCwIl/IHHhByku/MDGUs1LLlUCpgcW8gtfb8AgH2zFIB/sxCAfcLPk9Huu3mDR6QtOGHrLyUOOydvbIpdvPwC5fMt/nB5ADsd8yzOpJZytL9TZesXJ15y+LT1A93fs6K9/3I5+ae3oV7CjX3F6n6OvCjv687Id/Sns6Md6R8OLcW97D5RkbH8YZUTYV0CEfc21LOjrbI1fcvJ1usb3YY0/59KD9ee3XePPP5gYAJ/jmQvrz/mtVTbOWDCVXoEsn9bgiEnU68oREzC8Mw1zLcX1WaGRBWNvt3YU2nDd7Leqo1hDwWuyxrGKsdVz90jBn70MyVkskPq3h5YJ36iw7g34IaDb9Tpm9FoNL80ELu1gBny2Lmf85bxPk6wlymwtgZu8SH+S6jwUp+TefF68XCW9IqS/d6W4n5uTTL3NvmYTadxtr/mms4ZyIM1AdmjmRajJAO1+TLZPBLMngr/nRKgQ+Z/zG/1Vq/akozKvWKLEX7VdCmZ2GzJbVk9xFYp1acCRL7u7B/HiVh34SRWi5HrkV+A2i/IpR8VulTl8DmdIOXdOG8YlPP9HyPJsIkkpcPqcpdjUtCn+RhSbzhQalSpG5ioqLVznJMxteIBooTZavPkwR/ache+5h6WZRH7GfoRj50/4RmxvCcw+PLb357chUKSQ1p6nDFKkRZDI5l554rZmPEqVqrVKjHSxrZWqIxrlVoJv6VXM379bsSQiSvu0yzgySn2EayGuyay7Siwv4QWjl88lxSGjgDxWeNHHnHy1SXO8vvfgM0V1fMfp56VI0yd8t4ut73hphG196XxAhO1PLVTqY0ClPub0E56PsP1RDpOzWRDW0RzxMYV/6VVpa9Sy7H7hmGRyDUvMe3R1fhLPE9fd9bVQkVh/xwElg+P33Y2Y/HdbMfnvdmLy33GLwi943+aNJ2ajfk0+4oiTa228Yos2HgtkQT3t6icNvmnxDws6bpnS3BamACyBnHh5Ja+JiJ9ArB5pAVgiW4E2P2VaFZyI+NOjbQKYcrMVYQckdkyyQylsgHgmrbo+PRqqB1C6ipOg3lSDL57/fjT54vnQ/Chr1hhFbpmSTzIVQ8j9LFiUZPxYr6FtYimzq/1603JfF1HI2y1npiha0qXP2bYupYRaSam3HC3VFhgWNpmfbozykg9vV3L7RH9k7b3yXIFdv72bEfaX+W/ZmP10/wituwTEUTB5WzZntxgDRDcoKfqG3WL4kibbLOyMnmhTiLGzJsfHmzltZlMBv6sxX9QcMJoonpMCLfgsWCwYx403cRghh1QcLIuxNVF20SWDmmr2Z6KfJRxv2FOGnexvQ4QLrGhmx8NjxoJbB/bytxK1mQ075AYWf2GCNo8Wt7webD1a2jm08qomB8i0/+bUChbuxSq4syF8TZQwqHxxzIwJngW8UwKxWRhN/WJUAAsxhe9S5z2n4hINQeMLFI30zVbebG7kpDBSYDBKqTyTtmQENkBuqQqlM3eXYug4NeE7xNEUo+OQ200pvuZDmDWf1aggrCbd5FZpNIxS7gXCvuPAlLI1ATzgFgv/GlQ8gkX5HJiptxWxwTZVmjmWzzwJB6UTWOQEHLSGIa02/Sij3csgtHJCNEQhbJeIMuxHRq7BSURUBhhxZLr1Kjw9E7t2Rc79Z4rOZioeqhpjiZcjFnXuHcfa0p4HdQqgnnZPbbSxq+Fkl5ZRmlRKECdgl0kmRhJzUQYlTAM5QY0EobfZFi/wmpwsxdMg2aozIgeHdPyqMHkEbLmSvNnOTwFsDtz61TJ4lo65qVzXxMtIlE/kcySGOTitBcuQx9wTsvj9q/IKGLftjNh3y2BeCnYvhZlIBVivCjdqxhtTvl2OpcqnbQrHRJZs+EW56+UzjguXsOX/0zLgKTW7SBWJmYGnz+Mtc6aho5qndCel4HLL6eE3wiiegv0U6vMc1I/oKCR8rAF/pNr8jBVXxuKZlsNwiOcHlteyfH6T5kOR7DjxsmqdPauofAJuWVva0VyVm+O7ZXWfsSvNqZyFfPtBlON4CLnmgXh2EywPeA6Wa/cOuyDIMi2hr11rJ25BPL9JEc+c0lnJHoymW+JYXhiv2SZSHFO1PYn83TboJKTSilbanYc5u/PUWyDPFMdRBNb1Uhtc64TH5Bn2n2E7UHzq4DVak1MeLxfbgAnPAZOzYDsCpzSenwEL7pnM+STalfdJZOUOk6PlSVFXzxSgpVSbQSiHwofJIdZdgm701ikx0uRouWC8vFW0rVXdUd0qThPM9njLrl7Mto7bHIKb8FwlhbsNofvzDQi7JvMdl9yGFVtDHkVT7LOd3d9ytOWYlZZ98K6s8JxnN8158G7bXptbmhRrch5GO+saMkj240jlvbFSyM8gv6yds5sQAcACkrfhYjvFIrM9C8RZ4zx4h5QqqyxSAOCbmH/5SkBRJKwUXd1X4N5qNxCMN7Gut+Hii0iE8x3QKq28gH7dv9rdWkHlRQRv2Q2z1CTFeYJCHzhPULQ4T5B2i3kK85cFlLppktKarUkylPv2U5Y/kcBFfRBP2XZEFXKAoUb2RhOl9g6BsBR29Q2nNs/3Gz6aS87lN3z0aky/0XdAQr7hmnkFaZqbaBJtOp/yCJLDKFmwiVCZj+Iln2zV48rosjWUnJwFYQTl9EUt0+JIBGGU0G8y2zpgbEbkGnw27yA1vmRmvEDAPSqS+jvMGUv3y3a/UCkuN4pJOE7tG3HBplz14zN2tb0TOzugIy3k+xGRcLMfEQlND2SZdMW/5DQq4/L/nBcUrjKv3bkvI0bEOPso7EO2SZqILTLFrAK2s4L6zRVwqwInkXvJul6HwH9tqORwBoZbvt5vtl5FZO2dd7YGRJqjeIwPpG+Sw6JvkiRVOFOSEOlLN/MDqwiamTLxl56pIoh9mZkKLyNkez3gQ1bwEhXRtncnqgUReiig/EOBGMarZtFhVERLM9nVpZXJTPkqVAXmA85Fk1kWaEP6XCBG2p12a9/rt/famKikgwNvbwUBs+reHul0vX5nb7/Zs7629dc22dOpiFHEau1+t9Pab3n73VUT/0LgX0A2r0tarU6332rtqUqOZHaoSn1vk3ar2+309/eb+9iUsbodFFbwF6zW6u51Ou29Xne/hsTBQRfXkDg8bMGQda4v+ehMAsQvDUDU/ELyZR4iKo67Sc2BxNJ7oLAtGa454IJGbuY1ALeQXGe40U1CAg9/AH/QM/xBebG8N3k5BOrSr3vAiJ2ydwUcz1L1MLhc3gWLjH9Z93ymsMQnu+tIEcld9bwH68n7ENaTKrQoKaVmq8i/k0a5NMNSVow0MYEe89WqRaW/7WpeWUYodYSD5lDryi8jkQa7T/nLRqlA40HZlJhue1D5MPIj43zUAmqkhYlVXNQYxhYlfovNkPIAtm2InaS4MjF+X6rawzsIZVtXDB80V6t0vhQAXq02OWyuKw7TF/D+dQOtLa0885R2Oema64xyP77Z+nCjh+IgTfLrnsmrj5ayS5GWEYs4YbvmbGLzMmWJOQsu2CYXbgTZxiUbBAN0XXBkYx7ko0Y8I/LaYra7y4z3puZUa9hqFeM7aVR9MrUsYreSoscCNS3Nm91kJ+yjuveB9IzcTt/vxB31DJAoIjumStcDirslsMvOmk6VjJEDNRdbUhSEDEyRqTAs5PUTbvj8+IijuGCvuEFnKLuqISgL3iwtM0ZYuxkFls+cNSZxwcrLRtDtbykGH5c4KIGhfEospBRDykckLKR8Q+JCyonYKPZGbJR7J/IFr43Y0+cERvoJeRDP50yO1j8XBNiDHwtiibk/Z0TKX98womS4n0NJ4T/kxBJrv+VE2av655y8CqJT5h8L8ootWCD8Z4KEiX8hJGfw0yN/IdZrIHn2el7PF/TwuoQ3lFOzGYoGe7eIuUgKCAWcOtFIgPF0TBkRW326Wa4WyLVlheWnupQsWp7rqal65JKHQv1ugqx2Fp4u9bem9APhb+uRcqaR9WlQUNLBoHhgddPumN19QHE4Efl9ZHpNxXq9Jv29vZavucn00DDhUX+vhRFHe932PibOw0AEX4bs0sGDrMvRmnj7/f2Swl6vv49JSDnqND1MYspRr9vtYZLAx3arJ11XIq/f7eKc42dz6sGPLzW63U3fAEGl1SyvdR12tVZjB3yQSt5GbDxIrYKlCw6QwuL1OtjQv4yInabMbWlIgoKadUyCwglNSFBwz7Ak2aQEa9LvNHslk9Jrdpp6UrzWnpqVlufpWenteX01K51ec+//gbPSa/a8G/fZs2BR3GLtdrdsi3V6/T01m16rr2ezy9pqMvf3va6azL1W9/+Jk9nue/0bJ/Mlj8/DhBUntL/X2rux7BETG+W63bI2YX3UQnS9fb2r2/29fOD+W0/68fE0EMHxsYRckZl7+UlaFI3YGK+Tgo1zUrQ5CElSmNHYmr9kTbrtfqdsDjrNnhpLr9PVm6rd6+2bI7q/b47oXh+TAH61m/vWUCcFois/HKC8LI16+VhPNrZNSCab2yYmk8K2ScikMMglmRS2TWANe7ImrV6zbOnlmjeUMmYBvnud/a0Fvggj0Zd8zHyhvV7vxv31FQvelhz2zn63Le/ybRdjpphc90hUspW0o/LReFCr8YNokEbhGPHxgKGEcAJGZygehbXamGYB3OP1mnS67W5J11t7HQ1r9rr7HbUtvE5Xb4uO1+loYNPrddW26PbgLEwM+pBbJkv/dbBtmJnNHZnRKnfdEH7O5c/qTEU5IGfpa3UO8SQEJgugi2er1Xy1OiNTuhhGKJ0YxVGR8d+v6NQ+edDaaSWMKgJXwWv2RKvik1O8WkFoQEdldig9Xa0gFLUTz2YJEyrBWQScRfIFr1Zn8PlkOZsxrj+fXAn21KpAJrxIK8Cr1RKdkiuw6Z7K84tOM3J8vSb7++3Wj94USik3wmZbhFIjE8G2kFsicwi+Jq1Ov/8+DbKsmRSi6WbEKKxpFepBGnNxTVrdnfvc1hSveyTeBjIhSpcMOCZGtVo4hsGFEOiJqyino3BMQmtsHLZ4f2/vx01mOoMzZM0fNpo0urGqB421+t2tjdmO4BfzUCDHwbDazda+LKPA6N3RL75912zWv33Xmn37rh3Uv33XaX77bu+k/u27bvPbdz340ZuNa3dPBze0ci4d2kCkjNFYzkS3DBjud7r6sPf6XhsO+4cdYsIz0MPGg9icKQhSEgIcwjZbg69WTJ3A1coUB+S802uWoZLQs8HNK5gumTKUmaFILhggLZkhj1G4qcOKwejLgHev18M7BuocH8vpOT52ZPyAaKg+XReonhx91FQSO5/bdNIa+zBfFPbqfr/vlY++q1Zov7XvYRTZPQvXxOt3+jceLjNJ2ZEFsF5D0dDz6x4eRMO4XvdrtfggTLd6PFYBS1LH5NbE9Tv9dtlN2ey2MSrccjCAUuyj31bjand7ne3rm2nOq/GEsFN6vX5ZnV7f83Sdrd5ttgxcnojRSKo9GK8oA6UEKlyXA3gRVIxCxEa8Vhvjsa0tRWk8FL7a1TAp/W7pfdrp9zXuDrfoDQcopraPNrgIh7EfoZgoL/MAZdplSAogO3qbdJs9dWu32m1JItjqrK+DU93j3QDEQMKUaS6GziiWsKHyRaRcYU3Hjp8mPl/O52PHT1w3gaOdRj0YhlJFAlhFa+LtxnestuX8s1xN8sDulWGyMCcaijWBnNw5slDa4af9vmcYbmNHBk+SzZRPcWe/tbsV7Y04JokdbE/x73WQEbZapdFGqiEC+FgNEcdDVgVQwquU+1FaiyBLuejQdEmPALHXPfI8jar123ueQtW6zX1NF/b7/ZZC1RQiN0kRuVmKvs1p2ZSQMzuZB1djhyyyJLU2Y4dMP/jeIFfklFyozX9JJV73jAaIYfKUXg7PfCn1PKbP5E+GyTlFT+lTSufDhf8UU7ogJxQd02OVcixT3kCGYxCnvHFdoFKU2vwEpfdA1RtcAlf0nFa9tcpXPcdGULRaoQtFxGByuVrN9Da2ugsbmjDyNE1QYRs8l2M1lHf03HWn5hp0jo8vOUj3psfHDibP6Un6kRU+QkXvVqvnqpoj+m6oQ5OAWIc8pM+HLH1ng7Ien6Ij8lB27AKvNcJfrb6BC9jOluQGJHfZ/l5/5y7bb++AX1kMQG7umCXQ9rQqY1pogGLmf6lt9tPTPUjg1taRwPgokcHkAtedjFrjIQQBAxHHaDJqjsd+FcFTIhDpeq6hvkGtlhwsByaeGlI1YUAB5lSMZmNyJgOKWXVb0eEopXOIMzarFKpm84TJKheaUpcBDiFhSmM0J2dkJsUEC70N0uqmwxCdkTlpk5gssD+16lynythwnZZOfHuvq3loe+1OVxPoLa+vjrdMWtK7v/jWnMZGbfggY3h+O/7oLgnoY71I2cksodTIjAbpFUHmdFI4veSMvmKnj94tkPMLpzZTO3eOG5wt5sGEobujb7/9xUeNO7UhwqNvx9fr1fjuKXG+/fYj17Fy5StdpYIz3LgzREP67bffIryqzGIOQ1EJYwwVfeQ17gzBEugjZzd0r6JqLEMGSucCENoJzu6Zv8QNwRKBEn2L9nud/Z0XidfrNzUvF4A9ScD6LRmlkO/xPA5Eu6Vh4pgWP3U7ZZ+eGB7CZrrX3fKhvJGMHVH24cE8OF+w6bbvXnfbF6uxapMko7I7IVeorCKZdl8SxYUv9+N4zoKokGr47ZvJrJAkXf4Wp1tvgkLys2BRSHm+PD/Z6JG5wXKJaq8XEo9YMZs6LoVEzeuRM+iRnZhILDGRMGVaAC5QTUaQBUi2XqtZiuTu9TTi3O20W5oXvLdn5A3ygpfyhl67vfuwlKkXD4VvUL/Yd9SY7M+JvgsBpEIQN+xDhfKWhhukX0bA7fVamoTpSlb1hxGZ6g6PLJfHoTGSZ8ABS7H6HNKIU1IUSFDHEgg5VdCnUDxUlJmkM0BT93pl5A3cjwaT3zfc3267s22WVddCC5/3Mp97rguCXbjcYunWUJKp8IQ5te3TbX0CIJe5UoRfrwmsfxlNnyLD7a6WR+039/fU/tjrNNtqf+x7XY0UyjGQyU1kkxV8FoK7Mog8OlHqqLkOKyQgRDajKb0RgT6QKjix/C5x3YS05XjaPe9m/kmJ2rrGrE0sXzYSY6hu3yuV9QHtiAfv3YZ2uwuSgV7nR3TTkFSS8F9Lgm5vfycBb4QxEIg9Y3KwA9AUYbTODsNh0w9rDBPEKT8Mh6HPsfzKazSErcAO+bDp8zrouDUJ/KfN9LzEmlsYAkMtAgZAPIogeGRUY+OBxS/ea/bK4BHwLfBu3q7lRJvkoo1mmqhgWMJ0kjQ+rFaBKQLM6Pdh4JmYx5JtJwY4UozP7HhHMJC2t5uC3jdwNeN7tzsdKbEEWjoFW5qSJgFdDpcp/rSdvgb6UIKxDb1TKwwD4JYZoVIJ5carOQ58SKwPwTDQFARYqGqZOYOMKoPTdKTGmHeXUVp/Es3CKBRXQ6fedMD/EentdUv5Tfv7Eum8+4tvk9rdG7iMQ6G1wpoE0mtehuyFxHHAjcGaeEZl4X0PDMzWWlaw17vhhGTuc3KnhMQZu1picHqHy9EuaXQQD9koGpsV4yghYhSNyTIVlCRwQne3n/VX6sQwyejoe2WTq/ZTmMJh2GN7e15H7bH9frt9I/iNlIaOJEfxcCTGfpxitV7p9QsA5ia2kwVY0vsmhdkQTpoTEJbwQxoChpDxbDud1laZ2cg5Pp7EnNXfJMfJWcAlgTvOcweBqVnGHex6rRunwraRlMdKc1YyXq/CGHh6ZoTMaoE+nu0ONoz9ukeWhiTleIDYMJF80eQgNsGPQ7QcJWPJ4RnYsgaAjzvEHCX7vMialbIP3TiTfDt4LNPAjYNlSiEHNBmJ4dIHOUgaTJujeBSMSUBijE84C95mMSkld7JUGur1NYnZ6vY1StP2Os2bNmTpsWUqCI6CRMDbAtQGGyC5pFrjk4HRBr8HWp2Afgxh/Tzj8MHBPkutn8z0LkdijHAtkLPc3i/Fzbpdw07ue5pW7vc6klY2pOvoZ7/91Z8fO8Q5dT4EAYgRjCkFcAkAOEwEkZIc4El3SqUXraZGfuWeJvFOjnf5NkkMwEp3h1x06I7ZDzCJbTxgFKaclPrnChDMo+QprnUIfqGrNxM9Oax7w+UoGLLRZOxPxilzG3iipcpPgA1hdP3rX/nOPYf8+g+px3+vHn9YPf4H9fgj6vHXfCdwyK//unr8DfX4m+rxt9Tjb6vHH/OdBw759d/1nYlDfv3/9p2HDvn1P/WdqUN+/cd955FDfv0n1ONPqsefUo+/5zvMIb/+++rxD9TjH6rH/+g7Txzy6/9JPf60evzP6vGPfCd0yK//T/X4x+rxT9Tjf/Gd5w759T/zncghv/5ffeeFQ379v6nH/64ef0Y9/qx6/Dn1+Oe+Ezvk1/9CPf6levwr9fjX6vFv1OPP+84XDvn1X1CPv6gef0k9/q3vLB3y63+nHv9ePf6Devxl3/naIb/+j75z5ZBf/2f1+KO+cw+G+3d8J4DnX/Gd12cO+fV/8h0Bz7/qO0nikB/Usv2g1usHtVA//CE5/T/8YfX4I+rxR+Vi/PDH1eNPqsf/qB5/TK7QD39CPf6UevxP6vGn5bL9oFbvh/9Zrt4P/4t6/K9yvX7439Xjz6rHn1OPv6Ae/5tcth/+jHr8H+rx59XjL6rHX/Kdjx3yw19Rj7+mHn9DPf6y75w65Ie/qh5/XT3+pnr8Ld/5xCE//B31+Nu+c+aQH/6uevw9uSN++Afq8Y/U4x+rxz9Vj78vN8YP/1A91Kb5Qe2WH/6ZevxL3/nUIT/8K99545Af/rXvfOaQH/4v33nrkB/+jXr8W9956pAf/r16/Ef1+M/y8Zs/pN7+ne/MHfLDf1CP/yQfv/mVevz36vGH5e78zR9Rjz+mHn9SPf4HuWV/80fV44+rx59Sj/9RbtLf/Gn1+H+rx/8k9+Nv/mf1+F/U43/3nVcO+c2fVY8/px5/xne4Q37zf6jHn1ePv+A7Rw75zV9Sj7+iHn9NPf6i7yQO+c1fVo+/qh5/XT3+hu+8dshv/pZ6/B31+Ju+Ixzym7+tHn9XPf6ePBS/+Qfq8Y/U4x+rxz9Vj3+uHn9fHpHf/EP1+D/V45+oxz9Tj3+hHv/Sd75yyG/+le9cOuQ3/1qeqd/8X/Iw/ebfqLd/6zvfOOQ3/149/qN6/Dvf+d4hv/kP6vGf5OOHf+47T2Dh/4XvhG8c8hsAF8whv/nffCeG55/wnZ/B9P9nGPm6qHnW26pRREJbKRZi2mg/bDrMDnHyMWocKyLd9Zo4DrleYyLsYDeoIE4GQVIpmO9q1geoVKhbDNDiHeKAhCxJYBj6nsvJLEPL5ylaDhfZrKr47hPXnR/OLBENlD2jgQ7JTBb6p7r+zlx3obNWzqQcfkGpGCiufN0jV8DBPKUtlw8l094g+YBwBWmQLhKkMXUGtdr0YKYxrAsqRtMxuaSgPw/NJZLf/4xOhgm6JBdkShgRJMB+gi7IJZlKAUAge5bqOTyTWOkzPIkjEUZLNriCyPgKNwtn6BRf62ucoM2gZzE6leoT6EJxfpboggg5rwFOEdpTxb6S/gTwtVW9lFlUoDNVSi9dt7qU3dTFczkNeqCV72Cm098MkytAHtt7u0lmUJ3T7HKpR5KkMsmlUqjQqmJe35OsplIyekYnw4kSbr2Y7ZJSy/0VkQn5/7H3r8ttI9viOPYxD5EvEn6zNcBmi8aNd8M8sih7NFukbIsaj4bi6EBkk4REAdoAaJEUeb4lD5AnyIukUpVHyQvkFVJrdTfQICFanr3PqeRfvypbIBqNRl9Wr173NSUT7Tl68mKMpfg8cCOaI1Wuo39lapi171DpDnJrpDZa4hm7S0ExduIis/Mi1KH8ZyPzwYzYu87f3Pnh/akKoInmbuwH1TQt22oiMq9vjo5ulgkBdz2RLxdiUqAbDXIZej1hWjEZvEPxCkk2HmgUuWMs4j+zDQjpePbjQhietOswYUSmEgrG60yTNss+QvF6ne1e4wBVbI+rlfrouBphzrH7jvAa2h9mccTCmYi07d5IXYjNsXAc2vBXjkkmaVI+qD92IvURMQoAuAAkgSsn0kYYb3QSrUYZRI3EZ7g6LNYch/9MwquiXRjo4fNQqmmhWvUvK865mQGj/wHBDtB+AbZRFrkCG5NInffddO4E7zl1Ro1pwlyCinTK2ErVbU5AnE7rHh8ZmWiSEpOvpEDQQ2cmIejHgwOxTnuPiKCHAkEDbm7MUiQ8S5FwYqLpuI1CYZpByKxnDCtP+qkWtu24zQCx8gSx8gz084DuJoiVZ5tq2XYTcOrTahVxpBjAK+0sXhyvVurYyWgMHGeiAeJeHBzsj1m3zpxMIjpy42S8ThpgiLBa7auZdrjp23YpmOvlaGXODg7O9jw/il1/wO9zat0cHNzItW5WKxXGk8iyZhJgzzYwvFErbVMdW/qfsDg+OBB/igxyHYeDMBZu0DKmlUtNgCsME7WWSsareWIA/IAZgJX0Ui6zrRu178iinTixU0/F0lRrhr1toWxTFNUVCIeh9OshxFKA6bJredww06l43xmOAHGmG0IXSdlyUdiBgbEp2FMG/Qb8dnqw4X010vqSYAccHHKt64SqqlLVvyeed7xsMEcwRwpTuzYwKNtx+G/ZEgSOt2k+EDleal4w+zGDNDZTiRpvBhMW92b9BtC/8IMLKrmn874ukbc8cTF/Nx0haE6a+G5YZ/tgD+40AgbRAJN5YtSakDDZlVquEvORD/c0OkkMP0nk7AoGTWZO1Nxhftfr12UjGVh+uOQFb5JsboEYXNc9aUJnIACs5ru+lRKrtorYi1VhZALuM4m5P3cCQRuJgbBAGTnZ0102JOM+OVkzMjzoZSOyRGNOhk4O9UYWzkAFsyZnoHoa+eYM1EAjT85AjcBUbKDOwFTMbYB9+JnKXE+SwNSMHFMNTdP2neFq5Yk6nrbvjFarAO6DYkijYPqNqlBrulpFolak7TuT1Wom7mfavvMINPlZDoi6bDNvmRaAajJzSAh613fC5kANUX2D0ds5JeszSnZvIWipIVIhe2NxP2L338T9lN0/ifsJu2+L+8cUYWiSScIZGOfqxmtNMrd0l8Q0zZ1WuHkOJMxtZJaY2Li71c6ycmcAbN1o22AXXEWMRqEwSIiFieOqIMLkB//USU161RhJGDzjgarvTRJ0Ol2tCoXBvjNqTuv7++ooxx0ANd8jDXxSJmSErjWpuRG6OlRle/5E8nx9PTN1fXh9PRtWwb7/ejYcjUbX1zPdYre6VYbbETXxdkTNEb4zxFtTH7GnOmWXUf97ImzKDJ7QLMMyShWpV2967uGy3zs6/KO/wr/P5hqLVj39sNaHn+wZ/4Glq96f7FY/rO3137z+4+Aem4dOS1a+Gl573nAma/qo2NHqz2sipQQB5ZO+y2BA8kwTyb0Frpc/sJlFHVo/ZFF2CByw4Mv7Uv//Ivme6zXXSBM4hA5Ne6Xc3EyDoRtNMCTXzUwYX6P3gROKLRmuk5wKnFKPtSY0kx7k4Iv8PzKSJCp0IgnBjkg9Q2VlufxSb75HwuV9Lc2gJK95U68bJOzFfYhIKUWA2TGrdRY9eY2+fWV53/ypNuv6qmcc1vrXw79rP+XvAik2MreaEl5CYOTAdZTNmq5XjFrNLNkVW6/VjDq6yCg+8vCK4/irlRIhkaDsQ9+TLQWJMN8dGgcH8d8MB8KFxG9BnV8uG+ZLzjMb6ieGgiNhTboD8wL2jFL9KWe3Z2JgNB1Y2vFZ01NhLIFKiVCvavWUuJ5xu36o46thj/YZPIBGfKfKnJlgBM6b6+Lquqc2670/e9f9/t9Xak/5ua+pzbra3L82tN6f19f91fV1Uft789rQrvtvSATmDE9/f2nBmMRNMi3jAw23VjAZJxqPpQsEN7dMUsPukgNnH4+G1UqNxAKuVvtB+ptVBMGLZLeGcT0IcDPfx2/ZHqbzDPnQk0WhKTSxG9FZvheEP9O+4wgDQCdGbau9tUkZDWybSASrPjitFfuFn94U6ZwOVO/gwMOEKeJaPD25+fTlvHu+WoHCtKkw0leNwoF2YxSVgg/0z06j3v3g4CCA6cEuVUx5U25irR1oKoT1yFBiDSl1kZrDUoOXT9r0aoW2HcDk5Vlrv7iX5AxJABHcywRCJLy0vJuHYK+/efQZ+X7tdgX1+Uj8yu7RGIXq9SichJzGSgA/fKtr6H2ZcKuHRhMzm6laitlBk08OD5O+Yj6SNYHIDy/19q93ai98q6dGfyGYTqJdk1H9wW+l8oXM52LtHXq72fmO5C+3+MJJRTxU06f9997qTbVQSGcrZOL8XkxoX9PqYc+DQTnJgQRxIPJwZE1EIGE+/YHg5nbQV5m8agLMnuEwrCMPhRkMfPqkBquVpxGGVtgjtMkyc3sCcpndK+qLcFOpMGon+VWm1g9+J7Oa8CEmnUTfaqP8rzbGwx6tCQTb+KHGUqhIWoM1wxFLmUSFTFSiZ1gdx/GQmuGwUBXEya7ZxhAU3CiSB4RL5P452RIxQ3OvUKB9p+dD3E5MJQJsjWW/hk3MsVtm51sIqAOoMJQDpxoyyDcvnXshCC4Azktm3qpXLb38XegieYmXSjoasnH9QSjCnZAYRwgyW8ggINuAAx36UmAHoW1lgZI2wzqUX4CyUrkmXsWDkecQ0zaDUGTYjdgJi/7DUFi6s91ewrgdoAihRT8Y0u7ikR4coOTt4ABOmFgqjknkwPmZfMSBWqh+G9AoIrN8nTK+EtJ/zryQSj9VZRZ7U0UrwrGSJv5GmUmE+Vs8fyz9FPVf1DnP1gT8VHec6Imo8Hs8J5fywd4slWt/EV5jlaohg0IwYszFtmz+t6TjEZ1CtIrk77aAHEpJALS9t1oJxxVVkfCfkvWfDtakvJMIzIZwZmic45AdLI4mkAgEr/nR1hMMCMrcfzcGSvBPLHBP5QUnfTjpXiU/wNMsSz1BmJvXSQ4k2oPuOLFEHnVmWF374RVLjigItvOXFwTi8+wO9JOKeK1y7cfYbG+khrJ6yU/dPeU6+95qlSQkMmo1oQGMZNJGWo5CIUzzgjbCnEhCgZrGrck7Hx2pARR22flW0NXypqVruVJ+hbN4E0R8dV9gllz7GDiw0Gq+92cRmNIC41DVw+b1sKA269fF62FBa2qCV+XMqplhVrVrU7vur9Smg2+srnvXfS39ufpJA2dIYICv1etrrflmjEbC6jbo9hKRll3GbP5gcQvR39HqNvF6UgARSBb7qizEYN4ZvKbf9JJ6AfhjKlo9XK0wVwSha02exmhN8gVNyMC/7Nu1HYx7tZJ48r1Y5PfZ8HGg4OMQb/k4wCEO3Gt6sGy7wn7vaOE25ftOzI7GraMmPdWkx9BB6QmHXUUB3FCr6bJE6Tp68x0lYTauSSoHEkbUoZa1QyfljMxK6W3InPsK6ih+SPTcV4jvKNiQNeixhm6lBj1H6f25/Z3AUQB6pdeGtIzPh6NRX3s21wqJNnp4Oxr1sWCQaWqGTSmFsKCslIJfUDSloDQV4rLXZcF4UyEDxy3MCir/Ogje8eWeRwIS9bml+Qr8iTWlAFUV7e8KqrJ4PfhOU+E2FnTjDTIVcn2/oKhNh/VnpRRGhcErbMtFaJ6pCM1jVjLSeZhns8JGb1ZuR7he7uHy+no+HB1eX89HZfhTxZ8jXJmjwz+ur+cDeGU+hKdDfDqkuDTX13N3cH09vzWgrAKvwR/8AsQZYoGGMNIQCzWEsYZYsKHr+S0DBt4fvTzau76O4fXb62t419Vx4kE/4V9fh1jJrLJL7fp6ZpSrUMOo6pQ3xC4Gu5jsYrGLzS4ldimzS4VdWJt6jV1c9gXWuRJcLF3XEeZ6SsErKH2ErevrYQGhp7cxq30GOwhRfYCYbQiGZqICLYSwvn2Fg8ducJ6+Dpwn+GnW7COHOhdBe4AQNuRlE6lsgWXMh0Ft1oer6XT1sArpKlrFq28UJGtknK3TWp2drdqrLyeri1V39dsJ1vnG6vwYAli9tPmhxafcTdh2ngrfcjZhLqoYkenmvoS32b48E/tyllevTW7EfuxNYNvCTBaUwoJvzl5AJkT5Sdl4jwyx1liqVXjMq8fafGRtkgl7C2IbDP+uNuvGRXdldlor60trpTb3e4Zp9bXr62H3Fw0DGNyiFu2mrynpK1G8Mv3hygqHmVfiSfLK0eEf+EpEzqTu/AByuRHIxSjlUkNVdD9joSVqlpaRAchRzIGwjYOz4ImGx25EgWMuqOCyQ7U62hlkrYer+TJ89CPiYRV0/bskF9xlv4pKzPw4UuCXwm2J8CuB86bHcCGgQsSEgDERYc5Ho+uZbgDkzXSjMuojBSUraX/oTPzucqixw+NQxBL15G/4EK0xRtarIiPFDhOZsOhI+w7SOFauqyG4I2kqOLNWtQ0RQ61WzVXb28JkCF2WmG8iuiaKlATfcSKc5cXPA5ZgponYYQ3urcSVYHo9kHxzXXRadp1InRVcomsageY9lRJLIy7Ok1U2fsilW/Zw3LBh8DNGT4lq0GuGdbQC0mu5olYOyKb5ff/ERAfEYgf5MAKI1PAK/g6lDzU7N2JfyZaiUOXYIZHICTYNsGYskvq2eZLr+GpOCmsR7WOtalozN+cCC6MVJRGO4EqpAm6Ss63CdSMT0Bd0ehLFw5QVXrQZXZWCs71h7oyMg0Yk3w9lJpZBirTBGTqIg7Vb3saCuHosvh2qULclbyhi25a8zTDegSR5C5p+kdkkpb7jKjiPe1GmGExKNuasnMvbm8J/k8X4ChKrm2wwEKb8dZO4X4MkLIccAewvh+XYCO6kw66fIYCgvRooH7d5u9zshkxRtVq5+NoI/wL/nWhmE8QiRdcA5jFjhMZ0nBmLM8ehaRMocvdGGL5ClOKpsxEnNmSRoLyROk3DiKQ6YimEE0DkzoBCu9SDzAtkU/uM0myBoHLC3WQH+ZH6LM/wSxWOooU/eOnhpzCYL7CQWezo38VRqW5ZrN22XYKzaeQAE2Ua1R9VaqdbWE2FrjQLQZSpVPVXiDOT1rbj26DMwK7+izEGU1V7Cu0bYQeFJ0EScxD2YG4sUjuJSGVw1R6oCDQh1fei7kKEekJrUk+NtLqflbCDNXLemMqWkImJaFcvO95nwhY1oaDusa6DWualWOlptHAvMbRIl21fmqDEECLnMdXiSRg8YRIrGC76sKjKyfyRDmI63HMTSb6ibWePYB1KzjTiObRJ5TxxvlbH2PaBE3JFELgWoFzVSwOgopDWY+1HTpxtIFXgYQNOgIJKj0SAv0i03nwOmlWveAy/VysfJN38zvFJNr5rxcojqa0Kt7GEKDcc7yNNH+2yp9xcQwwcIZaxVtFzgQQd/jboQr1S3jxiyjtCNggiDGg7+HA9kOKozngNDGfDjz/iJpQfk4rXXraUYC0htwMH9Pcqok9Otazn2nhXbZxW443+vcgnaow7V3Mcx1utYsdxDo03etOo1CrlmmVYdrVsgt0lNWvm31XI0HVo1A2tHgMJGtf1OguqGtd1lCrnO12+ZLovqTtRffk3o5FGG6XNsEkPwzrFpmFIeSRzqWxIBxMJhHwWbaV6h4W+Pge7T/dwBLY9HkYa1G97usFuXbgNevphhd0PnEc3jOipH+8Q8G4dGaloFzacJOvtuB0owkNRyMXyyAXuLNhMfkGUzEbMok+A/1mdriXR8v7Wl8UqFOAtX5B9oTMTFloJoK5WrihrDlQR+MbUSNg061Wtnth3NTtup16IMaRz7t4tWYb2uui7iiL0DsA453rS6CVNVeLg8vGRMctbiSwquccZBB7n62+U+LnCGOgIfTtKOy2yE3YU1owkvJNKU3tUyiL/YsA4HER9KyZ5xazm2qNWRUxyY0c3ZEYE2iMU/yKTVbE5jaHMIroHaz+IlUZGu5zvjRF+jwQm/uvcPjLHXoZEzj3IeJtuFHljf2/g+n4Q793SPeSfhntPXjxBmNgLwr1Ej5t6d6chkvP1oeECSczMV7K0ZoyaNp7pVHFvBywQbdwr9R0F5MhKSXGc/MnruA8UVrmn97ddC6nzvCYh5Ml9a+iNsFDQaE+5UQrsU5ju9pjrptRQ6zsYs0fRDdOyS+VKtabs7/wq3Uxum+4hDAOnpXFlsgP2IRoUK4HhDuloPPHu7qcPfvD4zzCKlTRMf76a2u/FXEmd/346W2DroWbmHpzxfalrqbIoPaJUrZl5R07bFqTqIfSyZ35kA8doDN5uJZUcFAppdQxtK1yS0mRz4CqhhYzFiZhfg9sbQVzLEfOhoNrzzKFqpEk+o3pj+nYmvjItFDRfNDDrTfusDfgFzeBVS33NkfHmuRmgNXTodlLofV7LO0h7TncN3zERjbveAw1m8d7EjfbYfqH+XrI3pFS8ee+jAdBrW4j4HqaO46QfTjTbSYkaEx2nC2t6q9U+OPBvv0GlVsjm67KeEUs4dIR5KkhACiRbKVuBJwoEK8z1/gZOyD1T0+400591LwVR6nhM2Zn3tjytTfmmHqQthE6ARkAoMyQuGLcOIFjqyDk00mWfqtrz4OBgdnCg4lMBak3XmYnUiq5Wh5eIm7g3T0BYnLQxUXnkb5HEMlKnWmMA3scpghIvN2iDbZSZ42KvGoXC6C1taLODg1lv1C+GM1/VGvjB9K01k3ryEWQJntBxHHkSxPrLZVx+gXWD1Wof6OC8d8JMS2SzCVyRVCNNM8ASysAiVck+5jaJ6/UaSY5kEh/ZcRtjwtOZ78TM9MIFjtOR8n4PIVGfX/TpPO56g/s8clW45ambWAqSUHijreJ3hpbqwY1GuI3d2IkSHhr9lMGD9DMut1ugT7z/GoHobWLVVqsBeH9PtDV5lI7ycOZvGRKNZj5n9dj8JWPX1sQvxl48pY5yGwZPEQ0V4hf5T4g+4hep/w0OQL/ohuNvAOh+8RsNIy/wHUVJbyJWKfCdIdQdDiF9JPVpiPeBP6Dsx2iE15A+BN9opg4rOppORWmExfTBi/HHY0gfqZ9tl5ed+4NsW9Okie1jtdeHjnLjvszzLRTLDQxF5T2PIdlo9gg4HjEs8YuDp+E2j6a8UfDZZOiFr/sIVn3hE7MHN7rf/gj6RNl2RuyCvgEs6n2N20zV7BLKRnxu8ijlGJu6UbQXy7kxExkHM2OKaNyiI3c2jdFtN5btp5jaiWV788Z+EFIg351YumHA9jCbxt7U8+FZ8pvETlyMglk4oA0MT5/P5WzNlyQyCemYzh+BpOTv8XxtUmfowQHmFqZJYmHF48aDUrdyaj0o2hprxcE99SMm5MpMh8AoD+6ce2dS+N3Ev3VWIlPZ8Gzjvm7o3IB9yFrFJKoOzdw2s7f1rfrFwTTwqaoRWgxdSG4OYkasxW+d5IG2HtPNBLc3UCQNlfT62poVJpZ0xCceiciswR18YwQl7uUbFL+cn3fr/PfHL+eXn1jAmlEwnQZPdPh+ASFq/CD+kBRowqQIj7EYdj8F1lpycYuL4zCYPbKYMDAi+d4RZl3gV3loaATRUeRAlEY1dOJi8AhbJWJpp29g+Bd0ijyGeAT8XATJbROhOWReiIAM5A54OAmQf4HINp9pV1Xay3Sq7/ga8Rt8Ij6dX5x2T8879WSo/MHFSbfOZEnsK3T+6PpDiVMXOL6Zx2NsDcjVtHra+JeTTydd9l2YWc+RAUGNiw+eT2KEWUzD3cSSggBkZHHn6Ww2orfe9pRwWYU0K376+Q8nX046xyf1lIlhtQ+NPvhPiYrHvxx9qfMYjtk9e3CQDhFiB6kaX8Q4OI8nXKHOG9Xq/IfoSN6MDbT1OvtyugEKaq3yFr2q3jqGaTYPLbNeLomSmt60zLquraXOCA4nM686MbS1vCjSJ2SU6TVjhmLUjdfjlIyAjFovP+2vU3hBNI070XEEdseJFSQXj83E56qRV/3LUefjyWZ9mD4SF+NAazxPacwJHw/3KtwzdjguRjSWyRis629ANVTqhZwJw6ygvkY2llwTDceO3ojf+qLRWDQaOr6YNzjTtuEBdPD76BfAvuGlrBpinuaLWLMYzW7j0EWfxZdxa9HzYxpGzLVxvU72k2zaUEArg9E0CEIVf0Kt4EHV/q4aBXoI/vVjGu/JzW+i4hAKHen3asXWxDKJYZa1dbTZQKw9S9WdeB3FbuwN9uDbdP6opuHXt/wrhWoCxdbwGW5HAq9IMjHKtiKdPzZVH+th0FWNpA8cX6urvlRTK24clcQv4skjuhfNxm6oas/sk5lUqX4OoRMXxXhgsEDhQ+yGTDiQLUkZSuIMJvovl0pWmQSObdbsWrli1kpcCRKqVq2scY02aAGK4+IgXDzGwWoFvx+iY7xrAAsFApwvuKa/wYaKmjt8ceN3gUS74FpxAgb8UGgEFEwcBHsPrr/YY4CyB5HVokT/ExXd6TQYXPqRO6KcyYrf6RpevIStcB294b6NGy6Eyt/qohpyMa9L3IKnaUhq7eVUw+Zz+OEkrnvKF6myvQVlTEWIplSJEW/9BbeGDVrugg5mIRXDZ8L1vTHTA6PgZIMa3rtd4EbZ4yxK8dq/jOje8SQMHijZ++CFdBTMgSA89WMa+jTeO5k/ToOQhnuGobBEZHr1e1AD0leEGt3QLRLgtdygxQ+hOwb+DAsqhBYv8MV2MKRYVCW0+CkMRt6UhlBg2BzK4GGNzLAMDCbgajZo8WIWPVI/wrcNq8GOQ/hdIiO8ll9YFSbuPThgV5DvsU0+ddKShudMAdrcQVykUwodV4ANSAphTt2pohFpZMnDES9RtOw4kwps0m4egiFVNHngafu8RAGRvFz6zRti6SwtHQR+TOfwNVfqQxA+ueHwJqQj1g0xXWkneIkCwsOk9IE+BGgNnZRM3eVC0dYsssr359OLmSVCQxYhyGGvlEkcP0b1N2+w+buoGITjN8NgEL2hANmHQzoIhjQsTuKHadPzv7mh5/qxoxRisksMUHCUAzccR72+oxSoD21cfjk9Dh4eA5/6srgz7IvdprQ93xt5dLj3Bfqyhx3Y+19KIS4ojb1vXuTFe0qBFhRMSxVP6N4I5PA8MCLK4yOK5X7gHz6Ixob02x71v3lh4MMX8WV8EduP9lx/uOcOhx7Mjjvdm9Dp42g23XtyQ9/zx1FRWbNIiM9e1A5mfkyH9TxFJqH+P2d0Rj8E4YBePg7dmMr1kudfmL3hRfxShQsabz9ck0VGEDsW6h88MkHtEQlhEAdA7qlcDOkochbs9wy7FULCw2EqLPoGH0junv71lsfSKehFuJjJyoN0ZZxNFo6j3T53hEXIvmTVkmeukAR44KcUw8iParWkaQ25c8WNCRYCWUoU0QtFy/ZulC7mhuxju1lp4UXLivQ+NP0tbdqRPtNggROfpKdwtHxrtOUACc4T8dW23D2NtIte9GkW0o053mcR2M6c58EsDKnP4kisyc13dWoPzvM9XUCK3JCO4HJzE9Gp+IUyDsiVmwLibSYxDglgfSMmhmXS2EbiuMOIYx/UHqnTMUDRwYE6Y780Ij25pwswWnMUpYA3GqHajYhu4IPd4MNG91WfZVj3IfG139e4ieuWhNOETkGeBhdyUk286TCkvhM2RKBe460rh7riclLAw3pj9NZtjAoFbQBKmRSNjQpmvyE1NgBdN8a44EQu9DFKZ8B1Np4kVCp0X4zCxVEwHPP8008M5OsegR/1mMBSRbhSM4I7tR6Qm+DJp2H9rMiXXpIe30lmUVvmVmIbscAc4lugO1mzJJpvrt9AruuktY7MM+xuj/1C9VtzWxT9rDhKXXF0hSh1+GEqiTrwJ6UQS5kNnTqYie/QMK5VBd4AYKmnHmWqVZYUERdJ0OIkl0Ni46akGt19DI8jRZJxZquVGiNMC8DaN9J0mk6subDxEIa4cGnG4yCL0JV4wy0v6libxT7Dcj6D9UQwJdaANbLncbkUvrgG8HITtZcTqa4TayR2FNB2+k2lqBQ6Klhu132SMepVI62phijyTiz5Qied5TlRfjp4Aw4XbxSNXKgRTpai5E47KF01LiGMDg7UOzVCQ9Nc84BtAEbOHaGYIhRDL0YckmN29gh4jovsx3qtRiQsqPsRrPJq5R4cuPAL1IEIX4pSByDAGy1/VIVY0wgXu0WgjUB9h6MTX5q+ul9Q6srG3MWalqIFvTF4G2dUu98w1alf6KgzSJDaJwMNuJkLdYaTOCKRlgQDH71sbAIhxrdPvyY8rG+TfGrsTA4OIHjeahX3lP/4D0HzKX2NpxAELRLZfnNvpLHMr0nYZAKj2ofeI4+kalpxGPi0obFRODMhL4PRsIESGDnkLREDS1GBM+NHMgUcHhNxMlsGyYnMCNGPeCEaWYCufu9ZKciq+1go6skerOVaqdOUWXPTPd6SomZlDaK566oPCiAPhD+s9ALznSnKS4DOVbghiYlXKKCrrZ9+7pSLsA4NlPbeRLEbz6LEgrZ4E9JoNo0b1EG3HVEBYr6IZw4ltBhPqOxDTLVnXW4QxRuJDF1qx5Db0dYa2dlG+p658R5GVM6OIRFbiDGwFU3u8XA42SA00kPiSBXq3hNxIGVQZpZks0wjXU7W9LHzzIgb9nLLix5BZUrD+gmRH7yH0mNM+l9/jkPXj5Cgr+vrTLVzdjiS0+gieKBfqD+kIQ2PBjFE1EkGAeQ8N/Xw1w1aPBan+jNE4mkRboVS37TFamVCnoDHtmSYmtAKsEChtiYD4Cbq2+dhApRbzRUKzMebxAHiozxnkJYav4iq0diLBP40+yao5YE22FgNw7bk1VgTWkxJzDEwyrOQpiVPIEa7uTg5/nLSvTntdE++dI7OLm5a5zed8+7N5cXJzfmXm6vzy5uvp2dnN+9Pbj6cfjlpOceEiyZPGGefF/gua7Al+meWKyTW2FkcOGjJww8NYNMRY5CZw84U1xEHiEyPPssZIjZIUeI6CQ31HbKUnWMHB+y6QdYxxcD2ExRBow2QRNWOcqnaEaNqR/00DxztjfpCw7TvQLKG3qhfh1ImGxjtIHpHLxG9QGlwYne0aV00QrMiiPkuEb3TLaL3+yf9C/Sqi/DFovgccz4z18YwnQIm7QVyjKixk35zRm4G7nQwm0JTE5BXDt97cVSn5IavJ4oK63H23oSCeBJSd3iMO1Mnn7iEB7EagYTeswd+t9aK4qn06YjccCa5HgM0ilecOB2dgPPbpOSDC/zdIsdK4xY19sJapJEcRvA5JwZMINr4Qkfb0uYsVobKXBCVqR1vE2guCRE11tm296Lf3Kk3FD2/IxTlUDubGJGbR3cxDdxh/ZkfJpDTjx8bMD03nu/F9VP8Agi6vkM0DgTXMwgeHt2Q1lNIYKQRxZZmET12p9Nbd3Cf2yAcSXIlfCZe3AV44j1WR36tRW9nY4QhJyvQgYcnoxEd7G6SVZFbPH14RMnxN/qL6w+n9CWrX97AZnVeizd25i6CWfyKfsgV5d60X1oc8SJUkF/4QoezAQ2/02teK9vZfMhMXxmpsai6KTfarMyFPFg9sfQxKkW9aCpg8VyzXxKfpzL/UAUxuwapgavGS3bMsmAuZPFatGd/tVJ9B88qdkR52/ub+LJpJZWT8O7FkiVYZh791LVpQ/8VNsN6yOuseXPaagVNCBWi78REpY6nSbImTmAzPKL6snyJpjcZURTFs55HKnV84q1VX2t4Um3MZOPjhXiZVoaIucBk10sp6FTst8VwPW+GXuHz48Qv2htztp+FxAnfmU0l8OleMNpThLUiJcqeoom7NHVueGjILAZRCMiVgWVEw7a6iXGev9ccODAp7MW0DJKJK7tfErGVdlRLhqatwYToy5eb085vR2enrZvzT92b347OLk+UzRRWrNGfuxO6h+zbnvJzgRZ+VkArBSL9qTdEqTgzJ4GnceFn5ee1RhK7eI1sfO3oy8eb7tWnzY8lLt4sJU4jN8EyUDOOAvowhVDQWkdxqO5Hq1X0Vm/q9UJEAr7Y4NITaE3Vc5SHWRRzy2AF8z4lYqE/ofQNpgCti4pQKR/7pKlnwtUqfCe5IaspkGGeI+wXTHZ4mGQuDqFHdA0O1YlntqJpM0eB2U3WLM6smZe582FFYSoUrsTk7lj53U2DHKdxcNXQ0TWyr4YF0S95GGj/FSf2X4hboUNFRWsqwkFCqStJ9xszB2FD+Tnt/s/K3s9Jj91XjiZJblNwlOLeFzqg3jdQDi8e5anhw9Byweui++XkqH3z6fLil5ujD92TLzcn5x8UAjBE3QcmstH23FFMwz14krzYPun+ct5CVuO0/enspH3S6Z60cpl5tlSgVdp7oPEkGArtrPfwyCgcOlTWWtK06NOXk/ZR9/LLyc3x2fnFiUKUTyF9cONZSPcG0wBVd9k3WicX3S/nVy9145j5lgCa591h43L32Gj3ntxob0ijOAwW2R61L8+6p5/OTm6Oj87O3h8d/0MhiqBnhKMK2gQ+Tule7D2ALn6jb8dHHZirT6ewhUVXHr1HSnAqgAoG55mt975+Oe2eiKXptBSiPIVeTHnXqT/ceqNzeXbGENOFQpS2u8APsLfQkQZxUrQXB3zcSg5cXHb+0Tn/2rk56Ryft047H/Nn9NK/94Mnfw8VjsDRw7zugrPLzsUvpx+66XhuTn476XRTgJv50cQbxQnMUX+4R78xbXJCJRT/6VDwGi5Zr7XikGRaq1VuzLgNt3curUwDdFA5jsOI88BgIVs1uONprWpqDQgtYFQ0dUSClKObOZ4aycc8s7xIHEhcIcscOLOe22+M0rq9QX+1UrMFTpS5lUTtIyFdQDJGNh9LUkuiEQXUawQZ03cSZW9jtPUGo8tf3Ono/JFCeiECwut9JrASEJtYjPJ7yLpFeB0AOrmOuJfrZL4hKm58mAX+RWNwVQGYJ1NN9nWYJpEvxReQFi1Sf0iHq5VkgDIhzAxIcpMA9SLUVLU1B5NsJlF59okiPvCLN558dWMatt3wXiHPNPF/q+8bZEzjeo4tUk4XJ3I767VGfqAPzPbor3/84CCnsDimMWtY1X6wPyy3478wGdyt5HVfTTH16z6YCG/YlwW88pnYeLoxT2rOO8WkA/nzmDzW1iSiG4LH/7a+CNuBlzrjgH+LBpH3zNJL2FNywk8xaRkiP2y5dm5jGk/bsAnVGn7WyUbgSI/4WoZXuUE58igIH7aJs8SDZ00A5+5E/MQTqF8aypzMi1/kOXPuGpCotVrRiidwwpw8eHFMQ47a82NQpf4haV7JNXMbLukWCwZQrZTtxC7QRbvAS8+Pq8hgSaeP9rzGbw0wDo1dNsrgnjU6OBgVhyDgmAbjZvpT5SekotW3WpiSCXkkQ7A/g/AHZIGBMmolSBwGDHWpgoHgMyiLfGOBYQ2t+E/y5Hwr5jEbpM0f5JKJ5Iw/zacFyU325RfO/uTInINSC4bz4CyKaCt0HrYY2JJbp6dgkUIURvsl+18hyqM7w5KQgvBP6TdkDTyhwOT7EHgaov2ULNDaxqsVJIVOlc2cSvYODlTPodlYv/zwQYyElmz7+7F0SzyxJ6UqG/erFbwjtux5Us7bzhwCzjgxZxEvbBw2Hn+NZeJF25UhK2EQKXIbAHnJbUPSe5S1ihqjafAEzkxpHTwykxOX+kO2LdIi6BO8Iu4htA6SBnDjUzr8khICvBHWwla5GB1zwJLbZAt5MZjQ4WwqfRsXeph8DRo+Bmhw9hkPlhSw5+4sDjgE4ZpJ98J3RmBF8QWuMTjhBK0Tb5asVsosHlUV/oUn14tboev5jp4OyvPH7SCkUqNo05eZZd4+K0ruDw7U6WqlThGhlCytGGkbTdCnvamavqBttCc9SWmcufDDlzfBNuaeZzD3XASEoM5mRb+RcwRh12C/wbMkSHVKFTLiMTeSBVTKnGucvMzVocdi1ZI3+L2TPNEy1Ky2zprQiPDOAzXZX0fD4fFk5t8rIktygAqt7AAlPSrVMvtgy5RuoCqBfxKMWKMa4ZQon17mz8BXNMl6JgoYKdrA9DvCs1cVWbcFV0IErVRwqIRmmkZdvKStqdjLOqG4S5snEIpCpVu7lG5u0dVKpdvbVidHGI0MpBuS/YG3WqlBXhD1hu9QkoZ3U31I+STDkZsTHI2uVqmeAZy/ZCSqsg3wpCoDtlxJ4lqiCGJYSQ9bBdyvtIbsw7AXgj0L1TQSaA8YTiAZRxZfU/AH4cIetJzP62n2lTRWwydB0oA8CCRYMzmTkporRJ+h7wzIUFXonq/J2LcJfYWh32h1MJ2ICKYXkvrOAExUa2uJZ6SE5dIwEBnwTbDLwcF+2FSpkxQgw4Z5krMjZYpZ4Wom+mNo9WP4rUl9NLgBjo9ZwKSP8poijhwfACh6RQD9jWNxtdLR5kiA97Z9Gej/+YF2cKA7aRcxZCHuAJVuoGyEclUZurGrkFDD7fGdnUX8ZrIdhbgi1OpJWbpF5X12cAC7T8Nx05c4zfl/H3+zk2nYZlBy2/ge54H8ojQG8cxZiF+Zp5iWQ1SY+blVRIUsemFaJrkiTHoeDiJ+3nQkzofyCoPben1rl8OhpVJARf4mKaDtO44vH92xIzYxnH9ghKURaFUjndT2ed8g4UbnORTlYYXkRSQU9nXcUZmXvegTo4pyjOSdXGAQmyTbTkRTqkfuyCY90kjjGWBe6XyYYNQKJXlPU2Ll5VeTSokQLXchxZ6bUHcI8d6VBjeC9RuaBzuYITC/CBtcI77DREGNrOtcXosiS5KiMOfAHVVxwwuafKMKJ8g9/gPrMKat5Rh6xQKHIdNuyJZtMr/51tEZ3ktRGT/Wm3p9Az/F+07clDBggqAzs4QzwZ/URZW6Gr/bEEUh0ZEpyQPP+J3TasZOq67Gh4ckXjnxu3fvDPHDFD9s8aOa1CkTcNEk8RqxYvw2PU/iuhhi2r1NmkWHEMnpEXAihc/c3OoDVQEcL95VNvDyNvGziblfopByGuZzr5Fc6slLxZBHJJb7f/T6/t/AdxKcm9CBCYkpP4UgPPIRyMWhqjj1JKVDToeN7YmQoEs0l8LZW2cDYMilGkuDPOa4W2aRkNDMsEyZaeoCxpQnqsvaAJzQ2E/elHuTnN9v6Sb98AJ1oDVSGjwN3D1QlQd3cUth8NAz1NDs6TxrjDtUdcDtUitoWb7eGI2Rdv18xxrTHF44lTUhxy6v1jug6Dd4ZJwDxh+nSABOtK12kNbR3uksMjs0okozvFTjDGu0B4sRe4P7nAlI32JWPew9aFEhyURo6U8g58Xc0VwmPwFMbERDCCJZqEvWPKcblzvmGGBmoCrQlLxVG1LrwoGCNQy5ZdKmv2QQsww/zBhKpVlaIkG6XJ9V349Xq/hd+pYq8XwpihZh0eoGfoGXbiLykRdG0GZSIEwXEnX65jmm1aUuDZiVHIwoYUMJt5oJ0yFffQcr+UMZ96XMipa5Y7yk4ESlzf2e0CwWfC/zz1LroB+MpRZJajWwL5ezbR2nXHOckV/phMpKKo7y8EsI1JJ0SEsxQlaO3lD3wYpBrgt8+sjzvWhCh5heiqNfVY4j9SnFW6xhnfhJTxvhWx+9ODHqYIiJIpPQVw2RPmAtE2swjsx5zHesgqq7JB6pGhNDl6U4G8tIQgfNw3XmNqTmnwI4qwcH2cMAyGLGBmawbHJmv9t8UpfY6dWKg0QiceL9r+9tHqmbx1s+MXTFJD31E3YlwhEOaquxw6gqLamv5Wzjg4Mr+WWhSMiOupF2F4pTtT3IZFW5udVK/DqMN48iCHOmKuzp3pRG0V48cf29J3j+wCS8yDUkJ3aC9Zp8niCmVIDacWAPPVimOoihB6oyDLBXioR3UxEQ/MoOepu0kgnZTRpQS9oxZLSOUxzCFLPZg1n3nfid3mRoE+1htebmt16mHGJHl1jxQycmWcZd2x6FIG5yxhPucwDmNAJfaI0IZoGT97IswAeHkywvurXpHngEbPq0d6YqbMI0sMvJvAdS9xdzDL7An3KfNF8S2XOvNL3OC51YcmbbM5LiHv9B4j6vwNlWUYPxK7G2llsvOAYZqAoU7KGfhPO3IdinRc7f7hQi1xQi0shR90GvwaJfUR+QXwy8UjGKh8Eslu5oGDbduuTKOFMpl8AG/syHtgFcHQdy5XoHB8i4emCVf4kPgayTbwGKByyOpT975BSJHBZOFUqiYd4zhq0Vssh7OAQAU8go7xnXQD3mPePDIDONhNsvgsmC++KTcd4TBoYTjUxhuPu+BP9AR21qhfc3i3Ab8OqjTGRIV+VTL04+Zvzgy/JG6ZyOtHoom16ASqIY+PKQG8wFIodB3AhFn09JQDPnfovNvLzRtczdwYF8d3jIcYBcIQDDO0bgIlJI6WAkJbX1Gkx6WPeTlW4wr4t9KQAnhojEOWKNcYPiOBGIQrfhoGcFiP4Z1MK5aGCUpHTLHBzwO+ZqKD96Z3ADwk9qsm/hoNqfMmw+ckF6ih/ZC2n0GPgRJXtc4elnpkq+A9Y6ZOxAZukfk3FxWKYaGau7YR1mGeeVFWkHB0zAlY1+uXObjWUOYyHAj1fYvX0zr47ZqwnKCIvsJ2CzJKAnrq3YPDnOTbk6pY0Ykakb3sYDNO9sxMUbNEqLDg6Snz3ab27YUkuPtKZ8J8uN5XKnFxL5vl9HUE1MSmUExHakjOdYSQa7sRONbdMQQIRvCCa+gPK9hNcKE24QxDIbAsqNQyybJ3iTpnxOUTW49glCjMqnmSSBE35Suc/ZMcIfAaUB/8W9RvgPpkelWQ23tP0NUD6K6RB4C8/fUNOI6MR+LBJm8JaIJ35hm43Xfi0RWgaO3gjeeo0AAuH1gn5eDzZmK5Ngec1O2k8qTZBDwhCANmS1Ek949iA1IoaW6dqhY5DN2UXcyIbCr2AMT3ZOUBYiAj+XpIkyVTJhmKm2UxbPtixmJFD9PHEIvLlLIoKEiJ8y85IhAbC/aWXGzah+hkfN+ST4k+R0xN8SRfoynPl55g6I7iRmwU9USamURJQ1OQ9T91P6WjqNl7gYGiYIz6yIHDk3u1KZahvxc7+3iNnqGwsqlm5jaqXenrPebvY1J2LvNm6Jdr6Q7+m71SNG+aeqsdUqp3d0s3cANJtZdOJdiqQ4i1gTnBqnoBFvw9KG4cCWSIxLRzfEZBkhyj2XkPJFgb2aWMkYOfsWn+WEyQNyGuzi8fme6DTQ/jsUSBrbdDtqMCKGkSvarqYyxkLJG/mvZK2AsuN7Ct3HF84pEuYeV76Mrz20/kYSAmld2RucyaXgA490KCzvZdU5ly1waAk3DDrwAErlFoIJk6PRrjVBWjP6Jf26pz3LH8fHma+rnvRBRqR6gCMkoSSTbzqOp4EhRUaf78GmTf1yqFBpoUsdzHNCSrI+psFzYEZ7Xv/gII+qwgcqr+LIIRK2uQNeEgN1xLe27MG/huFsHKu3Qn4GpyvO220v6KdgxHx54ZbAA03bVvtlOiVN8A0TpsGZBUcFk00nh8maA96OcHCyKr/HCosuSE5OebCQvvOSBt9xIL6IOoFsOdUSpAKdcDuml8yiM2YDL9gM/oBNdna3vdJAPbcPf8FA/RVmC0yK/YNd+cDwzL8wEYnW/K/bSghkJywlbsBaAI4D58uPjOUv2NnnaaWhD7tBGLr3nagAjwcH6iOAKiTm08ijOscTCUzMwWT8+ybmo9TEnFsje46/y6w4EI83nbJIxJ90vxx1Lj6cf2nfHJ19OTlqXaUlp52PZLZV7etp95ebs5POx+4vNzqmCkUbyVQeMNiU20m26pwQKCYFnAfgw2LYeHArmQ/6MvcjtBCMtQNZYqA1xFtg5ca4jaQddptkDMQmEF1TzBmciCpyiBVPNsBSvQwhC4QRl8Z6W9LqtDF18+FfdD5yc7yNNqbUeUYXsK4orA9ShK4R6Hv6CPCzNP1wz6eLhZJIZ1O6T7zWWLC+vI2ySern1RHy8BfNW5OeJVghKXGkpy9Yu46ms2iSvIl3Di8V9BFQDI8hFZz/dMMzKqVeG3nxHNN2V6u88SXmBE12DpEEALW69LIcRwkkLRMRZyCblCaVw9BUyJLZAizcOAPwEIgmYVAX57sLScGS0f853gSkzM6Uakcp9S7RYKkHn6uR0W4TswyK3fhoBkABPtyNtjaYqXXmY7tdYdCxRknrcK1DpgEE8dxMlH4+BoNJT5BMSHwZBcXiNrHdoiCSzkzs88uIR/Wz08G4sH8D8tkY85aGRpZSbQyX42IqDRMUbfKQmlurqNfVbJUUKaSLITdJaHbeCC1msdrWuuVbPLo5VTIAlHHPpSwUA2T0rVXN1/pKSVnDMlPX4K4d8zjjwRCHC+meIR+ZqN1/AfbYq41YagIJ+4bfEDDEJEfgXQ0iN4o5dAa3h4fEU8PUim9Ni4MgvAcwwrjo0YeQUtbPeK1SdBbL+n99ldGHc8f1WM9D+hjSgRvTeqjaNbOirckscelyN126Bt936RqRaeKCNXnZBetRInqGzmO+C9bCedxFDY2dxxeooW/Oo+x+JTmgk6fso8RvnrSzDyRXcnKWfbThl05u+ONNx3Hy4Ew3vLnkYLZyJGTmrBW92lkrwrBgP+isFb3WWUscNd9x1pokzloveAYLD56R57vTY4wVkAg7EhWd7HCVkYZkvLGEmUlSIPsvNZinOfeqZqIAFryEb+BMkbM/+0sOT1kHMxiy3F22HcXTjGcYY9o+sZRV8juBv3lSbaCONCBN9vgnQFzDNzB3tURl5xA5ckaFMdSRvxZnxpGls+PUBIE9mFKfpD8dHRSKhGobyI74kKjy8FDCXiRsqpLgLmDOr7JFKDpNbbmww9aRbJjQwwP8FALVf3V1cNzhYkLsGxV+L88MA56qIcQtSuGpEYGVE1tO+LW5epCsjxcm6He1glCIgJ9l7fEFgU9GJGBOIeznmqPnFIrSCU9KcHpl4Em+JNWculH8/sWnyeSLhhIqWdpE2Skz/k2uhRtdznhdbp9aaE4fbLqrzbPnsIToMq58SC/vgw0Ajys75zqCTW++HMfwfK+9NE7DDq89qJQKOvBObJIXWBl89i37yjfxzre/7O2X/x7i2+QtvHN4qaaR2ff8A0kAJxFNQdEXpNzg1okITXGGbFslc0tUoDaV+6zhDQRNCpuxGL3qkaQe6oLZG/D5tDz5gJHr9RSuVll8uWkjlxwziblXeugIoycoUBiGwA/KVJevCgyyEfEXJmgbsesNYTy5sQk4dybAAF0cAVGkWte8XUM8KXmmr5HIyaP7GoIkDaWwKyDL2dcbYUPzerO+E5KQeSOuVqqLpo2hw3pAZgXHaHhFdzpl2CRyXMKgAVeVq+s8CFcszuHMLBUKhL6MjBj13FTzOu6wh0SisLV6bkWGI2hq3LuJXdDVjhnHhw0RUSYsMkZk5KRONUCepiS2N1L5UMGoL+NTMhAjH5ARmUrzld+Fw8N0Y3CDeM5mhcwy/4UJ0tZb7TkhyYMtYyMIdCpC8IeJZX+qZ+FfjzdbR3spcRLgDe91xqcEADzmuEMSb4Tas7Tyh4ckZCYx6POXOWFSB8OkWEm20oYDSEronKpMBAJztqkhlJpHh4aUqFyt8ggf0f3VSkZN6mv6iQaYWQDP0LAZPeQJ00MK/MHNPcTLzBYr53PJnNCs+XXKLG5KEvZ9UNZn7K9lTb7GMkAIC+zE6TcNMjHTyF0maxiHSJl1FRiE63w3gIdgwKi4oSVaPMxIKjuW0TXZSDidL96/k8X7t0JbAoL8qJhwpZmsWbLISY7UoxFlgxRkzUGstaSlYXEPsl69FPAHCQzwEVOI0jr5pOu6pWhrkTQ5hrQ46k6VAbuCpeYpJ1VyFYMfeMm2ikx6s99UR87rar6kOyHbdckzRj/LCeS9L6gnLpNmwlDHceZARKChV2baZLbzDvQ5kI87z0VOrpijkM8YVmVsir9pm7rtFyV6JMiLFUQiTB7u7AcZNbDqOzFxd/jlDxJN6ezgYF+qGYN2ONdP1U2912MtF0YoxlGkRISQnjUhdzQD+Trzig+2PGxJDkpjARlvNRJwpN/M4xbRKLvB0bKEqLgtHbNsqquzLO3ECCpoIWhkU0SEzQDbbG+7CIeAXDNhCljV4Y4wBX20q9oPViuVhSGQ+wjMIzr6sn4GBAYBUx9k8HHkbHYdyFamg+GAEW2BSzagQp60IA3KsC+FOlCpw9cYmpHC4vG4tw1/38GUJJj920uWlvhOxEKkzzYd6pNknanL/YxnsnvJia8xWK2yJKzO04Py83u1EqSTyA6SS3U0ckudZ64iIolqyCMI/PWQCHKpHhBYKK40GjVHjG7LbbC+Tdbk1nuBnCo4BouekJBnM77GiR9KAiIzwhZYIxsIBmZjK7H7xgnAZqxQ2LT63HxXtoPKtNCIeRvodcV+gkNwuixxIk2I86QJca40gds0bTmqtzaEVhvJFXKd+OGIjoOz4InnfYXtp/aUCZ0rhEu44HIIVzcaeJ5ClFvPd0OIMHXrRrRsQ41BZLLLocleMMpTyn4d8p+h+6T0k3iwalxQFG3j0+8gW28qkrqRUjjnR2/LjjfmBiivsBn4/4FYgfP/0fiJ8+8rwZgGbaEyionm+OwI2QRyb/ID6g936tSyu4L1O088Ae6fMeHsIT8S6684NZMcUqmcjFHcfroBxU+Hy7LYPgaA9/mJuXnoAWchJM86509ImKHaZZkeWq1nrc3hSJDcPGM51CfHUT6cxD8Mt//tMSX/nTFXXrFjvhtzJT/a485ojrkxV6bfi7ky/QsxV9YETNL+ikIxk5CAopllM39BYpJQ6SGRF0EnA8w3NEvuxaxAikRIfk5BxhOvRYLmatnQNTLjmW1VBU7eLzQKpt8opovNlkNfMBusKOb+LmSUlqC/Jbhkya9+CoMHDw1cJ0n5BPNCpE8ekycijGNDcp+R829847lShoFPIcWG5DeTSoN7s35qJOGkKpLeY184r/NnsItjyCLjo1S8N0t+ufwXVYdqCOSmJnssj/EsTff8AuT18JVvTn6cK5lJBSPRJ1Ev2qgXqL4DLC6PPp3dvb3H/prRV7lkB2GS8N4gO3zeAJ/uYohryaUoWH/U366DcKAOeXx6CKeV1n/sb0XNwoOav71p6+LlZ7iGlHTNUIWLVt/41Br/NeQgRb0pG5UWOvK3ck1OkkJkWOLNTGbaM6QmaobZb9Zpb9LHN9Ya/FmrPtcUpMqgwBETwOEonefg5TkMEH4a2Y5jQ5MkycIeH6MTknC9JnlGseQFSQfAU6D6RGFlWbtsybzp+ysVS0uL48oaTsSwXC+s1RqjspFvclDZ7Qwj4UZ6jyeiBiqkLCGPJNnbGbxFApWSmXiYmKdln7vfeT74zvNR8vHtaExJXIXt1ybb8hLBYvtOKGObphomWCZMsEyYYJlYHao+wzJ1FSvE7ClYPmU/LKUYTNuMNtOssXSvuxIGoN4OGFuBNuF7Mlu/z5Ja7e44RYEGQIMaQvB1jgZh+LN+I/Ug/04zfhakNBJCfjEYr7D5T92DxlIWKuDoYLtAZN+Xzt3NrC7sMMjmcYR9nCJuKdsZ24eRWNRddaAZJj/yiyNvGtMwmzyRT21uEy0aDULvMQ4wYY5WTA/2Nc4GM4ljlv+IoRLRrRxz+n+SishSL5ngHY3wLZXTr/O5o72w3/CloTnyDUhbkExPvw6ksoKdVDx/D10NZN3rSyRrTHxYVkThCa0jmyih9ZJVNrSi50ePdBATF5LrzoqDWRQHD6uVwsuVPFymPSdDj9UtYwxm4SuLC6nMyiapH9RMvg13bzB1o2jPjfbc5PBSUr8lbloD9nup+j52val0K2xP1hzTkkEihQJk4vQwlbYCgKSQbTEvzx0FvjP1WJLhNKTG3+nN5MtcrlNPe0alblFSKEgvrteEfZ179r6yA0nb64bwppHC16Qfk+fnpS/nfxcXLOFQ2CsZQU4SFE5MppHtSDP9cnZR6hsPsCEYFTk8lN4n8TrpIgYjyuniS5/YWHfRDARJyptg7mcsj5QTDEqiFKZpZ0nIckri4KnDTAcbWlhwYlGaIKFkBBho6Yc+vsfSWQxUPXVg4tJahz+69CN3RFUIjKdrJJC6OANPp4aGsnE3dknoeMR3ZiTKSPgeFyKhJaBOUGYHcpw/EjhBRj3lyeNh8bpeOuZFAKK9+G0WXKQ4VhsPRG4veVfjk/yKMQanih1n87EMfyKKFmW3N2MaJ5m56klRoqYg6YphrKwcmJNFBsk3k7fSD7y8k2VAMojvcJiBNY4PnVS6nUAWt25l9WCZ3wmz56b4UUcjiQDjn/BHfsHx6vBHnljdceAjgaY9ZyqrhUJI2PekjcsK6i9tM62uSnUJ61/yvUDTWCCZdaEQyrR8YhoXEj8zcULW+NLEbUC9RiQQIr7D3DFDBgQA3Crgv0MnlMGiIUwRkmkNvzutHm+NpKGZiE6CnbPpk3BzNsMfmM2QhC/Ppp87mz6hYjbd3G3JX5pt5ipMSRTqGA36djMjbYMKUoWFot+XMsvSflO+qT+vG/RvZtPnNKMaakC2Fnki5izZx+kyiHIBWtDvkH9RLsHmUSDwv/eqGkL2+7RPL3ToZYrwOx9gMazWaWa1eA1ZjikBE/F4UtcJI6BOGfWE4Rq09boPstJAlZI3klAjAywjAwxyqq0JmIO/Kl9VI0vxas8RXkiQCacZMNPV78cBSiwXMWzQhpvi/jY/KFdnVho821r67UjYxmw47kjm9s+cya6/GPlq9hcCVRP3L0haE2X2auU2VdqkeGbki1abeS3JFqIQfuQ7VVhMiDR+EhF6rHpuKf4QSOMHQ26n0dp+TGycvsfLIK7KtjgEjErjZrg5R+Gu0Td92cgZdOzhD0yWR8LNmWJFddpUN1pmrHl9o3QtZnRNZv4WEP61yObCKnijwmYqlG3JysvvZjKr/MVFNMiLSc92PMz0OPs0z0dho0qeBfV2Kzufbxhca2uS9Q+pv2D9vxm3Id5St4FBqWQatlr5YB0mlTRT4zA0t93EX+s1AaXBd9E0c9opvix8knG49rze5rL3YqTWg5dCUqUhqFRKuCSoEWIKjGcQzuW4ue0bje24Cmgarj2DOCHNcbiVrJ74ko1tqBHP0Rve27DhYdwiT85B7/UbsRx8BYQQazVYrTwRFzBMIp3w8IChlCwwjcFKZtxtI1MzTRlIk9/ElYUTVHppBAfrYCtM68HBZkkClCSTtn2G0XkAB0WrFU+ICALJqUO3cATdITnNOL6h1RVG7ZtlGs0GJhEP0A9iuGVlIZYyglhwTXXz4/ndYccTLqWvEekLWh0suTD/xXdnanc7TIa5JovskoT0nyjEFPrgkbYWqT3ybOKKEY1/oS6GLsm13ndvgxCc+LTmDKM+b6FGmgZpcTXC7ngwNJeZrmJJAClmYwod4pWwZYyYhr1uLjCOcoACWLQ8gdBpGpHan4i7dHCEQytijoMD9jiNzpbpzlAjG1O1Eesu28fNx9n+Zp9JPWajYfvrny/G4sv9QjqJL4Thy332/SYnuU+kSXox6h/gYQgogQngcyWUqcCRCxuFKzHaqIkEw+4315tCKUqKJ3TvNgyeIhoqmEawZpd3IvoG5ysTH83A8Ziv5enFxWnnI/hoXpDI8eRzIPGllKOdcnUFF5PGcihO+D5a7b0aozNjPDSyYGiYqxXSaDmg2Qu0xCGQx7vks6tm0R8qIJJoHizaDdecYzwJ8iywTJ2mknJvva2MEQcWMCYNaJkEaqLjdPPGwqXJaGQq3gaHDfKvoIxYa/Lfaq5FTUIBSLQAR2uBCmleIDIAj5mY0YgPoDexmo14kUkOCe+oNBN9QR4AFyrkWen3xNNDo9+c1ePiY/CoavXZOl88n5inb53jVDrHUbACuooYlRSgnZDO8bCf2GtMuao8G76SQkxAwLQO/kqEJm9NSdwfCIOGSBHHPy0+uI+qrLbmNlKBk6pNDhNogC0QkPCdnoUoH2NPxRr4n0UJmz/QSICZpKQCT/UTdTpvE1DLcDag6gg2Onhf/whFt5XgX1KSPG86cdc3bHA9Mdb89PJc7xhvRE3fuAcxZy/sM/2ECggilBTx3MTXiz54vheDSGm1arvxBMILBaEaQF6iYLUK3urSQvmq1wzryiRrdpdakmYaSMQfGYPdct0oW1UbkDO4xktTms5PXkbVNSnX5AUAmXOMs142bUzPHaol3QaiFvzvayZLqG3aekXWtcNZIGTnzLXrGbZP3St+OT/vkigG49xeH+hBl4wct4glZOr0+mTiUOfds8/OazUmSieIJxBEPQ72QvpI3XjPjfcGwXT24O8pBZWCfSZQbH4xisNucDxxQ1R/wtajzqOQqM3e0obGg3WHzmNvVij0WaBu5fpaqec/uVXqI+Y5EhWfgnD4Ppj5QzdcqJomhfRW3kvV/MD/+nLNp6RmgA1GG8+/Ss/9IP6aU2UoVfH8ePNxK9vC6XaNSO7DxItp9OgO6Eali42O5NQTwcrfXA/fFGMaxWqoNflLyXqffDj5ctI5PuHi0STdQQjpDtZafaP+8S9HX3hV8IZzw+NgSI9iSB2iraXu/SnN+C0de/5G93+SnmNQv8zTnlIH8Bw2lD8hEOZjb9ZvqijfmBUKWj3x0V84fjEO7qnvLekxKEXVRy4bnmkEjs6Cs+gZfbIxiIuTLloMLnp6n/hBXB+uM58vSnPr+guA2I0OqqyD42TffPxyfvkp2TgkpA/04ZaGoAdvKE0YBANdPAYYEBt90C6ZRHEUdIoYF0cB5Gqnw/cLzJ+2L8r9IP6QfVRXmD1aug3/89T/5k694d44DGaPZA/Wxh3ENNz7+afncP3zHsZK2fu5+bO0Pf+zoM5ge5JxUfQYRSh89GONTNmvARjzjcnIGTNUIM+FptSB3pmmnoIpbrj0H8DhiQ73tCxWYJ8dOerAmbITWisGj4Dko+ZA/Oolv9IjvT7Y7sFKqSc1IWqo+O30eOU+GVLgC/b4PTtfv4HvWfoJHOg36NQ3ufFnttRPpE3OnDd/qtfDgqYSvDS15vX6TZHO6UACvMRe8KyJasxRMjETAMunNKXIGcAm2FW2nbOe2W+e9ax+U3pq4dO68UavPwGsnPX0Pm+MbO3jTyfd0+7peYc8eH79iTy483pb6DzYDKNf1Yvb2TCt7C5oKvWc7u/+ro7fNTa/Kzdb+AvNGqzZN/quhv/+1/v7YsMChf4IFhQJUfZf3BMxhYBHbkz5bgUL2jVJjucifCVyPCC39PzTHs74Bs0ceY6qOe94D/3ip/MLNkrWS+VWWWMOsuzht/ud9+wdRN+7a/7JaoJF/856PykYzLBWM18cFfGwiZ5o4stR5+MJAU60bldJHNRLlXWfBNlK0nrUSmuS826tAu8appn7tFyCpzV93RfpnzxVA9Lpxa+sSU6poecXG/nFZn6xlVtsvVC7nP/NUqWs5w61atRMGGzV1PNbrJrWiw+slx7UXnhQrbwwdLNazX1SLpmV2rrPYTvKgpM4twNVw2N73+BAHX99RWW0h0SKLL+it9Xq6ffrskZTKi2/erTd4de+wT7AqZD8ur0fAEXrL6x5P+nJmpR0e0OS9AwsQ10nSAHVDSL2fN0kFyfdukUQ+Oo2SVFvvUQSurNeJvC9emW9JsDD7EIMgqUJnGe9rpO4XiN+3dDJt7phkFHdMEkII2xQidPI9SPGvHSPU6CW36jXvevr2+u+tlKvr7Xm9bXarM/U3tHhB/2w1n+219pqnt6aa22l6s2eflhhNwO19x9Hh3/0rq+v+382oZWeHvvfRmFf096MiZrjVxqRGXGR8Uw1E8JZs1mt+ykJ4BOjrNW9tMDDgigtiEhVq8+ayn8cvT9unXz4+Mvpr/84a3fOP33+ctG9/O3r71fQtf6fe00lcaObafWg5/bBGQPNclDOd8xPMTXxWH7T6133n9d//lRcNf9eULU+ZyJGQMKOHODKCiONjEBeQGiWDndQVOG8S+QrAYwa2EfXeYNTrD5pK3WordRIW6lftZXa0lbqhaatVHjYrF9fa2oRZvPP6/71dV/TDllhE0v6UBHXLCnsa2/GnPJSA8dlVFmsaQ3IBRf0jL4247G4U8ZO5PwOeqb0WDBt6VNLfjnDaaV1bKmOzBmmNUrZGqdbnylvNPHClypJtRz8rga9an+1Cnq1vpYlTQDrBz1D729SLEl+8301coKeYfZFvKPejLjoZHsKoNNvbHxWQhTRFhnElZNquEHxpIwJmrEqCDxY1UEZICCXRNRysfBjd87l0oLDCel4NgXpxfwxpFHkBX59741SiAvKm/qeUgDdI7GNak1CJUI2ZdXKwrJ3yzSE6Vdvp8Hg3vG5nWESTxfVuRfekiaJmLEilsS8hAUx82S338ehG29mLMt1qcW02CI6nOMz9/AY7fdTVaP0YeI5G90gQRKshURO0iM0e5y9DRqprNN1or95ZOCgnOrB89XgcEa8QxdYH70xejtojAoFLey5hVEf/KMKoz5w0gOiRgVnoP3Ncxxd2ImwEaphxjMFP1xwAu6iKM/I0BvTKH4xsOiU+n/bGFdDugd7csOsyisA1vNTVSe0YGiEvnM2livR/POOSm9qOc0INyKn+vekQxg/5q1jmzW7Vq6YtZImv4d+o5enfmyZ70/UcBM4Dm3JIch31LSVg1ADG1Wgd8NDX3uTPCk3Xm7f22q/qpGXq/s53Vm/OB+N1GmpeDNxo4maelI3g2IcpGaiQXZVbzYBPUerxOvsPcyieO+W7nmgqgP5OR3u3S72otltgg9S4sJbk5peMbd2cr7b0JZfukiw3ov7LLCbttmtuKAIDVc0e4Qm6XBPfaJ77mBAH+O9x9l0usd1g5GmaLJfFF03/GI0cYEuse2qRvDOAJ2PZZX5rWnaUGBbpigolUG0a9dqvMCqQg2jXBWvlAwTXqmWTWCWbLuag8gwBA/3D63WmLxXQm4kcnpGyaiWdN20a8SolmqVSsmqWeTQqNZsXa+UqlVyWK3V7FqlZNh9MpO0HVVda2RS3CFIgIRcTcxrnBnxpCAvZZuUytqGhicxd35r6SvMYr6h8OFB19IMonGTHoSr/6IHft1MbumBvwoP/Dr9M/zTX/uqSzwtEwIaeua85MLsOkbFMktV26hZYjs4tm5apmXZRoUXDRyzVDatqmXoJi8aOmbFqFhWtSIQDnUss1qyKpWSKRKmuLt2gWxGmqRMeSK+o/Po4C7xkt+3JEh+D8gs+Q2GeuI3JVNHb0zfGuVGoTDV0E2KJcMRO97++5SdGI3p26qe1gp700Or/ydcquxi2Pxa7idHzMTRGxP2HvdseHT+67/UyRtT18jQ0VeqSh1fe/u2tKKwnBWtMFIfMcLfTCu4hbA36RfA47HhOjMycwISOAPVA0D1ie8M12JJ/AL/tdKTNfF42W1SNnACXjZIyobOjJcNkzLquLyMrvSNNQE8th13I8iYTZt6iugYBhXTmS4U0E/5z26J/eKzAam++GxIDPPFhxQIfRLLuNBdE8Ar//+PDEoMF1Q28h3swhbT/40t/gq2YPsZscVEg825hS0mHFskux5rqeDgOWEIY8IQxoQjjAkgDO3tWwMxgJXme3p09MYja+WRbbMh4I5HxB0LZ6D6WmGqDmVk8QjIYthf6RK6GKXoYvG/0cW/iC5sy9yJLhgVEiSII9pAHDIeKNuvwgPBNh748T1owd6qGLplJstdM2yjpJfLVrLYVcOs6GatVkuW2jZsu2aY5VolWWnbrOmVSqlaEkUjx6iU9LJu6WaJF40do1yz9Uq5aom2Jo5l6rZeKdmc23gVmGSdcczqS2DyMpC8DCIvA8gO8MgAR96jETFf6sSYmPY2SAHE/MUTyDDKVsm09Sox4KixK7ZtEEu3a5Zp2RWDWDXT0PVaqWKRWtmoVStG2SJGSa/WKnqtZoHtiVW2ShW7SsxqRa+ULdM0iFU2batq6FWdWIZeqlVt3SBlvWKaJbNSJYZtlqtVaI0YNbOkV6pWtUpMo2zqlaqpl4lZNuxqtWroFrFMu2qaZgmaqlolq6ZDW7ZumqZpVyo2Mcu2ZVf0SpWUdbuqV8pmlVQqulkq1aoWMUy7ZpR0wzSJUSqV9KpRrpnEqNXKetmuVcvELJVs09SrVZOYVdOoWrZl14hZK5m1WrmqV4llGrplWGWYDMsqlypGtWYQq1S1S2a1YhjEMKyaWYbJsKyqCVNdIuVy2dIrpl4ilYoFTRkmMcyaXSlVrIpJDKtWNqqmWTOIUa6VjKpV0XVi1KrlctnQSwYxDRhC2SrpMMPlWqmsWxViVizdrpZqpgF9hd1iGwaxzFKtYulVXSeWZZcqZbsCfS0ZZb1cqhoVYpV13SqZVd0mtl6zSxWjpteICfvIsmyb2JZumpWKZZOSXq7Z1bJRJuVSTS/rpVKZVKtWrVapViqkVqoaVq1UMYhhmSasilElRgn6buoAFhW7Uq1YlUqNGLVSqVSGNSIm9FK3q0aJmPgZ3S6ZBLy3qyXTNm1i2mbVtso2rGWlVK5YtlGtENzqlmFXasSyzJpllsxatf9XkN93iKCM9VL4Z3yg0j9DbYsnSqrEB3QVHqjx6gVDOPRyNVdIMWl/4p1hwa1R47cmPjV06f3JxvtlqGGWxfsG3hri/RLcVjJpr7OvV7B+Sbxexe/Z2p9wZ/0l8qtS0y2roqfkl2XYtmHZZiUlvwzdgO1p2jL5VbHYpkyQv2GVatWaZRi1BPmbZV2H3WDaCfKHvWXZZauU4H6jZBt2qWaapTzc/z9Atw2T3yOySH6PyTj5PSHfHL3xjdF237Sw922btvvGabtvb8t2WguYOKTvvh2afRBBGZUVffvWgCUEks6o4a2l/Yl3ulaAqpV+4VGFH0apz0pkvvHJ0RtP7CtP7DBsO+PCRHW1wkB1yZAstELUe+oXwt5Tf6WTM2cK5OBIZSEYV3pj7CzIwhmSoeMS15kV2iudE4QepwbbhbOV/j9BESawMuRlo6Rs7Cx42TgpmzhjXjb5S9SkZf4fgUzIezQhZnWbggBB104KAgVfryRKjbL+w1QpCJANw/wLdOnEgQNDr+hWWSCL24ljlEs2UKKlBOomDtAkJbNmVRKomzhWqWSXTassiugEeE3D1ku2IZobTRzTqsFHTEt4fI0njlWulHS9WkpI1snEMeBULptVQyBKd5pDN0+3CefpNuU8zSGdpzm08zSHeJ7mUM/TfwP5bFellaU8HsPzBqBR4m8BX0j8gp3oI4SI250k0wQbShTfTpKZItW0eDBJZgt3hSgfTpIpQ7gX5XSSTBuxzLR8NEmmjth6DodWLZv/MjltlfVapVyxSxnKuqyb1ZpRMUtZGrts21XbqtVkctsEmUetVLKrEuVtAwFrVu2KKRPhll6yqpZtlksZerxmVcoVo1SpZUnzsl3WgaCUqXQTjumqZdVsiWA3jLJdq8FGkml3C0g3vVK2ZTLeKtV0GFHNlil6Wy9XjSqAv0zc12qGZZUNw5LJ/LJlVXXLMioZgt+u1CoVu1quyrS/WQYq2YKZldgAmGEDSHGJITAt2zDLQINIvIGp65WqrtcsS2YT7FqpVtNrupHhGKqlsm0ZplWSmQfLqJRMo2pYWT7CqFVrJb1qVWSWwqrVahWjZlk1mbuolMsVmOGyzGeYpXK5VLOrlVqG5TB1y7IqtVJZ5j4M3bLtEnAIMiNi2mUg32t6hieplKqWWbaqMntilKsVo1KzyhKjYhjVqlGp1cq2zLOU7LJul4A3kNmXkmlW9ZJdLcmcDMx7Fb5hyUyNadlWyaxYtQx/Y+iGDctW1WVWxzD1cqVUM2xT5nos29YrlbKZ4X8Ms6ZXy5Zd1jOskAGzYZsVK8MVlfRSrWSWSxWZQTL0smlUdL26wSoBLQpckcQ1GbZlVsxSpVKWGSjDLld0yyjVbImXqpYMo1yrmLrEVVkGkLdWpWRKDJZhlUGkadRKEq9lASKvlko1S2K7rEq1pJd0s6pLHJhlGVVLr9hmRWbGLDhETMvWLZkvM3Xd0i27VivJLJpV1k3dKldrGW7NKFVgI+hGhnEzDNgnZg22j8TDVYxyTbctvSyzczbwBGXdzjB2pZpVruG0yixexbIMA8XfErcHR6BVMgAFpowfjKxUM3UYr2XVjFK5VjZsQJdVq2rVcJGNklmumBWDlMpls6rDjrBqtm5UK2W9TCy7ZOvlWsmyiW0Y1bKlQ1Vb13UTNqmJ2M6oQmcNOJ2taqlkw3wBWwIMdwUQY8msAMDVAFnBepm6VatZul6GZNBWzTLLOJayCYBBytWSjfJnUqpW7Fq5apVJtWQatllDAKyWKzWzyrYD0C840HKpZAPEwKSXdV0HRGjCHoPjpAyEEiBKuwLoUa9VSyUDjpiSbgCU21Vim5Zd0mGrEaOsV4xypWaUCJ4fFbsMp5FRBtk5wKBplwEf1Iz+XyLgvDwC7r+Dt0690BgDXAVuyOa8UcprU4n13cjJm3nfsJGbqqb8MWX8MbZQwxasLHu92QK8YQmGHN83OX9dWecHvt31Hmfa6UbPF9vvJ3wgGzr21WIfLmeC277yRS5toChtSBv4lvX8A5uIt/Ce3jTq+l+h0bclCJMcEcIkR4YwyREiTHKkCJMcMcJkW46wJUhAGt3WqzXTKlVMPaXRAdNVK1apVkqJdNusGEalVDGtlEo3SrVSBQiGWkqlmzWjUiqXDEui0itmqWQYRkLgj6E5o2RVayW7IhPpFd2yTL32o2KORMgRpkKOiSTxuJ1IIo/BRJJ5DCeS0INOJKnHaEKekpvxhLSTm8mEnKXfmZKb9DtT8pB+Z0pu0+9MyV36nSmZp9+Zkk76nSm5SL8zJS1Hb7TeWmajVXBMjfZa21KVlkZor1Uwcp4UbC5xab01yjprA2n6U4f2WoeW3icn/Bd4/xw5j+opOdHIsTNUT8ipRrrOQmV1bVHVLhh9jZw7Y1ZjiYWgo73nv6ClS9aq2Sdf+C8ovXKOC/crnbx3jgrLwjf1ihyDqOW9o7533he6UOJcFc5XOjnXVrpWuOQlX1Y6+aKtdILjfy+Ge7UW6//J0RufcIifcIjvHdr7BN+jvU8FgznDfnZGKpq7auSDM1LPyA150MhHZ6qG5EwjX52pekZCjfzTmagzcqeRX5yJekdmGvnViaC1n+ACw/jDGagz4pInjfzmDNQ7MicdjfzuXBR+WenkH0678M/CN/V3cgGD+4ej4r9/FP6AQuf3wm8rnfyG4/uVl/y00slPWPKel1ytdHIFr0PP49j5WvgAw4+dj4XPhW9qHJOv8LTtPJELp0OeIKOdMwdBFZk7dyCoKvyj8E29c24Lv690cguTB5KrW+cBpVcPzg3xnZDcOGckdP5RoHHhm3rm/F6I45VOftdSwdbUET9A3JViCPGjcJOUDkTpYFp4SEqHonQ4LaSCMCpK6bRwl5SOROloWpgnpWNROp4WOknpRJROpoWLpNSdiP5OCiHMlNijZ1rae1HndlLwkzq3U3KT1hmIOoNJwUvqDKbkIa0zFHWGk0KQ1BlO2XQn6Fr8KMySOnRK7tI6I1FnNCm4SZ3RlMzTOmNRZzwpPCV1xlPSSetMRJ3JpNBO6kymCIh/QRBYtv8PKPAQ5eNJAl3Elr47mSTwBeqSLQGJWbX0XO9oL/E33/aSzkYM8uX8pCJ5ncdyBotQF06o1uyqAUUisTmKXKomFLVmj1M6d0Qaba+YprEPVbusg+N18ZMbRd1JGMzGmKa8YmJpEsBJpFDwMLTB1PPxmzW7DEUX6PcPou4X05lthHyTh0gFlLBgPzwRFRRQlWoQyOnRnUU0+aFmQt9pz3JMIfgNUUzTX5D6D4NeDN3YVQCfsxgYLM0pCeD+xosu4qEXrFbi++gMBiGW0tgvM42EG7FleJiDfUOO8KE9gxNnxHMbom/wOsMhJI93J7lNfqrapi0SZK1WWWpFvzjlQVMwTxTjMES+iu0oIyM+X9loK9LUbD1KpinMD+0yy3uSTFHeW9g3DIa449kLXxvt+Noor8XkmcAvoRygZyCgQf5w4MsfC/zsBzbvebJMiBGCWW0wJELJ+l6UCVkc6jl+0YvSLOsyzcrjBqgxBCiOMadlNn8UCyQAuavq+Att8JOfh+I3y2PFfvNcVvyG5bPib0BOq+TnoZk2hAmt0malW8hxVecBnvTE45QXGOt1IxunEgnwBh8Wz2yfk7sLq21m89oXkWXYKNMYyo0k9ELMJ2R7GjJv/pXRpg3gPWtj6saeb2xMLK/JnzW251leDVy6xI9KzJ83UkUyk0bsqLj42ZUnEPlnveYB/bcT9WEI+RSsHMfxVqt9T401bcuMXLn07/3gyd8TawGOKGkiMggAuk6cSUSddKbZ/ODDGCKlu6Li0BkQ6tiymzNbGHwOPgtnbhQ7s81KYrLSJkdpk1NCHWvDwVlmolmmrwlL1JC89cg9B8AdqEPpUGRgh/tuELtTuQCdFf0MeUOzQU8le0/HMCtNvR6/e1dynHLThF+24xh204KfluNYetOGn2XHMZuHRv3QXGdDQklcadKfw0x3SbgVRsYbqYZZ3XdUo2YeYGAeLQ0wlI5S+f/8P/8fCsu3LUrfGRAdjMe5N7ZaMnJbMnJbMqWWzIMDuRkztxmTNbNO4v03NhNohc2wnhn728QzqIkpBR8XavIcVopQopPMG1p2KSXfDxmAySYIQLS4lz/A+6BJgCW+d5j0MHvYizQJqnh8SLW/mY6jp7Esk64lG4nwnM9JchXZNy0UDRlYyX/nlEpmrXxw4L91SmXLqGmZQNLprG8Au52doZ4OrlJJL83+xmMj89jokzAJQX5oyMmV8z5ubH7c3Plxo0/yp4WkVTYpIr6BDg5ElaacFU+rK4pI6JTCiESOvrTrEgRYeAGe0t7pJHUio1uBwdinMmBgNSRT8LApD5mjP0JFLOBkKq3DcHMyLWKwBnZOaV39l9ZbI7n9S1ck1DZtvF6xJHnQ0nx5rsVndWJll0mr01wDsb34hZ2fbxC2t6uza1qMnIAEO7Iwp5kftnJOcOuqHCD0RmoSc0+lTuZgxLM6aSN0Mq+SjeOM5YfFMG98N75NBkPFrMJZir7N9Y1bcN9U1pnhZRNc/isLihi/TrPNs2M9hz/MT6ophYsDvPc28T3XeXDGSKU9v49Y0Xvn6OKx907HTLTJRHmHwCdDtcND/224Wh2C84WXNgf4OmlO292U+S81hbWbnqPXM40Cj54gB3FO8lNhPws+23DOnXCp1tjAE2FD+B7yiVTDjV2U2nHlHIE6k9xsfQei/mbWNaHqNjbGC0e6tuujOymif9OZ3/hLX06IgRfogPUajFVybGMwOxOyfJt+3JvJpTBSKYvVCCEAN2LuS7pAkQ1r7aHf9cGBxyjX5AcjYTduL6bBUzMVSPl1SMcIyQUo75kTaXJymY1sdpKoB/IReARrw/fzCFXFn0F0LknQkZ8o6ogHpWRutuDWekv33D3+ttbYHDHhRle7vrn/Q9/Mfo+tmqfmkKm0uZ3+vekzR2zoQl38Tn7pGvGTHrNF2MpX/W/oczpH2sbXYMn/G7/oF+EDSeYdCGNQy6Sqk7PTac9xuGAJ6ovj4jQYuNOLOAjdsWB79431wOUsfVLCs/dtvAH+0ZmgmvTgQInDGYUIdBwL0A32OS+eqiDXfVXxgxZ9DOnAZdnJsoFOwpcDrIeiAZzEbBubXDfVGlAxdAc0U7EJkWOCKS3iIwAgUfDkhhArl0Bm/TQypxSCPY38y2JzlupJrEznWTlQ6sqB+/DYUMjPys915eCfsyBuKET5GR88BhHcvIWbKZa/g5/juKGsX8h0KRNOPCRNUw5O0ztQ3r77ub8ZSibFXYDeILAbyM3AiC0HY2ImFLQmNEslZkwIYnWNi50bOXjRCVdcG+aDu56j+g4lUlj71UrXipA7TPWB7tEgBTDmBVJYlNNscAvVdeJesJFFsaf3IRiiCyoE+pAACL8v+u4DdQIibr0BSGedMC3wh9SPHbpREDkzqSSmYTh7BHkKL8MhDcgIItFANNNGlurN9jA/Iw4PBZebRygu/OwoPxdAJF/4WfkZ4s1CDkQWU1cI2Ni8uFz6w+erzkPSOo7jakw04xZv3DgOIY4d/wl2EDcDEDYfHPDAjKrydr933DrqHvWUgniqpQB03b/uv3szJkq/3++/S+q+U7SC0u+/g8hzYpgHB+oAw+2zlhX5mZoJKLy9yE0FO6g424vcnKgx736dN83hrGCAvICHf2SpA3gFJmSDcNqD1Srtz2ZUvqS2m6QZega4qQckWXtIOA4f925nMY3qI0hZGVM/rk8Jg6l6SDjo1Gf8VyZX9Ew+EfnAUyFhmlpi3wCaUdDayfebRp0W+TdFqNuMegoNBraqpAnOxAOetq2R8Dv7QClDrwLVE9unAb31tPUa+6P674wmFTujrihaQaW4s5rK2zdKgf0uIJZiz1jVg4P9sKlc+1iM2evDrM4ogfj9fWmomEoguQOsChxQTORCKPHkEoC4WIUkOvu6tgYyF2dSdFrqsOiv6Gu6qkk4cIj+I5cjhlL2FI294zdFYzjiurh7sz0BMHPpDOz72XXm1ZIaDZiS1crbcSpugw5Ml/OcQJxIYO0R18EAZtR9aGJccJaIeOAoChmxfBhJB/Z1x0nvlL29vT2lLu7rikImmKwkw6tP5LRCkL5J1bbsyGQgg44OCpg48+Bgf3RwoLqOy4K7B9AhHR+MBPc5aDxmsme7XMPDdGQsqRCMZb1lfDZTh5ibeEqmYPsF2YtlOzPslSt057s/E2tEFIAmCu6EjhNm0N3IxgXB6fkCZ1udOJiXDPL40cHUDZHAwAi+mRLiOc/faIihqBSjqCsk0QOECee0WimX3Q+HVWUNwr/Y9YfuNPBhdj3p1pGfaWShPivN+cNUqT8jDq176zXM3yBB8QC9RGm+AzhFMoKj7Gacd34l8oBGWDAkSQsmKxjD95Ah1bQ6LMpYI25TledOJ65WH8jBSimL4pOG5Cme8JItewcWFD/ldFECyrTzKd1FqPOMZ3UdDhACGL6+pcYCnpHhl21VDGariCJv7NMhBCV39x7dkPrxviLiAcHriaeeRDw0ZqrUMsJiSORaeGAU1BDBU8vkf6D8LUBlGBEPYSubsVHsqJhHz8LTK9UKDXkxtKKt14nsFDJ41GrlerypC01xDYJ93Xtwx/RN9G1cmD9MG0zaRz790jH/WLy3b7/OZ4Ol7rm/fNEHreDbmTW0houS1V6Uvg0eBt/ad0dP7ePacvgw8E5/mcS3H0vLc38SuV9L4aeLX4PhL1+ezr3qt6E1tM78wfLsobb4Y1Gdn3fvS2cWq3fqvTfdr79Znx9q9qeL06fT1tG40zqyTlsn8453Wmh9Dsanrfn9H1//WH4a6/84PT6C++Xwl2n0R9fuHHtHYyw7Lt3d/tKO2xfvK/jOMS//2Inc34/iwccPutsKxsPff43+cdy5u/04fTr7rfP778aXztnX+bc/zGp8a9rxHx8/LNzFvdTG+1rrc7D9HW/zO3883n58Kp8edx7+eLj6/vuLl95v252vv03aD5+/20bn+MU25p1l2+y0Bptt4O9Px7XN+atemfPHwYf3k+HH8dj9+rl2+sDm7dIYjn470ePbjzX/dmF8u/WM+6vffw1P76S5b80Xf3zt6Ke/DB//+OVL8OniVG+3Bt7px8nU/ToMhi3da3dPzFOvWpD6gGtwievC2vp0XJPXufrH4v3jHy3de7EP3vu7248flgCjV+Z8edY98SQY8U8/Tu8/Xfza/c2o/XrrGZ8HD7X7IfTTe68PHj7MBuYf3wYPeu3U/7K4+lpa3n78oP9xMY7bx/ay3Tp53Bjj09XvXwLe5mf4/fvytNReXnmnH79Mhh9P4tuHD/EfXd3DMR0fLc67bXO7j6fe6cfPtdN7fdnp3s/a3cuoszyade4GunuszzrGldnx7NJ599fgrHsya9/9YZ5d2Kbbas/adyeloWebF622cX5hzzu/Bd7Z8jV9HOzqo7Wzj3f4XeusNXg6u7uyOsdPy87dyey8OzDOWlfW2d2H4OzCfmrfdeZnd0fG2d141m5dRu0LWz/vfjHbnv103upAHbO9/ONr5+5q1l7ez91ju3TeGkK9+fnF0/Ls7vNTx4R3T6zbFoz71DjrtmeAC7Du71f62d2p3dbHy87d5az925V5fmwbne4fd+1jHfq4PLs7eup4+qzTuod2YN7s9vJXKF+cXdgGKz+1sS9pvyM2z2PzrNWedbqD+Vfv9FsCRwCrv0j7Ygq/J6P23f3y/Ph0/MfHD/rVhTG7+mpMxZyftmDOxx7gnqvfO8tPF7/yPX86Bnjudi+fzu7ai/Pjp1JnYZudu+n7Tmsw69x9Xpy1Pltnd1d623t6OmudRO0LrFPqLE+iTvcI+m9JdXR8v3UatY9ZfayzPLXPWvdQxzx/NYzc74KR0jaMtAWMGLhf7sZR59i22FguZ+27S/us9dk4uzs1zo+TsRhnd0fWWQvGf7ToLJ70zoW96HTHUfrOEYxlftZK5kg/uxuY51/1p7Pu51lneVW66ibvw7ef2ncD/s6RNEe8jn6S1EmfHfH5Sr7x9Mo119s71rzTOtpa886xWPP2/OxuHLWX7dl561K/Wuiw9/Wz1smsszxZwN45P8Z9YLc9HfYQjAHh+qr7eQFr2bl40tsewMulWO/47O6q1F7AvJ4YHe+pdHZ3uTxr3c863XZSp3Nsw3zOzlunpavl51m7NSidtU5nnTv4/udZp/s56ni2hTB2bNvti6d5+9gudVpXs3a3bUDdNuy51sBi9U9n7eWRfda6mnWWl4uzuxOrvXian90Nlu0L2+60rqK2Z887y89wNdtLvF+0lzDWNqwBww/YP2gL1uT9187yctZedu7OujDu+9n5hV46u7syOgt91vF06JPVXn6OOxf2U2c5nbQBb0Lb4prOidE51g02xycW7O/OclwC2Ot4+vLs7tI6P8Y5tvFbyyvr7MJenreuYK5K7dZV3Abc4Onm2d2lCXU6y5PlWRfGez8fYvmH94CDz7snkbiKPdi+O5qdXzzpHc+22w/tBXyvs9D5XLYR159d2KX23WeYb5iXuL2w9c5dZwLjbi+eFucX9jKB02NbP2fruGzf3UfnMGcXTzbMcbJfjm3zrDsGfGleAU5YAr4BHHsatRf2vL28gnmDsUWdBXzzc9zxoHyAOLjT/RwDDHYWTwvYE53F0xPr66Vx1npvdi6gf1/uEI92AU+3bcSbrXbp7O5k3rnQ5wB7MBft7m+t9hJg5tQ8a52Wzu7udcDxcBZ0uqcRwEG7NdbPWtk98fUB5vXzU8d7miOcdyeIozvL4aS9sA04K5Mr2+/x2V1b73hPFuz19tfxrH3Xnl/huXE1P2uJq9jvfDxdONMu57/dIY4oYf3u6fKsJa68vmgHnw/srw/6HMdwdxq1P8J50wa4F9cn9p7O9zCUnxqwl87uYL8BDN7zfhxFeCaxeYb3YVzLTD+7A5v1/zP2q313JV2levAc+3eJ9dm+FFdpHN1Li49nycex7HRP+DguS6y/eJXGcWV0LjhOusd6C3ZW45XXe4Iz3jhrXUL5so1neLt0hXv9qnR2137i7xjnCCOfYaxmZ/mZw/yvuLaAe5IrmyN4bvK+l6DPQBd1WrDGY/tqoS/O7k6XiCsvoK9tHfb0eeszvnN+Ac/vzfbDyQJwTac1iNp3JzBfVrJnkCa5RPzYXv5xd8b3AYynsxzHZ3cny86FDrjV6lzobE67A8B5y7MLWwd8wPDLZ8CtNuy/9t3l4qx7Moe17xzr1tndSanz+5VxBnCJcH9Swu8DbdIaQz8At/PyE8BF5tndEdBEi7M7wLVthqv5td26LLF+AVy17Q7st+Ul4FfrHOgbOOeOAR8hrLB3l/ezTut0AWdA5+7EPgMccmwv4aw+B5zavYzEVcAn4Glxvna6v97hOmBfT+3zC13n+9Tg66oznArnz+myA7gV+3+54HMaIc7u3sfsfdivVzHDG1Cv/YRrx/cgw8tsj+J9F2k5s9P9zOaJ0WsMnyMcHM053mF4qvXbXfsCxncp5hdg56kDuG5h2507PCuWZ3djG+hFwBlwlrXv2kD7sjVtDQBf2uet4XtGN8Ie/ozflGDf6lw8mQDHV4hjL+HcKLXveJ8BDltXJh+fcXY3sNoX9uK8dQ8wxnAzjgthcNHutmHtTMTVx7Z+heNt45nLrsl5YLfvED5Lbf8K8TicdQijMHd4BrfjFC+f4vzC+fNqOudiF51zsk3beoLOOTHgrO8s2xGeE93TxRXbD0BnzWH8QCtKtMnyvPUZzlC9ffdlDnupvRwzOFiO+V66R/x43jqNz4/teQdx2gnCV+cY1v/DV+BTOuYJnDGlsxacuSmulc45G3EwnE0LPI8kXHoFONc4u7uyz38/gf26YLgQeRID4aL166QD9MzdVSSu4htwXp13TwFmzDaeR6cL6Spw6uwcaD7ET082wl96lfDuSYmNC+jiz7POXRvoNP3s7mrZOU7qmWd34zn067x7Mh9cwH3bZvvhFOAa8MSi4wHcfZ53zBOY+1IH9ngX+LdLg7XzhG0APsa5Xf76vg10cvcKaNOns7sTM/km0IV3DL+ftU6tAYyjO8BzpQ30Lda/XIj7KyxrpzRKC9fMYHv+t3a7C/vpM9wv219PFucIC6cRfgPWPjm/AAaOFm3E6W2bfQf4P6RTzU4CT0cxrkvriuHX7hXgnRLAl7iKerD258fIj+htxEcDvl4Dab2eZudI84r1OgWeS1xFHYA1g+Hrgd3xnpZAS5+12uYZ40sEDC7xbPNse9AdzNoe4keT0ez3SJ93uh9w7rGf4ipoyi7Q2adAZxiwTu27X11ZngLyEpRxHXOZCfz+UF2cdz8vTr3391e/f5mcfS1Nbr9e8n0+HuM+907HV+Z8MrDaKPMZLHSs/+ni1067OzYQXx8/WcDjnbVOAPfBmTDvWEBnXwKcAg0HZ++Cwbpun921S+2vgJ9OjfbvJ4ATF0D3IW5eIu6bn3dP4czRO91Ou90FOcIYaET8jouwfTRvf32KzxGPn8Rt4FuWJ4YL89T6jLDXXp7YDKbul5y3BPoVzgLzvNuZnLcAjk8WZ60xwPE8WTM8O+9tdl6dzBnd+NscaUnElwObna/wDpxtV/PzXz7D8yXyoK375S2eF/f6WfekhDyYCXN1tex8fTLxfJb6wWQecBbjWWAm5wc7+2BO4VzRzz8i/QD7BnCAiThqYc87dwkvF4EMpr1AGtPq/H4CvJuNuGn52WI4DOiQU1g3s/MAfWrbncsn4E9mndalyeQ4ndfCznIn7Cx2wA7Ib9j5jmt6i3BytGwvYD7HdofDx1X3BGgJoOHncOYh7yjOBjzT7+FMXOIeBz7sDuhawM9XT+cJXkLcZ7U9XDO9bbF3YZ8zuLxaDvF6H3cWwEt15u1j5CcBx5lnd1cLzl+WbhmfDPTd/Lw1+YpyGf8EZQ+C5+ks28g3nnev9N9ap0BbgOyodHY3mLfNkwXjedtR+w547LbET9zPOssjk/HeTO7E+NF7q2OeLGD8eE4B3Zee9xw3XFqMNrnC521cy1MdYT6Fa5PJEXTgd5cDpE/bJjw7x/kAPH+qD1twZlyW4GzrLNvGb3cMJm4vdDgjrE4L+VGzc9dBfNQBPM1wuy7RPov23UnUuWNnwFX3HvCVcdYSV3GuwjcHc6SbW5fAOy/P7j5EyHeDTKA7nSMdjb/v4QwCWVTE6Kg/JkCDoewiPfcAz+pQ1sa5hOulnl7Fd2GdjhjtdHcPPO/TeeskuSZ4GWRWSyav7CxfjVP1Xfuic7xrX4AcE2QWAD9ja4jy1bF1izivbaAcpvvrBM5oXGtxFecA0FYgM/Zsu4PPAcd95mfiiS7tiSXuq2M4r450wNfIb8GZstDZues9La+6sJcGQFfMOxcoX0n2H5xVSDfe/falffcZeTeQWXdgH7ROdZizs9YV0F8T7Bec3eKa9APo//uIyYEFzr9Krkk9lHFegWxk6QJubQ30Aez37gD3D8p6AQ/COdqaTJBW6Y4jcZXmp3SO+2C86CBeHejtRXIVc4P8ShvP9Cv7/BekQ43O8pS9D/Q5ni84ztl59x7oCqsNzxneWPJzzLhCWcjYOmuJq6CpQNbw2QK4b7cGgHdn593P0lXsbaQZ9XZ3wNby7oTTUafzdnpOsLMF984JnqMI0/wqrdei3RrETAaHfCDwWoBvSxLPa59328h/tNmZbDD54eVSotMAVhbnwJ/i/j8V+38hnV3sjPJwPy7YHJ9anYvkKs11m/d9sOh4QrZ4yWic7meJDj2B9TcQH7ROFoPuEaOnl59l+t88b0308wsb8BzI9o32w8kc8DriDcQz7XS8LeDNQTZxtehMHzvtu7aFcoPFk4U84d2vE+C5Oh7M05Up46328rPN8MepxekMlHPC3AF/I9F2DDYAh/wC58klh7X7WefuPmZ0N+Ky0jmbc7xKsD/vMH5z2W4N5xzHAS0/Z3ML4xybHQtldigLBPnk+ccx3C/aJvD0p0+Ml8CrwJWwrnPEhQAvMN5WO7kmtPAFGw/AS6fbcW+Bp2idAB0BdIndeYAx3dud4ydxlWjfI5BFAI8BuMcGnqO9SK6inoHya5QRjwEXWen5PWBnOuyTJcIpnNFWe9nGK5O3tJdXcL0DvYa4ijMVaAKQq6JOajFA+u3e6iAtAOvF77GPY+O1OrNO63KHPuSzsUNntjjv3vNzt23gfFlXS+TJzKcY+YAuk5szvvjE5HzxvHOXwWMLLpeX8bwh8Hzn4gfw/MWr8TziLQ7LFsrCFvaS02B6u/sbx/PtKLlm8Px4A8/fJ9dX4Hn9fwzPo4z7HuRYcxzfcsDbgzN4sEQ+oXvyxGhz2Iu/QT+eAB+KazJ3qH8bA6w+gQ4CdXWtQXJN+8v58AXiP8DTHN8fZem8uzbKjRjO/wx9EVcxpgWX57C9tED8LvC8neJnkIXeL7h8gMn+um2B71M+qIv0hy3hd+ifIZ0rDD/dnQKcWO27NtCSC+BlxVWW5YDsqg379+5Kwu/Q9r0Ms3PUJV9wPg/p60su50C5zxx1GXdje4i6rDHrZwv3jMXhfd4RcM77yfAXnLun5td7HfoGtOfiDM7fuyOQBQOON5hO8co4z+Cxts7KTxcdgeNhDnD+rkoZOdGyzXBq6wvQ/gbvA+MDGf4xOg+w3+65bg6v4ltL3L8ID+2njgVjBxp5zPVUpwsuN7SGSBN+tlmbRzbji0+XSHMgHySuEi7snnB8P33P8PxpJK7JHrwbzFEW6SVn4L+O8y+S606cz/RKP4TzSxznl76L8y/+VZy/y5bj805bjjbidNDZAC91v0BcswBZ1JjRjJ79BLQZk+9+0VGOwMrtzt0EznyQmwAMP4EeEunP7nh+1UW9C6M7UD/SlmRCsL8GOtM/jPXPXSYLwfME6LulhC+Xp0hDgo4Z5BIgP+ksrxaMvvmMMi52D2fSryaj/4an7SXYdAyMjgV8CPCcgLM/61xOj7rmdnegD1tj/lzI/k5hvYHOWuA8SPQS6/cll1ucWMM7JuNk71wCfM4kXGEgLcz0y6BzWCDeAd0V6EqRvhH0I/BUIDu5nLeNxxj3U+uodIa699N55yvQSZ9tJsv7nOJK3FeXJSaPuQIdGMyfzviv01kHZAQJ7J3OmP7is9F+GM8Rz//C5APnKPMHHv2e8bsg+wG4hnOcXyU5Jdg9xCgr6H55Ld9p7eQ7d8ljlqDrvEe+s728gvV5YrJUcU3pZdSdLH+LzoBOuOM2AaCnw30JZwns5cE8qyscMD19a4h6ccA34pqs+/LzEnlI1FsOJ+2u0P2fSDpnwePfG3ydFu3plZALGmd3v34VvLOLsDsFmRiTr3b/CLhMCe1MYB2ZrcCpfEagPr/dvTLZuQQ89xPj6SQ+G3XlXaTd7Xb3j9euj71rfc53ygUuGa5aPJVQhr2cML0u6nHaJUbLjA20U+h+uGP6CLSXiVG/z+RSTLeNZwDA4Zit6d0l2ErZXN9UQn3hAuQ8SK9ZQN+hXv0OeFO047EZbj1h/BOzwbFSm4AB04fg2XZqdhawLldzZq9yZV2BncPdlQ26beDfzlM6CHnd89YAbDTM9sMT6CuZPPgX2LftJ+BvOmz+n8D+pNP6PL8CfU2L6wMW9lN7OYi5zHeJZxHqPAaIZxFeLkD+dYl6wvPuCaNhl0jnGu07tIsA3Q22wWDhnun9Wm3pHvbzZzG/y3NOk7dBhogyI9QtGOetS37/x6S9RLn+EuwrZJoO5NZnd5d2x3jtObTLFutzaRfv0blDesU6a93rQGdctGB9bOsW8TvKiYD+eOrcPy3Q3sZ7KoG+uPNxDGtstH3UlVrnJ+NFB8YzvQJ41NsPqG/UO1+fYqZfhvMH6JQT02W6Kr0Nbd6B/BToo3ugXRYu6h0/G8M7oL1+Cxitc1J6pR7S2Glv1T3aoYcE/R3onAfROchuu19O28u2fnbXMRG3Y1/AnuzU/g3k/CB3fEB8bHVana/ncJ53rwz3WGew/9q1657sWLvL+U5bS2Zvlazd1RJlUAhLKAtHnvYUZLAgW2dn95LbtwB9sQRccbXsLJJ9Mee025LTe3OknS8SHovZn3XvFyinxXr3nEb9zPfEGHEA2nmhrVS7JO4ZPXppdqZXDIY+wJmLNjaMf+v+agIuAdvVzgOcwWDbymXCaKvR5t8F+Plscb0u2ChYzHYT5VRLjiMYzlmeWl8fdKQh22gTem+1zfHy7G4IOn/zvHUvdP6gm0rtJ1BXczrriD2N+B3lKHr7DnGD3r675Ov/md//9kq8f7lLx2bs1LEtL23kC4/5PjwB+9apifO3HAZAR5y3rnSkkZaXc+SZl/f6b3cnT4DH2Bq0S51fcC4W0vs62gkj3/5a+uJyuXMcO+mLgQ28d2fBx/FxzPjdj0861yEzvPJR7LEv7fYScE5bR3qr9UfA7OwGFtMVnS4ZT3u/aJvsnXZ36t4yfSDaC8B5D7i33ZpEXC/D5K3dge2yNhavpv27n3ftW33Xvj2HbwLfDHqK7mekZ8+7gyXDi4P5+W9XYk5QT3XFbGueGL8/XrKzGuWC5nkLeXSby1ZQPwg2KudIW4M90EmJ4dDPQuexuEUdC/CowP+NjYvW5yeUAZlPHI6ZXWNnCbI93J9z1EW1Lo2LVhvsi+fDu8Gs/ZXvI4432ffHOuM/x2gHet76zO3hxiWxj9CWkdlzGe0ulyd1xf2H18KesQv2Ohe7aKf7J5QjbMBe23wJ9jptaJPLIP9F2Bv+G2BvF995uZvvBL6L8W1PQLN2WkOTr3Vy1v52x84ThjuuSn8R/owN+FtuwJ8t4I/B/b19/vUpwasb8BezOYQzGO5/AxpljueE2Ra20E8pvJ2ADB9tJ7hu0jhvTVvtJdAU99E5yEPAhoTZoyw47YE2jchjtE7Ssulr12SXz8TlLp+JOdoj3x0hf8Hs8zsTpFvRxvJoIes72kxmaoAMkum728lVkjEumI0I2t6gDFKScTL7U6B5u1f60NPRLpDpBAYlJosfyPInOIcN/GYL9XAm8EfiKslzLViTc9DHg9zh7jK9pjZP804XaPFLlMeBzqK9SK7ie0BjGbhHUA55gjgyvXK+Gnl61F09cZtA6LO4pvZTLaDbB0aHyTjmKH9Ennswl+yFgLYAHb7FbX9slIPxqyT3Bh0k0gAoW14wG7zkmtiTD5bcvoXxS8ur9Jr4NgCfiW0uOswGE2WT4irJrW3gRRCv4tq09fZFcpV0a20bZaRfn2Kk2RaM9uuYKEdbchvKpSTDQHtX9H8BXS3unYGdXtO56SwHJS7/AFjgdOWVzM9DGxHKAboDLtM/Sa+JDfPpkulZjnRm/3S6bKfX1IYK+COU0Y+5jSheJXgcoE5C6DtAxnR+LNvICDniZ5vBxD23j8WrqAdwtuQ2stZr+YvznfzFeJed45KtLcrx58jv+ldM1vsV5Bmf56zvn+fyXHRANoHy2bHJ+sp14xeZ/fl0jnb2V8x/4u4+vUp7D2yfO0ug3QHOpG9wevm8NTSZbPBSd+Gbv1wx+9z78bwNNsEPAFunaJ/VXk65rT3qeKzzbjtKrmK/oJ9Dm8F66xK+qWd0t8vP/Cxqc52xuEp2ht1T8H3gtu1HpfSa6qfbXLfeufvVZLrgz4tXr+cuu9Xu/a711M9RL4M2F3PhF3AOsoCWuEpjBZkp6GuXiC+YnXJGv3FqtBEXHyENgTYSgKfurgwm7zo1OxLsMpu0y2UbdYUT9P3rfLiatzmswPoBb3iLvDfuzUXn7ksAeg2we4XvMb4Pr5Kd26nRwX6gHgdwT3pNcZfJ5Uycd/w8b6dXaR+O+X6HNkEvcAX7UVzF2NGHD/kz9GGC9UUfFD2rwxrPOU4zkW+8sBdoP78cG1cocx0z3rAl24HCfvlso24cZVJouymuMu5iPF/rXh8C/9hlOv723WUkrikOu9IT2022X83OIrnKOg+b4e57du7dfU6v6Zlpd5aXIFcyGf14hd+T7LZN8CtA3xbma6Vzvzm9LdmwAt7ndmtGgk+95CrGCbw92Hej3fwr94e5U55yd7TTrvscfKKW9/97f/zv/fH/N/uj/aP7Y9f5cbfT78FCuTLY9XSPzLO7Dtj7PJ0zev5p8xxtLwdC5g7je2q3LpOrRPfPkQ9DWMZ5mAONLq6y3dcZyqIZ7DI53jh7NrfGS7zeMTkBs30fSzTu5YLZcl3qTG96BPJ7cZX2y8niHHmOyznzV70vtS+Sq2wbtuS+JybDD0dWeuX9Qh3iGMfHZJpHVju9yt/U29wP7RxljLB3wL7m/UT4j4hrCrcD4O2ZXgDgsnWfXlPbz3mH2eiDHAr9a/lV4l1O0IaC2VmcltrpNd1Pog7oRWBfMd8n2ddgjjKSLvKwOsgQkmtaB/RUM7Yv2svOcXJNv7Ngcs1Xw7K3C5ZPd8GyzWxALjltewn+I6DDED5fT52MLf3JrI18CvitwH0H1sUC3lFcJVuzJ5ARoW8KxyMMF2XqlEDXyPRPws5fXBN+0WZ+yVdc3nZpMLnLFxNkW+27Nj8TLnU4ExheHkDcgafO3QT694T8Br9KuH7RBhwEejaB9xbJVRoz8KGfX78Wi11r0d65Fp0FyJ3asBbgK7LsoB0LroGeWQsGrybS8d1LvicGyTXdG5c68wc70YewdqCL9WwBu8k51QGZINjeAG6SykH+0Fn+yuh5xCGIi1GfD7bzQ2brVOp8ZPPYvut8bXfB3/VDxO7hLL20kUfhthvth/HsvHVUGt4BL/957kJf7iYm2jm2hkyWdTdB/7428ChwltzdR8k1GRs7+89bH94zPfYgSq6J/cHYgn2ONhVgr95tm69dx85O+unzrnUE2aSBNhkJ/QT6a6ZPYdfUdhh9EtHP8bPFbFhP9fSakR/pwq+Xy+OM9JrY83IfWVj3pyWT/yRXiX65N5g/T3su/H47x8lVqjfg8ogTjEGBdnLHyVXaI0dzrqOdt60rsFExuL4CbLGWzN4W5H9X0vqNF+ws/2yy/YdyEnGV6A2UDS7RNgj1jeKa2u+CrpTF+vhi4hnaGk5wLy0HkbhKZzL4oRhMrjIG2BBX2WYNaEzwRY65baB9dvcFbSLPWcyDxQaus4AnRvkX4q+2lV4TPGZ1GM30BHJoZmsxQXkhnvEL2X7hCH2uGJ0HdhOn3LZcXCUZD7MVsNqt19ouXO2Sv5u75e9A79wbTAcCNs/jJep9WwPzitkXsnHfyfbxeNbN0Y+Y0dIL6Zqhg88vEpk84BWj7SVXaW0w9suS0TF4RunSdQMm5b2AtuW6ZI8POm2d7b0rbo94NU+vcr3xkvtsGy745LWm0Vni5wzxOmAO2jr6LremX89Rl3dU4rKYObPb6LA4P902xBdA3QmMtfPAfCW5H7XRvsjQ2hbz8RmUGE312UqvEi3N9JhGu4sxhCCOyKyDtj7imsCg2WE+ATbnKebpVeYtxjymDqfxsS8nelbuCjqke4PRSwOms1peybS7wfYznksGP4ON9AyWeY4ri40f6QpxleRk92AvUvoBGDd3wri305/tids8MZ6zC/ZqaJ9jnLXEVYYNtGsC/L1Em9G7S+mayLqegB5F22Emd2Z+IOIq2WejngBwGODb1mcZb6H9MdCKjCc9KnWOk2uW377I8NsAq6DLYDGYWsBTf8jCKPLXH+Zsro/Mc4TnK24bdJrQUmhfc3caJVdJ1o16QIytMgT/shn30bO4jFRncPY5Swff4Tm3OEN9VdtOrwlsP8H8oB0Dk1PqnN/UM/wmk1eDLoDFTsAYGeKa+omdd0+Bfyoxmnu8OE+vkky1LfEBV3rnIrlKfDWvg/42QJt+XqRXaY+A7x3GFnitfcGVtRNuv+uHOTCYnjOVlXS+Ih5ZJDZc4LcIshPgx+7A12BsS7FlbLTn9sDeANZnCGv4BPCAZy6em2h7ZbV//8xiROA5/yVoX9hGt3W6bKMfAPDGsO6/Tljcp3YkrhI8LzvLMbO16jJ/rPZSuqb1QOfM9xPoMS9L6VWijwCHox0i0KBAcyDtjzRXVuYO9jI2t5trm+lV8gcCn0tmn2LfAi3HYXvTvuLTcc0XMezOzAGUV8+sjvkH6B3HjqOsSckoW7uiM/58eHh47c/CaX1PmcTxY1R/8+aRxlEchLQYPbnjMQ2LXvDmm/lG3N1Fga9c+8Pg4cYb1veU/8UfHM485dr/5k69oRsH4WWmzaQ406goVK79n9eYPr+uQnjwNbHLRln8LhlGfqLNUK2Ua1VtTaqWXnuhhmWVLW1NDKMq1wjVqlEra2tS003zhTerermkrYltVcuZNyu2Dd80zdJLvarZJXjTtMwXa1Rs6JVtG9VM20aNv1mxXnizrJdtGE+58tKIjVKlAnNSfqkFs1KD54ZRfaGGVanACI1q9aW5qVkV6H+1YrxUw6oa8JVKWX+pH0YF1842M1+BSPBmBcsrL/XP1PHrpm69NAclswqra5Yq2fk1qyUor+i2lSkvGWzEZb2SKS9bZUNbk1LZrLzwpWrNwpmway/NRKlahrbLtRdXxLKq2Ib5IixaegnXzDReasM2TBNGViq91IZZxgWx7dJLHylXS7hVzFJOqH54UWvkx5CWg/5japMmK37+5k5ntB4S6s8eaAhxeuv7OsQ5H3njWXIvEsTW9/W1Voew9E6YSbdbvLmhUTsYzlic37Sch1tPI/yuiWXoRl73SyWTZRqASdQamTSzLNFkknRBDDFw/KanUsfXWCRgqtXzc84bDfo2CRMs4qTTQkEEnk6e9Wg/yWzpw0yFGo9Kn4YenrjR+ZP/KQweaRgv2Jch3zLkJ+r5fSeEhENJhoi/NEskeCGxhDT2vzT7awiRLKUFDVU/CRff8/sbEeL5GDzxOsZADrCm8+wN6z6ZBu6QDiFQPq9Sf06jD8NssOkJ0oGR9HeokaDIWoB+Jw/WYdF30twZGJk6HWITTp1YjA3PoLiRJJgdqpQ8uxATnVAIlj10MEA4JECRl5VqYTHAeNUQsL4I6X/gJ1/rIR15PhUrDM/Ic3aHjGlch2lYa/CRsZPNRLKV5GA8DW7daRfSSPOepiUNyMUiJe9i4dE/iAYV6ZGiqVqamCXvQ0+ePwyexEfY3XqtYjJdMROvAmioCul+HUab+GxKhmkM+ovFw20wPThg1yTjU9cdvzyN23UFBlLY2iprjbz0spKCgCJe29fZAvgPQ+ioGhcf3XgSQYqOuDiYeNNhSP3VSk1vnB7k0xPJFp4FrKrqVrZggCWfPMtw9s+Qh9qP8c0iZNoBGFNj8tw9//jx7OTm+Lzz4fTjBVafh+TyU+uomy29C8WugbtWSOJgPJ5SvLsIyexx6MbsriM+R8XnKPscJc/D4MmHZo4RVWP10xDAkhW8XwB5B6UnohFPNOKxRjzyDFAMdY5EHcjvUjFrttYYTN0o2ov26Dym/jDaC4rHwcNj4FM/fg6pP6Shqj1Dapz4GT/Kn9XjNYuN/hgGjxGhTqwqx4Efu54P2YogcrqqfAmeFI347NlUAawfq0o3eLx1QwVyOZAICt67ET1zF8EsZoUzKAx8yH/+myBM37vDMcXHAgUEPEkWjyQPc4Vj6WD6B4kUXhOvuVnZI5AvQWNZEzYfRuzhVnlIcqv7+cUz1grkKMGMSjOgtAzI+O46YdFXZxCiHzK+a2SEBQONTJGS1ssamWDRVCOPUMfSaxoZYtGjRhaOGqpADWskVIFyhSvQLJpGxlhpoZFvUAmIbaxkQpL6UAXSR9PIE1b6ppE2VALajFWqVDWNnOHDtkZugLzUTVMjD1h0o5FbpPmsmkbusOhWI5DyHihYjXSwaK6RC6C1ymZFIy0sutDIKQy+Cp04waJTjRxBUblS08gxFh1ppAvdAZIQugMkIFwtq2ZpGjnHSl2NLLFSrYwPjZKt47Wi47Vq6TDAe6y81MglVLYsw4CHtYpuw7VSNfALVqmML0FKJE0jX/ClS41cOTitNY28x6IrjXzCzsIqfMaiTxr5AHNhlnSNfMSiDxr5CgM3IIP/P7Hoq0Z+wWx0hqmRX7HoF438BC8C2U7+wKKfNPIb1LJrpkZ+x6LftAZuub1/ALajzruhqqmQmByuVEuzQ2JmD5Gd4SNWEmhdxRRQzrsY6vTCPqR+yORbEOdk7GxSTIQ6aR4nwHyQ7/Jt3AgLBZYqLyWjwn6agoDjEhoLZKL+DrnTh3RKMXklQyN71Pmnqqm/QmfhnOT9hZ/wpWMcISuk5B+Q5Ed8IZo90rDImwu19ZjG/7ZWoa1QW0/c6FVN8pcPjX3H+WO77fWatRBmEzROYWq2ZhsSRSe0WDqzRr8p39TjmMHE87E7mNB6uHbuVa1xr2pFLHAof77nwwM818UQ00ohJMfzY+eZZdUDgQQepTEN/aYKJIBEpPj0SX2vaghUY+rLJAmrIXLzrdeamjSj1UUxYdcb+uB67IyCczf8Dzp3Hx6nkLXoQSG88g0choex90AVrKkCALbcGDPKnV6c8wRzGpGq76xYjGa3rK6qE9iS/MXZzGMHsmKN3GppVLYPSxWjcmiXyubhrTUaHJqDWtkalcvuyC0nY5gEUYyJhfDVzAh4De/xm82eGrVqsWQUDV0v2qb8nAlSFFPXjbo+vK3WS7e1cl3XdZ39sc3yqF6lRqVetk1XISz3H76k85ub0TRwY14ECZLGSYXbIJhS14c1VfhvKeGgoKNXq+Tnmngxkn2x8wgUTmNK42eoX6dkFIQPbgxQFhPf8ePef/70TNc3Pz2H6//sr1Y+8IYCvMbAXACjqdWTHPeQ1BDz2xehwTUJ8EtPIdB3P/0U0pFCANy2sjvGBwcnGTSm/C9Fe3doaLCATk95cOecVPRopBDlwfOl+z6ZYSXPP43pAz535+xnn7j8kfcwe2BP+C86H0xnkfeNtj1/q4jX6pMBf/0MNy1rgP/uk1Full2Y0D1/e8ObuRvelDe82a8/r/mG9pwQeCSRwBgwsNRAjLdqksJUWzMKr6GOVE0NnZ6AVoUofOFhgD6OE7IGEQXmXiHFYjGK4e8M/7r4dxD3NcH5woKxrGsk/XoxpP+ceSEdssMpvdeAT07qUale+ptPyGqlpmVIuEPXA6lx3ocA+8Ant4PDo5t1QhJrq5X0DZ5BZ61pGsur/5gADKTkSe+wG+mt87zGLbEX4vaQX0PRATzymOgAexRB668UIniahokse15/tcJLccizU9Lh1iNIrXTuTxerlV/0/MF0NqRfeNFWXcwUvVH5qyjD2vIYe15f27h3oBmyv2NhDw7w3IN9Gm2vUUQ8DbO8ifLm5lp4Wl1ebq+fZtaKix7sVnwff61W4hcsBxG/R7HKq4oi4msoCZj+G4/c5zUJ/+ruZVVIzu63ct+3+gcH8l0jPjgYA9jFwa8XKHJy2G9VY1DpOUkr4WoVyxihyHf8ZjHf/hytBM6+d3CAQpfAp+ej5IfoqE6itIrrL85HyY+kCqbL9g4O1GC1ijRBOeGOCZq8vZ7er/P3ejpLHj4CbgpFD/txcf4wPTgI2UXFWwfvNLI9JGk8oSjTPEhgh5nZvZEaZvZ3nN3fcd7+9qG34cv7m0vOZq/e377Y3z7bmH7O/pYfpfub5u5vua60v2n+/o439vfGvePj/g439neYu79nTri5v2dsf6f7vhlv7+94c39zgtgF2QSQGfOHaX1AkNiYEr6O9UeS9rS+IO5w6ME+dqfpEV8fE9zu9W9rJ16tntfkeWPG6k9kc1rq7bVDGwNnsFqxz+/dEJYE9JY8hnTkzetzAvfRozug9Yu1MyAt6CkkXD04UG+d29UKcsa5Y6ilkBtHnTfnBaXOckLekgtNe3Z78yYcqH5UVwrzOvup9J2LNbTR6t304Wjjm+/Uoc67uwznoNIfENlpGiCIfdgxi9VqvFqdqlGsNadOkiz2G5TNWJkLnJxSP1XdWGuqU0fkoyaMPEvutboH9CEQB6sV1BOUvKjH7zUxjCMYBjuPiU88EpCowbJDOyJVrRo6cCAn5EvYZD/rYVEQZ5q0q5PX/OxrvnjNl19TqXOWmUSdiPej7PuReD9K30dUtNldL/ueJ97zioKszO1ukH0tEK8F6WvsNAJmmudzZUj0bdLGLNvGTLQxS9toCFxE2V6jvbBQ+JtoSjpJ6Zp0Aa8tGI47J0tH54t276As/eCADz0uZqjqzJGx8WT5brMMRCwgUkXMs1pJdIMQTusNdgjrInlpw28y0jTept2AvqMFJ5mDVi/uN/U6ZAGGd7ztdzyZJhSDl1qQ4BD2YC4ognggTPtAnXcp4dqL+5qmQR/WqdAg3iRhD+mafEk3A6+3r/Kk3QLTbr2nrVb7nZcmg2prcgWNwszyYWxMvzSarSf79ypskf0vmHh24/Hh8vBS1d7puAfOHb+ZUE0hg1Pvr1JNrAo0Gx8cdIEjAeCAH3i0ix+IvMVNmpBXkBCxA6cSPgd0pDWTnz1dfINQ1ho/P4jPbgWZw9cK6qpuL/kUoPDVKuyn9BNt0npy4zf99CZuxnUvxm5o2jrThLPZ4BopEChdrfYNx3HGqxW+6eBpxw6ccM2JjsCZxmKCxCISSjziMwl91LhSQ1i+ZaFAYCoCrYlnyAOS3fAzIbgDrQ73DCMEmrauI4vBdiU0gyvwyrNFGXrRIPQePB8NQlDYmCk6OHhdS9m3gF9+fMTDY6vFIn/02paFCAFbwp/bTXJKYgHqANjqTN6JmAgV4/k9AAIKiR/ebjGibggir/zqmLf9uQVrTFne8TWDAiyaMsARq7osFNZr4vHdBfvDc4I4ZZHDZpgC3mPzsZ4Q7JhcGtWA/JxOxCXewUEqQnGmHOX+50/P3vo/4QviYSIH8larnBf2PFk5+evFeaf46IYRVb0tmd+et8YM3EgfAFx6WlPQF+IbGuEl+Ak8GlhNNohNmU+qf254QC4K4SnsviKn9hhOCSGtcsogrFZI0ZEw3ZShtCMHeBXbbYI9kM+MKTAhlMTAOqZqJedIDTQyKD6FkKx32GSUW0A+q5rqaqtVutN4tmUXMiG3nIC01hlNrTP97nAzIOm9zgLBIxTpb0AdtM/+JhzEwcH+E3J90rOEYzg42G8DD5R5ilyX+JWi4abbSwrZbNK+4/Vov36uUuIh4CdztmtqSEtQJPDUYVWbXr2XVCFeP2/qxNzEMDfd181Nl4CSRO32YhxeLHNdq5VUnJ0v6cHGZJ2rMacSDw7cg4PcAd6rIrP+XgvZYTiMx5rflGpnWZm6cuQv4onnj/cGrr93S/cmNASdeL1VzNY0nOc1WRYKCXc7zrDYY414DgdjIXAQ3Q0lvhqX8OBAYmGQfxZPtLSnnoafSjQgKYkoC1qzJGL2yfLtRlFz4/5wWbcSyI8doxG/dWgjBiMdb6RuzqafamOe1w3aU7ITpBTivuMVk4GRdChUE7g49x2GjxPgRADcxFrfeFeYGBDP5ffkE+vWN8RD3xgeSojM91kS/r2gMN9DPQ2x1bcUW33bwlbQNuDKb0xeomkhIq5PjihggP5JILBRrH5jghQJAjQtgRfWFophNMY6fGiwJj84opw1+eE7TeJk4qyo+/5q5R8cJDgyWbEpvCqDYuj0Nsv6YspD50gNNSI3xJFtSHZjFK0ermFFPnIik8knkUzUPjrDUNz09H4j6XUs1tIbqR8dLwbF5tZ5+lFIDBFmQfLP2VmKIo9NTcHBAS0UyEeHCkExEtnSa+HGa+zhwUF4eAhqY22dd0B8hET7Emt2xvW7WbZMlKofkQX+yJfxI9GJ9FzTiLyF85oSpZoYO+dPPwr+VKrT0D4WnI89Wij8TTzuIz2gjLwpYpWEpPD5YnKM/1HG+B/7sIgf12QSO4mFYC8mLeTl8RJq/UYYq2ry+FloN6cxK0NjENyKWW1kMo9AyX9RNWbcM5i6oQs4AKy6WCr/unIdK2ttrZFJrJHMx9JvgOZpEgt5x2NGg84thKbBgLX8vCYTL4qDcAE/g0fqC8tpluif33zwplLSfw0M6WAGU9urF6y8YiTSYoeVJuaLdC8Y9RRoVCHK+2lwqxDlQxA+tNzYVfoa0hbsFTRcpH3eAJzhKXUH4wumtEjDMAhBXSI+ula1BpiXlGta41zVihc0LgYjlSuNFKYoVIjicY1bqmPK07AlRQ85ujZZN5eq2SSVG1cxy3o9Imv7Zr73zxkVd1zH9TCbxt7jlJ6PFBiJyW1hanrFlKxPh7FE4zJsHqPdziJ2nr2oE+B5XR/GxIsYLZIxQeUguGmmJwlZ1iQO0KIi5z1malH0ImFy0Yzr2KFmr1/vxf01CekjdeVPJkpG4juKgsDAzDRoIyw4huYXnIQD9tfEizp07MbeN/oHDYO8LqAI4eCggxix2Dn5eNQ9/e3k5rTz4bRz2r1yHMd4E68JM+3I7wgIAAGUqca7Q3xHDRxOut3TRaRSTRNmueFbn3U17nlO0Av7fYf2vESrHK8l89VxLH3IURTio7zEjQJ/tVLUGdc6sxJNSdooPrjhfVNlV04FqWHB+dnz95SfC1J54Wdl72eNhAVHUZWCeGPq+bRgaCDpTcoGwXT24GOpppB9enDAH0S+9/hIY/YF5dq/9pVC9pFG/IKypxRCre6vk8F944M7ga0nWZkQ+Iudc5Sro/bZyXxAH+ENhT1ho3VidgffcSj/TaPIHVNnHDPD4n1DI6L1x3gW0ovYHdx3Q3dAmy+UsxexNUR94WwQB6FWx5IIqjloeoGva6xktVKU9TeJSBdLzyzjVPap5KlG5LryZ5xvcfaZsCR1cgA3maWCAhYHYswxV4Q/xc63OIWktsDsPrDizBZSUUgEf2ZO240nxdE0CELVe2Nqh0ayhQ7puxnKm/3DWUENHGWvWCzuKQKaNRIe+lgjdPzC7FCNWA2pAtjc1INCXIym3oCqcNAUQ/o4hcl+cx2/GRPl//1//b8pWiEij0FU9w9pIeAvr1NgOePAwvu1iIsMNajKnkLooTAM0woMe91sGibA9txYFsqEi6iLu52NRjTUJDzYoCk1gdrY5M6p1FISKpEw0CI7XZkOF386Rm492FzRezoKQoqVpXvHevmNo1FMw/QFvHVMLTkPASO+uQ6b1/7qOlxd62/GxHN6ep8EYC4cObCojl+kczpQkwE3tIDRmSH2ea4RL3NfCHt6P1nKuPgYRMhRvHV4hYOD6K1+cKBGjidEwqbW2CozmHRvRlwyAJAbMZB78Hw1ZthGHhYRAKAlO0AVAEWmjrQWh8lkF0YFi03GzDEas7fOxkTvq9Hh7K2uNWaFguY67TiZBOL1osNZnwTskg7yUPV6Uf+QPdbIVCMDZwPw+Me1wlms8pEczgqG3G8y0grK3mpPKbjFKA4LCuDHAfZ0qxvYCbkL+NHCK776/W9utHOoEHnqCi58Uysof0LV7BRyyNtXo8LsnZOsTf5MFthMFl6YyYKYyVcN6hVTKTDVQEIq/k9viKJoa/IAxkv3nj8U9JBClJBGwfQb0HAJ5gUyzo9i1x8AtUSUx5AOvYEbU6z+GNKI+rH8u4PqUEHzXcQLJEIjuB5NPTdCm6xb+Hg0cKdg9a1E9J8z6g+okkqE+7gn7nJRFUWeOUtCFEdBeOIOJqqavMCqH4LY/SFmO/IcCBEtnoTBE5gy7j3F6s/CPC3AcxRIADz4vWjvgaKgCUlwXKq9YATPY3gOp+8eHkQ/gx0ROxZZG5E4c2N3LI5imGiH4oWhVnFe44Q7VPxarSQugGuM9PXGoetIJ+NqlXf+8TfSlXOodCP3IFlPh6a/s13kC+vQ9HduBVh5h2bv5YoySDg0cytXQ2B0KLuC3oRwAiOFoMyZn3pJpCfUPhKuMojEeSASas9gH5fzJNaeaY/vrVjrO+Faw2Wma5VmuiIOSoSz2xTOklV/Cd7gGUKTqMihLnqkA2/k0eHeKAjzwS0lXeYZIriXUso9+sKo2GyFCcWdNyvE155DhF7HieEKMkOEYLiHH1DA1glK8BejhHCSQuAoY/yVUimdeJNAY3wDMJYdmbBjxTlL3OsTHCNIePYENAejvbtYC4VJXyLl2mSftNAJYdcM3FhUSxTUG3WL3sPj1Bt4IDjeekbn/Fl2WZWLwYQ+uLzze0L7uRdNgtl0CPJcFw1gyV4Pr3t9sheEe+5ehO/JSEZ93hMdqO/1isVin+zReaZgb60p4O8p6uHc02R46QA0kvYYCdF0DqSRrGk+rKBkb3OqN8edgOvUi2LAkAmoRnsqG6Lnj6eUDZtxw9regDkGRXvunh/4h9KzosJtMsHF6R8Ia+KkQOGUKN7oRxfk5XueaJC9gd1A3d6EJpOadFRMfnHvVDxiWBik8MFoL5oNJnwgXrTnBzE4BjwGYUyHSScR9F/uCj5+bS/arLI7jlAHEPjTBQAOVKTDPTdKoKCosG32/1urBrYQzLUuy0vImzvRD/kJjDoMVYrb1arX1wSQUo34CZzyinSeUzGEioPg4dGb0qFYTWceqz5RRMuKXOdknqlD5zl1YHht93FLvAe8ovPMIAykeoJ8gd+cfoGfI3c6vXUH91gMS1sX7/T66Tu9fvJOr5++0+vLsg5cVw5sTZWj3h5DxX2B+ggvL4pGxAOtHoq6PUTnfSdMKomSeA1Ud+yABcWWu3T8FrQxjqElT0AxJqDPT1Y1XKvby5Az6xrxGSd+ETudmLRidC66i1Uldsf1hfswLQbhmJi6btajOFTIM/S+nlCMCfWTJ7US538zrivgh0JOdzZP/5k2n9Chr/0ALJNGTnZ94MF9TD4gqNtXt/+8hvaPWPsXsfqcHAS9VkxOY3IS97n/5vGuTkCTW7PISc76BvIQVkWaID+5H2qc0Aysm0DwgAbxvxSovVrZ7B4sEqZTXqZ05N+XZ2f4W1vnTUBm/GuSEKMvTZGDEtSE2qw/D1w/8L2BO91uU/kvZU2mwRMNB25Ec55jn9dk9vj4YhXs/poM3Ac6fakKa4U+PMaLnMfKek1kqreuJF1S+Cp2d60iuPD81VU0XljFZNXicEbFSnXl318uT/C3tlqVROWRO42SGh8yN0dnFyc7VjkVjH/3g7thQOmxk2fvPXNs6sM7WyYBgkHmMlQtAzA5ACGRqE3WwzofbC5wZKpjx+t8BnIBJVudtc5mbzdkJAfBuUxE29W3GCDhrVMqpXT28oUqFRT+3e+CL8+P/0XwIiHxExAjnqOTwNk30ATCT6uCausQqDmVOjEYgx8cKAW4pyBNdOJeoeD1NaLosKSMjfcKgG78FCdBG7fwPH2BGYh4hULDe+s3vEIBTLeUG/lD2Jai47cODhQDf6QdC5x9XWi5goMD9i5FZeac9+V1n9hXYebRhHMwccPjYEiPYtUDB6gQ1mK1Kpfegio4fOtU9NWqVhF3hm5qr+pQ8GMdAsjZ6Mt3P7NmbTmZOUJF8Hc+tnzlx/bV/WC1Ep94CV9wyEK3Q6MhzPHChNVWbhSNMzeJnOsGZOeKppEE0EBamwE05VDh6Mx3Dg2NUAebYKJ4QwNTAQGDYWq4zOAngUsGg2HP6Isq/t/RTu7Uj1XRmKkRU2skUPT92kaZVQ9eV72qrXOeg4vrK1Eo0/C9DoMeHMR/MxxHPzjYX8TFrBJxE8Heer4b5mo343eO3lT0W3QH5V9QTa2uHG6VJSuyJsEgdqc7mgsyr1axuc0yqbkhHXgPLzSYvoLTOKFzd1d19v155ltGGQS0l3BqHLsRVbE736mS9m7zQOBfV4gsfUqmuGcS5dbzlT6fo16VKMEgVvrJIHuGDlLZgdLPDKZnlIkyoXMFKdlLdjx8oeOT+aOq/Kk2673DQr8JV/2w1oc/N/2/a2qzfn1dFHf4mJ70sSrWK2jNVVKhkPsY766vi2qz7vmj1Sn873zQVqzId/1Vx+2sOkcdTftJYcTRl9h586fUCn2DxVe7zjR0jn7VqbYlPtxX9y/jYkwjOFEFmop7caK46X8PZSV8kcOQDWDJLRxVjIOz4EkAAKCd5qFRN4hSOFRSsTVgo3eOziU9CZIiUGUEbdOmgf4LXFv/6fziNKOtr7+kxq8rRd/1WRMdt1MPGQ75ABOn0v82LKLGfzP2HX21ysMiGTSS50YNJEHUcTtQN3rywGIF/ASAXkoppzrvII6vgUUJDZc+7Bx1+MOEYpMeuh1lLWSKL00tEkLf7wUs1Iu9OO18eLkXp/5oqxd55hiv6MXhzm4c7uzHYbYjuQsnqupFXUnBP4tOyRe+rUKtmZ7ZCuh/ilQBU74dxDB5HztHsRAePyei0t5xTLoxuY/JFfDE5FPsvI/J502ElqAx6Y92qL5085OikQ8/3EZz4w4QYDfur3p719dxv6BlHtbl72VuGJZlJX/XGJrFFv6u/rFSARFqm5/JvK81Na0pkOfHXVgy9h5oFLsPj38NUwrbxM8x16FrTC2y7zgfkqLvIUvhLUiYJlwnIzQ1bqTchkqlLzBXP6l54eZHJVknmpeoCgQD4bJduofmbEx+GzoF2jP6xIer2T80iAe/rD7Zpz07pbp4PBEV/hQvu8cqdpaZgQfwhg1WBAXaK/XJDK7lPqG9Sp9R5wMHfnOcrRNLawyEHaXV0AYFR9GVxsApDBJHvV4N4jeMnDK1/66W9b9DL/V+Ya+g0p5h9FcrnVG2MFpR93CkacR1Xugrn9iBppHRwYFbjGjc9R6o6hbH/Jd2ONIImN4nasI6NJGLhzNkkhRhZc2lGF93CsRoOKY/AGfK27dcNJBIfdYa+WfsKEfvj1snHz7+cvrrP87anfNPn79cdC9/+/r71R/u7WBIR+OJd3c/ffCDx3+GUTz79jRfLHXDtOxSuVKtFd441/51qGCPf9kpd0Ea69/BGuvES9njwPlnLFnpeRhMCZlHlTpBqklETuoIsKWmvSvbjMeib/X0A37BKScswN+qjqN/d6/JhEjvOrz2nT4jR4jn+HIPSeToZAZ6N+gqmALTtx4G0KR/s5EPABZqxoTN0bt3RvnALJU0kpZUMwVmqXQQaRqJnOjt2/IqHacvxklTNxKdeWl6f7P/XtaaP/6VulFFokh+U99808QCrW6YUPfgIH1is6oAGZeeH1eZEnD2WoIofefHhVP5i6YoXKiSAFEkgIitTMBXxkpWxi84Uc97986oHpStPkluzcxtOb0rWwcgffEc1Xv7tqoV5Lg+fD2Cv1la86+2XDfZkiT19Ux9W7p7+1Zqq2z3tbrBlih52czUtjO109eIDyjj19j5jssQ+Sl+eZVwG/+xC08Esqw/VSb8mJw9PQhB4T1zktUloTNL4te+DRsUFDLYkDPr0T5K2kgCe2wYfRB2/BQzAPO1rBSHR7LwRuqvsRSlApoMNsQ1gJWCjAAPZTBRsnk9qe1I+A2tUwOWH1Oo/Pa9Zfh91zI8ul4Y/TvXgS1BIEXAixK7P7Yw0QsLA0GFc9fkt5w18UYqTKrqZcyj/cQ8WloTMLDoeWDB6OOl3//uXG+PtNf/t4yUj3Kz1ySnk4lHJSzyP767HbF7Md2pvYu3lWs/us5ii7HATd5I/Uecutsn1vpg1JKuwI9DNlPlUep8ymNePsbka9wnqXrvl5j8EZPfYxJT4GZC+l3c5VPnTe96ruuH13O9ej3X31/P9ePruX5yeD03PlzPKx8Or+dV+3peLR9ez2sfrmcfPnw4wb8f+qve9axVhZdnrfcfPvTV5j6UHLMSqKGtgP/4M1tt9ae2Ue0N8VhHqqXrmambVfxb678hAZST6951//r5et1/QyLqvAER0/5qf3+133MPl9eH/cK+9tMbj8ySZ70/03eAxfkbMDfu4aj/bK5X7Pfy+vB/Na7fXDfr/3HgXBeufyI318X9/7r++8/X6rUGb/e1v//0xksVKS6VVun7B3Py3kB+z9AZXWpYcE0rjeRKNVbHMrN1pi/WWa1eanciv2PbrFLN4FeLv2QmP0rZ1x9pQkw0cvRDzfjQrtZBE6FSxzJXIKShqJBo0sNapWDo9UMjbW2Y6QyQWXFTudaVeq3Cfv+flHpNFN8qdcMo847yshjKdH7jw42o/U2pG7rJb0bwxOY3ITwx2M3/WalbvNKeUrdYlZ+Vn+s278AbpV4TrVwr9Qpv/v/1f1HqtRL//X9X6pUyr4PQqtSrunRfg3A+6aAX8qDjt065VLJKTQ4sozB4OOZKD4h8mFdcKpm1ckGND+HNMhA/GimVLVNPyg4M3bQ0bS1s18dy5FWzVNbIt62SJyD+nuhbs1RuPAEBOKa9J9p3hlR9olrTqOvkm1wi+T5QJlXj5qKPs1hYrI68KfWZRaf4KVtpMqMlh/IfqxXlRq+B/9UNfc8fOzT9Lb85pWN3sHAo/5FaeELyEofiJS1E6yefhg5NfsqNCQwKtjqRI/Vs2wwFH8bcpievJrf3Eb1Ef4aEzsZCYbPt6KJzPpV/X8RuGMsFp8zZQRdTGkZx1739/7L27ttt21ij+P+/p6Dw60cTFexKtuMmlGGtJE36Zb500jbJdDqOJqUpyGJDkxoQtOMR9R7nv/MM583OI5y1Ny4EJdlyOs3KsngBcd3YN+zLq+I1fLhvhjgtU21Uw88nnleH8E07dagQ5ZaCaavyWOlFc8oFOMiyvYx9W/msgM/hh2lvJe/tvmp7v2rj6RsPJf5R21dp0zHBpIcOPzrwAY2L6XL7+sq+biEhDL0bwwPBappvKZ5SXwi+BIO0eD2RBbzUPmXOhfVayCorizDEvpBpDcudWHPt/4JqgmkmRQpaSkKZPju0AZL1R91CQZKmYqHA3i5JVX4blIVwFp3E6ZmiAiiUOc+waro+/eobrZHC80ZqW8jyfB/8IoVnGwrOgHMRbPQw4+4QrwDtFOCI0n92qJ/BSDLbQF3oXkOWAl2lmRjbjIUyQpmbNDwUxXD5Iv0EMPkMApZUvDw9xNrLMDzUP1emEWcBuaONFXv39Pu7lm90uGUN3j39/p4lUDelm7gK1kB3PcPTUVYJrU8uaNNszLdKLoN5UkxzEUS4A11F1Pa70zYBbssGrFHJJaCEwi7knmqNOxdSXGdlXeW3gXYmFtOgqmez7LM13S7AdLttf4+y2vQ0u6unOvhcEFUiLYvpzq6i/2/GpyItp+L9z69cNP42TougS92SVz14NyS5bhY85DK6smOFBB+Zb3z4u7DucXoBjS4RvTpPJbLWNe/iIVhsVqDLp1ZXOME1Oy1HGUgNT3RAtnrNTACYoFNegesUHw6Hx8Ph0E6U+LwQKUAepqbCkDQBfJykSoA+F48lCjO/tdt5MGmVkiK5as1XwXh1IbNC7xZXSQXzeSBFVeeqz2vPxe7zHXMAI8RjEM1CRtIta5oURakCVHYGRjSpRriEC1leZ1MAlrKWqbWghTXxNzGhRvO2Jg/K1l22Oq1HFUxmydFByoKtYCUFS5rzEkK3liB/lRPeG3iY+a+i9Xm0Snc9tJTNRhv28xmlWpMNWvko4/ptyzDjsuvGs7Z/6elslEL/1io7TydueQqhrZvhTRUkUnQNrYOsqLKpCGDohLINb2qIdrQuXnPOE2FbwV++UcbYVzy8NqxqSz0s40b7nbUnEHg0AcFp71R/ozHTxjSX/jSXG9NooLA8TydMGoC3z9wDZRgoCwySAa7ptbABt8aJjFdNo6+YxwLwumm8W89XjSdN096xLsUVUwvnsFpoe/PxI4LIRzw5z8bb07sIlrHletqpbsodLwkV09lfyhWNwTWcl0xH/w+k5yguWkB/6wk9w4E9g9fIykM97agoHbc3/X6MQpgYR/5DNtTR9e6rJgzbm36fxnqykgDmNcDAYLDjLVJDqgyv+nzYWQtvutUmA9mO8zthiaxLdmROG+7r5AiTPY30R6MZwPkIUXMGEV9xjN02MXjJWje8+rY32O/7TQLdCMMjkMAyOi2Xuz9Z3cyzXETDgWZ4hkf6F2+xvl4KPac4q4iTcdV3jp7BUqoOoz5CkTIbUf9xv/+Aca28/A9Sd64IQ7+aU2n5KNgBaSYKFWjHTmRXgatp1/OV6Ji+uXZcGMnjR727gFkC33l8ou3cRG8rpMr+kN7z8hCQhOzzIza4v5leLuDAxiMtLwzTP8R909JTkNLF2dAECtbPPD9X8K0V+0O/pqdiLZaE9kbD44+kSOeltEpyTye9Dp5dnbcTG/T32Bl9CayPvZ7wCpMU7d47EMd3a7Nh6JXdeMssR3bhcR/BVV0pJH8XIqgrJH5dAKHs+BFSjjDMRXRv9/pDSkcUeTPMC+chL0QVPZQV1wCUC2qU99qpcecUuAjb3JCRNxoNHbHeUGdz0tXZFad+4zurZ5ZM6XjmXk/PQDGL80/1TF4kU3+qjGOVUf0HolDylrTd7QxaGLxh4KRX49KB92PGLEBgyj30gPTOE+ygeMU6vNVzsWHVAEczlYHSoyOt5N9BhTrnAg5s0Rlzq6SbGPFBU1VC2cmA39VOB3HRcQRnPA9AcTQ+OkJCHlXwgeSk1yMP+g6KEuaTClbS5Zcgf4P7Tw410m8LnFoVzTgqNsSQLyNNlkjXhZM1RDG1kpcRIm4yNc+KIAHZ9yJR2RXMOzGx0JCMmq72ck1O9ZxBzIdxK4cZOdFICUYwCSDuUXKllxSCt1SExpF0/TajAr2Rv8+d+NtKH0Vi5Ukj+a41hK6NvtzDcEUF7yKQh5Dz+ye9dJJ5GO4a/Swvb2ATA1CXstM9iJfeq7fWBUPdMTyQcAstKhfbROViq6iMFa8Lyo7OB+VYI4ki3tAWSGre2SfnctIvYtJDc27zjvQIPus+3JQXoJRWPdSFUzN4K0v2+rK/R/Yo6w1aHPTOx0GIdR73dOD1h2KdrdRyO+YpAv3exz5yJ+R0oA03jdSbBmIc9+bwO6K7q2kDO9lHWgJrN0K3j0U5FZrSWmhJVJCLpFKo5/M0Ci32vw/C/Vl/IzYiCVl7NjZjOZuzBZvyIbsFenDJ13C71W+HYXsdkXIhCsIUZRpCtOrbdszcIWUy14Ym4V3JK17zY00/AQ1JiK3Yof/RreYPfNo6nvJh7D9CTm7KB3GXcIZhNOWYiAl4vSnKriOkfk2DADiiXltj3VSNtPQ/ai62DB+Q6prfNk2mtaHTMDzWyU+iOR/qYWtrExGL/pAt+Halt+7/GKpDnnNB/bAaa6rndkUNO5q37OgcgrMu1hygNS9lFuhW/5iFvAZAuPnz2dc5ZelD2Nd0hPq766bZKu01jffFn8fE7uD7gKSVlqE8AW4pDcNHgL/SpgEEUWgjlcqWqbm/lonPZfRwS0p2aBhSw+s5HpBzXlorzR3zNRNRSkc0fZCE+wgOG1Oai2h3eUe1k+BmnimdcqSdUl9NECQzeALsyCdxu4+6kKASi0QiyXS8yUVepp8CF16dXYdhhCo/xEDsVucn1Vo/NuW3XMMj5AHtDTRUZvBnakD8llvE0kbOaINQQhE+a7FSznqDkVODIjBIkUwBCbvwB6ZvwSLJ5ChIgrTMywLJbVZVoA9Zrf6TlrozoAWAkQ3IgCqYT+I2uEpuLaj6fUP1lW4/OAG0mY6jL55BYKh7AxpfjyM7nwPLYGYFnDmi2spafGxMCPQPaZWbk1FQymBW5mANPw0ubk0UBpVc1Dmq39DvGBVMxA9IBiqllGOUWw/qtwhUOMgH7CpqxbxjQO2oGr0etyASX/JW3rtumu68XW6bNF8q3LURKbt/FIjX7hMLO0CxQyq03N51GN65+jdOYOxAphUYnV2So81zym5WQGbYnK5RGtrNnLPBO/QG7NbsycuW7Fxvoziw3mgcEi12cn0Vf3LEUgBTiIjn9vjwELDvwjPt48PDR1AQDnWWq9WX0KSSssVu3Amrt9BUyQKFoGz3EDjnFd1gBVG/Bytz267MpV2ZdOzWJt4m1peAXKZjNLRZWIbSYWLAwbg/gY0UhWLBRa2CWVkX02CP7RG71/XeDdLy6ioJLoS6EcKIOmmZ50IzjgCKmQA5bK4Zg5onsBqIfRYP0/YgL5Tcqe0RVDPluLeLzt7OfIpptvbQanByA24zt6m9KtmudWG9xOqYNGOGdHzRNFovtRsg/MbWuzb38Ew6xv1ZsmuWsxmbGyY8rscmiiO+1kxXpwSNTYFZpy1c9d1gN46mDxsHjae8N1x9iYJhDUgIBZwxp+NL3hvEURWG9yIOPmQIQrirZ1ywnA/Y3LCaw8Njk6FqB2Io4AOLDlD54pkBF2AaDCjA6caMZ4TbQoTghi5RE3l8dGeb6/wQqjlLOsSUVzXHL8vxUXxoNpVWGmt0ns7Bfqe4DK6QWgL2zmaZOxLW7vhRxtEjv+IlxcPlR9+OK7B32x+CW6XlC3V6r5Z2OOrsE5GbbKrmum3NZehhj4JMWUUEhG0SVRWoOQZyAqe2dLzR9WJLtX7/42jGRT/bx0UcYGT4mYBDQtCclQ/VnOlPUAegZ/VLPoaTldIQ1VIbpdkVXbYHLd0zFLYLrEZRL+0S7tMZDUM8eik3jl7KBzHcvbSryz6boVeU/4gyHAzN+30HGWudWB4hxN15QJGMh/08zrUfQo0JQLzTDChCTdIfpIxjnPtxNNdYeUelNJ5j0eHdRXNA9DFAaT5eazsgNG5v17+j8c7mGdIOpO05mnp72pkOGIzoA5bkdy13+aLY0KWi6w00KruT90FoffIHNFb3YKIuWSx4tq5+2t0WorGjJzp5VDaLcJBFZ5ADyu7peL+/resDVKJ657xeP/1eaokkFeA3a9vOsEk8c9PUa4iUvjs8Gne0Yx7tD0M8aBzfR5esVVdLmUxsuH/VJRQ1c03j6M6e09GXEL7t1e8AGcsuI+iAFiiqt63AnwA6khcboFPvBp0ldgzwhmk32LJHBrTblo50g2bMdQtyG9+kIqofAG8thDihpwaj4TC8FOf1hNoh9/k13He64r6IQAjAs6WE13R8GA+HYHCdjI/jx4/w4nE8gDSCS23/VbGSD0YZJAbe36dRxRcP6yyE1Ch5VJ6eHtN+Fa+x314Mi44VmIfmAGV1hqD3j4VEHYZVVGmyEO6oEPJBdJbX7bna7jnJ9Jxv7Lnuh3/2npuW9cXOPdfpwBcxm9ur9znO3laXRADPY+ALo+ILzxb+wAFBoQ8ICnNAUNARLf7EA4I8S6ovOR+4/2CgPRZy0jBzJ3R7deE4valpuT3JcajHl6TlpKMmQRpKxw9QU6MIB7jNE5hg2YA33LVmTTMX0QzMMmFrz5rm6LH+PT4090f6V0sUs6Y5sS+emF/z/Ohb897cPwG3i1nnlDs6MZUh+w8sIfRxt7aYNo0MQwSIL8DpGS99qovH8wBkM42qUYM7w8sv7oRmAS3CPHrk13RvPXC64X2tOdR7sYhtdeaptlO8tYa58AVLzAV+ylJzp/leh8QArDo8NBd0iQfDu+AEciGprKjFqn3oznl43bFoSzpt8NRmyYQU/4hgAXgxPQnc2MQBdNt6UTbT0BmV3ZNrNtuJFlY+EbZN9noWQprGAo93qDZHJhbVgHi6BBAKuJG5TLxon2G0gWRMKI23FXB3enc7/X+LgEzcaJAl5wnYwxe3QZsJnfg5vHao3WznjdwwxS7XYWgOuijt9I1+cbWOLyBj4mpZdhSDuuRaHGcdRtwiQ895AccPb9GKvnc6PoPjbpSu22jabkfjFGHeHhNDfQ8EmYHWIvvOQdaaNjlNRwlY0wLCyddLnScTamPxt1ZLdGkved6G3m/fGyVjDn+/fGE6aWJhInuk5yYym0UtITHeSyaab9MQG8WXTHQXKM35vcVMuF+9aLBIudY36imLZu3XnSDDnTrotqnEep0b0uw8meADXZICAjSP6DLHazPqvGm6DBmYNvROMUpactknZ8QDdQtIOXZqHYzIvWBka9zbCk57/dwC0FaQyjeAwkz4OLoXNEypP7JbO34EpnU88pkKZNs6s9RqqrQ10pr/8R22BZiDDo0LfAMzF7unxU+XXnzRLdZt62RUk17rZGQME9adjJRxAGTWSmbrsbk3Tdvea+lrpzFdGEY+75Tt1Fp3FENnA2Bf0PCMGr1X9TCLuU0W1jMM2/25YZTPJyy6l9e0O/J06Ex0nCcVMrj+oXxHMekfMxeBroVsNwt/mGm3sev+I7Z9xorbIAbNx3Ssuv/DySysLerd87gytWq1pkW8F8BZjy/EuZxo+Y/GV4ZuaZzlpLV22h0zv/IBb+B4uFcmIxayurs8CXYX6g8fVuyQdjwZ+JEvU9C42oCfCoVGMEZcc1d403Jneiz7Q3ZsT2b8MW/s/DDMjCVfdylK1t2yOMcEjrifvn3+6pXnOaEdhbJCCbmQwmRHMOdwePRtF2TD/HiXTH588oDluG8Gtxim7rtduSl/Y6oFBz6tJUdnrlvk+8lYxNskSIj9FHeJaqhzqtTeEmtjsP2hxoni7teRVWezk0eH3z7ha2UHWISrNhClDvwl0TPdOJUbOx8TpPfDgGh8tj80dvOyXQv0JZIMw7AHF7dK2IwbibF2QCOiRa0I5r3AS+jggGiHDbm5YtLb+VT6Rwp8yKRvHIG9GrWPTqWbiRH9N9ohOp9oB1jop/xeQKrXZPo0zzd8XQ0NhTDT625mwmbbNcGMJBdM59W2GTRwnaV2UrMVe1nvXGA6l+FOu1kWG26WIiogLDMEv0+2pujkBqSgqYHnlouc55B7D+wcQArlbjaR1jrJqm4dRGeFB+3+EflVKQUY2azYz+LuYD/s191xT54JjjDKfhR8OYjJhw8Dwr6F34Swx/B7QdgT+FWEDbFAQdhwCBfXhA0P4WJG2PAILiRhh/ixIOzoON778IHssSdY5sMHKISl/krY8ASr+kjY48MjfP8aL/H9j2TFfhL8nNwSRn4ljNyKCq703xdvCSNlQRh5g3/+ShiBC/wt4QL/vIFSM0i69kb/ffmSTNhL4QfB1dF1Y3NJ4caLy/vVN60b7/diQ28n+H2hiJk65RD+THLymbCCH7aGMibwBbyq4dWxd9KqTvnx4ZPjJyffHj55tJ53Bkyvg0WZdQ4TsH3fFusSGTypmZTB55fmH+pmyXto8LFhcCGwR1/2vROtAWHFvnApLz1PwF9wAvzgFWojeIVJTqkTMCafIziK08+a5tBmX9U5e436QnXvvXxln7LFqwJ9lbny79oicMD/WlyLnOtQqjqhcKTaFxRCAXv3Xiq0Tmaau49CXFBMPWHLlYk4uFyZSHJRcVc2XodEKg5IhNWWzojzCkKja9P1ygl9hxTNx7basFcuWjllEcz8WtCNNh9NBV67vxrOK+nkWmO1MXLpPIWDCybPqwmvvTw03jozpcu7XG36XSnV/4hbkEPspR91pBC/wHk8V+110zweWBD4WcwqrsxF+11RgldBon4op4Krzm1bCBz7RYERlm+46ty2hUARnxWXMD18j+whAfaewbGLAaJSpuKnulQCOuTdtXWZQI+St7TE0SIXBlKO28v4jwdYEZ93lX/x2S/f2rTrnroTN7h1rsXo5oePwKT4u/YxfNpu8X8Z9sjP+7qWSpMasN8f2gS/qoX3ekTRDjoqO5xLQcBOaIwymAbjDKqpaew/YmUfRUv8aaNbwOfatyGq+rygrOpzFxi8avv+38LP4gtf9btdt5joaz/Cyl/8gEQ2ftSTbtynr7plbMSn4eFJ0wxPhm0SkcMnj8IQqJmO6gvErIcVPvr26PjYlgPUfxSGqsf5MwGJLB4dnbg6dciGtu1/+G1jR+yXlgUNQ82ptt/8TXQOM/g/jDMvnP7geM3sRXJcxEUYHh/rep4Mza+t99BdgK8sNH30yIZ2R/U+5L4Fd/h/iEhQXbugxjcZhm3KZG3f/t51Se1yxV56szOczpMwLE75o5Oj4ZMwFP2hEwmQ6et+C0cIZxxjQZkkIUfHR+Ph4PD466jYx9poX+5jgT5Oup8w/H+8ef7mnx+Kr4NvbOT6tpCS60Ef9EC0MwOYfeBORBOUBUfzb82ss1vYL5f8KxGlHKdgAAJaahYS5i1F+VPb6ndt9s0CpWaBUrNAqV2g1C5QatYHfu2Xh+beFTRVnZiqTmyBJ+bXvD/61ry3TZ/o380YfRqkTGfVKvq7kWutLKQd1pumojot8WCUuGUczc5wO5yMkz4/jJN+X+c/+QpO1rCixJ1IBY9Gl/wyDP8mIjDnrSnL+az1GnxQxTqAgVc1GhaBSn8BNpXJ/u3+8KwIQxKgBvn8tj+csFuetEp67Nr9XcKqpmG4tTarWJw3zWIsz56EIULe+FFcjdF8a/woPowX4+P4KO5dNk3VNBm8b995yEFI311KHUzrq062PRBJYAeuSSCHG8Rwj5C9mOztEbQE69Jd66D9k/ByADfNS6OCEJTeV6/OBgyVk77okz0dLdqSh0J97bGKkmIqcc59pgEYOFdmLbE3FmDHA8q8+/2SspoXTeOxfWegQpBn3Hs0MpH+lYwEqx1xYBXrZEA2Q9vKKNrY01pO33ZWIk+LkXQK/u5BiZw4lbjwLJnMxXCFTa0o68wo63AoYdjTEWfTpBLB0KQZCATmHwgOzT1OfRuseg/CVO/tEYqrgSWPbMmG9KWMhJsN2i9k9C+BAXGoLntsy57dUfYOnpp/E30oIHL/Pz8Uk6/pNxCFwJixtGwCrBwCWz2u4zYN1kGeVArkg8+8ZpmM2nBtNUXLkQp1LZhdZDBpGhLYa4Q2HWZoVPDMxrofGbzNMT5YyiEkGMhG+F2Kie37POlHVdPIpiH4dExIjH3sZzJKgRGquHTOrKtIsIq20/TITBPsAB9nWs5KMAnck44xMhhlLeYqLObKAHNBkqhC46sM1OqC/yjOC2DxvxJRATxVHxNHMftZGJpH/eEEHMf7oOn6XnSTTAoKm3JkkkTEXRkzu1qUVZVd5CbUfxxkWvAyVi0BCgOge4g82iilt+BcIzUr7tAYh+rWyMsA4zrVj0CltVnkENbTPKVj0idxgSuxDwD8ofBiSxayJUrbmhp3ovzFHsOUSZcdndgseg6EnFPEyOOKAZ6D838GE4TjgYk0NWAJWGxLH9Ci2mbaovvlmc6WVZ2V4yquWaJ1hP1WgVtQVnLtk95KY6ZYS1SxHqgk6fP224r2O7VV/SGN/RKUJW16JDf4Um4NzQXQmejzrFEbh0xukW0hcBBrZR/jAmVvbRClNshTBSJsja32hwxiP+B/VHw3jVPsVWHoCiFb5ZcDORYoKyxSDc4QfW74f9Re11cLYIuRksBNV/U6rvuQTyjGn4Cwum+KgfUbyFOJqQOqJucTD8YqeYedkIn7DJAQZeiO3hHk4jXs73QE5Wk1Ku1pelTz7Lyc0E6q+bpNKw+j7tWdt9t0o8LPXewXN997aeq9G6TkeFw+rm1G8rqbjN442a89hf1tX4DqwhlvMK8g1owaI6N7OcfCE+iCn6umDfb90sw5RhD72egz/Bpp4fckEizxHBZ+3fwAGL4upoMD4No71LanxNKFTzQn2QnEIcSe79FRp9nzZIItrwzEFK2FtqPkDnrqNfmBLvVcmQNerEAyhDEJljJNY68HWrFds8RNhQFZ9EwsEK143M3poMv/CP09+sHxrSHamsY9bjMuoAYMhK1ck2W0b2iVCo52SzCDWQuyQsakPRDPmwaFYsMrhKE4G+iIcGA1lMOpelcxcT6bUD1CTr6WYvaR9GdudedhmIdhb8s3eLa08RScP9jWUdNC2+T7GjwzsS4qSq97MrGGJ40nOyHO0Wu+Fm4QeWqNi6yijM7xMvKMf7ZpltaLe09GrdOle9YFbvtYn11jAv0LnelVH5q5BqmH3+cb+D2FoVlUO2uaKPVQbc3lecXn59lkjQLUWygAiMuUbSJ+BIQo4feAj0Xp+tf08Qxke32auAvhQ46gMYnxJyCUpRbjM3AC6YzIdq+GviUPrz7W1ccBYbM+dw1QS1PcBgekvVwR5O/NM2DtctcSJ6EB+L6tI14Hwg36A6uUOk/DNQDUVhDAac4cl3maQdabIUVCT2CuYSqAJLIAj4B9tap+g4Y8QO9LPjsvJg8g+iWr3JyihSBgNTdQfx1N27g6jh73o243MDo5AcYvJhvvgCkMiGutaltL+rzeWI/UrgdZkn7SJ90VuWM9SEDcmrRbdwvetHilM9BxtHbYEYa9ClHhWDNhGJjIAESsH/05EGIVtZaZ08AgN4Ch5PK8WFvX8m5mrkCBh0VrC+wxcaVl4gwLh+9hTRDiKq3TJezO1URtb5c5q93CnZN+1SeTP7RwS3/h9HAwr0hCO2/eFzrY5tQsq29t3TmUat90sXDHpA6t6bStvKlflQF0KiD9hK48lBeGqNsxiw9Tm9LVnQgSEa4oTFymSMdJwjeQDNO3LBwaVo22+oAe6AP+63CIwvfapxBjqY63HUhxzmsnTg0f0zGcZvXtoyGkjkXuCgwQHTftL0PLJ7U5JeS6zuB8ov3pAWZTqe2MKdMql3JDySJ81kSn0Dkvz+VkQkdi49zDJQAo/OwUm+y9H2FoiymCojbwTJTxVkOmKNUnIW2WZQjzIE23WuQh9BNFWTeSraJe9Ge1SZVlhFK/cGFr29JrB5KK0u2fF0i2u/HoZ9I/RAk8XeJGTkDLnwcIGaSv+gSMTqS4Kq+1wcnv1T68C44PgveVsOUElCsqJZIpg3gt6VzbqtwEVTITEJbDyAMHoGJAi9Agl3wJclP8u2Jv8TQsfqvYy6evXr99+vLFx7fP//vFD0/jp4pBUGt7+0yx529+dm9/VOy7Fy+fvn/9zj4RQlt1vBcH8MusJYq5f5rnDEA1XuLfbbYfMB2/iMhYE9GRdAeaCMvSmqOo1gDGw6wFl2uYdUlIXKwYcFyabko2YIXhksZSE0VM9kHIaoW3DOLIv/gMOCYri/hGMXW78BIb/7dimMI3/lWxq2QRv9BO/PFzxTDPU/x3xSqhYiWYS2IZf68Y8IrxO8WyQsWfFMOoy/EvikGOrvgf8M2/4leKVUrG36kVg7V7jSYyMiL2hjACE0moe42GPl6Jp3luCsGVLvcdzrYpBDcEIqZdLQhdjWT0+NuTY3rwDLM3jDR0zCVX/OxNRA9eKR3z+CCr7CUoYdoMLp6KKLiFm/Ec/6oDVf7lbURjzDPkyk/98tOIYtH4XHlpJm69Ir3eVhTRFr70Cm/j+A24X0seUX62zIUKFFgaCL5QB3mpQ8YdVCKR6RzFCs8sAZVXPfA4gc8kFwdVfVEpGQ3pQbXIMxWR0PD6UMBka9phHOTnccIc8GJiK+PAJZ5viQaIqZYnHBKDhuHW98MJpINurayVFy3/xicCI+hpwV3iAssxHloDsB5vX54fTsb+TQxzqP0/7Zo4CzDVNHo526SYTeNmM1AGsjJ+E9FouWLuzDSYRTSS/DKiGKjdTE+k+NlS6cPOIsrO1YQpOjaBteE2hj/8Rup3ghV0RSnLVmmeVFXwgwx0RqsqKA/cRC2dNXxp5qSqF/rIh7IEej/PKkbK4r3Mn8+TApKCYkdyoZYqkZdCxUsd7VusVlyNtDGBUG9VokS0rGUeixV2pK0NtuLbhUhNVcbMpq7mT2s1/y5RSWTMP8CZpjqoFiJ9igtXHdSLaaLEe5lH6s4y0/KmgCYitdYujuKtyGGV3CgCNLDCkRzgQJrG3c+lmOkR2S5HgjI3RKxJTKE3Aq1WpbgWhfpOk5ZorXXbrfcy9wfuatbVwrQd1DLfXR/2ADZpZyzXEgT+c1LLHOYmu0rkLSjQCLgtFMmVMFAn+W9fLW+yYlretJsed2ha5qtvvtl8OS8rtdryTaLmUO/qt5HNHKKLhKEpOs8qVcrb9XtkShBMNt4YgoUvdeYZQthvXy3lavzVsuCCzWFr4AYp7AbJcBYcg9qiAkX7hJP+ljfFuZpQSg9+L7MCEdfqt21z7FbZTLSeQME98LsU6jkGx68iCqtXNQ1EaApD4WwJVBjCthbUhviPMAMHP1vienOdo3lt+1SmcTyXiuXKBz5YetCnrugGmL/MciXkw/erHkSe3Ja16u40XVMksIkWQjlubIXbTk9QKSsYRkRZt8+D1er9X5GBSu28/5Ll+c8iFdk15heoWou/DtrYWvuKrtYwhV6O5ULIKqsUPC9l9u9E51Za3bVII9U03qukVnM7cCkACEWnpmiZmFsxBeJNV24032XTH8q6ULYngbqrTfQzccChI+y7Y3PD7HlIoDOPhkjB7t62t5tGbXs8KjaBDjA74B5EBlwbed8LdQIyG3IPAlpcCOfLgKsgZEgxFTJCvmCJgzbTEyvWWcZYsHZOYukvkMFMBVcReVYrhdH2M7h7nRWfMPwxXJeXJaE4HzVHUgKxwbQFgr6BUdSwxrq+hJ873Ltfy3xf26hPRmSWgKnb1m8h/TYKTbYQZXX7zLZqmgBwreJ0xWWkO5aDULlwmdFTargskDIjGqVmOdIWByhdc2n8pl7kAjgMCCyL3BtbfhK3sTR5NRBhrJjAJdS7P7/j+zy5EDlhS+QBAChiold337yZq6v8ZSntY7JiG3VUi6QgWrdC9DqCOwSoLDKTEmHzE0Nkl9nU1cymWQXM8jSuWVlo5BSbbHSOLpsRpud3bYSJHjvIvtoX06SJ86jrnZOhF96fjMTgftRGguxHlPisyLb+6Qemf11a7Y3snrUoOqvQgcgLDe3QbJ6ln+L1Ea0YAYNMtMZ3TOLG8KbZdXelVbm4SOS2Jd0oeiMTMCp/UFld7f7dn2QaWNYfV/iYbgEXCN1N7p4e2xIri7f1xVWm4sUKWYDcbKTc4bVoALXnZWErp5HSm0esqP63Qq+Q15LL6NGTJycWTXzU8tAm0FxdEraci+xyrmJyPCCskmn8WrIkVzF5e5NcXgoZvH9FTFr9K6x4eHJkK76Q3CFdeWsFvVweWB5VhzVvZUAkC0JKS5AKcfMOFCPFCymjVjmiKGWQr5b9LjnRqXmqj5pqE/bZe6bKy8tckFb8+WtHAbNEwP9dskVyi6qKJcgSYuWJqG89kVIX/9wWV1aB8p2eQctteGheMI/AItJH8hfRg21kuyWkwGTmb1Upk0sBpPSVElcRaUkxhJIBhddOkg0qmwNMzodCwWrFXqE8Lyw7t5wV8XImVDqP5WrFXe5VGPiKvXBLKM3gPHkjLlYco7cr5/Hj9q6egEjRAzUXRZSxzFMUZJGkS+mfo+PCNo1E1FJXZ/x4MBhHheHFXndIlCFMugVC2ZeVApmFwCFVWVRljuBWysg2/E58VkaPiqKI8eOlsYguoBQgSdhI7KmbGIXrU0RTZDfGIoZMzOy55Mvz3+UkdqVQxxNFA/YOc67+5S0F9aQGJUrZ+ee28NJKKq4AGsFdCuV5eyngWyLJegX0553kAHevAW70mFFAv5DRlaQr3J9vYH8eHh0OKPu35PKgiN5Iyj7B028PH39L2Xv99JOk7Gd4ejQcDCn7VT/9Wdpt/UzyzuSxH9cgqsMFSTYrAFBUBCyNjAgu9TPwskogvi2reAEj+y6rFnlya0weRlp0r7dK7o7luoMeZMww/IhRK7atPxuoWLBfpdFGOL7MOUToZTcINLErUB9M207z337J1LwzuOirZbWiv7Eo4YK2SiDkpuzNQVb9LJK07SCcNnivr5IF8qbvSpQYuLj7HWX1iv2Ea4E7FXOyilWrHt1N4FwUhRUj//d//6//E5BNkpVZjui5iwGiFyQgjCjkKccEJi5wkgKJBSMsqIRAdzsLPYTSlVnol9t1NLApszQA8BAyuxZTHO9LWV5ZSmBx8zyp8BFmZ8MLi52Nisfqdw4ODpwaqyvYtVUMTRXovtEReJ5riuVyEmtZZ1YcbC/USgdbdoZiHpQKls6zfCpFsSYaAHpteS7bR+pQhIrIS7tqd3JIkllocFpBuVq9lNY4SMOWv2vWV7C7iQC1/IQ7abkx8PiZXLWDwSk0iON7yV9K9ov0jagBUF0Vr7NKxSAssFmd52+uhZTZVMQCQtNuqikHW9WUA19NOZjEyzZlMD/TB08jQ6Tf3lZKXMUZUDHdw5IL0ECTpwtQiz9LKvEaVQOEkb/pcBE/yuTyKtHKAcLIq2JWPteBuPD+rZAQV6LzCE5UROeRJdfimSr850Z74T15sxASiXrl3/glwCX7SmAeGkakqBZlUYlOadMnwggYnOfu4hfLXB4cHKgJq/h7QIAl+zf8FNzkfLRiumE4i1aQhlmcIbi2TMPBzQYSRF2khczlXSDDNj6Mf5SQJZa50lW87Bb4XjIL+vFPcsWAW37elq6ARfyX5OceuJle+HW+Q6Y+/kEyELDjjxI+2/wE99+PeX2ZFVWMXFC8TAwfJFjlpPx3AP2G+1tKMa1TIav4uWS2sPIKZytoDHZT1OnTW5UU0wSYeQ1/MBbKfpHRsrMxICBqZ++cEz0aALu1KtCnN88K8Tc4UE9UKZ8l00tBJis6WdGIsoIXFh3A/YrS0f/3zTf/v0kD+4MORf7+59e80pz/fp3tV66RfbTYg+yeQJb+3wBmadKImcMEAA==",
+	},
+	"swagger-ui.css": {
+		contentType: "text/css; charset=utf-8",
+		gzipped:     "H4sIAAAAAAAC/+y9+3PbOLIv/vv3r9DN1lRNzpgKSYl6WHe2jhM7GWfjZOy8JtmZmqJESKINkLwk9bB153//FvgSATQIkPaes7fqbDYZm/h0o9FoNJqNB/vJzl2tUGxs/MMixGF8+rfBfGg51mwZBqmxdImP708TN0iMBMX+8sV//K9eEMbExf4D6i+SpLcd982+2fu/vavLT713/gIFCer9397KT9ebeX8RkhcBWoTYTV6wdP/x4q9a5b11SvDBIImRon1qJP4DMlzvdpOkp5Zp/jAzdmh+56eSUuwHyFgjf7VOT62+5TCc56F3fyBuvPKDU5MpcePUX2B0wjxLfI99sgzDFMXMozVyPe5R4G6Z3xO0SP0wOHh+EmH3/nSOw8Ud22LrkOmYtubURmRWyNgfjRHpsZIu/dXCjSjDE+7xJmalJa7fVGlOUarDQqQ3NKM9K1d8mId7KpYfrE4XYZCiIDXm4X5WaNichVsUL3G4O936iT/HiKGPYnSoGw8JgzCJ3AU6qX6aHRtuIcL2yYHpag8twtilDTeSOz86Dee3aJEms7m7uFvF4SbwjNxs09gNksiNUZCy/Obz+J+pn2L0x2Eexh6KjXmYpiE5DcIAzbhKTjeBh2JqTjOJGEdEzwvTFHkzFYA1RtZI0jgMVrm2drly/WCNYj+d1Z/NQ+yhmOGzCDkrvZt7LGuXRI/oBm8ZFNaZ3mN06qcu9hd/sYYW3x2EbvjbcmnOih9NkzXihLgY12x+Yv7Alm847WyiGnrssOPcnEVh4mcajxF2U3+LZltER7SLDRf7q+B07iaIkvC1HAoLMPq2w7Wb1pmG0anR50vcjeeHjHxb30NhNdb8IJNOHHIZ4WkQpj/+kw6mOMTJH88rsswKyyYxdD5ZlRabdwKFssJuVxnf0zgM0+eHalSufc9DAWt3mzTlnIcfRJuUeRJGKe3MiHNkGC1YHLV4N0buAZ4j6mYFeecZ6IxlEh4anQ1AlQt8yIZl5hWWYUxE5f0zvY/QzzFKUPrHiViQbObE50qAyui0VVDkpX9U/suNIuTGbrBAp3kRUH1Bc3pqkPDBWIaLTWL4QcDNLXVRtaCF8ApsXrkIEq1uFrmeR2cDs6ENRzaxH6zkDVDiSukbgIXoLOIQblJqa6dWtC98b+9lBvyE9ik3DSLsJSg9lA3rDxxEev1x9u9IcAsYrVDg1efFai7ZF+6udNzlwE7dOUYz4u6Nne+l63woVHqc7dZ+iozMDZ/mgRE3iYarGCUJ6F60/Fw1SKvx427SEOi/xRot7ubhHhoFsev54R+SdjcZRbAhcxRTCyxGQ2ZZRhL5gQEMIwlRuElZokPhRiRNSZAbL9bgEKTqyHp9VliJES6XCUpPDTvay1kdZckfGAvKDcvbIKM7BgiQdIJ3OtIvfYyMTYRD1yuVIPUwmectLZGdz1Hq+jhhQ0UUbBpCxWRDiBvfVwjsJ6nhp9zIWLjB1k3Us+A/80npD24iIRF2U8TMhgxZ30Pzzar3H8zYTkLse71ViD0Aa2QjC6T423K5hCjm2F3cwRR8DFNQrGLfqwU/9eizt4nxj56buqc+cVfoRRSsZnSAjoYn/peXH2525j/erMKzs7Oz9x8/ry8+r+iP2T+Xr86+nZ2dnV+cj0fv6IM3v928/vrLzae5/d307Nf3369fvvz+Zup///jy7fzr6+D7l7f429cbZ7HA+FdKcL+Ovrxem18vrKsP5P12/tFZ53hnOP/tLP/f+e4F+uXl+pudYu/VS//7Vy+a35r+eLx5cem/jL6fm/6Xhy/vry6s3bX9JXQ/r0cL8uUTunM+fxtE8bcHfHd5O/np8nw//BCs08UbC3tvLlbojZXMg6sROjf9b19vtt/I5xH9ff71i/nt48S//GU1Qm+snfcmmV7evb6b22/x5ev1+8+vXp7PB2/x5fnnzXvfur36dLG/PF84724vrA/nV/fvP+5WV7dn+/cfJ7sPHyf7q1fm7upTuL86D++vXp2tLsu/t8PVr7+8vft+G328ufhWybMgN+TXj29D75eb3Qd/svUG3uBdsHh4R6b33+8n+w+f7px3D2f37x4u79/99vbuu289oK+O+e23VToPrm5rfC++//b+dkHwznuDt3P/5f33N99G376+3Xq/XU8v/cujDt5Yu0+fzeklWZveL2ejd/fTzeK+6ovbuW1u0ZvXu3cPF5urV9N0nulnnc7fOA8fgvfmZ/KFkXkeXG0KnW6+2dP03WC9Xrya7N/dnm0X1o2zePN5+5l8eZgPvtx/s798/P712+2l/3I4/7rfLB4i2veasuRyLC6s9x/ubrbfBl/S718d8+Pn6+kl7etXzp379Xp09enm/MOnb8P35mf7vfnl9dWn16/f367sq4fv59e3d7v3d9fDq9vV7urissYPbxaDm/WcvMc1fhHL71qP33l0viBf1t6b6f2XN9Pt/Nz0r3P9rD6/WW+9N9MH9810d3nx/vzy/HJ39enz6h8X1vqbjTdZ2as7/1f/bFL00+jmzZd797fv+PvF9/u5ba4Wgxuqw5H71Xnw3rzefLO/vL05N32Kf0dw9P08HLy/Xdxf310P3t9+G1w/fN5fff5sX9++fXVtfr5/f342vPp0tru6vRhcfbys+H1/M731vlp4HtzU+N2w/B6U/LZU9ncDwCaprb6aZnb5+e7mTY7Lx102Dj8NV7+eT4eLN69vXfuLefnmy4aO94V/+eLX5OXHn95cUQ9x+/Hzzcsvv9y637YPL148TM7fJefD1eLNb/63i7M3Ly//8eZlgF68WL6Odmer3dnL6eTMfW1Ht/jzNSV/+fbms3MR371drVY///y8F6MIuWnP7Ek9qWGNntCZvqS/nl3nzvRsOXkxXf+PM/0fZ/rv6kw/nH+7v7ldX9ycU2f67f6xzvTI71qP37+RM73+9LTO9Pr2v8mZbl99NK1L6oyuKmdKboYvnJ9evv715fLs6jV5cfVytZ/cnL35+iqwzs7Ix9VgeD26e3n+j6s3V+evdpev0NUlIq9fONfu7sKPzr7uPq93F7dX59v52Vf89W775eVuM9leX569PLvYvXwVrSefRwsz80sX+PWnu4+ba/LqlZYDnhhZvFv3wjRY5t3vbYQq//tievtieH327ea3dfR9dXb28fPd/ykC2eP/fn2RnL18feF9fR+ja+qZr0vH+WJ4fbV3f7kxF+fh9p3tPLwjuR97l/na6fDbb2fbq4/D3bvd2eTXwXr37ev7+Ptv16tv5Evgfh1OLzc/jbf3lyv36/X0Ei+sT59evl9cvMfuG3yJgrf48yD68OXN+5FrXznfX12+yGz0nI7fl+n3327WRx+xml4WdX8gzsMHkvngLR3zmU/+5Sb+9ePbcq749unL2erant5//3hmvbv9ln57uNhffTwbfPg43L//tHq4uv2cFONke3V+t736dJZenV+Mrj6dja5uaz721SX1+5V9HeWhtmj6lW4G3sC7dwZX9852QRbbq093zoePk92VP7m/8i1Kny4GdCy+Hr776jxc3h/53rz58vBt8DZa/HITze3hivrvD+S1Px98MX/9eFnzk9QPmL5Wf9z/G/rm385G14O3+NtvN9sFvtnO7V1tXnwZzgc324W93i5evTy/vj+7vzq/sC5frd9/+/o+mge0bBXlfF6+//QpejsP3pvfvjq33z/ji18/vqX8N+5XfPfh7nJ/dXtpfvi0eHh/6726erjY33z6/urq7u35zadL8+p8tb86P7u/+rTY1/hdzO331vzrl413ceT3/Q3Dz1Tyy+bOaj7BC4Lt719v3izINL38JdflByKfF65vL+6vbq+d9w8X9nvz8iGbF+4u76/v3r++ur3efTi/2F2dX+7fP1z4R37yeevI71LJ793D0ZdyNvnT5flum9klfntxk/vceiz00yUbY+E5oXJc+r8+jF+MV2cfirnq7HsWu569fDFNztZnZ/M36zD65SN++db//vLan2zfmW9/+fVh+NOvpvfLTce/Zzdm9I9P1+T9+ST8x/n0l18fPr+4eQS//O/yxe7s7ObV2dnl2dnq/Ozj5dnZ+uLl2f7i5YvJ9dlX6jevz4D/fXt5dn0BPL+8uDi7eHl2dvWSK3h5dn7x6sy8uDk7uzh/KfK8ufz0+uXnrxcfL7cv3LOz3fnZ9dmri+urm7PzyVn4Ifj0xbr+dD5++zZZ/zrYfrwa/PrixXfNAF9vgmkV39P59dX13deV/T/x/f/b8b3uHMDG4dS3as5ZtpXW560ihtSdtx6+/IJ33z9OP37/7f3W++3tLbWl7/7Vk8XPOnE9TQxc3b29yJMuV1az/1a/dxz5fVPye7L4/uFp4/ub8/+u+B6N3g7uqId44JMl5ufz5Gp3dfvxJvz2q/Pm/ww//vp+fnP75eri2lq+ujlf/eQHL0Y/vbXO3n7eJ6uzizdnZwPr5dnbq4/mm2+5P//4+cuHm384r75dXjYkUI5LMewOlhPVHhe6I+akcTeBx24m8Hx2c4uH2V/TE2hxTbWLhq7LnrA7YthfbfbXAfvrkP3VYX8dqTbs0JVbcbU5X8RBxPXxH7LSYplKVhy5SbILY08KSBFuKNun0sJNzBHmy5LsI1/YDsQ8CNlGs2v9UYygTUzMs2xdk33igbsD2Idr9le2LzYYXmJk7d1NIrRIjWwV7SDfBSInMgxrtJ+Wa77lPiBn1LedH5qopntrxFNZ43F/PG4kG+4HPNW4uaLBfijUMxj0B4NGqpFINRr1RwrZxBY5ZiPJZO8I1dj95gY5+4lQzai5mrFYzdjqD21FPWOhnmFzPdbeEkjMZpJ881m5e8gst+3km1zQkrFGd56EeJOiWVzYKd1VZM5qewEeDD/w0J7+8td/EuT5bi9ZxAgFPTfwej8SPyh2DgxMRJ4f5HIFySNGA6XuNCAAQr0xARCqhwVUm9bIAAh1BgdUn3p8QJVpDBGoMvUogfSoM1Cg2tRjBaLSGi6U8F8wYjSGTP6s2oAzUgwj8phRRDoOItJxDJEOQ4h0HEGk0wAiXcYP6TR8SJfRQ7oNHtJl7JCOQ4f8148c1SjBjxkluOMowR1HCe4wSnDHUYI7jRLcZZTgTqMEdxkluNsowV1GCe44SvC/ZJTwW7HZnZysWAu6x7K+GT7b/Jw9/V8+icI4dbljCX26FZwe1RCJsuc1sraxYVZtNut2kAem40V6ksk3l5N0E5M8WkqpRLibRFhHIoZyvjIWKEhZy6nM1TF/qJ9yyZNAp0FY/CSwSsMI5JOGUSs+2egAOWVjpA2rfFiCvPKiVtzoeAZ5mY1s2g6fqle4ofCYjpGx6tQ3MmYdu0fGrmMPydg1d9KTOJRjz5En6zjyhP1GnrTbnnRcGeTJO62xg/CTdRB+wg7CT9pB+Ek7CHfpIIZTsbtd3OUOwozaLkyRpCc7blmRmxVR++xWySNIOggLUmnIW6d7ovRCyZt0aQbp1gqi3YgGgXEXgXE3gXFdYNb0XfYkWsZyVjwqQnHu7E5/npY01DkAdPSxjLY6+Za7A4A6L5DRz9kTxhCDokTGAZccsjEP0GfPZdQBcHKPUVf7oTh38/xd626okXXpiRp5186osejeHzUm3bpEUB/UK08T/rhZvqh9T5FHdRR5dD+RJ+gm8sheIo/tJLhDcKcOwY/qEPzoDsFP0CGP9GQG1ugQlsbIz/GVdJJLCCguQG4Mgi3LEsCeG98Zq9i957CDwUDAEt+DoI7jCFAANh6PBVji4y2KOeB0OhWAOO9sCO66rihpGAaQDIvFQsIaACOEYN1mRzA58HJI/wh4ELpcwjhjarLQdYLdH80T84cTGqWf9KfPJYQTBeFERjhWEI5lhCMF4UhG6CgIHRnhUEE4lBEOFIQDGaGtILRlhJaC0JIRmk4zoSnVjmmrSG05raUktgAlZS5GsNl4Nc9IT0zQXnOiSQPRREY0biAay4hGDUQjGZHTQOTIiIYNREMZ0aCBSKpyu4HIlhFZDUSWjMh05ESmVBOm3URmy+msRkLIBrPpK0Ye77rH5tAUvawIXC6H1mAkmRMg+Nh2xCkkjN1gJbr50QCYnem5fgE5HwPIe4RxuGOx4q0AlbQQ/G/L+dJyxek02sQR5gV2kL1wHQl3kMIdjgZLG+6UyA/4+MNzTHMi9so6TCE07Zq5OKOCyIkpndhBvDvwxrJQCKGAj5sG47EjNhOETt3peCiNMUSCKULzhaiTwN3eC6GeNR8OYanneIME+HA4RcBIE5ADZ4w8mVUB+OlosVguYTxKQJKFhyCSnZuskQcRLEfL5RLJCCBFosnSWzoyCnhwLJcLNJeRQKPfW3piK+qpRIZAmh6aG0ZxiwlLAF1t0p/HleeOXc/fJKe8q4gtDtC3bCfmbjLpz2Obh4GoAY+CQEMOZAEYwzJ5ycW15XlsRD7GHG46nU6Bd79qye34opi9Z5V6qb9B5m+BMo0Z+YIi87YHsirKVNyKZUU1P0FmkVe+EthQfUMzWyfAYrOei5LZl4iRmRiAhK0MAIKGJuJktgYgZeYmQpstjkv3PcboxLzdo+1Ok6Wm6YnctK3vaRJ9sVnLukktkugaJNG0R6JnjkTXGom2MZJ2tvhk/k9ITT7eEslTGiL519ihxOaw2uawrs1hTZvDejaHdW0Oa9tcuxmXzdk+yubwk9scfkqbw51tTgjMsysa2TwvcGVuBs0iTg6aPROgxTljIe0uAGlKGbhgtXWAUDZEWOlpbIuIbmgOvAYHtghcb3qieadqKWnTUNKinUS7maR1KxtbhNu0CLdoEdZuEYZaxCJ31etCuQbClVtsOex+dzaHAkEDDgRhhiwG8Lo7h4XYIiQ1zIOwsCV6H/MArF6ZwtqVeYBWqExhfco8iOtQHd4OdvWgWtYpAkTWLyIQ7hoRB/aOAAM7SEDBfcRF+NJuElbupT0lxtHSzuKX4Z924XxXi0WlfUg0u5Do9SDR6kCi039Eq/uIXu8R3c4j2n1HOnSdpJuwspuwZjdhvW7CWt2EdboJa3UT1usmrNtNWLubMNxNDDBZu164M6z8uHb2y6nZM3vDaN+zo32vaaGloLV52ok+7aBOaxd0LeoeQvS0flOH2qlTD4uaQeq200ip1tzNddYsQN5GuRx5F/1KWOirmGPQqOUncf6V6smjNE8epXjyaL2TR6qdPJHWmzSMH6Vh/CgN40drGD9Sw1hfwwyDKEbVFf7GPrv5vvr+jnFffOujenKaLOIQs98S6NOpxMw+a8L5/XzyMA8x8PmRfjFhmNXpOrY4myXMA85O2Yn1WVl94kyYV2kVVYrlRa1WWauIyCq28orFUlq3ndVtS+q2i7ptWd12WbcN123nddtg3UXDDVnLq6Yb0rYfG29IWl8234DbXyjAkGmgUoEh1cFRCYZEC6UagPLypKVBP15QO51ZnMhkzl+2niszY6bTBGDPuU3SQsgsC2unxY0GX7+xADDssm6ZZVbVS43/KIHc/mtCSIy8lENmpZUc0oFwlEM+FmpyyAy+FKTB5o81NZl9rS655VfNkhp/JY/c/mvyNAyBujzSUXCURzUQmJswwLHwJBFNPkBIw/ggjcODKEYHaRwcpHlsENXQIOqRQVQDgzSPC6IaFkQ9KohyUBDlmCA6Q4JojAiiGhBEOR6IznAgGqOB6A4G8qixILV73GD3uNHuscLucaPd42a7xyq7x2q7xyq7x812j1V2j9V2j5V2j5V2j3XsHmvYPVbZPVbaPdaxe6xh91jX7lXxEEu8WJ66S/7iRPp0jpZhjA7F5zlPn/WesV8eg9kcFhi5MT2EuuYBh4eQmhb3GBcUVFSuqGSWic+VzeUVBUVRp8W2RTaJykU6loJSHYshwY6lT7dmtqCOrkFe0iguaZKW6AsLC9bUtwZuFAw3CYYZwZjSJUb76mNf9Be2uPh6GIOqPRN5GfR1+EB/OrV6Vo/+NqPNru4oOurABKizJeCMWvzwWAZYhHhDggxieH6cX4d5mj8F4HG447FxuAOAu9iNDtVPp/QfUDwOF4QSJH1sxGiL4gRxfMvH0taxdHwrG8jjcCejrRVxPZwikhhJ6sbpIft4YfZFuSSzhfwxhEeBJ6JR4EHY4kqVOjx/BIHLLycycPBzipXkMUoXawZfPGPhCcJLpp30gbSZGfrYyiNWaGSGZNqYgaEmZlCuhRkYbmAhcb19GRpsHv32tr+8L1pY/lbORrJmljjaUpBGaG6FylvME0HNLjFzlO4QCgSa7JubZSlM6mYXKkgo80LxEiAUpIU6cuWplFHSHLu9WRUlnun8JkWUBKUiWIoGNZSEhRogumYl1G2oooPMKF9wMg/Zf3kXnRdaRaEFFdpFoQ0VDorCAVQ4LAqHUKFTFDpQ4agoHEGF46JwDBVOisIJVIjdJC3K6VazKeBwV9StmofqZ3BOy0BWDWQBoGQd+8FdySv/DeRWAC0GaLWO2zLqINGb+utA9exP0Y8KACoGjTFAidIMAwC4PBKowOpggIVqxQMid+2QQNpovagAVoIyMKBkrWIDkKAxPOAp1BECT6ERJPAkijjhCNcKFSB4U7TA4ZUBA4dXxwwcgTpsoAQdIgcpmSJ4gOg04geITDuEkBMro4ijOlsEEhIiRSwhUmmEEyKRdkQhI9UIKgDShriCoptCi2M5HF0cy+EA41gOxxjHcjjMOJbDkcaxHA42juVwvHEsl4YcR0hz1FH5ckXgweKaYo8KqRF+8FjrabIzGUuiGZWQNkEJeWRMQnRCEtIqIiEtAhKiHY+Q1uEI6RyNkEcFI6RTLEJahiKkbSRC2gYipHUcQlqFIaRVFELaBSGkXQxCWoYgpGUEQjoFIKRb/EE6hR/kEdEH6Rx8kA6xB+kSepAOkQfpHHiQznEHaRN2kOaogzQHHaQ55iDNIQdpjjhIc8BBmuMN0hxuEGW0QfSCDaIXaxDdUIPoRxqkVaAhCyqwZlCB2wQV+JFBBdYJKnCroAK3CCqwdlCBWwcVuHNQgR8VVOBOQQVuGVTgtkEFbhtU4NZBBW4VVOBWQQVuF1TgdkEFbhlU4JZBBe4UVOBuQQXuFFTgRwQVuHNQgTsEFbhLUIE7BBW4c1CBOwcVuE1QgZuDCtwcVODmoAI3BxW4OajAzUEFbg4qcHNQgZVBBdYLKrBeUIF1gwqsH1RgPqhgsF5QRQHi3Oz5XIjAFc+r4jkOF3c88ZyjNkBUyqOAPUTeEQSWLthiY4H4cwhemu2QYGFCwJCjqP43kYjNnwsUxUYNTgIgcqmwYAX1IoHOWPp75B1yJHbvw016mj2qfUOo9cqYF9SXu6DuF5fDeAuoI2AjEHlI7AAAgp1dx8HWICCkBiEiG20ChDeaBSCK2jJkRI3GQYma7eNJ8pZeYJBmmyEqkyEqiyGaBkP07IWozIXoWgtpZSykra2QDqZCOlgKeZShwEaBm40Cq4wCq4wCaxoF1jMKrDIKrGsUuJVR4LZGgTsYBe5gFFhlFFyMcfiT1fNsiUM3BXZ0LmMJFNjfuQwOeVmnLbrLbIuuvlxyNChavhJUSfdEy0AGaSMxaSUw0ZcXlq1NLxu4lWyYkY0pTdwgMRIU+8vDMgxSY+kSH9+fGm4UYWQk90mKyMlL7Ad3V+7iY/br6zBIT9wtCvy4F6B9+fPJGuEtSv2Fe/ypF6ANOtnMN0G6OYnDeZiGJwH9J0GrEPU2/okb+y4+OUrBSScItkJhvPLdEwicSWdIWiStoqASCUTsIvTYb+LTBwzRqzBIQuwmJyQM3EVI/xNmL4o8o03so5gjzZ713lOVFgAZfaVghgOn9mN/yNqedxzDA+hXKXW6RthNWPL82UlDNxVFur2a+gSxdWRPIOg89MLAZ7Avs0e9q08QfuFiP0lDhuBV/kxCsXJjl4SBxwpfPARFcpM7FG99jFk7qT2HyIpG5FdE+amLfe5a52ViBGFMXFwH5k9au/Ls1UO3Pg5bVPkk/pmGs9pikNZSgDVi/RohVbPYeo/s8oT9ULjPnCkWrjtf7iwGYIkAmwHYImDAAAYiYNgs4nLnMABHBIwYwEgEjFXNnDCAiQiYMoCp2f4IOWew0h7hEVCn8BioX3gM1DU8BuodpcTLncNjoD7iMVA3abR9wmOgzuIx06f6dj873OV9SNRdSNQ9SNQdSNT9R9TdR9S9R9SdR9R9R9RdR1r2nLyXVL7PwCqBLQOrewmrewmrewmrewmrewmrewmrewmrewmLvcQtN0eb1IhRgtKDsUPzOz+lQTxyYzdYFF/WMkj4wD/kumiTpmGQszk9zfDLcLFJDD8I+OOptRpFaHHLGP0ye/61dz5zvrYO5WfchbO0a7ssE8/Zrgdl2VAsG5ZlE7HMqeobiYWGXRXb/Df21/Q7OOvSBoTCcUU5Finp1f+1r9WzxdnCfgapLfGLsC0r3XYtFNflA4rrEgLFrIw8gIq2haTkgev8lGYBoD/z5eW3HgpI8WvreX2dzcYNxlMrhuynVgyZUK0YsqJ63bAh1auHbKlWDppTrRy2qLoIcqOiKLVdMcKCpsWIC1oXIzBsYKzIchsThYbNrMavwdJqqNLYniQoWdNgoskASaP9kUbzI43WR1TGR5ptjzSbHlFYHtExPKJjd0RldkRldURpdETP5oieyREdiyPtDA42LtxoXLjRuHCjceFG48Iq48LNxoWbjQsrjAvrGBfWMS6sMi6sMi6sNC6sZ1xYz7iwjnFhwbgYYBq7izvkHTBKUxQbNG9IA6++hQgINFLKjIcbfdORERC0cgX2NoW3vt+t4BgkAr9mcQEKlcRQJZnQT3MRV1lT24aQ1u0gXZrRIDJuKzJuLTKWiMxQ4HXxPYdsEbEcKwIm9VOMWEzfdgTYIozuOZTT2j5LkTLbUUslwGSCiUDnacywEphoyUs0xSUtpW2SDGtJhjUlw6JkLI5+8DBF+9Tw0CKM3WzfrvjGS2EnwpNTd5H6WwQUZO+4wPM1vZsVeC7hv/UTP6XbjGI3SPxyR3IY9/qWk/SQmyDDD/6SVH8INyltPP2kdi//iEVvsYljFKSvKBOeLkkP9J88d22k9xH06k92+UteZWTALLyz6uVCjEB2dq3cBsoHtfIhUD6slU+Acqde/wgAjGqAASTBuC4BVMWkBhhBMk5rgCkkQ37h0BHUaYE9747sfai5RzgI1CksBOwXFgJ2DQsBe4eTBewgFgP3EScN2E0sBu4pFiPvLBb3dFsOik4kyj4kyi4kyh4kyg4kyv4j6u4j6t4j6s4j6r4jel1H2vWcvJewspewspewspewspewspewupewupewupewupewXi9hoZcY2M46yNS6sw8yfe4GB5kid8ODTIM75yBVHbWEspS3gJ1hl2U2UFZyFV6Nd/Tr5qXbEMvKNgwGYlnZiMFQKBuWPIciT6csc8SyUVk2EsvGZdkYKCvbNxbbNynpJiLdtCybimWWWRaK421npGs/9irl9AfV/0TkLszRSdm2UX9U/m8swLM8dQ6kP7aemvM5V26vx1LIZI+lkNUeSyHDrdUL224NAJlvTTLIgmvFkBEfi0E7rhVDplwrhqy5phbIoI/FoE0fi0GzPhaDll0rhoy71ieQfR+LYROv94nEyuuq0zN0I9C39SOW/vY0kU0WszQMAdI0AkjTACBN9k8U5k8arZ80Gj9ptH3SaPqk0fJJo+GTRrsnjWZPGq2eNBo9abR50mjypNniidLgSVt7J/rmTvStHbZs3GTZuMmycZNl4ybLxgrLxo2WjRstGzdaNm60bNxo2bjRsnGjZeNGy8aNlo0bLRs3WjZutGzcbNlYadm4rWVjfcvGjGUziOpjQTSzNMfHrwmdFg8k8PzLQkd0/rsEnH926ND4GaIKnIVZFVRc16iAe0FmY6+Qes/LbeybJd/zshv7Zun3rPzFN5kk4HuxBfeKFtwLLbhvbsG90IL75hbccy247xbt8oaVXSXWxrYYAg3zYvBKC2PQOkZWJ9C2M45Iy9Q4Gg1r4yh0DI4h0bU5jkjL7DgaDcvjKJ4u9hQskrQ0SNLOHkkbcyQtrZF0MEbS3hZJW1MkrS2RdDBE0t4OSVszJC2tUMficEuLw+0srs0UWwdrWRzuYHG4vcW1nWpZAi2Lwx0sDre3uLZTLksAxGpJ6qb+4hCFxcpf/juLiRF26RLkEVU+gb87c8SVT1hcfqtBBcp+bf8F20xQ6ti1ZGeAzeIz0IYWMLi8EU/zgdi8ZUSzYUS7XUSvWaRdq+QtwJotwNotwHotwEIL2DGSZXtDugskvReurzGmx7L+lC+c1Ar5+2GMca2Qv1vGGNUK+XtpDKdWyN9pYwxrhfx9OMagVsjfpWPYtUJbVMKxUFCC6RwLTUEi064X22J5Vcp/+ixM3RQZQyffbbAMY3KaP/tx6Hho9RyET00RPjWlcGsAsLcGcv7WBKjAmshrsG2gBtuW12CPgRrssbyGgQXUMLDyGtr6ykrr2W0hrRQPUjTrHiRRqB+mae4BkEbRCTBNcz+ANGVXPInHP/YPads9pHXvkPadQ9r3DWnfNaR9z5CuHdPYCbhtJ+DWnYDbdwJu3wm4fSfg9p2AGzqBoZljd3FHnXm2vey0/hHy6XMIOoGgExA6hqBjEDqCoCMQ6kBQB4QOIegQhA4g6ACE2hDUBqEWBLVAqOkAUJNv127t53Nvjl0nOMP+cEITzmKX5fCJDD4B4WMZfAzCRzL4CIQ7Mjjc1KEMPgThAxl8AMJtGdwG4ZYMDnZpgf2byZ/XDJAbGwzEsrhgz3PjO2MVu/clYjAY8GcIPAbgOFzEVy8cj7nQN/HxFsVlsXBhIs62c7Mg13U5CcIwYERYLBYQmzoEIQToItNuCVkO6R9A/RVguQSUFSOvqmRsDs0l/2LjHemH1mAEScqAxrbDNSeM3WBVE2MkHBxehbjGYS4c5r1HGIe7AkHBkBQM6G/L+dJyue6JNnGEK0EcZC9cB+LE4tzhaLC0AdVFdCt0AfIc05xwuluHKYOhCpxzPcSWT0zYFFiUO/DGoNkjFFQjYzAeOzZv2XXA1J2Oh7Dd1WBThOYLrl2Bu70/DlJrPhwC0szxBh1Bw+GUPxJdKx84Y+SBfVpHTUeLxXIJoFDCAhceEoA7N1kjj4EtR8vlEoEwRgVosvSWDojjTG65XKA5CGRGiLf0hBuQcBhXB3lzYPEbp7WVUUUec3dxt8ov0dUIQkrCiYJwIiMcKwjHMsKRgnAkI3QUhI6McKggHMoIBwrCgYzQVhDaMkJLQWjJCE2nmdAEtFNFQQKlKiCqiCcaxBMZ8ViDeCwjHmkQj2TEjgaxVGFDDeKhjHigQTyQEdsaxLaM2NIglpmXSCmGY/OVUYvIRAIhOJuvjGN8JuKFUG2+MqpoTYQLgdt8JYMKYdx8VQZpIlgI6uYrg4nrRBIhxKOSV1GeiBcCvqoKCYEQ/pW6zwM8kQAKBucrOZyfLucrI3sRjtwYBalIUSuE+5hOeEA7gAhzvpKAoXizUpSMRIw+5yujCEAhvBiLUjOi4SiEFiPT+aoMAgS8GKdW0stIwKh1vioDUmAQADFsVYuUCopoy47LQk2RBIpv5yujCnHh7puLJihFi7HvsSEyGjESPvoYGsEBTgmIi+crORyIkmuDFSaCYmY6YGnYDPlVMYIuW5GFqxAJEE+vZGgouq7aIKGBYu2SBiVSMijypm6nFnwD3QjE4UcimZKhqPxIJR9gQIx+JJN5FTFin6+qcF0gACP37CBrPldCh13rxdnP0ixIjjzOvHJuNQzDUpiYc3g1N8s5HiEMQ2HmztHl5C3nVyEYdsLMnoObWYlshFk/B+azuJxRUc6wEmKCHFoPC+QMGRTDVogbCq2UoUOD4ioIw1AILOoSNHOsYRiWQuhRs60snFDYX45hWELBSU6hYAjxWi6l48yYmoqhRhF1dk1v0HWyiZLxRM540sB4rGQ8ljMeNzAeKRmP5IxHDYwdJWNHzthpYDxUMh7KGQ8bGA+UjAdyxoMGxraSsS1nbDcwtpSMLTlj67l0pDUOkApRZ6zKE9RJJ0rmk2bmkwbmYyXzcTPzcQPzkZL5qJn5qIG5o2TuNDN3GpgPlcyHzcyHDcwHSuaDZuaDBua2krndzNxuYG4pmVvNzOFBVAR6ct4loM66KTpcKQPEFRPQaWRpKjqdiJGFyeqQxI/19E5jFXwUqZEKqmjVMWUdJOMviTDnKzXvRr6SkLNKNzVyZiJEjbxURagXhvJAWT2SoLSe0mpWPheaaqS/OAGVVQixqkbGjDVwRaDJwmR1yOPY+UqjBgVzSWDLJOYa+ddxklqkaTzW2zQH0is+lm61MMUxmejUNNGqadJc01inprFWTePmmkY6NY20aho11+To1ORo1eQ01zTUqWmoVdOwuaaBTk0DrZoGzTXZOjXZWjXZzTVZOjVZWjVZz5sckGrkckF+63VBjtFEp7aJdm2T5trGOrWNtWsbN9c20qltpF3bqLk2R6c2R7s2p7m2oU5tQ+3ahs21DXRqG2jXNmiuzdapzdauzW6uzdKpzdKuDR7d5QqbIlFLEWwuD1h+y/GNzAQ+0MpcPYvYyO0I4XiKS3c5Qb56J2dYlHPcxIW9HE1X5eS8slKOk7jol2PzNQY5r6K8zk1cEqzrRMWQQbFCAsuGOU2+BijnWZSz+XdgUbEugYong2I4QwuPNYONJDeB8hCGJ7zzjhKUi5NylhVCMO85GNc3c4M4iauajI4a+dUwHFdx3ZNZn0EoUK7hUAy7KgSsjJZLK40MIV7Asim7zNDIsQ5i12GAldWchC6uyhlmpdyymrjqWtMPXapUqDCDcDzFZdkySdvETuQELdnWVdPMr4ZhtQcs6tYoUKLFuIKxK0/Aym9OVFv8lXOug1hbBxaHGRKFKTEohjO0gMzQqNwxC+NXpYRVZoaocYasYTiu4jo0m1RrZFsHSdMm0ghhvlJyb2IsDxnqW3o0sj7NlchiiGoTUGMNTCSht1uoom2OK0qAnLUsyqg2GDUyZ4xQYycSp1SNGoDgQ2/7UsVBFTYcIdK0rTQw4fY8abREUZU8UqlvlFIPttrsrbepqiJXRy91UNO4m8tylEr2Ctay4IbZuKXTF83VyKIdZq+XzorDcQ7Q2xh2HLvKGhTMpfEQu5lMK+vdVJE8QCo2oDXWUAuT9PapsQpWRDkrIW7S29lWS4sp+DeylsdUzGY4jS5orEYeZPH759Q1oURRmTzqYnfdNVYlxl56e/R4BmoLBoIxvY19PAeN+QqKzvS2A/IsVHGJEK7p7SCs6JWryDWMpAJwTTlyzYPkK5GRa1VFfdsRrsaMXPtYDhUPqmILKB1WpTZQ6lSlQ6B0VJVOgNLxsV7xQs8IVw026Of9hVZjiy0Hm45tDgRhBiwGUAIeshBAE9hhIYA68IiFADrBY04WQDHxUTFx9l0XXjOxxQFA1cQ2j4JAAw4EKCcechhAO7HDYQD1xCMOA+gnHvPyAAqaHxU0D9M0JIKG5haPAFU0twUYhBrwKEBJ8yEPArQ0d3gQoKb5iAcBepqPBZkARaVHRaVhJGgptZhiUEWpzWIgyICBAMpJhwwC0EzqMAhALemIQQA6ScesHIBCtqLlzJo0tJXY0Uypty1sWjOVMregsc0UCt6C1jdTKH0LmuNM0RFb0D5nis7ZwgY7U/XYmp8lZs2+cQ3OGjMNf7mGppKZ2oWugellpnSqa2DGmSnd7BqYhGZKx7sG5qWZ0hWvoalqpvTOxKXfz4pXfsD3DXGtsgTqAOLaVTFUOihLLaBwWBbaQKFTFg6BwlFZOAEKx1WdQENx2VAwfiHYYorBJmObxUCQAQMBGo+HDALQAHYYBKAGPGIQgC7wmJUDUEhcKQQcmyS22HJQJbHNgSDMgMUASomHLATQSuywEEAt8YiFAHqJx5wsgGLmlWLgeIXMLQ4AqmZu8ygINOBAgHLmQw4DaGfucBhAPfMRhwH0Mx/z8gAKSisFAbMwSa16Kaia1GYgEGJQRwBKSYd1AKCR1KkDAHWkozoA0EU6ZmQAFLEVLGXWoJgtbDYzlba2oCHNFBrcQpY1a1bqFrK0WbOet5DlzZpVv4UscdbcG1vQMmeKHlpzXn/W6PLW0CQwU3vBNTAxzJR+cS3OFTOVo1yLs8dM5TnX4nwyU7nStTjDzFS+dQ3MOTPA27a9ljJys/sY5fmWeqkk5cJAwKxLHQEmXuoAMPdSB4DplzoAzMAwMoBJmBpClocRIJJUjIgDszECDEzICCgwJyOgwLSMgAIzM6JcYHKmDpPlZ0SMJEUDAMEsjYgDEzUiDMzViDAwXSPCwIwNIBuYtKnjpHkbACRJ3UBIMHsDAMEEDoADczgADkzjADgwkwPJByZz6kA4n8MjJCkdAQZmdXgUmNjhQWBuhweB6R0eBGZ4BJnAJA9kaYo8j9TudFI9MlPUyPZIjFOd8JFYqzrnIzFfddpHYs/qzI/MwDWSP+LspMz/SGYrvRQQPIVpZYHAaU0nEQTOdDq5IHDy00kHgfOhTkYIniK1kkJG9nlpSV6oVihJDdURYHaoBgATRLVyMEdUKwfTRLVyMFNUrx9MFh0BsnwRj5CkjAQYmDXiUWDiiAeBuSMeBKaPeBCYQRJkApNINZQsjyRAJKkkEQdmkwQYmFASUGBOSUCBaSUBBWaWRLnA5FINJs0viRhJigkAglkmEQcmmkQYmGsSYWC6SYSBGSdANjDpVMPBeScOIEk98Sgw+8SBwAQUhwFzUBwGTENxGDATxcsDJqMAy2rOR8nMTCMlJTE8dVYKtkRlYgq2TGVuCrZUZXoKtlxlhkpiyeoklTDbqPJU8OSjlaoCJySdbBU0R2kkrKBZSyNnBc1jGmkraGbTyFyBcx2UvHqSD3nQjBZpSmgRZT6LqNJZRJHNIopkFlHksogilUVUmSyiTGQRvTwW0UpjEZ0sFtFJYhGdHBbRSWERrQwWUSewiGb+iuilr4hW9opoJa+IVu6KaKWuiF7mimgkrohu3opopq2IXtaK6CWtiF7OiuilrIhmxoqoElZEK19FdNJVRCNbRTSSVUQjV0U0UlVEJ1NFWieqyCPyVKRzmop0zFKRjkkq0jFHRTqmqEjnDBVpnaAij8hPkc7pKdIxO0U6JqdIx9wU6ZiaIp0zU6QhMUVUeSmiSEuR5qwUaU5KkeacFGlOSRFFRoqoElJEKx9FdNJRRCMbRTSSUUQjF0U0UlFEJxNFlIkoopeHIlppKKKThSI6SSiik4MiOikoopWBIuoEFNHMPxG99BPRyj4RreQT0co9Ea3UE9HLPBFF4ono5J2IRtqJqLNORJ10IuqcE1GnnIhGxom0TDiRzvkm0jHdRDplm0inZBPplGsinVJNpGOmibRMNJHOeSbSMc1EOmWZSKckE+mUYyKdUkzkKTJMkmwSbsomYWU2CauySViRTcKKbBJWZJOwIpuEVdkkrMwmYb1sEtbKJmGdbBLWySZhnWwS1skmYa1sElZnk7BmNgnrZZOwVjYJa2WTsFY2CWtlk7BeNglrZJOwbjYJa2aTsF42Cetlk7BeNgnrZZOwZjYJq7JJWCubhHWySVgjm4Q1sklYI5uENbJJWCebhFtnk/Ajskm4czYJd8wm4Y7ZJNwxm4Q7ZpNw52wSbp1Nwo/IJuHO2STcMZuEO2aTcMdsEu6YTcKds0m4IZuEVdkkrMgm4eZsEm7OJuHmbBJuziZhRTYJq7JJWCubhHWySVgjm4Q1sklYI5uENbJJWCebhJXZJKyXTcJa2SSsk03COtkkrJNNwjrZJKyVTcLqbBLWzCZhvWwS1somYa1sEtbKJmGtbBLWyyZhRTYJ62STsEY2CauzSVidTcLqbBJWZ5OwRjYJt8wm4c7ZJNwxm4Q7ZZNwp2wS7pRNwp2ySbhjNgm3zCbhztkk3DGbhDtlk3CnbBLulE3CnbJJWDObxBAGx0P9BqTo4Hiq3+hDxdWxfsMCSqtz/YYNlFYH+40hUFqd7DcmQOn4WK9oiAF3eB9uGnd6H2wfd3wfaiR3fh9qKXeAH2oud4IfajN3hB9sOH9GH245f0gfbDp/Sh9qO39MH2o8f04faj1/UB9qPn9SH2y/cBIfVoBwFB/UgHAWH1KBcBgf0oFwGh9SgnAcH9KCcB4fVAN75B7WAXvmHlQAe+geaj176h5qOnvsHmo3e+4eajR78N7odmo4YM6pyLwdAwEdXh0B+rw6AHR7dQDo+eoA0PkxMoD+j9+/KnOBIg70ggIMdIQCCvSFAgp0hwIK9IiiXKBTFE5iyPwiAARdo4gDvaMIAx2kCAN9pAgD3SQgG+gpxc3pMmcJIUF/CQBBlwngQK8J4EDHCeBA3wnJB7pP7niFzIMKMNCJ8ijQj/Ig0JXyINCb8iDQoQoyPd1m9qC+70rqZ4nKzRKFlyUKJ0sUPpYoXCxReVii52CJln8lOu6V6HhXouNciY5vJVqulWh6VqLnWImWXyVabpVoeVWi5VSJnk8lui6VaHpUoudQiZ4/JXrulOh5U6LpTImWLyU6rpRoeFKi4UiJhh8lGm6UtPaiEo+JlR4TqzwmVnhMrPCYWOExscJjYpXHxHoeE2t5TKzjMbGOx8Q6HhPreEys5TGxpsfEeh4Ta3lMrOUxsZbHxFoeE+t5TKzrMbGmx8R6HhPreUys5zGxnsfEmh4Ta3lMrOMxsYbHxBoeE2t4TKzhMTHkMRnUIsTYjRJ0mIexh2Kj/P20/GFWFCSRuwC2uCVp7EfIM9hvMAfp2lisfez9GHrec52PMVd8jp9ZVjIRvrfMCaPHRfiicsWl9qlkJRfom8k5o1wYJQfVFxILZp4b3yl5NX1HlfK5Q4cU7VPDQ4swdlM/DE6xHyAjXcfhZrVmCTaBh2JaLNBUJZzhhYacJggD1DqdlMtMX6M6iA2RaUgOkWXCP8l7W9Ei0qlBpFN7SJfmyEXHnUTHnUTHEtEZirRAudhfBac0KODK43p5Nk9ygEUdsEBBimIOcVtH3G6S1F/et7bmFFe2JZeVg4DichhYYg5UCv0kVpxig9SZgw0h6nYQjWaQ9q2AJVaaiYHVEmMNiTEoMYsqbS77YP0yjMnpwo381MX+A+KhmIficIfihZsIyA2P3EQRjAx4ZCf/nNZsUaslAFreGAAsbw8AfjqvnR5tVa+ZpEUrSYtGkg5tlLQHt2lPCxM0cIv2YLg9DG5pjE64B2vkelmUsQwDGns+oFMxxF8aDk+XbOYAqRjSL61a8UAstmvFNvTisBzUEBbw0rAcMgCIhVNHiMWjWnF/MgYYjOuIHNB2gC8NmumWqZ+OOUUPANS1TmAZQP3AIqCuYBGS3mBBcIfwGLBPOBDQLSxC0jMcKMc8iauiPUakHUaU/UWauouoeouoOoto9BVRdxXR6Cmi6iii0U+kbTdJugRLuwQruwQ3dQlWdQlWdQnW6BKs7hKs0SVY1SVYo0sw0CUMhiA32cTocBwndOyAGFrOAIcyYODGcbirQW2Bpx94KEgbd1POsukuB55aPIOEuBgbCzcqnMMSuSmtO0Fp6ger5PRZQhbRs1lWuHVj3w3S0yMRH89ugoWbokO4RfEy+2ag73koyEWoHiKM/Sjxk1mW78iyPeg0CHexG7WeLSpdJdq657AK9bNoWQ+obuTT7QQjeMp+oNy6d8WTzA2lMol+95A2vUN0Ooc8Td+Qp+wa8i/rmYZewPq9gNv0AtbpBfw0vYCfshfwY3qBYVmSGIswSF0/QHHF2rg/TRZxiDFLkb/KMytL7iYN2c299Amn+tigDw8qGGZgFfvWHjYXk9+Q1UJSYd+SVFiokqdxQ0UjSOc2EN0mkLYtkEuLO0uLdaXFgLSsKNiPDvSf0xgt0h+taN+r/X0+Y4pOan+fz6Iw8bME6tLfI+9/+SQK49QN0tmfVYk7T0K8SdGxsL15Yj+idvNfKePTmCQVnPwbyS2TEf/XysgIsEuMIIyJiw+sA6aP+CQ+dcoHwE8zsChGDCaK26cgK6Go3enJJSJB0XgYle5JjO0oMtGVmGgJTFrK2yibbi8LQFg2LMrGYLbG3E3QYYvi1F+4uMie02fiGtHWIL7HQ4nveVgApmHEA9MwEqpOiVBzFhe1Nse8GdR09FsCoKWNAbBweyAR8iY9iQ0X7SRtmkn0W0k0G0k6tVHeHtymPVi/PVizPVjWHgbr+eQQRu7CT+9PrVmWPM9dePGw17ecpIdog/xAIIW+y08f55/iL/n2nfaM3UXqb9GRxaSZRbjh1tdWONwdWtVKKaD2ZM/ZBrVU1Nr3UL7XotfP/lMxMrsyKvRT8DuRobLmqEBZ23jJWjbxuM6ecYP0yEOyf3WX8lc0YWaQ8MEIk72RJ+1IGKZrP1id0j06ycLFaGbs0PzOTw26m2XpLpCx9RN/7mPaouI9sKHouHSU/YTdFH3/0Xxe10QF6fVtue3FEkuKK0s6VpUJ/qPVN53nAJdiGPDw/hQAG9iNV+jfVUt+ACsql1qmrqJUpjRbqga55ng9R6FP38qKOhabOAnj0+Ihi0zWrhfuDAh4DIpjhF1ac10fLsa9vpP0Fpu5vzDm6MFH8Y99a+Sc9CfDk/5weGI9l1d16i5pPqHYQxe7nr9JTv1gjWI/nc3DvZGDT82e2bNG0b5nR/teffuW/XxGkxc0AfPs2WyN8tPFpvnDrDj0fXRHQgQ/K5I4gDNQNGiWT5BZPQ9ZAmh/algNzcxMIG/siRxVV0nlrFiu85XhBj5xU3QieQ6ZW620Zm55m/ndcYwnFO36wTAPZZNNvsiqiiy+yK6KbL5oUBUN+KJhVTTki5yqyOGLptNpVTidToFiplwAEHd/lNYajoeTwWg45lGFmVbI4ncR5qe+iyuYmNh4MDZBgo6Mst9YSICSFNEOiu6NfJ7JLL0X4hMtXKQH2+BD7Ve6hAWKcVxVq9GurRN9rN0CO2iBHbbAOi2wI04vNqwY7CepEaMESXumhsiUnaRGkt5jZKT3Ecq2dczYSyuabo6sd2Oehe5FP0X6Keu+kLOuc0xQhGI3DeM6U8rE6jsSOp+sej5ZHTw/ibB7fzrH4eJudnxDyhzm8UeJDoO7pOceio3DA2eMPK/upEUv1cQHcoccopj08vqmo8ViuWxTH32Rj7JJbE9XW2k8Usxn83A/K2/w6hW50EoVw5EZ7WfVzag9m/4q000YZao0UndlJGhB5aqUvMRoP6P/GJ4fo0Up84YE/ALCPXXn/XkaGNThRyKH077Vs3NJJVe2Fkz+/HOeBuwlH9Badk3sQ/aiZvgpIkmxI3BWqSm/1iXa95IQ+14+wTvTk5FzMrFO+oPnMy4iYQQvJbVMGh/Qf6zyHyFQsROpgIUZHKdDZpu4aT+XUlamOh9ajpWv5ixd4uP708QNEiNBsb+c1XYPDKOaXZg9J9pLefeD0PBQsuglkRscllicWmvgXrJdHfgmD5MGPF1r4sWnddjqVli0Fdmvu9wrDk2zZs5U/dKK/+7526IxPatnOSbA6rHr8fXEzNCM9s8PMmlyNZxoCPuXXJV9P1iGf/6J9imKAxd74UK1QTlyY5egFMV//kmdf4MVkTAIsxbW1W9zOhvV1O9E+54pqS1b7ItDzH5ocCy4dLR3SYRR8uefqZ9ixPn0miB0FmEkGZvVnFMM7Ub2pT9rnmEEfDZTo7i+y2UaqyVxYhlnI0EYLdKqoX6QzfnlHKbZnoKL8MBAGBG670Xm4jn8sZ3YnSOs20zpdVMF+5bqfnptb320M8oA645/1ay8ohPtjy9sx/fOMDodAG7dSWblQr7E6xSvmLVJ5m+mac7YF0/q0biXzgH3vmlNn9ddtyXz3b1+6s5LpYnzrKUkyn+m06XwOl752KEZ7VswOl36cZIa4TILNg8sn57ZM1vw6hdJuvUwn5mEvurOq8wJHCdimt6ZVacUqWmI7/y077JAxDF/gF71xczObz8ajvlD9S5vS2Kuvp8YYYSC6oGRbAhx4/uDLHyhliVpfsWCHVVQbHRsPn/0bfKctVILyItYz9kgiWb1C0059bhzUsRMreT9e+6SAKnZOYy1e2VAwc9oR3+SjbU8hO4g6N9zC2VCkwZzh1n11kMoULI0AyX4Il+xzty0DILSdejVxwDgrgbZQMhEWi6XHSK2TMHVcs+kbhc011a8LNYP8eRPGMsze03HKaUtDLOXS6pf3zvRI4ncdN0C+uefHopitHBT5D3CVsHga9QQfOUGNtuFsWfMY+TenWb/GvSBGJuORxNZbPrfrjR2bP71lz6bLF42knXsB3dZBqJs7sLFix+pG+sZPSsbiEbPolHC81Zhu0atFpd3+OuvjlrQP8ep1W8HdkBqcqDvgLEfZZEb5IZ6Vv7arnYCA6ltakkCjiSNt3NHt6m6IaIkPLSbwkNN91suG3KCMMFpYViTaP8Ynv0s05aGxgL70Tx0Y694P7BlplHxjcIkFbIV48GJbQ5PrOEwCwGOlxZQgxlOF4upqeaqNR11ZnboKlSn0FGP/0bUpO3YJ9bIOhlOAE0uF641UDPVUmRXXoeOInVTow57D2GUIlGTw+nJyKb/BxQ5HaAB0mGrp8vu7A7dBeuoUY0aVkg0zOn4xBo7J7YDDfGR5S6XaqZayuzK69BRpG5q1GEfueliLShyYp7Y9vjEmkKKdEw0WNgabLVU2Z3bobNY3dSpUwHlKmiTTjnWRGKWU9Oyl0jNVUuZnZkdugrVTZU6/MMsoktEbQ5OpuaJNRoDyjQ9x3XHWnz1Xiwfwe/wCNG6aVWnilrwDqZRpvYPgF7RnP6pNoz0R7qVaGk5596Z5QGSVZ9bN1031FITcLFGBCWHthmlnKzXL34w8lUGVZZm6eOUNuL4RpU/Mfwgi+LyLK99zMV5E89D4/Ktwc6YMkuG0jVXL9wFOHQ9YxNjo1jk7fWXro8R9/JdCpWXFe9nMfJ0+NEnfrCCGRaFB9mtYCT0EDaKXD2T0efT7qk7Z9PQx60H+a4D2XqvO+9hn3vl5N7I9DJ79X3F0b65PjhTDX/HLGOuSD9DXEV719tXJuaWczloSijfYlEYVLSHhChG3YHLw0HQ3nyTpmFAf8u2KtRlzfqssHazSGWV++bq/cE8K6orn0m64Og/qpRDaa7wGmm55mnQRc9maDbI/yyOx7RcNs+tiFk2Pw5kp8nrQE0R9go1tkaKrjcISBF3aJN+C6I2DYg05I9ai69MbUvV2fhJXLRHi02KSvjx+73Zno7GVXWOtEe3uzBz07DJ589Dj2YAY5dUoVnbbTYiC+YZ8vz0wGxZMZX0+XJLvRGaNL1iSbvoogE0o8YoicIgQYnhBwGn60akMCaEcucxw6GY/x2VFP3FJlYfwiqpaAD1Z5K66SbpYuxKprWHm8ALFxu65l/FBH+bmvSPen3BEtcXGqrOZ4duY7dMkQ/N2lcp8w9LIaKq87+vtfUIsJacrRZjxR0Nah69fj3Lzu9kkO1to2Pv+FsRsRXbrevb9Or7SE4HiMhXM3OWUYz6xF/EYXY/6iFL2VOnVsvY1xeJ/zYYDIDtDJL1Qe0dReV5ivV9tEZBkp/oZn455uOZoJo9xZhJLi47uBi3WIuA9NLr56812V1ljKr540WrdUZgLEIPqbYrsOi/1/uBjph1tUstYlbWR4jMahcoiB0s59vLxIK0VjuEDOmPrYD6QmMREuIGnqqR1ftIEegmim0Ifxt7E3s6APfM5IPOZA2uaVmo/WI1tcZCzwNaUXFjZCl9KXBtvUkaqltcGAFdUJm/ltZuxmByt8slvAWDWwx3mM1Cdv29ZwBM/Xyd5RO2Z7IYBAUeo1BNRsXOjaalbyjCedzmDSPflOtA0YdC0iqAqV4erUHVecqrE0tnfOxEwMI1Wi8xNfZ9u+4IrFpHF5urRkI4JUhXPVKNXDll8VLLva6XL7IF6JlGd5pAdzZtr5L0R/ZGTEmA/VfZ9qsT+s9z7ebN0TKM0aGclapZ9jQO0+yHnpX06Fzgxj0/WPqBn6KT2qmuWXuKx50oFBJS+dKDc5L/v5aFLPxplmMoHqVhBNxYPmJSE2x4UU5EZn03XDkMs5FzPAQLn4dzqhMamcX+Z9n8O3S/jF2asSsVd0jD2hHE7Cn60Uo3cfD8r7/+syVeFqoVW5WhLQVwoounNbJdJXkeif0EaQtKw3AXCxSlZRFGcemZmLzsKkYo0Ob6558FV4KSxF2hQ43JrH55n1JSbq/2ib4EzXu7adX8ll76WgBYRZaiQvHBMX84HgFn7ECC4I9ue+gYM2fX3PL3nufylKleCinNHo5H+QwyDt00t4FD9jOURUi2K8NNEpQmh4I3dH4U2lRS7BrsrQdaL2QMrdsPwiwly+1Bh0En4NNT6oOO72Blro+Lxfg9TeDZxaWLMXVttfTzMcgX9nWjOPHDwIhid0XcA5MxLUMyRHpmE9Wff5YjQYwVywszEWGysZKpmTvFeHy1PTV7fRqmK6I/iVy1oyC1V2fHWay1yPMgX/w4hofon2PEQLNUeUDJv0fB70XZCzB3fkaO7CfrcBc0JqDpelA/De9QYCRpXFuBcBxHBgzC1KCe3U1rGQDHcWZNFWXZuVpknc0PkRujIBWnzmIbeOOOfcle6C65ET78qR+usaFTCINEaBv9aySE20nJ9DRlJdD90/MTd46R90e5/Y2q18U43CHvuEQ5EAjLU2zsKQaH08jguSjpwg0WCIvWKXZJtUdmOTJH5oz9TcPNZdW5m3Qdxv4DalNjvplrxv7G+kp26DfUW56oozMADZXYTgEXHzn67eqw9DGG95hRbJGLBkb8cDo1kS20Lnt4fIMWOELnNusvlBKC7Efw8CALKRbI8ttShIFG9dEbNldyil0Jg4w0+8spqlRoNhHl50ol61zsOmUmjnjKUGTXp54PebIbFQCCTcCR9IfQuisdZEVMdSIWJ3AZv1WgAB1cjGsXX9QPlpjCems+AUniG0E26AQ0KyGEkMiZQw/hJqXjDEhxoX3kBl6xAyI5gcqqrGtTRzdxpUOvmXM2OAsnUDtYLa5psIwPTedXWWixn7fmBEz6RyV2AR6b9I9qBsnXf5vubckRfT/Yutj3gPfiZO3eIXoIuGfN4KdMXgtlW2DUWyHFbcttcof1zpblERkfp5P3k+b6jt0vukihHaXOa7JvYvzjM89N3VOfuCv0ItmuftoTPFus3ThB6c+bdGlMTv53sl319gQHyc+/P1unaXT64sVut+vvBv0wXr2wTdOklL8/62Wy/Pz7M2v0+7Ne3jL6m/P7s54b+66RvwP9/PuzNN6g35/9/X/TEwk9ajc///7sB3uwXC5/f5b9bsQbjH7+/RnaoiD0vN+f9byff392NexZ9nq4tX4Zbg3rgTjG6Jfh1lo7X0YPxO4NvoyxMehlf7aGvXa+TNeG83A16ju9SQa0+86XyQNlk/28NSgn64FMe9ba2toLo2/afXti9C2r79hGf9AfG31r2rcmRn+Yl4z7g18GC6PvOD3TsIz+0DEsw/oyWJj0WfZrzzKsNX1gUT6TqWH3bMPu0d9orrTXn0x7ds9eDxYZl57V6w8delp866wN64vzy2A7XVvm1rCpqMP1JOdd1mVYa8MSJEiOxYbVK+XIGNOffnEqirLw4fdnL/7+v2nX/f3Z855j/tALQiNGEXJTxpqzzGF2r5U1Y4+hlG5IWMmsJ+uhIVUMB5rJrruyWg5b5tUUnEsjL6OkSdezGYDQpaSjev5dvNGmyJ5UDiuKkBvTCDTXZnbRF/+Q/53xXGP6p/fUY5U292W4//n3Z3kCv2eb1YjMxpo16A+tSW/cnzhTtz+aOvRvHpr0+tPxpEffNyf90aR4Zvb609EUG4P+1Jz0Bv3JgFKN6d8cYfSn42nPLBBGgahzMCgHsKp3ltmzLJyJZAz61pCz3sxy8o11udes2/IxRs0vqTCjfWngte10Q2s4HCLdtzB2OcR2nv5FLMuuO8V1H4CF/ZNscOpHGP1xEM2lzBU5zKZAB2T0Xz/HMquewjqInU1mbY4TShhZ9NznX+0uzZBw4m73YZoDLTw9+kgw31XZJtB/0szmz4i4Pv7jRFK69DGSFkZuktClVikgQW68WEuLaW7pD/bCKK2O+neS/iioNXb4s5//RoIyhfSJGyMXWKvlL3/wpvQP4McYl8DYNndqXnA4glZKl9GoHRWo0pIKWGhLBcu0BoJK7f2Xe7pcuirjdQJ4cbiwlPhYDH152KV/yolnMpnMxIzaX01VclvvJ5PJX62FKKbNWmoHWELJ9Hiw6AEF84cDsGI68H406FX15on5/C/b/OFkIsXZJWxg/nBC11nHcpbDEjs0fzgZSXEV7K///H9QZpWfAC/ZaHpZfYLrmmo7Buzs9oUyrcJkupoOKZQtKdIyYrwGHI+rxnm2cZI5KysL7h67i6xak6u1mPWoWSNjlLEQ0z+LNVrczcN9FT+Y9A973ZVw4VttER/mVsQk8m00FTBqcTlXtgiXfSF0wd9pVtvKJdPQESIRpebKy2d/NKyXNtL9lF+P0s9uGaobAprQP/zOBPF2JDOL8ku4ZL8Xc49Wlrusr9lmV2lUId0kTzPDu7iEa6CKQTGK9h0brX0HdSPH0+wn5CnU2ebltMoRmfUc0YR5Ix30xj3L7NGHOu+yQh5pSG+2vWhOJY36g8GgZzm9Qc+y+qPRGFv0iUH/HdL0zLv8MRVl0JvQsoaECX8VvovDlbHZH6pEd3nAh/kKS5HwMIvTNfKLeyuGvT7VvheHUcYdPuGTOXj9eqVVkdBzMVePJOgsThiqXp7pK+3AFF+fn9e39FSvaY7JHK4a5G5VureneSMWdLF1o6LL1pfp2Pr+WCc7asFtiJ3Jzxc0sD16YDaSerJzNk11dzjWM5FPgvA+SKj+hsvBZDePFRYG349qR3v9aumHlMIEGdnjJ8vTNSznWVr2UAgn7q7RvIzLNpvCE/Y2XmBd7bGXdA5Ms/mkQ1ZLr187u0uXqU8UmLTacOGa9I8sihAo/5563FlL/duOMmZGGq5WGB205n52e2mhdeoUev0BIkenxUz0o2hfc1rFtsGp6aHV8+NzI4z97LXd/KFXeTnhgw3SK5zrTekvQozdKEGeuFsxq1VOKh4p/VetHdnDelxgD6vpPU9Km71Rb9J3pr1xf2j1rEHfGvcsGxvDvjPpDfvONMsUT/DIGDWub3A54WxaUO09bVxuzbV1uyGRkYb5nV2qj02oGKjui4KnQqMvfh8078n8GLqOFBm0vNcpf7T2g/RQ2wWc/YhB6gwqiU3Gz+UvYYWLL10VvX23l+9wgxtq9SeIzMSdyaqvkeW+IjrUbzsFVdbrR3EYoTi9r859Tafiy1AjZT+KfeJnsXjBYzSnf0Ai5kgpmxCiCZgClsZ94NK00Wg0a9opJ+fQq1yl+MkhjqpslRGHu36M/s/Gj5HH0Tfuv2vgR3294iNZzdSMEOxR+j5/5K+JUz9J3Vhy1wFHRzssSGq9MB6PtfBU3NqOonbtZsyku/Z7/RgFHoqRd+XGd/TQVC9iWNU/rwBtBc45JeINxOI199J0NHBaiOVefeunabcIS1JebHuob6myxWU0iIbGxPq39zcE3lwr1sOGmzpHZr7f8FHHyurphsd8OkAQXOfyfYBIcq2/gGz3eQKevDqJXWw8aqcnaKMdb37AzA7Hc44WI/DcHdNip2G4mL3qyJnW16vaCKToiPHzVszY4Lt2S00rLtgVmZi9tmyqbYFMLx7vQ3Kkm6yc4u6TiVaFNJHLvRNKtjDKtGxBfQ++c9RHuKSSFrY7lDKpvVPVvg0pDzCr+9zoH73hKGWWfXKH/9pP/lEW6K0K4nOU3ti5cVDb8Z+v12k6DPjFWnLgirq+7GruMq6bx+4CGVna4aDa5ktDESNwCWr8XANccU5c/+aG47gAAgUb0nSmPAPlRx4OzBTFwhIU0/Mgf+++A0LnHG29kuO1H8yHl/hDtLJLUApevfWwX57HKePEwsqEqyFKkix06qXx4fiV3EagJ+u+SvDa2R2jvj+UOQRIH2h8ZJavXHLNlMRqeOp8LYA56aTSaH8RkmhDRxldeiu9vXgsCsTnp4dUmRVm3raOyhN9V1FJ4ZCaN93wO5PKazMAU2GPC4JvFLUV9SzTclr+INyhDuuTCdCzvUhpTG2pop61eVM4MpB+IIP+oSfPyh0xvC5zfnluMqll454wTahh36wUxQ/V7XDa7+aFfQN6La/k01Jvg2JrV8HVDBS4DKlgRFuSMzppKl3rvpLYz2dPsRGNTazXjxTSyVfy+aUku39GftHM0cym0x90eRSu6Og2B423XDUwYk81S26ya6Dvk+ol+QBfDFb77FU2f7ftiRHwrTD240fix5m4OqucTPMtfDKq/KVNcsujjIi5maG4YdwRD/SX8Gd8tly9Im6MpP30559VSuVEhvCD6vy5e9/CZUk3PIwa9FmLlis9/qvrRCRK7//cuniD/ixWLaTfNBvUIozSm9hc1OFE+xZ1VUO0ZqnjVhz65Qaz4wvGWLg2EtevrFFf5SY4I4nrkeE1xjtwUxnj/2F0zWL/1WaZhtHcjYFNe9ac/mmanwra8r/w3ZcFxlVdPvMXTFXfe6X7xaFsPUDcN71n9gjwK33ie3gpQ+7wgFVS8b2sVAh02S3wHbSBcJyrvN1In624eRnYBme75mA5k55pPaYEmS14sgm0UZx+8cE/1Ueylib9w32mqxSjtsHDbJrK9SWppxr5+VS8dVlxy2abausRBdgn1d6X6iDVqf0U/cA8Fc/1sUYBbfOsnVlu96GtUUO0OCx297At8YNlWI4wB3A0tLy4X5oOlaW/Yr6+W7v5pJhbuM8NTyYad57RSnprqp6oT1w/OHCXlzWjey7/wSgRHsWo8ftHGQj7JwAl8Cx/mXz0NZ55QyyggrUNPRxAD4fQQ6f9HTA5pXtg7wTQatVM9enfnDX7vWtrOZouAGPLrjpp2jGVMevP3QRlyYzHv/Fq7I6FJGDyqbpGMBhFezXX4vvI3Dnm4dTm/IQzlqWf4S0veUKhfn+1nfsZWWTviPmtZBOhWCF5dQ9NkrokAiKcyXS+NIcKLtmgVbs/1b1L7iZdG/M0gKMBxW2OUOzFM8zvovDCQHXRV0Yoj0kszZikzqZXu8+EScAfe92ondtlM402eIlFfQlMsd2PW6cTlzWbuLOLR9WV8vI10iZmdAG3foIqy1bLdqNxpNWH9LUs6UinPFLWQFAcduNOVTZKieI4jBPooBGSH52gr7dPcWCk2ELKZc10BO7NhVHB39q4cKPsfemBT5svwgglvbX9+Km2YiXMcNA3JYQLk2qDin972QTFLcBAhYaHls27HAolVV4BOPq2cDH6HHGXSNaf6nz3rB7/Fitr0kVsW2Mos2IXvxv8QAJX0EDSpHmzM2iow4YdtVpV5rNswwqahK5acuCvTRbNQMGhn65jeica98VQLRbZf7MqD4pL9iQmyjHOI+p2yQKQRfcvFtv1WAU8qZib/aF+k+NMOEQzef5XGh6OF47yAIs7SvhkTBnd3GSHyuJ+GT8d02jyU1PHpNaJ5HksXLLJ7xFTlcfVTg2ruAy+8/3obcSKkXDrUmEkpmkKifXjihp0NblECrpOqRCDuwhRY2cP89G2bk6pflxQSL3Wdfh3jRaUMG6DwP/3/734j7/1knATL9CVG0V+sPp88+7nI6v+Ikn6xI3+48X/PwCGxYKrRjYCAA==",
+	},
+}
@@ -0,0 +1,157 @@
+// Package swaggerui serves embedded copy of Swagger UI (https://github.com/swagger-api/swagger-ui),
+// Swagger UI is distributed under the Apache License, version 2.0, see LICENSE and NOTICE files of this package.
+//
+// Assets are generated with gen.go from dist directory of swagger-ui package.
+package swaggerui
+
+//go:generate go run gen.go -dist ./swagger-ui/dist -version 4.15.5
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/lazada/swgen/internal/httputil"
+)
+
+// Config controls appearance and behavior of Swagger UI
+type Config struct {
+	Title          string // Title of page, "Swagger UI" by default
+	DocumentURL    string // URL of swagger.json document
+	DocExpansion   string // Default expansion of operations: "list" (default), "full" or "none"
+	DeepLinking    bool   // Enables deep linking to tags and operations
+	OAuth2ClientID string // Default client id for OAuth2 authorization dialog
+}
+
+type asset struct {
+	contentType string
+	gzipped     string // base64 encoded gzipped content
+
+	once sync.Once
+	data []byte // gzipped content
+	raw  []byte // content for clients not accepting gzip encoding
+	err  error
+}
+
+func (a *asset) decode() {
+	a.data, a.err = base64.StdEncoding.DecodeString(a.gzipped)
+	if a.err != nil {
+		return
+	}
+
+	var r *gzip.Reader
+	if r, a.err = gzip.NewReader(bytes.NewReader(a.data)); a.err != nil {
+		return
+	}
+	a.raw, a.err = ioutil.ReadAll(r)
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" type="text/css" href="swagger-ui.css">
+  <link rel="icon" type="image/png" href="favicon-32x32.png" sizes="32x32">
+  <link rel="icon" type="image/png" href="favicon-16x16.png" sizes="16x16">
+  <style>
+    html { box-sizing: border-box; overflow-y: scroll; }
+    *, *:before, *:after { box-sizing: inherit; }
+    body { margin: 0; background: #fafafa; }
+  </style>
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="swagger-ui-bundle.js"></script>
+<script src="swagger-ui-standalone-preset.js"></script>
+<script>
+  window.onload = function () {
+    var ui = SwaggerUIBundle({
+      url: {{.DocumentURL}},
+      dom_id: "#swagger-ui",
+      docExpansion: {{.DocExpansion}},
+      deepLinking: {{.DeepLinking}},
+      validatorUrl: null,
+      oauth2RedirectUrl: window.location.href.replace(/[^/]*([?#].*)?$/, "oauth2-redirect.html"),
+      presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+      plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+      layout: "StandaloneLayout"
+    });
+{{- if .OAuth2ClientID}}
+    ui.initOAuth({clientId: {{.OAuth2ClientID}}});
+{{- end}}
+    window.ui = ui;
+  };
+</script>
+</body>
+</html>
+`))
+
+type handler struct {
+	prefix string
+	index  []byte
+}
+
+// New returns http.Handler serving Swagger UI page and its assets under prefix, e.g. "/docs/"
+func New(prefix string, config Config) http.Handler {
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	if config.Title == "" {
+		config.Title = "Swagger UI"
+	}
+	if config.DocumentURL == "" {
+		config.DocumentURL = prefix + "swagger.json"
+	}
+	if config.DocExpansion == "" {
+		config.DocExpansion = "list"
+	}
+
+	index := bytes.NewBuffer(nil)
+	if err := indexTemplate.Execute(index, config); err != nil {
+		panic("swaggerui: failed to render index page: " + err.Error())
+	}
+
+	return &handler{prefix: prefix, index: index.Bytes()}
+}
+
+// ServeHTTP implements http.Handler
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path+"/" == h.prefix {
+		http.Redirect(w, r, h.prefix, http.StatusMovedPermanently)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, h.prefix)
+	if name == "" || name == "index.html" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(h.index)
+		return
+	}
+
+	a, found := assets[name]
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+
+	a.once.Do(a.decode)
+	if a.err != nil {
+		http.Error(w, a.err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", a.contentType)
+	w.Header().Set("Vary", "Accept-Encoding")
+	if httputil.AcceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(a.data)
+		return
+	}
+	w.Write(a.raw)
+}
@@ -0,0 +1,74 @@
+package swaggerui
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	h := New("/docs/", Config{})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/docs/", nil))
+	if !strings.Contains(w.Body.String(), "<title>Swagger UI</title>") {
+		t.Fatalf("unexpected index page: %s", w.Body.String())
+	}
+	if strings.Contains(w.Body.String(), "initOAuth") {
+		t.Fatal("OAuth2 should not be initialized without client id")
+	}
+	if !strings.Contains(w.Body.String(), "validatorUrl: null") {
+		t.Fatal("online validator should be disabled")
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/docs", nil))
+	if w.Code != http.StatusMovedPermanently || w.Header().Get("Location") != "/docs/" {
+		t.Fatalf("unexpected redirect: %d %s", w.Code, w.Header().Get("Location"))
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/docs/missing.js", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/docs/swagger-ui-bundle.js", nil))
+	raw := w.Body.Bytes()
+	if w.Header().Get("Content-Encoding") != "" || !bytes.Contains(raw, []byte("SwaggerUIBundle")) {
+		t.Fatal("unexpected plain content of swagger-ui-bundle.js")
+	}
+
+	r := httptest.NewRequest("GET", "/docs/swagger-ui-bundle.js", nil)
+	r.Header.Set("Accept-Encoding", "gzip, deflate")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatal("gzip encoding expected")
+	}
+
+	gz, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, raw) {
+		t.Fatal("gzipped content differs from plain content")
+	}
+
+	r = httptest.NewRequest("GET", "/docs/swagger-ui-bundle.js", nil)
+	r.Header.Set("Accept-Encoding", "gzip;q=0, deflate")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Header().Get("Content-Encoding") != "" || !bytes.Equal(w.Body.Bytes(), raw) {
+		t.Fatal("plain content expected when gzip is not acceptable")
+	}
+}
@@ -0,0 +1,31 @@
+package swgen
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/lazada/swgen/swaggerui"
+)
+
+// UIHandler returns http.Handler serving embedded Swagger UI under prefix, e.g. "/docs/",
+// document of generator is served at prefix + "swagger.json" and prefix + "swagger.yaml"
+func (g *Generator) UIHandler(prefix string, config swaggerui.Config) http.Handler {
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	ui := swaggerui.New(prefix, config)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, prefix) {
+		case "swagger.json", "swagger.yaml":
+			g.ServeHTTP(w, r)
+		default:
+			ui.ServeHTTP(w, r)
+		}
+	})
+}
+
+// UIHandler returns http.Handler serving embedded Swagger UI and document of package generator under prefix
+func UIHandler(prefix string, config swaggerui.Config) http.Handler {
+	return gen.UIHandler(prefix, config)
+}
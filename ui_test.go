@@ -0,0 +1,45 @@
+package swgen
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lazada/swgen/swaggerui"
+)
+
+func TestUIHandler(t *testing.T) {
+	g := NewGenerator()
+	g.SetInfo("Test", "", "", "1.0")
+
+	handler := g.UIHandler("/docs", swaggerui.Config{
+		DocExpansion:   "none",
+		DeepLinking:    true,
+		OAuth2ClientID: "client-id",
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/docs/", nil))
+	// escaping of JS values differs between Go versions
+	page := strings.NewReplacer(" ", "", `\/`, "/").Replace(w.Body.String())
+	assertTrue(w.Code == http.StatusOK, t)
+	assertTrue(strings.Contains(page, `url:"/docs/swagger.json",`), t)
+	assertTrue(strings.Contains(page, `docExpansion:"none",`), t)
+	assertTrue(strings.Contains(page, `deepLinking:true,`), t)
+	assertTrue(strings.Contains(page, `ui.initOAuth({clientId:"client-id"});`), t)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/docs/swagger.json", nil))
+	assertTrue(w.Header().Get("Content-Type") == "application/json", t)
+	assertTrue(strings.Contains(w.Body.String(), `"title":"Test"`), t)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/docs/swagger.yaml", nil))
+	assertTrue(w.Header().Get("Content-Type") == "application/x-yaml", t)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/docs/swagger-ui.css", nil))
+	assertTrue(w.Code == http.StatusOK, t)
+	assertTrue(strings.HasPrefix(w.Header().Get("Content-Type"), "text/css"), t)
+}
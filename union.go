@@ -0,0 +1,120 @@
+package swgen
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RegisterImplementations registers implementations of interface, iface should be a nil pointer to named interface,
+// e.g. (*Shape)(nil), fields of the interface type are rendered as a union of implementations:
+// definition of interface with x-oneOf extension in Swagger 2.0 and oneOf in OpenAPI 3,
+// if all implementations have a field with `unionDiscriminator:"true"` tag, union is discriminated by the field,
+// as Swagger 2.0 discriminator requires implementations to extend definition with allOf, it is rendered
+// as x-discriminator extension in Swagger 2.0 and as discriminator in OpenAPI 3
+func (g *Generator) RegisterImplementations(iface interface{}, implementations ...interface{}) *Generator {
+	t := reflect.TypeOf(iface)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface || t.Elem().Name() == "" {
+		panic("Generator.RegisterImplementations() failed: iface must be a pointer to named interface, e.g. (*Shape)(nil)")
+	}
+	t = t.Elem()
+
+	types := make([]reflect.Type, 0, len(implementations))
+	for _, implementation := range implementations {
+		it := reflect.TypeOf(implementation)
+		if it == nil {
+			panic("Generator.RegisterImplementations() failed: implementation of " + t.String() + " must not be nil")
+		}
+		if !it.Implements(t) && !reflect.PtrTo(it).Implements(t) {
+			panic("Generator.RegisterImplementations() failed: " + it.String() + " does not implement " + t.String())
+		}
+		types = append(types, it)
+	}
+
+	g.mu.Lock()
+	g.implementations[t] = append(g.implementations[t], types...)
+	// definition of interface is generated again with all implementations
+	if def, found := g.definitions[t]; found {
+		delete(g.definitions, t)
+		delete(g.definitionAdded, def.TypeName)
+	}
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// RegisterImplementations registers implementations of interface in package generator
+func RegisterImplementations(iface interface{}, implementations ...interface{}) *Generator {
+	return gen.RegisterImplementations(iface, implementations...)
+}
+
+// unionSchema returns reference to definition of interface with registered implementations
+func (g *Generator) unionSchema(t reflect.Type, implementations []reflect.Type) SchemaObj {
+	if def, found := g.getDefinition(t); found {
+		return def.Export()
+	}
+
+	def := *NewSchemaObj("object", t.Name())
+
+	oneOf := make([]SchemaObj, 0, len(implementations))
+	names := make([]interface{}, 0, len(implementations))
+	discriminator := ""
+	for i, implementation := range implementations {
+		so := g.genSchemaForType(implementation)
+		if so.Ref != "" {
+			so = SchemaObj{Ref: so.Ref}
+			names = append(names, so.Ref[len(refDefinitionPrefix):])
+		}
+		oneOf = append(oneOf, so)
+
+		// all implementations should have the same discriminator
+		if name := discriminatorName(implementation); i == 0 || name == discriminator {
+			discriminator = name
+		} else {
+			discriminator = ""
+		}
+	}
+	def.AddExtendedField("x-oneOf", oneOf)
+
+	if discriminator != "" && len(names) == len(implementations) {
+		def.AddExtendedField("x-discriminator", discriminator)
+		def.Properties = map[string]SchemaObj{
+			discriminator: {Type: "string", Enum: Enum{Enum: names}},
+		}
+		def.Required = []string{discriminator}
+	}
+
+	if g.reflectGoTypes {
+		def.GoType = goType(t)
+	}
+
+	g.addDefinition(t, &def)
+	return def.Export()
+}
+
+// discriminatorName returns JSON name of struct field with `unionDiscriminator:"true"` tag,
+// fields of embedded structs are checked too, `discriminator` tag is reserved for embedded structs
+func discriminatorName(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			if name := discriminatorName(field.Type); name != "" {
+				return name
+			}
+			continue
+		}
+
+		if field.Tag.Get("unionDiscriminator") == "true" {
+			if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+				return name
+			}
+		}
+	}
+	return ""
+}
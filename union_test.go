@@ -0,0 +1,171 @@
+package swgen
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	Kind   string  `json:"kind" unionDiscriminator:"true"`
+	Radius float64 `json:"radius"`
+}
+
+func (c Circle) Area() float64 { return 3.14 * c.Radius * c.Radius }
+
+type Square struct {
+	Kind string  `json:"kind" unionDiscriminator:"true"`
+	Side float64 `json:"side"`
+}
+
+func (s *Square) Area() float64 { return s.Side * s.Side }
+
+type Drawing struct {
+	Main   Shape   `json:"main"`
+	Shapes []Shape `json:"shapes"`
+}
+
+func testUnionGenerator() *Generator {
+	g := NewGenerator()
+	g.RegisterImplementations((*Shape)(nil), Circle{}, Square{})
+	g.SetPathItem(PathItemInfo{Path: "/drawings", Method: "POST"}, nil, Drawing{Main: Circle{}}, Drawing{})
+	return g
+}
+
+func TestRegisterImplementations(t *testing.T) {
+	g := testUnionGenerator()
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	drawing := doc.Definitions["Drawing"]
+	assertTrue(drawing.Properties["main"].Ref == "#/definitions/Shape", t)
+	assertTrue(drawing.Properties["shapes"].Items.Ref == "#/definitions/Shape", t)
+
+	shape := doc.Definitions["Shape"]
+	// Swagger 2.0 discriminator requires members to extend definition with allOf
+	assertTrue(shape.Type == "object" && shape.Discriminator == "" && shape.data["x-discriminator"] == "kind", t)
+	assertTrue(len(shape.Required) == 1 && shape.Required[0] == "kind", t)
+	enum := shape.Properties["kind"].Enum.Enum
+	assertTrue(len(enum) == 2 && enum[0] == "Circle" && enum[1] == "Square", t)
+
+	oneOf, _ := shape.data["x-oneOf"].([]interface{})
+	assertTrue(len(oneOf) == 2, t)
+	assertTrue(doc.Definitions["Circle"].Properties["radius"].Type == "number", t)
+	assertTrue(doc.Definitions["Square"].Properties["side"].Type == "number", t)
+
+	for _, e := range g.Validate() {
+		t.Error(e)
+	}
+}
+
+func TestRegisterImplementationsOpenAPI3(t *testing.T) {
+	data, err := testUnionGenerator().GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	var doc struct {
+		Components struct {
+			Schemas map[string]map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	shape := doc.Components.Schemas["Shape"]
+	schema, _ := json.Marshal(shape)
+	assertTrue(string(schema) == `{"discriminator":{"mapping":{"Circle":"#/components/schemas/Circle",`+
+		`"Square":"#/components/schemas/Square"},"propertyName":"kind"},`+
+		`"oneOf":[{"$ref":"#/components/schemas/Circle"},{"$ref":"#/components/schemas/Square"}]}`, t)
+}
+
+func TestRegisterImplementationsWithEmbeddedStructs(t *testing.T) {
+	g := testUnionGenerator().ReflectEmbeddedStructs(true)
+
+	data, err := g.GenDocument()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	// union discriminator does not make members inheritable definitions
+	assertTrue(doc.Definitions["Circle"].Discriminator == "", t)
+	assertTrue(doc.Definitions["Square"].Discriminator == "", t)
+	assertTrue(doc.Definitions["Shape"].data["x-discriminator"] == "kind", t)
+
+	data, err = g.GenDocumentOpenAPI3()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+	var oas3 struct {
+		Components struct {
+			Schemas map[string]map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &oas3); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	_, found := oas3.Components.Schemas["Circle"]["discriminator"]
+	assertFalse(found, t)
+	discriminator, _ := oas3.Components.Schemas["Shape"]["discriminator"].(map[string]interface{})
+	assertTrue(discriminator["propertyName"] == "kind" && len(discriminator["mapping"].(map[string]interface{})) == 2, t)
+
+	for _, e := range g.Validate() {
+		t.Error(e)
+	}
+}
+
+type Content interface{}
+
+func TestRegisterImplementationsWithoutDiscriminator(t *testing.T) {
+	type Label struct {
+		Text string `json:"text"`
+	}
+
+	g := NewGenerator()
+	g.RegisterImplementations((*Content)(nil), Label{}, "")
+	g.SetPathItem(PathItemInfo{Path: "/contents", Method: "GET"}, nil, nil, []Content{})
+
+	data, _ := g.GenDocument()
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	union := doc.Paths["/contents"].Get.Responses["200"].Schema.Items
+	assertTrue(union.Ref == "#/definitions/Content", t)
+	def := doc.Definitions["Content"]
+	assertTrue(def.data["x-discriminator"] == nil && def.Properties == nil, t)
+	oneOf, _ := def.data["x-oneOf"].([]interface{})
+	assertTrue(len(oneOf) == 2, t)
+}
+
+func TestRegisterImplementationsPanics(t *testing.T) {
+	for _, register := range []func(){
+		func() { NewGenerator().RegisterImplementations(Circle{}, Circle{}) },
+		func() { NewGenerator().RegisterImplementations((*Shape)(nil), Drawing{}) },
+		func() { NewGenerator().RegisterImplementations((*Shape)(nil), nil) },
+		func() { NewGenerator().RegisterImplementations((*interface{})(nil), Circle{}) },
+	} {
+		func() {
+			defer func() {
+				assertTrue(recover() != nil, t)
+			}()
+			register()
+		}()
+	}
+}
@@ -0,0 +1,307 @@
+package swgen
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	swagger20SchemaID   = "http://swagger.io/v2/schema.json"
+	jsonSchemaDraft04ID = "http://json-schema.org/draft-04/schema"
+)
+
+var (
+	swaggerValidatorOnce sync.Once
+	swaggerValidator     *jsonSchemaValidator
+)
+
+// getSwaggerValidator returns validator with decoded Swagger 2.0 schema
+func getSwaggerValidator() *jsonSchemaValidator {
+	swaggerValidatorOnce.Do(func() {
+		var swagger, draft04 interface{}
+		if err := json.Unmarshal([]byte(swagger20Schema), &swagger); err != nil {
+			panic("failed to decode Swagger 2.0 schema: " + err.Error())
+		}
+		if err := json.Unmarshal([]byte(jsonSchemaDraft04), &draft04); err != nil {
+			panic("failed to decode JSON Schema draft 4: " + err.Error())
+		}
+
+		swaggerValidator = &jsonSchemaValidator{schemas: map[string]interface{}{
+			swagger20SchemaID:   swagger,
+			jsonSchemaDraft04ID: draft04,
+		}}
+	})
+	return swaggerValidator
+}
+
+// Validate checks generated document against Swagger 2.0 schema and semantic rules of specification:
+// unique operation ids, declared and required path parameters, resolvable references,
+// defined security schemes and absence of "null" type, it returns nil for valid document
+func (g *Generator) Validate() []ValidationError {
+	data, err := g.GenDocument()
+	if err != nil {
+		return []ValidationError{{Message: "failed to generate document: " + err.Error()}}
+	}
+
+	return validateDocument(data)
+}
+
+// Validate checks document of package generator against Swagger 2.0 schema and semantic rules of specification
+func Validate() []ValidationError {
+	return gen.Validate()
+}
+
+// validateDocument checks JSON document against Swagger 2.0 schema and semantic rules of specification
+func validateDocument(data []byte) []ValidationError {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return []ValidationError{{Message: "failed to decode document: " + err.Error()}}
+	}
+
+	v := getSwaggerValidator()
+	errs := v.validate(jsonSchemaLocation{schema: v.schemas[swagger20SchemaID], id: swagger20SchemaID}, doc, "")
+
+	if root, ok := doc.(map[string]interface{}); ok {
+		errs = append(errs, semanticErrors(root)...)
+	}
+
+	return errs
+}
+
+// semanticErrors checks rules of Swagger 2.0 specification that can not be expressed with its JSON schema
+func semanticErrors(doc map[string]interface{}) []ValidationError {
+	var errs []ValidationError
+
+	refs := make(map[string][]string) // JSON pointers of $ref values by reference
+	collectRefs(doc, "", refs)
+	for _, ref := range sortedKeys(refs) {
+		sort.Strings(refs[ref])
+		if !strings.HasPrefix(ref, "#") {
+			continue // remote references are not resolved
+		}
+		if _, found := jsonPointerValue(doc, ref[1:]); !found {
+			for _, path := range refs[ref] {
+				errs = append(errs, ValidationError{Path: path, Message: "unresolvable reference " + ref})
+			}
+		}
+	}
+
+	nulls := make(map[string]bool)
+	collectNullTypes(doc, "", nulls)
+	for _, path := range sortedKeys(nulls) {
+		errs = append(errs, ValidationError{Path: path, Message: `type "null" is not supported by Swagger 2.0`})
+	}
+
+	securityDefinitions, _ := doc["securityDefinitions"].(map[string]interface{})
+	if security, ok := doc["security"].([]interface{}); ok {
+		errs = append(errs, securityErrors(security, securityDefinitions, "/security")...)
+	}
+
+	operationIDs := make(map[string]string)
+	paths, _ := doc["paths"].(map[string]interface{})
+	for _, path := range sortedKeys(paths) {
+		item, ok := paths[path].(map[string]interface{})
+		if !ok || strings.HasPrefix(path, "x-") {
+			continue
+		}
+		itemPath := "/paths/" + jsonPointerToken(path)
+		pathParams := pathTemplateParams(path)
+		itemParams, _ := item["parameters"].([]interface{})
+
+		for _, method := range operationMethods {
+			method = strings.ToLower(method)
+			op, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			opPath := itemPath + "/" + method
+
+			if id, ok := op["operationId"].(string); ok {
+				if previous, found := operationIDs[id]; found {
+					errs = append(errs, ValidationError{
+						Path:    opPath + "/operationId",
+						Message: "operationId " + id + " is already used by " + previous,
+					})
+				} else {
+					operationIDs[id] = opPath
+				}
+			}
+
+			opParams, _ := op["parameters"].([]interface{})
+			errs = append(errs, pathParamErrors(doc, pathParams, itemParams, opParams, opPath)...)
+
+			if security, ok := op["security"].([]interface{}); ok {
+				errs = append(errs, securityErrors(security, securityDefinitions, opPath+"/security")...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// pathParamErrors checks that parameters of path template are declared and required and
+// there are no path parameters missing in template
+func pathParamErrors(doc map[string]interface{}, pathParams []string, itemParams, opParams []interface{}, opPath string) []ValidationError {
+	var errs []ValidationError
+
+	declared := make(map[string]bool)
+	check := func(params []interface{}, paramsPath string) {
+		for i, param := range params {
+			p, ok := param.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if ref, ok := p["$ref"].(string); ok && strings.HasPrefix(ref, "#") {
+				if resolved, found := jsonPointerValue(doc, ref[1:]); found {
+					p, _ = resolved.(map[string]interface{})
+				}
+			}
+			if p["in"] != "path" {
+				continue
+			}
+
+			name, _ := p["name"].(string)
+			declared[name] = true
+			paramPath := paramsPath + "/" + strconv.Itoa(i)
+
+			if p["required"] != true {
+				errs = append(errs, ValidationError{Path: paramPath, Message: "path parameter " + name + " should be required"})
+			}
+			if !containsString(pathParams, name) {
+				errs = append(errs, ValidationError{Path: paramPath, Message: "path parameter " + name + " is not in path template"})
+			}
+		}
+	}
+	check(opParams, opPath+"/parameters")
+	check(itemParams, opPath[:strings.LastIndex(opPath, "/")]+"/parameters")
+
+	for _, name := range pathParams {
+		if !declared[name] {
+			errs = append(errs, ValidationError{Path: opPath, Message: "path parameter " + name + " is not declared"})
+		}
+	}
+
+	return errs
+}
+
+// securityErrors checks that security requirements refer defined security schemes and OAuth2 scopes
+func securityErrors(security []interface{}, definitions map[string]interface{}, path string) []ValidationError {
+	var errs []ValidationError
+
+	for i, requirement := range security {
+		r, ok := requirement.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, name := range sortedKeys(r) {
+			requirementPath := path + "/" + strconv.Itoa(i) + "/" + jsonPointerToken(name)
+
+			def, found := definitions[name].(map[string]interface{})
+			if !found {
+				errs = append(errs, ValidationError{Path: requirementPath, Message: "security definition " + name + " is not defined"})
+				continue
+			}
+
+			scopes, _ := r[name].([]interface{})
+			if def["type"] != string(SecurityOAuth2) {
+				if len(scopes) > 0 {
+					errs = append(errs, ValidationError{Path: requirementPath, Message: "scopes are allowed only for oauth2 security"})
+				}
+				continue
+			}
+
+			defScopes, _ := def["scopes"].(map[string]interface{})
+			for _, scope := range scopes {
+				if s, ok := scope.(string); ok {
+					if _, found := defScopes[s]; !found {
+						errs = append(errs, ValidationError{Path: requirementPath, Message: "scope " + s + " is not defined"})
+					}
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// collectRefs collects JSON pointers of $ref values of document
+func collectRefs(value interface{}, path string, refs map[string][]string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, item := range value {
+			if ref, ok := item.(string); ok && name == "$ref" {
+				refs[ref] = append(refs[ref], path)
+				continue
+			}
+			collectRefs(item, path+"/"+jsonPointerToken(name), refs)
+		}
+	case []interface{}:
+		for i, item := range value {
+			collectRefs(item, path+"/"+strconv.Itoa(i), refs)
+		}
+	}
+}
+
+// collectNullTypes collects JSON pointers of schemas with "null" type
+func collectNullTypes(value interface{}, path string, nulls map[string]bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, item := range value {
+			if name == "type" && item == "null" {
+				nulls[path] = true
+				continue
+			}
+			// vendor extensions and examples are not schemas
+			if strings.HasPrefix(name, "x-") || name == "example" || name == "examples" || name == "default" || name == "enum" {
+				continue
+			}
+			collectNullTypes(item, path+"/"+jsonPointerToken(name), nulls)
+		}
+	case []interface{}:
+		for i, item := range value {
+			collectNullTypes(item, path+"/"+strconv.Itoa(i), nulls)
+		}
+	}
+}
+
+// pathTemplateParams returns names of parameters of path template
+func pathTemplateParams(path string) []string {
+	var names []string
+	for _, match := range regexFindPathParameter.FindAllStringSubmatch(path, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+func containsString(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]interface{}:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string][]string:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]bool:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,188 @@
+package swgen
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// validateTagOneOfValues splits values of oneof rule, values with spaces are enclosed in single quotes
+var validateTagOneOfValues = regexp.MustCompile(`'[^']*'|\S+`)
+
+// validateTagFormats maps go-playground/validator baked-in rules to Swagger formats
+var validateTagFormats = map[string]string{
+	"email":    "email",
+	"uuid":     "uuid",
+	"uuid3":    "uuid",
+	"uuid4":    "uuid",
+	"uuid5":    "uuid",
+	"uri":      "uri",
+	"url":      "uri",
+	"hostname": "hostname",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"base64":   "byte",
+}
+
+// ReflectValidateTags controls translation of go-playground/validator `validate` tags into
+// required lists, validation keywords, formats and enums of definitions and parameters
+func (g *Generator) ReflectValidateTags(enabled bool) *Generator {
+	g.mu.Lock()
+	g.reflectValidateTags = enabled
+	g.mu.Unlock()
+	g.invalidateCache()
+	return g
+}
+
+// parseValidateTag applies rules of `validate` field tag to schema object,
+// it returns true if the field is required
+func (g *Generator) parseValidateTag(field reflect.StructField, so *SchemaObj) (required bool) {
+	if !g.reflectValidateTags {
+		return false
+	}
+
+	tag := field.Tag.Get("validate")
+	if tag == "" || tag == "-" {
+		return false
+	}
+
+	rules := strings.Split(tag, ",")
+	var diveRules []string
+	for i, rule := range rules {
+		if rule == "dive" {
+			rules, diveRules = rules[:i], rules[i+1:]
+			break
+		}
+	}
+
+	for _, rule := range rules {
+		if rule == "required" {
+			required = true
+		}
+	}
+	g.applyValidateRules(field.Type, so, rules)
+
+	if len(diveRules) > 0 {
+		// rules after dive are applied to items of slice, array or map
+		t := field.Type
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		var items *SchemaObj
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			items = so.Items
+		case reflect.Map:
+			items = so.AdditionalProperties
+		}
+		if items != nil && items.Ref == "" {
+			g.applyValidateRules(t.Elem(), items, diveRules)
+		}
+	}
+
+	return required
+}
+
+func (g *Generator) applyValidateRules(t reflect.Type, so *SchemaObj, rules []string) {
+	for _, rule := range rules {
+		if rule == "dive" {
+			return
+		}
+
+		// alternative rules can not be expressed with validation keywords
+		if strings.Contains(rule, "|") {
+			continue
+		}
+
+		name, param := rule, ""
+		if pos := strings.Index(rule, "="); pos != -1 {
+			name, param = rule[:pos], rule[pos+1:]
+		}
+
+		if format, ok := validateTagFormats[name]; ok {
+			so.Format = format
+			continue
+		}
+
+		switch name {
+		case "min", "gte":
+			setLowerLimit(so, param, false)
+		case "max", "lte":
+			setUpperLimit(so, param, false)
+		case "gt":
+			setLowerLimit(so, param, true)
+		case "lt":
+			setUpperLimit(so, param, true)
+		case "len":
+			setLowerLimit(so, param, false)
+			setUpperLimit(so, param, false)
+		case "eq":
+			if value, err := g.caseDefaultValue(t, param); err == nil {
+				so.Enum.Enum = []interface{}{value}
+			}
+		case "oneof":
+			var values []interface{}
+			for _, item := range validateTagOneOfValues.FindAllString(param, -1) {
+				if value, err := g.caseDefaultValue(t, strings.Trim(item, "'")); err == nil {
+					values = append(values, value)
+				}
+			}
+			if len(values) > 0 {
+				so.Enum.Enum = values
+			}
+		}
+	}
+}
+
+// setLowerLimit sets minimum, minLength or minItems depending on schema type
+func setLowerLimit(so *SchemaObj, param string, exclusive bool) {
+	switch so.Type {
+	case "integer", "number":
+		if value, err := strconv.ParseFloat(param, 64); err == nil {
+			so.Minimum = &value
+			so.ExclusiveMinimum = exclusive
+		}
+	case "string", "array":
+		value, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return
+		}
+		if exclusive {
+			value++
+		}
+		if so.Type == "string" {
+			so.MinLength = &value
+		} else {
+			so.MinItems = &value
+		}
+	}
+}
+
+// setUpperLimit sets maximum, maxLength or maxItems depending on schema type
+func setUpperLimit(so *SchemaObj, param string, exclusive bool) {
+	switch so.Type {
+	case "integer", "number":
+		if value, err := strconv.ParseFloat(param, 64); err == nil {
+			so.Maximum = &value
+			so.ExclusiveMaximum = exclusive
+		}
+	case "string", "array":
+		value, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return
+		}
+		if exclusive {
+			value--
+		}
+		if value < 0 {
+			return
+		}
+		if so.Type == "string" {
+			so.MaxLength = &value
+		} else {
+			so.MaxItems = &value
+		}
+	}
+}
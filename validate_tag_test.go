@@ -0,0 +1,77 @@
+package swgen
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testValidateTags struct {
+	Email    string            `json:"email" validate:"required,email"`
+	Name     string            `json:"name" validate:"required,min=1,max=100"`
+	Age      int               `json:"age" validate:"gte=18,lt=150"`
+	Status   string            `json:"status" validate:"omitempty,oneof=active 'on hold' closed"`
+	Level    int               `json:"level" validate:"oneof=1 2 3"`
+	IDs      []string          `json:"ids" validate:"required,min=1,dive,uuid4"`
+	Labels   map[string]string `json:"labels" validate:"max=5,dive,max=32"`
+	Code     string            `json:"code" validate:"len=8" maxLength:"10"`
+	Homepage *string           `json:"homepage" validate:"omitempty,url|email"`
+	Ignored  string            `json:"ignored" validate:"-"`
+}
+
+type testValidateParams struct {
+	Limit  int    `schema:"limit" in:"query" validate:"omitempty,min=1,max=100"`
+	Sort   string `schema:"sort" in:"query" validate:"required,oneof=asc desc"`
+	Filter string `schema:"filter" in:"query"`
+	Token  string `schema:"token" in:"query" required:"false" validate:"required,uuid"`
+}
+
+func TestReflectValidateTags(t *testing.T) {
+	g := NewGenerator().ReflectValidateTags(true)
+	if _, err := g.ParseDefinition(testValidateTags{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	typeDef, found := g.getDefinition(reflect.TypeOf(testValidateTags{}))
+	if !found {
+		t.Fatal("No definition for testValidateTags")
+	}
+
+	assertTrue(reflect.DeepEqual(typeDef.Required, []string{"email", "name", "ids"}), t)
+
+	props := typeDef.Properties
+	assertTrue(props["email"].Format == "email", t)
+	assertTrue(*props["name"].MinLength == 1 && *props["name"].MaxLength == 100, t)
+	assertTrue(*props["age"].Minimum == 18 && !props["age"].ExclusiveMinimum, t)
+	assertTrue(*props["age"].Maximum == 150 && props["age"].ExclusiveMaximum, t)
+	assertTrue(reflect.DeepEqual(props["status"].Enum.Enum, []interface{}{"active", "on hold", "closed"}), t)
+	assertTrue(reflect.DeepEqual(props["level"].Enum.Enum, []interface{}{int64(1), int64(2), int64(3)}), t)
+	assertTrue(*props["ids"].MinItems == 1, t)
+	assertTrue(props["ids"].Items.Format == "uuid", t)
+	assertTrue(props["labels"].AdditionalProperties.MaxLength != nil && *props["labels"].AdditionalProperties.MaxLength == 32, t)
+	assertTrue(*props["code"].MinLength == 8 && *props["code"].MaxLength == 10, t)
+	assertTrue(props["homepage"].Format == "", t)
+	assertTrue(props["ignored"].MinLength == nil, t)
+
+	_, params, err := g.ParseParameter(testValidateParams{})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	assertFalse(params[0].Required, t)
+	assertTrue(*params[0].Minimum == 1 && *params[0].Maximum == 100, t)
+	assertTrue(params[1].Required, t)
+	assertTrue(len(params[1].Enum.Enum) == 2, t)
+	assertTrue(params[2].Required, t)
+	assertFalse(params[3].Required, t)
+	assertTrue(params[3].Format == "uuid", t)
+}
+
+func TestReflectValidateTagsDisabled(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.ParseDefinition(testValidateTags{}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	typeDef, _ := g.getDefinition(reflect.TypeOf(testValidateTags{}))
+	assertTrue(len(typeDef.Required) == 0, t)
+	assertTrue(typeDef.Properties["email"].Format == "", t)
+}
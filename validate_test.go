@@ -0,0 +1,154 @@
+package swgen
+
+import (
+	"testing"
+)
+
+type testValidateDocParams struct {
+	ID    int64 `schema:"id" in:"path"`
+	Limit int   `schema:"limit" in:"query" required:"false"`
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGenerator()
+	g.SetInfo("Test", "", "", "1.0")
+	g.AddSecurityDefinition("BasicAuth", SecurityDef{Type: SecurityBasicAuth})
+
+	if err := g.SetPathItem(PathItemInfo{Path: "/persons/{id}", Method: "GET", Security: []string{"BasicAuth"}},
+		testValidateDocParams{}, nil, Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+	if err := g.SetPathItem(PathItemInfo{Path: "/persons/{id}", Method: "PUT"}, testValidateDocParams{}, Person{}, []Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	errs := g.Validate()
+	for _, e := range errs {
+		t.Error(e)
+	}
+	assertTrue(errs == nil, t)
+}
+
+func TestValidateErrors(t *testing.T) {
+	g := NewGenerator()
+	g.AddSecurityDefinition("BasicAuth", SecurityDef{Type: SecurityBasicAuth})
+
+	list := PathItemInfo{Path: "/users", Method: "GET"}
+	list.AddExtendedField("operationId", "getUser")
+	g.SetPathItem(list, testValidateDocParams{}, nil, []Person{})
+
+	get := PathItemInfo{Path: "/users/{name}", Method: "GET", SecurityOAuth2: map[string][]string{"BasicAuth": {"read"}}}
+	get.AddExtendedField("operationId", "getUser")
+	g.SetPathItem(get, nil, nil, Person{})
+
+	errs := g.Validate()
+	expected := []string{
+		`/paths/~1users/get/parameters/0: path parameter id is not in path template`,
+		`/paths/~1users~1{name}/get/operationId: operationId getUser is already used by /paths/~1users/get`,
+		`/paths/~1users~1{name}/get: path parameter name is not declared`,
+		`/paths/~1users~1{name}/get/security/0/BasicAuth: scopes are allowed only for oauth2 security`,
+	}
+
+	assertTrue(len(errs) == len(expected), t)
+	for i, e := range errs {
+		if i < len(expected) && e.Error() != expected[i] {
+			t.Errorf("unexpected error %q, %q expected", e.Error(), expected[i])
+		}
+	}
+}
+
+func TestValidateDocumentErrors(t *testing.T) {
+	errs := validateDocument([]byte(`{"swagger":"2.0","info":{"title":"","version":""},` +
+		`"paths":{"/users":{"get":{"responses":{"200":{"description":"","schema":{"$ref":"#/definitions/User"}}},` +
+		`"security":[{"APIKey":[]},{"OAuth2":["read","write"]}]}}},` +
+		`"securityDefinitions":{"OAuth2":{"type":"oauth2","flow":"implicit","authorizationUrl":"http://example.com","scopes":{"read":""}}}}`))
+	expected := []string{
+		`/paths/~1users/get/responses/200/schema: unresolvable reference #/definitions/User`,
+		`/paths/~1users/get/security/0/APIKey: security definition APIKey is not defined`,
+		`/paths/~1users/get/security/1/OAuth2: scope write is not defined`,
+	}
+
+	assertTrue(len(errs) == len(expected), t)
+	for i, e := range errs {
+		if i < len(expected) && e.Error() != expected[i] {
+			t.Errorf("unexpected error %q, %q expected", e.Error(), expected[i])
+		}
+	}
+
+	errs = validateDocument([]byte(`[]`))
+	assertTrue(len(errs) == 1 && errs[0].Error() == "object expected, array given", t)
+}
+
+func TestValidateSchemaErrors(t *testing.T) {
+	g := NewGenerator()
+	g.AddExtendedField("x-service-type", ServiceTypeJSONRPC)
+	g.SetPathItem(PathItemInfo{Path: "user.get", Method: "POST"}, nil, Person{}, nil)
+
+	errs := g.Validate()
+	expected := []string{
+		`/paths/user.get: property is not allowed`,
+		`/paths/user.get/post/responses/200/schema: type "null" is not supported by Swagger 2.0`,
+	}
+
+	assertTrue(len(errs) == len(expected), t)
+	for i, e := range errs {
+		if i < len(expected) && e.Error() != expected[i] {
+			t.Errorf("unexpected error %q, %q expected", e.Error(), expected[i])
+		}
+	}
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	v := &jsonSchemaValidator{schemas: map[string]interface{}{
+		"test": map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"name"},
+			"properties": map[string]interface{}{
+				"name":  map[string]interface{}{"type": "string", "minLength": float64(2)},
+				"count": map[string]interface{}{"$ref": "#/definitions/count"},
+				"kind": map[string]interface{}{"oneOf": []interface{}{
+					map[string]interface{}{"enum": []interface{}{"a"}},
+					map[string]interface{}{"type": "string", "pattern": "^b"},
+				}},
+			},
+			"additionalProperties": false,
+			"definitions": map[string]interface{}{
+				"count": map[string]interface{}{"type": "integer", "minimum": float64(0)},
+			},
+		},
+	}}
+	loc := jsonSchemaLocation{schema: v.schemas["test"], id: "test"}
+
+	assertTrue(len(v.validate(loc, map[string]interface{}{"name": "ab", "count": float64(1), "kind": "a"}, "")) == 0, t)
+	assertTrue(len(v.validate(loc, map[string]interface{}{"name": "ab", "kind": "bc"}, "")) == 0, t)
+
+	errs := v.validate(loc, map[string]interface{}{"name": "a", "count": 1.5, "kind": "c", "other": true}, "")
+	expected := []string{
+		`/count: integer expected, number given`,
+		`/kind: value does not match pattern ^b`,
+		`/name: length should be at least 2`,
+		`/other: property is not allowed`,
+	}
+
+	assertTrue(len(errs) == len(expected), t)
+	for i, e := range errs {
+		if i < len(expected) && e.Error() != expected[i] {
+			t.Errorf("unexpected error %q, %q expected", e.Error(), expected[i])
+		}
+	}
+
+	errs = v.validate(loc, "name", "")
+	assertTrue(len(errs) == 1 && errs[0].Error() == "object expected, string given", t)
+
+	errs = v.validate(loc, map[string]interface{}{"name": "ab", "count": float64(-1)}, "")
+	assertTrue(len(errs) == 1 && errs[0].Error() == "/count: value should be greater than or equal to 0", t)
+
+	bounds := jsonSchemaLocation{schema: map[string]interface{}{
+		"type": "number", "minimum": float64(0), "exclusiveMinimum": true, "maximum": float64(10),
+	}, id: "test"}
+	errs = v.validate(bounds, float64(0), "")
+	assertTrue(len(errs) == 1 && errs[0].Error() == "value should be greater than 0", t)
+	errs = v.validate(bounds, float64(11), "")
+	assertTrue(len(errs) == 1 && errs[0].Error() == "value should be less than or equal to 10", t)
+	assertTrue(len(v.validate(bounds, float64(10), "")) == 0, t)
+}
@@ -0,0 +1,351 @@
+package swgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContractViolation describes a mismatch between actual value and its specification in document
+type ContractViolation struct {
+	In      string `json:"in"`             // Location of value: "path", "query", "header", "formData" or "body"
+	Name    string `json:"name,omitempty"` // Name of parameter or path to property of body, e.g. "items[0].name"
+	Message string `json:"message"`
+}
+
+// Error implements error interface
+func (v ContractViolation) Error() string {
+	if v.Name == "" {
+		return v.In + ": " + v.Message
+	}
+	return v.In + " " + v.Name + ": " + v.Message
+}
+
+// schemaValidator checks decoded JSON values against schema objects
+type schemaValidator struct {
+	definitions map[string]SchemaObj
+	in          string
+	response    bool // value of response is checked, otherwise value of request
+	violations  []ContractViolation
+}
+
+func (v *schemaValidator) addViolation(name, format string, args ...interface{}) {
+	v.violations = append(v.violations, ContractViolation{
+		In:      v.in,
+		Name:    name,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// resolve follows schema references, it returns false if reference can not be resolved
+func (v *schemaValidator) resolve(so SchemaObj) (SchemaObj, bool) {
+	for i := 0; so.Ref != "" && i < 32; i++ {
+		if !strings.HasPrefix(so.Ref, refDefinitionPrefix) {
+			return so, false
+		}
+
+		def, ok := v.definitions[so.Ref[len(refDefinitionPrefix):]]
+		if !ok {
+			return so, false
+		}
+		so = def
+	}
+	return so, so.Ref == ""
+}
+
+// validate checks JSON value decoded with json.Decoder.UseNumber against schema object
+func (v *schemaValidator) validate(so SchemaObj, value interface{}, name string) {
+	// x-nullable is set on definition or on allOf wrapper of reference,
+	// pointer fields accept null when nullable fields are not reflected
+	nullable := isNullable(so) || so.goPointer
+	so, ok := v.resolve(so)
+	if !ok {
+		return // unknown schema accepts any value
+	}
+
+	if value == nil && (nullable || isNullable(so)) {
+		return
+	}
+
+	for _, s := range so.AllOf {
+		v.validate(s, value, name)
+	}
+
+	if oneOf, ok := so.data["x-oneOf"].([]SchemaObj); ok && len(oneOf) > 0 {
+		v.validateOneOf(so, oneOf, value, name)
+		if _, discriminated := so.data["x-discriminator"]; !discriminated {
+			return // members of union without discriminator are not necessarily objects
+		}
+	}
+
+	switch so.Type {
+	case "object":
+		v.validateObject(so, value, name)
+	case "array":
+		v.validateArray(so, value, name)
+	case "string":
+		v.validateString(so, value, name)
+	case "integer", "number":
+		v.validateNumber(so, value, name)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.addViolation(name, "boolean expected, %s given", jsonTypeName(value))
+		}
+	}
+
+	if len(so.Enum.Enum) > 0 && !enumContains(so.Enum.Enum, value) {
+		v.addViolation(name, "value is not one of %s", enumString(so.Enum.Enum))
+	}
+}
+
+// isOmitted checks if property is not sent in checked value,
+// write only properties are not sent in responses and read only properties are not sent in requests
+func (v *schemaValidator) isOmitted(property SchemaObj) bool {
+	if v.response {
+		return isWriteOnly(property)
+	}
+	return property.ReadOnly
+}
+
+// validateOneOf checks that value matches exactly one member of union,
+// member of discriminated union is selected by value of discriminator property
+func (v *schemaValidator) validateOneOf(so SchemaObj, oneOf []SchemaObj, value interface{}, name string) {
+	if discriminator, ok := so.data["x-discriminator"].(string); ok {
+		// missing or unknown value of discriminator is reported by validation of discriminator property
+		obj, _ := value.(map[string]interface{})
+		typeName, _ := obj[discriminator].(string)
+		for _, member := range oneOf {
+			if typeName != "" && member.Ref == refDefinitionPrefix+typeName {
+				v.validate(member, value, name)
+			}
+		}
+		return
+	}
+
+	matched := 0
+	for _, member := range oneOf {
+		mv := schemaValidator{definitions: v.definitions, in: v.in, response: v.response}
+		mv.validate(member, value, name)
+		if len(mv.violations) == 0 {
+			matched++
+		}
+	}
+
+	switch {
+	case matched == 0:
+		v.addViolation(name, "value does not match any schema of oneOf")
+	case matched > 1:
+		v.addViolation(name, "value matches %d schemas of oneOf, exactly one expected", matched)
+	}
+}
+
+func (v *schemaValidator) validateObject(so SchemaObj, value interface{}, name string) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		v.addViolation(name, "object expected, %s given", jsonTypeName(value))
+		return
+	}
+
+	for _, required := range so.Required {
+		if property, ok := so.Properties[required]; ok && v.isOmitted(property) {
+			continue
+		}
+		if _, ok := obj[required]; !ok {
+			v.addViolation(joinName(name, required), "required property is missing")
+		}
+	}
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		item := obj[key]
+		if property, ok := so.Properties[key]; ok {
+			v.validate(property, item, joinName(name, key))
+		} else if so.AdditionalProperties != nil {
+			v.validate(*so.AdditionalProperties, item, joinName(name, key))
+		}
+	}
+}
+
+func (v *schemaValidator) validateArray(so SchemaObj, value interface{}, name string) {
+	items, ok := value.([]interface{})
+	if !ok {
+		v.addViolation(name, "array expected, %s given", jsonTypeName(value))
+		return
+	}
+
+	if so.MinItems != nil && int64(len(items)) < *so.MinItems {
+		v.addViolation(name, "at least %d items expected, %d given", *so.MinItems, len(items))
+	}
+	if so.MaxItems != nil && int64(len(items)) > *so.MaxItems {
+		v.addViolation(name, "at most %d items expected, %d given", *so.MaxItems, len(items))
+	}
+
+	if so.UniqueItems {
+	unique:
+		for i := range items {
+			for j := 0; j < i; j++ {
+				if reflect.DeepEqual(items[i], items[j]) {
+					v.addViolation(name, "items are not unique")
+					break unique
+				}
+			}
+		}
+	}
+
+	if so.Items != nil {
+		for i, item := range items {
+			v.validate(*so.Items, item, name+"["+strconv.Itoa(i)+"]")
+		}
+	}
+}
+
+func (v *schemaValidator) validateString(so SchemaObj, value interface{}, name string) {
+	s, ok := value.(string)
+	if !ok {
+		v.addViolation(name, "string expected, %s given", jsonTypeName(value))
+		return
+	}
+
+	length := int64(utf8.RuneCountInString(s))
+	if so.MinLength != nil && length < *so.MinLength {
+		v.addViolation(name, "length should be at least %d", *so.MinLength)
+	}
+	if so.MaxLength != nil && length > *so.MaxLength {
+		v.addViolation(name, "length should be at most %d", *so.MaxLength)
+	}
+
+	if so.Pattern != "" {
+		// patterns that are not supported by regexp package are skipped
+		if re, err := regexp.Compile(so.Pattern); err == nil && !re.MatchString(s) {
+			v.addViolation(name, "value does not match pattern %s", so.Pattern)
+		}
+	}
+
+	switch so.Format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			v.addViolation(name, "date-time expected: %s", err.Error())
+		}
+	case "date":
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			v.addViolation(name, "date expected: %s", err.Error())
+		}
+	}
+}
+
+func (v *schemaValidator) validateNumber(so SchemaObj, value interface{}, name string) {
+	num, ok := value.(json.Number)
+	if !ok {
+		v.addViolation(name, "%s expected, %s given", so.Type, jsonTypeName(value))
+		return
+	}
+
+	f, err := num.Float64()
+	if err != nil {
+		v.addViolation(name, "invalid number: %s", err.Error())
+		return
+	}
+
+	if so.Type == "integer" && f != math.Trunc(f) {
+		v.addViolation(name, "integer expected, %s given", num)
+		return
+	}
+
+	if so.Minimum != nil {
+		if so.ExclusiveMinimum && f <= *so.Minimum {
+			v.addViolation(name, "value should be greater than %v", *so.Minimum)
+		} else if f < *so.Minimum {
+			v.addViolation(name, "value should be greater than or equal to %v", *so.Minimum)
+		}
+	}
+	if so.Maximum != nil {
+		if so.ExclusiveMaximum && f >= *so.Maximum {
+			v.addViolation(name, "value should be less than %v", *so.Maximum)
+		} else if f > *so.Maximum {
+			v.addViolation(name, "value should be less than or equal to %v", *so.Maximum)
+		}
+	}
+	if so.MultipleOf > 0 {
+		if q := f / so.MultipleOf; math.Abs(q-math.Floor(q+0.5)) > 1e-9 {
+			v.addViolation(name, "value should be multiple of %v", so.MultipleOf)
+		}
+	}
+}
+
+// isNullable checks if schema is marked with x-nullable extension
+func isNullable(so SchemaObj) bool {
+	nullable, _ := so.data["x-nullable"].(bool)
+	return nullable
+}
+
+func joinName(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number, float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// enumContains checks if JSON value equals one of enum items, items are compared by JSON representation
+func enumContains(enum []interface{}, value interface{}) bool {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+
+	num, isNumber := value.(json.Number)
+	for _, item := range enum {
+		itemJSON, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		if string(itemJSON) == string(valueJSON) {
+			return true
+		}
+		if isNumber {
+			if a, err := num.Float64(); err == nil {
+				if b, err := strconv.ParseFloat(string(itemJSON), 64); err == nil && a == b {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func enumString(enum []interface{}) string {
+	data, err := json.Marshal(enum)
+	if err != nil {
+		return fmt.Sprint(enum)
+	}
+	return string(data)
+}
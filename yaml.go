@@ -0,0 +1,196 @@
+package swgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// yamlMap is a JSON object which keeps the original order of keys
+type yamlMap struct {
+	keys   []string
+	values []interface{}
+}
+
+var (
+	// yamlPlainScalar matches strings that can be written in YAML without quotes
+	yamlPlainScalar = regexp.MustCompile(`^[a-zA-Z_/$][a-zA-Z0-9_/$.{}() -]*$`)
+
+	// yamlReservedScalars can not be used as plain strings because they would be resolved to non-string values
+	yamlReservedScalars = map[string]bool{
+		"y": true, "yes": true, "n": true, "no": true,
+		"true": true, "false": true, "on": true, "off": true,
+		"null": true, "~": true,
+	}
+)
+
+// JSONToYAML converts JSON document to YAML document keeping order of object keys,
+// vendor extensions rendered by MarshalJSON are preserved.
+func JSONToYAML(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	value, err := decodeOrderedJSON(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	switch v := value.(type) {
+	case *yamlMap:
+		if len(v.keys) == 0 {
+			buf.WriteString("{}\n")
+		} else {
+			writeYAMLMap(buf, v, "", false)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString("[]\n")
+		} else {
+			writeYAMLSeq(buf, v, "")
+		}
+	default:
+		buf.WriteString(yamlScalar(v))
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decodeOrderedJSON(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, isDelim := token.(json.Delim)
+	if !isDelim {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		m := &yamlMap{}
+		for dec.More() {
+			keyToken, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, errors.New("unexpected JSON object key")
+			}
+
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			m.keys = append(m.keys, key)
+			m.values = append(m.values, value)
+		}
+		_, err = dec.Token() // closing '}'
+		return m, err
+	case '[':
+		s := make([]interface{}, 0)
+		for dec.More() {
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, value)
+		}
+		_, err = dec.Token() // closing ']'
+		return s, err
+	}
+
+	return nil, errors.New("unexpected JSON delimiter " + delim.String())
+}
+
+func writeYAMLMap(buf *bytes.Buffer, m *yamlMap, indent string, firstInline bool) {
+	for i, key := range m.keys {
+		if i > 0 || !firstInline {
+			buf.WriteString(indent)
+		}
+		buf.WriteString(yamlScalar(key))
+		buf.WriteByte(':')
+		writeYAMLChild(buf, m.values[i], indent)
+	}
+}
+
+func writeYAMLSeq(buf *bytes.Buffer, s []interface{}, indent string) {
+	for _, item := range s {
+		buf.WriteString(indent)
+		buf.WriteByte('-')
+		if m, ok := item.(*yamlMap); ok && len(m.keys) > 0 {
+			buf.WriteByte(' ')
+			writeYAMLMap(buf, m, indent+"  ", true)
+			continue
+		}
+		writeYAMLChild(buf, item, indent)
+	}
+}
+
+// writeYAMLChild writes a value which follows a mapping key or a sequence dash
+func writeYAMLChild(buf *bytes.Buffer, value interface{}, indent string) {
+	switch v := value.(type) {
+	case *yamlMap:
+		if len(v.keys) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAMLMap(buf, v, indent+"  ", false)
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAMLSeq(buf, v, indent+"  ")
+	default:
+		buf.WriteByte(' ')
+		buf.WriteString(yamlScalar(v))
+		buf.WriteByte('\n')
+	}
+}
+
+func yamlScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	case string:
+		if yamlPlainScalar.MatchString(v) && !yamlReservedScalars[strings.ToLower(v)] &&
+			!strings.HasSuffix(v, " ") && !strings.Contains(v, " -") {
+			return v
+		}
+		// JSON string is a valid YAML double-quoted scalar
+		quoted, _ := json.Marshal(v)
+		return string(quoted)
+	}
+
+	return ""
+}
+
+// genDocumentYAML returns document specification in YAML string (in []byte)
+func (g *Generator) genDocumentYAML(host *string) ([]byte, error) {
+	data, err := g.genDocument(host)
+	if err != nil {
+		return nil, err
+	}
+	return JSONToYAML(data)
+}
+
+// GenDocumentYAML returns document specification in YAML string (in []byte)
+func (g *Generator) GenDocumentYAML() ([]byte, error) {
+	// pass nil here to set host as g.host
+	return g.genDocumentYAML(nil)
+}
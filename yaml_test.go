@@ -0,0 +1,91 @@
+package swgen
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJSONToYAML(t *testing.T) {
+	data := []byte(`{"swagger":"2.0","info":{"title":"yes","description":"multi\nline","version":"1.0"},` +
+		`"paths":{"/pets/{id}":{"get":{"tags":["v1"],"parameters":[{"name":"id","in":"path","required":true}],` +
+		`"responses":{"200":{"description":"ok: done"}},"security":[]}}},"definitions":{},"x-custom":null}`)
+
+	expected := `swagger: "2.0"
+info:
+  title: "yes"
+  description: "multi\nline"
+  version: "1.0"
+paths:
+  /pets/{id}:
+    get:
+      tags:
+        - v1
+      parameters:
+        - name: id
+          in: path
+          required: true
+      responses:
+        "200":
+          description: "ok: done"
+      security: []
+definitions: {}
+x-custom: null
+`
+
+	yaml, err := JSONToYAML(data)
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	if string(yaml) != expected {
+		t.Fatalf("unexpected YAML:\n%s\nexpected:\n%s", yaml, expected)
+	}
+}
+
+func TestJSONToYAMLError(t *testing.T) {
+	_, err := JSONToYAML([]byte(`{"swagger":`))
+	assertTrue(err != nil, t)
+}
+
+func TestGenDocumentYAML(t *testing.T) {
+	g := NewGenerator()
+	g.SetHost("localhost:1234").AddExtendedField("x-service-type", ServiceTypeRest)
+
+	info := PathItemInfo{
+		Path:   "/v1/test/handler",
+		Title:  "TestHandler",
+		Method: "GET",
+	}
+	info.AddExtendedField("x-example", "example")
+
+	if err := g.SetPathItem(info, nil, nil, Person{}); err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	data, err := g.GenDocumentYAML()
+	if err != nil {
+		t.Fatalf("error %v", err)
+	}
+
+	yaml := string(data)
+	assertTrue(strings.HasPrefix(yaml, "swagger: \"2.0\"\ninfo:\n"), t)
+	assertTrue(strings.Contains(yaml, "\n      x-example: example\n"), t)
+	assertTrue(strings.HasSuffix(yaml, "\nx-service-type: rest\n"), t)
+
+	for _, r := range []*http.Request{
+		httptest.NewRequest("GET", "http://localhost:1234/docs/swagger.yaml", nil),
+		func() *http.Request {
+			r := httptest.NewRequest("GET", "http://localhost:1234/docs/swagger.json", nil)
+			r.Header.Set("Accept", "application/x-yaml")
+			return r
+		}(),
+	} {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, r)
+
+		assertTrue(w.Header().Get("Content-Type") == "application/x-yaml", t)
+		assertTrue(w.Body.String() == yaml, t)
+	}
+}